@@ -0,0 +1,263 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package alerts
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+//go:generate mockgen -destination=./fake/mock_provider.go -package=fake github.com/vmware-tanzu/octant/internal/alerts Provider
+
+const (
+	// DefaultRefreshInterval is how long alerts are cached before they are
+	// fetched again.
+	DefaultRefreshInterval = 30 * time.Second
+
+	// fetchTimeout bounds how long fetching alerts can take. Fetches aren't
+	// cancelled with the request which started them, since other requests may
+	// be waiting for them.
+	fetchTimeout = 10 * time.Second
+)
+
+// Alert is a firing alert.
+type Alert struct {
+	Name     string
+	Severity string
+	Summary  string
+	Labels   map[string]string
+	StartsAt time.Time
+}
+
+// Namespace returns the namespace an alert is about, or blank if it isn't
+// about a namespace.
+func (a Alert) Namespace() string {
+	return a.Labels["namespace"]
+}
+
+// objectLabel is a label which names the object an alert is about. Exporters
+// such as kube-state-metrics use these labels.
+type objectLabel struct {
+	label      string
+	apiVersion string
+	kind       string
+}
+
+// objectLabels are in order of precedence. An alert about a pod is usually
+// also labelled with the service it was scraped through, so services come last.
+var objectLabels = []objectLabel{
+	{label: "pod", apiVersion: "v1", kind: "Pod"},
+	{label: "deployment", apiVersion: "apps/v1", kind: "Deployment"},
+	{label: "statefulset", apiVersion: "apps/v1", kind: "StatefulSet"},
+	{label: "daemonset", apiVersion: "apps/v1", kind: "DaemonSet"},
+	{label: "replicaset", apiVersion: "apps/v1", kind: "ReplicaSet"},
+	{label: "job_name", apiVersion: "batch/v1", kind: "Job"},
+	{label: "cronjob", apiVersion: "batch/v1beta1", kind: "CronJob"},
+	{label: "persistentvolumeclaim", apiVersion: "v1", kind: "PersistentVolumeClaim"},
+	{label: "horizontalpodautoscaler", apiVersion: "autoscaling/v1", kind: "HorizontalPodAutoscaler"},
+	{label: "node", apiVersion: "v1", kind: "Node"},
+	{label: "service", apiVersion: "v1", kind: "Service"},
+}
+
+// Object returns the api version, kind, and name of the object an alert is
+// about. Alerts which only have a namespace label are about the namespace.
+func (a Alert) Object() (apiVersion, kind, name string, ok bool) {
+	for _, ol := range objectLabels {
+		if name := a.Labels[ol.label]; name != "" {
+			return ol.apiVersion, ol.kind, name, true
+		}
+	}
+
+	if namespace := a.Namespace(); namespace != "" {
+		return "v1", "Namespace", namespace, true
+	}
+
+	return "", "", "", false
+}
+
+// Matches returns true if an alert is about an object. An object matches if
+// the label for its kind names it and, for namespaced objects, the namespace
+// label names its namespace. Alerts about cluster scoped objects are often
+// labelled with the namespace of the exporter, so it is ignored for them.
+func (a Alert) Matches(object runtime.Object) bool {
+	accessor, err := meta.Accessor(object)
+	if err != nil {
+		return false
+	}
+
+	kind := object.GetObjectKind().GroupVersionKind().Kind
+
+	if kind == "Namespace" {
+		_, alertKind, _, ok := a.Object()
+		return ok && alertKind == "Namespace" && a.Namespace() == accessor.GetName()
+	}
+
+	for _, ol := range objectLabels {
+		if ol.kind != kind {
+			continue
+		}
+		if a.Labels[ol.label] != accessor.GetName() {
+			return false
+		}
+		return accessor.GetNamespace() == "" || a.Namespace() == accessor.GetNamespace()
+	}
+
+	return false
+}
+
+// Provider provides firing alerts.
+type Provider interface {
+	// List lists firing alerts.
+	List(ctx context.Context) ([]Alert, error)
+	// ForObject lists firing alerts about an object.
+	ForObject(ctx context.Context, object runtime.Object) ([]Alert, error)
+}
+
+// Source fetches firing alerts from a monitoring system.
+type Source interface {
+	Fetch(ctx context.Context) ([]Alert, error)
+}
+
+// Service is a Provider which caches the alerts fetched from a source, so pages
+// which show alerts don't query the source every time they are generated.
+type Service struct {
+	source          Source
+	refreshInterval time.Duration
+	now             func() time.Time
+
+	mu        sync.Mutex
+	alerts    []Alert
+	err       error
+	fetchedAt time.Time
+	fetching  *fetch
+}
+
+// fetch is a fetch in progress. done is closed once alerts and err are set.
+type fetch struct {
+	done   chan struct{}
+	alerts []Alert
+	err    error
+}
+
+var _ Provider = (*Service)(nil)
+
+// NewService creates an instance of Service. Alerts are fetched again after the
+// refresh interval. The default refresh interval is used if it isn't positive.
+func NewService(source Source, refreshInterval time.Duration) *Service {
+	if refreshInterval <= 0 {
+		refreshInterval = DefaultRefreshInterval
+	}
+
+	return &Service{
+		source:          source,
+		refreshInterval: refreshInterval,
+		now:             time.Now,
+	}
+}
+
+// List lists firing alerts, most severe first. Failures are cached like alerts
+// so an unavailable source isn't queried on every request. Only one fetch runs
+// at a time; concurrent requests wait for it instead of fetching again.
+func (s *Service) List(ctx context.Context) ([]Alert, error) {
+	s.mu.Lock()
+
+	now := s.now()
+	if !s.fetchedAt.IsZero() && now.Sub(s.fetchedAt) < s.refreshInterval {
+		defer s.mu.Unlock()
+		return s.alerts, s.err
+	}
+
+	f := s.fetching
+	if f == nil {
+		f = &fetch{done: make(chan struct{})}
+		s.fetching = f
+		go s.fetch(f, now)
+	}
+
+	s.mu.Unlock()
+
+	select {
+	case <-f.done:
+		return f.alerts, f.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// fetch fetches alerts from the source and caches them. A fetch which times
+// out isn't cached, so the next request tries again.
+func (s *Service) fetch(f *fetch, now time.Time) {
+	ctx, cancel := context.WithTimeout(context.Background(), fetchTimeout)
+	defer cancel()
+
+	alerts, err := s.source.Fetch(ctx)
+	if err == nil {
+		sortAlerts(alerts)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !errors.Is(err, context.DeadlineExceeded) && !errors.Is(err, context.Canceled) {
+		s.alerts, s.err, s.fetchedAt = alerts, err, now
+	}
+	s.fetching = nil
+
+	f.alerts, f.err = alerts, err
+	close(f.done)
+}
+
+// ForObject lists firing alerts about an object.
+func (s *Service) ForObject(ctx context.Context, object runtime.Object) ([]Alert, error) {
+	alerts, err := s.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var matched []Alert
+	for _, alert := range alerts {
+		if alert.Matches(object) {
+			matched = append(matched, alert)
+		}
+	}
+
+	return matched, nil
+}
+
+// severityOrder ranks common severities. Unknown severities are ranked last.
+var severityOrder = map[string]int{
+	"critical": 0,
+	"error":    1,
+	"warning":  2,
+	"info":     3,
+}
+
+func severityRank(severity string) int {
+	if rank, ok := severityOrder[severity]; ok {
+		return rank
+	}
+	return len(severityOrder)
+}
+
+// sortAlerts sorts alerts by severity, then by the longest firing, then by name.
+func sortAlerts(alerts []Alert) {
+	sort.SliceStable(alerts, func(i, j int) bool {
+		a, b := alerts[i], alerts[j]
+		if ra, rb := severityRank(a.Severity), severityRank(b.Severity); ra != rb {
+			return ra < rb
+		}
+		if !a.StartsAt.Equal(b.StartsAt) {
+			return a.StartsAt.Before(b.StartsAt)
+		}
+		return a.Name < b.Name
+	})
+}
@@ -0,0 +1,268 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package alerts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/vmware-tanzu/octant/internal/testutil"
+)
+
+type fakeSource struct {
+	alerts  []Alert
+	err     error
+	fetches int
+}
+
+func (s *fakeSource) Fetch(ctx context.Context) ([]Alert, error) {
+	s.fetches++
+	return s.alerts, s.err
+}
+
+func TestAlert_Object(t *testing.T) {
+	tests := []struct {
+		name       string
+		labels     map[string]string
+		apiVersion string
+		kind       string
+		objectName string
+		ok         bool
+	}{
+		{
+			name:       "pod scraped through a service",
+			labels:     map[string]string{"namespace": "default", "pod": "pod", "service": "kube-state-metrics"},
+			apiVersion: "v1",
+			kind:       "Pod",
+			objectName: "pod",
+			ok:         true,
+		},
+		{
+			name:       "job",
+			labels:     map[string]string{"namespace": "default", "job_name": "job"},
+			apiVersion: "batch/v1",
+			kind:       "Job",
+			objectName: "job",
+			ok:         true,
+		},
+		{
+			name:       "namespace",
+			labels:     map[string]string{"namespace": "default"},
+			apiVersion: "v1",
+			kind:       "Namespace",
+			objectName: "default",
+			ok:         true,
+		},
+		{
+			name:   "no object",
+			labels: map[string]string{"alertname": "Watchdog"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			apiVersion, kind, name, ok := Alert{Labels: test.labels}.Object()
+			assert.Equal(t, test.apiVersion, apiVersion)
+			assert.Equal(t, test.kind, kind)
+			assert.Equal(t, test.objectName, name)
+			assert.Equal(t, test.ok, ok)
+		})
+	}
+}
+
+func TestAlert_Matches(t *testing.T) {
+	pod := testutil.CreatePod("pod")
+	node := testutil.CreateNode("node")
+	namespace := testutil.CreateNamespace("namespace")
+
+	tests := []struct {
+		name     string
+		labels   map[string]string
+		object   runtime.Object
+		expected bool
+	}{
+		{
+			name:     "pod",
+			labels:   map[string]string{"namespace": "namespace", "pod": "pod"},
+			object:   pod,
+			expected: true,
+		},
+		{
+			name:     "pod in another namespace",
+			labels:   map[string]string{"namespace": "other", "pod": "pod"},
+			object:   pod,
+			expected: false,
+		},
+		{
+			name:     "another pod",
+			labels:   map[string]string{"namespace": "namespace", "pod": "other"},
+			object:   pod,
+			expected: false,
+		},
+		{
+			name:     "node labelled with the exporter namespace",
+			labels:   map[string]string{"namespace": "monitoring", "node": "node"},
+			object:   node,
+			expected: true,
+		},
+		{
+			name:     "namespace",
+			labels:   map[string]string{"namespace": "namespace"},
+			object:   namespace,
+			expected: true,
+		},
+		{
+			name:     "object in namespace",
+			labels:   map[string]string{"namespace": "namespace", "pod": "pod"},
+			object:   namespace,
+			expected: false,
+		},
+		{
+			name:     "unsupported kind",
+			labels:   map[string]string{"namespace": "namespace", "configmap": "config-map"},
+			object:   testutil.CreateConfigMap("config-map"),
+			expected: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, Alert{Labels: test.labels}.Matches(test.object))
+		})
+	}
+}
+
+func TestService_List(t *testing.T) {
+	now := time.Unix(1600000000, 0)
+
+	source := &fakeSource{
+		alerts: []Alert{
+			{Name: "b", Severity: "warning", StartsAt: now},
+			{Name: "a", Severity: "warning", StartsAt: now},
+			{Name: "unknown", Severity: "page", StartsAt: now.Add(-time.Hour)},
+			{Name: "older", Severity: "warning", StartsAt: now.Add(-time.Hour)},
+			{Name: "critical", Severity: "critical", StartsAt: now},
+		},
+	}
+
+	service := NewService(source, time.Minute)
+	service.now = func() time.Time { return now }
+
+	got, err := service.List(context.Background())
+	require.NoError(t, err)
+
+	var names []string
+	for _, alert := range got {
+		names = append(names, alert.Name)
+	}
+	assert.Equal(t, []string{"critical", "older", "a", "b", "unknown"}, names)
+
+	_, err = service.List(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, source.fetches, "alerts are cached within the refresh interval")
+
+	service.now = func() time.Time { return now.Add(time.Minute) }
+	_, err = service.List(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, source.fetches, "alerts are fetched again after the refresh interval")
+}
+
+func TestService_List_caches_errors(t *testing.T) {
+	source := &fakeSource{err: errors.New("connection refused")}
+
+	service := NewService(source, 0)
+
+	_, err := service.List(context.Background())
+	require.Error(t, err)
+	_, err = service.List(context.Background())
+	require.Error(t, err)
+
+	assert.Equal(t, 1, source.fetches)
+}
+
+func TestService_List_does_not_cache_timeouts(t *testing.T) {
+	source := &fakeSource{err: fmt.Errorf("fetch alerts: %w", context.DeadlineExceeded)}
+
+	service := NewService(source, 0)
+
+	_, err := service.List(context.Background())
+	require.Error(t, err)
+
+	source.err = nil
+	_, err = service.List(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, source.fetches)
+}
+
+// blockingSource is a source whose fetches wait until it is released.
+type blockingSource struct {
+	release chan struct{}
+	fetches int32
+}
+
+func (s *blockingSource) Fetch(ctx context.Context) ([]Alert, error) {
+	atomic.AddInt32(&s.fetches, 1)
+	select {
+	case <-s.release:
+		return []Alert{{Name: "alert"}}, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func TestService_List_shares_fetches(t *testing.T) {
+	source := &blockingSource{release: make(chan struct{})}
+
+	service := NewService(source, 0)
+
+	// A request which gives up doesn't cancel the fetch others wait for.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := service.List(ctx)
+	require.Equal(t, context.Canceled, err)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			got, err := service.List(context.Background())
+			assert.NoError(t, err)
+			assert.Len(t, got, 1)
+		}()
+	}
+
+	close(source.release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&source.fetches))
+}
+
+func TestService_ForObject(t *testing.T) {
+	source := &fakeSource{
+		alerts: []Alert{
+			{Name: "pod", Labels: map[string]string{"namespace": "namespace", "pod": "pod"}},
+			{Name: "other", Labels: map[string]string{"namespace": "namespace", "pod": "other"}},
+		},
+	}
+
+	service := NewService(source, time.Minute)
+
+	got, err := service.ForObject(context.Background(), testutil.CreatePod("pod"))
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, "pod", got[0].Name)
+}
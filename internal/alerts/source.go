@@ -0,0 +1,148 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package alerts
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	// requestTimeout is how long a source is waited on for alerts.
+	requestTimeout = 10 * time.Second
+)
+
+// NewSource creates a source for an Alertmanager or Prometheus URL. Alertmanager
+// is preferred if both are set because it only returns alerts which aren't
+// silenced or inhibited. It returns nil if neither is set.
+func NewSource(alertmanagerURL, prometheusURL string) Source {
+	client := &http.Client{Timeout: requestTimeout}
+
+	switch {
+	case alertmanagerURL != "":
+		return &AlertmanagerSource{client: client, url: strings.TrimSuffix(alertmanagerURL, "/")}
+	case prometheusURL != "":
+		return &PrometheusSource{client: client, url: strings.TrimSuffix(prometheusURL, "/")}
+	default:
+		return nil
+	}
+}
+
+// AlertmanagerSource fetches active alerts from the Alertmanager v2 API.
+type AlertmanagerSource struct {
+	client *http.Client
+	url    string
+}
+
+var _ Source = (*AlertmanagerSource)(nil)
+
+type alertmanagerAlert struct {
+	Labels      map[string]string `json:"labels"`
+	Annotations map[string]string `json:"annotations"`
+	StartsAt    time.Time         `json:"startsAt"`
+}
+
+// Fetch fetches alerts which are active and aren't silenced or inhibited.
+func (s *AlertmanagerSource) Fetch(ctx context.Context) ([]Alert, error) {
+	var response []alertmanagerAlert
+	if err := getJSON(ctx, s.client, s.url+"/api/v2/alerts?active=true&silenced=false&inhibited=false", &response); err != nil {
+		return nil, fmt.Errorf("fetch alertmanager alerts: %w", err)
+	}
+
+	var alerts []Alert
+	for _, a := range response {
+		alerts = append(alerts, newAlert(a.Labels, a.Annotations, a.StartsAt))
+	}
+
+	return alerts, nil
+}
+
+// PrometheusSource fetches firing alerts from the Prometheus alerts API.
+type PrometheusSource struct {
+	client *http.Client
+	url    string
+}
+
+var _ Source = (*PrometheusSource)(nil)
+
+type prometheusResponse struct {
+	Status string `json:"status"`
+	Error  string `json:"error"`
+	Data   struct {
+		Alerts []struct {
+			Labels      map[string]string `json:"labels"`
+			Annotations map[string]string `json:"annotations"`
+			State       string            `json:"state"`
+			ActiveAt    time.Time         `json:"activeAt"`
+		} `json:"alerts"`
+	} `json:"data"`
+}
+
+// Fetch fetches firing alerts. Pending alerts are skipped.
+func (s *PrometheusSource) Fetch(ctx context.Context) ([]Alert, error) {
+	var response prometheusResponse
+	if err := getJSON(ctx, s.client, s.url+"/api/v1/alerts", &response); err != nil {
+		return nil, fmt.Errorf("fetch prometheus alerts: %w", err)
+	}
+
+	if response.Status != "success" {
+		return nil, fmt.Errorf("fetch prometheus alerts: %s", response.Error)
+	}
+
+	var alerts []Alert
+	for _, a := range response.Data.Alerts {
+		if a.State != "firing" {
+			continue
+		}
+		alerts = append(alerts, newAlert(a.Labels, a.Annotations, a.ActiveAt))
+	}
+
+	return alerts, nil
+}
+
+// newAlert creates an alert from the labels and annotations used by the
+// Prometheus community's alerting rules.
+func newAlert(labels, annotations map[string]string, startsAt time.Time) Alert {
+	summary := annotations["summary"]
+	for _, key := range []string{"message", "description"} {
+		if summary != "" {
+			break
+		}
+		summary = annotations[key]
+	}
+
+	return Alert{
+		Name:     labels["alertname"],
+		Severity: labels["severity"],
+		Summary:  summary,
+		Labels:   labels,
+		StartsAt: startsAt,
+	}
+}
+
+func getJSON(ctx context.Context, client *http.Client, url string, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(v)
+}
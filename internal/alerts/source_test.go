@@ -0,0 +1,97 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package alerts
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSource(t *testing.T) {
+	assert.IsType(t, &AlertmanagerSource{}, NewSource("http://alertmanager", "http://prometheus"))
+	assert.IsType(t, &PrometheusSource{}, NewSource("", "http://prometheus"))
+	assert.Nil(t, NewSource("", ""))
+}
+
+func TestAlertmanagerSource_Fetch(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v2/alerts", r.URL.Path)
+		assert.Equal(t, "true", r.URL.Query().Get("active"))
+		assert.Equal(t, "false", r.URL.Query().Get("silenced"))
+		assert.Equal(t, "false", r.URL.Query().Get("inhibited"))
+
+		_, _ = w.Write([]byte(`[{
+			"labels": {"alertname": "KubePodCrashLooping", "severity": "critical", "namespace": "default", "pod": "pod"},
+			"annotations": {"description": "Pod is restarting.", "summary": "Pod is crash looping."},
+			"startsAt": "2020-09-13T12:26:40Z"
+		}]`))
+	}))
+	defer server.Close()
+
+	source := NewSource(server.URL+"/", "")
+
+	got, err := source.Fetch(context.Background())
+	require.NoError(t, err)
+
+	expected := []Alert{
+		{
+			Name:     "KubePodCrashLooping",
+			Severity: "critical",
+			Summary:  "Pod is crash looping.",
+			Labels:   map[string]string{"alertname": "KubePodCrashLooping", "severity": "critical", "namespace": "default", "pod": "pod"},
+			StartsAt: time.Date(2020, 9, 13, 12, 26, 40, 0, time.UTC),
+		},
+	}
+	assert.Equal(t, expected, got)
+}
+
+func TestAlertmanagerSource_Fetch_error(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	_, err := NewSource(server.URL, "").Fetch(context.Background())
+	require.Error(t, err)
+}
+
+func TestPrometheusSource_Fetch(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/alerts", r.URL.Path)
+
+		_, _ = w.Write([]byte(`{
+			"status": "success",
+			"data": {"alerts": [
+				{
+					"labels": {"alertname": "KubeDeploymentReplicasMismatch", "severity": "warning", "namespace": "default", "deployment": "deployment"},
+					"annotations": {"message": "Deployment has not matched the expected number of replicas."},
+					"state": "firing",
+					"activeAt": "2020-09-13T12:26:40Z"
+				},
+				{
+					"labels": {"alertname": "KubePodNotReady", "severity": "warning"},
+					"annotations": {},
+					"state": "pending",
+					"activeAt": "2020-09-13T12:26:40Z"
+				}
+			]}
+		}`))
+	}))
+	defer server.Close()
+
+	got, err := NewSource("", server.URL).Fetch(context.Background())
+	require.NoError(t, err)
+
+	require.Len(t, got, 1)
+	assert.Equal(t, "KubeDeploymentReplicasMismatch", got[0].Name)
+	assert.Equal(t, "Deployment has not matched the expected number of replicas.", got[0].Summary)
+}
@@ -0,0 +1,263 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package analysis finds common misconfigurations in the objects of a namespace.
+package analysis
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/vmware-tanzu/octant/internal/util/kubernetes"
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+const (
+	// CheckServiceWithoutPods flags services whose selector matches no pods.
+	CheckServiceWithoutPods = "Service without pods"
+	// CheckPendingClaim flags persistent volume claims which aren't bound.
+	CheckPendingClaim = "Pending claim"
+	// CheckLatestImage flags containers which run a mutable latest image.
+	CheckLatestImage = "Latest image"
+	// CheckMissingRequests flags containers without cpu or memory requests.
+	CheckMissingRequests = "Missing resource requests"
+)
+
+// Object identifies the object a finding is about.
+type Object struct {
+	APIVersion string
+	Kind       string
+	Namespace  string
+	Name       string
+}
+
+// Finding is a misconfiguration found in a namespace.
+type Finding struct {
+	// Check is the name of the check which found the problem.
+	Check string
+	// Object is the object with the problem.
+	Object Object
+	// Message describes the problem.
+	Message string
+}
+
+// Check looks for a misconfiguration in a namespace.
+type Check func(ctx context.Context, objectStore store.Store, namespace string) ([]Finding, error)
+
+// DefaultChecks are the checks run for a namespace.
+var DefaultChecks = []Check{
+	ServicesWithoutPods,
+	PendingClaims,
+	PodContainers,
+}
+
+// Namespace runs checks against a namespace. Findings are ordered by object
+// kind and name.
+func Namespace(ctx context.Context, objectStore store.Store, namespace string, checks ...Check) ([]Finding, error) {
+	if objectStore == nil {
+		return nil, errors.New("object store is nil")
+	}
+
+	if len(checks) == 0 {
+		checks = DefaultChecks
+	}
+
+	var findings []Finding
+	for _, check := range checks {
+		found, err := check(ctx, objectStore, namespace)
+		if err != nil {
+			return nil, err
+		}
+		findings = append(findings, found...)
+	}
+
+	sort.SliceStable(findings, func(i, j int) bool {
+		a, b := findings[i].Object, findings[j].Object
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		return a.Name < b.Name
+	})
+
+	return findings, nil
+}
+
+// ServicesWithoutPods finds services with a selector which doesn't match any pods.
+func ServicesWithoutPods(ctx context.Context, objectStore store.Store, namespace string) ([]Finding, error) {
+	key := store.Key{Namespace: namespace, APIVersion: "v1", Kind: "Service"}
+	list, _, err := objectStore.List(ctx, key)
+	if err != nil {
+		return nil, errors.Wrapf(err, "list all objects for key %+v", key)
+	}
+
+	var findings []Finding
+	for i := range list.Items {
+		service := &corev1.Service{}
+		if err := kubernetes.FromUnstructured(&list.Items[i], service); err != nil {
+			return nil, err
+		}
+
+		if len(service.Spec.Selector) == 0 || service.Spec.Type == corev1.ServiceTypeExternalName {
+			continue
+		}
+
+		selector := labels.Set(service.Spec.Selector)
+		podKey := store.Key{Namespace: namespace, APIVersion: "v1", Kind: "Pod", Selector: &selector}
+		pods, _, err := objectStore.List(ctx, podKey)
+		if err != nil {
+			return nil, errors.Wrapf(err, "list all objects for key %+v", podKey)
+		}
+
+		if len(pods.Items) > 0 {
+			continue
+		}
+
+		findings = append(findings, Finding{
+			Check:   CheckServiceWithoutPods,
+			Object:  objectFor("v1", "Service", &service.ObjectMeta),
+			Message: fmt.Sprintf("Selector %s doesn't match any pods", selector.String()),
+		})
+	}
+
+	return findings, nil
+}
+
+// PendingClaims finds persistent volume claims which are waiting for a volume.
+func PendingClaims(ctx context.Context, objectStore store.Store, namespace string) ([]Finding, error) {
+	key := store.Key{Namespace: namespace, APIVersion: "v1", Kind: "PersistentVolumeClaim"}
+	list, _, err := objectStore.List(ctx, key)
+	if err != nil {
+		return nil, errors.Wrapf(err, "list all objects for key %+v", key)
+	}
+
+	var findings []Finding
+	for i := range list.Items {
+		pvc := &corev1.PersistentVolumeClaim{}
+		if err := kubernetes.FromUnstructured(&list.Items[i], pvc); err != nil {
+			return nil, err
+		}
+
+		if pvc.Status.Phase != corev1.ClaimPending {
+			continue
+		}
+
+		message := "Claim is not bound to a persistent volume"
+		if pvc.Spec.StorageClassName != nil && *pvc.Spec.StorageClassName != "" {
+			message = fmt.Sprintf("Claim is waiting for a volume from storage class %s", *pvc.Spec.StorageClassName)
+		}
+
+		findings = append(findings, Finding{
+			Check:   CheckPendingClaim,
+			Object:  objectFor("v1", "PersistentVolumeClaim", &pvc.ObjectMeta),
+			Message: message,
+		})
+	}
+
+	return findings, nil
+}
+
+// PodContainers finds containers running latest images or without resource
+// requests. Pods created by a controller are reported once for the controller.
+func PodContainers(ctx context.Context, objectStore store.Store, namespace string) ([]Finding, error) {
+	key := store.Key{Namespace: namespace, APIVersion: "v1", Kind: "Pod"}
+	list, _, err := objectStore.List(ctx, key)
+	if err != nil {
+		return nil, errors.Wrapf(err, "list all objects for key %+v", key)
+	}
+
+	seen := make(map[Finding]bool)
+
+	var findings []Finding
+	add := func(finding Finding) {
+		if seen[finding] {
+			return
+		}
+		seen[finding] = true
+		findings = append(findings, finding)
+	}
+
+	for i := range list.Items {
+		pod := &corev1.Pod{}
+		if err := kubernetes.FromUnstructured(&list.Items[i], pod); err != nil {
+			return nil, err
+		}
+
+		object := objectFor("v1", "Pod", &pod.ObjectMeta)
+		if ownerReference := metav1.GetControllerOf(pod); ownerReference != nil {
+			object = Object{
+				APIVersion: ownerReference.APIVersion,
+				Kind:       ownerReference.Kind,
+				Namespace:  pod.Namespace,
+				Name:       ownerReference.Name,
+			}
+		}
+
+		for _, container := range append(pod.Spec.InitContainers, pod.Spec.Containers...) {
+			if IsLatestImage(container.Image) {
+				add(Finding{
+					Check:   CheckLatestImage,
+					Object:  object,
+					Message: fmt.Sprintf("Container %s runs image %s which can change without notice", container.Name, container.Image),
+				})
+			}
+
+			if missing := missingRequests(container); len(missing) > 0 {
+				add(Finding{
+					Check:   CheckMissingRequests,
+					Object:  object,
+					Message: fmt.Sprintf("Container %s has no %s requests", container.Name, strings.Join(missing, " or ")),
+				})
+			}
+		}
+	}
+
+	return findings, nil
+}
+
+// IsLatestImage returns true if an image refers to the latest tag, either
+// explicitly or by omitting the tag. Images pinned by digest are never latest.
+func IsLatestImage(image string) bool {
+	if strings.Contains(image, "@") {
+		return false
+	}
+
+	name := image
+	if i := strings.LastIndex(image, "/"); i >= 0 {
+		name = image[i+1:]
+	}
+
+	i := strings.LastIndex(name, ":")
+	if i < 0 {
+		return true
+	}
+
+	return name[i+1:] == "latest"
+}
+
+func missingRequests(container corev1.Container) []string {
+	var missing []string
+	for _, name := range []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory} {
+		if _, ok := container.Resources.Requests[name]; !ok {
+			missing = append(missing, string(name))
+		}
+	}
+	return missing
+}
+
+func objectFor(apiVersion, kind string, objectMeta *metav1.ObjectMeta) Object {
+	return Object{
+		APIVersion: apiVersion,
+		Kind:       kind,
+		Namespace:  objectMeta.Namespace,
+		Name:       objectMeta.Name,
+	}
+}
@@ -0,0 +1,130 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package analysis
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	storeFake "github.com/vmware-tanzu/octant/pkg/store/fake"
+)
+
+func TestNamespace(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	objectStore := storeFake.NewMockStore(controller)
+
+	orphaned := testutil.CreateService("orphaned")
+	orphaned.Spec.Selector = map[string]string{"app": "orphaned"}
+	served := testutil.CreateService("served")
+	served.Spec.Selector = map[string]string{"app": "served"}
+	headless := testutil.CreateService("headless")
+
+	pending := testutil.CreatePersistentVolumeClaim("pending")
+	pending.Status.Phase = corev1.ClaimPending
+	bound := testutil.CreatePersistentVolumeClaim("bound")
+	bound.Status.Phase = corev1.ClaimBound
+
+	replicaSet := testutil.CreateAppReplicaSet("replica-set")
+	requests := corev1.ResourceRequirements{
+		Requests: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse("100m"),
+			corev1.ResourceMemory: resource.MustParse("64Mi"),
+		},
+	}
+
+	var pods []*corev1.Pod
+	for _, name := range []string{"replica-set-1", "replica-set-2"} {
+		pod := testutil.CreatePod(name)
+		pod.SetOwnerReferences(testutil.ToOwnerReferences(t, replicaSet))
+		pod.Spec.Containers = []corev1.Container{
+			{Name: "app", Image: "nginx", Resources: requests},
+		}
+		pods = append(pods, pod)
+	}
+
+	standalone := testutil.CreatePod("standalone")
+	standalone.Spec.Containers = []corev1.Container{
+		{Name: "app", Image: "nginx:1.19"},
+	}
+
+	objectStore.EXPECT().
+		List(gomock.Any(), store.Key{Namespace: "namespace", APIVersion: "v1", Kind: "Service"}).
+		Return(testutil.ToUnstructuredList(t, orphaned, served, headless), false, nil)
+	orphanedSelector := labels.Set{"app": "orphaned"}
+	objectStore.EXPECT().
+		List(gomock.Any(), store.Key{Namespace: "namespace", APIVersion: "v1", Kind: "Pod", Selector: &orphanedSelector}).
+		Return(&unstructured.UnstructuredList{}, false, nil)
+	servedSelector := labels.Set{"app": "served"}
+	objectStore.EXPECT().
+		List(gomock.Any(), store.Key{Namespace: "namespace", APIVersion: "v1", Kind: "Pod", Selector: &servedSelector}).
+		Return(testutil.ToUnstructuredList(t, standalone), false, nil)
+	objectStore.EXPECT().
+		List(gomock.Any(), store.Key{Namespace: "namespace", APIVersion: "v1", Kind: "PersistentVolumeClaim"}).
+		Return(testutil.ToUnstructuredList(t, pending, bound), false, nil)
+	objectStore.EXPECT().
+		List(gomock.Any(), store.Key{Namespace: "namespace", APIVersion: "v1", Kind: "Pod"}).
+		Return(testutil.ToUnstructuredList(t, pods[0], pods[1], standalone), false, nil)
+
+	got, err := Namespace(context.Background(), objectStore, "namespace")
+	require.NoError(t, err)
+
+	expected := []Finding{
+		{
+			Check:   CheckPendingClaim,
+			Object:  Object{APIVersion: "v1", Kind: "PersistentVolumeClaim", Namespace: "namespace", Name: "pending"},
+			Message: "Claim is waiting for a volume from storage class manual",
+		},
+		{
+			Check:   CheckMissingRequests,
+			Object:  Object{APIVersion: "v1", Kind: "Pod", Namespace: "namespace", Name: "standalone"},
+			Message: "Container app has no cpu or memory requests",
+		},
+		{
+			Check:   CheckLatestImage,
+			Object:  Object{APIVersion: "apps/v1", Kind: "ReplicaSet", Namespace: "namespace", Name: "replica-set"},
+			Message: "Container app runs image nginx which can change without notice",
+		},
+		{
+			Check:   CheckServiceWithoutPods,
+			Object:  Object{APIVersion: "v1", Kind: "Service", Namespace: "namespace", Name: "orphaned"},
+			Message: "Selector app=orphaned doesn't match any pods",
+		},
+	}
+
+	assert.Equal(t, expected, got)
+}
+
+func TestIsLatestImage(t *testing.T) {
+	tests := []struct {
+		image    string
+		expected bool
+	}{
+		{image: "nginx", expected: true},
+		{image: "nginx:latest", expected: true},
+		{image: "nginx:1.19", expected: false},
+		{image: "registry.example.com:5000/nginx", expected: true},
+		{image: "registry.example.com:5000/nginx:1.19", expected: false},
+		{image: "nginx@sha256:4cf620a5c81390ee209398ecc18e5fb9dd0f5155cd82adcbae532fec94006fb9", expected: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.image, func(t *testing.T) {
+			assert.Equal(t, test.expected, IsLatestImage(test.image))
+		})
+	}
+}
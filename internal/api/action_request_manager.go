@@ -10,6 +10,7 @@ import (
 
 	ocontext "github.com/vmware-tanzu/octant/internal/context"
 	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/internal/replay"
 	"github.com/vmware-tanzu/octant/pkg/action"
 )
 
@@ -17,16 +18,34 @@ const (
 	RequestPerformAction = "action.octant.dev/performAction"
 )
 
+// ActionRequestManagerOption is an option for configuring ActionRequestManager.
+type ActionRequestManagerOption func(manager *ActionRequestManager)
+
+// WithActionReplayPosition configures the position of the recording the client is
+// replaying. Actions change it to replay the recording for the client.
+func WithActionReplayPosition(position *replay.Position) ActionRequestManagerOption {
+	return func(manager *ActionRequestManager) {
+		manager.replayPosition = position
+	}
+}
+
 // ActionRequestManager manages action requests. Action requests allow a generic interface
 // for supporting dynamic requests from clients.
 type ActionRequestManager struct {
+	replayPosition *replay.Position
 }
 
 var _ StateManager = (*ActionRequestManager)(nil)
 
 // NewActionRequestManager creates an instance of ActionRequestManager.
-func NewActionRequestManager() *ActionRequestManager {
-	return &ActionRequestManager{}
+func NewActionRequestManager(options ...ActionRequestManagerOption) *ActionRequestManager {
+	a := &ActionRequestManager{}
+
+	for _, option := range options {
+		option(a)
+	}
+
+	return a
 }
 
 func (a ActionRequestManager) Start(ctx context.Context, state octant.State, s OctantClient) {
@@ -45,6 +64,9 @@ func (a *ActionRequestManager) Handlers() []octant.ClientRequestHandler {
 // PerformAction is a handler than runs an action.
 func (a *ActionRequestManager) PerformAction(state octant.State, payload action.Payload) error {
 	ctx := ocontext.WithWebsocketClientID(context.TODO(), state.GetClientID())
+	if a.replayPosition != nil {
+		ctx = replay.WithPosition(ctx, a.replayPosition)
+	}
 
 	actionName, err := payload.String("action")
 	if err != nil {
@@ -52,6 +74,11 @@ func (a *ActionRequestManager) PerformAction(state octant.State, payload action.
 		return nil
 	}
 
+	if actionName == action.RequestShowMore {
+		// Show more changes the client's content, so the content manager handles it.
+		return nil
+	}
+
 	if err := state.Dispatch(ctx, actionName, payload); err != nil {
 		return err
 	}
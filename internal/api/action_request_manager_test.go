@@ -6,13 +6,18 @@
 package api_test
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/vmware-tanzu/octant/internal/api"
+	"github.com/vmware-tanzu/octant/internal/octant"
 	octantFake "github.com/vmware-tanzu/octant/internal/octant/fake"
+	"github.com/vmware-tanzu/octant/internal/replay"
 	"github.com/vmware-tanzu/octant/pkg/action"
 )
 
@@ -40,3 +45,39 @@ func TestActionRequestManager_PerformAction(t *testing.T) {
 
 	require.NoError(t, manager.PerformAction(state, payload))
 }
+
+func TestActionRequestManager_PerformAction_replayPosition(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	state := octantFake.NewMockState(controller)
+	state.EXPECT().GetClientID()
+
+	position := replay.NewPosition(time.Time{})
+	manager := api.NewActionRequestManager(api.WithActionReplayPosition(position))
+
+	payload := action.CreatePayload(octant.ActionReplayLive, nil)
+
+	state.EXPECT().
+		Dispatch(gomock.Any(), octant.ActionReplayLive, payload).
+		DoAndReturn(func(ctx context.Context, _ string, _ action.Payload) error {
+			assert.Equal(t, position, replay.PositionFrom(ctx))
+			return nil
+		})
+
+	require.NoError(t, manager.PerformAction(state, payload))
+}
+
+func TestActionRequestManager_PerformAction_showMore(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	state := octantFake.NewMockState(controller)
+	state.EXPECT().GetClientID()
+
+	manager := api.NewActionRequestManager()
+
+	// Show more is handled by the content manager, so it isn't dispatched.
+	payload := action.CreatePayload(action.RequestShowMore, map[string]interface{}{"limit": float64(1000)})
+	require.NoError(t, manager.PerformAction(state, payload))
+}
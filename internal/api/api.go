@@ -18,6 +18,7 @@ import (
 	"github.com/spf13/viper"
 
 	"github.com/vmware-tanzu/octant/internal/config"
+	"github.com/vmware-tanzu/octant/internal/filecopy"
 	"github.com/vmware-tanzu/octant/internal/mime"
 	"github.com/vmware-tanzu/octant/internal/module"
 	"github.com/vmware-tanzu/octant/pkg/log"
@@ -30,7 +31,7 @@ const (
 	ListenerAddrKey  = "listener-addr"
 	AcceptedHostsKey = "accepted-hosts"
 	// PathPrefix is a string for the api path prefix.
-	PathPrefix          = "/api/v1"
+	PathPrefix          = LegacyPathPrefix + "/" + APIVersion
 	defaultListenerAddr = "127.0.0.1:7777"
 )
 
@@ -54,15 +55,59 @@ func acceptedHosts() []string {
 	return hosts
 }
 
-// Listener returns the default listener if OCTANT_LISTENER_ADDR is not set.
+// Listener returns the default listener if OCTANT_LISTENER_ADDR is not set. If the
+// requested port is already in use, a free port on the same host is used instead.
+// Callers should use the address of the returned listener rather than the requested one.
 func Listener() (net.Listener, error) {
-	listenerAddr := getListenerAddr()
+	return listen(getListenerAddr())
+}
+
+func listen(listenerAddr string) (net.Listener, error) {
+	host, port, err := net.SplitHostPort(listenerAddr)
+	if err != nil {
+		return nil, fmt.Errorf("parse listener address %q: %w", listenerAddr, err)
+	}
+
 	conn, err := net.DialTimeout("tcp", listenerAddr, time.Millisecond*500)
 	if err != nil {
 		return net.Listen("tcp", listenerAddr)
 	}
 	_ = conn.Close()
-	return nil, fmt.Errorf("tcp %s: dial: already in use", listenerAddr)
+
+	if port == "0" {
+		return nil, fmt.Errorf("tcp %s: dial: already in use", listenerAddr)
+	}
+
+	listener, err := net.Listen("tcp", net.JoinHostPort(host, "0"))
+	if err != nil {
+		return nil, fmt.Errorf("tcp %s: already in use and no free port is available: %w", listenerAddr, err)
+	}
+
+	return listener, nil
+}
+
+// RequestedListenerAddr returns the listener address requested by the user, or the
+// default listener address if one was not requested.
+func RequestedListenerAddr() string {
+	return getListenerAddr()
+}
+
+// ListenerPortMatches returns true if listener is bound to the port in listenerAddr.
+func ListenerPortMatches(listener net.Listener, listenerAddr string) bool {
+	_, requestedPort, err := net.SplitHostPort(listenerAddr)
+	if err != nil {
+		return false
+	}
+	if requestedPort == "0" {
+		return true
+	}
+
+	_, boundPort, err := net.SplitHostPort(listener.Addr().String())
+	if err != nil {
+		return false
+	}
+
+	return requestedPort == boundPort
 }
 
 func getListenerAddr() string {
@@ -155,10 +200,23 @@ func (a *API) Handler(ctx context.Context) (http.Handler, error) {
 	}
 	router := mux.NewRouter()
 	router.Use(rebindHandler(ctx, acceptedHosts()))
+	router.Use(versionHeaderMiddleware)
 
 	s := router.PathPrefix(a.prefix).Subrouter()
 
 	s.Handle("/stream", websocketService(a.wsClientManager, a.dashConfig))
+	s.Handle("/version", versionHandler(a.dashConfig, a.logger))
+	s.Handle("/feature-gates", featureGatesHandler(a.dashConfig, a.logger))
+	s.Handle("/port-forwards", portForwardsHandler(a.dashConfig, a.logger))
+	s.Handle("/port-forwards/{id}", portForwardHandler(a.dashConfig, a.logger))
+	s.Handle("/search", searchHandler(a.dashConfig, a.logger))
+	s.Handle("/preferences", preferencesHandler(a.dashConfig, a.logger))
+	s.Handle("/preferences/ui", uiPreferencesHandler(a.dashConfig, a.logger))
+	s.Handle("/preferences/time", timePreferencesHandler(a.dashConfig, a.logger))
+	s.Handle("/pods/{namespace}/{name}/containers/{container}/files", containerFilesHandler(a.dashConfig, filecopy.NewExecutor, a.logger))
+	s.Handle("/configmaps/{namespace}/{name}/data/{key}", configMapDataHandler(a.dashConfig, a.logger))
+	s.Handle("/explain", explainHandler(a.dashConfig, a.logger))
+	s.Handle("/audit", auditHandler(a.dashConfig, a.logger))
 
 	s.NotFoundHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		a.logger.Errorf("api handler not found: %s", r.URL.String())
@@ -207,6 +265,7 @@ func (l *LoadingAPI) ForceUpdate() error {
 func (l *LoadingAPI) Handler(ctx context.Context) (http.Handler, error) {
 	router := mux.NewRouter()
 	router.Use(rebindHandler(ctx, acceptedHosts()))
+	router.Use(versionHeaderMiddleware)
 
 	s := router.PathPrefix(l.prefix).Subrouter()
 
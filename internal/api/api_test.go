@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -17,6 +18,7 @@ import (
 
 	"github.com/golang/mock/gomock"
 	"github.com/pkg/errors"
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -135,3 +137,22 @@ func TestAPI_routes(t *testing.T) {
 		})
 	}
 }
+
+func TestListener_fallsBackToFreePort(t *testing.T) {
+	defer viper.Reset()
+
+	busy, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer busy.Close()
+
+	requested := busy.Addr().String()
+	viper.Set(api.ListenerAddrKey, requested)
+
+	listener, err := api.Listener()
+	require.NoError(t, err)
+	defer listener.Close()
+
+	require.NotEqual(t, requested, listener.Addr().String())
+	require.False(t, api.ListenerPortMatches(listener, requested))
+	require.True(t, api.ListenerPortMatches(listener, listener.Addr().String()))
+}
@@ -0,0 +1,60 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/vmware-tanzu/octant/internal/audit"
+	"github.com/vmware-tanzu/octant/internal/config"
+	"github.com/vmware-tanzu/octant/internal/mime"
+	"github.com/vmware-tanzu/octant/pkg/log"
+)
+
+// auditHandler responds with the mutating actions performed through the dashboard,
+// most recent first. The namespace, kind, name, and limit query parameters filter
+// the entries.
+func auditHandler(dashConfig config.Dash, logger log.Logger) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			RespondWithError(w, http.StatusMethodNotAllowed, "method not allowed", logger)
+			return
+		}
+
+		query := r.URL.Query()
+		filter := audit.Filter{
+			Namespace: query.Get("namespace"),
+			Kind:      query.Get("kind"),
+			Name:      query.Get("name"),
+		}
+
+		if limit := query.Get("limit"); limit != "" {
+			n, err := strconv.Atoi(limit)
+			if err != nil || n < 0 {
+				RespondWithError(w, http.StatusBadRequest, "limit must be a non-negative integer", logger)
+				return
+			}
+			filter.Limit = n
+		}
+
+		entries, err := dashConfig.AuditLog().Entries(filter)
+		if err != nil {
+			RespondWithError(w, http.StatusInternalServerError, err.Error(), logger)
+			return
+		}
+
+		if entries == nil {
+			entries = []audit.Entry{}
+		}
+
+		w.Header().Set("Content-Type", mime.JSONContentType)
+		if err := json.NewEncoder(w).Encode(entries); err != nil {
+			logger.Errorf("encoding audit response: %v", err)
+		}
+	})
+}
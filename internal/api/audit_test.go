@@ -0,0 +1,91 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/octant/internal/audit"
+	configFake "github.com/vmware-tanzu/octant/internal/config/fake"
+	"github.com/vmware-tanzu/octant/internal/log"
+)
+
+func Test_auditHandler(t *testing.T) {
+	first := audit.Entry{Action: "action.octant.dev/deleteObject", Namespace: "default", Kind: "Pod", Name: "a"}
+	second := audit.Entry{Action: "action.octant.dev/update", Namespace: "default", Kind: "Deployment", Name: "b"}
+	third := audit.Entry{Action: "action.octant.dev/update", Namespace: "other", Kind: "Deployment", Name: "c"}
+
+	cases := []struct {
+		name     string
+		path     string
+		code     int
+		expected []audit.Entry
+	}{
+		{
+			name:     "all entries",
+			path:     "/audit",
+			code:     http.StatusOK,
+			expected: []audit.Entry{third, second, first},
+		},
+		{
+			name:     "filtered",
+			path:     "/audit?namespace=default&kind=Deployment",
+			code:     http.StatusOK,
+			expected: []audit.Entry{second},
+		},
+		{
+			name:     "limited",
+			path:     "/audit?limit=1",
+			code:     http.StatusOK,
+			expected: []audit.Entry{third},
+		},
+		{
+			name:     "no matches",
+			path:     "/audit?name=missing",
+			code:     http.StatusOK,
+			expected: []audit.Entry{},
+		},
+		{
+			name: "invalid limit",
+			path: "/audit?limit=ten",
+			code: http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			auditLog := audit.NewMemoryLog()
+			for _, entry := range []audit.Entry{first, second, third} {
+				require.NoError(t, auditLog.Record(entry))
+			}
+
+			dashConfig := configFake.NewMockDash(controller)
+			dashConfig.EXPECT().AuditLog().Return(auditLog).AnyTimes()
+
+			w := httptest.NewRecorder()
+			auditHandler(dashConfig, log.NopLogger()).ServeHTTP(w, httptest.NewRequest(http.MethodGet, tc.path, nil))
+			require.Equal(t, tc.code, w.Code)
+
+			if tc.code != http.StatusOK {
+				return
+			}
+
+			var got []audit.Entry
+			require.NoError(t, json.NewDecoder(w.Body).Decode(&got))
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
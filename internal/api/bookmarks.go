@@ -0,0 +1,98 @@
+/*
+ * Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package api
+
+import (
+	"context"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/internal/objectstatus"
+	"github.com/vmware-tanzu/octant/internal/preferences"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/icon"
+	"github.com/vmware-tanzu/octant/pkg/navigation"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+// BookmarksNavigationConfig is configuration for BookmarksNavigation.
+type BookmarksNavigationConfig interface {
+	ObjectStore() store.Store
+	ObjectPath(namespace, apiVersion, kind, name string) (string, error)
+	Preferences() preferences.Manager
+}
+
+// BookmarksNavigation creates a navigation section for bookmarked objects. Entries
+// include the status of their object so the section shows which bookmarks
+// need attention. No section is created if there are no bookmarks.
+func BookmarksNavigation(ctx context.Context, config BookmarksNavigationConfig) []navigation.Navigation {
+	bookmarks := config.Preferences().Get().Bookmarks
+	if len(bookmarks) == 0 {
+		return nil
+	}
+
+	logger := log.From(ctx)
+	objectStore := config.ObjectStore()
+
+	section := navigation.Navigation{
+		Module:   "bookmarks",
+		Title:    "Bookmarks",
+		IconName: icon.Bookmarks,
+	}
+
+	for _, bookmark := range bookmarks {
+		entry := navigation.Navigation{
+			Title: bookmark.String(),
+		}
+
+		objectPath, err := config.ObjectPath(bookmark.Namespace, bookmark.APIVersion, bookmark.Kind, bookmark.Name)
+		if err != nil {
+			logger.WithErr(err).With("bookmark", bookmark.String()).Debugf("unable to find path for bookmark")
+		}
+		entry.Path = objectPath
+
+		object, err := objectStore.Get(ctx, bookmark.Key())
+		switch {
+		case kerrors.IsNotFound(err), err == nil && object == nil:
+			// The object was deleted.
+			entry.Status = string(component.NodeStatusError)
+		case err != nil:
+			logger.WithErr(err).With("bookmark", bookmark.String()).Debugf("unable to get bookmarked object")
+		default:
+			status, err := objectstatus.Status(ctx, object, objectStore)
+			if err != nil {
+				logger.WithErr(err).With("bookmark", bookmark.String()).Debugf("unable to get status for bookmark")
+				break
+			}
+			entry.Status = string(status.Status())
+		}
+
+		section.Children = append(section.Children, entry)
+	}
+
+	return []navigation.Navigation{section}
+}
+
+// BookmarkFromPayload creates a bookmark from a payload.
+func BookmarkFromPayload(payload action.Payload) (preferences.Bookmark, error) {
+	var bookmark preferences.Bookmark
+	var err error
+
+	if bookmark.APIVersion, err = payload.String("apiVersion"); err != nil {
+		return preferences.Bookmark{}, err
+	}
+	if bookmark.Kind, err = payload.String("kind"); err != nil {
+		return preferences.Bookmark{}, err
+	}
+	if bookmark.Name, err = payload.String("name"); err != nil {
+		return preferences.Bookmark{}, err
+	}
+	bookmark.Namespace, _ = payload.OptionalString("namespace")
+
+	return bookmark, bookmark.Validate()
+}
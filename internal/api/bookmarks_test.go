@@ -0,0 +1,86 @@
+/*
+ * Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package api_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/octant/internal/api"
+	configFake "github.com/vmware-tanzu/octant/internal/config/fake"
+	"github.com/vmware-tanzu/octant/internal/preferences"
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/icon"
+	"github.com/vmware-tanzu/octant/pkg/navigation"
+	storeFake "github.com/vmware-tanzu/octant/pkg/store/fake"
+)
+
+func TestBookmarksNavigation(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	configMap := preferences.Bookmark{APIVersion: "v1", Kind: "ConfigMap", Namespace: "default", Name: "config"}
+	deleted := preferences.Bookmark{APIVersion: "v1", Kind: "ConfigMap", Namespace: "default", Name: "deleted"}
+
+	manager := preferences.NewService(nil)
+	require.NoError(t, manager.AddBookmark(configMap))
+	require.NoError(t, manager.AddBookmark(deleted))
+
+	objectStore := storeFake.NewMockStore(controller)
+	objectStore.EXPECT().
+		Get(gomock.Any(), configMap.Key()).
+		Return(testutil.ToUnstructured(t, testutil.CreateConfigMap("config")), nil)
+	objectStore.EXPECT().
+		Get(gomock.Any(), deleted.Key()).
+		Return(nil, nil)
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().Preferences().Return(manager)
+	dashConfig.EXPECT().ObjectStore().Return(objectStore)
+	dashConfig.EXPECT().
+		ObjectPath("default", "v1", "ConfigMap", "config").
+		Return("/overview/namespace/default/config-and-storage/config-maps/config", nil)
+	dashConfig.EXPECT().
+		ObjectPath("default", "v1", "ConfigMap", "deleted").
+		Return("/overview/namespace/default/config-and-storage/config-maps/deleted", nil)
+
+	got := api.BookmarksNavigation(context.Background(), dashConfig)
+
+	expected := []navigation.Navigation{
+		{
+			Module:   "bookmarks",
+			Title:    "Bookmarks",
+			IconName: icon.Bookmarks,
+			Children: []navigation.Navigation{
+				{
+					Title:  "ConfigMap default/config",
+					Path:   "/overview/namespace/default/config-and-storage/config-maps/config",
+					Status: "ok",
+				},
+				{
+					Title:  "ConfigMap default/deleted",
+					Path:   "/overview/namespace/default/config-and-storage/config-maps/deleted",
+					Status: "error",
+				},
+			},
+		},
+	}
+	assert.Equal(t, expected, got)
+}
+
+func TestBookmarksNavigation_none(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().Preferences().Return(preferences.NewService(nil))
+
+	assert.Empty(t, api.BookmarksNavigation(context.Background(), dashConfig))
+}
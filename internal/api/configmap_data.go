@@ -0,0 +1,83 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/vmware-tanzu/octant/internal/config"
+	"github.com/vmware-tanzu/octant/internal/util/kubernetes"
+	"github.com/vmware-tanzu/octant/pkg/log"
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+// ConfigMapDataPath returns the API path which downloads the value of a
+// config map key.
+func ConfigMapDataPath(namespace, name, key string) string {
+	return VersionedPath(fmt.Sprintf("/configmaps/%s/%s/data/%s", namespace, name, key))
+}
+
+// configMapDataHandler downloads the value of a config map key. Both data and
+// binaryData keys are served.
+func configMapDataHandler(dashConfig config.Dash, logger log.Logger) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			RespondWithError(w, http.StatusMethodNotAllowed, "method not allowed", logger)
+			return
+		}
+
+		vars := mux.Vars(r)
+		key := store.Key{
+			Namespace:  vars["namespace"],
+			APIVersion: "v1",
+			Kind:       "ConfigMap",
+			Name:       vars["name"],
+		}
+
+		object, err := dashConfig.ObjectStore().Get(r.Context(), key)
+		if err != nil {
+			RespondWithError(w, http.StatusInternalServerError, err.Error(), logger)
+			return
+		}
+		if object == nil {
+			RespondWithError(w, http.StatusNotFound, "config map not found", logger)
+			return
+		}
+
+		configMap := &corev1.ConfigMap{}
+		if err := kubernetes.FromUnstructured(object, configMap); err != nil {
+			RespondWithError(w, http.StatusInternalServerError, err.Error(), logger)
+			return
+		}
+
+		dataKey := vars["key"]
+
+		var data []byte
+		contentType := "text/plain; charset=utf-8"
+		if value, ok := configMap.Data[dataKey]; ok {
+			data = []byte(value)
+		} else if value, ok := configMap.BinaryData[dataKey]; ok {
+			data = value
+			contentType = "application/octet-stream"
+		} else {
+			RespondWithError(w, http.StatusNotFound, fmt.Sprintf("config map has no key %q", dataKey), logger)
+			return
+		}
+
+		w.Header().Set("Content-Type", contentType)
+		w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", dataKey))
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write(data); err != nil {
+			logger.WithErr(err).Errorf("write config map data")
+		}
+	})
+}
@@ -0,0 +1,102 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	configFake "github.com/vmware-tanzu/octant/internal/config/fake"
+	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	storeFake "github.com/vmware-tanzu/octant/pkg/store/fake"
+)
+
+func TestConfigMapDataPath(t *testing.T) {
+	assert.Equal(t, "/api/v1/configmaps/default/settings/data/app.yaml", ConfigMapDataPath("default", "settings", "app.yaml"))
+}
+
+func Test_configMapDataHandler(t *testing.T) {
+	configMap := testutil.CreateConfigMap("settings")
+	configMap.Data = map[string]string{"app.yaml": "key: value"}
+	configMap.BinaryData = map[string][]byte{"logo.png": {0x89, 0x50, 0x4e, 0x47}}
+
+	cases := []struct {
+		name        string
+		path        string
+		found       bool
+		code        int
+		contentType string
+		body        string
+	}{
+		{
+			name:        "data",
+			path:        "/configmaps/namespace/settings/data/app.yaml",
+			found:       true,
+			code:        http.StatusOK,
+			contentType: "text/plain; charset=utf-8",
+			body:        "key: value",
+		},
+		{
+			name:        "binary data",
+			path:        "/configmaps/namespace/settings/data/logo.png",
+			found:       true,
+			code:        http.StatusOK,
+			contentType: "application/octet-stream",
+			body:        "\x89PNG",
+		},
+		{
+			name:  "missing key",
+			path:  "/configmaps/namespace/settings/data/missing",
+			found: true,
+			code:  http.StatusNotFound,
+		},
+		{
+			name: "missing config map",
+			path: "/configmaps/namespace/settings/data/app.yaml",
+			code: http.StatusNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			objectStore := storeFake.NewMockStore(controller)
+			key := store.Key{Namespace: "namespace", APIVersion: "v1", Kind: "ConfigMap", Name: "settings"}
+			if tc.found {
+				objectStore.EXPECT().Get(gomock.Any(), key).Return(testutil.ToUnstructured(t, configMap), nil)
+			} else {
+				objectStore.EXPECT().Get(gomock.Any(), key).Return(nil, nil)
+			}
+
+			dashConfig := configFake.NewMockDash(controller)
+			dashConfig.EXPECT().ObjectStore().Return(objectStore)
+
+			router := mux.NewRouter()
+			router.Handle("/configmaps/{namespace}/{name}/data/{key}", configMapDataHandler(dashConfig, log.NopLogger()))
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, tc.path, nil))
+			require.Equal(t, tc.code, w.Code)
+
+			if tc.code != http.StatusOK {
+				return
+			}
+
+			assert.Equal(t, tc.contentType, w.Header().Get("Content-Type"))
+			assert.Equal(t, tc.body, w.Body.String())
+		})
+	}
+}
@@ -0,0 +1,137 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package api
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/vmware-tanzu/octant/internal/cluster"
+	"github.com/vmware-tanzu/octant/internal/config"
+	"github.com/vmware-tanzu/octant/internal/filecopy"
+	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/pkg/log"
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+const (
+	// maxUploadSize is the largest upload which is accepted.
+	maxUploadSize = 32 << 20
+
+	tarContentType = "application/x-tar"
+)
+
+// containerFilesHandler downloads files from a container as a tar archive and
+// uploads multipart form files into a container. Uploads are recorded to the audit log.
+func containerFilesHandler(dashConfig config.Dash, newExecutor func(cluster.ClientInterface) filecopy.Executor, logger log.Logger) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		target := filecopy.Target{
+			Namespace: vars["namespace"],
+			Pod:       vars["name"],
+			Container: vars["container"],
+		}
+
+		copier := filecopy.NewCopier(newExecutor(dashConfig.ClusterClient()))
+
+		switch r.Method {
+		case http.MethodGet:
+			srcPath := r.URL.Query().Get("path")
+			if srcPath == "" {
+				RespondWithError(w, http.StatusBadRequest, "path is required", logger)
+				return
+			}
+
+			aw := &archiveResponseWriter{w: w, filename: filecopy.ArchiveName(srcPath)}
+			if err := copier.Download(r.Context(), target, srcPath, aw); err != nil {
+				if aw.wrote {
+					// The response has started, so the error can't be reported to the client.
+					logger.WithErr(err).Errorf("download from container")
+					return
+				}
+				RespondWithError(w, http.StatusBadRequest, err.Error(), logger)
+			}
+		case http.MethodPost:
+			r.Body = http.MaxBytesReader(w, r.Body, maxUploadSize)
+			if err := r.ParseMultipartForm(maxUploadSize); err != nil {
+				RespondWithError(w, http.StatusBadRequest, fmt.Sprintf("parse upload: %v", err), logger)
+				return
+			}
+
+			files, err := uploadedFiles(r)
+			if err != nil {
+				RespondWithError(w, http.StatusBadRequest, err.Error(), logger)
+				return
+			}
+			if len(files) == 0 {
+				RespondWithError(w, http.StatusBadRequest, "upload has no files", logger)
+				return
+			}
+
+			pr, pw := io.Pipe()
+			go func() {
+				pw.CloseWithError(filecopy.WriteArchive(pw, files))
+			}()
+
+			pod := store.Key{Namespace: target.Namespace, APIVersion: "v1", Kind: "Pod", Name: target.Pod}
+			err = dashConfig.Auditor().Audit(r.Context(), octant.ActionUploadContainerFiles, pod, func() error {
+				return copier.Upload(r.Context(), target, r.FormValue("path"), pr)
+			})
+			if err != nil {
+				pr.CloseWithError(err)
+				RespondWithError(w, http.StatusBadRequest, err.Error(), logger)
+				return
+			}
+
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			RespondWithError(w, http.StatusMethodNotAllowed, "method not allowed", logger)
+		}
+	})
+}
+
+func uploadedFiles(r *http.Request) ([]filecopy.File, error) {
+	var files []filecopy.File
+
+	for _, header := range r.MultipartForm.File["files"] {
+		f, err := header.Open()
+		if err != nil {
+			return nil, fmt.Errorf("open %q: %w", header.Filename, err)
+		}
+
+		data, err := ioutil.ReadAll(f)
+		f.Close()
+		if err != nil {
+			return nil, fmt.Errorf("read %q: %w", header.Filename, err)
+		}
+
+		files = append(files, filecopy.File{Name: header.Filename, Data: data})
+	}
+
+	return files, nil
+}
+
+// archiveResponseWriter sets download headers when the archive is first written,
+// so errors which happen before then can still be sent as an error response.
+type archiveResponseWriter struct {
+	w        http.ResponseWriter
+	filename string
+	wrote    bool
+}
+
+func (aw *archiveResponseWriter) Write(p []byte) (int, error) {
+	if !aw.wrote {
+		aw.wrote = true
+		aw.w.Header().Set("Content-Type", tarContentType)
+		aw.w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", aw.filename))
+		aw.w.WriteHeader(http.StatusOK)
+	}
+	return aw.w.Write(p)
+}
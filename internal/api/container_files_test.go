@@ -0,0 +1,165 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package api
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware-tanzu/octant/internal/audit"
+	"github.com/vmware-tanzu/octant/internal/cluster"
+	clusterFake "github.com/vmware-tanzu/octant/internal/cluster/fake"
+	configFake "github.com/vmware-tanzu/octant/internal/config/fake"
+	"github.com/vmware-tanzu/octant/internal/filecopy"
+	fileCopyFake "github.com/vmware-tanzu/octant/internal/filecopy/fake"
+	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/internal/octant"
+)
+
+func containerFilesRouter(controller *gomock.Controller) (http.Handler, *fileCopyFake.MockExecutor, audit.Log) {
+	executor := fileCopyFake.NewMockExecutor(controller)
+
+	// Pods can't be looked up, so audit entries don't have resource versions.
+	clusterClient := clusterFake.NewMockClientInterface(controller)
+	clusterClient.EXPECT().Resource(gomock.Any()).Return(schema.GroupVersionResource{}, false, errors.New("unknown resource")).AnyTimes()
+
+	auditLog := audit.NewMemoryLog()
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().ClusterClient().Return(clusterClient).AnyTimes()
+	dashConfig.EXPECT().Auditor().Return(audit.NewAuditor(auditLog, dashConfig.ClusterClient, func() string { return "cluster" })).AnyTimes()
+
+	newExecutor := func(cluster.ClientInterface) filecopy.Executor {
+		return executor
+	}
+
+	router := mux.NewRouter()
+	router.Handle("/pods/{namespace}/{name}/containers/{container}/files", containerFilesHandler(dashConfig, newExecutor, log.NopLogger()))
+
+	return router, executor, auditLog
+}
+
+var testFileTarget = filecopy.Target{Namespace: "default", Pod: "pod", Container: "app"}
+
+func Test_containerFilesHandler_download(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	router, executor, _ := containerFilesRouter(controller)
+	executor.EXPECT().
+		Exec(gomock.Any(), testFileTarget, []string{"tar", "cf", "-", "-C", "/var/log/", "--", "app.log"}, nil, gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ filecopy.Target, _ []string, _ io.Reader, stdout, _ io.Writer) error {
+			_, err := stdout.Write([]byte("archive"))
+			return err
+		})
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/pods/default/pod/containers/app/files?path=/var/log/app.log", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "application/x-tar", w.Header().Get("Content-Type"))
+	assert.Equal(t, `attachment; filename="app.log.tar"`, w.Header().Get("Content-Disposition"))
+	assert.Equal(t, "archive", w.Body.String())
+}
+
+func Test_containerFilesHandler_download_error(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	router, executor, _ := containerFilesRouter(controller)
+	executor.EXPECT().
+		Exec(gomock.Any(), testFileTarget, gomock.Any(), nil, gomock.Any(), gomock.Any()).
+		Return(errors.New("command terminated with exit code 2"))
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/pods/default/pod/containers/app/files?path=/missing", nil))
+	require.Equal(t, http.StatusBadRequest, w.Code)
+
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/pods/default/pod/containers/app/files", nil))
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func Test_containerFilesHandler_upload(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	var uploaded []string
+
+	router, executor, auditLog := containerFilesRouter(controller)
+	executor.EXPECT().
+		Exec(gomock.Any(), testFileTarget, []string{"tar", "xmf", "-", "-C", "/tmp"}, gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ filecopy.Target, _ []string, stdin io.Reader, _, _ io.Writer) error {
+			tr := tar.NewReader(stdin)
+			for {
+				header, err := tr.Next()
+				if err == io.EOF {
+					return nil
+				}
+				if err != nil {
+					return err
+				}
+				uploaded = append(uploaded, header.Name)
+			}
+		})
+
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+	require.NoError(t, mw.WriteField("path", "/tmp"))
+	fw, err := mw.CreateFormFile("files", "debug.sh")
+	require.NoError(t, err)
+	_, err = fw.Write([]byte("#!/bin/sh"))
+	require.NoError(t, err)
+	require.NoError(t, mw.Close())
+
+	req := httptest.NewRequest(http.MethodPost, "/pods/default/pod/containers/app/files", &body)
+	req.Header.Set("Content-Type", mw.FormDataContentType())
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusNoContent, w.Code)
+	assert.Equal(t, []string{"debug.sh"}, uploaded)
+
+	entries, err := auditLog.Entries(audit.Filter{})
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, octant.ActionUploadContainerFiles, entries[0].Action)
+	assert.Equal(t, "Pod", entries[0].Kind)
+	assert.Equal(t, "default", entries[0].Namespace)
+	assert.Equal(t, "pod", entries[0].Name)
+	assert.Empty(t, entries[0].Error)
+}
+
+func Test_containerFilesHandler_upload_noFiles(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	router, _, _ := containerFilesRouter(controller)
+
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+	require.NoError(t, mw.WriteField("path", "/tmp"))
+	require.NoError(t, mw.Close())
+
+	req := httptest.NewRequest(http.MethodPost, "/pods/default/pod/containers/app/files", &body)
+	req.Header.Set("Content-Type", mw.FormDataContentType())
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
@@ -12,18 +12,24 @@ import (
 	"errors"
 	"fmt"
 	"hash/fnv"
+	"strconv"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/vmware-tanzu/octant/internal/config"
+	"github.com/vmware-tanzu/octant/internal/contentcache"
 	ocontext "github.com/vmware-tanzu/octant/internal/context"
 	oevent "github.com/vmware-tanzu/octant/pkg/event"
 
 	oerrors "github.com/vmware-tanzu/octant/internal/errors"
 	"github.com/vmware-tanzu/octant/internal/event"
+	"github.com/vmware-tanzu/octant/internal/i18n"
 	internalLog "github.com/vmware-tanzu/octant/internal/log"
 	"github.com/vmware-tanzu/octant/internal/module"
 	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/internal/preferences"
+	"github.com/vmware-tanzu/octant/internal/replay"
 	"github.com/vmware-tanzu/octant/pkg/action"
 	"github.com/vmware-tanzu/octant/pkg/log"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
@@ -31,6 +37,10 @@ import (
 
 const (
 	RequestSetContentPath = "action.octant.dev/setContentPath"
+
+	// DefaultContentBudget is how long content is generated for before it is sent with
+	// placeholders for the parts which aren't ready.
+	DefaultContentBudget = 5 * time.Second
 )
 
 // ContentManagerOption is an option for configuring ContentManager.
@@ -74,6 +84,52 @@ func WithContentGeneratorPoller(poller Poller) ContentManagerOption {
 	}
 }
 
+// WithContentCache configures the cache used to skip generating content when the
+// objects it was generated from haven't changed.
+func WithContentCache(cache *contentcache.Cache) ContentManagerOption {
+	return func(manager *ContentManager) {
+		manager.contentCache = cache
+	}
+}
+
+// WithContentPrefetcher configures the prefetcher which generates the detail content
+// linked from lists before it is requested.
+func WithContentPrefetcher(prefetcher *Prefetcher) ContentManagerOption {
+	return func(manager *ContentManager) {
+		manager.prefetcher = prefetcher
+	}
+}
+
+// WithTableStreamChunkSize configures the number of rows of a table sent in a content
+// event. The remaining rows are sent in table rows events of the same size. Tables are
+// sent whole if n is zero.
+func WithTableStreamChunkSize(n int) ContentManagerOption {
+	return func(manager *ContentManager) {
+		if n >= 0 {
+			manager.tableChunkSize = n
+		}
+	}
+}
+
+// WithContentBudget configures how long content is generated for before it is sent with
+// placeholders for the parts which aren't ready. Content generation isn't limited if
+// budget is zero.
+func WithContentBudget(budget time.Duration) ContentManagerOption {
+	return func(manager *ContentManager) {
+		if budget >= 0 {
+			manager.contentBudget = budget
+		}
+	}
+}
+
+// WithContentReplayPosition configures the position of the recording the client is
+// replaying. Content is live if it is nil.
+func WithContentReplayPosition(position *replay.Position) ContentManagerOption {
+	return func(manager *ContentManager) {
+		manager.replayPosition = position
+	}
+}
+
 // ContentManager manages content for websockets.
 type ContentManager struct {
 	ctx                 context.Context
@@ -83,6 +139,16 @@ type ContentManager struct {
 	contentGenerateFunc ContentGenerateFunc
 	poller              Poller
 	updateContentCh     chan struct{}
+	contentCache        *contentcache.Cache
+	prefetcher          *Prefetcher
+	tab                 *atomicString
+	tableChunkSize      int
+	contentBudget       time.Duration
+	// limit is the most rows a list table has. The user's list limit preference is
+	// used if it is zero.
+	limit int64
+	// replayPosition is the position of the recording the client is replaying.
+	replayPosition *replay.Position
 }
 
 // NewContentManager creates an instance of ContentManager.
@@ -93,6 +159,9 @@ func NewContentManager(moduleManager module.ManagerInterface, dashConfig config.
 		logger:          logger,
 		poller:          NewInterruptiblePoller("content"),
 		updateContentCh: make(chan struct{}, 1),
+		tab:             newStringValue(""),
+		tableChunkSize:  DefaultTableStreamChunkSize,
+		contentBudget:   DefaultContentBudget,
 	}
 	cm.contentGenerateFunc = cm.generateContent
 
@@ -107,17 +176,21 @@ var _ StateManager = (*ContentManager)(nil)
 
 // Start starts the manager.
 func (cm *ContentManager) Start(ctx context.Context, state octant.State, s OctantClient) {
-	cm.ctx = ctx
 	logger := internalLog.From(ctx)
 	logger.Debugf("starting content manager")
 
+	ctx, cancel := context.WithCancel(ctx)
+	cm.ctx = ctx
+
 	defer func() {
 		logger.Debugf("stopping content manager")
 		close(cm.updateContentCh)
+		// Prefetches use the manager's context, so they are finished before the
+		// cache's watches are removed.
+		cm.prefetcher.Wait()
+		cm.contentCache.Stop()
 	}()
 
-	ctx, cancel := context.WithCancel(ctx)
-
 	updateCancel := state.OnContentPathUpdate(func(contentPath string) {
 		cm.updateContentCh <- struct{}{}
 	})
@@ -133,6 +206,7 @@ func (cm *ContentManager) Start(ctx context.Context, state octant.State, s Octan
 
 func (cm *ContentManager) runUpdate(state octant.State, s OctantClient) PollerFunc {
 	previousChecksum := ""
+	generation := 0
 
 	return func(ctx context.Context) bool {
 		contentPath := state.GetContentPath()
@@ -166,7 +240,20 @@ func (cm *ContentManager) runUpdate(state octant.State, s OctantClient) PollerFu
 
 		if ctx.Err() == nil {
 			if content.Path == state.GetContentPath() {
-				s.Send(CreateContentEvent(content.Response, state.GetNamespace(), contentPath, state.GetQueryParams()))
+				queryParams := state.GetQueryParams()
+				if tab := cm.tab.get(); tab != "" {
+					queryParams["tab"] = []string{tab}
+				}
+				if limit := atomic.LoadInt64(&cm.limit); limit > 0 {
+					queryParams["limit"] = []string{strconv.FormatInt(limit, 10)}
+				}
+
+				generation++
+				response, streams := streamTables(content.Response, strconv.Itoa(generation), cm.tableChunkSize)
+				s.Send(CreateContentEvent(response, state.GetNamespace(), contentPath, queryParams))
+				sendTableStreams(ctx, s, contentPath, streams, cm.tableChunkSize, func() bool {
+					return content.Path == state.GetContentPath()
+				})
 			}
 
 		}
@@ -191,25 +278,66 @@ func (cm *ContentManager) generateContent(ctx context.Context, state octant.Stat
 	modulePath := strings.TrimPrefix(contentPath, m.Name())
 	options := module.ContentOptions{
 		LabelSet: FiltersToLabelSet(state.GetFilters()),
+		Tab:      cm.tab.get(),
+		Limit:    int(atomic.LoadInt64(&cm.limit)),
+	}
+	if options.Limit == 0 {
+		options.Limit = cm.dashConfig.Preferences().Get().UI.ListLimit
 	}
 
 	ctx = ocontext.WithWebsocketClientID(ctx, state.GetClientID())
+	if cm.contentBudget > 0 {
+		ctx = ocontext.WithContentDeadline(ctx, now.Add(cm.contentBudget))
+	}
 
-	contentResponse, err := m.Content(ctx, modulePath, options)
-	if err != nil {
-		if nfe, ok := err.(notFound); ok && nfe.NotFound() {
-			logger.Debugf("path not found")
-			contentResponse = notFoundPage(contentPath)
+	// Content is generated at the time being replayed when generation starts, so it
+	// matches the key it is cached with if the client replays another time meanwhile.
+	replayTime, _ := cm.replayPosition.Time()
+	ctx = replay.WithPosition(ctx, replay.NewPosition(replayTime))
+
+	cacheKey := contentCacheKey(ctx, contentPath, options)
+
+	contentResponse, ok := cm.contentCache.Get(ctx, cacheKey)
+	if ok {
+		logger.Debugf("content is unchanged")
+	} else {
+		dependencies := contentcache.NewDependencies()
+
+		var err error
+		contentResponse, err = m.Content(contentcache.WithDependencies(ctx, dependencies), modulePath, options)
+		if err != nil {
+			if nfe, ok := err.(notFound); ok && nfe.NotFound() {
+				logger.Debugf("path not found")
+				contentResponse = notFoundPage(contentPath)
+			} else {
+				return emptyContent, false, fmt.Errorf("generate content: %w", err)
+			}
 		} else {
-			return emptyContent, false, fmt.Errorf("generate content: %w", err)
+			contentResponse.Alert = staleAlert(cm.dashConfig.ObjectStore(), dependencies.Keys())
+			cm.contentCache.Set(cacheKey, contentResponse, dependencies)
+			if cm.ctx != nil {
+				prefetchCtx := ocontext.WithWebsocketClientID(cm.ctx, state.GetClientID())
+				prefetchCtx = replay.WithPosition(prefetchCtx, replay.PositionFrom(ctx))
+				cm.prefetcher.Prefetch(prefetchCtx, contentResponse, options)
+			}
 		}
 	}
 
+	if alert := replayAlert(ctx); alert != nil {
+		contentResponse.Alert = alert
+	}
+
 	title := GenerateBreadcrumb(cm, contentPath, state, m, options)
 	if len(title) > 0 {
 		contentResponse.Title = title
 	}
 
+	ApplyTimePreferences(&contentResponse, cm.dashConfig.Preferences().Get().Time)
+
+	if locale := i18n.LocaleFrom(ctx); locale != i18n.DefaultLocale {
+		contentResponse = i18n.Localize(contentResponse, cm.dashConfig.Translator(), locale)
+	}
+
 	content := Content{
 		Response: contentResponse,
 		Path:     contentPath,
@@ -217,6 +345,34 @@ func (cm *ContentManager) generateContent(ctx context.Context, state octant.Stat
 	return content, false, nil
 }
 
+// contentCacheKey returns the key content generated for a content path with options is
+// cached with. Content generated while replaying a recording is cached by the time
+// replayed.
+func contentCacheKey(ctx context.Context, contentPath string, options module.ContentOptions) string {
+	key := fmt.Sprintf("%s#%s@%d", contentPath, options.Tab, options.Limit)
+	if options.LabelSet != nil {
+		key += "?" + options.LabelSet.String()
+	}
+	if at, ok := replay.TimeFrom(ctx); ok {
+		key += "~" + at.UTC().Format(time.RFC3339Nano)
+	}
+	return key
+}
+
+// ApplyTimePreferences sets how the timestamps in a content response are shown.
+func ApplyTimePreferences(contentResponse *component.ContentResponse, timePreferences preferences.TimePreferences) {
+	setFormat := func(c component.Component) {
+		if ts, ok := c.(*component.Timestamp); ok {
+			ts.SetFormat(timePreferences.Format, timePreferences.TimeZone)
+		}
+	}
+
+	for i := range contentResponse.Components {
+		component.Walk(contentResponse.Components[i], setFormat)
+	}
+	component.Walk(contentResponse.ExtensionComponent, setFormat)
+}
+
 // Handlers returns a slice of client request handlers.
 func (cm *ContentManager) Handlers() []octant.ClientRequestHandler {
 	return []octant.ClientRequestHandler{
@@ -232,25 +388,100 @@ func (cm *ContentManager) Handlers() []octant.ClientRequestHandler {
 			RequestType: CheckLoading,
 			Handler:     cm.Loaded,
 		},
+		{
+			RequestType: RequestPerformAction,
+			Handler:     cm.ShowMore,
+		},
 	}
 }
 
-// SetQueryParams sets the current query params.
+// SetQueryParams sets the current query params. The "tab" param selects the tab to
+// generate on a detail page; every tab is generated if it is missing. The "limit" param
+// is the most rows a list table has; the user's list limit preference is used if it
+// is missing.
 func (cm *ContentManager) SetQueryParams(state octant.State, payload action.Payload) error {
-	if params, ok := payload["params"].(map[string]interface{}); ok {
-		// handle filters
-		if filters, ok := params["filters"]; ok {
-			list, err := FiltersFromQueryParams(filters)
-			if err != nil {
-				return fmt.Errorf("extract filters from query params: %w", err)
-			}
-			state.SetFilters(list)
+	params, _ := payload["params"].(map[string]interface{})
+
+	if tab := stringFromQueryParams(params, "tab"); tab != cm.tab.get() {
+		cm.tab.set(tab)
+		cm.requestUpdate()
+	}
+
+	var limit int64
+	if s := stringFromQueryParams(params, "limit"); s != "" {
+		var err error
+		limit, err = strconv.ParseInt(s, 10, 64)
+		if err != nil || limit < 0 {
+			return fmt.Errorf("limit query param %q is not a row count", s)
+		}
+	}
+	cm.setLimit(limit)
+
+	// handle filters
+	if filters, ok := params["filters"]; ok {
+		list, err := FiltersFromQueryParams(filters)
+		if err != nil {
+			return fmt.Errorf("extract filters from query params: %w", err)
 		}
+		state.SetFilters(list)
 	}
 
 	return nil
 }
 
+// stringFromQueryParams returns a query param which can be a string or a list with
+// one string.
+func stringFromQueryParams(params map[string]interface{}, key string) string {
+	switch value := params[key].(type) {
+	case string:
+		return value
+	case []interface{}:
+		if len(value) > 0 {
+			s, _ := value[0].(string)
+			return s
+		}
+	}
+
+	return ""
+}
+
+// ShowMore changes the most rows a list table has when the payload is a show more action.
+// Other actions are ignored.
+func (cm *ContentManager) ShowMore(state octant.State, payload action.Payload) error {
+	if name, _ := payload.OptionalString("action"); name != action.RequestShowMore {
+		return nil
+	}
+
+	limit, err := payload.Int64("limit")
+	if err != nil {
+		return fmt.Errorf("extract limit from payload: %w", err)
+	}
+	if limit < 0 {
+		return fmt.Errorf("limit %d is negative", limit)
+	}
+
+	cm.setLimit(limit)
+	return nil
+}
+
+func (cm *ContentManager) setLimit(limit int64) {
+	if atomic.SwapInt64(&cm.limit, limit) != limit {
+		cm.requestUpdate()
+	}
+}
+
+// requestUpdate regenerates content without waiting for the next poll.
+func (cm *ContentManager) requestUpdate() {
+	if cm.ctx == nil || cm.ctx.Err() != nil {
+		return
+	}
+
+	select {
+	case cm.updateContentCh <- struct{}{}:
+	default:
+	}
+}
+
 // SetNamespace sets the current namespace.
 func (cm *ContentManager) SetNamespace(state octant.State, payload action.Payload) error {
 	namespace, err := payload.String("namespace")
@@ -8,8 +8,10 @@ package api_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/vmware-tanzu/octant/internal/api"
@@ -19,6 +21,7 @@ import (
 	moduleFake "github.com/vmware-tanzu/octant/internal/module/fake"
 	"github.com/vmware-tanzu/octant/internal/octant"
 	octantFake "github.com/vmware-tanzu/octant/internal/octant/fake"
+	"github.com/vmware-tanzu/octant/internal/preferences"
 	"github.com/vmware-tanzu/octant/pkg/action"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
@@ -37,6 +40,7 @@ func TestContentManager_Handlers(t *testing.T) {
 		api.RequestSetContentPath,
 		action.RequestSetNamespace,
 		api.CheckLoading,
+		api.RequestPerformAction,
 	})
 }
 
@@ -164,6 +168,24 @@ func TestContentManager_SetQueryParams(t *testing.T) {
 				})
 			},
 		},
+		{
+			name: "tab",
+			payload: action.Payload{
+				"params": map[string]interface{}{
+					"tab": []interface{}{"summary"},
+				},
+			},
+			setup: func(state *octantFake.MockState) {},
+		},
+		{
+			name: "limit",
+			payload: action.Payload{
+				"params": map[string]interface{}{
+					"limit": "1000",
+				},
+			},
+			setup: func(state *octantFake.MockState) {},
+		},
 	}
 
 	for _, test := range tests {
@@ -189,3 +211,62 @@ func TestContentManager_SetQueryParams(t *testing.T) {
 		})
 	}
 }
+
+func TestContentManager_SetQueryParams_invalidLimit(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	moduleManager := moduleFake.NewMockManagerInterface(controller)
+	dashConfig := configFake.NewMockDash(controller)
+	state := octantFake.NewMockState(controller)
+
+	manager := api.NewContentManager(moduleManager, dashConfig, log.NopLogger(),
+		api.WithContentGeneratorPoller(api.NewSingleRunPoller()))
+
+	for _, limit := range []string{"all", "-1"} {
+		payload := action.Payload{
+			"params": map[string]interface{}{
+				"limit": limit,
+			},
+		}
+		require.Error(t, manager.SetQueryParams(state, payload), limit)
+	}
+}
+
+func TestContentManager_ShowMore(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	moduleManager := moduleFake.NewMockManagerInterface(controller)
+	dashConfig := configFake.NewMockDash(controller)
+	state := octantFake.NewMockState(controller)
+
+	manager := api.NewContentManager(moduleManager, dashConfig, log.NopLogger(),
+		api.WithContentGeneratorPoller(api.NewSingleRunPoller()))
+
+	showMore := action.CreatePayload(action.RequestShowMore, map[string]interface{}{"limit": float64(1000)})
+	require.NoError(t, manager.ShowMore(state, showMore))
+
+	// Other actions are handled by the action manager.
+	other := action.CreatePayload("action.octant.dev/deleteObject", nil)
+	require.NoError(t, manager.ShowMore(state, other))
+
+	require.Error(t, manager.ShowMore(state, action.CreatePayload(action.RequestShowMore, nil)))
+}
+
+func TestApplyTimePreferences(t *testing.T) {
+	created := component.NewTimestamp(time.Unix(1600000000, 0))
+	table := component.NewTableWithRows("table", "placeholder", component.NewTableCols("Age"), []component.TableRow{
+		{"Age": created},
+	})
+
+	contentResponse := component.ContentResponse{
+		Components: []component.Component{table},
+	}
+
+	timePreferences := preferences.TimePreferences{Format: preferences.TimeFormatAbsolute, TimeZone: "UTC"}
+	api.ApplyTimePreferences(&contentResponse, timePreferences)
+
+	assert.Equal(t, component.TimestampFormatAbsolute, created.Config.Format)
+	assert.Equal(t, "UTC", created.Config.TimeZone)
+}
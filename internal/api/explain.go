@@ -0,0 +1,65 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware-tanzu/octant/internal/config"
+	"github.com/vmware-tanzu/octant/internal/mime"
+	"github.com/vmware-tanzu/octant/pkg/log"
+)
+
+// ExplainPath returns the API path which explains a field of an object. A
+// blank field explains the object itself.
+func ExplainPath(apiVersion, kind, field string) string {
+	query := url.Values{}
+	query.Set("apiVersion", apiVersion)
+	query.Set("kind", kind)
+	if field != "" {
+		query.Set("field", field)
+	}
+	return VersionedPath("/explain") + "?" + query.Encode()
+}
+
+// explainHandler responds with the OpenAPI documentation of a field, like
+// kubectl explain.
+func explainHandler(dashConfig config.Dash, logger log.Logger) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			RespondWithError(w, http.StatusMethodNotAllowed, "method not allowed", logger)
+			return
+		}
+
+		query := r.URL.Query()
+		apiVersion, kind := query.Get("apiVersion"), query.Get("kind")
+		if apiVersion == "" || kind == "" {
+			RespondWithError(w, http.StatusBadRequest, "apiVersion and kind are required", logger)
+			return
+		}
+
+		gv, err := schema.ParseGroupVersion(apiVersion)
+		if err != nil {
+			RespondWithError(w, http.StatusBadRequest, err.Error(), logger)
+			return
+		}
+
+		field, err := dashConfig.Explainer().Explain(gv.WithKind(kind), query.Get("field"))
+		if err != nil {
+			RespondWithError(w, http.StatusNotFound, err.Error(), logger)
+			return
+		}
+
+		w.Header().Set("Content-Type", mime.JSONContentType)
+		if err := json.NewEncoder(w).Encode(field); err != nil {
+			logger.Errorf("encoding explain response: %v", err)
+		}
+	})
+}
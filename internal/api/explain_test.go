@@ -0,0 +1,89 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	configFake "github.com/vmware-tanzu/octant/internal/config/fake"
+	"github.com/vmware-tanzu/octant/internal/explain"
+	explainFake "github.com/vmware-tanzu/octant/internal/explain/fake"
+	"github.com/vmware-tanzu/octant/internal/log"
+)
+
+func TestExplainPath(t *testing.T) {
+	assert.Equal(t, "/api/v1/explain?apiVersion=apps%2Fv1&field=spec.replicas&kind=Deployment", ExplainPath("apps/v1", "Deployment", "spec.replicas"))
+	assert.Equal(t, "/api/v1/explain?apiVersion=v1&kind=Pod", ExplainPath("v1", "Pod", ""))
+}
+
+func Test_explainHandler(t *testing.T) {
+	deployment := schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}
+	replicas := &explain.Field{Name: "replicas", Type: "integer", Description: "Number of desired pods."}
+
+	cases := []struct {
+		name    string
+		path    string
+		explain func(explainer *explainFake.MockExplainer)
+		code    int
+	}{
+		{
+			name: "field",
+			path: ExplainPath("apps/v1", "Deployment", "spec.replicas"),
+			explain: func(explainer *explainFake.MockExplainer) {
+				explainer.EXPECT().Explain(deployment, "spec.replicas").Return(replicas, nil)
+			},
+			code: http.StatusOK,
+		},
+		{
+			name: "unknown field",
+			path: ExplainPath("apps/v1", "Deployment", "spec.missing"),
+			explain: func(explainer *explainFake.MockExplainer) {
+				explainer.EXPECT().Explain(deployment, "spec.missing").Return(nil, errors.New("does not exist"))
+			},
+			code: http.StatusNotFound,
+		},
+		{
+			name: "missing kind",
+			path: "/explain?apiVersion=v1",
+			code: http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			explainer := explainFake.NewMockExplainer(controller)
+			dashConfig := configFake.NewMockDash(controller)
+			if tc.explain != nil {
+				tc.explain(explainer)
+				dashConfig.EXPECT().Explainer().Return(explainer)
+			}
+
+			w := httptest.NewRecorder()
+			explainHandler(dashConfig, log.NopLogger()).ServeHTTP(w, httptest.NewRequest(http.MethodGet, tc.path, nil))
+			require.Equal(t, tc.code, w.Code)
+
+			if tc.code != http.StatusOK {
+				return
+			}
+
+			var got explain.Field
+			require.NoError(t, json.NewDecoder(w.Body).Decode(&got))
+			assert.Equal(t, *replicas, got)
+		})
+	}
+}
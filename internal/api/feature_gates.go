@@ -0,0 +1,40 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/vmware-tanzu/octant/internal/config"
+	"github.com/vmware-tanzu/octant/internal/featuregate"
+	"github.com/vmware-tanzu/octant/internal/mime"
+	"github.com/vmware-tanzu/octant/pkg/log"
+)
+
+// FeatureGatesResponse lists the state of Octant's feature gates.
+type FeatureGatesResponse struct {
+	FeatureGates []featuregate.Status `json:"featureGates"`
+}
+
+// featureGatesHandler responds with the state of the dashboard's feature gates.
+func featureGatesHandler(dashConfig config.Dash, logger log.Logger) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			RespondWithError(w, http.StatusMethodNotAllowed, "method not allowed", logger)
+			return
+		}
+
+		resp := FeatureGatesResponse{
+			FeatureGates: dashConfig.FeatureGate().Statuses(),
+		}
+
+		w.Header().Set("Content-Type", mime.JSONContentType)
+		if err := json.NewEncoder(w).Encode(resp); err != nil {
+			logger.Errorf("encoding feature gates response: %v", err)
+		}
+	})
+}
@@ -0,0 +1,49 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	configFake "github.com/vmware-tanzu/octant/internal/config/fake"
+	"github.com/vmware-tanzu/octant/internal/featuregate"
+	"github.com/vmware-tanzu/octant/internal/log"
+)
+
+func Test_featureGatesHandler(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	gate := featuregate.NewWithSpecs(map[featuregate.Feature]featuregate.Spec{
+		"Alpha": {Default: false, Stage: featuregate.Alpha, Description: "alpha feature"},
+	})
+	require.NoError(t, gate.Set(map[string]bool{"Alpha": true}))
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().FeatureGate().Return(gate)
+
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodGet, "/api/v1/feature-gates", nil)
+	featureGatesHandler(dashConfig, log.NopLogger()).ServeHTTP(w, r)
+
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var got FeatureGatesResponse
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&got))
+
+	expected := FeatureGatesResponse{
+		FeatureGates: []featuregate.Status{
+			{Name: "Alpha", Enabled: true, Default: false, Stage: featuregate.Alpha, Description: "alpha feature"},
+		},
+	}
+	require.Equal(t, expected, got)
+}
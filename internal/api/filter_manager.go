@@ -8,6 +8,7 @@ package api
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -22,6 +23,8 @@ const (
 	RequestAddFilter    = "action.octant.dev/addFilter"
 	RequestClearFilters = "action.octant.dev/clearFilters"
 	RequestRemoveFilter = "action.octant.dev/removeFilter"
+	// RequestSetLabelSelector replaces the filters with a label selector.
+	RequestSetLabelSelector = "action.octant.dev/setLabelSelector"
 )
 
 // FilterManager manages filters.
@@ -54,6 +57,10 @@ func (fm *FilterManager) Handlers() []octant.ClientRequestHandler {
 			RequestType: RequestRemoveFilter,
 			Handler:     fm.RemoveFilter,
 		},
+		{
+			RequestType: RequestSetLabelSelector,
+			Handler:     fm.SetLabelSelector,
+		},
 	}
 }
 
@@ -86,6 +93,55 @@ func (fm *FilterManager) RemoveFilter(state octant.State, payload action.Payload
 	return nil
 }
 
+// SetLabelSelector replaces the filters with a label selector such as `app=payments,tier=web`.
+// The filters apply to every list view, so this scopes the whole dashboard. A blank
+// selector clears the filters.
+func (fm *FilterManager) SetLabelSelector(state octant.State, payload action.Payload) error {
+	selector, err := payload.String("selector")
+	if err != nil {
+		return errors.Wrap(err, "get selector from payload")
+	}
+
+	filters, err := FiltersFromLabelSelector(selector)
+	if err != nil {
+		message := fmt.Sprintf("Invalid label selector %q: %v", selector, err)
+		state.SendAlert(action.CreateAlert(action.AlertTypeWarning, message, action.DefaultAlertExpiration))
+		return nil
+	}
+
+	state.SetFilters(filters)
+
+	message := "Cleared filters"
+	if len(filters) > 0 {
+		message = fmt.Sprintf("Showing objects with labels %s", FiltersToLabelSet(filters).String())
+	}
+	state.SendAlert(action.CreateAlert(action.AlertTypeInfo, message, action.DefaultAlertExpiration))
+
+	return nil
+}
+
+// FiltersFromLabelSelector converts a label selector to filters. Only equality based
+// selectors are supported. Filters are sorted by key.
+func FiltersFromLabelSelector(selector string) ([]octant.Filter, error) {
+	set, err := labels.ConvertSelectorToLabelsMap(strings.TrimSpace(selector))
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	filters := make([]octant.Filter, 0, len(keys))
+	for _, key := range keys {
+		filters = append(filters, octant.Filter{Key: key, Value: set[key]})
+	}
+
+	return filters, nil
+}
+
 // FilterFromPayload creates a filter from a payload. Returns false
 // if the payload is invalid.
 func FilterFromPayload(in action.Payload) (octant.Filter, bool) {
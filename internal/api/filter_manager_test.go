@@ -35,6 +35,7 @@ func TestFilterManager_Handlers(t *testing.T) {
 		api.RequestClearFilters,
 		api.RequestAddFilter,
 		api.RequestRemoveFilter,
+		api.RequestSetLabelSelector,
 	}
 	sort.Strings(expected)
 
@@ -95,6 +96,67 @@ func TestFilterManager_RemoveFilter(t *testing.T) {
 	require.NoError(t, manager.RemoveFilter(state, payload))
 }
 
+func TestFilterManager_SetLabelSelector(t *testing.T) {
+	tests := []struct {
+		name      string
+		payload   action.Payload
+		expected  []octant.Filter
+		alertType action.AlertType
+		wantErr   bool
+	}{
+		{
+			name:    "selector",
+			payload: action.Payload{"selector": "tier=web, app=payments"},
+			expected: []octant.Filter{
+				{Key: "app", Value: "payments"},
+				{Key: "tier", Value: "web"},
+			},
+			alertType: action.AlertTypeInfo,
+		},
+		{
+			name:      "blank selector",
+			payload:   action.Payload{"selector": ""},
+			expected:  []octant.Filter{},
+			alertType: action.AlertTypeInfo,
+		},
+		{
+			name:      "invalid selector",
+			payload:   action.Payload{"selector": "app!=payments"},
+			alertType: action.AlertTypeWarning,
+		},
+		{
+			name:    "missing selector",
+			payload: action.Payload{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			state := octantFake.NewMockState(controller)
+			if tt.expected != nil {
+				state.EXPECT().SetFilters(tt.expected)
+			}
+			if !tt.wantErr {
+				state.EXPECT().SendAlert(gomock.Any()).Do(func(alert action.Alert) {
+					assert.Equal(t, tt.alertType, alert.Type)
+				})
+			}
+
+			manager := api.NewFilterManager()
+
+			err := manager.SetLabelSelector(state, tt.payload)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
 func TestFilterFromPayload(t *testing.T) {
 	type args struct {
 		in action.Payload
@@ -16,6 +16,7 @@ import (
 	"github.com/vmware-tanzu/octant/internal/event"
 	"github.com/vmware-tanzu/octant/internal/log"
 	"github.com/vmware-tanzu/octant/internal/octant"
+	dashstrings "github.com/vmware-tanzu/octant/internal/util/strings"
 	oevent "github.com/vmware-tanzu/octant/pkg/event"
 )
 
@@ -113,7 +114,7 @@ func (n *NamespacesManager) runUpdate(state octant.State, client OctantClient) P
 }
 
 // NamespacesGenerator generates a list of namespaces.
-func NamespacesGenerator(_ context.Context, config NamespaceManagerConfig) ([]string, error) {
+func NamespacesGenerator(ctx context.Context, config NamespaceManagerConfig) ([]string, error) {
 	if config == nil {
 		return nil, errors.New("namespaces manager config is nil")
 	}
@@ -128,19 +129,20 @@ func NamespacesGenerator(_ context.Context, config NamespaceManagerConfig) ([]st
 
 	names, err := namespaceClient.Names()
 	if err != nil {
-		initialNamespace := namespaceClient.InitialNamespace()
-		names = []string{initialNamespace}
+		// The user can't list namespaces cluster wide, so restrict the namespaces
+		// to the initial namespace and the namespaces configured at startup.
+		log.From(ctx).WithErr(err).Debugf("unable to list namespaces, using provided namespaces")
+		names = []string{namespaceClient.InitialNamespace()}
+		for _, namespace := range providedNamespaces {
+			if namespace != "" && !dashstrings.Contains(namespace, names) {
+				names = append(names, namespace)
+			}
+		}
+		return names, nil
 	}
 
 	for _, namespace := range providedNamespaces {
-		found := false
-		for _, foundNamespace := range names {
-			if namespace == foundNamespace {
-				found = true
-				break
-			}
-		}
-		if !found && namespaceClient.HasNamespace(namespace) {
+		if !dashstrings.Contains(namespace, names) && namespaceClient.HasNamespace(namespace) {
 			names = append(names, namespace)
 		}
 	}
@@ -7,6 +7,7 @@ package api_test
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -70,6 +71,43 @@ func TestNamespacesGenerator(t *testing.T) {
 			},
 			expected: []string{"ns-1"},
 		},
+		{
+			name: "provided namespaces are added when they exist",
+			setup: func(controller *gomock.Controller) *configFake.MockDash {
+				namespaceClient := clusterFake.NewMockNamespaceInterface(controller)
+				namespaceClient.EXPECT().Names().Return([]string{"ns-1"}, nil)
+				namespaceClient.EXPECT().ProvidedNamespaces().Return([]string{"ns-1", "ns-2", "ns-3"})
+				namespaceClient.EXPECT().HasNamespace("ns-2").Return(true)
+				namespaceClient.EXPECT().HasNamespace("ns-3").Return(false)
+
+				clusterClient := clusterFake.NewMockClientInterface(controller)
+				clusterClient.EXPECT().NamespaceClient().Return(namespaceClient, nil)
+
+				dashConfig := configFake.NewMockDash(controller)
+				dashConfig.EXPECT().ClusterClient().Return(clusterClient)
+
+				return dashConfig
+			},
+			expected: []string{"ns-1", "ns-2"},
+		},
+		{
+			name: "unable to list namespaces restricts to provided namespaces",
+			setup: func(controller *gomock.Controller) *configFake.MockDash {
+				namespaceClient := clusterFake.NewMockNamespaceInterface(controller)
+				namespaceClient.EXPECT().Names().Return(nil, fmt.Errorf("forbidden"))
+				namespaceClient.EXPECT().InitialNamespace().Return("ns-1")
+				namespaceClient.EXPECT().ProvidedNamespaces().Return([]string{"ns-1", "ns-2"})
+
+				clusterClient := clusterFake.NewMockClientInterface(controller)
+				clusterClient.EXPECT().NamespaceClient().Return(namespaceClient, nil)
+
+				dashConfig := configFake.NewMockDash(controller)
+				dashConfig.EXPECT().ClusterClient().Return(clusterClient)
+
+				return dashConfig
+			},
+			expected: []string{"ns-1", "ns-2"},
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
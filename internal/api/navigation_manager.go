@@ -26,6 +26,7 @@ import (
 
 // NavigationManagerConfig is configuration of NavigationManager.
 type NavigationManagerConfig interface {
+	BookmarksNavigationConfig
 	ModuleManager() module.ManagerInterface
 }
 
@@ -164,6 +165,8 @@ func NavigationGenerator(ctx context.Context, state octant.State, config Navigat
 		sections = append(sections, lookup[m.Name()]...)
 	}
 
+	sections = append(sections, BookmarksNavigation(ctx, config)...)
+
 	return sections, nil
 }
 
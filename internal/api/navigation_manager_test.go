@@ -19,6 +19,7 @@ import (
 	moduleFake "github.com/vmware-tanzu/octant/internal/module/fake"
 	"github.com/vmware-tanzu/octant/internal/octant"
 	octantFake "github.com/vmware-tanzu/octant/internal/octant/fake"
+	"github.com/vmware-tanzu/octant/internal/preferences"
 	"github.com/vmware-tanzu/octant/pkg/navigation"
 )
 
@@ -75,6 +76,7 @@ func TestNavigationGenerator(t *testing.T) {
 
 				dashConfig := configFake.NewMockDash(controller)
 				dashConfig.EXPECT().ModuleManager().Return(moduleManager)
+				dashConfig.EXPECT().Preferences().Return(preferences.NewService(nil))
 
 				state := octantFake.NewMockState(controller)
 				state.EXPECT().GetNamespace().Return("default")
@@ -0,0 +1,151 @@
+/*
+ * Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package api
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/vmware-tanzu/octant/internal/notification"
+	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/event"
+)
+
+const (
+	RequestMuteNotifications   = "action.octant.dev/muteNotifications"
+	RequestUnmuteNotifications = "action.octant.dev/unmuteNotifications"
+)
+
+// NotificationManager sends resource change notifications to a client. Each client has
+// its own manager, so the notifications a client mutes are only muted for it.
+type NotificationManager struct {
+	notifier notification.Notifier
+
+	mu        sync.Mutex
+	muteRules map[notification.MuteRule]bool
+}
+
+var _ StateManager = (*NotificationManager)(nil)
+
+// NewNotificationManager creates an instance of NotificationManager.
+func NewNotificationManager(notifier notification.Notifier) *NotificationManager {
+	return &NotificationManager{
+		notifier:  notifier,
+		muteRules: map[notification.MuteRule]bool{},
+	}
+}
+
+// Handlers returns a slice of handlers.
+func (n *NotificationManager) Handlers() []octant.ClientRequestHandler {
+	return []octant.ClientRequestHandler{
+		{
+			RequestType: RequestMuteNotifications,
+			Handler:     n.Mute,
+		},
+		{
+			RequestType: RequestUnmuteNotifications,
+			Handler:     n.Unmute,
+		},
+	}
+}
+
+// Start starts the manager. Notifications are sent for the client's current namespace
+// until ctx is cancelled or the client disconnects.
+func (n *NotificationManager) Start(ctx context.Context, state octant.State, s OctantClient) {
+	var watchMu sync.Mutex
+	release := n.notifier.Watch(state.GetNamespace())
+	defer func() {
+		watchMu.Lock()
+		defer watchMu.Unlock()
+		release()
+	}()
+
+	cancelNamespace := state.OnNamespaceUpdate(func(namespace string) {
+		watch := n.notifier.Watch(namespace)
+
+		watchMu.Lock()
+		defer watchMu.Unlock()
+		release()
+		release = watch
+	})
+	defer cancelNamespace()
+
+	ch := make(chan notification.Notification, 10)
+	unsubscribe := n.notifier.Subscribe(ch)
+	defer unsubscribe()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.StopCh():
+			return
+		case item := <-ch:
+			if n.muted(item) {
+				continue
+			}
+			s.Send(CreateNotificationEvent(item))
+		}
+	}
+}
+
+// Mute mutes notifications matching the rule in the payload for the client.
+func (n *NotificationManager) Mute(state octant.State, payload action.Payload) error {
+	rule := MuteRuleFromPayload(payload)
+
+	n.mu.Lock()
+	n.muteRules[rule] = true
+	n.mu.Unlock()
+
+	message := fmt.Sprintf("Muted notifications for %s", rule)
+	state.SendAlert(action.CreateAlert(action.AlertTypeInfo, message, action.DefaultAlertExpiration))
+	return nil
+}
+
+// Unmute removes the mute rule in the payload.
+func (n *NotificationManager) Unmute(state octant.State, payload action.Payload) error {
+	rule := MuteRuleFromPayload(payload)
+
+	n.mu.Lock()
+	delete(n.muteRules, rule)
+	n.mu.Unlock()
+
+	message := fmt.Sprintf("Unmuted notifications for %s", rule)
+	state.SendAlert(action.CreateAlert(action.AlertTypeInfo, message, action.DefaultAlertExpiration))
+	return nil
+}
+
+// muted returns true if the client muted notifications for the notification's object.
+func (n *NotificationManager) muted(item notification.Notification) bool {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	for rule := range n.muteRules {
+		if rule.Matches(item.Object) {
+			return true
+		}
+	}
+	return false
+}
+
+// MuteRuleFromPayload creates a mute rule from a payload. Missing fields match anything.
+func MuteRuleFromPayload(payload action.Payload) notification.MuteRule {
+	var rule notification.MuteRule
+	rule.Namespace, _ = payload.OptionalString("namespace")
+	rule.Kind, _ = payload.OptionalString("kind")
+	rule.Name, _ = payload.OptionalString("name")
+	return rule
+}
+
+// CreateNotificationEvent creates a notification event.
+func CreateNotificationEvent(n notification.Notification) event.Event {
+	return event.Event{
+		Type: event.EventTypeNotification,
+		Data: n,
+	}
+}
@@ -0,0 +1,105 @@
+/*
+ * Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package api_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/octant/internal/api"
+	"github.com/vmware-tanzu/octant/internal/api/fake"
+	"github.com/vmware-tanzu/octant/internal/notification"
+	notificationFake "github.com/vmware-tanzu/octant/internal/notification/fake"
+	"github.com/vmware-tanzu/octant/internal/octant"
+	octantFake "github.com/vmware-tanzu/octant/internal/octant/fake"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/event"
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+func TestNotificationManager_Start(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	item := notification.Notification{ID: "id", Type: action.AlertTypeError, Message: "Deployment nginx became unavailable"}
+
+	var namespaceUpdate octant.NamespaceUpdateFunc
+
+	released := map[string]int{}
+	release := func(namespace string) func() {
+		return func() {
+			released[namespace]++
+		}
+	}
+
+	muted := notification.Notification{ID: "muted", Object: store.Key{Namespace: "default", Kind: "Pod", Name: "web"}}
+
+	notifier := notificationFake.NewMockNotifier(controller)
+	notifier.EXPECT().Watch("default").Return(release("default"))
+	notifier.EXPECT().Watch("other").Return(release("other"))
+	notifier.EXPECT().
+		Subscribe(gomock.Any()).
+		DoAndReturn(func(ch chan<- notification.Notification) func() {
+			ch <- muted
+			ch <- item
+			return func() {}
+		})
+
+	state := octantFake.NewMockState(controller)
+	state.EXPECT().GetNamespace().Return("default")
+	state.EXPECT().
+		OnNamespaceUpdate(gomock.Any()).
+		DoAndReturn(func(fn octant.NamespaceUpdateFunc) octant.UpdateCancelFunc {
+			namespaceUpdate = fn
+			return func() {}
+		})
+
+	octantClient := fake.NewMockOctantClient(controller)
+	octantClient.EXPECT().StopCh().Return(nil).AnyTimes()
+	octantClient.EXPECT().
+		Send(gomock.Any()).
+		Do(func(e event.Event) {
+			assert.Equal(t, event.EventTypeNotification, e.Type)
+			assert.Equal(t, item, e.Data)
+			namespaceUpdate("other")
+			cancel()
+		})
+
+	manager := api.NewNotificationManager(notifier)
+
+	state.EXPECT().SendAlert(gomock.Any())
+	require.NoError(t, manager.Mute(state, action.Payload{"kind": "Pod"}))
+
+	manager.Start(ctx, state, octantClient)
+
+	// The previous namespace is released when the client changes namespaces, and the
+	// current one when it stops.
+	assert.Equal(t, map[string]int{"default": 1, "other": 1}, released)
+}
+
+func TestNotificationManager_Mute(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	notifier := notificationFake.NewMockNotifier(controller)
+
+	state := octantFake.NewMockState(controller)
+	state.EXPECT().SendAlert(gomock.Any()).Times(2)
+
+	manager := api.NewNotificationManager(notifier)
+	require.Len(t, manager.Handlers(), 2)
+
+	payload := action.Payload{"namespace": "default", "kind": "Deployment"}
+	require.NoError(t, manager.Mute(state, payload))
+	require.NoError(t, manager.Unmute(state, payload))
+}
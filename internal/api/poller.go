@@ -83,9 +83,16 @@ func (a *InterruptiblePoller) Run(ctx context.Context, ch <-chan struct{}, actio
 			case <-timer.C:
 				logger.Debugf("poller is running action")
 				now := time.Now()
-				action(cur)
+				interrupted := a.runAction(cur, cancel, ch, action)
 				logger.With("elapsed", fmt.Sprintf("%s", time.Since(now))).
 					Debugf("poller ran action")
+
+				if interrupted {
+					// The action was abandoned, so start it again right away.
+					logger.Debugf("poller was interrupted while running action")
+					canceled = true
+					timer.Reset(0)
+				}
 			}
 
 			if !canceled {
@@ -95,3 +102,30 @@ func (a *InterruptiblePoller) Run(ctx context.Context, ch <-chan struct{}, actio
 		}()
 	}
 }
+
+// runAction runs action with ctx. If a message is sent to ch before action returns,
+// ctx is canceled so the work action is doing for a stale request stops early. It
+// returns true if action was interrupted.
+func (a *InterruptiblePoller) runAction(ctx context.Context, cancel context.CancelFunc, ch <-chan struct{}, action PollerFunc) bool {
+	finished := make(chan struct{})
+	interrupted := make(chan bool, 1)
+
+	go func() {
+		select {
+		case _, ok := <-ch:
+			if ok {
+				cancel()
+			}
+			interrupted <- ok
+		case <-ctx.Done():
+			interrupted <- false
+		case <-finished:
+			interrupted <- false
+		}
+	}()
+
+	action(ctx)
+	close(finished)
+
+	return <-interrupted
+}
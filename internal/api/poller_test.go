@@ -42,3 +42,42 @@ func TestInterruptiblePoller_Run(t *testing.T) {
 
 	assert.True(t, ran)
 }
+
+func TestInterruptiblePoller_Run_interruptAction(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ip := NewInterruptiblePoller("poller")
+
+	ch := make(chan struct{}, 1)
+
+	started := make(chan bool, 2)
+	canceled := make(chan bool, 1)
+	runs := 0
+	action := func(ctx context.Context) bool {
+		runs++
+		started <- true
+		if runs == 1 {
+			<-ctx.Done()
+			canceled <- true
+		}
+		return false
+	}
+
+	go ip.Run(ctx, ch, action, time.Hour)
+
+	<-started
+	ch <- struct{}{}
+
+	select {
+	case <-canceled:
+	case <-time.After(time.Second):
+		t.Fatal("action context was not canceled when the poller was interrupted")
+	}
+
+	select {
+	case <-started:
+	case <-time.After(time.Second):
+		t.Fatal("action was not run again after it was interrupted")
+	}
+}
@@ -0,0 +1,183 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware-tanzu/octant/internal/config"
+	"github.com/vmware-tanzu/octant/internal/mime"
+	"github.com/vmware-tanzu/octant/internal/portforward"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/log"
+)
+
+// PortForwardTarget is an object a port forward was created for.
+type PortForwardTarget struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Namespace  string `json:"namespace"`
+	Name       string `json:"name"`
+}
+
+// PortForwardResponse describes an active port forward.
+type PortForwardResponse struct {
+	ID        string                            `json:"id"`
+	CreatedAt time.Time                         `json:"createdAt"`
+	Target    PortForwardTarget                 `json:"target"`
+	Pod       PortForwardTarget                 `json:"pod"`
+	Ports     []portforward.PortForwardPortSpec `json:"ports"`
+	Status    portforward.Status                `json:"status"`
+	Message   string                            `json:"message,omitempty"`
+	// Reconnects is the number of times the port forward moved to a new pod.
+	Reconnects    int        `json:"reconnects"`
+	ReconnectedAt *time.Time `json:"reconnectedAt,omitempty"`
+}
+
+// PortForwardsResponse lists active port forwards.
+type PortForwardsResponse struct {
+	PortForwards []PortForwardResponse `json:"portForwards"`
+}
+
+// NewPortForwardResponse converts port forward state to a response.
+func NewPortForwardResponse(state portforward.State) PortForwardResponse {
+	resp := PortForwardResponse{
+		ID:        state.ID,
+		CreatedAt: state.CreatedAt,
+		Target:    newPortForwardTarget(state.Target),
+		Pod:       newPortForwardTarget(state.Pod),
+		Ports:     []portforward.PortForwardPortSpec{},
+		Status:    state.Status,
+		Message:   state.Message,
+
+		Reconnects: state.Reconnects,
+	}
+
+	if !state.ReconnectedAt.IsZero() {
+		reconnectedAt := state.ReconnectedAt
+		resp.ReconnectedAt = &reconnectedAt
+	}
+
+	for _, port := range state.Ports {
+		resp.Ports = append(resp.Ports, portforward.PortForwardPortSpec{
+			Remote: port.Remote,
+			Local:  port.Local,
+		})
+	}
+
+	return resp
+}
+
+func newPortForwardTarget(target portforward.Target) PortForwardTarget {
+	apiVersion, kind := target.GVK.ToAPIVersionAndKind()
+	return PortForwardTarget{
+		APIVersion: apiVersion,
+		Kind:       kind,
+		Namespace:  target.Namespace,
+		Name:       target.Name,
+	}
+}
+
+// portForwardsHandler lists port forwards and creates new ones. A port forward
+// is created for each port in a create request.
+func portForwardsHandler(dashConfig config.Dash, logger log.Logger) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		portForwarder := dashConfig.PortForwarder()
+
+		switch r.Method {
+		case http.MethodGet:
+			resp := PortForwardsResponse{PortForwards: []PortForwardResponse{}}
+			for _, state := range portForwarder.List(r.Context()) {
+				resp.PortForwards = append(resp.PortForwards, NewPortForwardResponse(state))
+			}
+
+			respondWithJSON(w, http.StatusOK, resp, logger)
+		case http.MethodPost:
+			var req portforward.CreateRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				RespondWithError(w, http.StatusBadRequest, fmt.Sprintf("decode port forward request: %v", err), logger)
+				return
+			}
+
+			if len(req.Ports) == 0 {
+				RespondWithError(w, http.StatusBadRequest, "port forward request has no ports", logger)
+				return
+			}
+
+			gvk := schema.FromAPIVersionAndKind(req.APIVersion, req.Kind)
+
+			// Ports are forwarded together, so the forwards already created are
+			// stopped if a port can't be forwarded.
+			var createdIDs []string
+			stopCreated := func() {
+				for _, id := range createdIDs {
+					portForwarder.StopForwarder(id)
+				}
+			}
+
+			resp := PortForwardsResponse{PortForwards: []PortForwardResponse{}}
+			for _, port := range req.Ports {
+				created, err := portForwarder.Create(r.Context(), action.NewAlerter(), gvk, req.Name, req.Namespace, port.Remote)
+				if err != nil {
+					stopCreated()
+					RespondWithError(w, http.StatusBadRequest, err.Error(), logger)
+					return
+				}
+				createdIDs = append(createdIDs, created.ID)
+
+				state, ok := portForwarder.Get(created.ID)
+				if !ok {
+					stopCreated()
+					RespondWithError(w, http.StatusInternalServerError, fmt.Sprintf("port forward %s stopped", created.ID), logger)
+					return
+				}
+				resp.PortForwards = append(resp.PortForwards, NewPortForwardResponse(state))
+			}
+
+			respondWithJSON(w, http.StatusCreated, resp, logger)
+		default:
+			RespondWithError(w, http.StatusMethodNotAllowed, "method not allowed", logger)
+		}
+	})
+}
+
+// portForwardHandler gets or stops a single port forward.
+func portForwardHandler(dashConfig config.Dash, logger log.Logger) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		portForwarder := dashConfig.PortForwarder()
+		id := mux.Vars(r)["id"]
+
+		state, ok := portForwarder.Get(id)
+		if !ok {
+			RespondWithError(w, http.StatusNotFound, fmt.Sprintf("port forward %q not found", id), logger)
+			return
+		}
+
+		switch r.Method {
+		case http.MethodGet:
+			respondWithJSON(w, http.StatusOK, NewPortForwardResponse(state), logger)
+		case http.MethodDelete:
+			portForwarder.StopForwarder(id)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			RespondWithError(w, http.StatusMethodNotAllowed, "method not allowed", logger)
+		}
+	})
+}
+
+func respondWithJSON(w http.ResponseWriter, code int, v interface{}, logger log.Logger) {
+	w.Header().Set("Content-Type", mime.JSONContentType)
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		logger.Errorf("encoding response: %v", err)
+	}
+}
@@ -0,0 +1,168 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	configFake "github.com/vmware-tanzu/octant/internal/config/fake"
+	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/internal/portforward"
+	portForwardFake "github.com/vmware-tanzu/octant/internal/portforward/fake"
+)
+
+func testPortForwardState() portforward.State {
+	return portforward.State{
+		ID:        "id",
+		CreatedAt: time.Unix(1600000000, 0).UTC(),
+		Ports:     []portforward.ForwardedPort{{Local: 40000, Remote: 8080}},
+		Target: portforward.Target{
+			GVK:       schema.GroupVersionKind{Version: "v1", Kind: "Service"},
+			Namespace: "default",
+			Name:      "service",
+		},
+		Pod: portforward.Target{
+			GVK:       schema.GroupVersionKind{Version: "v1", Kind: "Pod"},
+			Namespace: "default",
+			Name:      "pod",
+		},
+		Status: portforward.StatusActive,
+	}
+}
+
+func portForwardsRouter(controller *gomock.Controller) (http.Handler, *portForwardFake.MockPortForwarder) {
+	portForwarder := portForwardFake.NewMockPortForwarder(controller)
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().PortForwarder().Return(portForwarder).AnyTimes()
+
+	router := mux.NewRouter()
+	router.Handle("/port-forwards", portForwardsHandler(dashConfig, log.NopLogger()))
+	router.Handle("/port-forwards/{id}", portForwardHandler(dashConfig, log.NopLogger()))
+
+	return router, portForwarder
+}
+
+func TestNewPortForwardResponse(t *testing.T) {
+	expected := PortForwardResponse{
+		ID:        "id",
+		CreatedAt: time.Unix(1600000000, 0).UTC(),
+		Target:    PortForwardTarget{APIVersion: "v1", Kind: "Service", Namespace: "default", Name: "service"},
+		Pod:       PortForwardTarget{APIVersion: "v1", Kind: "Pod", Namespace: "default", Name: "pod"},
+		Ports:     []portforward.PortForwardPortSpec{{Remote: 8080, Local: 40000}},
+		Status:    portforward.StatusActive,
+	}
+
+	assert.Equal(t, expected, NewPortForwardResponse(testPortForwardState()))
+}
+
+func Test_portForwardsHandler_list(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	router, portForwarder := portForwardsRouter(controller)
+	portForwarder.EXPECT().List(gomock.Any()).Return([]portforward.State{testPortForwardState()})
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/port-forwards", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var got PortForwardsResponse
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&got))
+	require.Len(t, got.PortForwards, 1)
+	assert.Equal(t, "id", got.PortForwards[0].ID)
+}
+
+func Test_portForwardsHandler_create(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	router, portForwarder := portForwardsRouter(controller)
+	gvk := schema.GroupVersionKind{Version: "v1", Kind: "Pod"}
+	portForwarder.EXPECT().
+		Create(gomock.Any(), gomock.Any(), gvk, "pod", "default", uint16(8080)).
+		Return(portforward.CreateResponse{ID: "id"}, nil)
+	portForwarder.EXPECT().Get("id").Return(testPortForwardState(), true)
+
+	body := `{"apiVersion":"v1","kind":"Pod","namespace":"default","name":"pod","ports":[{"remote":8080}]}`
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/port-forwards", strings.NewReader(body)))
+	require.Equal(t, http.StatusCreated, w.Code)
+
+	var got PortForwardsResponse
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&got))
+	require.Len(t, got.PortForwards, 1)
+	assert.Equal(t, uint16(40000), got.PortForwards[0].Ports[0].Local)
+}
+
+func Test_portForwardsHandler_create_failure(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	router, portForwarder := portForwardsRouter(controller)
+	gvk := schema.GroupVersionKind{Version: "v1", Kind: "Pod"}
+	portForwarder.EXPECT().
+		Create(gomock.Any(), gomock.Any(), gvk, "pod", "default", uint16(8080)).
+		Return(portforward.CreateResponse{ID: "id"}, nil)
+	portForwarder.EXPECT().Get("id").Return(testPortForwardState(), true)
+	portForwarder.EXPECT().
+		Create(gomock.Any(), gomock.Any(), gvk, "pod", "default", uint16(9090)).
+		Return(portforward.CreateResponse{}, fmt.Errorf("port is not exposed"))
+
+	// The forward of the first port is stopped since the second failed.
+	portForwarder.EXPECT().StopForwarder("id")
+
+	body := `{"apiVersion":"v1","kind":"Pod","namespace":"default","name":"pod","ports":[{"remote":8080},{"remote":9090}]}`
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/port-forwards", strings.NewReader(body)))
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func Test_portForwardsHandler_create_noPorts(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	router, _ := portForwardsRouter(controller)
+
+	body := `{"apiVersion":"v1","kind":"Pod","namespace":"default","name":"pod"}`
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/port-forwards", strings.NewReader(body)))
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func Test_portForwardHandler(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	router, portForwarder := portForwardsRouter(controller)
+	portForwarder.EXPECT().Get("id").Return(testPortForwardState(), true).Times(2)
+	portForwarder.EXPECT().Get("missing").Return(portforward.State{}, false)
+	portForwarder.EXPECT().StopForwarder("id")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/port-forwards/id", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodDelete, "/port-forwards/id", nil))
+	require.Equal(t, http.StatusNoContent, w.Code)
+
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/port-forwards/missing", nil))
+	require.Equal(t, http.StatusNotFound, w.Code)
+}
@@ -0,0 +1,93 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/vmware-tanzu/octant/internal/config"
+	"github.com/vmware-tanzu/octant/internal/preferences"
+	"github.com/vmware-tanzu/octant/pkg/log"
+)
+
+// preferencesHandler returns the user's preferences.
+func preferencesHandler(dashConfig config.Dash, logger log.Logger) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			RespondWithError(w, http.StatusMethodNotAllowed, "method not allowed", logger)
+			return
+		}
+
+		respondWithJSON(w, http.StatusOK, dashConfig.Preferences().Get(), logger)
+	})
+}
+
+// uiPreferencesHandler returns or replaces the user's UI preferences. Settings which are
+// blank in a PUT are reset to their defaults.
+func uiPreferencesHandler(dashConfig config.Dash, logger log.Logger) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		manager := dashConfig.Preferences()
+
+		switch r.Method {
+		case http.MethodGet:
+			respondWithJSON(w, http.StatusOK, manager.Get().UI, logger)
+		case http.MethodPut:
+			var ui preferences.UIPreferences
+			if err := json.NewDecoder(r.Body).Decode(&ui); err != nil {
+				RespondWithError(w, http.StatusBadRequest, fmt.Sprintf("decode ui preferences: %v", err), logger)
+				return
+			}
+
+			if err := ui.Validate(); err != nil {
+				RespondWithError(w, http.StatusBadRequest, err.Error(), logger)
+				return
+			}
+
+			if err := manager.SetUI(ui); err != nil {
+				RespondWithError(w, http.StatusInternalServerError, err.Error(), logger)
+				return
+			}
+
+			respondWithJSON(w, http.StatusOK, manager.Get().UI, logger)
+		default:
+			RespondWithError(w, http.StatusMethodNotAllowed, "method not allowed", logger)
+		}
+	})
+}
+
+// timePreferencesHandler returns or replaces the user's time preferences.
+func timePreferencesHandler(dashConfig config.Dash, logger log.Logger) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		manager := dashConfig.Preferences()
+
+		switch r.Method {
+		case http.MethodGet:
+			respondWithJSON(w, http.StatusOK, manager.Get().Time, logger)
+		case http.MethodPut:
+			var timePreferences preferences.TimePreferences
+			if err := json.NewDecoder(r.Body).Decode(&timePreferences); err != nil {
+				RespondWithError(w, http.StatusBadRequest, fmt.Sprintf("decode time preferences: %v", err), logger)
+				return
+			}
+
+			if err := timePreferences.Validate(); err != nil {
+				RespondWithError(w, http.StatusBadRequest, err.Error(), logger)
+				return
+			}
+
+			if err := manager.SetTime(timePreferences); err != nil {
+				RespondWithError(w, http.StatusInternalServerError, err.Error(), logger)
+				return
+			}
+
+			respondWithJSON(w, http.StatusOK, manager.Get().Time, logger)
+		default:
+			RespondWithError(w, http.StatusMethodNotAllowed, "method not allowed", logger)
+		}
+	})
+}
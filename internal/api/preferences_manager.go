@@ -0,0 +1,220 @@
+/*
+ * Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/internal/preferences"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/event"
+)
+
+const (
+	RequestFavoriteNamespace   = "action.octant.dev/favoriteNamespace"
+	RequestUnfavoriteNamespace = "action.octant.dev/unfavoriteNamespace"
+	RequestAddBookmark         = "action.octant.dev/addBookmark"
+	RequestRemoveBookmark      = "action.octant.dev/removeBookmark"
+	RequestSetUIPreferences    = "action.octant.dev/setUIPreferences"
+	RequestSetTimePreferences  = "action.octant.dev/setTimePreferences"
+)
+
+// PreferencesManager records namespace visits and sends a client the user's preferences.
+type PreferencesManager struct {
+	preferences preferences.Manager
+}
+
+var _ StateManager = (*PreferencesManager)(nil)
+
+// NewPreferencesManager creates an instance of PreferencesManager.
+func NewPreferencesManager(manager preferences.Manager) *PreferencesManager {
+	return &PreferencesManager{
+		preferences: manager,
+	}
+}
+
+// Handlers returns a slice of handlers.
+func (p *PreferencesManager) Handlers() []octant.ClientRequestHandler {
+	return []octant.ClientRequestHandler{
+		{
+			RequestType: RequestFavoriteNamespace,
+			Handler:     p.FavoriteNamespace,
+		},
+		{
+			RequestType: RequestUnfavoriteNamespace,
+			Handler:     p.UnfavoriteNamespace,
+		},
+		{
+			RequestType: RequestAddBookmark,
+			Handler:     p.AddBookmark,
+		},
+		{
+			RequestType: RequestRemoveBookmark,
+			Handler:     p.RemoveBookmark,
+		},
+		{
+			RequestType: RequestSetUIPreferences,
+			Handler:     p.SetUIPreferences,
+		},
+		{
+			RequestType: RequestSetTimePreferences,
+			Handler:     p.SetTimePreferences,
+		},
+	}
+}
+
+// Start starts the manager. The client is sent the current preferences and then every
+// change until ctx is cancelled. Namespaces the client switches to are recorded as visits.
+func (p *PreferencesManager) Start(ctx context.Context, state octant.State, s OctantClient) {
+	logger := log.From(ctx)
+
+	ch := make(chan preferences.Preferences, 1)
+	unsubscribe := p.preferences.Subscribe(ch)
+	defer unsubscribe()
+
+	cancelNamespace := state.OnNamespaceUpdate(func(namespace string) {
+		if err := p.preferences.VisitNamespace(namespace); err != nil {
+			logger.WithErr(err).Errorf("record namespace visit")
+		}
+	})
+	defer cancelNamespace()
+
+	s.Send(CreatePreferencesEvent(p.preferences.Get()))
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.StopCh():
+			return
+		case current := <-ch:
+			s.Send(CreatePreferencesEvent(current))
+		}
+	}
+}
+
+// FavoriteNamespace pins the namespace in the payload.
+func (p *PreferencesManager) FavoriteNamespace(state octant.State, payload action.Payload) error {
+	namespace, err := payload.String("namespace")
+	if err != nil {
+		return fmt.Errorf("get namespace from payload: %w", err)
+	}
+
+	if err := p.preferences.FavoriteNamespace(namespace); err != nil {
+		return fmt.Errorf("favorite namespace %q: %w", namespace, err)
+	}
+
+	message := fmt.Sprintf("Added %s to favorite namespaces", namespace)
+	state.SendAlert(action.CreateAlert(action.AlertTypeInfo, message, action.DefaultAlertExpiration))
+	return nil
+}
+
+// UnfavoriteNamespace unpins the namespace in the payload.
+func (p *PreferencesManager) UnfavoriteNamespace(state octant.State, payload action.Payload) error {
+	namespace, err := payload.String("namespace")
+	if err != nil {
+		return fmt.Errorf("get namespace from payload: %w", err)
+	}
+
+	if err := p.preferences.UnfavoriteNamespace(namespace); err != nil {
+		return fmt.Errorf("unfavorite namespace %q: %w", namespace, err)
+	}
+
+	message := fmt.Sprintf("Removed %s from favorite namespaces", namespace)
+	state.SendAlert(action.CreateAlert(action.AlertTypeInfo, message, action.DefaultAlertExpiration))
+	return nil
+}
+
+// AddBookmark bookmarks the object in the payload.
+func (p *PreferencesManager) AddBookmark(state octant.State, payload action.Payload) error {
+	bookmark, err := BookmarkFromPayload(payload)
+	if err != nil {
+		return fmt.Errorf("get bookmark from payload: %w", err)
+	}
+
+	if err := p.preferences.AddBookmark(bookmark); err != nil {
+		return fmt.Errorf("add bookmark for %s: %w", bookmark, err)
+	}
+
+	message := fmt.Sprintf("Bookmarked %s", bookmark)
+	state.SendAlert(action.CreateAlert(action.AlertTypeInfo, message, action.DefaultAlertExpiration))
+	return nil
+}
+
+// RemoveBookmark removes the bookmark in the payload.
+func (p *PreferencesManager) RemoveBookmark(state octant.State, payload action.Payload) error {
+	bookmark, err := BookmarkFromPayload(payload)
+	if err != nil {
+		return fmt.Errorf("get bookmark from payload: %w", err)
+	}
+
+	if err := p.preferences.RemoveBookmark(bookmark); err != nil {
+		return fmt.Errorf("remove bookmark for %s: %w", bookmark, err)
+	}
+
+	message := fmt.Sprintf("Removed bookmark for %s", bookmark)
+	state.SendAlert(action.CreateAlert(action.AlertTypeInfo, message, action.DefaultAlertExpiration))
+	return nil
+}
+
+// SetUIPreferences changes the UI settings in the payload. Settings missing from the
+// payload are not changed.
+func (p *PreferencesManager) SetUIPreferences(state octant.State, payload action.Payload) error {
+	ui := p.preferences.Get().UI
+	if theme, err := payload.OptionalString("theme"); err == nil && theme != "" {
+		ui.Theme = theme
+	}
+	if tableDensity, err := payload.OptionalString("tableDensity"); err == nil && tableDensity != "" {
+		ui.TableDensity = tableDensity
+	}
+	if landingPage, err := payload.OptionalString("landingPage"); err == nil && landingPage != "" {
+		ui.LandingPage = landingPage
+	}
+	if _, ok := payload["listLimit"]; ok {
+		listLimit, err := payload.Int64("listLimit")
+		if err != nil {
+			return fmt.Errorf("extract list limit from payload: %w", err)
+		}
+		ui.ListLimit = int(listLimit)
+	}
+
+	if err := p.preferences.SetUI(ui); err != nil {
+		message := fmt.Sprintf("Unable to save preferences: %v", err)
+		state.SendAlert(action.CreateAlert(action.AlertTypeWarning, message, action.DefaultAlertExpiration))
+	}
+
+	return nil
+}
+
+// SetTimePreferences changes how times are shown. A blank time zone in the payload
+// means the browser's time zone is used.
+func (p *PreferencesManager) SetTimePreferences(state octant.State, payload action.Payload) error {
+	timePreferences := p.preferences.Get().Time
+	if format, err := payload.OptionalString("format"); err == nil && format != "" {
+		timePreferences.Format = format
+	}
+	if _, ok := payload["timeZone"]; ok {
+		timePreferences.TimeZone, _ = payload.OptionalString("timeZone")
+	}
+
+	if err := p.preferences.SetTime(timePreferences); err != nil {
+		message := fmt.Sprintf("Unable to save preferences: %v", err)
+		state.SendAlert(action.CreateAlert(action.AlertTypeWarning, message, action.DefaultAlertExpiration))
+	}
+
+	return nil
+}
+
+// CreatePreferencesEvent creates a preferences event.
+func CreatePreferencesEvent(current preferences.Preferences) event.Event {
+	return event.Event{
+		Type: event.EventTypePreferences,
+		Data: current,
+	}
+}
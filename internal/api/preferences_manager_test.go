@@ -0,0 +1,177 @@
+/*
+ * Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package api_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/octant/internal/api"
+	"github.com/vmware-tanzu/octant/internal/api/fake"
+	"github.com/vmware-tanzu/octant/internal/octant"
+	octantFake "github.com/vmware-tanzu/octant/internal/octant/fake"
+	"github.com/vmware-tanzu/octant/internal/preferences"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/event"
+)
+
+func TestPreferencesManager_Handlers(t *testing.T) {
+	manager := api.NewPreferencesManager(preferences.NewService(nil))
+	AssertHandlers(t, manager, []string{
+		api.RequestFavoriteNamespace,
+		api.RequestUnfavoriteNamespace,
+		api.RequestAddBookmark,
+		api.RequestRemoveBookmark,
+		api.RequestSetUIPreferences,
+		api.RequestSetTimePreferences,
+	})
+}
+
+func TestPreferencesManager_Start(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	manager := preferences.NewService(nil)
+
+	var namespaceUpdate octant.NamespaceUpdateFunc
+
+	state := octantFake.NewMockState(controller)
+	state.EXPECT().
+		OnNamespaceUpdate(gomock.Any()).
+		DoAndReturn(func(fn octant.NamespaceUpdateFunc) octant.UpdateCancelFunc {
+			namespaceUpdate = fn
+			return func() {}
+		})
+
+	var sent []preferences.Preferences
+
+	octantClient := fake.NewMockOctantClient(controller)
+	octantClient.EXPECT().StopCh().Return(nil).AnyTimes()
+	octantClient.EXPECT().
+		Send(gomock.Any()).
+		Do(func(e event.Event) {
+			assert.Equal(t, event.EventTypePreferences, e.Type)
+			sent = append(sent, e.Data.(preferences.Preferences))
+			if len(sent) == 1 {
+				namespaceUpdate("payments")
+				return
+			}
+			cancel()
+		}).
+		Times(2)
+
+	api.NewPreferencesManager(manager).Start(ctx, state, octantClient)
+
+	require.Len(t, sent, 2)
+	assert.Empty(t, sent[0].Namespaces.Recent)
+	assert.Equal(t, []string{"payments"}, sent[1].Namespaces.Recent)
+}
+
+func TestPreferencesManager_FavoriteNamespace(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	manager := preferences.NewService(nil)
+
+	state := octantFake.NewMockState(controller)
+	state.EXPECT().SendAlert(gomock.Any()).Times(2)
+
+	pm := api.NewPreferencesManager(manager)
+
+	payload := action.Payload{"namespace": "payments"}
+	require.NoError(t, pm.FavoriteNamespace(state, payload))
+	assert.Equal(t, []string{"payments"}, manager.Get().Namespaces.Favorites)
+
+	require.NoError(t, pm.UnfavoriteNamespace(state, payload))
+	assert.Empty(t, manager.Get().Namespaces.Favorites)
+
+	require.Error(t, pm.FavoriteNamespace(state, action.Payload{}))
+}
+
+func TestPreferencesManager_AddBookmark(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	manager := preferences.NewService(nil)
+
+	state := octantFake.NewMockState(controller)
+	state.EXPECT().SendAlert(gomock.Any()).Times(2)
+
+	pm := api.NewPreferencesManager(manager)
+
+	payload := action.Payload{"apiVersion": "apps/v1", "kind": "Deployment", "namespace": "default", "name": "nginx"}
+	require.NoError(t, pm.AddBookmark(state, payload))
+
+	expected := []preferences.Bookmark{{APIVersion: "apps/v1", Kind: "Deployment", Namespace: "default", Name: "nginx"}}
+	assert.Equal(t, expected, manager.Get().Bookmarks)
+
+	require.NoError(t, pm.RemoveBookmark(state, payload))
+	assert.Empty(t, manager.Get().Bookmarks)
+
+	require.Error(t, pm.AddBookmark(state, action.Payload{"kind": "Deployment", "name": "nginx"}))
+}
+
+func TestPreferencesManager_SetUIPreferences(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	manager := preferences.NewService(nil)
+
+	state := octantFake.NewMockState(controller)
+	state.EXPECT().SendAlert(gomock.Any())
+
+	pm := api.NewPreferencesManager(manager)
+
+	require.NoError(t, pm.SetUIPreferences(state, action.Payload{"theme": "dark"}))
+	require.NoError(t, pm.SetUIPreferences(state, action.Payload{"landingPage": "workloads"}))
+	require.NoError(t, pm.SetUIPreferences(state, action.Payload{"listLimit": float64(100)}))
+
+	expected := preferences.UIPreferences{
+		Theme:        preferences.ThemeDark,
+		TableDensity: preferences.TableDensityComfortable,
+		LandingPage:  "workloads",
+		ListLimit:    100,
+	}
+	assert.Equal(t, expected, manager.Get().UI)
+
+	// Invalid settings are reported to the user.
+	require.NoError(t, pm.SetUIPreferences(state, action.Payload{"theme": "sepia"}))
+	assert.Equal(t, expected, manager.Get().UI)
+
+	require.Error(t, pm.SetUIPreferences(state, action.Payload{"listLimit": "all"}))
+}
+
+func TestPreferencesManager_SetTimePreferences(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	manager := preferences.NewService(nil)
+
+	state := octantFake.NewMockState(controller)
+	state.EXPECT().SendAlert(gomock.Any())
+
+	pm := api.NewPreferencesManager(manager)
+
+	payload := action.Payload{"format": "absolute", "timeZone": "Asia/Tokyo"}
+	require.NoError(t, pm.SetTimePreferences(state, payload))
+
+	expected := preferences.TimePreferences{Format: preferences.TimeFormatAbsolute, TimeZone: "Asia/Tokyo"}
+	assert.Equal(t, expected, manager.Get().Time)
+
+	// An unknown time zone is reported to the user.
+	require.NoError(t, pm.SetTimePreferences(state, action.Payload{"timeZone": "Mars/Olympus_Mons"}))
+	assert.Equal(t, expected, manager.Get().Time)
+
+	require.NoError(t, pm.SetTimePreferences(state, action.Payload{"timeZone": ""}))
+	assert.Equal(t, preferences.TimePreferences{Format: preferences.TimeFormatAbsolute}, manager.Get().Time)
+}
@@ -0,0 +1,111 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	configFake "github.com/vmware-tanzu/octant/internal/config/fake"
+	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/internal/preferences"
+)
+
+func Test_preferencesHandler(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	manager := preferences.NewService(nil)
+	require.NoError(t, manager.FavoriteNamespace("payments"))
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().Preferences().Return(manager)
+
+	handler := preferencesHandler(dashConfig, log.NopLogger())
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/preferences", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var got preferences.Preferences
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&got))
+	assert.Equal(t, []string{"payments"}, got.Namespaces.Favorites)
+	assert.Equal(t, []string{}, got.Namespaces.Recent)
+
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/preferences", nil))
+	require.Equal(t, http.StatusMethodNotAllowed, w.Code)
+}
+
+func Test_uiPreferencesHandler(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	manager := preferences.NewService(nil)
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().Preferences().Return(manager).AnyTimes()
+
+	handler := uiPreferencesHandler(dashConfig, log.NopLogger())
+
+	body := `{"theme":"dark","tableDensity":"compact"}`
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodPut, "/preferences/ui", strings.NewReader(body)))
+	require.Equal(t, http.StatusOK, w.Code)
+
+	expected := preferences.UIPreferences{
+		Theme:        preferences.ThemeDark,
+		TableDensity: preferences.TableDensityCompact,
+		LandingPage:  preferences.DefaultLandingPage,
+		ListLimit:    preferences.DefaultListLimit,
+	}
+
+	var got preferences.UIPreferences
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&got))
+	assert.Equal(t, expected, got)
+
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/preferences/ui", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&got))
+	assert.Equal(t, expected, got)
+
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodPut, "/preferences/ui", strings.NewReader(`{"theme":"sepia"}`)))
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func Test_timePreferencesHandler(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	manager := preferences.NewService(nil)
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().Preferences().Return(manager).AnyTimes()
+
+	handler := timePreferencesHandler(dashConfig, log.NopLogger())
+
+	body := `{"format":"absolute","timeZone":"UTC"}`
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodPut, "/preferences/time", strings.NewReader(body)))
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var got preferences.TimePreferences
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&got))
+	assert.Equal(t, preferences.TimePreferences{Format: preferences.TimeFormatAbsolute, TimeZone: "UTC"}, got)
+
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodPut, "/preferences/time", strings.NewReader(`{"format":"fuzzy"}`)))
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
@@ -0,0 +1,259 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package api
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/vmware-tanzu/octant/internal/contentcache"
+	"github.com/vmware-tanzu/octant/internal/module"
+	"github.com/vmware-tanzu/octant/pkg/log"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+const (
+	// DefaultPrefetchPaths is the number of rows in a list whose detail content is prefetched.
+	DefaultPrefetchPaths = 5
+
+	// DefaultPrefetchConcurrency is the number of detail pages which are generated at once.
+	DefaultPrefetchConcurrency = 2
+)
+
+// PrefetcherOption is an option for configuring Prefetcher.
+type PrefetcherOption func(p *Prefetcher)
+
+// WithPrefetchPaths sets the number of rows in a list whose detail content is prefetched.
+func WithPrefetchPaths(n int) PrefetcherOption {
+	return func(p *Prefetcher) {
+		if n > 0 {
+			p.maxPaths = n
+		}
+	}
+}
+
+// WithPrefetchConcurrency sets the number of detail pages which are generated at once.
+func WithPrefetchConcurrency(n int) PrefetcherOption {
+	return func(p *Prefetcher) {
+		if n > 0 {
+			p.concurrency = n
+		}
+	}
+}
+
+// Prefetcher warms a content cache with the detail pages of the first rows of the lists
+// a client is viewing, so following a link to one of them doesn't wait for generation.
+type Prefetcher struct {
+	moduleManager module.ManagerInterface
+	cache         *contentcache.Cache
+	logger        log.Logger
+	maxPaths      int
+	concurrency   int
+
+	mu     sync.Mutex
+	cancel context.CancelFunc
+	done   chan struct{}
+	// keys are the cache keys of the last prefetch. Lists are regenerated whenever they
+	// expire, so they are only prefetched again when their rows change.
+	keys []string
+}
+
+// NewPrefetcher creates an instance of Prefetcher. Prefetched content is stored in cache.
+func NewPrefetcher(moduleManager module.ManagerInterface, cache *contentcache.Cache, logger log.Logger, options ...PrefetcherOption) *Prefetcher {
+	p := &Prefetcher{
+		moduleManager: moduleManager,
+		cache:         cache,
+		logger:        logger,
+		maxPaths:      DefaultPrefetchPaths,
+		concurrency:   DefaultPrefetchConcurrency,
+	}
+
+	for _, option := range options {
+		option(p)
+	}
+
+	return p
+}
+
+// Prefetch generates the detail content linked from the lists in contentResponse in the
+// background. A prefetch which is still running is cancelled. Content is generated with
+// options, except every tab is generated. Nothing is generated if the linked content is
+// the same as the last prefetch's or is already cached.
+func (p *Prefetcher) Prefetch(ctx context.Context, contentResponse component.ContentResponse, options module.ContentOptions) {
+	if p == nil {
+		return
+	}
+
+	options.Tab = ""
+
+	paths := prefetchPaths(contentResponse, p.maxPaths)
+	keys := make([]string, 0, len(paths))
+	for _, contentPath := range paths {
+		keys = append(keys, contentCacheKey(ctx, contentPath, options))
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if equalStrings(keys, p.keys) {
+		return
+	}
+	p.keys = keys
+
+	if p.cancel != nil {
+		p.cancel()
+	}
+
+	if len(paths) == 0 {
+		p.cancel = nil
+		return
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	p.cancel = cancel
+	p.done = done
+
+	go func() {
+		defer close(done)
+		defer cancel()
+		p.run(ctx, paths, options)
+	}()
+}
+
+// Wait waits for the running prefetch to finish.
+func (p *Prefetcher) Wait() {
+	if p == nil {
+		return
+	}
+
+	p.mu.Lock()
+	done := p.done
+	p.mu.Unlock()
+
+	if done != nil {
+		<-done
+	}
+}
+
+func (p *Prefetcher) run(ctx context.Context, paths []string, options module.ContentOptions) {
+	sem := make(chan struct{}, p.concurrency)
+	var wg sync.WaitGroup
+
+	for _, contentPath := range paths {
+		select {
+		case <-ctx.Done():
+			wg.Wait()
+			return
+		case sem <- struct{}{}:
+		}
+
+		wg.Add(1)
+		go func(contentPath string) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			p.prefetch(ctx, contentPath, options)
+		}(contentPath)
+	}
+
+	wg.Wait()
+}
+
+func (p *Prefetcher) prefetch(ctx context.Context, contentPath string, options module.ContentOptions) {
+	if ctx.Err() != nil {
+		return
+	}
+
+	key := contentCacheKey(ctx, contentPath, options)
+	if _, ok := p.cache.Get(ctx, key); ok {
+		return
+	}
+
+	m, ok := p.moduleManager.ModuleForContentPath(contentPath)
+	if !ok {
+		return
+	}
+
+	dependencies := contentcache.NewDependencies()
+	contentResponse, err := m.Content(contentcache.WithDependencies(ctx, dependencies), strings.TrimPrefix(contentPath, m.Name()), options)
+	if err != nil {
+		if ctx.Err() == nil {
+			p.logger.WithErr(err).With("content-path", contentPath).Debugf("prefetch content")
+		}
+		return
+	}
+
+	if ctx.Err() != nil {
+		return
+	}
+
+	p.cache.Set(key, contentResponse, dependencies)
+}
+
+// prefetchPaths returns the content paths linked from the name column of the first
+// rows of the tables in the lists of a content response.
+func prefetchPaths(contentResponse component.ContentResponse, max int) []string {
+	var paths []string
+	seen := map[string]bool{}
+
+	for _, c := range contentResponse.Components {
+		list, ok := c.(*component.List)
+		if !ok {
+			continue
+		}
+
+		for _, item := range list.Config.Items {
+			table, ok := item.(*component.Table)
+			if !ok {
+				continue
+			}
+
+			for _, row := range table.Rows() {
+				if len(paths) >= max {
+					return paths
+				}
+
+				link, ok := row["Name"].(*component.Link)
+				if !ok {
+					continue
+				}
+
+				contentPath, ok := contentPathFromRef(link.Ref())
+				if !ok || seen[contentPath] {
+					continue
+				}
+				seen[contentPath] = true
+				paths = append(paths, contentPath)
+			}
+		}
+	}
+
+	return paths
+}
+
+// contentPathFromRef returns the content path an internal link refers to.
+func contentPathFromRef(ref string) (string, bool) {
+	if !strings.HasPrefix(ref, "/") || strings.HasPrefix(ref, "//") || strings.ContainsAny(ref, "?#") {
+		return "", false
+	}
+
+	return strings.TrimPrefix(ref, "/"), true
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,191 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package api
+
+import (
+	"context"
+	"path"
+	"sync"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/vmware-tanzu/octant/internal/contentcache"
+	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/internal/module"
+	moduleFake "github.com/vmware-tanzu/octant/internal/module/fake"
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	storeFake "github.com/vmware-tanzu/octant/pkg/store/fake"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func podListResponse(names ...string) component.ContentResponse {
+	table := component.NewTable("Pods", "placeholder", component.NewTableCols("Name", "Age"))
+	for _, name := range names {
+		table.Add(component.TableRow{
+			"Name": component.NewLink("", name, "/overview/namespace/default/workloads/pods/"+name),
+		})
+	}
+
+	list := component.NewList(component.TitleFromString("Pods"), []component.Component{table})
+	return component.ContentResponse{Components: []component.Component{list}}
+}
+
+func Test_prefetchPaths(t *testing.T) {
+	contentResponse := podListResponse("a", "b", "a", "c")
+
+	table := component.NewTable("Links", "placeholder", component.NewTableCols("Name"))
+	table.Add(
+		component.TableRow{"Name": component.NewLink("", "external", "https://example.com")},
+		component.TableRow{"Name": component.NewLink("", "query", "/overview/namespace/default?view=yaml")},
+		component.TableRow{"Name": component.NewText("text")},
+	)
+	contentResponse.Add(component.NewList(component.TitleFromString("Links"), []component.Component{table}))
+
+	expected := []string{
+		"overview/namespace/default/workloads/pods/a",
+		"overview/namespace/default/workloads/pods/b",
+		"overview/namespace/default/workloads/pods/c",
+	}
+	assert.Equal(t, expected, prefetchPaths(contentResponse, 5))
+	assert.Equal(t, expected[:2], prefetchPaths(contentResponse, 2))
+}
+
+func TestPrefetcher_Prefetch(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	pod := testutil.ToUnstructured(t, testutil.CreatePod("a"))
+	pod.SetResourceVersion("1")
+	podKey, err := store.KeyFromObject(pod)
+	require.NoError(t, err)
+
+	objectStore := storeFake.NewMockStore(controller)
+	objectStore.EXPECT().
+		Get(gomock.Any(), podKey).
+		DoAndReturn(func(context.Context, store.Key) (*unstructured.Unstructured, error) {
+			return pod, nil
+		}).AnyTimes()
+
+	watch := storeFake.NewMockWatchHandle(controller)
+	watch.EXPECT().Done().Return(make(chan struct{})).AnyTimes()
+	objectStore.EXPECT().
+		Watch(gomock.Any(), store.Key{Namespace: podKey.Namespace, APIVersion: "v1", Kind: "Pod"}, gomock.Any()).
+		Return(watch, nil)
+
+	detail := component.ContentResponse{Title: component.TitleFromString("a")}
+
+	m := moduleFake.NewMockModule(controller)
+	m.EXPECT().Name().Return("overview").AnyTimes()
+	m.EXPECT().
+		Content(gomock.Any(), "/namespace/default/workloads/pods/a", module.ContentOptions{Limit: 10}).
+		DoAndReturn(func(ctx context.Context, contentPath string, options module.ContentOptions) (component.ContentResponse, error) {
+			contentcache.RecordGet(ctx, podKey, pod, nil)
+			return detail, nil
+		})
+
+	moduleManager := moduleFake.NewMockManagerInterface(controller)
+	moduleManager.EXPECT().
+		ModuleForContentPath("overview/namespace/default/workloads/pods/a").
+		Return(m, true).AnyTimes()
+
+	cache := contentcache.New(objectStore)
+	prefetcher := NewPrefetcher(moduleManager, cache, log.NopLogger())
+
+	ctx := context.Background()
+	options := module.ContentOptions{Tab: "yaml", Limit: 10}
+
+	prefetcher.Prefetch(ctx, podListResponse("a"), options)
+	prefetcher.Wait()
+
+	got, ok := cache.Get(ctx, contentCacheKey(ctx, "overview/namespace/default/workloads/pods/a", module.ContentOptions{Limit: 10}))
+	require.True(t, ok)
+	assert.Equal(t, detail, got)
+
+	// Cached content isn't generated again.
+	prefetcher.Prefetch(ctx, podListResponse("a"), options)
+	prefetcher.Wait()
+}
+
+func TestPrefetcher_Prefetch_cancelled(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	moduleManager := moduleFake.NewMockManagerInterface(controller)
+
+	prefetcher := NewPrefetcher(moduleManager, nil, log.NopLogger())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	prefetcher.Prefetch(ctx, podListResponse("a", "b", "c"), module.ContentOptions{})
+	prefetcher.Wait()
+}
+
+func TestPrefetcher_Prefetch_steadyState(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	objectStore := storeFake.NewMockStore(controller)
+	objectStore.EXPECT().
+		Get(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, key store.Key) (*unstructured.Unstructured, error) {
+			pod := testutil.ToUnstructured(t, testutil.CreatePod(key.Name))
+			pod.SetResourceVersion("1")
+			return pod, nil
+		}).AnyTimes()
+
+	watch := storeFake.NewMockWatchHandle(controller)
+	watch.EXPECT().Done().Return(make(chan struct{})).AnyTimes()
+	objectStore.EXPECT().Watch(gomock.Any(), gomock.Any(), gomock.Any()).Return(watch, nil).AnyTimes()
+
+	var mu sync.Mutex
+	generated := map[string]int{}
+
+	m := moduleFake.NewMockModule(controller)
+	m.EXPECT().Name().Return("overview").AnyTimes()
+	m.EXPECT().
+		Content(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, contentPath string, options module.ContentOptions) (component.ContentResponse, error) {
+			name := path.Base(contentPath)
+			pod := testutil.ToUnstructured(t, testutil.CreatePod(name))
+			pod.SetResourceVersion("1")
+			key, err := store.KeyFromObject(pod)
+			require.NoError(t, err)
+			contentcache.RecordGet(ctx, key, pod, nil)
+
+			mu.Lock()
+			generated[name]++
+			mu.Unlock()
+
+			return component.ContentResponse{Title: component.TitleFromString(name)}, nil
+		}).AnyTimes()
+
+	moduleManager := moduleFake.NewMockManagerInterface(controller)
+	moduleManager.EXPECT().ModuleForContentPath(gomock.Any()).Return(m, true).AnyTimes()
+
+	cache := contentcache.New(objectStore)
+	prefetcher := NewPrefetcher(moduleManager, cache, log.NopLogger())
+
+	ctx := context.Background()
+	options := module.ContentOptions{Limit: 10}
+
+	// A list which is regenerated without changing doesn't generate its rows again.
+	for i := 0; i < 10; i++ {
+		prefetcher.Prefetch(ctx, podListResponse("a", "b"), options)
+		prefetcher.Wait()
+	}
+	assert.Equal(t, map[string]int{"a": 1, "b": 1}, generated)
+
+	// Only rows which aren't cached are generated when the rows change.
+	prefetcher.Prefetch(ctx, podListResponse("b", "a", "c"), options)
+	prefetcher.Wait()
+	assert.Equal(t, map[string]int{"a": 1, "b": 1, "c": 1}, generated)
+}
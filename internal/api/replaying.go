@@ -0,0 +1,28 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vmware-tanzu/octant/internal/replay"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+// replayAlert returns an alert saying content shows a recording if ctx is replaying
+// one. It returns nil if ctx is live.
+func replayAlert(ctx context.Context) *component.Alert {
+	at, ok := replay.TimeFrom(ctx)
+	if !ok {
+		return nil
+	}
+
+	alert := component.NewAlert(component.AlertTypeInfo, fmt.Sprintf(
+		"Replaying the recording at %s. Recorded kinds are shown as they were then. Return to live from Configuration > Recording.",
+		at.Format("15:04:05")))
+	return &alert
+}
@@ -0,0 +1,44 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/octant/internal/module"
+	"github.com/vmware-tanzu/octant/internal/replay"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func Test_replayAlert(t *testing.T) {
+	at := time.Date(2020, 10, 1, 12, 30, 0, 0, time.Local)
+	ctx := replay.WithPosition(context.Background(), replay.NewPosition(at))
+
+	got := replayAlert(ctx)
+	require.NotNil(t, got)
+	assert.Equal(t, component.AlertTypeInfo, got.Type)
+	assert.Equal(t, "Replaying the recording at 12:30:00. Recorded kinds are shown as they were then. Return to live from Configuration > Recording.", got.Message)
+
+	live := replay.WithPosition(context.Background(), replay.NewPosition(time.Time{}))
+	assert.Nil(t, replayAlert(live))
+
+	assert.Nil(t, replayAlert(context.Background()))
+}
+
+func Test_contentCacheKey_replaying(t *testing.T) {
+	at := time.Date(2020, 10, 1, 12, 30, 0, 0, time.UTC)
+	ctx := replay.WithPosition(context.Background(), replay.NewPosition(at))
+
+	live := contentCacheKey(context.Background(), "overview/namespace/default", module.ContentOptions{Limit: 10})
+	replayed := contentCacheKey(ctx, "overview/namespace/default", module.ContentOptions{Limit: 10})
+	assert.NotEqual(t, live, replayed)
+	assert.Equal(t, "overview/namespace/default#@10~2020-10-01T12:30:00Z", replayed)
+}
@@ -0,0 +1,59 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package api
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/vmware-tanzu/octant/internal/config"
+	"github.com/vmware-tanzu/octant/internal/search"
+	"github.com/vmware-tanzu/octant/pkg/log"
+)
+
+// SearchResponse lists objects which matched a search.
+type SearchResponse struct {
+	Results []search.Result `json:"results"`
+}
+
+// searchHandler searches cached objects. The q parameter matches names, labels is a
+// label selector, and namespace and limit narrow the results.
+func searchHandler(dashConfig config.Dash, logger log.Logger) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			RespondWithError(w, http.StatusMethodNotAllowed, "method not allowed", logger)
+			return
+		}
+
+		params := r.URL.Query()
+		query, err := search.NewQuery(params.Get("q"), params.Get("labels"), params.Get("namespace"))
+		if err != nil {
+			RespondWithError(w, http.StatusBadRequest, err.Error(), logger)
+			return
+		}
+
+		if limit := params.Get("limit"); limit != "" {
+			n, err := strconv.Atoi(limit)
+			if err != nil || n < 1 {
+				RespondWithError(w, http.StatusBadRequest, "limit must be a positive number", logger)
+				return
+			}
+			query.Limit = n
+		}
+
+		searcher := search.NewSearcher(dashConfig.ObjectStore(), dashConfig)
+		results, err := searcher.Search(r.Context(), query)
+		if err != nil {
+			RespondWithError(w, http.StatusInternalServerError, err.Error(), logger)
+			return
+		}
+
+		resp := SearchResponse{Results: []search.Result{}}
+		resp.Results = append(resp.Results, results...)
+
+		respondWithJSON(w, http.StatusOK, resp, logger)
+	})
+}
@@ -0,0 +1,77 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	configFake "github.com/vmware-tanzu/octant/internal/config/fake"
+	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	storeFake "github.com/vmware-tanzu/octant/pkg/store/fake"
+)
+
+type searchableStore struct {
+	*storeFake.MockStore
+	keys []store.Key
+}
+
+func (s *searchableStore) CachedKeys() []store.Key {
+	return s.keys
+}
+
+func Test_searchHandler(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	key := store.Key{APIVersion: "apps/v1", Kind: "Deployment", Namespace: "default"}
+
+	deployment := testutil.CreateDeployment("nginx")
+	deployment.Namespace = "default"
+	list := &unstructured.UnstructuredList{Items: []unstructured.Unstructured{*testutil.ToUnstructured(t, deployment)}}
+
+	objectStore := storeFake.NewMockStore(controller)
+	objectStore.EXPECT().List(gomock.Any(), key).Return(list, false, nil)
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().ObjectStore().Return(&searchableStore{MockStore: objectStore, keys: []store.Key{key}})
+	dashConfig.EXPECT().
+		ObjectPath("default", "apps/v1", "Deployment", "nginx").
+		Return("/overview/namespace/default/workloads/deployments/nginx", nil)
+
+	w := httptest.NewRecorder()
+	searchHandler(dashConfig, log.NopLogger()).ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/search?q=ngi", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var got SearchResponse
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&got))
+	require.Len(t, got.Results, 1)
+	assert.Equal(t, "nginx", got.Results[0].Name)
+	assert.Equal(t, "/overview/namespace/default/workloads/deployments/nginx", got.Results[0].Ref)
+}
+
+func Test_searchHandler_invalid(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	dashConfig := configFake.NewMockDash(controller)
+	handler := searchHandler(dashConfig, log.NopLogger())
+
+	for _, target := range []string{"/search", "/search?labels=app+in+(", "/search?q=nginx&limit=0"} {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, target, nil))
+		assert.Equal(t, http.StatusBadRequest, w.Code, target)
+	}
+}
@@ -0,0 +1,56 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package api
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/vmware-tanzu/octant/internal/objectstore"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+// StaleInformerChecker reports informers whose objects may be out of date.
+type StaleInformerChecker interface {
+	StaleInformer(key store.Key) (objectstore.InformerStatus, bool)
+}
+
+// staleAlert returns an alert listing the kinds of the keys content was generated from
+// whose objects may be out of date. It returns nil if the objects are current or the
+// object store can't tell.
+func staleAlert(objectStore store.Store, keys []store.Key) *component.Alert {
+	checker, ok := objectStore.(StaleInformerChecker)
+	if !ok {
+		return nil
+	}
+
+	seen := map[string]bool{}
+	var kinds []string
+	for _, key := range keys {
+		status, stale := checker.StaleInformer(key)
+		if !stale || seen[key.Kind] {
+			continue
+		}
+		seen[key.Kind] = true
+
+		since := status.LastEvent
+		if status.WatchError != nil {
+			since = status.WatchErrorTime
+		}
+		kinds = append(kinds, fmt.Sprintf("%s (since %s)", key.Kind, since.Format("15:04:05")))
+	}
+
+	if len(kinds) == 0 {
+		return nil
+	}
+
+	sort.Strings(kinds)
+	alert := component.NewAlert(component.AlertTypeWarning, fmt.Sprintf(
+		"This content may be out of date. Updates haven't been received for %s.", strings.Join(kinds, ", ")))
+	return &alert
+}
@@ -0,0 +1,57 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package api
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/octant/internal/objectstore"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	storeFake "github.com/vmware-tanzu/octant/pkg/store/fake"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+type staleStore struct {
+	*storeFake.MockStore
+	stale map[string]objectstore.InformerStatus
+}
+
+func (s *staleStore) StaleInformer(key store.Key) (objectstore.InformerStatus, bool) {
+	status, ok := s.stale[key.Kind]
+	return status, ok
+}
+
+func Test_staleAlert(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	since := time.Date(2020, 10, 1, 12, 30, 0, 0, time.Local)
+	objectStore := &staleStore{
+		MockStore: storeFake.NewMockStore(controller),
+		stale: map[string]objectstore.InformerStatus{
+			"Pod": {WatchError: fmt.Errorf("connection refused"), WatchErrorTime: since},
+		},
+	}
+
+	pods := store.Key{APIVersion: "v1", Kind: "Pod", Namespace: "default"}
+	deployment := store.Key{APIVersion: "apps/v1", Kind: "Deployment", Namespace: "default", Name: "nginx"}
+
+	assert.Nil(t, staleAlert(objectStore, []store.Key{deployment}))
+
+	got := staleAlert(objectStore, []store.Key{deployment, pods, pods})
+	require.NotNil(t, got)
+	assert.Equal(t, component.AlertTypeWarning, got.Type)
+	assert.Equal(t, "This content may be out of date. Updates haven't been received for Pod (since 12:30:00).", got.Message)
+
+	// Object stores which can't tell if objects are stale are assumed to be current.
+	assert.Nil(t, staleAlert(storeFake.NewMockStore(controller), []store.Key{pods}))
+}
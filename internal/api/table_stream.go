@@ -0,0 +1,94 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package api
+
+import (
+	"context"
+	"fmt"
+
+	oevent "github.com/vmware-tanzu/octant/pkg/event"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+// DefaultTableStreamChunkSize is the number of table rows sent in a content event or
+// table rows event.
+const DefaultTableStreamChunkSize = 100
+
+// tableStream is the rows of a table which weren't sent in its content event.
+type tableStream struct {
+	id   string
+	rows []component.TableRow
+}
+
+// streamTables returns contentResponse with the tables holding more than chunkSize rows
+// replaced by tables holding their first chunkSize rows, and the remaining rows of those
+// tables. Stream IDs are prefixed with prefix. contentResponse is not modified.
+func streamTables(contentResponse component.ContentResponse, prefix string, chunkSize int) (component.ContentResponse, []tableStream) {
+	if chunkSize <= 0 {
+		return contentResponse, nil
+	}
+
+	var streams []tableStream
+	head := func(c component.Component) component.Component {
+		table, ok := c.(*component.Table)
+		if !ok || len(table.Rows()) <= chunkSize {
+			return c
+		}
+
+		id := fmt.Sprintf("%s-%d", prefix, len(streams))
+		streams = append(streams, tableStream{id: id, rows: table.Rows()[chunkSize:]})
+
+		config := table.Config
+		config.Rows = table.Rows()[:chunkSize]
+		config.StreamID = id
+		return &component.Table{Base: table.Base, Config: config}
+	}
+
+	components := make([]component.Component, len(contentResponse.Components))
+	for i := range contentResponse.Components {
+		components[i] = component.Rewrite(contentResponse.Components[i], head)
+	}
+
+	if len(streams) == 0 {
+		return contentResponse, nil
+	}
+
+	contentResponse.Components = components
+	return contentResponse, streams
+}
+
+// sendTableStreams sends the rows of streams in table rows events of at most chunkSize
+// rows. It stops if ctx is cancelled or isCurrent returns false.
+func sendTableStreams(ctx context.Context, s OctantClient, contentPath string, streams []tableStream, chunkSize int, isCurrent func() bool) {
+	for _, stream := range streams {
+		for start := 0; start < len(stream.rows); start += chunkSize {
+			if ctx.Err() != nil || !isCurrent() {
+				return
+			}
+
+			end := start + chunkSize
+			if end > len(stream.rows) {
+				end = len(stream.rows)
+			}
+
+			s.Send(CreateTableRowsEvent(contentPath, stream.id, stream.rows[start:end], end == len(stream.rows)))
+		}
+	}
+}
+
+// CreateTableRowsEvent creates a table rows event. done is true if rows are the last
+// rows of the stream.
+func CreateTableRowsEvent(contentPath, streamID string, rows []component.TableRow, done bool) oevent.Event {
+	return oevent.Event{
+		Type: oevent.EventTypeTableRows,
+		Data: map[string]interface{}{
+			"contentPath": contentPath,
+			"streamID":    streamID,
+			"rows":        rows,
+			"done":        done,
+		},
+	}
+}
@@ -0,0 +1,140 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package api
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	oevent "github.com/vmware-tanzu/octant/pkg/event"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+type recordingClient struct {
+	events []oevent.Event
+}
+
+var _ OctantClient = (*recordingClient)(nil)
+
+func (c *recordingClient) Send(ev oevent.Event) {
+	c.events = append(c.events, ev)
+}
+
+func (c *recordingClient) ID() string {
+	return "id"
+}
+
+func (c *recordingClient) StopCh() <-chan struct{} {
+	return nil
+}
+
+func tableWithRows(n int) *component.Table {
+	table := component.NewTable("Pods", "placeholder", component.NewTableCols("Name"))
+	for i := 0; i < n; i++ {
+		table.Add(component.TableRow{"Name": component.NewText(fmt.Sprintf("pod-%d", i))})
+	}
+	return table
+}
+
+func Test_streamTables(t *testing.T) {
+	large := tableWithRows(5)
+	small := tableWithRows(2)
+
+	layout := component.NewFlexLayout("layout")
+	layout.AddSections(component.FlexLayoutSection{
+		{Width: component.WidthFull, View: large},
+	})
+
+	contentResponse := component.ContentResponse{
+		Components: []component.Component{layout, small},
+	}
+
+	got, streams := streamTables(contentResponse, "1", 2)
+	require.Len(t, streams, 1)
+	assert.Equal(t, "1-0", streams[0].id)
+	assert.Equal(t, large.Rows()[2:], streams[0].rows)
+
+	var tables []*component.Table
+	component.Walk(got.Components[0], func(c component.Component) {
+		if table, ok := c.(*component.Table); ok {
+			tables = append(tables, table)
+		}
+	})
+	require.Len(t, tables, 1)
+	assert.Equal(t, large.Rows()[:2], tables[0].Rows())
+	assert.Equal(t, "1-0", tables[0].Config.StreamID)
+	assert.Equal(t, large.Columns(), tables[0].Columns())
+
+	assert.True(t, got.Components[1] == component.Component(small))
+
+	// The content response is not modified.
+	assert.Len(t, large.Rows(), 5)
+	assert.Empty(t, large.Config.StreamID)
+	assert.True(t, contentResponse.Components[0] == component.Component(layout))
+}
+
+func Test_streamTables_small(t *testing.T) {
+	contentResponse := component.ContentResponse{
+		Components: []component.Component{tableWithRows(2)},
+	}
+
+	got, streams := streamTables(contentResponse, "1", 2)
+	assert.Empty(t, streams)
+	assert.Equal(t, contentResponse, got)
+
+	got, streams = streamTables(component.ContentResponse{
+		Components: []component.Component{tableWithRows(5)},
+	}, "1", 0)
+	assert.Empty(t, streams)
+	assert.Len(t, got.Components[0].(*component.Table).Rows(), 5)
+}
+
+func Test_sendTableStreams(t *testing.T) {
+	rows := tableWithRows(5).Rows()
+	streams := []tableStream{{id: "1-0", rows: rows}}
+
+	client := &recordingClient{}
+	sendTableStreams(context.Background(), client, "pods", streams, 2, func() bool { return true })
+
+	expected := []oevent.Event{
+		CreateTableRowsEvent("pods", "1-0", rows[:2], false),
+		CreateTableRowsEvent("pods", "1-0", rows[2:4], false),
+		CreateTableRowsEvent("pods", "1-0", rows[4:], true),
+	}
+	assert.Equal(t, expected, client.events)
+}
+
+func Test_sendTableStreams_notCurrent(t *testing.T) {
+	streams := []tableStream{{id: "1-0", rows: tableWithRows(5).Rows()}}
+
+	client := &recordingClient{}
+	checks := 0
+	sendTableStreams(context.Background(), client, "pods", streams, 2, func() bool {
+		checks++
+		return checks == 1
+	})
+	assert.Len(t, client.events, 1)
+}
+
+func TestCreateTableRowsEvent(t *testing.T) {
+	rows := tableWithRows(1).Rows()
+
+	got := CreateTableRowsEvent("pods", "1-0", rows, true)
+	expected := oevent.Event{
+		Type: oevent.EventTypeTableRows,
+		Data: map[string]interface{}{
+			"contentPath": "pods",
+			"streamID":    "1-0",
+			"rows":        rows,
+			"done":        true,
+		},
+	}
+	assert.Equal(t, expected, got)
+}
@@ -8,7 +8,6 @@ package api
 import (
 	"context"
 	"fmt"
-	"sync"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
@@ -20,6 +19,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/vmware-tanzu/octant/internal/config"
+	"github.com/vmware-tanzu/octant/internal/featuregate"
 	"github.com/vmware-tanzu/octant/internal/gvk"
 	"github.com/vmware-tanzu/octant/internal/log"
 	"github.com/vmware-tanzu/octant/internal/octant"
@@ -35,15 +35,20 @@ const (
 	RequestActiveTerminal  = "action.octant.dev/setActiveTerminal"
 )
 
+// terminalStateManager connects a websocket client to a terminal session. Sessions are
+// owned by the dash config's terminal manager, so they keep running when the client
+// disconnects and the client can reattach to them later.
 type terminalStateManager struct {
-	client   OctantClient
-	config   config.Dash
-	ctx      context.Context
-	instance terminal.Instance
-
-	chanInstance          chan terminal.Instance
-	terminalSubscriptions sync.Map
-	existingInstance      bool
+	client OctantClient
+	config config.Dash
+	ctx    context.Context
+
+	sessionID    string
+	detach       func()
+	cancelEvents context.CancelFunc
+
+	chanInstance     chan terminal.Instance
+	existingInstance bool
 }
 
 type terminalOutput struct {
@@ -57,9 +62,8 @@ var _ StateManager = (*terminalStateManager)(nil)
 // NewTerminalStateManager returns a terminal state manager.
 func NewTerminalStateManager(dashConfig config.Dash) StateManager {
 	return &terminalStateManager{
-		config:                dashConfig,
-		terminalSubscriptions: sync.Map{},
-		chanInstance:          make(chan terminal.Instance, 10),
+		config:       dashConfig,
+		chanInstance: make(chan terminal.Instance, 10),
 	}
 }
 
@@ -81,7 +85,13 @@ func (s *terminalStateManager) Handlers() []octant.ClientRequestHandler {
 	}
 }
 
+// SetActiveTerminal attaches the client to the terminal session for a container. A
+// session is started if the container does not have one.
 func (s *terminalStateManager) SetActiveTerminal(state octant.State, payload action.Payload) error {
+	if !s.config.FeatureGate().Enabled(featuregate.Exec) {
+		return fmt.Errorf("terminals are disabled: enable the %s feature gate to use them", featuregate.Exec)
+	}
+
 	namespace, err := payload.String("namespace")
 	if err != nil {
 		return fmt.Errorf("getting namespace from payload: %w", err)
@@ -96,90 +106,122 @@ func (s *terminalStateManager) SetActiveTerminal(state octant.State, payload act
 		return fmt.Errorf("getting containerName from payload: %w", err)
 	}
 
-	eventType := event.NewTerminalEventType(namespace, podName, containerName)
 	key := store.KeyFromGroupVersionKind(gvk.Pod)
 	key.Name = podName
 	key.Namespace = namespace
 
-	if s.instance != nil {
-		if s.instance.Key() == key && s.instance.Active() && s.instance.Container() == containerName {
-			s.existingInstance = true
-			s.chanInstance <- s.instance
-			return nil
-		}
-		// Remove old terminal instance
-		prevEventType := event.NewTerminalEventType(s.instance.Key().Namespace, s.instance.Key().Name, s.instance.Container())
-		val, ok := s.terminalSubscriptions.Load(eventType)
-		if ok {
-			cancelFn, ok := val.(context.CancelFunc)
-			if !ok {
-				return fmt.Errorf("bad cancelFn conversion for %s", eventType)
+	terminalManager := s.config.TerminalManager()
+
+	if s.sessionID != "" {
+		info, ok := terminalManager.Get(s.sessionID)
+		if ok && info.Key == key && info.Container == containerName && info.Active {
+			if instance, ok := terminalManager.Instance(s.sessionID); ok {
+				s.sendScrollback(instance)
 			}
-			s.terminalSubscriptions.Delete(prevEventType)
-			cancelFn()
+			return nil
 		}
+
+		// Leave the previous session running so it can be reattached.
+		s.detachSession()
 	}
 
-	val, ok := s.terminalSubscriptions.Load(eventType)
-	if ok {
-		cancelFn, ok := val.(context.CancelFunc)
-		if !ok {
-			return fmt.Errorf("bad cancelFn conversion for %s", eventType)
-		}
-		cancelFn()
+	info, existing, err := terminalManager.Open(key, containerName, func(ctx context.Context, activity chan terminal.Instance) (terminal.Instance, error) {
+		return s.startInstance(ctx, key, containerName, activity)
+	})
+	if err != nil {
+		return err
 	}
 
-	objectStore := s.config.ObjectStore()
-	po := &corev1.Pod{}
-	pod, err := objectStore.Get(s.ctx, key)
+	instance, ok := terminalManager.Instance(info.ID)
+	if !ok {
+		return fmt.Errorf("terminal session %q stopped", info.ID)
+	}
+
+	detach, err := terminalManager.Attach(info.ID, s.chanInstance)
 	if err != nil {
 		return err
 	}
-	if pod != nil {
-		err := kubernetes.FromUnstructured(pod, po)
-		if err != nil {
-			return err
-		}
+
+	ctx, cancelEvents := context.WithCancel(s.ctx)
+	s.sessionID = info.ID
+	s.detach = detach
+	s.cancelEvents = cancelEvents
+
+	go s.sendTerminalEvents(ctx, instance, s.chanInstance)
+
+	if existing {
+		log.From(s.ctx).With("session", info.ID).Debugf("reattaching to terminal session")
+		s.sendScrollback(instance)
 	}
 
-	cancelFn := s.startStream(po, key, containerName)
-	s.terminalSubscriptions.Store(eventType, cancelFn)
 	return nil
 }
 
-func (s *terminalStateManager) startStream(pod *corev1.Pod, key store.Key, container string) context.CancelFunc {
-	ctx, cancelFn := context.WithCancel(s.ctx)
+// startInstance starts a shell in a container. The first shell which can be started is used.
+func (s *terminalStateManager) startInstance(ctx context.Context, key store.Key, container string, activity chan terminal.Instance) (terminal.Instance, error) {
 	logger := log.From(s.ctx).With("startStream", container)
 
-	eventType := event.NewTerminalEventType(pod.Namespace, pod.Name, container)
+	objectStore := s.config.ObjectStore()
+	pod := &corev1.Pod{}
+	object, err := objectStore.Get(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if object != nil {
+		if err := kubernetes.FromUnstructured(object, pod); err != nil {
+			return nil, err
+		}
+	}
 
 	commands := []string{"bash", "sh"}
 	if IsWindowsContainer(pod) {
 		commands = []string{"powershell", "cmd"}
 	}
 
+	var lastErr error
 	for _, command := range commands {
-		validInstance, err := terminal.NewTerminalInstance(ctx, s.config.ClusterClient(), logger, key, container, command, s.chanInstance)
+		instance, err := terminal.NewTerminalInstance(ctx, s.config.ClusterClient(), logger, key, container, command, activity)
 		if err != nil {
 			logger.Debugf("streaming: %+v", err)
+			lastErr = err
 			continue
 		}
 
-		if validInstance != nil {
-			s.instance = validInstance
-			go s.sendTerminalEvents(ctx, eventType, s.instance, s.chanInstance)
-			break
+		if instance != nil {
+			return instance, nil
 		}
 	}
 
-	if s.instance == nil {
-		cancelFn()
+	return nil, fmt.Errorf("start terminal in container %s: %v", container, lastErr)
+}
+
+// sendScrollback sends the session's scrollback the next time its events are sent.
+func (s *terminalStateManager) sendScrollback(instance terminal.Instance) {
+	s.existingInstance = true
+
+	select {
+	case s.chanInstance <- instance:
+	default:
 	}
-	return cancelFn
+}
+
+// detachSession stops sending events for the current session without stopping it.
+func (s *terminalStateManager) detachSession() {
+	if s.cancelEvents != nil {
+		s.cancelEvents()
+		s.cancelEvents = nil
+	}
+
+	if s.detach != nil {
+		s.detach()
+		s.detach = nil
+	}
+
+	s.sessionID = ""
 }
 
 func (s *terminalStateManager) SendTerminalResize(state octant.State, payload action.Payload) error {
-	if s.instance == nil {
+	if s.sessionID == "" {
 		return errors.New("terminal instance not found")
 	}
 
@@ -193,14 +235,12 @@ func (s *terminalStateManager) SendTerminalResize(state octant.State, payload ac
 		return errors.Wrap(err, "extract cols from payload")
 	}
 
-	if s.instance.Active() {
-		s.instance.Resize(cols, rows)
-	}
-	return nil
+	return s.config.TerminalManager().Resize(s.sessionID, cols, rows)
 }
 
 func (s *terminalStateManager) SendTerminalCommand(state octant.State, payload action.Payload) error {
-	if s.instance == nil {
+	instance, ok := s.config.TerminalManager().Instance(s.sessionID)
+	if s.sessionID == "" || !ok {
 		return errors.New("terminal instance not found")
 	}
 
@@ -209,23 +249,35 @@ func (s *terminalStateManager) SendTerminalCommand(state octant.State, payload a
 		return errors.Wrap(err, "extract key from payload")
 	}
 
-	return s.instance.Write([]byte(key))
+	return instance.Write([]byte(key))
 }
 
+// Start starts the manager. The client is detached from its session when ctx is
+// cancelled, but the session keeps running.
 func (s *terminalStateManager) Start(ctx context.Context, state octant.State, client OctantClient) {
 	s.client = client
 	s.ctx = ctx
+
+	go func() {
+		<-ctx.Done()
+		if s.detach != nil {
+			s.detach()
+		}
+	}()
 }
 
-func (s *terminalStateManager) sendTerminalEvents(ctx context.Context, terminalEventType event.EventType, instance terminal.Instance, terminalCh <-chan terminal.Instance) {
-	ctx, cancelFn := context.WithCancel(s.ctx)
+func (s *terminalStateManager) sendTerminalEvents(ctx context.Context, instance terminal.Instance, terminalCh <-chan terminal.Instance) {
 	for {
 		select {
 		case <-ctx.Done():
-			cancelFn()
 			return
 		case t := <-terminalCh:
-			event, err := newEvent(ctx, t, !s.instance.Active() || s.existingInstance)
+			// Ignore activity from a session the client was previously attached to.
+			if t != instance {
+				break
+			}
+
+			event, err := newEvent(ctx, t, !t.Active() || s.existingInstance)
 			if err != nil {
 				break
 			}
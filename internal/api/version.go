@@ -0,0 +1,125 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/vmware-tanzu/octant/internal/config"
+	"github.com/vmware-tanzu/octant/internal/mime"
+	"github.com/vmware-tanzu/octant/pkg/log"
+	"github.com/vmware-tanzu/octant/pkg/plugin"
+)
+
+const (
+	// APIVersion is the current version of Octant's API.
+	APIVersion = "v1"
+	// LegacyPathPrefix is the unversioned path prefix used before the API was versioned.
+	LegacyPathPrefix = "/api"
+	// APIVersionHeader is the response header containing the API version that served a request.
+	APIVersionHeader = "X-Octant-Api-Version"
+	// DeprecationHeader is set on responses served from a deprecated path.
+	DeprecationHeader = "Deprecation"
+)
+
+var (
+	reVersionedPath = regexp.MustCompile(`^/api/(v[0-9]+[a-z0-9]*)(/|$)`)
+)
+
+// SupportedAPIVersions returns the API versions this build of Octant can serve.
+func SupportedAPIVersions() []string {
+	return []string{APIVersion}
+}
+
+// VersionedPath returns the current versioned path for an API sub path.
+func VersionedPath(subPath string) string {
+	if !strings.HasPrefix(subPath, "/") {
+		subPath = "/" + subPath
+	}
+	return PathPrefix + subPath
+}
+
+// VersionResponse describes the running build of Octant.
+type VersionResponse struct {
+	Version          string   `json:"version"`
+	Commit           string   `json:"commit"`
+	BuildTime        string   `json:"buildTime"`
+	PluginAPIVersion uint     `json:"pluginAPIVersion"`
+	APIVersions      []string `json:"apiVersions"`
+}
+
+// NewVersionResponse creates a VersionResponse for a build.
+func NewVersionResponse(version, commit, buildTime string) VersionResponse {
+	return VersionResponse{
+		Version:          version,
+		Commit:           commit,
+		BuildTime:        buildTime,
+		PluginAPIVersion: plugin.Handshake.ProtocolVersion,
+		APIVersions:      SupportedAPIVersions(),
+	}
+}
+
+// versionHandler responds with the build information for the running dashboard.
+func versionHandler(dashConfig config.Dash, logger log.Logger) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			RespondWithError(w, http.StatusMethodNotAllowed, "method not allowed", logger)
+			return
+		}
+
+		resp := NewVersionResponse(dashConfig.BuildInfo())
+
+		w.Header().Set("Content-Type", mime.JSONContentType)
+		if err := json.NewEncoder(w).Encode(resp); err != nil {
+			logger.Errorf("encoding version response: %v", err)
+		}
+	})
+}
+
+// versionHeaderMiddleware adds the API version header to every response.
+func versionHeaderMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(APIVersionHeader, APIVersion)
+		next.ServeHTTP(w, r)
+	})
+}
+
+// CompatHandler serves requests made to unversioned API paths (e.g. /api/stream) by
+// rewriting them to the current API version. Responses are marked as deprecated and
+// link to the successor path so consumers can migrate. Requests for API versions
+// this build does not support receive a not found response.
+func CompatHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestPath := r.URL.Path
+
+		if match := reVersionedPath.FindStringSubmatch(requestPath); match != nil {
+			if match[1] != APIVersion {
+				w.Header().Set(APIVersionHeader, APIVersion)
+				http.Error(w, fmt.Sprintf("unsupported api version %q", match[1]), http.StatusNotFound)
+				return
+			}
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		successor := VersionedPath(strings.TrimPrefix(requestPath, LegacyPathPrefix))
+
+		w.Header().Set(DeprecationHeader, "true")
+		w.Header().Set("Link", fmt.Sprintf("<%s>; rel=\"successor-version\"", successor))
+		w.Header().Set("Warning", fmt.Sprintf("299 - \"%s is deprecated, use %s\"", requestPath, successor))
+
+		r2 := r.Clone(r.Context())
+		r2.URL.Path = successor
+		r2.URL.RawPath = ""
+		r2.RequestURI = r2.URL.RequestURI()
+
+		next.ServeHTTP(w, r2)
+	})
+}
@@ -0,0 +1,122 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	configFake "github.com/vmware-tanzu/octant/internal/config/fake"
+	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/pkg/plugin"
+)
+
+func TestVersionedPath(t *testing.T) {
+	require.Equal(t, "/api/v1/stream", VersionedPath("stream"))
+	require.Equal(t, "/api/v1/stream", VersionedPath("/stream"))
+}
+
+func TestCompatHandler(t *testing.T) {
+	cases := []struct {
+		name           string
+		path           string
+		expectedCode   int
+		expectedPath   string
+		wantDeprecated bool
+	}{
+		{
+			name:         "current version",
+			path:         "/api/v1/stream",
+			expectedCode: http.StatusOK,
+			expectedPath: "/api/v1/stream",
+		},
+		{
+			name:           "unversioned path",
+			path:           "/api/stream",
+			expectedCode:   http.StatusOK,
+			expectedPath:   "/api/v1/stream",
+			wantDeprecated: true,
+		},
+		{
+			name:         "unsupported version",
+			path:         "/api/v9/stream",
+			expectedCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var servedPath string
+			fake := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				servedPath = r.URL.Path
+				fmt.Fprint(w, "response")
+			})
+
+			ts := httptest.NewServer(CompatHandler(fake))
+			defer ts.Close()
+
+			res, err := http.Get(ts.URL + tc.path)
+			require.NoError(t, err)
+			defer res.Body.Close()
+
+			require.Equal(t, tc.expectedCode, res.StatusCode)
+			if tc.expectedPath != "" {
+				require.Equal(t, tc.expectedPath, servedPath)
+			}
+
+			if tc.wantDeprecated {
+				require.Equal(t, "true", res.Header.Get(DeprecationHeader))
+				require.Equal(t, `</api/v1/stream>; rel="successor-version"`, res.Header.Get("Link"))
+			} else {
+				require.Empty(t, res.Header.Get(DeprecationHeader))
+			}
+		})
+	}
+}
+
+func Test_versionHeaderMiddleware(t *testing.T) {
+	fake := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "response")
+	})
+
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodGet, "/api/v1/stream", nil)
+	versionHeaderMiddleware(fake).ServeHTTP(w, r)
+
+	require.Equal(t, APIVersion, w.Header().Get(APIVersionHeader))
+}
+
+func Test_versionHandler(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().BuildInfo().Return("0.16.0", "abc123", "2020-10-01T00:00:00Z")
+
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodGet, "/api/v1/version", nil)
+	versionHandler(dashConfig, log.NopLogger()).ServeHTTP(w, r)
+
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var got VersionResponse
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&got))
+
+	expected := VersionResponse{
+		Version:          "0.16.0",
+		Commit:           "abc123",
+		BuildTime:        "2020-10-01T00:00:00Z",
+		PluginAPIVersion: plugin.Handshake.ProtocolVersion,
+		APIVersions:      []string{APIVersion},
+	}
+	require.Equal(t, expected, got)
+}
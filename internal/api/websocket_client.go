@@ -135,12 +135,26 @@ func (c *WebsocketClient) readPump() {
 		c.logger.Debugf("closing read pump")
 	}()
 
+	tracked := c.manager != nil && c.manager.track()
+
 	go func() {
 		<-c.ctx.Done()
+
+		if c.manager != nil {
+			if tracked {
+				defer c.manager.closing.Done()
+			}
+
+			if c.manager.ctx.Err() != nil {
+				// let the browser know the dashboard is going away rather than dropping the connection.
+				message := websocket.FormatCloseMessage(websocket.CloseGoingAway, "octant is shutting down")
+				_ = c.conn.WriteControl(websocket.CloseMessage, message, time.Now().Add(writeWait))
+			}
+		}
+
 		if err := c.conn.Close(); err != nil {
 			c.logger.WithErr(err).Errorf("Close websocket connection")
 		}
-
 	}()
 
 	c.isOpen.Store(true)
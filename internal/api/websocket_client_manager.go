@@ -8,12 +8,14 @@ package api
 import (
 	"context"
 	"net/http"
+	"sync"
 
 	"github.com/vmware-tanzu/octant/pkg/event"
 
 	"github.com/google/uuid"
 
 	"github.com/vmware-tanzu/octant/internal/config"
+	"github.com/vmware-tanzu/octant/internal/i18n"
 )
 
 //go:generate mockgen -destination=./fake/mock_client_manager.go -package=fake github.com/vmware-tanzu/octant/internal/api ClientManager
@@ -49,6 +51,13 @@ type WebsocketClientManager struct {
 
 	ctx              context.Context
 	actionDispatcher ActionDispatcher
+
+	// closing tracks connections which have not been closed yet.
+	closing sync.WaitGroup
+	// closingMu guards stopping, so connections aren't added to closing once Wait
+	// has started waiting for it.
+	closingMu sync.Mutex
+	stopping  bool
 }
 
 var _ ClientManager = (*WebsocketClientManager)(nil)
@@ -96,6 +105,40 @@ func (m *WebsocketClientManager) Run(ctx context.Context) {
 	}
 }
 
+// track adds a connection to the connections which have not been closed yet. It
+// returns false if the manager is already waiting for connections to close.
+func (m *WebsocketClientManager) track() bool {
+	m.closingMu.Lock()
+	defer m.closingMu.Unlock()
+
+	if m.stopping {
+		return false
+	}
+
+	m.closing.Add(1)
+	return true
+}
+
+// Wait waits for websocket connections to close after the manager's context is
+// done, or until ctx is done. Connections opened after Wait is called aren't
+// waited for.
+func (m *WebsocketClientManager) Wait(ctx context.Context) {
+	m.closingMu.Lock()
+	m.stopping = true
+	m.closingMu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		m.closing.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-ctx.Done():
+	}
+}
+
 // ClientFromRequest creates a websocket client from a http request.
 func (m *WebsocketClientManager) ClientFromRequest(dashConfig config.Dash, w http.ResponseWriter, r *http.Request) (*WebsocketClient, error) {
 	clientID, err := uuid.NewUUID()
@@ -109,6 +152,9 @@ func (m *WebsocketClientManager) ClientFromRequest(dashConfig config.Dash, w htt
 	}
 
 	ctx, cancel := context.WithCancel(m.ctx)
+	if translator := dashConfig.Translator(); translator != nil {
+		ctx = i18n.WithLocale(ctx, translator.Negotiate(r.Header.Get("Accept-Language")))
+	}
 	client := NewWebsocketClient(ctx, conn, m, dashConfig, m.actionDispatcher, clientID)
 	m.register <- &clientMeta{
 		cancelFunc: func() {
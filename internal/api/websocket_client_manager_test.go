@@ -0,0 +1,44 @@
+/*
+ * Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebsocketClientManager_Wait(t *testing.T) {
+	m := NewWebsocketClientManager(context.Background(), nil)
+
+	require.True(t, m.track())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	// returns when ctx is done even though a connection is still open
+	m.Wait(ctx)
+	require.Error(t, ctx.Err())
+
+	m.closing.Done()
+
+	done := make(chan struct{})
+	go func() {
+		m.Wait(context.Background())
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		require.Fail(t, "wait did not return after connections closed")
+	}
+
+	// Connections opened while waiting aren't waited for.
+	require.False(t, m.track())
+}
@@ -13,6 +13,7 @@ import (
 	"sort"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/vmware-tanzu/octant/internal/util/path_util"
 
@@ -21,8 +22,11 @@ import (
 	"github.com/google/uuid"
 
 	"github.com/vmware-tanzu/octant/internal/config"
+	"github.com/vmware-tanzu/octant/internal/contentcache"
 	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/internal/replay"
 	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
 
 //go:generate mockgen -destination=./fake/mock_state_manager.go -package=fake github.com/vmware-tanzu/octant/internal/api StateManager
@@ -41,16 +45,30 @@ type StateManager interface {
 func defaultStateManagers(clientID string, dashConfig config.Dash) []StateManager {
 	logger := dashConfig.Logger().With("client-id", clientID)
 
+	contentCache := contentcache.New(dashConfig.ObjectStore(),
+		contentcache.WithMaxEntries(contentcache.DefaultMaxEntries+DefaultPrefetchPaths))
+
+	// Each client replays recordings at its own position.
+	replayPosition := replay.NewPosition(time.Time{})
+
+	contentOptions := []ContentManagerOption{
+		WithContentCache(contentCache),
+		WithContentPrefetcher(NewPrefetcher(dashConfig.ModuleManager(), contentCache, logger)),
+		WithContentReplayPosition(replayPosition),
+	}
+
 	return []StateManager{
-		NewContentManager(dashConfig.ModuleManager(), dashConfig, logger),
+		NewContentManager(dashConfig.ModuleManager(), dashConfig, logger, contentOptions...),
 		NewHelperStateManager(dashConfig),
 		NewFilterManager(),
 		NewNavigationManager(dashConfig),
 		NewNamespacesManager(dashConfig),
 		NewContextManager(dashConfig),
-		NewActionRequestManager(),
+		NewActionRequestManager(WithActionReplayPosition(replayPosition)),
 		NewTerminalStateManager(dashConfig),
 		NewPodLogsStateManager(dashConfig),
+		NewNotificationManager(dashConfig.Notifier()),
+		NewPreferencesManager(dashConfig.Preferences()),
 	}
 }
 
@@ -106,6 +124,9 @@ type WebsocketState struct {
 	filters            []octant.Filter
 	contentPathUpdates map[string]octant.ContentPathUpdateFunc
 	namespaceUpdates   map[string]octant.NamespaceUpdateFunc
+	// clusterNamespaces are the namespaces the client was viewing in the clusters it
+	// switched from, by kube config context, so switching back restores them.
+	clusterNamespaces map[string]string
 
 	mu               sync.RWMutex
 	managers         []StateManager
@@ -116,6 +137,7 @@ type WebsocketState struct {
 }
 
 var _ octant.State = (*WebsocketState)(nil)
+var _ octant.ModalSender = (*WebsocketState)(nil)
 
 // NewWebsocketState creates an instance of WebsocketState.
 func NewWebsocketState(dashConfig config.Dash, actionDispatcher ActionDispatcher, wsClient OctantClient, options ...WebsocketStateOption) *WebsocketState {
@@ -126,6 +148,7 @@ func NewWebsocketState(dashConfig config.Dash, actionDispatcher ActionDispatcher
 		wsClient:           wsClient,
 		contentPathUpdates: make(map[string]octant.ContentPathUpdateFunc),
 		namespaceUpdates:   make(map[string]octant.NamespaceUpdateFunc),
+		clusterNamespaces:  make(map[string]string),
 		namespace:          newStringValue(defaultNamespace),
 		contentPath:        newStringValue(""),
 		filters:            make([]octant.Filter, 0),
@@ -148,6 +171,7 @@ func NewTemporaryWebsocketState(actionDispatcher ActionDispatcher, wsClient Octa
 		wsClient:           wsClient,
 		contentPathUpdates: make(map[string]octant.ContentPathUpdateFunc),
 		namespaceUpdates:   make(map[string]octant.NamespaceUpdateFunc),
+		clusterNamespaces:  make(map[string]string),
 		actionDispatcher:   actionDispatcher,
 	}
 
@@ -346,15 +370,21 @@ func (c *WebsocketState) SetFilters(filters []octant.Filter) {
 	c.filters = filters
 }
 
-// SetContext sets the Kubernetes context.
+// SetContext sets the Kubernetes context. The namespace the client last viewed in the
+// context is restored.
 func (c *WebsocketState) SetContext(requestedContext string) {
+	c.saveClusterNamespace()
 	c.dashConfig.SetContextChosenInUI(true)
 
 	if err := c.dashConfig.UseContext(context.TODO(), requestedContext); err != nil {
 		c.dashConfig.Logger().WithErr(err).Errorf("update context")
 	}
 
-	c.SetNamespace(c.dashConfig.DefaultNamespace())
+	namespace := c.dashConfig.DefaultNamespace()
+	if clusterNamespace, ok := c.clusterNamespace(requestedContext); ok {
+		namespace = clusterNamespace
+	}
+	c.SetNamespace(namespace)
 
 	for _, fn := range c.contentPathUpdates {
 		fn(c.GetContentPath())
@@ -367,6 +397,25 @@ func (c *WebsocketState) SetContext(requestedContext string) {
 	)))
 }
 
+// saveClusterNamespace saves the namespace the client is viewing in the current cluster.
+func (c *WebsocketState) saveClusterNamespace() {
+	clusterContext := c.dashConfig.CurrentContext()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.clusterNamespaces[clusterContext] = c.namespace.get()
+}
+
+// clusterNamespace returns the namespace the client was last viewing in a cluster.
+func (c *WebsocketState) clusterNamespace(clusterContext string) (string, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	namespace, ok := c.clusterNamespaces[clusterContext]
+	return namespace, ok
+}
+
 func (c *WebsocketState) GetQueryParams() map[string][]string {
 	filters := c.filters
 
@@ -390,6 +439,11 @@ func (c *WebsocketState) SendAlert(alert action.Alert) {
 	c.wsClient.Send(CreateAlertUpdate(alert))
 }
 
+// SendModal opens a modal in the websocket client.
+func (c *WebsocketState) SendModal(modal *component.Modal) {
+	c.wsClient.Send(CreateModalEvent(modal))
+}
+
 func (c *WebsocketState) GetClientID() string {
 	if c.wsClient == nil {
 		return ""
@@ -428,3 +482,10 @@ func CreateAlertUpdate(alert action.Alert) event.Event {
 		"expiration": alert.Expiration,
 	})
 }
+
+// CreateModalEvent creates a modal event.
+func CreateModalEvent(modal *component.Modal) event.Event {
+	return event.CreateEvent(event.EventTypeModal, action.Payload{
+		"modal": modal,
+	})
+}
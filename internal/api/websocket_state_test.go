@@ -257,6 +257,7 @@ func TestWebSocketState_SetContext(t *testing.T) {
 	defer mocks.finish()
 
 	contextName := "fakeContext"
+	mocks.dashConfig.EXPECT().CurrentContext().Return("previousContext")
 	mocks.dashConfig.EXPECT().SetContextChosenInUI(gomock.Eq(true))
 	mocks.dashConfig.EXPECT().UseContext(context.TODO(), contextName)
 	mocks.dashConfig.EXPECT().DefaultNamespace().Return("defaultNamespace")
@@ -0,0 +1,208 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package audit records the mutating actions performed through the dashboard, so
+// teams sharing an Octant instance can see who changed what and when.
+package audit
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/afero"
+)
+
+// memoryLogLimit is the most entries kept by a log which isn't saved to a file.
+const memoryLogLimit = 1000
+
+// Entry records a mutating action performed through the dashboard.
+type Entry struct {
+	// Time is when the action was performed.
+	Time time.Time `json:"time"`
+	// User is the local user running Octant.
+	User string `json:"user"`
+	// Context is the kube config context the action was performed in.
+	Context string `json:"context"`
+	// Action is the name of the action.
+	Action string `json:"action"`
+
+	APIVersion string `json:"apiVersion,omitempty"`
+	Kind       string `json:"kind,omitempty"`
+	Namespace  string `json:"namespace,omitempty"`
+	Name       string `json:"name,omitempty"`
+
+	// BeforeResourceVersion is the resource version of the object before the action.
+	BeforeResourceVersion string `json:"beforeResourceVersion,omitempty"`
+	// AfterResourceVersion is the resource version of the object after the action. It
+	// is blank if the object was deleted.
+	AfterResourceVersion string `json:"afterResourceVersion,omitempty"`
+
+	// Error describes why the action failed. It is blank if the action succeeded.
+	Error string `json:"error,omitempty"`
+}
+
+// Filter selects audit entries. Blank fields match every entry.
+type Filter struct {
+	Namespace string
+	Kind      string
+	Name      string
+	// Limit is the most entries returned. Zero returns every entry.
+	Limit int
+}
+
+// Matches returns true if the filter selects an entry.
+func (f Filter) Matches(entry Entry) bool {
+	return (f.Namespace == "" || f.Namespace == entry.Namespace) &&
+		(f.Kind == "" || f.Kind == entry.Kind) &&
+		(f.Name == "" || f.Name == entry.Name)
+}
+
+// Log is a history of mutating actions.
+type Log interface {
+	// Record adds an entry to the log.
+	Record(entry Entry) error
+	// Entries returns the entries selected by a filter, most recent first.
+	Entries(filter Filter) ([]Entry, error)
+}
+
+// NewLog creates a log which appends entries to a file. Entries are only kept in
+// memory if the path is blank.
+func NewLog(fs afero.Fs, path string) Log {
+	if path == "" {
+		return NewMemoryLog()
+	}
+	return NewFileLog(fs, path)
+}
+
+// FileLog appends entries to a file as JSON lines.
+type FileLog struct {
+	fs   afero.Fs
+	path string
+
+	mu sync.Mutex
+}
+
+var _ Log = (*FileLog)(nil)
+
+// NewFileLog creates an instance of FileLog.
+func NewFileLog(fs afero.Fs, path string) *FileLog {
+	return &FileLog{
+		fs:   fs,
+		path: path,
+	}
+}
+
+// Record appends an entry to the file.
+func (l *FileLog) Record(entry Entry) error {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return errors.Wrap(err, "encode audit entry")
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if err := l.fs.MkdirAll(filepath.Dir(l.path), 0700); err != nil {
+		return errors.Wrapf(err, "create directory for %q", l.path)
+	}
+
+	f, err := l.fs.OpenFile(l.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return errors.Wrapf(err, "open %q", l.path)
+	}
+
+	_, err = f.Write(append(data, '\n'))
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+
+	return errors.Wrapf(err, "write %q", l.path)
+}
+
+// Entries reads the entries selected by a filter from the file. Lines which
+// can't be decoded are skipped.
+func (l *FileLog) Entries(filter Filter) ([]Entry, error) {
+	l.mu.Lock()
+	data, err := afero.ReadFile(l.fs, l.path)
+	l.mu.Unlock()
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "read %q", l.path)
+	}
+
+	var entries []Entry
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		var entry Entry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Wrapf(err, "read %q", l.path)
+	}
+
+	return selectEntries(entries, filter), nil
+}
+
+// MemoryLog keeps the most recent entries in memory.
+type MemoryLog struct {
+	mu      sync.Mutex
+	entries []Entry
+}
+
+var _ Log = (*MemoryLog)(nil)
+
+// NewMemoryLog creates an instance of MemoryLog.
+func NewMemoryLog() *MemoryLog {
+	return &MemoryLog{}
+}
+
+// Record adds an entry, discarding the oldest entry if the log is full.
+func (l *MemoryLog) Record(entry Entry) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.entries = append(l.entries, entry)
+	if len(l.entries) > memoryLogLimit {
+		l.entries = l.entries[len(l.entries)-memoryLogLimit:]
+	}
+
+	return nil
+}
+
+// Entries returns the entries selected by a filter.
+func (l *MemoryLog) Entries(filter Filter) ([]Entry, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return selectEntries(l.entries, filter), nil
+}
+
+// selectEntries returns the entries selected by a filter, most recent first.
+// Entries are expected in the order they were recorded.
+func selectEntries(entries []Entry, filter Filter) []Entry {
+	var selected []Entry
+	for i := len(entries) - 1; i >= 0; i-- {
+		if filter.Limit > 0 && len(selected) == filter.Limit {
+			break
+		}
+		if filter.Matches(entries[i]) {
+			selected = append(selected, entries[i])
+		}
+	}
+
+	return selected
+}
@@ -0,0 +1,83 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package audit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileLog(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	l := NewFileLog(fs, "/home/user/.octant/audit.log")
+
+	entries, err := l.Entries(Filter{})
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+
+	first := Entry{Time: time.Unix(1, 0).UTC(), Action: "delete", Kind: "Pod", Namespace: "default", Name: "a"}
+	second := Entry{Time: time.Unix(2, 0).UTC(), Action: "delete", Kind: "Pod", Namespace: "other", Name: "b", Error: "forbidden"}
+	require.NoError(t, l.Record(first))
+	require.NoError(t, l.Record(second))
+
+	entries, err = l.Entries(Filter{})
+	require.NoError(t, err)
+	assert.Equal(t, []Entry{second, first}, entries)
+
+	entries, err = NewFileLog(fs, "/home/user/.octant/audit.log").Entries(Filter{Namespace: "default"})
+	require.NoError(t, err)
+	assert.Equal(t, []Entry{first}, entries)
+}
+
+func TestFileLog_skips_invalid_lines(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	require.NoError(t, afero.WriteFile(fs, "/audit.log", []byte("not json\n{\"action\":\"delete\"}\n"), 0600))
+
+	entries, err := NewFileLog(fs, "/audit.log").Entries(Filter{})
+	require.NoError(t, err)
+	assert.Equal(t, []Entry{{Action: "delete"}}, entries)
+}
+
+func TestMemoryLog(t *testing.T) {
+	l := NewMemoryLog()
+	for i := 0; i < memoryLogLimit+5; i++ {
+		require.NoError(t, l.Record(Entry{Time: time.Unix(int64(i), 0)}))
+	}
+
+	entries, err := l.Entries(Filter{})
+	require.NoError(t, err)
+	require.Len(t, entries, memoryLogLimit)
+	assert.Equal(t, time.Unix(memoryLogLimit+4, 0), entries[0].Time)
+
+	entries, err = l.Entries(Filter{Limit: 2})
+	require.NoError(t, err)
+	assert.Len(t, entries, 2)
+}
+
+func TestFilter_Matches(t *testing.T) {
+	entry := Entry{Kind: "Deployment", Namespace: "default", Name: "web"}
+
+	tests := []struct {
+		name     string
+		filter   Filter
+		expected bool
+	}{
+		{name: "blank filter", filter: Filter{}, expected: true},
+		{name: "matching kind and name", filter: Filter{Kind: "Deployment", Name: "web"}, expected: true},
+		{name: "different namespace", filter: Filter{Namespace: "other"}, expected: false},
+		{name: "different kind", filter: Filter{Kind: "Pod"}, expected: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, test.filter.Matches(entry))
+		})
+	}
+}
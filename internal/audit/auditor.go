@@ -0,0 +1,231 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package audit
+
+import (
+	"context"
+	"os"
+	"os/user"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/vmware-tanzu/octant/internal/cluster"
+	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+// MutatingActions are the actions which change the cluster.
+var MutatingActions = map[string]bool{
+	octant.ActionDeleteObject:                true,
+	octant.ActionOverviewCordon:              true,
+	octant.ActionOverviewUncordon:            true,
+	octant.ActionDrainNode:                   true,
+	octant.ActionSetNodeTaint:                true,
+	octant.ActionRemoveNodeTaint:             true,
+	octant.ActionOverviewContainerEditor:     true,
+	octant.ActionOverviewCronjob:             true,
+	octant.ActionOverviewSuspendCronjob:      true,
+	octant.ActionOverviewResumeCronjob:       true,
+	octant.ActionOverviewServiceEditor:       true,
+	octant.ActionServicePortEditor:           true,
+	octant.ActionDeploymentConfiguration:     true,
+	octant.ActionHorizontalPodAutoscalerEdit: true,
+	octant.ActionPauseDeployment:             true,
+	octant.ActionResumeDeployment:            true,
+	octant.ActionUpdateObject:                true,
+	octant.ActionApplyYaml:                   true,
+	octant.ActionUploadContainerFiles:        true,
+	octant.ActionResizePersistentVolumeClaim: true,
+	octant.ActionRunKubectlPlugin:            true,
+}
+
+// Auditor records mutating actions to a log.
+type Auditor struct {
+	log            Log
+	clusterClient  func() cluster.ClientInterface
+	currentContext func() string
+	user           string
+	now            func() time.Time
+}
+
+// NewAuditor creates an instance of Auditor. The cluster client func returns the
+// client resource versions are read with, and the current context func names the
+// kube config context actions are performed in.
+func NewAuditor(auditLog Log, clusterClient func() cluster.ClientInterface, currentContext func() string) *Auditor {
+	return &Auditor{
+		log:            auditLog,
+		clusterClient:  clusterClient,
+		currentContext: currentContext,
+		user:           currentUser(),
+		now:            time.Now,
+	}
+}
+
+// Log returns the log actions are recorded to.
+func (a *Auditor) Log() Log {
+	return a.log
+}
+
+// Wrap returns a dispatcher func which records the action to the log. Actions
+// which don't change the cluster are returned unchanged.
+func (a *Auditor) Wrap(actionName string, f action.DispatcherFunc) action.DispatcherFunc {
+	if !MutatingActions[actionName] {
+		return f
+	}
+
+	return func(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+		// Actions such as applying YAML don't target a single object.
+		var target *store.Key
+		if key, err := store.KeyFromPayload(payload); err == nil {
+			target = &key
+		}
+
+		return a.record(ctx, actionName, target, func() (string, error) {
+			recorder := &failureRecorder{alerter: alerter}
+			err := f(ctx, recorder, payload)
+			return recorder.failure, err
+		})
+	}
+}
+
+// Audit performs a change to the object with key which isn't made by an action
+// dispatcher, e.g. a REST upload or the end of a long running action, and records
+// it to the log as actionName. It only performs the change if a is nil.
+func (a *Auditor) Audit(ctx context.Context, actionName string, key store.Key, f func() error) error {
+	if a == nil {
+		return f()
+	}
+
+	return a.record(ctx, actionName, &key, func() (string, error) {
+		return "", f()
+	})
+}
+
+// record performs a change and records it to the log. f returns a failure which
+// was reported to the user rather than returned as an error.
+func (a *Auditor) record(ctx context.Context, actionName string, key *store.Key, f func() (string, error)) error {
+	entry := Entry{
+		Time:    a.now(),
+		User:    a.user,
+		Context: a.currentContext(),
+		Action:  actionName,
+	}
+
+	if key != nil {
+		entry.APIVersion = key.APIVersion
+		entry.Kind = key.Kind
+		entry.Namespace = key.Namespace
+		entry.Name = key.Name
+		entry.BeforeResourceVersion = a.resourceVersion(ctx, *key)
+	}
+
+	failure, err := f()
+
+	if key != nil {
+		entry.AfterResourceVersion = a.resourceVersion(ctx, *key)
+	}
+
+	switch {
+	case err != nil:
+		entry.Error = err.Error()
+	case failure != "":
+		entry.Error = failure
+	}
+
+	if recordErr := a.log.Record(entry); recordErr != nil {
+		log.From(ctx).WithErr(recordErr).Errorf("record audit entry")
+	}
+
+	return err
+}
+
+// resourceVersion returns the resource version of an object, or blank if the
+// object doesn't exist. It is read from the API server because the informer
+// cache may not have seen the change an action just made.
+func (a *Auditor) resourceVersion(ctx context.Context, key store.Key) string {
+	clusterClient := a.clusterClient()
+
+	gvr, namespaced, err := clusterClient.Resource(key.GroupVersionKind().GroupKind())
+	if err != nil {
+		return ""
+	}
+
+	client, err := clusterClient.DynamicClient()
+	if err != nil {
+		return ""
+	}
+
+	var object *unstructured.Unstructured
+	if namespaced {
+		object, err = client.Resource(gvr).Namespace(key.Namespace).Get(ctx, key.Name, metav1.GetOptions{})
+	} else {
+		object, err = client.Resource(gvr).Get(ctx, key.Name, metav1.GetOptions{})
+	}
+	if err != nil {
+		return ""
+	}
+
+	return object.GetResourceVersion()
+}
+
+// failureRecorder passes alerts through and remembers the last error or warning.
+// Most actions report failures as alerts rather than returning an error.
+type failureRecorder struct {
+	alerter action.Alerter
+	failure string
+}
+
+var _ action.Alerter = (*failureRecorder)(nil)
+
+func (r *failureRecorder) SendAlert(alert action.Alert) {
+	if alert.Type == action.AlertTypeError || alert.Type == action.AlertTypeWarning {
+		r.failure = alert.Message
+	}
+	r.alerter.SendAlert(alert)
+}
+
+// ActionRegistrar registers dispatcher funcs for action paths.
+type ActionRegistrar interface {
+	Register(actionPath, pluginPath string, actionFunc action.DispatcherFunc) error
+	Unregister(actionPath, pluginPath string)
+}
+
+// Registrar is an ActionRegistrar which audits the actions it registers.
+type Registrar struct {
+	registrar ActionRegistrar
+	auditor   *Auditor
+}
+
+var _ ActionRegistrar = (*Registrar)(nil)
+
+// NewRegistrar creates an instance of Registrar.
+func NewRegistrar(registrar ActionRegistrar, auditor *Auditor) *Registrar {
+	return &Registrar{
+		registrar: registrar,
+		auditor:   auditor,
+	}
+}
+
+// Register registers an audited dispatcher func for an action path.
+func (r *Registrar) Register(actionPath, pluginPath string, actionFunc action.DispatcherFunc) error {
+	return r.registrar.Register(actionPath, pluginPath, r.auditor.Wrap(actionPath, actionFunc))
+}
+
+// Unregister unregisters the dispatcher funcs of a plugin for an action path.
+func (r *Registrar) Unregister(actionPath, pluginPath string) {
+	r.registrar.Unregister(actionPath, pluginPath)
+}
+
+func currentUser() string {
+	if u, err := user.Current(); err == nil && u.Username != "" {
+		return u.Username
+	}
+	return os.Getenv("USER")
+}
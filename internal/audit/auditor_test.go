@@ -0,0 +1,207 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package audit
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware-tanzu/octant/internal/cluster"
+	clusterFake "github.com/vmware-tanzu/octant/internal/cluster/fake"
+	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	actionFake "github.com/vmware-tanzu/octant/pkg/action/fake"
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+func TestAuditor_Wrap(t *testing.T) {
+	now := time.Unix(1000, 0)
+	key := store.Key{Namespace: "default", APIVersion: "apps/v1", Kind: "Deployment", Name: "web"}
+
+	tests := []struct {
+		name     string
+		f        action.DispatcherFunc
+		after    *unstructured.Unstructured
+		expected Entry
+	}{
+		{
+			name: "succeeded",
+			f: func(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+				alerter.SendAlert(action.CreateAlert(action.AlertTypeInfo, "updated", action.DefaultAlertExpiration))
+				return nil
+			},
+			after: deploymentWithResourceVersion(t, "2"),
+			expected: Entry{
+				Time: now, User: "user", Context: "cluster", Action: octant.ActionUpdateObject,
+				APIVersion: "apps/v1", Kind: "Deployment", Namespace: "default", Name: "web",
+				BeforeResourceVersion: "1", AfterResourceVersion: "2",
+			},
+		},
+		{
+			name: "failure alert",
+			f: func(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+				alerter.SendAlert(action.CreateAlert(action.AlertTypeError, "forbidden", action.DefaultAlertExpiration))
+				return nil
+			},
+			after: deploymentWithResourceVersion(t, "1"),
+			expected: Entry{
+				Time: now, User: "user", Context: "cluster", Action: octant.ActionUpdateObject,
+				APIVersion: "apps/v1", Kind: "Deployment", Namespace: "default", Name: "web",
+				BeforeResourceVersion: "1", AfterResourceVersion: "1", Error: "forbidden",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			clusterClient := clusterClientWithObjects(controller, key, deploymentWithResourceVersion(t, "1"), test.after)
+
+			alerter := actionFake.NewMockAlerter(controller)
+			alerter.EXPECT().SendAlert(gomock.Any())
+
+			l := NewMemoryLog()
+			auditor := newTestAuditor(l, clusterClient, now)
+
+			f := auditor.Wrap(octant.ActionUpdateObject, test.f)
+			require.NoError(t, f(context.Background(), alerter, key.ToActionPayload()))
+
+			entries, err := l.Entries(Filter{})
+			require.NoError(t, err)
+			assert.Equal(t, []Entry{test.expected}, entries)
+		})
+	}
+}
+
+func TestAuditor_Wrap_non_mutating_action(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	clusterClient := clusterFake.NewMockClientInterface(controller)
+	l := NewMemoryLog()
+	auditor := newTestAuditor(l, clusterClient, time.Now())
+
+	called := false
+	f := auditor.Wrap("action.octant.dev/setNamespace", func(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+		called = true
+		return nil
+	})
+	require.NoError(t, f(context.Background(), nil, action.Payload{}))
+	assert.True(t, called)
+
+	entries, err := l.Entries(Filter{})
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func TestAuditor_Audit(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	now := time.Unix(1000, 0)
+	key := store.Key{Namespace: "default", APIVersion: "apps/v1", Kind: "Deployment", Name: "web"}
+
+	deployment := deploymentWithResourceVersion(t, "1")
+	clusterClient := clusterClientWithObjects(controller, key, deployment, deployment)
+
+	l := NewMemoryLog()
+	auditor := newTestAuditor(l, clusterClient, now)
+
+	err := auditor.Audit(context.Background(), octant.ActionUpdateObject, key, func() error {
+		return fmt.Errorf("connection refused")
+	})
+	require.Error(t, err)
+
+	entries, err := l.Entries(Filter{})
+	require.NoError(t, err)
+	assert.Equal(t, []Entry{{
+		Time: now, User: "user", Context: "cluster", Action: octant.ActionUpdateObject,
+		APIVersion: "apps/v1", Kind: "Deployment", Namespace: "default", Name: "web",
+		BeforeResourceVersion: "1", AfterResourceVersion: "1", Error: "connection refused",
+	}}, entries)
+}
+
+func TestAuditor_Audit_nil(t *testing.T) {
+	var auditor *Auditor
+
+	called := false
+	require.NoError(t, auditor.Audit(context.Background(), octant.ActionUpdateObject, store.Key{}, func() error {
+		called = true
+		return nil
+	}))
+	assert.True(t, called)
+}
+
+func TestAuditor_resourceVersion_not_found(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	key := store.Key{Namespace: "default", APIVersion: "apps/v1", Kind: "Deployment", Name: "web"}
+	gvr := schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}
+
+	resourceClient := clusterFake.NewMockNamespaceableResourceInterface(controller)
+	resourceClient.EXPECT().Namespace("default").Return(resourceClient)
+	resourceClient.EXPECT().Get(gomock.Any(), "web", gomock.Any()).Return(nil, kerrors.NewNotFound(gvr.GroupResource(), "web"))
+
+	dynamicClient := clusterFake.NewMockDynamicInterface(controller)
+	dynamicClient.EXPECT().Resource(gvr).Return(resourceClient)
+
+	clusterClient := clusterFake.NewMockClientInterface(controller)
+	clusterClient.EXPECT().Resource(key.GroupVersionKind().GroupKind()).Return(gvr, true, nil)
+	clusterClient.EXPECT().DynamicClient().Return(dynamicClient, nil)
+
+	auditor := newTestAuditor(NewMemoryLog(), clusterClient, time.Now())
+	assert.Equal(t, "", auditor.resourceVersion(context.Background(), key))
+}
+
+func newTestAuditor(l Log, clusterClient cluster.ClientInterface, now time.Time) *Auditor {
+	auditor := NewAuditor(l, func() cluster.ClientInterface { return clusterClient }, func() string { return "cluster" })
+	auditor.user = "user"
+	auditor.now = func() time.Time { return now }
+	return auditor
+}
+
+func deploymentWithResourceVersion(t *testing.T, resourceVersion string) *unstructured.Unstructured {
+	deployment := testutil.CreateDeployment("web")
+	deployment.Namespace = "default"
+	deployment.ResourceVersion = resourceVersion
+	return testutil.ToUnstructured(t, deployment)
+}
+
+// clusterClientWithObjects creates a cluster client which returns each object
+// in turn when the object with key is fetched from the API server.
+func clusterClientWithObjects(controller *gomock.Controller, key store.Key, objects ...*unstructured.Unstructured) *clusterFake.MockClientInterface {
+	gvr := schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}
+
+	resourceClient := clusterFake.NewMockNamespaceableResourceInterface(controller)
+	resourceClient.EXPECT().Namespace(key.Namespace).Return(resourceClient).Times(len(objects))
+	var calls []*gomock.Call
+	for _, object := range objects {
+		calls = append(calls, resourceClient.EXPECT().Get(gomock.Any(), key.Name, gomock.Any()).Return(object, nil))
+	}
+	gomock.InOrder(calls...)
+
+	dynamicClient := clusterFake.NewMockDynamicInterface(controller)
+	dynamicClient.EXPECT().Resource(gvr).Return(resourceClient).Times(len(objects))
+
+	clusterClient := clusterFake.NewMockClientInterface(controller)
+	clusterClient.EXPECT().Resource(key.GroupVersionKind().GroupKind()).Return(gvr, true, nil).Times(len(objects))
+	clusterClient.EXPECT().DynamicClient().Return(dynamicClient, nil).Times(len(objects))
+
+	return clusterClient
+}
@@ -0,0 +1,59 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package commands
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/vmware-tanzu/octant/internal/cluster"
+	"github.com/vmware-tanzu/octant/internal/kubeconfig"
+)
+
+// clusterFlags are the flags used by commands which connect to a cluster
+// without starting the dashboard. Their values are read with viper so they can
+// be set with environment variables once the command's flags are bound.
+type clusterFlags struct{}
+
+func (f *clusterFlags) addFlags(cmd *cobra.Command) {
+	cmd.Flags().StringSlice("kubeconfig", []string{}, "absolute path to kubeConfig file (can be repeated or a path list)")
+	cmd.Flags().String("context", "", "context to use (defaults to the current-context in kube config)")
+}
+
+// kubeConfigList returns the kube config path list, falling back to the default
+// kube config location.
+func (f *clusterFlags) kubeConfigList() string {
+	list := kubeConfigList(kubeConfigValue())
+	if list == "" {
+		list = clientcmd.NewDefaultClientConfigLoadingRules().GetDefaultFilename()
+	}
+	return list
+}
+
+// contextName returns the kube config context to use.
+func (f *clusterFlags) contextName() string {
+	return viper.GetString("context")
+}
+
+// contextManager loads kube config for the flags.
+func (f *clusterFlags) contextManager(ctx context.Context) (*kubeconfig.KubeConfigContextManager, error) {
+	return kubeconfig.NewKubeConfigContextManager(ctx,
+		kubeconfig.WithKubeConfigList(f.kubeConfigList()),
+		kubeconfig.WithContextName(f.contextName()))
+}
+
+// clusterClient creates a cluster client for the flags.
+func (f *clusterFlags) clusterClient(ctx context.Context) (cluster.ClientInterface, string, error) {
+	manager, err := f.contextManager(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return manager.ClusterClient(), manager.CurrentContext(), nil
+}
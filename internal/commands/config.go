@@ -0,0 +1,180 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package commands
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/afero"
+	"github.com/spf13/viper"
+
+	"github.com/vmware-tanzu/octant/internal/persistence"
+)
+
+const (
+	// configFileKey is the flag used to specify an alternate configuration file.
+	configFileKey = "config"
+)
+
+// defaultConfigFile returns the default location of Octant's configuration file.
+func defaultConfigFile() string {
+	home, err := os.UserHomeDir()
+	if err != nil || home == "" {
+		return ""
+	}
+
+	return filepath.Join(home, ".octant", "config.yaml")
+}
+
+// defaultPortForwardStateFile returns the default location port forwards are saved to.
+func defaultPortForwardStateFile() string {
+	home, err := os.UserHomeDir()
+	if err != nil || home == "" {
+		return ""
+	}
+
+	return filepath.Join(home, ".octant", "port-forwards.json")
+}
+
+// defaultPreferencesFile returns the default location preferences are saved to.
+func defaultPreferencesFile() string {
+	home, err := os.UserHomeDir()
+	if err != nil || home == "" {
+		return ""
+	}
+
+	return filepath.Join(home, ".octant", "preferences.json")
+}
+
+// defaultAuditLogFile returns the default location mutating actions are recorded to.
+func defaultAuditLogFile() string {
+	home, err := os.UserHomeDir()
+	if err != nil || home == "" {
+		return ""
+	}
+
+	return filepath.Join(home, ".octant", "audit.log")
+}
+
+// defaultWebhooksFile returns the default location outbound webhook rules are saved to.
+func defaultWebhooksFile() string {
+	home, err := os.UserHomeDir()
+	if err != nil || home == "" {
+		return ""
+	}
+
+	return filepath.Join(home, ".octant", "webhooks.json")
+}
+
+// defaultRecordingFile returns the default location recorded changes are saved to.
+func defaultRecordingFile() string {
+	home, err := os.UserHomeDir()
+	if err != nil || home == "" {
+		return ""
+	}
+
+	return filepath.Join(home, ".octant", "recording.jsonl")
+}
+
+// webhooksFile returns the file outbound webhook rules are saved to. Rules are only
+// kept in memory if it is blank.
+func webhooksFile() string {
+	if webhooksFile := viper.GetString("webhooks-file"); webhooksFile != "" {
+		return webhooksFile
+	}
+
+	return defaultWebhooksFile()
+}
+
+// auditLogFile returns the file mutating actions are recorded to. The audit log is
+// only kept in memory if it is blank.
+func auditLogFile() string {
+	if auditLogFile := viper.GetString("audit-log-file"); auditLogFile != "" {
+		return auditLogFile
+	}
+
+	return defaultAuditLogFile()
+}
+
+// recordingFile returns the file recorded changes are saved to. Changes are only
+// kept in memory if it is blank.
+func recordingFile() string {
+	if recordingFile := viper.GetString("recording-file"); recordingFile != "" {
+		return recordingFile
+	}
+
+	return defaultRecordingFile()
+}
+
+// preferencesFile returns the file preferences are saved to. Preferences are only kept
+// in memory if it is blank.
+func preferencesFile() string {
+	if preferencesFile := viper.GetString("preferences-file"); preferencesFile != "" {
+		return preferencesFile
+	}
+
+	return defaultPreferencesFile()
+}
+
+// portForwardStateFile returns the file port forwards are saved to. Port forwards are
+// not saved if it is blank.
+func portForwardStateFile() string {
+	if viper.GetBool("disable-port-forward-restore") {
+		return ""
+	}
+
+	if stateFile := viper.GetString("port-forward-state-file"); stateFile != "" {
+		return stateFile
+	}
+
+	return defaultPortForwardStateFile()
+}
+
+// stateBackend returns where preferences and port forwards are saved. State is saved to
+// files unless Octant runs in a cluster or another backend was requested.
+func stateBackend() (persistence.Backend, error) {
+	return persistence.ParseBackend(viper.GetString("state-backend"))
+}
+
+// readConfigFile merges settings from Octant's configuration file into viper. Keys in the
+// file match the names of the command line flags e.g. "listener-addr" or "namespace-list".
+// Flags and environment variables continue to override values found in the file.
+//
+// A missing default configuration file is not an error; a missing configuration file
+// that was explicitly requested is. The path of the file which was read is returned.
+func readConfigFile(fs afero.Fs) (string, error) {
+	configFile := viper.GetString(configFileKey)
+	explicit := configFile != ""
+
+	if !explicit {
+		configFile = defaultConfigFile()
+		if configFile == "" {
+			return "", nil
+		}
+	}
+
+	exists, err := afero.Exists(fs, configFile)
+	if err != nil {
+		return "", fmt.Errorf("check config file %q: %w", configFile, err)
+	}
+
+	if !exists {
+		if explicit {
+			return "", fmt.Errorf("config file %q does not exist", configFile)
+		}
+		return "", nil
+	}
+
+	viper.SetFs(fs)
+	viper.SetConfigFile(configFile)
+	if err := viper.ReadInConfig(); err != nil {
+		return "", fmt.Errorf("read config file %q: %w", configFile, err)
+	}
+
+	return configFile, nil
+}
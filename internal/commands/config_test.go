@@ -0,0 +1,132 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package commands
+
+import (
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/octant/internal/persistence"
+)
+
+const testConfig = `
+listener-addr: 127.0.0.1:8900
+context: staging
+namespace-list:
+  - default
+  - kube-system
+informer-resync: 5m
+`
+
+func Test_readConfigFile(t *testing.T) {
+	defer viper.Reset()
+
+	fs := afero.NewMemMapFs()
+	require.NoError(t, afero.WriteFile(fs, "/config/octant.yaml", []byte(testConfig), 0600))
+
+	cmd := &cobra.Command{}
+	cmd.Flags().String(configFileKey, "", "")
+	cmd.Flags().String("context", "", "")
+	cmd.Flags().String("listener-addr", "", "")
+	require.NoError(t, cmd.Flags().Parse([]string{"--config", "/config/octant.yaml", "--context", "production"}))
+
+	require.NoError(t, bindViper(cmd))
+
+	got, err := readConfigFile(fs)
+	require.NoError(t, err)
+	assert.Equal(t, "/config/octant.yaml", got)
+
+	assert.Equal(t, "127.0.0.1:8900", viper.GetString("listener-addr"))
+	assert.Equal(t, []string{"default", "kube-system"}, viper.GetStringSlice("namespace-list"))
+	assert.Equal(t, "5m0s", viper.GetDuration("informer-resync").String())
+
+	// flags override values from the config file
+	assert.Equal(t, "production", viper.GetString("context"))
+}
+
+func Test_readConfigFile_missing(t *testing.T) {
+	defer viper.Reset()
+
+	fs := afero.NewMemMapFs()
+
+	got, err := readConfigFile(fs)
+	require.NoError(t, err)
+	assert.Equal(t, "", got)
+
+	viper.Set(configFileKey, "/missing.yaml")
+	_, err = readConfigFile(fs)
+	require.Error(t, err)
+}
+
+func Test_portForwardStateFile(t *testing.T) {
+	defer viper.Reset()
+
+	assert.Equal(t, defaultPortForwardStateFile(), portForwardStateFile())
+
+	viper.Set("port-forward-state-file", "/state/port-forwards.json")
+	assert.Equal(t, "/state/port-forwards.json", portForwardStateFile())
+
+	viper.Set("disable-port-forward-restore", true)
+	assert.Equal(t, "", portForwardStateFile())
+}
+
+func Test_preferencesFile(t *testing.T) {
+	defer viper.Reset()
+
+	assert.Equal(t, defaultPreferencesFile(), preferencesFile())
+
+	viper.Set("preferences-file", "/state/preferences.json")
+	assert.Equal(t, "/state/preferences.json", preferencesFile())
+}
+
+func Test_webhooksFile(t *testing.T) {
+	defer viper.Reset()
+
+	assert.Equal(t, defaultWebhooksFile(), webhooksFile())
+
+	viper.Set("webhooks-file", "/state/webhooks.json")
+	assert.Equal(t, "/state/webhooks.json", webhooksFile())
+}
+
+func Test_auditLogFile(t *testing.T) {
+	defer viper.Reset()
+
+	assert.Equal(t, defaultAuditLogFile(), auditLogFile())
+
+	viper.Set("audit-log-file", "/state/audit.log")
+	assert.Equal(t, "/state/audit.log", auditLogFile())
+}
+
+func Test_recordingFile(t *testing.T) {
+	defer viper.Reset()
+
+	assert.Equal(t, defaultRecordingFile(), recordingFile())
+
+	viper.Set("recording-file", "/state/recording.jsonl")
+	assert.Equal(t, "/state/recording.jsonl", recordingFile())
+}
+
+func Test_stateBackend(t *testing.T) {
+	defer viper.Reset()
+
+	got, err := stateBackend()
+	require.NoError(t, err)
+	assert.Equal(t, persistence.BackendFile, got)
+
+	viper.Set("state-backend", "Secret")
+	got, err = stateBackend()
+	require.NoError(t, err)
+	assert.Equal(t, persistence.BackendSecret, got)
+
+	viper.Set("state-backend", "etcd")
+	_, err = stateBackend()
+	require.Error(t, err)
+}
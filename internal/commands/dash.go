@@ -12,16 +12,28 @@ import (
 	golog "log"
 	"os"
 	"os/signal"
+	"path/filepath"
 	"strings"
+	"syscall"
+	"time"
 
+	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/klog"
 
+	"github.com/vmware-tanzu/octant/internal/alerts"
 	"github.com/vmware-tanzu/octant/internal/api"
 	"github.com/vmware-tanzu/octant/internal/config"
+	"github.com/vmware-tanzu/octant/internal/featuregate"
+	"github.com/vmware-tanzu/octant/internal/kubectlplugin"
 	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/internal/persistence"
+	"github.com/vmware-tanzu/octant/internal/replay"
+	"github.com/vmware-tanzu/octant/internal/telemetry"
+	"github.com/vmware-tanzu/octant/internal/terminal"
+	dashstrings "github.com/vmware-tanzu/octant/internal/util/strings"
 	pconfig "github.com/vmware-tanzu/octant/pkg/config"
 	"github.com/vmware-tanzu/octant/pkg/dash"
 )
@@ -30,7 +42,7 @@ func newOctantCmd(version string, gitCommit string, buildTime string) *cobra.Com
 	octantCmd := &cobra.Command{
 		Use:   "octant",
 		Short: "octant kubernetes dashboard",
-		Long:  "octant is a dashboard for high bandwidth cluster analysis operations",
+		Long:  "octant is a dashboard for high bandwidth cluster analysis operations\n\n" + envHelp,
 		Run: func(cmd *cobra.Command, args []string) {
 			ctx, cancel := context.WithCancel(context.Background())
 			defer cancel()
@@ -40,12 +52,30 @@ func newOctantCmd(version string, gitCommit string, buildTime string) *cobra.Com
 				os.Exit(1)
 			}
 
+			configFile, err := readConfigFile(afero.NewOsFs())
+			if err != nil {
+				golog.Printf("unable to load config file: %v", err)
+				os.Exit(1)
+			}
+
 			logLevel := 0
 			if viper.GetBool("verbose") {
 				logLevel = 1
 			}
 
-			logger, err := log.Init(logLevel)
+			backend, err := stateBackend()
+			if err != nil {
+				golog.Printf("invalid state configuration: %v", err)
+				os.Exit(1)
+			}
+
+			logOptions, err := logInitOptions()
+			if err != nil {
+				golog.Printf("invalid log configuration: %v", err)
+				os.Exit(1)
+			}
+
+			logger, err := log.Init(logLevel, logOptions...)
 			if err != nil {
 				golog.Printf("unable to initialize logger: %v", err)
 				os.Exit(1)
@@ -53,15 +83,21 @@ func newOctantCmd(version string, gitCommit string, buildTime string) *cobra.Com
 
 			defer logger.Close()
 
+			if configFile != "" {
+				logger.Debugf("loaded configuration from %s", configFile)
+			}
+
 			sigCh := make(chan os.Signal, 1)
-			signal.Notify(sigCh, os.Interrupt)
+			signal.Notify(sigCh, os.Interrupt, syscall.SIGTERM)
 
 			runCh := make(chan bool, 1)
 
 			shutdownCh := make(chan bool, 1)
 
 			logger.Debugf("disable-open-browser: %s", viper.Get("disable-open-browser"))
+			logger.Debugf("headless: %s", viper.Get("headless"))
 
+			viper.Set("kubeconfig", kubeConfigList(kubeConfigValue()))
 			if viper.GetString("kubeconfig") == "" {
 				viper.Set("kubeconfig", clientcmd.NewDefaultClientConfigLoadingRules().GetDefaultFilename())
 			}
@@ -73,6 +109,11 @@ func newOctantCmd(version string, gitCommit string, buildTime string) *cobra.Com
 				os.Exit(1)
 			}
 
+			if requested := api.RequestedListenerAddr(); !api.ListenerPortMatches(listener, requested) {
+				logger.Warnf("%s is already in use, listening on %s instead", requested, listener.Addr())
+			}
+			logger.Infof("listening on %s", listener.Addr())
+
 			go func() {
 				buildInfo := config.BuildInfo{
 					Version: version,
@@ -83,7 +124,7 @@ func newOctantCmd(version string, gitCommit string, buildTime string) *cobra.Com
 				options := []dash.RunnerOption{
 					dash.WithKubeConfig(viper.GetString("kubeconfig")),
 					dash.WithNamespace(viper.GetString("namespace")),
-					dash.WithNamespaces(viper.GetStringSlice("namespace-list")),
+					dash.WithNamespaces(stringSliceValue("namespace-list")),
 					dash.WithFrontendURL(viper.GetString("ui-url")),
 					dash.WithBrowserPath(viper.GetString("browser-path")),
 					dash.WithContext(viper.GetString("context")),
@@ -92,14 +133,60 @@ func newOctantCmd(version string, gitCommit string, buildTime string) *cobra.Com
 					dash.WithClientUserAgent(fmt.Sprintf("octant/%s", version)),
 					dash.WithBuildInfo(buildInfo),
 					dash.WithListener(listener),
+					dash.WithInformerResync(viper.GetDuration("informer-resync")),
+					dash.WithPortForwardStateFile(portForwardStateFile()),
+					dash.WithPreferencesFile(preferencesFile()),
+					dash.WithWebhooksFile(webhooksFile()),
+					dash.WithAuditLogFile(auditLogFile()),
+					dash.WithLocaleDir(viper.GetString("locale-dir")),
+					dash.WithAlerts(viper.GetString("alertmanager-url"), viper.GetString("prometheus-url"), viper.GetDuration("alerts-refresh-interval")),
+					dash.WithStateBackend(backend, viper.GetString("state-namespace"), viper.GetString("state-name")),
+					dash.WithTerminalOptions(
+						terminal.WithIdleTimeout(viper.GetDuration("terminal-idle-timeout")),
+						terminal.WithMaxSessions(viper.GetInt("terminal-max-sessions"))),
 				}
 				if viper.GetBool("disable-cluster-overview") {
 					options = append(options, dash.WithoutClusterOverview())
 				}
+				if viper.GetBool("disable-port-forward-restore") {
+					options = append(options, dash.WithoutPortForwardSave())
+				}
+				if viper.GetBool("headless") {
+					options = append(options, dash.WithHeadless())
+				}
 				if viper.GetBool("enable-opencensus") {
 					options = append(options, dash.WithOpenCensus())
 				}
 
+				gate, err := featureGate()
+				if err != nil {
+					golog.Printf("invalid feature gates: %v", err)
+					os.Exit(1)
+				}
+				options = append(options, dash.WithFeatureGate(gate))
+
+				kubectlPluginMappings, err := kubectlplugin.ParseMappings(stringSliceValue("kubectl-plugins"))
+				if err != nil {
+					golog.Printf("invalid kubectl plugin mappings: %v", err)
+					os.Exit(1)
+				}
+				options = append(options, dash.WithKubectlPluginMappings(kubectlPluginMappings))
+
+				recordKinds, err := replay.ParseKinds(stringSliceValue("record"))
+				if err != nil {
+					golog.Printf("invalid recorded kinds: %v", err)
+					os.Exit(1)
+				}
+				options = append(options, dash.WithRecording(recordKinds, recordingFile(), viper.GetDuration("recording-retention")))
+
+				if snapshotFile := viper.GetString("snapshot"); snapshotFile != "" {
+					options = append(options, dash.WithSnapshot(snapshotFile))
+				}
+
+				if telemetryOptions := telemetryOptions(); telemetryOptions.Enabled() {
+					options = append(options, dash.WithTelemetry(telemetryOptions))
+				}
+
 				klogVerbosity := viper.GetString("klog-verbosity")
 				var klogOpts []string
 
@@ -135,9 +222,8 @@ func newOctantCmd(version string, gitCommit string, buildTime string) *cobra.Com
 
 			select {
 			case <-sigCh:
-				logger.Debugf("Shutting dashboard down due to interrupt")
+				logger.Infof("Shutting dashboard down")
 				cancel()
-				// TODO implement graceful shutdown semantics (GH#494)
 
 				<-shutdownCh
 			case <-runCh:
@@ -149,24 +235,53 @@ func newOctantCmd(version string, gitCommit string, buildTime string) *cobra.Com
 	// All flags can also be environment variables by adding the OCTANT_ prefix
 	// and replacing - with _. Example: OCTANT_DISABLE_CLUSTER_OVERVIEW
 	octantCmd.Flags().SortFlags = false
+	octantCmd.Flags().String(configFileKey, "", "path to the config file (default is $HOME/.octant/config.yaml)")
 
-	octantCmd.Flags().StringP("context", "", "", "initial context")
+	octantCmd.Flags().StringP("context", "", "", "initial context (defaults to the current-context in kube config)")
 	octantCmd.Flags().BoolP("disable-cluster-overview", "", false, "disable cluster overview")
 	octantCmd.Flags().BoolP("enable-feature-applications", "", false, "enable applications feature")
-	octantCmd.Flags().String("kubeconfig", "", "absolute path to kubeConfig file")
+	octantCmd.Flags().StringSlice("kubeconfig", []string{}, "absolute path to kubeConfig file (can be repeated or a path list)")
 	octantCmd.Flags().StringP("namespace", "n", "", "initial namespace")
-	octantCmd.Flags().StringSlice("namespace-list", []string{}, "a list of namespaces to use on start")
+	octantCmd.Flags().StringSlice("namespace-list", []string{}, "a list of namespaces to use on start; used as the namespace list when listing namespaces is not permitted")
 	octantCmd.Flags().StringP("plugin-path", "", "", "plugin path")
 	octantCmd.Flags().BoolP("verbose", "v", false, "turn on debug logging")
+	octantCmd.Flags().String("log-level", "", "minimum log level: debug, info, warn, or error (overrides --verbose)")
+	octantCmd.Flags().String("log-format", log.FormatConsole, "log format: console or json")
+	octantCmd.Flags().StringSlice("log-component-level", []string{}, "minimum log level for a component as component=level (can be repeated)")
+	octantCmd.Flags().StringSlice("feature-gates", []string{}, "enable or disable experimental features as Feature=true|false (can be repeated)")
+	octantCmd.Flags().Bool("headless", false, "run without opening a browser and print a JSON ready message with the dashboard URL to stdout")
+	octantCmd.Flags().String("port-forward-state-file", "", "file port forwards are saved to and restored from (default is $HOME/.octant/port-forwards.json)")
+	octantCmd.Flags().Bool("disable-port-forward-restore", false, "do not save port forwards or restore them on startup")
+	octantCmd.Flags().String("preferences-file", "", "file preferences are saved to (default is $HOME/.octant/preferences.json)")
+	octantCmd.Flags().String("webhooks-file", "", "file outbound webhook rules are saved to (default is $HOME/.octant/webhooks.json)")
+	octantCmd.Flags().String("audit-log-file", "", "file mutating actions performed through the dashboard are recorded to (default is $HOME/.octant/audit.log)")
+	octantCmd.Flags().String("alertmanager-url", "", "Alertmanager URL firing alerts are shown from; preferred over --prometheus-url")
+	octantCmd.Flags().String("prometheus-url", "", "Prometheus URL firing alerts are shown from")
+	octantCmd.Flags().Duration("alerts-refresh-interval", alerts.DefaultRefreshInterval, "how often firing alerts are fetched")
+	octantCmd.Flags().StringSlice("kubectl-plugins", []string{}, "kinds installed kubectl plugins run against as plugin=Kind|Kind[:args] (can be repeated); args can use $KIND, $NAME, $NAMESPACE, and $CONTEXT. Requires the KubectlPlugins feature gate")
+	octantCmd.Flags().StringSlice("record", []string{}, "kinds whose changes are recorded as apiVersion/Kind, such as v1/Pod or apps/v1/Deployment (can be repeated); the recording is replayed from Configuration > Recording")
+	octantCmd.Flags().String("recording-file", "", "file recorded changes are saved to (default is $HOME/.octant/recording.jsonl)")
+	octantCmd.Flags().Duration("recording-retention", replay.DefaultRetention, "how long recorded changes are kept")
+	octantCmd.Flags().String("snapshot", "", "snapshot archive created by octant snapshot export whose objects are shown instead of the cluster's; objects can't be changed while a snapshot is shown")
+	octantCmd.Flags().String("locale-dir", "", "directory of catalogs named after their locale, such as pt-BR.json, which translate generated dashboard text; browsers choose a locale with their preferred languages")
+	octantCmd.Flags().String("state-backend", "", "where preferences and port forwards are saved: file, configmap, or secret (default is configmap in a cluster and file otherwise)")
+	octantCmd.Flags().String("state-namespace", "", "namespace of the ConfigMap or Secret state is saved to (default is the namespace Octant runs in)")
+	octantCmd.Flags().String("state-name", persistence.DefaultName, "name of the ConfigMap or Secret state is saved to")
+	octantCmd.Flags().Duration("terminal-idle-timeout", terminal.DefaultIdleTimeout, "stop terminal sessions without input or output for this long; 0 disables the timeout")
+	octantCmd.Flags().Int("terminal-max-sessions", terminal.DefaultMaxSessions, "maximum number of terminal sessions running at the same time; 0 is unlimited")
 	octantCmd.Flags().IntP("client-max-recv-msg-size", "", pconfig.MaxMessageSize, "client max receiver message size")
 
 	octantCmd.Flags().StringP("accepted-hosts", "", "", "accepted hosts list [DEV]")
 	octantCmd.Flags().Float32P("client-qps", "", 200, "maximum QPS for client [DEV]")
 	octantCmd.Flags().IntP("client-burst", "", 400, "maximum burst for client throttle [DEV]")
 	octantCmd.Flags().BoolP("disable-open-browser", "", false, "disable automatic launching of the browser [DEV]")
-	octantCmd.Flags().BoolP("enable-opencensus", "c", false, "enable open census [DEV]")
+	octantCmd.Flags().BoolP("enable-opencensus", "c", false, "send all traces to a Jaeger agent on localhost:6831 [DEV]")
+	octantCmd.Flags().String("telemetry-exporter", "", "trace exporter: jaeger, zipkin, or otlp; traces are not exported if blank")
+	octantCmd.Flags().String("telemetry-endpoint", "", "address traces are sent to; defaults to the exporter's local default")
+	octantCmd.Flags().Float64("telemetry-sample-rate", 1, "fraction of traces to sample, from 0 to 1")
+	octantCmd.Flags().Duration("informer-resync", 180*time.Second, "resync period for informers [DEV]")
 	octantCmd.Flags().IntP("klog-verbosity", "", 0, "klog verbosity level [DEV]")
-	octantCmd.Flags().StringP("listener-addr", "", "", "listener address for the octant frontend [DEV]")
+	octantCmd.Flags().StringP("listener-addr", "", "", "host:port address for the octant frontend; a free port is used if the port is busy [DEV]")
 	octantCmd.Flags().StringP("local-content", "", "", "local content path [DEV]")
 	octantCmd.Flags().StringP("proxy-frontend", "", "", "url to send frontend request to [DEV]")
 	octantCmd.Flags().String("ui-url", "", "dashboard url [DEV]")
@@ -175,10 +290,81 @@ func newOctantCmd(version string, gitCommit string, buildTime string) *cobra.Com
 	return octantCmd
 }
 
+// featureGate creates a feature gate from the feature-gates flag. In a config file,
+// feature gates can also be set as a map of feature names to booleans.
+func featureGate() (*featuregate.FeatureGate, error) {
+	gate := featuregate.New()
+
+	if values, ok := viper.Get("feature-gates").(map[string]interface{}); ok {
+		m := map[string]bool{}
+		for name, value := range values {
+			enabled, ok := value.(bool)
+			if !ok {
+				return nil, fmt.Errorf("feature gate %q must be true or false", name)
+			}
+			m[name] = enabled
+		}
+		return gate, gate.Set(m)
+	}
+
+	return gate, gate.SetFromStrings(stringSliceValue("feature-gates"))
+}
+
+// telemetryOptions converts the telemetry flags into telemetry options.
+func telemetryOptions() telemetry.Options {
+	return telemetry.Options{
+		Exporter:   strings.ToLower(viper.GetString("telemetry-exporter")),
+		Endpoint:   viper.GetString("telemetry-endpoint"),
+		SampleRate: viper.GetFloat64("telemetry-sample-rate"),
+	}
+}
+
+// logInitOptions converts the logging flags into logger options.
+func logInitOptions() ([]log.InitOption, error) {
+	options := []log.InitOption{
+		log.WithFormat(viper.GetString("log-format")),
+	}
+
+	if name := viper.GetString("log-level"); name != "" {
+		level, err := log.ParseLevel(name)
+		if err != nil {
+			return nil, err
+		}
+		options = append(options, log.WithLevel(level))
+	}
+
+	componentLevels, err := log.ParseComponentLevels(stringSliceValue("log-component-level"))
+	if err != nil {
+		return nil, err
+	}
+	if len(componentLevels) > 0 {
+		options = append(options, log.WithComponentLevels(componentLevels))
+	}
+
+	return options, nil
+}
+
+// kubeConfigList merges kube config values into a single path list. Each value
+// can be a single path or a path list (as found in KUBECONFIG). Empty entries and
+// duplicates are removed while preserving the order in which paths were supplied,
+// so the first file wins when kube configs are merged, matching kubectl.
+func kubeConfigList(values []string) string {
+	var paths []string
+	for _, value := range values {
+		for _, path := range filepath.SplitList(value) {
+			if path = strings.TrimSpace(path); path != "" {
+				paths = append(paths, path)
+			}
+		}
+	}
+
+	return strings.Join(dashstrings.Deduplicate(paths), string(filepath.ListSeparator))
+}
+
 func bindViper(cmd *cobra.Command) error {
 	replacer := strings.NewReplacer("-", "_")
 	viper.SetEnvKeyReplacer(replacer)
-	viper.SetEnvPrefix("OCTANT")
+	viper.SetEnvPrefix(envPrefix)
 	viper.AutomaticEnv()
 
 	if err := viper.BindPFlags(cmd.Flags()); err != nil {
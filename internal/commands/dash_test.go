@@ -7,12 +7,16 @@ package commands
 
 import (
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/octant/internal/featuregate"
+	"github.com/vmware-tanzu/octant/internal/telemetry"
 )
 
 func Test_bindViper_KUBECONFIG(t *testing.T) {
@@ -33,3 +37,124 @@ func Test_bindViper_KUBECONFIG(t *testing.T) {
 	actual = viper.GetString("kubeconfig")
 	assert.Equal(t, expected, actual)
 }
+
+func Test_kubeConfigList(t *testing.T) {
+	sep := string(filepath.ListSeparator)
+
+	cases := []struct {
+		name     string
+		values   []string
+		expected string
+	}{
+		{
+			name:     "empty",
+			expected: "",
+		},
+		{
+			name:     "single path",
+			values:   []string{"/a"},
+			expected: "/a",
+		},
+		{
+			name:     "repeated flags",
+			values:   []string{"/a", "/b"},
+			expected: "/a" + sep + "/b",
+		},
+		{
+			name:     "path lists are expanded and deduplicated",
+			values:   []string{"/a" + sep + "/b", "/b" + sep + sep + "/c"},
+			expected: "/a" + sep + "/b" + sep + "/c",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, kubeConfigList(tc.values))
+		})
+	}
+}
+
+func Test_bindViper_repeatedKubeconfig(t *testing.T) {
+	defer viper.Reset()
+
+	cmd := &cobra.Command{}
+	cmd.Flags().StringSlice("kubeconfig", []string{}, "")
+	require.NoError(t, cmd.Flags().Parse([]string{"--kubeconfig", "/a", "--kubeconfig", "/b"}))
+	require.NoError(t, bindViper(cmd))
+
+	sep := string(filepath.ListSeparator)
+	assert.Equal(t, "/a"+sep+"/b", kubeConfigList(viper.GetStringSlice("kubeconfig")))
+}
+
+func Test_bindViper_KUBECONFIG_list(t *testing.T) {
+	defer viper.Reset()
+
+	sep := string(filepath.ListSeparator)
+	os.Setenv("KUBECONFIG", "/a"+sep+"/b")
+	defer os.Unsetenv("KUBECONFIG")
+
+	cmd := &cobra.Command{}
+	cmd.Flags().StringSlice("kubeconfig", []string{}, "")
+	require.NoError(t, bindViper(cmd))
+
+	assert.Equal(t, "/a"+sep+"/b", kubeConfigList(viper.GetStringSlice("kubeconfig")))
+}
+
+func Test_logInitOptions(t *testing.T) {
+	defer viper.Reset()
+
+	viper.Set("log-format", "json")
+	viper.Set("log-level", "warn")
+	viper.Set("log-component-level", []string{"api=debug"})
+
+	options, err := logInitOptions()
+	require.NoError(t, err)
+	assert.Len(t, options, 3)
+
+	viper.Set("log-level", "loud")
+	_, err = logInitOptions()
+	require.Error(t, err)
+
+	viper.Set("log-level", "")
+	viper.Set("log-component-level", []string{"api"})
+	_, err = logInitOptions()
+	require.Error(t, err)
+}
+
+func Test_telemetryOptions(t *testing.T) {
+	defer viper.Reset()
+
+	assert.False(t, telemetryOptions().Enabled())
+
+	viper.Set("telemetry-exporter", "OTLP")
+	viper.Set("telemetry-endpoint", "http://collector:4318/v1/traces")
+	viper.Set("telemetry-sample-rate", 0.25)
+
+	expected := telemetry.Options{
+		Exporter:   telemetry.ExporterOTLP,
+		Endpoint:   "http://collector:4318/v1/traces",
+		SampleRate: 0.25,
+	}
+	assert.Equal(t, expected, telemetryOptions())
+}
+
+func Test_featureGate(t *testing.T) {
+	defer viper.Reset()
+
+	viper.Set("feature-gates", []string{"MultiCluster=true", "Exec=false"})
+	gate, err := featureGate()
+	require.NoError(t, err)
+	assert.True(t, gate.Enabled(featuregate.MultiCluster))
+	assert.False(t, gate.Enabled(featuregate.Exec))
+
+	// config files can set gates as a map
+	viper.Set("feature-gates", map[string]interface{}{"javascriptplugins": false})
+	gate, err = featureGate()
+	require.NoError(t, err)
+	assert.False(t, gate.Enabled(featuregate.JavaScriptPlugins))
+	assert.True(t, gate.Enabled(featuregate.Exec))
+
+	viper.Set("feature-gates", []string{"Teleport=true"})
+	_, err = featureGate()
+	require.Error(t, err)
+}
@@ -0,0 +1,96 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/vmware-tanzu/octant/internal/doctor"
+	"github.com/vmware-tanzu/octant/pkg/plugin"
+)
+
+func newDoctorCmd() *cobra.Command {
+	var (
+		flags     clusterFlags
+		namespace string
+		output    string
+	)
+
+	doctorCmd := &cobra.Command{
+		Use:   "doctor",
+		Short: "Diagnose problems running octant",
+		Long:  "Check kube config, cluster connectivity, permissions, metrics-server and plugins, and suggest fixes for problems found",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			if err := bindViper(cmd); err != nil {
+				return err
+			}
+
+			ctx, cancel := context.WithCancel(cmd.Context())
+			defer cancel()
+
+			var findings doctor.Findings
+
+			manager, err := flags.contextManager(ctx)
+			if err != nil {
+				findings = append(findings, doctor.Finding{
+					Check:   "kube config",
+					Status:  doctor.StatusError,
+					Message: err.Error(),
+					Remedy:  "set --kubeconfig or KUBECONFIG to a valid kube config file",
+				})
+			} else {
+				client := manager.ClusterClient()
+				defer client.Close()
+
+				findings = append(findings, doctor.Finding{
+					Check:   "kube config",
+					Status:  doctor.StatusOK,
+					Message: fmt.Sprintf("using context %q from %s", manager.CurrentContext(), flags.kubeConfigList()),
+				})
+
+				if namespace == "" {
+					namespace = client.DefaultNamespace()
+				}
+
+				findings = append(findings, doctor.CheckCluster(ctx, client, namespace)...)
+			}
+
+			findings = append(findings, doctor.CheckPlugins(ctx, plugin.DefaultConfig, plugin.NewDefaultClientFactory())...)
+
+			switch output {
+			case "":
+				findings.Print(cmd.OutOrStdout())
+			case "json":
+				encoder := json.NewEncoder(cmd.OutOrStdout())
+				encoder.SetIndent("", "  ")
+				if err := encoder.Encode(findings); err != nil {
+					return err
+				}
+			default:
+				return fmt.Errorf("unsupported output format %q", output)
+			}
+
+			if findings.HasErrors() {
+				return fmt.Errorf("doctor found problems")
+			}
+
+			return nil
+		},
+	}
+
+	flags.addFlags(doctorCmd)
+	doctorCmd.Flags().StringVarP(&namespace, "namespace", "n", "", "namespace to check permissions in (defaults to the context's namespace)")
+	doctorCmd.Flags().StringP("plugin-path", "", "", "plugin path")
+	doctorCmd.Flags().StringVarP(&output, "output", "o", "", "output format. One of: json")
+
+	return doctorCmd
+}
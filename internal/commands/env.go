@@ -0,0 +1,49 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package commands
+
+import (
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+// envPrefix is the prefix of environment variables which set flags. A flag named
+// listener-addr is set by OCTANT_LISTENER_ADDR.
+const envPrefix = "OCTANT"
+
+// envHelp describes how flags can be set with environment variables.
+const envHelp = `Every flag can also be set with an environment variable named after the flag,
+prefixed with OCTANT_ and with dashes replaced by underscores, e.g. OCTANT_LISTENER_ADDR
+sets --listener-addr. List flags such as --namespace-list accept comma separated values.
+Flags take precedence over environment variables, which take precedence over the config
+file. KUBECONFIG is used if neither --kubeconfig nor OCTANT_KUBECONFIG are set.`
+
+// stringSliceValue returns the value of a list setting. Lists set by environment
+// variables are split on commas instead of viper's default of whitespace.
+func stringSliceValue(key string) []string {
+	s, ok := viper.Get(key).(string)
+	if !ok {
+		return viper.GetStringSlice(key)
+	}
+
+	var list []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
+
+// kubeConfigValue returns the kube config setting. A kube config set by an environment
+// variable is a path list and is not split since paths can contain spaces.
+func kubeConfigValue() []string {
+	if s, ok := viper.Get("kubeconfig").(string); ok {
+		return []string{s}
+	}
+	return viper.GetStringSlice("kubeconfig")
+}
@@ -0,0 +1,81 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package commands
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setEnv(t *testing.T, env map[string]string) {
+	for k, v := range env {
+		require.NoError(t, os.Setenv(k, v))
+	}
+	t.Cleanup(func() {
+		for k := range env {
+			os.Unsetenv(k)
+		}
+	})
+}
+
+func Test_bindViper_env(t *testing.T) {
+	defer viper.Reset()
+
+	setEnv(t, map[string]string{
+		"OCTANT_LISTENER_ADDR":        "0.0.0.0:7777",
+		"OCTANT_PLUGIN_PATH":          "/plugins",
+		"OCTANT_LOG_LEVEL":            "debug",
+		"OCTANT_DISABLE_OPEN_BROWSER": "true",
+		"OCTANT_NAMESPACE_LIST":       "default, kube-system",
+		"OCTANT_LOG_COMPONENT_LEVEL":  "api=debug,objectstore=warn",
+		"OCTANT_INFORMER_RESYNC":      "1m",
+	})
+
+	cmd := newOctantCmd("", "", "")
+	require.NoError(t, bindViper(cmd))
+
+	assert.Equal(t, "0.0.0.0:7777", viper.GetString("listener-addr"))
+	assert.Equal(t, "/plugins", viper.GetString("plugin-path"))
+	assert.Equal(t, "debug", viper.GetString("log-level"))
+	assert.True(t, viper.GetBool("disable-open-browser"))
+	assert.Equal(t, []string{"default", "kube-system"}, stringSliceValue("namespace-list"))
+	assert.Equal(t, []string{"api=debug", "objectstore=warn"}, stringSliceValue("log-component-level"))
+	assert.Equal(t, "1m0s", viper.GetDuration("informer-resync").String())
+}
+
+func Test_bindViper_env_flagsTakePrecedence(t *testing.T) {
+	defer viper.Reset()
+
+	setEnv(t, map[string]string{
+		"OCTANT_NAMESPACE_LIST": "default",
+	})
+
+	cmd := newOctantCmd("", "", "")
+	require.NoError(t, cmd.Flags().Parse([]string{"--namespace-list", "a,b"}))
+	require.NoError(t, bindViper(cmd))
+
+	assert.Equal(t, []string{"a", "b"}, stringSliceValue("namespace-list"))
+}
+
+func Test_kubeConfigValue(t *testing.T) {
+	defer viper.Reset()
+
+	sep := string(filepath.ListSeparator)
+	setEnv(t, map[string]string{
+		"KUBECONFIG":        "/ignored",
+		"OCTANT_KUBECONFIG": "/home/my user/config" + sep + "/b",
+	})
+
+	cmd := newOctantCmd("", "", "")
+	require.NoError(t, bindViper(cmd))
+
+	assert.Equal(t, "/home/my user/config"+sep+"/b", kubeConfigList(kubeConfigValue()))
+}
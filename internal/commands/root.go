@@ -27,7 +27,11 @@ func Execute(version string, gitCommit string, buildTime string) {
 
 func newRoot(version string, gitCommit string, buildTime string) *cobra.Command {
 	rootCmd := newOctantCmd(version, gitCommit, buildTime)
+	// errors are printed by Execute
+	rootCmd.SilenceErrors = true
 	rootCmd.AddCommand(newVersionCmd(version, gitCommit, buildTime))
+	rootCmd.AddCommand(newSnapshotCmd())
+	rootCmd.AddCommand(newDoctorCmd())
 
 	return rootCmd
 }
@@ -0,0 +1,104 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/vmware-tanzu/octant/internal/snapshot"
+)
+
+func newSnapshotCmd() *cobra.Command {
+	snapshotCmd := &cobra.Command{
+		Use:   "snapshot",
+		Short: "Work with cluster snapshots",
+		Long:  "Snapshots are archives of cluster objects which can be analyzed offline or attached to support requests",
+	}
+
+	snapshotCmd.AddCommand(newSnapshotExportCmd())
+
+	return snapshotCmd
+}
+
+func newSnapshotExportCmd() *cobra.Command {
+	var (
+		flags          clusterFlags
+		output         string
+		namespaces     []string
+		kinds          []string
+		includeSecrets bool
+	)
+
+	exportCmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export objects from a cluster into a snapshot archive",
+		Long:  "Export all objects the current user can list into a snapshot archive, optionally filtered by namespace and kind",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			if err := bindViper(cmd); err != nil {
+				return err
+			}
+
+			ctx, cancel := context.WithCancel(cmd.Context())
+			defer cancel()
+
+			client, contextName, err := flags.clusterClient(ctx)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			options := []snapshot.ExportOption{
+				snapshot.WithContextName(contextName),
+				snapshot.WithNamespaces(namespaces...),
+				snapshot.WithKinds(kinds...),
+			}
+			if includeSecrets {
+				options = append(options, snapshot.WithSecrets())
+			}
+
+			s, err := snapshot.Export(ctx, client, options...)
+			if err != nil {
+				return err
+			}
+
+			var out io.Writer = cmd.OutOrStdout()
+			if output != "-" {
+				f, err := os.OpenFile(output, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+				if err != nil {
+					return fmt.Errorf("create snapshot file: %w", err)
+				}
+				defer f.Close()
+				out = f
+			}
+
+			if err := snapshot.Write(out, s); err != nil {
+				return err
+			}
+
+			if output != "-" {
+				fmt.Fprintf(cmd.ErrOrStderr(), "Exported %d objects to %s (%d resources skipped)\n",
+					s.Len(), output, len(s.Manifest.Skipped))
+			}
+
+			return nil
+		},
+	}
+
+	flags.addFlags(exportCmd)
+	exportCmd.Flags().StringVarP(&output, "output", "o", "octant-snapshot.tar.gz", "file to write the snapshot to, or - for stdout")
+	exportCmd.Flags().StringSliceVarP(&namespaces, "namespace", "n", []string{}, "namespaces to export (can be repeated, defaults to all namespaces and cluster scoped objects)")
+	exportCmd.Flags().StringSliceVar(&kinds, "kinds", []string{}, "kinds to export by kind, resource, or short name (defaults to all kinds)")
+	exportCmd.Flags().BoolVar(&includeSecrets, "include-secrets", false, "include secrets in the snapshot")
+
+	return exportCmd
+}
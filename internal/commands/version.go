@@ -6,24 +6,45 @@ SPDX-License-Identifier: Apache-2.0
 package commands
 
 import (
+	"encoding/json"
 	"fmt"
+	"io"
 
 	"github.com/spf13/cobra"
+
+	"github.com/vmware-tanzu/octant/internal/api"
 )
 
 func newVersionCmd(version string, gitCommit string, buildTime string) *cobra.Command {
+	var output string
 
 	versionCmd := &cobra.Command{
 		Use:   "version",
 		Short: "Show version",
 		Long:  "Version for octant binary",
-		Run: func(cmd *cobra.Command, args []string) {
-			out := cmd.OutOrStdout()
-
-			fmt.Fprintln(out, "Version: ", version)
-			fmt.Fprintln(out, "Git commit: ", gitCommit)
-			fmt.Fprintln(out, "Built: ", buildTime)
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return writeVersion(cmd.OutOrStdout(), output, api.NewVersionResponse(version, gitCommit, buildTime))
 		},
 	}
+
+	versionCmd.Flags().StringVarP(&output, "output", "o", "", "output format. One of: json")
+
 	return versionCmd
 }
+
+func writeVersion(out io.Writer, output string, resp api.VersionResponse) error {
+	switch output {
+	case "":
+		fmt.Fprintln(out, "Version: ", resp.Version)
+		fmt.Fprintln(out, "Git commit: ", resp.Commit)
+		fmt.Fprintln(out, "Built: ", resp.BuildTime)
+		fmt.Fprintln(out, "Plugin API version: ", resp.PluginAPIVersion)
+		return nil
+	case "json":
+		encoder := json.NewEncoder(out)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(resp)
+	default:
+		return fmt.Errorf("unsupported output format %q", output)
+	}
+}
@@ -0,0 +1,47 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package commands
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/octant/internal/api"
+)
+
+func Test_newVersionCmd(t *testing.T) {
+	cmd := newVersionCmd("0.16.0", "abc123", "2020-10-01T00:00:00Z")
+
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetArgs([]string{})
+	require.NoError(t, cmd.Execute())
+
+	assert.Contains(t, out.String(), "Version:  0.16.0")
+	assert.Contains(t, out.String(), "Git commit:  abc123")
+	assert.Contains(t, out.String(), "Plugin API version:  1")
+}
+
+func Test_newVersionCmd_json(t *testing.T) {
+	cmd := newVersionCmd("0.16.0", "abc123", "2020-10-01T00:00:00Z")
+
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetArgs([]string{"-o", "json"})
+	require.NoError(t, cmd.Execute())
+
+	var got api.VersionResponse
+	require.NoError(t, json.Unmarshal(out.Bytes(), &got))
+	assert.Equal(t, api.NewVersionResponse("0.16.0", "abc123", "2020-10-01T00:00:00Z"), got)
+}
+
+func Test_writeVersion_unsupported(t *testing.T) {
+	require.Error(t, writeVersion(&bytes.Buffer{}, "yaml", api.VersionResponse{}))
+}
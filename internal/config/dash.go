@@ -9,17 +9,28 @@ import (
 	"context"
 
 	"github.com/vmware-tanzu/octant/internal/kubeconfig"
+	"github.com/vmware-tanzu/octant/internal/kubectlplugin"
 	"github.com/vmware-tanzu/octant/internal/octant"
 	"github.com/vmware-tanzu/octant/pkg/store"
 
+	openapi_v2 "github.com/googleapis/gnostic/openapiv2"
 	"github.com/pkg/errors"
 	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
+	"github.com/vmware-tanzu/octant/internal/alerts"
+	"github.com/vmware-tanzu/octant/internal/audit"
 	"github.com/vmware-tanzu/octant/internal/cluster"
 	internalErr "github.com/vmware-tanzu/octant/internal/errors"
+	"github.com/vmware-tanzu/octant/internal/explain"
+	"github.com/vmware-tanzu/octant/internal/featuregate"
+	"github.com/vmware-tanzu/octant/internal/i18n"
 	"github.com/vmware-tanzu/octant/internal/module"
+	"github.com/vmware-tanzu/octant/internal/notification"
 	"github.com/vmware-tanzu/octant/internal/portforward"
+	"github.com/vmware-tanzu/octant/internal/preferences"
+	"github.com/vmware-tanzu/octant/internal/replay"
+	"github.com/vmware-tanzu/octant/internal/terminal"
 	"github.com/vmware-tanzu/octant/pkg/log"
 	"github.com/vmware-tanzu/octant/pkg/plugin"
 )
@@ -37,6 +48,7 @@ type CRDWatcher interface {
 type KubeContextDecorator interface {
 	SwitchContext(context.Context, string) error
 	ClusterClient() cluster.ClientInterface
+	ClusterClientForContext(context.Context, string) (cluster.ClientInterface, error)
 	CurrentContext() string
 	Contexts() []kubeconfig.Context
 }
@@ -58,6 +70,12 @@ func (scc *staticClusterClient) SwitchContext(
 func (scc *staticClusterClient) ClusterClient() cluster.ClientInterface {
 	return scc.ClientInterface
 }
+func (scc *staticClusterClient) ClusterClientForContext(
+	ctx context.Context,
+	contextName string,
+) (cluster.ClientInterface, error) {
+	return nil, errors.Errorf("context %q is not available: the cluster client is static", contextName)
+}
 func (scc *staticClusterClient) CurrentContext() string {
 	return ""
 }
@@ -127,6 +145,37 @@ type Dash interface {
 
 	PortForwarder() portforward.PortForwarder
 
+	TerminalManager() terminal.Manager
+
+	Notifier() notification.Notifier
+
+	// Webhooks returns the manager of outbound webhook rules.
+	Webhooks() notification.WebhookManager
+
+	Explainer() explain.Explainer
+
+	AuditLog() audit.Log
+
+	// Auditor records changes made outside of action dispatchers to the audit log.
+	Auditor() *audit.Auditor
+
+	// Alerts returns the provider of firing alerts. It is nil if no alert
+	// source is configured.
+	Alerts() alerts.Provider
+
+	// KubectlPlugins returns the manager which runs kubectl plugins against
+	// objects. It is nil if kubectl plugins are disabled.
+	KubectlPlugins() kubectlplugin.Manager
+
+	// Translator returns the translator of generated content text.
+	Translator() i18n.Translator
+
+	// Replay returns the manager which records and replays changes to objects.
+	// It is nil if no kinds are recorded.
+	Replay() replay.Manager
+
+	Preferences() preferences.Manager
+
 	SetContextChosenInUI(contextChosen bool)
 
 	UseFSContext(ctx context.Context) error
@@ -137,6 +186,8 @@ type Dash interface {
 
 	Contexts() []kubeconfig.Context
 
+	ClusterClientForContext(ctx context.Context, contextName string) (cluster.ClientInterface, error)
+
 	DefaultNamespace() string
 
 	Validate() error
@@ -144,6 +195,8 @@ type Dash interface {
 	ModuleManager() module.ManagerInterface
 
 	BuildInfo() (string, string, string)
+
+	FeatureGate() featuregate.Gate
 }
 
 // UseFSContext is used to indicate a context switch to the file system Kubeconfig context
@@ -159,8 +212,19 @@ type Live struct {
 	errorStore           internalErr.ErrorStore
 	pluginManager        plugin.ManagerInterface
 	portForwarder        portforward.PortForwarder
+	terminalManager      terminal.Manager
+	notifier             notification.Notifier
+	webhooks             notification.WebhookManager
+	explainer            explain.Explainer
+	auditor              *audit.Auditor
+	alerts               alerts.Provider
+	kubectlPlugins       kubectlplugin.Manager
+	translator           i18n.Translator
+	replay               replay.Manager
+	preferences          preferences.Manager
 	restConfigOptions    cluster.RESTConfigOptions
 	buildInfo            BuildInfo
+	featureGate          featuregate.Gate
 	contextChosenInUI    bool
 }
 
@@ -176,10 +240,39 @@ func NewLiveConfig(
 	errorStore internalErr.ErrorStore,
 	pluginManager plugin.ManagerInterface,
 	portForwarder portforward.PortForwarder,
+	terminalManager terminal.Manager,
+	notifier notification.Notifier,
+	webhooks notification.WebhookManager,
+	preferencesManager preferences.Manager,
+	auditor *audit.Auditor,
+	alertProvider alerts.Provider,
+	kubectlPlugins kubectlplugin.Manager,
+	translator i18n.Translator,
+	replayManager replay.Manager,
 	restConfigOptions cluster.RESTConfigOptions,
 	buildInfo BuildInfo,
+	featureGate featuregate.Gate,
 	contextChosenInUI bool,
 ) *Live {
+	if featureGate == nil {
+		featureGate = featuregate.New()
+	}
+	if terminalManager == nil {
+		terminalManager = terminal.NewManager(context.Background())
+	}
+	if notifier == nil {
+		notifier = notification.NewService(context.Background(), nil)
+	}
+	if webhooks == nil {
+		webhooks = notification.NewWebhooks(context.Background(), nil, nil)
+	}
+	if preferencesManager == nil {
+		preferencesManager = preferences.NewService(nil)
+	}
+	if translator == nil {
+		translator = i18n.NewCatalogs(i18n.BuiltinCatalogs)
+	}
+
 	l := &Live{
 		kubeContextDecorator: kubeContextDecorator,
 		crdWatcher:           crdWatcher,
@@ -189,10 +282,24 @@ func NewLiveConfig(
 		errorStore:           errorStore,
 		pluginManager:        pluginManager,
 		portForwarder:        portForwarder,
+		terminalManager:      terminalManager,
+		notifier:             notifier,
+		webhooks:             webhooks,
+		preferences:          preferencesManager,
+		auditor:              auditor,
+		alerts:               alertProvider,
+		kubectlPlugins:       kubectlPlugins,
+		translator:           translator,
+		replay:               replayManager,
 		restConfigOptions:    restConfigOptions,
 		buildInfo:            buildInfo,
+		featureGate:          featureGate,
 		contextChosenInUI:    contextChosenInUI,
 	}
+	l.explainer = explain.NewService(l.openAPISchema)
+	if l.auditor == nil {
+		l.auditor = audit.NewAuditor(audit.NewMemoryLog(), l.ClusterClient, l.CurrentContext)
+	}
 	objectStore.RegisterOnUpdate(func(store store.Store) {
 		l.objectStore = store
 	})
@@ -240,6 +347,70 @@ func (l *Live) PortForwarder() portforward.PortForwarder {
 	return l.portForwarder
 }
 
+// TerminalManager returns the terminal session manager.
+func (l *Live) TerminalManager() terminal.Manager {
+	return l.terminalManager
+}
+
+// Notifier returns the resource change notifier.
+func (l *Live) Notifier() notification.Notifier {
+	return l.notifier
+}
+
+// Webhooks returns the manager of outbound webhook rules.
+func (l *Live) Webhooks() notification.WebhookManager {
+	return l.webhooks
+}
+
+// Explainer returns the field documentation service.
+func (l *Live) Explainer() explain.Explainer {
+	return l.explainer
+}
+
+// AuditLog returns the log of mutating actions.
+func (l *Live) AuditLog() audit.Log {
+	return l.auditor.Log()
+}
+
+// Auditor returns the auditor of changes made outside of action dispatchers.
+func (l *Live) Auditor() *audit.Auditor {
+	return l.auditor
+}
+
+// Alerts returns the provider of firing alerts.
+func (l *Live) Alerts() alerts.Provider {
+	return l.alerts
+}
+
+// KubectlPlugins returns the manager which runs kubectl plugins.
+func (l *Live) KubectlPlugins() kubectlplugin.Manager {
+	return l.kubectlPlugins
+}
+
+// Translator returns the translator of generated content text.
+func (l *Live) Translator() i18n.Translator {
+	return l.translator
+}
+
+// Replay returns the manager which records and replays changes to objects.
+func (l *Live) Replay() replay.Manager {
+	return l.replay
+}
+
+// openAPISchema loads the OpenAPI schema of the current cluster.
+func (l *Live) openAPISchema() (*openapi_v2.Document, error) {
+	discoveryClient, err := l.ClusterClient().DiscoveryClient()
+	if err != nil {
+		return nil, err
+	}
+	return discoveryClient.OpenAPISchema()
+}
+
+// Preferences returns the user's preferences.
+func (l *Live) Preferences() preferences.Manager {
+	return l.preferences
+}
+
 func (l *Live) SetContextChosenInUI(contextChosen bool) {
 	l.contextChosenInUI = contextChosen
 }
@@ -268,6 +439,8 @@ func (l *Live) UseContext(ctx context.Context, contextName string) error {
 		return err
 	}
 
+	l.explainer.Reset()
+
 	l.Logger().With("new-kube-context", contextName).Infof("updated kube config context")
 
 	for _, m := range l.moduleManager.Modules() {
@@ -286,6 +459,12 @@ func (l *Live) CurrentContext() string {
 	return l.kubeContextDecorator.CurrentContext()
 }
 
+// ClusterClientForContext creates a cluster client for a context without switching
+// to it. The client should be closed when it is no longer needed.
+func (l *Live) ClusterClientForContext(ctx context.Context, contextName string) (cluster.ClientInterface, error) {
+	return l.kubeContextDecorator.ClusterClientForContext(ctx, contextName)
+}
+
 // Contexts returns the set of all contexts
 func (l *Live) Contexts() []kubeconfig.Context {
 	return l.kubeContextDecorator.Contexts()
@@ -337,3 +516,8 @@ func (l *Live) ModuleManager() module.ManagerInterface {
 func (l *Live) BuildInfo() (string, string, string) {
 	return l.buildInfo.Version, l.buildInfo.Commit, l.buildInfo.Time
 }
+
+// FeatureGate returns the feature gate.
+func (l *Live) FeatureGate() featuregate.Gate {
+	return l.featureGate
+}
@@ -20,6 +20,7 @@ import (
 	clusterFake "github.com/vmware-tanzu/octant/internal/cluster/fake"
 	configFake "github.com/vmware-tanzu/octant/internal/config/fake"
 	internalErr "github.com/vmware-tanzu/octant/internal/errors"
+	"github.com/vmware-tanzu/octant/internal/featuregate"
 	"github.com/vmware-tanzu/octant/internal/log"
 	"github.com/vmware-tanzu/octant/internal/module"
 	moduleFake "github.com/vmware-tanzu/octant/internal/module/fake"
@@ -105,8 +106,18 @@ func TestLiveConfig(t *testing.T) {
 		errorStore,
 		pluginManager,
 		portForwarder,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
 		restConfigOptions,
 		buildInfo,
+		nil,
 		false,
 	)
 
@@ -117,6 +128,7 @@ func TestLiveConfig(t *testing.T) {
 	assert.Equal(t, objectStore, config.ObjectStore())
 	assert.Equal(t, pluginManager, config.PluginManager())
 	assert.Equal(t, portForwarder, config.PortForwarder())
+	assert.True(t, config.FeatureGate().Enabled(featuregate.Exec))
 
 	objectPath, err := config.ObjectPath("", "", "", "")
 	require.NoError(t, err)
@@ -156,8 +168,18 @@ func TestLiveConfig_UseContext_WithContextChosenByUISetToTrue(t *testing.T) {
 		errorStore,
 		pluginManager,
 		portForwarder,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
 		restConfigOptions,
 		buildInfo,
+		nil,
 		true, // contextChosenInUI
 	)
 
@@ -216,8 +238,18 @@ func TestLiveConfig_UseContext_WithContextChosenByUISetToFalse(t *testing.T) {
 		errorStore,
 		pluginManager,
 		portForwarder,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
 		restConfigOptions,
 		buildInfo,
+		nil,
 		false, // contextChosenInUI
 	)
 
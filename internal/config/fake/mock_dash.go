@@ -10,12 +10,22 @@ import (
 
 	gomock "github.com/golang/mock/gomock"
 
+	alerts "github.com/vmware-tanzu/octant/internal/alerts"
+	audit "github.com/vmware-tanzu/octant/internal/audit"
 	cluster "github.com/vmware-tanzu/octant/internal/cluster"
 	config "github.com/vmware-tanzu/octant/internal/config"
 	errors "github.com/vmware-tanzu/octant/internal/errors"
+	explain "github.com/vmware-tanzu/octant/internal/explain"
+	featuregate "github.com/vmware-tanzu/octant/internal/featuregate"
+	i18n "github.com/vmware-tanzu/octant/internal/i18n"
 	kubeconfig "github.com/vmware-tanzu/octant/internal/kubeconfig"
+	kubectlplugin "github.com/vmware-tanzu/octant/internal/kubectlplugin"
 	module "github.com/vmware-tanzu/octant/internal/module"
+	notification "github.com/vmware-tanzu/octant/internal/notification"
 	portforward "github.com/vmware-tanzu/octant/internal/portforward"
+	preferences "github.com/vmware-tanzu/octant/internal/preferences"
+	replay "github.com/vmware-tanzu/octant/internal/replay"
+	terminal "github.com/vmware-tanzu/octant/internal/terminal"
 	log "github.com/vmware-tanzu/octant/pkg/log"
 	plugin "github.com/vmware-tanzu/octant/pkg/plugin"
 	store "github.com/vmware-tanzu/octant/pkg/store"
@@ -88,6 +98,21 @@ func (mr *MockDashMockRecorder) ClusterClient() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClusterClient", reflect.TypeOf((*MockDash)(nil).ClusterClient))
 }
 
+// ClusterClientForContext mocks base method
+func (m *MockDash) ClusterClientForContext(arg0 context.Context, arg1 string) (cluster.ClientInterface, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClusterClientForContext", arg0, arg1)
+	ret0, _ := ret[0].(cluster.ClientInterface)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClusterClientForContext indicates an expected call of ClusterClientForContext
+func (mr *MockDashMockRecorder) ClusterClientForContext(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClusterClientForContext", reflect.TypeOf((*MockDash)(nil).ClusterClientForContext), arg0, arg1)
+}
+
 // Contexts mocks base method
 func (m *MockDash) Contexts() []kubeconfig.Context {
 	m.ctrl.T.Helper()
@@ -144,6 +169,90 @@ func (mr *MockDashMockRecorder) ErrorStore() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ErrorStore", reflect.TypeOf((*MockDash)(nil).ErrorStore))
 }
 
+// Alerts mocks base method
+func (m *MockDash) Alerts() alerts.Provider {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Alerts")
+	ret0, _ := ret[0].(alerts.Provider)
+	return ret0
+}
+
+// Alerts indicates an expected call of Alerts
+func (mr *MockDashMockRecorder) Alerts() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Alerts", reflect.TypeOf((*MockDash)(nil).Alerts))
+}
+
+// AuditLog mocks base method
+func (m *MockDash) AuditLog() audit.Log {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuditLog")
+	ret0, _ := ret[0].(audit.Log)
+	return ret0
+}
+
+// AuditLog indicates an expected call of AuditLog
+func (mr *MockDashMockRecorder) AuditLog() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuditLog", reflect.TypeOf((*MockDash)(nil).AuditLog))
+}
+
+// Auditor mocks base method
+func (m *MockDash) Auditor() *audit.Auditor {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Auditor")
+	ret0, _ := ret[0].(*audit.Auditor)
+	return ret0
+}
+
+// Auditor indicates an expected call of Auditor
+func (mr *MockDashMockRecorder) Auditor() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Auditor", reflect.TypeOf((*MockDash)(nil).Auditor))
+}
+
+// Explainer mocks base method
+func (m *MockDash) Explainer() explain.Explainer {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Explainer")
+	ret0, _ := ret[0].(explain.Explainer)
+	return ret0
+}
+
+// Explainer indicates an expected call of Explainer
+func (mr *MockDashMockRecorder) Explainer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Explainer", reflect.TypeOf((*MockDash)(nil).Explainer))
+}
+
+// FeatureGate mocks base method
+func (m *MockDash) FeatureGate() featuregate.Gate {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FeatureGate")
+	ret0, _ := ret[0].(featuregate.Gate)
+	return ret0
+}
+
+// FeatureGate indicates an expected call of FeatureGate
+func (mr *MockDashMockRecorder) FeatureGate() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FeatureGate", reflect.TypeOf((*MockDash)(nil).FeatureGate))
+}
+
+// KubectlPlugins mocks base method
+func (m *MockDash) KubectlPlugins() kubectlplugin.Manager {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "KubectlPlugins")
+	ret0, _ := ret[0].(kubectlplugin.Manager)
+	return ret0
+}
+
+// KubectlPlugins indicates an expected call of KubectlPlugins
+func (mr *MockDashMockRecorder) KubectlPlugins() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "KubectlPlugins", reflect.TypeOf((*MockDash)(nil).KubectlPlugins))
+}
+
 // Logger mocks base method
 func (m *MockDash) Logger() log.Logger {
 	m.ctrl.T.Helper()
@@ -172,6 +281,34 @@ func (mr *MockDashMockRecorder) ModuleManager() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModuleManager", reflect.TypeOf((*MockDash)(nil).ModuleManager))
 }
 
+// Notifier mocks base method
+func (m *MockDash) Notifier() notification.Notifier {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Notifier")
+	ret0, _ := ret[0].(notification.Notifier)
+	return ret0
+}
+
+// Notifier indicates an expected call of Notifier
+func (mr *MockDashMockRecorder) Notifier() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Notifier", reflect.TypeOf((*MockDash)(nil).Notifier))
+}
+
+// Webhooks mocks base method
+func (m *MockDash) Webhooks() notification.WebhookManager {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Webhooks")
+	ret0, _ := ret[0].(notification.WebhookManager)
+	return ret0
+}
+
+// Webhooks indicates an expected call of Webhooks
+func (mr *MockDashMockRecorder) Webhooks() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Webhooks", reflect.TypeOf((*MockDash)(nil).Webhooks))
+}
+
 // ObjectPath mocks base method
 func (m *MockDash) ObjectPath(arg0, arg1, arg2, arg3 string) (string, error) {
 	m.ctrl.T.Helper()
@@ -229,6 +366,34 @@ func (mr *MockDashMockRecorder) PortForwarder() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PortForwarder", reflect.TypeOf((*MockDash)(nil).PortForwarder))
 }
 
+// Preferences mocks base method
+func (m *MockDash) Preferences() preferences.Manager {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Preferences")
+	ret0, _ := ret[0].(preferences.Manager)
+	return ret0
+}
+
+// Preferences indicates an expected call of Preferences
+func (mr *MockDashMockRecorder) Preferences() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Preferences", reflect.TypeOf((*MockDash)(nil).Preferences))
+}
+
+// Replay mocks base method
+func (m *MockDash) Replay() replay.Manager {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Replay")
+	ret0, _ := ret[0].(replay.Manager)
+	return ret0
+}
+
+// Replay indicates an expected call of Replay
+func (mr *MockDashMockRecorder) Replay() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Replay", reflect.TypeOf((*MockDash)(nil).Replay))
+}
+
 // SetContextChosenInUI mocks base method
 func (m *MockDash) SetContextChosenInUI(arg0 bool) {
 	m.ctrl.T.Helper()
@@ -241,6 +406,34 @@ func (mr *MockDashMockRecorder) SetContextChosenInUI(arg0 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetContextChosenInUI", reflect.TypeOf((*MockDash)(nil).SetContextChosenInUI), arg0)
 }
 
+// TerminalManager mocks base method
+func (m *MockDash) TerminalManager() terminal.Manager {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TerminalManager")
+	ret0, _ := ret[0].(terminal.Manager)
+	return ret0
+}
+
+// TerminalManager indicates an expected call of TerminalManager
+func (mr *MockDashMockRecorder) TerminalManager() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TerminalManager", reflect.TypeOf((*MockDash)(nil).TerminalManager))
+}
+
+// Translator mocks base method
+func (m *MockDash) Translator() i18n.Translator {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Translator")
+	ret0, _ := ret[0].(i18n.Translator)
+	return ret0
+}
+
+// Translator indicates an expected call of Translator
+func (mr *MockDashMockRecorder) Translator() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Translator", reflect.TypeOf((*MockDash)(nil).Translator))
+}
+
 // UseContext mocks base method
 func (m *MockDash) UseContext(arg0 context.Context, arg1 string) error {
 	m.ctrl.T.Helper()
@@ -51,6 +51,21 @@ func (mr *MockKubeContextDecoratorMockRecorder) ClusterClient() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClusterClient", reflect.TypeOf((*MockKubeContextDecorator)(nil).ClusterClient))
 }
 
+// ClusterClientForContext mocks base method
+func (m *MockKubeContextDecorator) ClusterClientForContext(arg0 context.Context, arg1 string) (cluster.ClientInterface, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClusterClientForContext", arg0, arg1)
+	ret0, _ := ret[0].(cluster.ClientInterface)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClusterClientForContext indicates an expected call of ClusterClientForContext
+func (mr *MockKubeContextDecoratorMockRecorder) ClusterClientForContext(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClusterClientForContext", reflect.TypeOf((*MockKubeContextDecorator)(nil).ClusterClientForContext), arg0, arg1)
+}
+
 // Contexts mocks base method
 func (m *MockKubeContextDecorator) Contexts() []kubeconfig.Context {
 	m.ctrl.T.Helper()
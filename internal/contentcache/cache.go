@@ -0,0 +1,290 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package contentcache caches generated content until the objects it was generated
+// from change. Changes are reported by watches of the object store.
+package contentcache
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	kcache "k8s.io/client-go/tools/cache"
+
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+const (
+	// DefaultMaxAge is how long content is cached. Cached content is evicted as soon as
+	// an object it was generated from changes, but content can depend on more than
+	// objects, e.g. plugins or port forwards, so it is regenerated periodically.
+	DefaultMaxAge = 30 * time.Second
+
+	// DefaultMaxEntries is the number of content responses which are cached.
+	DefaultMaxEntries = 8
+)
+
+// Option is an option for configuring Cache.
+type Option func(c *Cache)
+
+// WithMaxAge sets how long content is cached. A max age less than one disables caching.
+func WithMaxAge(maxAge time.Duration) Option {
+	return func(c *Cache) {
+		c.maxAge = maxAge
+	}
+}
+
+// WithMaxEntries sets the number of content responses which are cached.
+func WithMaxEntries(maxEntries int) Option {
+	return func(c *Cache) {
+		if maxEntries > 0 {
+			c.maxEntries = maxEntries
+		}
+	}
+}
+
+// WithClock sets the function which returns the current time.
+func WithClock(now func() time.Time) Option {
+	return func(c *Cache) {
+		c.now = now
+	}
+}
+
+type entry struct {
+	response     component.ContentResponse
+	dependencies *Dependencies
+	created      time.Time
+	// watches are the watches which evict the entry. An entry can't be trusted once one
+	// of them is removed, e.g. because the cluster changed.
+	watches []store.WatchHandle
+	// ready is false until the entry is watched and its dependencies are checked, so
+	// changes made while it was being generated aren't missed.
+	ready bool
+}
+
+// Cache caches content responses by key. A cached response is used until a watch
+// reports a change to one of the objects it was generated from or the response expires.
+type Cache struct {
+	objectStore store.Store
+	maxAge      time.Duration
+	maxEntries  int
+	now         func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]*entry
+
+	// watches are the watches of the kinds and namespaces cached content depends on.
+	// They are shared by entries and kept until the cache is stopped.
+	watchMu sync.Mutex
+	watches map[store.Key]store.WatchHandle
+}
+
+// New creates an instance of Cache. Dependencies are watched in objectStore.
+func New(objectStore store.Store, options ...Option) *Cache {
+	c := &Cache{
+		objectStore: objectStore,
+		maxAge:      DefaultMaxAge,
+		maxEntries:  DefaultMaxEntries,
+		now:         time.Now,
+		entries:     map[string]*entry{},
+		watches:     map[store.Key]store.WatchHandle{},
+	}
+
+	for _, option := range options {
+		option(c)
+	}
+
+	return c
+}
+
+// Get returns the cached response for key if its dependencies haven't changed.
+func (c *Cache) Get(ctx context.Context, key string) (component.ContentResponse, bool) {
+	if c == nil || c.maxAge < 1 {
+		return component.ContentResponse{}, false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.entries[key]
+	if !ok || !e.ready {
+		return component.ContentResponse{}, false
+	}
+
+	if c.now().Sub(e.created) > c.maxAge || !watching(e.watches) {
+		delete(c.entries, key)
+		return component.ContentResponse{}, false
+	}
+
+	return e.response, true
+}
+
+// Set caches a response generated from dependencies. Responses with incomplete
+// dependencies aren't cached.
+func (c *Cache) Set(key string, response component.ContentResponse, dependencies *Dependencies) {
+	if c == nil || c.maxAge < 1 || dependencies == nil || !dependencies.Complete() {
+		return
+	}
+
+	e := &entry{
+		response:     response,
+		dependencies: dependencies,
+		created:      c.now(),
+	}
+
+	c.mu.Lock()
+	if _, ok := c.entries[key]; !ok && len(c.entries) >= c.maxEntries {
+		c.evictOldest()
+	}
+	c.entries[key] = e
+	c.mu.Unlock()
+
+	// The entry is stored before it is watched, so changes reported while watching
+	// evict it.
+	var watches []store.WatchHandle
+	for _, watchKey := range dependencies.watchKeys() {
+		watch, err := c.watch(watchKey)
+		if err != nil {
+			c.remove(key, e)
+			return
+		}
+		watches = append(watches, watch)
+	}
+
+	// Objects could have changed between being read and being watched.
+	ctx := WithDependencies(context.Background(), nil)
+	if !dependencies.Current(ctx, c.objectStore) {
+		c.remove(key, e)
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.entries[key] == e {
+		e.watches = watches
+		e.ready = true
+	}
+}
+
+// Reset removes all cached responses.
+func (c *Cache) Reset() {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries = map[string]*entry{}
+}
+
+// Stop removes all cached responses and the cache's watches.
+func (c *Cache) Stop() {
+	if c == nil {
+		return
+	}
+
+	c.Reset()
+
+	c.watchMu.Lock()
+	defer c.watchMu.Unlock()
+
+	for _, watch := range c.watches {
+		watch.Cancel()
+	}
+	c.watches = map[store.Key]store.WatchHandle{}
+}
+
+// watch returns the watch of key, adding it if it doesn't exist or was removed.
+func (c *Cache) watch(key store.Key) (store.WatchHandle, error) {
+	c.watchMu.Lock()
+	defer c.watchMu.Unlock()
+
+	if watch, ok := c.watches[key]; ok && watching([]store.WatchHandle{watch}) {
+		return watch, nil
+	}
+
+	handler := kcache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			c.changed(obj, false)
+		},
+		UpdateFunc: func(_, newObj interface{}) {
+			c.changed(newObj, false)
+		},
+		DeleteFunc: func(obj interface{}) {
+			c.changed(obj, true)
+		},
+	}
+
+	// Watches are cancelled when the cache is stopped rather than with a context.
+	watch, err := c.objectStore.Watch(context.Background(), key, handler)
+	if err != nil {
+		return nil, err
+	}
+
+	c.watches[key] = watch
+	return watch, nil
+}
+
+// changed evicts the entries which depend on a changed object.
+func (c *Cache) changed(obj interface{}, deleted bool) {
+	if tombstone, ok := obj.(kcache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+
+	object, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for key, e := range c.entries {
+		if e.dependencies.changedBy(object, deleted) {
+			delete(c.entries, key)
+		}
+	}
+}
+
+// remove removes the entry for key if it is e.
+func (c *Cache) remove(key string, e *entry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.entries[key] == e {
+		delete(c.entries, key)
+	}
+}
+
+func (c *Cache) evictOldest() {
+	var oldestKey string
+	var oldest time.Time
+
+	for key, e := range c.entries {
+		if oldestKey == "" || e.created.Before(oldest) {
+			oldestKey = key
+			oldest = e.created
+		}
+	}
+
+	delete(c.entries, oldestKey)
+}
+
+// watching returns true if none of watches have been removed.
+func watching(watches []store.WatchHandle) bool {
+	for _, watch := range watches {
+		select {
+		case <-watch.Done():
+			return false
+		default:
+		}
+	}
+	return true
+}
@@ -0,0 +1,310 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package contentcache
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	kcache "k8s.io/client-go/tools/cache"
+
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	storeFake "github.com/vmware-tanzu/octant/pkg/store/fake"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func withResourceVersion(object *unstructured.Unstructured, resourceVersion string) *unstructured.Unstructured {
+	object = object.DeepCopy()
+	object.SetResourceVersion(resourceVersion)
+	return object
+}
+
+// watchStore records the handlers watching a mock store. Each watch is removed when
+// done is closed.
+type watchStore struct {
+	*storeFake.MockStore
+
+	mu       sync.Mutex
+	handlers map[store.Key]kcache.ResourceEventHandler
+	done     chan struct{}
+}
+
+func newWatchStore(controller *gomock.Controller) *watchStore {
+	s := &watchStore{
+		MockStore: storeFake.NewMockStore(controller),
+		handlers:  map[store.Key]kcache.ResourceEventHandler{},
+		done:      make(chan struct{}),
+	}
+
+	s.EXPECT().
+		Watch(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, key store.Key, handler kcache.ResourceEventHandler) (store.WatchHandle, error) {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+
+			s.handlers[key] = handler
+
+			watch := storeFake.NewMockWatchHandle(controller)
+			watch.EXPECT().Done().Return(s.done).AnyTimes()
+			watch.EXPECT().Cancel().AnyTimes()
+			return watch, nil
+		}).AnyTimes()
+
+	return s
+}
+
+func (s *watchStore) handler(t *testing.T, key store.Key) kcache.ResourceEventHandler {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	handler, ok := s.handlers[key]
+	require.True(t, ok, "%s isn't watched", key)
+	return handler
+}
+
+func TestCache(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	deployment := testutil.ToUnstructured(t, testutil.CreateDeployment("deployment"))
+	deployment.SetResourceVersion("1")
+	pod := testutil.ToUnstructured(t, testutil.CreatePod("pod"))
+	pod.SetUID("pod")
+	pod.SetResourceVersion("1")
+
+	deploymentKey, err := store.KeyFromObject(deployment)
+	require.NoError(t, err)
+	podsKey := store.Key{Namespace: "namespace", APIVersion: "v1", Kind: "Pod"}
+
+	objectStore := newWatchStore(controller)
+	objectStore.EXPECT().
+		Get(gomock.Any(), deploymentKey).
+		Return(deployment, nil).AnyTimes()
+	objectStore.EXPECT().
+		List(gomock.Any(), podsKey).
+		Return(&unstructured.UnstructuredList{Items: []unstructured.Unstructured{*pod}}, false, nil).AnyTimes()
+
+	now := time.Unix(1600000000, 0)
+	c := New(objectStore, WithClock(func() time.Time { return now }))
+
+	ctx := context.Background()
+
+	generate := func() *Dependencies {
+		dependencies := NewDependencies()
+		generateCtx := WithDependencies(ctx, dependencies)
+
+		object, err := objectStore.Get(generateCtx, deploymentKey)
+		RecordGet(generateCtx, deploymentKey, object, err)
+
+		list, loading, err := objectStore.List(generateCtx, podsKey)
+		RecordList(generateCtx, podsKey, list, loading, err)
+
+		return dependencies
+	}
+
+	response := *component.NewContentResponse(component.TitleFromString("deployment"))
+	c.Set("/deployment", response, generate())
+
+	got, ok := c.Get(ctx, "/deployment")
+	require.True(t, ok)
+	assert.Equal(t, response, got)
+
+	deploymentWatchKey := store.Key{Namespace: "namespace", APIVersion: "apps/v1", Kind: "Deployment"}
+
+	t.Run("unchanged object", func(t *testing.T) {
+		objectStore.handler(t, deploymentWatchKey).OnUpdate(deployment, deployment)
+		objectStore.handler(t, podsKey).OnAdd(pod)
+		_, ok := c.Get(ctx, "/deployment")
+		assert.True(t, ok)
+	})
+
+	t.Run("changed object", func(t *testing.T) {
+		objectStore.handler(t, deploymentWatchKey).OnUpdate(deployment, withResourceVersion(deployment, "2"))
+		_, ok := c.Get(ctx, "/deployment")
+		assert.False(t, ok)
+	})
+
+	c.Set("/deployment", response, generate())
+
+	t.Run("deleted list item", func(t *testing.T) {
+		objectStore.handler(t, podsKey).OnDelete(kcache.DeletedFinalStateUnknown{Obj: pod})
+		_, ok := c.Get(ctx, "/deployment")
+		assert.False(t, ok)
+	})
+
+	c.Set("/deployment", response, generate())
+
+	t.Run("added list item", func(t *testing.T) {
+		added := testutil.ToUnstructured(t, testutil.CreatePod("added"))
+		added.SetUID("added")
+		objectStore.handler(t, podsKey).OnAdd(added)
+		_, ok := c.Get(ctx, "/deployment")
+		assert.False(t, ok)
+	})
+
+	c.Set("/deployment", response, generate())
+
+	t.Run("other namespace", func(t *testing.T) {
+		other := withResourceVersion(deployment, "3")
+		other.SetNamespace("other")
+		objectStore.handler(t, deploymentWatchKey).OnUpdate(other, other)
+		_, ok := c.Get(ctx, "/deployment")
+		assert.True(t, ok)
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		now = now.Add(DefaultMaxAge + time.Second)
+		_, ok := c.Get(ctx, "/deployment")
+		assert.False(t, ok)
+	})
+
+	c.Set("/deployment", response, generate())
+
+	t.Run("watch removed", func(t *testing.T) {
+		close(objectStore.done)
+		_, ok := c.Get(ctx, "/deployment")
+		assert.False(t, ok)
+	})
+}
+
+func TestCache_Set_changedWhileWatching(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	key := store.Key{Namespace: "namespace", APIVersion: "v1", Kind: "Pod"}
+
+	objectStore := newWatchStore(controller)
+	objectStore.EXPECT().
+		List(gomock.Any(), key).
+		Return(&unstructured.UnstructuredList{Items: []unstructured.Unstructured{
+			*testutil.ToUnstructured(t, testutil.CreatePod("pod")),
+		}}, false, nil)
+
+	c := New(objectStore)
+
+	ctx := context.Background()
+	dependencies := NewDependencies()
+	RecordList(WithDependencies(ctx, dependencies), key, &unstructured.UnstructuredList{}, false, nil)
+
+	c.Set("/pods", component.ContentResponse{}, dependencies)
+
+	_, ok := c.Get(ctx, "/pods")
+	assert.False(t, ok)
+}
+
+func TestCache_Set_incomplete(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	c := New(storeFake.NewMockStore(controller))
+
+	ctx := context.Background()
+	key := store.Key{Namespace: "namespace", APIVersion: "v1", Kind: "Pod"}
+
+	list := &unstructured.UnstructuredList{}
+	tests := []struct {
+		name   string
+		record func(ctx context.Context)
+	}{
+		{
+			name:   "no dependencies",
+			record: func(ctx context.Context) {},
+		},
+		{
+			name: "loading",
+			record: func(ctx context.Context) {
+				RecordList(ctx, key, list, true, nil)
+			},
+		},
+		{
+			name: "error",
+			record: func(ctx context.Context) {
+				RecordList(ctx, key, list, false, nil)
+				RecordGet(ctx, key, nil, fmt.Errorf("failed"))
+			},
+		},
+		{
+			name: "partial",
+			record: func(ctx context.Context) {
+				RecordList(ctx, key, list, false, nil)
+				RecordIncomplete(ctx)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dependencies := NewDependencies()
+			test.record(WithDependencies(ctx, dependencies))
+
+			c.Set("/pods", component.ContentResponse{}, dependencies)
+
+			_, ok := c.Get(ctx, "/pods")
+			assert.False(t, ok)
+		})
+	}
+}
+
+func TestCache_evictsOldest(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	key := store.Key{Namespace: "namespace", APIVersion: "v1", Kind: "Pod"}
+
+	objectStore := newWatchStore(controller)
+	objectStore.EXPECT().List(gomock.Any(), key).Return(&unstructured.UnstructuredList{}, false, nil).AnyTimes()
+
+	now := time.Unix(1600000000, 0)
+	c := New(objectStore, WithMaxEntries(2), WithClock(func() time.Time { return now }))
+
+	ctx := context.Background()
+	for _, path := range []string{"/a", "/b", "/c"} {
+		dependencies := NewDependencies()
+		RecordList(WithDependencies(ctx, dependencies), key, &unstructured.UnstructuredList{}, false, nil)
+		c.Set(path, component.ContentResponse{}, dependencies)
+		now = now.Add(time.Millisecond)
+	}
+
+	_, ok := c.Get(ctx, "/a")
+	assert.False(t, ok)
+	_, ok = c.Get(ctx, "/c")
+	assert.True(t, ok)
+}
+
+func TestCache_Stop(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	key := store.Key{Namespace: "namespace", APIVersion: "v1", Kind: "Pod"}
+
+	watch := storeFake.NewMockWatchHandle(controller)
+	watch.EXPECT().Done().Return(make(chan struct{})).AnyTimes()
+	watch.EXPECT().Cancel()
+
+	objectStore := storeFake.NewMockStore(controller)
+	objectStore.EXPECT().Watch(gomock.Any(), key, gomock.Any()).Return(watch, nil)
+	objectStore.EXPECT().List(gomock.Any(), key).Return(&unstructured.UnstructuredList{}, false, nil)
+
+	c := New(objectStore)
+
+	ctx := context.Background()
+	dependencies := NewDependencies()
+	RecordList(WithDependencies(ctx, dependencies), key, &unstructured.UnstructuredList{}, false, nil)
+	c.Set("/pods", component.ContentResponse{}, dependencies)
+
+	c.Stop()
+
+	_, ok := c.Get(ctx, "/pods")
+	assert.False(t, ok)
+}
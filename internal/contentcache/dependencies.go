@@ -0,0 +1,290 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package contentcache
+
+import (
+	"context"
+	"sync"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+type contextKey struct{}
+
+// Dependencies records the objects read while content is generated. It is safe for
+// concurrent use.
+type Dependencies struct {
+	mu         sync.Mutex
+	gets       map[string]getDependency
+	lists      map[string]listDependency
+	incomplete bool
+}
+
+type getDependency struct {
+	key             store.Key
+	resourceVersion string
+}
+
+type listDependency struct {
+	key      store.Key
+	versions map[types.UID]string
+}
+
+// NewDependencies creates an instance of Dependencies.
+func NewDependencies() *Dependencies {
+	return &Dependencies{
+		gets:  map[string]getDependency{},
+		lists: map[string]listDependency{},
+	}
+}
+
+// WithDependencies returns a context which records objects read from an object store
+// to dependencies.
+func WithDependencies(ctx context.Context, dependencies *Dependencies) context.Context {
+	return context.WithValue(ctx, contextKey{}, dependencies)
+}
+
+func dependenciesFrom(ctx context.Context) *Dependencies {
+	dependencies, _ := ctx.Value(contextKey{}).(*Dependencies)
+	return dependencies
+}
+
+// RecordGet records an object read with key. A failed read makes the dependencies
+// incomplete. It does nothing if ctx isn't recording dependencies.
+func RecordGet(ctx context.Context, key store.Key, object *unstructured.Unstructured, err error) {
+	dependencies := dependenciesFrom(ctx)
+	if dependencies == nil {
+		return
+	}
+
+	dependencies.mu.Lock()
+	defer dependencies.mu.Unlock()
+
+	if err != nil || object == nil {
+		dependencies.incomplete = true
+		return
+	}
+
+	dependencies.gets[key.String()] = getDependency{
+		key:             key,
+		resourceVersion: object.GetResourceVersion(),
+	}
+}
+
+// RecordList records a list of objects read with key. A failed or loading list makes
+// the dependencies incomplete. It does nothing if ctx isn't recording dependencies.
+func RecordList(ctx context.Context, key store.Key, list *unstructured.UnstructuredList, loading bool, err error) {
+	dependencies := dependenciesFrom(ctx)
+	if dependencies == nil {
+		return
+	}
+
+	dependencies.mu.Lock()
+	defer dependencies.mu.Unlock()
+
+	if err != nil || loading || list == nil {
+		dependencies.incomplete = true
+		return
+	}
+
+	dependencies.lists[key.String()] = listDependency{
+		key:      key,
+		versions: versions(list),
+	}
+}
+
+// RecordIncomplete records that content generated with ctx is missing parts, e.g.
+// because they weren't generated in time. It does nothing if ctx isn't recording
+// dependencies.
+func RecordIncomplete(ctx context.Context) {
+	dependencies := dependenciesFrom(ctx)
+	if dependencies == nil {
+		return
+	}
+
+	dependencies.mu.Lock()
+	defer dependencies.mu.Unlock()
+
+	dependencies.incomplete = true
+}
+
+// Keys returns the keys of the objects which were read.
+func (d *Dependencies) Keys() []store.Key {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	keys := make([]store.Key, 0, len(d.gets)+len(d.lists))
+	for _, dependency := range d.gets {
+		keys = append(keys, dependency.key)
+	}
+	for _, dependency := range d.lists {
+		keys = append(keys, dependency.key)
+	}
+	return keys
+}
+
+// Complete returns true if every read succeeded and at least one object was read.
+// Content with incomplete dependencies can't be cached.
+func (d *Dependencies) Complete() bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return !d.incomplete && len(d.gets)+len(d.lists) > 0
+}
+
+// Current returns true if reading the dependencies from objectStore returns the same
+// resource versions that were recorded.
+func (d *Dependencies) Current(ctx context.Context, objectStore store.Store) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	// Don't record the reads which check dependencies.
+	ctx = WithDependencies(ctx, nil)
+
+	for _, dependency := range d.gets {
+		object, err := objectStore.Get(ctx, dependency.key)
+		if err != nil || object == nil || object.GetResourceVersion() != dependency.resourceVersion {
+			return false
+		}
+	}
+
+	for _, dependency := range d.lists {
+		list, loading, err := objectStore.List(ctx, dependency.key)
+		if err != nil || loading || list == nil || !equalVersions(versions(list), dependency.versions) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// watchKeys returns the keys which watch every object the dependencies were read from.
+func (d *Dependencies) watchKeys() []store.Key {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	seen := map[store.Key]bool{}
+	var keys []store.Key
+
+	add := func(key store.Key) {
+		watchKey := store.Key{Namespace: key.Namespace, APIVersion: key.APIVersion, Kind: key.Kind}
+		if !seen[watchKey] {
+			seen[watchKey] = true
+			keys = append(keys, watchKey)
+		}
+	}
+
+	for _, dependency := range d.gets {
+		add(dependency.key)
+	}
+	for _, dependency := range d.lists {
+		add(dependency.key)
+	}
+
+	return keys
+}
+
+// changedBy returns true if a change to object changes what reading the dependencies
+// returns. Changes which don't change an object's resource version, e.g. the adds an
+// informer replays to a new handler, don't change the dependencies.
+func (d *Dependencies) changedBy(object *unstructured.Unstructured, deleted bool) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for _, dependency := range d.gets {
+		if !sameKind(dependency.key, object) ||
+			dependency.key.Namespace != object.GetNamespace() ||
+			dependency.key.Name != object.GetName() {
+			continue
+		}
+		if deleted || dependency.resourceVersion != object.GetResourceVersion() {
+			return true
+		}
+	}
+
+	for _, dependency := range d.lists {
+		if !sameKind(dependency.key, object) {
+			continue
+		}
+
+		// An object which was listed changes the list even if it no longer matches the
+		// key, e.g. because its labels changed.
+		if resourceVersion, ok := dependency.versions[object.GetUID()]; ok {
+			if deleted || resourceVersion != object.GetResourceVersion() {
+				return true
+			}
+			continue
+		}
+
+		if !deleted && matches(object, dependency.key) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func sameKind(key store.Key, object *unstructured.Unstructured) bool {
+	return key.APIVersion == object.GetAPIVersion() && key.Kind == object.GetKind()
+}
+
+// matches returns true if listing key would return object. Keys with selectors which
+// can't be parsed match every object.
+func matches(object *unstructured.Unstructured, key store.Key) bool {
+	if key.Namespace != "" && object.GetNamespace() != key.Namespace {
+		return false
+	}
+	if key.Name != "" && object.GetName() != key.Name {
+		return false
+	}
+
+	if key.Selector != nil && !key.Selector.AsSelector().Matches(labels.Set(object.GetLabels())) {
+		return false
+	}
+	if key.LabelSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(key.LabelSelector)
+		if err == nil && !selector.Matches(labels.Set(object.GetLabels())) {
+			return false
+		}
+	}
+
+	if key.OwnerUID == "" {
+		return true
+	}
+	for _, ownerReference := range object.GetOwnerReferences() {
+		if ownerReference.UID == key.OwnerUID {
+			return true
+		}
+	}
+	return false
+}
+
+// versions returns the resource version of each object in a list by UID.
+func versions(list *unstructured.UnstructuredList) map[types.UID]string {
+	versions := make(map[types.UID]string, len(list.Items))
+	for i := range list.Items {
+		item := &list.Items[i]
+		versions[item.GetUID()] = item.GetResourceVersion()
+	}
+	return versions
+}
+
+func equalVersions(a, b map[types.UID]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for uid, resourceVersion := range a {
+		if other, ok := b[uid]; !ok || other != resourceVersion {
+			return false
+		}
+	}
+	return true
+}
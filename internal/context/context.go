@@ -5,7 +5,10 @@
 
 package context
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 type OctantContextKey string
 
@@ -33,3 +36,20 @@ func WebsocketClientIDFrom(ctx context.Context) string {
 func WithWebsocketClientID(ctx context.Context, id string) context.Context {
 	return context.WithValue(ctx, WebsocketClientIDKey, id)
 }
+
+type OctantContentDeadline string
+
+const ContentDeadlineKey = OctantContentDeadline("contentDeadline")
+
+// ContentDeadlineFrom returns the time after which content generation stops waiting for
+// slow content, if ctx has one.
+func ContentDeadlineFrom(ctx context.Context) (time.Time, bool) {
+	deadline, ok := ctx.Value(ContentDeadlineKey).(time.Time)
+	return deadline, ok
+}
+
+// WithContentDeadline returns a context where content generation returns what has been
+// generated by deadline, with placeholders for the rest.
+func WithContentDeadline(ctx context.Context, deadline time.Time) context.Context {
+	return context.WithValue(ctx, ContentDeadlineKey, deadline)
+}
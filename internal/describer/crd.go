@@ -15,10 +15,11 @@ import (
 
 func defaultCustomResourceTabs(crdName string) []Tab {
 	return []Tab{
-		{Name: "Summary", Factory: CustomResourceSummaryTab(crdName)},
-		{Name: "Metadata", Factory: MetadataTab},
-		{Name: "Resource Viewer", Factory: ResourceViewerTab},
-		{Name: "YAML", Factory: YAMLViewerTab},
+		{Name: "Summary", Accessor: "summary", Factory: CustomResourceSummaryTab(crdName)},
+		{Name: "Metadata", Accessor: "metadata", Factory: MetadataTab},
+		{Name: "Resource Viewer", Accessor: "resourceViewer", Factory: ResourceViewerTab},
+		{Name: "YAML", Accessor: "yaml", Factory: YAMLViewerTab},
+		{Name: "Kubectl Plugins", Factory: KubectlPluginsTab},
 	}
 }
 
@@ -72,6 +73,12 @@ func (c *crd) Describe(ctx context.Context, namespace string, options Options) (
 	title := component.Title(component.NewText(name))
 	cr := component.NewContentResponse(title)
 
+	key, err := store.KeyFromObject(object)
+	if err != nil {
+		return component.EmptyContentResponse, err
+	}
+	addKubectlPluginButtons(cr, key, options)
+
 	generatorConfig := TabsGeneratorConfig{
 		Object:      object,
 		TabsFactory: objectTabsFactory(ctx, object, c.tabFuncDescriptors, options),
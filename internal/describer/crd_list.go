@@ -48,6 +48,7 @@ func (cld *crdList) Describe(ctx context.Context, namespace string, options Opti
 		DashConfig:    options.Dash,
 		Link:          options.Link,
 		ObjectFactory: printer.NewDefaultObjectFactory(),
+		LabelSet:      options.LabelSet,
 	}
 
 	title := component.Title(component.NewText(""))
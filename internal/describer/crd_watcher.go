@@ -30,6 +30,8 @@ type DefaultCRDWatcher struct {
 	errorStore    oerrors.ErrorStore
 
 	watchConfigs map[string]*config.CRDWatchConfig
+	// watch is the CRD handler added by the last call to Watch.
+	watch store.WatchHandle
 
 	mu sync.Mutex
 }
@@ -132,7 +134,16 @@ func (cw *DefaultCRDWatcher) Watch(ctx context.Context) error {
 		},
 	}
 
-	err := cw.objectStore.Watch(ctx, crdKey, handler)
+	cw.mu.Lock()
+	if cw.watch != nil {
+		// Watching again replaces the handler, e.g. after a context change.
+		cw.watch.Cancel()
+		cw.watch = nil
+	}
+	objectStore := cw.objectStore
+	cw.mu.Unlock()
+
+	watch, err := objectStore.Watch(ctx, crdKey, handler)
 	if err != nil {
 		var e *oerrors.AccessError
 		if errors.As(err, &e) {
@@ -146,6 +157,10 @@ func (cw *DefaultCRDWatcher) Watch(ctx context.Context) error {
 		return fmt.Errorf("crd watcher has failed: %w", err)
 	}
 
+	cw.mu.Lock()
+	cw.watch = watch
+	cw.mu.Unlock()
+
 	return nil
 }
 
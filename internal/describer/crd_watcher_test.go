@@ -42,14 +42,23 @@ func TestDefaultCRDWatcher_Watch(t *testing.T) {
 
 	client := clusterFake.NewMockClientInterface(controller)
 
+	first := objectStoreFake.NewMockWatchHandle(controller)
+	second := objectStoreFake.NewMockWatchHandle(controller)
+
 	objectStore := objectStoreFake.NewMockStore(controller)
-	objectStore.EXPECT().
-		Watch(ctx, crdKey, gomock.Any()).
-		DoAndReturn(func(_ context.Context, key store.Key, c *cache.ResourceEventHandlerFuncs) error {
-			assert.NotNil(t, c.AddFunc)
-			assert.NotNil(t, c.DeleteFunc)
-			return nil
-		})
+	gomock.InOrder(
+		objectStore.EXPECT().
+			Watch(ctx, crdKey, gomock.Any()).
+			DoAndReturn(func(_ context.Context, key store.Key, c *cache.ResourceEventHandlerFuncs) (store.WatchHandle, error) {
+				assert.NotNil(t, c.AddFunc)
+				assert.NotNil(t, c.DeleteFunc)
+				return first, nil
+			}),
+		first.EXPECT().Cancel(),
+		objectStore.EXPECT().
+			Watch(ctx, crdKey, gomock.Any()).
+			Return(second, nil),
+	)
 	objectStore.EXPECT().
 		RegisterOnUpdate(gomock.Any())
 	errorStore, err := internalErr.NewErrorStore()
@@ -66,6 +75,9 @@ func TestDefaultCRDWatcher_Watch(t *testing.T) {
 
 	require.NoError(t, watcher.AddConfig(watchConfig))
 	require.NoError(t, watcher.Watch(ctx))
+
+	// Watching again replaces the handler.
+	require.NoError(t, watcher.Watch(ctx))
 }
 
 func TestDefaultCRDWatcher_Watch_failure(t *testing.T) {
@@ -79,8 +91,8 @@ func TestDefaultCRDWatcher_Watch_failure(t *testing.T) {
 	objectStore := objectStoreFake.NewMockStore(controller)
 	objectStore.EXPECT().
 		Watch(ctx, crdKey, gomock.Any()).
-		DoAndReturn(func(_ context.Context, _ store.Key, c *cache.ResourceEventHandlerFuncs) error {
-			return errors.New("failure")
+		DoAndReturn(func(_ context.Context, _ store.Key, c *cache.ResourceEventHandlerFuncs) (store.WatchHandle, error) {
+			return nil, errors.New("failure")
 		})
 	objectStore.EXPECT().
 		RegisterOnUpdate(gomock.Any())
@@ -0,0 +1,49 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package describer
+
+import (
+	"context"
+	"time"
+
+	"github.com/vmware-tanzu/octant/internal/contentcache"
+	ocontext "github.com/vmware-tanzu/octant/internal/context"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+// awaitContentDeadline waits for done to be closed or ctx to be done. It returns false
+// if the content deadline of ctx passes first, and records the content as incomplete so
+// it isn't cached.
+func awaitContentDeadline(ctx context.Context, done <-chan struct{}) bool {
+	select {
+	case <-done:
+		return true
+	default:
+	}
+
+	var expired <-chan time.Time
+	if deadline, ok := ocontext.ContentDeadlineFrom(ctx); ok {
+		timer := time.NewTimer(time.Until(deadline))
+		defer timer.Stop()
+		expired = timer.C
+	}
+
+	select {
+	case <-done:
+		return true
+	case <-ctx.Done():
+		return false
+	case <-expired:
+		contentcache.RecordIncomplete(ctx)
+		return false
+	}
+}
+
+// createPendingComponent creates a component which is shown in place of content that
+// wasn't generated by the content deadline.
+func createPendingComponent() component.Component {
+	return component.NewLoading(component.TitleFromString("Loading"), "This content is taking longer than expected to load")
+}
@@ -8,7 +8,6 @@ package describer
 import (
 	"context"
 	"errors"
-	"sort"
 
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	kLabels "k8s.io/apimachinery/pkg/labels"
@@ -20,6 +19,7 @@ import (
 	"github.com/vmware-tanzu/octant/internal/log"
 	"github.com/vmware-tanzu/octant/internal/printer"
 	"github.com/vmware-tanzu/octant/internal/queryer"
+	"github.com/vmware-tanzu/octant/internal/util/kubernetes"
 	"github.com/vmware-tanzu/octant/pkg/store"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
@@ -102,10 +102,7 @@ func LoadObjects(ctx context.Context, objectStore store.Store, errorStore oerror
 		list.Items = append(list.Items, storedObjects.Items...)
 	}
 
-	sort.SliceStable(list.Items, func(i, j int) bool {
-		a, b := list.Items[i], list.Items[j]
-		return a.GetName() < b.GetName()
-	})
+	kubernetes.SortObjects(list.Items)
 
 	return list, nil
 }
@@ -122,6 +119,11 @@ type Options struct {
 	Printer  printer.Printer
 	LabelSet *kLabels.Set
 	Link     link.Interface
+	// Tab is the accessor of the tab to generate. Other tabs only have placeholders.
+	// Every tab is generated if it is blank.
+	Tab string
+	// Limit is the most rows a list table has. Lists are not limited if it is zero.
+	Limit int
 
 	LoadObjects func(ctx context.Context, namespace string, fields map[string]string, objectStoreKeys []store.Key) (*unstructured.UnstructuredList, error)
 	LoadObject  func(ctx context.Context, namespace string, fields map[string]string, objectStoreKey store.Key) (*unstructured.Unstructured, error)
@@ -0,0 +1,78 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package describer
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/vmware-tanzu/octant/internal/analysis"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+// Findings describes common misconfigurations found in a namespace. It has
+// no content when nothing was found.
+type Findings struct {
+	*base
+
+	checks []analysis.Check
+}
+
+var _ Describer = (*Findings)(nil)
+
+// NewFindings creates an instance of Findings. The default analysis checks
+// are run if no checks are given.
+func NewFindings(checks ...analysis.Check) *Findings {
+	return &Findings{
+		base:   newBaseDescriber(),
+		checks: checks,
+	}
+}
+
+// Describe creates content.
+func (f *Findings) Describe(ctx context.Context, namespace string, options Options) (component.ContentResponse, error) {
+	if options.Dash == nil {
+		return component.EmptyContentResponse, errors.New("findings describer requires a dash config")
+	}
+
+	findings, err := analysis.Namespace(ctx, options.ObjectStore(), namespace, f.checks...)
+	if err != nil {
+		return component.EmptyContentResponse, errors.Wrap(err, "analyze namespace")
+	}
+
+	cols := component.NewTableCols("Object", "Kind", "Check", "Message")
+	table := component.NewTable("Findings", "No misconfigurations were found!", cols)
+
+	for _, finding := range findings {
+		object := finding.Object
+
+		// Controllers octant has no view for are shown without a link.
+		var objectComponent component.Component
+		objectLink, err := options.Link.ForGVK(object.Namespace, object.APIVersion, object.Kind, object.Name, object.Name)
+		if err != nil {
+			objectComponent = component.NewText(object.Name)
+		} else {
+			objectComponent = objectLink
+		}
+
+		check := component.NewText(finding.Check)
+		check.SetStatus(component.TextStatusWarning)
+
+		table.Add(component.TableRow{
+			"Object":  objectComponent,
+			"Kind":    component.NewText(object.Kind),
+			"Check":   check,
+			"Message": component.NewText(finding.Message),
+		})
+	}
+
+	list := component.NewList(component.TitleFromString("Findings"), []component.Component{table})
+
+	return component.ContentResponse{
+		Components: []component.Component{list},
+	}, nil
+}
@@ -0,0 +1,75 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package describer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/octant/internal/analysis"
+	configFake "github.com/vmware-tanzu/octant/internal/config/fake"
+	linkFake "github.com/vmware-tanzu/octant/internal/link/fake"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	storeFake "github.com/vmware-tanzu/octant/pkg/store/fake"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func TestFindings(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	objectStore := storeFake.NewMockStore(controller)
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().ObjectStore().Return(objectStore).AnyTimes()
+
+	linkGenerator := linkFake.NewMockInterface(controller)
+	linkGenerator.EXPECT().
+		ForGVK("default", "v1", "Service", "service", "service").
+		Return(component.NewLink("", "service", "/service"), nil)
+
+	check := func(ctx context.Context, o store.Store, namespace string) ([]analysis.Finding, error) {
+		return []analysis.Finding{
+			{
+				Check:   analysis.CheckServiceWithoutPods,
+				Object:  analysis.Object{APIVersion: "v1", Kind: "Service", Namespace: namespace, Name: "service"},
+				Message: "Selector app=app doesn't match any pods",
+			},
+		}, nil
+	}
+
+	options := Options{
+		Dash: dashConfig,
+		Link: linkGenerator,
+	}
+
+	got, err := NewFindings(check).Describe(context.Background(), "default", options)
+	require.NoError(t, err)
+
+	checkText := component.NewText(analysis.CheckServiceWithoutPods)
+	checkText.SetStatus(component.TextStatusWarning)
+
+	cols := component.NewTableCols("Object", "Kind", "Check", "Message")
+	table := component.NewTable("Findings", "No misconfigurations were found!", cols)
+	table.Add(component.TableRow{
+		"Object":  component.NewLink("", "service", "/service"),
+		"Kind":    component.NewText("Service"),
+		"Check":   checkText,
+		"Message": component.NewText("Selector app=app doesn't match any pods"),
+	})
+
+	expected := component.ContentResponse{
+		Components: []component.Component{
+			component.NewList(component.TitleFromString("Findings"), []component.Component{table}),
+		},
+	}
+
+	assert.Equal(t, expected, got)
+}
@@ -0,0 +1,87 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package describer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+// addKubectlPluginButtons adds a button for each installed kubectl plugin which
+// can run against an object.
+func addKubectlPluginButtons(cr *component.ContentResponse, key store.Key, options Options) {
+	manager := options.Dash.KubectlPlugins()
+	if manager == nil {
+		return
+	}
+
+	for _, plugin := range manager.ForKind(key.Kind) {
+		payload := key.ToActionPayload()
+		payload["plugin"] = plugin.Name
+		cr.AddButton(fmt.Sprintf("kubectl %s", plugin.Name), action.CreatePayload(octant.ActionRunKubectlPlugin, payload))
+	}
+}
+
+// KubectlPluginsTab generates a tab with the output of the kubectl plugins run
+// against an object. The output of running plugins is updated as the content
+// refreshes. If kubectl plugins are disabled or none can run against the object,
+// the returned component will be nil with a nil error.
+func KubectlPluginsTab(_ context.Context, object runtime.Object, options Options) (component.Component, error) {
+	manager := options.Dash.KubectlPlugins()
+	if manager == nil {
+		return nil, nil
+	}
+
+	key, err := store.KeyFromObject(object)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(manager.ForKind(key.Kind)) == 0 {
+		return nil, nil
+	}
+
+	layout := component.NewFlexLayout("Kubectl Plugins")
+	layout.SetAccessor("kubectlPlugins")
+
+	runs := manager.Runs(key)
+	if len(runs) == 0 {
+		layout.AddSections(component.FlexLayoutSection{
+			{
+				Width: component.WidthFull,
+				View:  component.NewText("Run a kubectl plugin with the buttons above to see its output here."),
+			},
+		})
+		return layout, nil
+	}
+
+	for _, run := range runs {
+		state := "finished"
+		if run.Running() {
+			state = "running"
+		} else if run.Err != "" {
+			state = fmt.Sprintf("failed: %s", run.Err)
+		}
+
+		title := fmt.Sprintf("kubectl %s %s (%s)", run.Plugin, strings.Join(run.Args, " "), state)
+		card := component.NewCard(component.TitleFromString(title))
+		card.SetBody(component.NewCodeBlock(run.Output))
+
+		layout.AddSections(component.FlexLayoutSection{
+			{Width: component.WidthFull, View: card},
+		})
+	}
+
+	return layout, nil
+}
@@ -0,0 +1,119 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package describer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	configFake "github.com/vmware-tanzu/octant/internal/config/fake"
+	"github.com/vmware-tanzu/octant/internal/kubectlplugin"
+	kubectlPluginFake "github.com/vmware-tanzu/octant/internal/kubectlplugin/fake"
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func TestKubectlPluginsTab(t *testing.T) {
+	deployment := testutil.CreateDeployment("nginx")
+	key, err := store.KeyFromObject(deployment)
+	require.NoError(t, err)
+
+	tree := kubectlplugin.Plugin{Name: "tree", Path: "/usr/local/bin/kubectl-tree"}
+	started := time.Unix(1600000000, 0)
+
+	tests := []struct {
+		name     string
+		manager  func(manager *kubectlPluginFake.MockManager)
+		disabled bool
+		expected func() component.Component
+	}{
+		{
+			name:     "disabled",
+			disabled: true,
+		},
+		{
+			name: "no plugins for kind",
+			manager: func(manager *kubectlPluginFake.MockManager) {
+				manager.EXPECT().ForKind("Deployment").Return(nil)
+			},
+		},
+		{
+			name: "no runs",
+			manager: func(manager *kubectlPluginFake.MockManager) {
+				manager.EXPECT().ForKind("Deployment").Return([]kubectlplugin.Plugin{tree})
+				manager.EXPECT().Runs(key).Return(nil)
+			},
+			expected: func() component.Component {
+				layout := component.NewFlexLayout("Kubectl Plugins")
+				layout.SetAccessor("kubectlPlugins")
+				layout.AddSections(component.FlexLayoutSection{
+					{
+						Width: component.WidthFull,
+						View:  component.NewText("Run a kubectl plugin with the buttons above to see its output here."),
+					},
+				})
+				return layout
+			},
+		},
+		{
+			name: "runs",
+			manager: func(manager *kubectlPluginFake.MockManager) {
+				manager.EXPECT().ForKind("Deployment").Return([]kubectlplugin.Plugin{tree})
+				manager.EXPECT().Runs(key).Return([]kubectlplugin.RunStatus{
+					{ID: "2", Plugin: "tree", Args: []string{"Deployment", "nginx"}, Key: key, Started: started, Output: "Deployment/nginx\n"},
+					{ID: "1", Plugin: "tree", Args: []string{"Deployment", "nginx"}, Key: key, Started: started, Finished: started, Err: "exit status 1", Output: "error: forbidden\n"},
+				})
+			},
+			expected: func() component.Component {
+				layout := component.NewFlexLayout("Kubectl Plugins")
+				layout.SetAccessor("kubectlPlugins")
+
+				running := component.NewCard(component.TitleFromString("kubectl tree Deployment nginx (running)"))
+				running.SetBody(component.NewCodeBlock("Deployment/nginx\n"))
+				failed := component.NewCard(component.TitleFromString("kubectl tree Deployment nginx (failed: exit status 1)"))
+				failed.SetBody(component.NewCodeBlock("error: forbidden\n"))
+
+				layout.AddSections(
+					component.FlexLayoutSection{{Width: component.WidthFull, View: running}},
+					component.FlexLayoutSection{{Width: component.WidthFull, View: failed}},
+				)
+				return layout
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			dashConfig := configFake.NewMockDash(controller)
+			if test.disabled {
+				dashConfig.EXPECT().KubectlPlugins().Return(nil)
+			} else {
+				manager := kubectlPluginFake.NewMockManager(controller)
+				test.manager(manager)
+				dashConfig.EXPECT().KubectlPlugins().Return(manager)
+			}
+
+			got, err := KubectlPluginsTab(context.Background(), deployment, Options{Dash: dashConfig})
+			require.NoError(t, err)
+
+			if test.expected == nil {
+				assert.Nil(t, got)
+				return
+			}
+
+			component.AssertEqual(t, test.expected(), got)
+		})
+	}
+}
@@ -7,12 +7,14 @@ package describer
 
 import (
 	"context"
+	"fmt"
 	"reflect"
 
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 
 	"github.com/vmware-tanzu/octant/internal/util/kubernetes"
+	"github.com/vmware-tanzu/octant/pkg/action"
 	"github.com/vmware-tanzu/octant/pkg/store"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
@@ -70,6 +72,11 @@ func (d *List) Describe(ctx context.Context, namespace string, options Options)
 		return component.EmptyContentResponse, err
 	}
 
+	total := len(objectList.Items)
+	if options.Limit > 0 && total > options.Limit {
+		objectList.Items = objectList.Items[:options.Limit]
+	}
+
 	title := component.Title(component.NewText(d.title))
 	list := component.NewList(title, nil)
 
@@ -77,16 +84,19 @@ func (d *List) Describe(ctx context.Context, namespace string, options Options)
 
 	v := reflect.ValueOf(listType)
 	f := reflect.Indirect(v).FieldByName("Items")
+	f.Set(reflect.MakeSlice(f.Type(), len(objectList.Items), len(objectList.Items)))
 
-	// Convert unstructured objects to typed runtime objects
+	// Convert unstructured objects to typed runtime objects in place, so each object
+	// is only converted once and isn't copied.
 	for i := range objectList.Items {
-		item := d.objectType()
-		if err := kubernetes.FromUnstructured(&objectList.Items[i], item); err != nil {
+		if err := ctx.Err(); err != nil {
 			return component.EmptyContentResponse, err
 		}
 
-		newSlice := reflect.Append(f, reflect.ValueOf(item).Elem())
-		f.Set(newSlice)
+		item := f.Index(i).Addr().Interface()
+		if err := kubernetes.FromUnstructured(&objectList.Items[i], item); err != nil {
+			return component.EmptyContentResponse, err
+		}
 	}
 
 	listObject, ok := listType.(runtime.Object)
@@ -102,6 +112,9 @@ func (d *List) Describe(ctx context.Context, namespace string, options Options)
 
 	if viewComponent != nil {
 		if table, ok := viewComponent.(*component.Table); ok {
+			if shown := len(objectList.Items); shown < total {
+				addShowMore(table, shown, total)
+			}
 			list.Add(table)
 		} else {
 			list.Add(viewComponent)
@@ -113,6 +126,22 @@ func (d *List) Describe(ctx context.Context, namespace string, options Options)
 	}, nil
 }
 
+// addShowMore adds buttons for showing the rows which were left out of a table.
+func addShowMore(table *component.Table, shown, total int) {
+	m := table.GetMetadata()
+	m.Title = append(m.Title, component.NewText(fmt.Sprintf("(showing %d of %d)", shown, total)))
+	table.SetMetadata(m)
+
+	if next := shown * 2; next < total {
+		table.AddButton("Show more", action.CreatePayload(action.RequestShowMore, map[string]interface{}{
+			"limit": next,
+		}))
+	}
+	table.AddButton("Show all", action.CreatePayload(action.RequestShowMore, map[string]interface{}{
+		"limit": total,
+	}))
+}
+
 // PathFilters returns path filters for this Describer.
 func (d *List) PathFilters() []PathFilter {
 	return []PathFilter{
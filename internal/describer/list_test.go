@@ -7,6 +7,7 @@ package describer
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -14,11 +15,13 @@ import (
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 
 	configFake "github.com/vmware-tanzu/octant/internal/config/fake"
 	moduleFake "github.com/vmware-tanzu/octant/internal/module/fake"
 	printerFake "github.com/vmware-tanzu/octant/internal/printer/fake"
 	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/action"
 	"github.com/vmware-tanzu/octant/pkg/store"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
@@ -78,3 +81,142 @@ func TestListDescriber(t *testing.T) {
 
 	assert.Equal(t, expected.Title, cResponse.Title)
 }
+
+func TestListDescriber_limit(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	var pods []corev1.Pod
+	var objects []runtime.Object
+	for _, name := range []string{"pod-a", "pod-b", "pod-c", "pod-d", "pod-e"} {
+		pod := testutil.CreatePod(name)
+		pods = append(pods, *pod)
+		objects = append(objects, pod)
+	}
+
+	key, err := store.KeyFromObject(&pods[0])
+	require.NoError(t, err)
+
+	tests := []struct {
+		name            string
+		limit           int
+		expectedPods    []corev1.Pod
+		titleSuffix     string
+		expectedButtons []component.Button
+	}{
+		{
+			name:         "no limit",
+			expectedPods: pods,
+		},
+		{
+			name:         "limit below half",
+			limit:        2,
+			expectedPods: pods[:2],
+			titleSuffix:  "(showing 2 of 5)",
+			expectedButtons: []component.Button{
+				component.NewButton("Show more", action.CreatePayload(action.RequestShowMore, map[string]interface{}{"limit": 4})),
+				component.NewButton("Show all", action.CreatePayload(action.RequestShowMore, map[string]interface{}{"limit": 5})),
+			},
+		},
+		{
+			name:         "limit above half",
+			limit:        3,
+			expectedPods: pods[:3],
+			titleSuffix:  "(showing 3 of 5)",
+			expectedButtons: []component.Button{
+				component.NewButton("Show all", action.CreatePayload(action.RequestShowMore, map[string]interface{}{"limit": 5})),
+			},
+		},
+		{
+			name:         "limit above total",
+			limit:        10,
+			expectedPods: pods,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			objectPrinter := printerFake.NewMockPrinter(controller)
+			objectPrinter.EXPECT().
+				Print(gomock.Any(), &corev1.PodList{Items: test.expectedPods}).
+				Return(createPodTable(test.expectedPods...), nil)
+
+			options := Options{
+				Printer: objectPrinter,
+				Limit:   test.limit,
+				LoadObjects: func(ctx context.Context, namespace string, fields map[string]string, objectStoreKeys []store.Key) (*unstructured.UnstructuredList, error) {
+					return testutil.ToUnstructuredList(t, objects...), nil
+				},
+			}
+
+			d := NewList(ListConfig{
+				Path:       "/",
+				Title:      "list",
+				StoreKey:   key,
+				ListType:   PodListType,
+				ObjectType: PodObjectType,
+			})
+			cResponse, err := d.Describe(context.Background(), "default", options)
+			require.NoError(t, err)
+
+			require.Len(t, cResponse.Components, 1)
+			list, ok := cResponse.Components[0].(*component.List)
+			require.True(t, ok)
+			require.Len(t, list.Config.Items, 1)
+			table, ok := list.Config.Items[0].(*component.Table)
+			require.True(t, ok)
+
+			expectedTitle := createPodTable().GetMetadata().Title
+			if test.titleSuffix != "" {
+				expectedTitle = append(expectedTitle, component.NewText(test.titleSuffix))
+			}
+			assert.Equal(t, expectedTitle, table.GetMetadata().Title)
+			if test.expectedButtons == nil {
+				assert.Nil(t, table.Config.ButtonGroup)
+				return
+			}
+			require.NotNil(t, table.Config.ButtonGroup)
+			assert.Equal(t, test.expectedButtons, table.Config.ButtonGroup.Config.Buttons)
+		})
+	}
+}
+
+func BenchmarkListDescriber(b *testing.B) {
+	controller := gomock.NewController(b)
+	defer controller.Finish()
+
+	var objects []runtime.Object
+	for i := 0; i < 500; i++ {
+		objects = append(objects, testutil.CreatePod(fmt.Sprintf("pod-%d", i)))
+	}
+	objectList := testutil.ToUnstructuredList(b, objects...)
+
+	objectPrinter := printerFake.NewMockPrinter(controller)
+	objectPrinter.EXPECT().Print(gomock.Any(), gomock.Any()).Return(component.NewText(""), nil).AnyTimes()
+
+	options := Options{
+		Printer: objectPrinter,
+		LoadObjects: func(ctx context.Context, namespace string, fields map[string]string, objectStoreKeys []store.Key) (*unstructured.UnstructuredList, error) {
+			return objectList.DeepCopy(), nil
+		},
+	}
+
+	d := NewList(ListConfig{
+		Path:       "/",
+		Title:      "list",
+		StoreKey:   store.Key{APIVersion: "v1", Kind: "Pod"},
+		ListType:   PodListType,
+		ObjectType: PodObjectType,
+	})
+
+	ctx := context.Background()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		if _, err := d.Describe(ctx, "default", options); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
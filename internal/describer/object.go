@@ -23,12 +23,13 @@ import (
 // defaultObjectTabs are the default tabs for an object (that is not a custom resource).
 func defaultObjectTabs() []Tab {
 	return []Tab{
-		{Name: "Summary", Factory: SummaryTab},
-		{Name: "Metadata", Factory: MetadataTab},
-		{Name: "Resource Viewer", Factory: ResourceViewerTab},
-		{Name: "YAML", Factory: YAMLViewerTab},
+		{Name: "Summary", Accessor: "summary", Factory: SummaryTab},
+		{Name: "Metadata", Accessor: "metadata", Factory: MetadataTab},
+		{Name: "Resource Viewer", Accessor: "resourceViewer", Factory: ResourceViewerTab},
+		{Name: "YAML", Accessor: "yaml", Factory: YAMLViewerTab},
 		{Name: "Logs", Factory: LogsTab},
 		{Name: "Terminal", Factory: TerminalTab},
+		{Name: "Kubectl Plugins", Factory: KubectlPluginsTab},
 	}
 }
 
@@ -88,7 +89,10 @@ func NewObject(c ObjectConfig) *Object {
 // This function should always return a content response even if there is an error.
 func (d *Object) Describe(ctx context.Context, namespace string, options Options) (component.ContentResponse, error) {
 	object, err := options.LoadObject(ctx, namespace, options.Fields, d.objectStoreKey)
-	if err != nil {
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		// The request was abandoned, so the object wasn't necessarily not found.
+		return component.EmptyContentResponse, ctxErr
+	} else if err != nil {
 		return component.EmptyContentResponse, api.NewNotFoundError(d.path)
 	} else if object == nil {
 		cr := component.NewContentResponse(component.TitleFromString("LoadObject Error"))
@@ -124,12 +128,30 @@ func (d *Object) Describe(ctx context.Context, namespace string, options Options
 		return component.EmptyContentResponse, err
 	}
 
-	if objAccessor.GetDeletionTimestamp() == nil {
-		key, err := store.KeyFromObject(currentObject)
+	key, err := store.KeyFromObject(currentObject)
+	if err != nil {
+		return component.EmptyContentResponse, err
+	}
+
+	cr.AddButton("Export YAML", action.CreatePayload(octant.ActionExportObject, key.ToActionPayload()))
+
+	if contexts := options.Contexts(); len(contexts) > 1 {
+		var contextNames []string
+		for _, kubeContext := range contexts {
+			contextNames = append(contextNames, kubeContext.Name)
+		}
+
+		compareModal, err := octant.CompareObjectModalButton(currentObject, contextNames, options.CurrentContext())
 		if err != nil {
 			return component.EmptyContentResponse, err
 		}
 
+		cr.AddButton("Compare", action.CreatePayload(octant.ActionCompareObject, key.ToActionPayload()), compareModal)
+	}
+
+	addKubectlPluginButtons(cr, key, options)
+
+	if objAccessor.GetDeletionTimestamp() == nil {
 		confirmation, err := octant.DeleteObjectConfirmationButton(currentObject)
 		if err != nil {
 			return component.EmptyContentResponse, err
@@ -15,6 +15,7 @@ import (
 
 	"github.com/vmware-tanzu/octant/internal/describer"
 	describerFake "github.com/vmware-tanzu/octant/internal/describer/fake"
+	"github.com/vmware-tanzu/octant/internal/kubeconfig"
 	"github.com/vmware-tanzu/octant/internal/link"
 
 	"github.com/golang/mock/gomock"
@@ -22,6 +23,8 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	configFake "github.com/vmware-tanzu/octant/internal/config/fake"
+	"github.com/vmware-tanzu/octant/internal/kubectlplugin"
+	kubectlPluginFake "github.com/vmware-tanzu/octant/internal/kubectlplugin/fake"
 	"github.com/vmware-tanzu/octant/internal/octant"
 	"github.com/vmware-tanzu/octant/internal/testutil"
 	"github.com/vmware-tanzu/octant/pkg/action"
@@ -51,6 +54,12 @@ func TestObjectDescriber(t *testing.T) {
 
 	pluginManager := plugin.NewManager(nil, moduleRegistrar, actionRegistrar, wsClient)
 	dashConfig.EXPECT().PluginManager().Return(pluginManager).AnyTimes()
+	dashConfig.EXPECT().Contexts().Return([]kubeconfig.Context{{Name: "staging"}, {Name: "production"}})
+	dashConfig.EXPECT().CurrentContext().Return("staging")
+
+	kubectlPlugins := kubectlPluginFake.NewMockManager(controller)
+	kubectlPlugins.EXPECT().ForKind("Pod").Return([]kubectlplugin.Plugin{{Name: "tree", Path: "/usr/local/bin/kubectl-tree"}})
+	dashConfig.EXPECT().KubectlPlugins().Return(kubectlPlugins)
 
 	podSummary := component.NewText("summary")
 
@@ -101,12 +110,26 @@ func TestObjectDescriber(t *testing.T) {
 
 	buttonGroup := component.NewButtonGroup()
 
+	buttonGroup.AddButton(
+		component.NewButton("Export YAML",
+			action.CreatePayload(octant.ActionExportObject, key.ToActionPayload())))
+	compareModal, err := octant.CompareObjectModalButton(pod, []string{"staging", "production"}, "staging")
+	require.NoError(t, err)
+	buttonGroup.AddButton(
+		component.NewButton("Compare",
+			action.CreatePayload(octant.ActionCompareObject, key.ToActionPayload()),
+			compareModal))
+	treePayload := key.ToActionPayload()
+	treePayload["plugin"] = "tree"
+	buttonGroup.AddButton(
+		component.NewButton("kubectl tree",
+			action.CreatePayload(octant.ActionRunKubectlPlugin, treePayload)))
 	buttonGroup.AddButton(
 		component.NewButton("Delete",
 			action.CreatePayload(octant.ActionDeleteObject, key.ToActionPayload()),
 			component.WithButtonConfirmation(
 				"Delete Pod",
-				"Are you sure you want to delete *Pod* **pod**? This action is permanent and cannot be recovered.",
+				"Are you sure you want to delete *Pod* **pod**? This action is permanent and cannot be recovered.\n\nkubectl equivalent: `kubectl delete pod/pod --namespace namespace`",
 			)))
 
 	expected := component.ContentResponse{
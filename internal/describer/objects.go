@@ -12,6 +12,7 @@ import (
 	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	batchv1 "k8s.io/api/batch/v1"
 	batchv1beta1 "k8s.io/api/batch/v1beta1"
+	coordinationv1 "k8s.io/api/coordination/v1"
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
@@ -177,6 +178,14 @@ func initNamespacedOverview() *Section {
 		Titles:         ResourceTitle{List: "Config Maps", Object: "Config Maps"},
 	})
 
+	csLeases := NewResource(ResourceOptions{
+		Path:           "/config-and-storage/leases",
+		ObjectStoreKey: store.Key{APIVersion: "coordination.k8s.io/v1", Kind: "Lease"},
+		ListType:       &coordinationv1.LeaseList{},
+		ObjectType:     &coordinationv1.Lease{},
+		Titles:         ResourceTitle{List: "Leases", Object: "Leases"},
+	})
+
 	csPVCs := NewResource(ResourceOptions{
 		Path:           "/config-and-storage/persistent-volume-claims",
 		ObjectStoreKey: store.Key{APIVersion: "v1", Kind: "PersistentVolumeClaim"},
@@ -205,6 +214,7 @@ func initNamespacedOverview() *Section {
 		"/config-and-storage",
 		"Config and Storage",
 		csConfigMaps,
+		csLeases,
 		csPVCs,
 		csSecrets,
 		csServiceAccounts,
@@ -245,6 +255,8 @@ func initNamespacedOverview() *Section {
 	rootDescriber := NewSection(
 		"/",
 		"Overview",
+		NewFindings(),
+		NewRestarts(),
 		workloadsDescriber,
 		discoveryAndLoadBalancingDescriber,
 		configAndStorageDescriber,
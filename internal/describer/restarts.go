@@ -0,0 +1,343 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package describer
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vmware-tanzu/octant/internal/util/kubernetes"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+const (
+	// restartHeatmapHours is the number of hourly buckets in the restart heatmap.
+	restartHeatmapHours = 12
+	// restartWorkloadLimit is the most workloads shown in the heatmap and the
+	// top offenders table.
+	restartWorkloadLimit = 10
+	// restartErrorThreshold is the restarts in an hour shown as an error. Fewer
+	// restarts are shown as a warning.
+	restartErrorThreshold = 3
+	// restartBackOffMessage prefixes the message of the event the kubelet records
+	// when a container is crash looping. Image pulls back off with the same reason.
+	restartBackOffMessage = "Back-off restarting failed container"
+)
+
+var (
+	restartOffendersCols = component.NewTableCols("Workload", "Kind", "Pods", "Restarts", "Last Restart", "Last Reason")
+)
+
+// restartWorkload is a workload whose pods have restarting containers.
+type restartWorkload struct {
+	namespace  string
+	apiVersion string
+	kind       string
+	name       string
+
+	pods     map[string]bool
+	restarts int32
+	// lastRestart is when a container last terminated.
+	lastRestart time.Time
+	// lastReason is why the container which last terminated was terminated.
+	lastReason string
+	// hours counts restarts by hour. The first bucket is the current hour.
+	hours [restartHeatmapHours]int
+}
+
+// Restarts shows the containers restarting in a namespace. Restarts are shown
+// by hour for each workload, so crash looping workloads stand out. It has no
+// content when nothing restarted.
+type Restarts struct {
+	*base
+
+	now func() time.Time
+}
+
+var _ Describer = (*Restarts)(nil)
+
+// NewRestarts creates an instance of Restarts.
+func NewRestarts() *Restarts {
+	return &Restarts{
+		base: newBaseDescriber(),
+		now:  time.Now,
+	}
+}
+
+// Describe creates content.
+func (r *Restarts) Describe(ctx context.Context, namespace string, options Options) (component.ContentResponse, error) {
+	if options.Dash == nil {
+		return component.EmptyContentResponse, errors.New("restarts describer requires a dash config")
+	}
+
+	workloads, err := r.workloads(ctx, options.ObjectStore(), namespace)
+	if err != nil {
+		return component.EmptyContentResponse, err
+	}
+
+	heatmap := r.heatmap(workloads, options)
+	offenders := r.offenders(workloads, options)
+
+	list := component.NewList(component.TitleFromString("Restarts"), []component.Component{heatmap, offenders})
+
+	return component.ContentResponse{
+		Components: []component.Component{list},
+	}, nil
+}
+
+// workloads returns the workloads with restarting containers, most restarts
+// first. Restart counts come from pod status. The hour of a restart comes from
+// when a container last terminated, and from crash loop back-off events, whose
+// occurrences are spread over the time between the first and last event.
+func (r *Restarts) workloads(ctx context.Context, objectStore store.Store, namespace string) ([]*restartWorkload, error) {
+	now := r.now()
+
+	podKey := store.Key{Namespace: namespace, APIVersion: "v1", Kind: "Pod"}
+	podList, _, err := objectStore.List(ctx, podKey)
+	if err != nil {
+		return nil, errors.Wrapf(err, "list all objects for key %+v", podKey)
+	}
+
+	workloadsByKey := map[string]*restartWorkload{}
+	workloadsByPod := map[string]*restartWorkload{}
+
+	for i := range podList.Items {
+		pod := &corev1.Pod{}
+		if err := kubernetes.FromUnstructured(&podList.Items[i], pod); err != nil {
+			return nil, err
+		}
+
+		workload, err := restartWorkloadForPod(ctx, objectStore, pod, workloadsByKey)
+		if err != nil {
+			return nil, err
+		}
+		workloadsByPod[pod.Name] = workload
+
+		for _, containerStatus := range pod.Status.ContainerStatuses {
+			if containerStatus.RestartCount == 0 {
+				continue
+			}
+
+			workload.pods[pod.Name] = true
+			workload.restarts += containerStatus.RestartCount
+
+			terminated := containerStatus.LastTerminationState.Terminated
+			if terminated == nil {
+				continue
+			}
+
+			finishedAt := terminated.FinishedAt.Time
+			workload.addRestart(now, finishedAt)
+			if finishedAt.After(workload.lastRestart) {
+				workload.lastRestart = finishedAt
+				workload.lastReason = terminated.Reason
+			}
+		}
+	}
+
+	eventKey := store.Key{Namespace: namespace, APIVersion: "v1", Kind: "Event"}
+	eventList, _, err := objectStore.List(ctx, eventKey)
+	if err != nil {
+		return nil, errors.Wrapf(err, "list all objects for key %+v", eventKey)
+	}
+
+	for i := range eventList.Items {
+		event := &corev1.Event{}
+		if err := kubernetes.FromUnstructured(&eventList.Items[i], event); err != nil {
+			return nil, err
+		}
+
+		if event.InvolvedObject.Kind != "Pod" || !strings.HasPrefix(event.Message, restartBackOffMessage) {
+			continue
+		}
+
+		workload, ok := workloadsByPod[event.InvolvedObject.Name]
+		if !ok {
+			continue
+		}
+		workload.pods[event.InvolvedObject.Name] = true
+
+		first, last := event.FirstTimestamp.Time, event.LastTimestamp.Time
+		if last.IsZero() {
+			last = event.EventTime.Time
+		}
+		if first.IsZero() {
+			first = last
+		}
+
+		count := int(event.Count)
+		if count < 1 {
+			count = 1
+		}
+
+		span := last.Sub(first)
+		for occurrence := 0; occurrence < count; occurrence++ {
+			at := last
+			if count > 1 {
+				at = first.Add(span * time.Duration(occurrence) / time.Duration(count-1))
+			}
+			workload.addRestart(now, at)
+		}
+	}
+
+	var workloads []*restartWorkload
+	for _, workload := range workloadsByKey {
+		if len(workload.pods) > 0 {
+			workloads = append(workloads, workload)
+		}
+	}
+
+	sort.Slice(workloads, func(i, j int) bool {
+		if workloads[i].restarts != workloads[j].restarts {
+			return workloads[i].restarts > workloads[j].restarts
+		}
+		return workloads[i].key() < workloads[j].key()
+	})
+
+	if len(workloads) > restartWorkloadLimit {
+		workloads = workloads[:restartWorkloadLimit]
+	}
+
+	return workloads, nil
+}
+
+// heatmap creates a table with a row for each workload and a column for each
+// hour. Columns are named by how many hours ago they start.
+func (r *Restarts) heatmap(workloads []*restartWorkload, options Options) *component.Table {
+	cols := component.NewTableCols("Workload")
+	for hour := restartHeatmapHours - 1; hour >= 0; hour-- {
+		cols = append(cols, component.NewTableCols(restartHourName(hour))...)
+	}
+
+	table := component.NewTable("Restarts by Hour", "There are no restarting containers!", cols)
+
+	for _, workload := range workloads {
+		row := component.TableRow{
+			"Workload": workload.link(options),
+		}
+
+		for hour, count := range workload.hours {
+			cell := component.NewText("")
+			if count > 0 {
+				cell = component.NewText(fmt.Sprintf("%d", count))
+				cell.SetStatus(component.TextStatusWarning)
+				if count >= restartErrorThreshold {
+					cell.SetStatus(component.TextStatusError)
+				}
+			}
+			row[restartHourName(hour)] = cell
+		}
+
+		table.Add(row)
+	}
+
+	return table
+}
+
+// offenders creates a table of the workloads with the most restarts.
+func (r *Restarts) offenders(workloads []*restartWorkload, options Options) *component.Table {
+	table := component.NewTable("Top Restarting Workloads", "There are no restarting containers!", restartOffendersCols)
+
+	for _, workload := range workloads {
+		var lastRestart component.Component = component.NewText("")
+		if !workload.lastRestart.IsZero() {
+			lastRestart = component.NewTimestamp(workload.lastRestart)
+		}
+
+		table.Add(component.TableRow{
+			"Workload":     workload.link(options),
+			"Kind":         component.NewText(workload.kind),
+			"Pods":         component.NewText(fmt.Sprintf("%d", len(workload.pods))),
+			"Restarts":     component.NewText(fmt.Sprintf("%d", workload.restarts)),
+			"Last Restart": lastRestart,
+			"Last Reason":  component.NewText(workload.lastReason),
+		})
+	}
+
+	return table
+}
+
+// restartWorkloadForPod returns the workload which controls a pod. Replica sets
+// are resolved to the deployment which controls them. Pods without a controller
+// are their own workload.
+func restartWorkloadForPod(ctx context.Context, objectStore store.Store, pod *corev1.Pod, workloads map[string]*restartWorkload) (*restartWorkload, error) {
+	apiVersion, kind, name := "v1", "Pod", pod.Name
+
+	if owner := metav1.GetControllerOf(pod); owner != nil {
+		apiVersion, kind, name = owner.APIVersion, owner.Kind, owner.Name
+
+		if kind == "ReplicaSet" {
+			key := store.Key{Namespace: pod.Namespace, APIVersion: apiVersion, Kind: kind, Name: name}
+			replicaSet, err := objectStore.Get(ctx, key)
+			if err != nil {
+				return nil, errors.Wrapf(err, "get replica set %s", name)
+			}
+			if replicaSet != nil {
+				if replicaSetOwner := metav1.GetControllerOf(replicaSet); replicaSetOwner != nil {
+					apiVersion, kind, name = replicaSetOwner.APIVersion, replicaSetOwner.Kind, replicaSetOwner.Name
+				}
+			}
+		}
+	}
+
+	workload := &restartWorkload{
+		namespace:  pod.Namespace,
+		apiVersion: apiVersion,
+		kind:       kind,
+		name:       name,
+		pods:       map[string]bool{},
+	}
+
+	if existing, ok := workloads[workload.key()]; ok {
+		return existing, nil
+	}
+
+	workloads[workload.key()] = workload
+	return workload, nil
+}
+
+func (w *restartWorkload) key() string {
+	return fmt.Sprintf("%s/%s/%s", w.apiVersion, w.kind, w.name)
+}
+
+// addRestart counts a restart in the bucket of the hour it happened in. Restarts
+// older than the oldest bucket are ignored.
+func (w *restartWorkload) addRestart(now, at time.Time) {
+	if at.IsZero() || at.After(now) {
+		return
+	}
+
+	hour := int(now.Sub(at) / time.Hour)
+	if hour >= restartHeatmapHours {
+		return
+	}
+
+	w.hours[hour]++
+}
+
+// link links to the workload. Workloads octant has no view for are shown
+// without a link.
+func (w *restartWorkload) link(options Options) component.Component {
+	workloadLink, err := options.Link.ForGVK(w.namespace, w.apiVersion, w.kind, w.name, w.name)
+	if err != nil {
+		return component.NewText(w.name)
+	}
+	return workloadLink
+}
+
+// restartHourName names the heatmap column of an hour, e.g. 3h for restarts
+// between three and four hours ago.
+func restartHourName(hour int) string {
+	return fmt.Sprintf("%dh", hour)
+}
@@ -0,0 +1,169 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package describer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	configFake "github.com/vmware-tanzu/octant/internal/config/fake"
+	linkFake "github.com/vmware-tanzu/octant/internal/link/fake"
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	storeFake "github.com/vmware-tanzu/octant/pkg/store/fake"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func TestRestarts(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	now := time.Date(2020, 10, 1, 12, 30, 0, 0, time.UTC)
+
+	deployment := testutil.CreateDeployment("web")
+	replicaSet := testutil.CreateAppReplicaSet("web-1234")
+	replicaSet.OwnerReferences = testutil.ToOwnerReferences(t, deployment)
+
+	webPod := testutil.CreatePod("web-1234-abcd")
+	webPod.OwnerReferences = testutil.ToOwnerReferences(t, replicaSet)
+	webPod.Status.ContainerStatuses = []corev1.ContainerStatus{
+		{
+			Name:         "app",
+			RestartCount: 5,
+			LastTerminationState: corev1.ContainerState{
+				Terminated: &corev1.ContainerStateTerminated{
+					Reason:     "OOMKilled",
+					FinishedAt: metav1.NewTime(now.Add(-10 * time.Minute)),
+				},
+			},
+		},
+	}
+
+	standalonePod := testutil.CreatePod("standalone")
+	standalonePod.Status.ContainerStatuses = []corev1.ContainerStatus{
+		{
+			Name:         "app",
+			RestartCount: 1,
+			LastTerminationState: corev1.ContainerState{
+				Terminated: &corev1.ContainerStateTerminated{
+					Reason:     "Error",
+					FinishedAt: metav1.NewTime(now.Add(-3*time.Hour - 10*time.Minute)),
+				},
+			},
+		},
+	}
+
+	healthyPod := testutil.CreatePod("healthy")
+
+	backOff := testutil.CreateEvent("back-off")
+	backOff.InvolvedObject = corev1.ObjectReference{Kind: "Pod", Name: webPod.Name}
+	backOff.Message = "Back-off restarting failed container"
+	backOff.Count = 3
+	backOff.FirstTimestamp = metav1.NewTime(now.Add(-2*time.Hour - 15*time.Minute))
+	backOff.LastTimestamp = metav1.NewTime(now.Add(-15 * time.Minute))
+
+	pullBackOff := testutil.CreateEvent("pull-back-off")
+	pullBackOff.InvolvedObject = corev1.ObjectReference{Kind: "Pod", Name: healthyPod.Name}
+	pullBackOff.Message = `Back-off pulling image "app"`
+	pullBackOff.Count = 1
+	pullBackOff.LastTimestamp = metav1.NewTime(now.Add(-time.Minute))
+
+	objectStore := storeFake.NewMockStore(controller)
+	objectStore.EXPECT().
+		List(gomock.Any(), store.Key{Namespace: "namespace", APIVersion: "v1", Kind: "Pod"}).
+		Return(testutil.ToUnstructuredList(t, webPod, standalonePod, healthyPod), false, nil)
+	objectStore.EXPECT().
+		List(gomock.Any(), store.Key{Namespace: "namespace", APIVersion: "v1", Kind: "Event"}).
+		Return(testutil.ToUnstructuredList(t, backOff, pullBackOff), false, nil)
+	objectStore.EXPECT().
+		Get(gomock.Any(), store.Key{Namespace: "namespace", APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "web-1234"}).
+		Return(testutil.ToUnstructured(t, replicaSet), nil)
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().ObjectStore().Return(objectStore).AnyTimes()
+
+	webLink := component.NewLink("", "web", "/web")
+	standaloneLink := component.NewLink("", "standalone", "/standalone")
+	linkGenerator := linkFake.NewMockInterface(controller)
+	linkGenerator.EXPECT().
+		ForGVK("namespace", "apps/v1", "Deployment", "web", "web").
+		Return(webLink, nil).
+		Times(2)
+	linkGenerator.EXPECT().
+		ForGVK("namespace", "v1", "Pod", "standalone", "standalone").
+		Return(standaloneLink, nil).
+		Times(2)
+
+	options := Options{
+		Dash: dashConfig,
+		Link: linkGenerator,
+	}
+
+	restarts := NewRestarts()
+	restarts.now = func() time.Time { return now }
+
+	got, err := restarts.Describe(context.Background(), "namespace", options)
+	require.NoError(t, err)
+
+	heatmapCell := func(count string, status component.TextStatus) *component.Text {
+		text := component.NewText(count)
+		if count != "" {
+			text.SetStatus(status)
+		}
+		return text
+	}
+
+	cols := component.NewTableCols("Workload", "11h", "10h", "9h", "8h", "7h", "6h", "5h", "4h", "3h", "2h", "1h", "0h")
+	heatmap := component.NewTable("Restarts by Hour", "There are no restarting containers!", cols)
+	webRow := component.TableRow{"Workload": webLink}
+	standaloneRow := component.TableRow{"Workload": standaloneLink}
+	for hour := 0; hour < restartHeatmapHours; hour++ {
+		webRow[restartHourName(hour)] = heatmapCell("", component.TextStatusOK)
+		standaloneRow[restartHourName(hour)] = heatmapCell("", component.TextStatusOK)
+	}
+	// The container terminated and the back-off event was last seen in the
+	// current hour. The first back-off event was two hours ago.
+	webRow["0h"] = heatmapCell("2", component.TextStatusWarning)
+	webRow["1h"] = heatmapCell("1", component.TextStatusWarning)
+	webRow["2h"] = heatmapCell("1", component.TextStatusWarning)
+	standaloneRow["3h"] = heatmapCell("1", component.TextStatusWarning)
+	heatmap.Add(webRow, standaloneRow)
+
+	offenders := component.NewTable("Top Restarting Workloads", "There are no restarting containers!", restartOffendersCols)
+	offenders.Add(
+		component.TableRow{
+			"Workload":     webLink,
+			"Kind":         component.NewText("Deployment"),
+			"Pods":         component.NewText("1"),
+			"Restarts":     component.NewText("5"),
+			"Last Restart": component.NewTimestamp(now.Add(-10 * time.Minute)),
+			"Last Reason":  component.NewText("OOMKilled"),
+		},
+		component.TableRow{
+			"Workload":     standaloneLink,
+			"Kind":         component.NewText("Pod"),
+			"Pods":         component.NewText("1"),
+			"Restarts":     component.NewText("1"),
+			"Last Restart": component.NewTimestamp(now.Add(-3*time.Hour - 10*time.Minute)),
+			"Last Reason":  component.NewText("Error"),
+		},
+	)
+
+	expected := component.ContentResponse{
+		Components: []component.Component{
+			component.NewList(component.TitleFromString("Restarts"), []component.Component{heatmap, offenders}),
+		},
+	}
+
+	assert.Equal(t, expected, got)
+}
@@ -7,9 +7,12 @@ package describer
 
 import (
 	"context"
+	"sync"
 
 	"github.com/pkg/errors"
+	"golang.org/x/sync/errgroup"
 
+	"github.com/vmware-tanzu/octant/internal/log"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
 
@@ -46,16 +49,61 @@ func (d *Section) Describe(ctx context.Context, namespace string, options Option
 	return cr, nil
 }
 
+// Component generates a list containing the items described by each of the section's
+// describers. Describers run concurrently. A describer which fails is shown as an error
+// instead of failing the whole section, and a describer which isn't finished by the
+// content deadline is shown as loading.
 func (d *Section) Component(ctx context.Context, namespace string, options Options) (*component.List, error) {
 	title := component.Title(component.NewText(d.title))
 	list := component.NewList(title, nil)
 
+	var mu sync.Mutex
+	responses := make([]component.ContentResponse, len(d.describers))
+	errs := make([]error, len(d.describers))
+	finished := make([]bool, len(d.describers))
+
+	var g errgroup.Group
+	for describerIndex := range d.describers {
+		describerIndex := describerIndex
+		g.Go(func() error {
+			response, err := d.describers[describerIndex].Describe(ctx, namespace, options)
+
+			mu.Lock()
+			responses[describerIndex], errs[describerIndex] = response, err
+			finished[describerIndex] = true
+			mu.Unlock()
+			return nil
+		})
+	}
+
+	done := make(chan struct{})
+	go func() {
+		_ = g.Wait()
+		close(done)
+	}()
+	awaitContentDeadline(ctx, done)
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	// Describers which finish late don't change the results after this point.
+	mu.Lock()
+	defer mu.Unlock()
+
 	for describerIndex := range d.describers {
-		cResponse, err := d.describers[describerIndex].Describe(ctx, namespace, options)
-		if err != nil {
-			return nil, err
+		if !finished[describerIndex] {
+			list.Add(createPendingComponent())
+			continue
+		}
+
+		if err := errs[describerIndex]; err != nil {
+			log.From(ctx).WithErr(err).Errorf("describe section %s", d.path)
+			list.Add(component.NewError(component.TitleFromString("Error"), err))
+			continue
 		}
 
+		cResponse := responses[describerIndex]
 		for componentIndex := range cResponse.Components {
 			if nestedList, ok := cResponse.Components[componentIndex].(*component.List); ok {
 				for itemIndex := range nestedList.Config.Items {
@@ -7,13 +7,17 @@ package describer
 
 import (
 	"context"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	configFake "github.com/vmware-tanzu/octant/internal/config/fake"
+	"github.com/vmware-tanzu/octant/internal/contentcache"
+	ocontext "github.com/vmware-tanzu/octant/internal/context"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
 
@@ -92,3 +96,81 @@ func TestSectionDescriber(t *testing.T) {
 	}
 
 }
+
+type errorDescriber struct {
+	*StubDescriber
+}
+
+func (d *errorDescriber) Describe(context.Context, string, Options) (component.ContentResponse, error) {
+	return component.EmptyContentResponse, fmt.Errorf("failed")
+}
+
+func TestSectionDescriber_error(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	options := Options{
+		Dash: configFake.NewMockDash(controller),
+	}
+
+	item := component.NewText("item")
+	d := NewSection(
+		"/section",
+		"section",
+		&errorDescriber{StubDescriber: NewEmptyDescriber("/foo")},
+		NewStubDescriber("/bar", component.NewList(component.TitleFromString("bar"), []component.Component{item})),
+	)
+
+	got, err := d.Component(context.Background(), "default", options)
+	require.NoError(t, err)
+
+	expected := component.NewList(component.TitleFromString("section"), []component.Component{
+		component.NewError(component.TitleFromString("Error"), fmt.Errorf("failed")),
+		item,
+	})
+	assert.Equal(t, expected, got)
+}
+
+type blockedDescriber struct {
+	*StubDescriber
+	release chan struct{}
+}
+
+func (d *blockedDescriber) Describe(context.Context, string, Options) (component.ContentResponse, error) {
+	<-d.release
+	return component.EmptyContentResponse, nil
+}
+
+func TestSectionDescriber_contentDeadline(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	options := Options{
+		Dash: configFake.NewMockDash(controller),
+	}
+
+	blocked := &blockedDescriber{StubDescriber: NewEmptyDescriber("/foo"), release: make(chan struct{})}
+	defer close(blocked.release)
+
+	item := component.NewText("item")
+	d := NewSection(
+		"/section",
+		"section",
+		blocked,
+		NewStubDescriber("/bar", component.NewList(component.TitleFromString("bar"), []component.Component{item})),
+	)
+
+	dependencies := contentcache.NewDependencies()
+	ctx := contentcache.WithDependencies(context.Background(), dependencies)
+	ctx = ocontext.WithContentDeadline(ctx, time.Now().Add(10*time.Millisecond))
+
+	got, err := d.Component(ctx, "default", options)
+	require.NoError(t, err)
+
+	expected := component.NewList(component.TitleFromString("section"), []component.Component{
+		createPendingComponent(),
+		item,
+	})
+	assert.Equal(t, expected, got)
+	assert.False(t, dependencies.Complete())
+}
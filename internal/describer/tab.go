@@ -13,6 +13,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 
+	"github.com/vmware-tanzu/octant/internal/featuregate"
 	"github.com/vmware-tanzu/octant/internal/link"
 	"github.com/vmware-tanzu/octant/internal/log"
 	"github.com/vmware-tanzu/octant/internal/modules/overview/logviewer"
@@ -49,7 +50,13 @@ func CustomResourceSummaryTab(crdName string) TabFactory {
 			ObjectFactory: printer.NewDefaultObjectFactory(),
 		}
 
-		return printer.CustomResourceHandler(ctx, crd, cr, printOptions)
+		summary, err := printer.CustomResourceHandler(ctx, crd, cr, printOptions)
+		if err != nil {
+			return nil, err
+		}
+
+		summary.SetAccessor("summary")
+		return summary, nil
 	}
 }
 
@@ -124,10 +131,10 @@ func LogsTab(_ context.Context, object runtime.Object, _ Options) (component.Com
 	return nil, nil
 }
 
-// TerminalTab generates a terminal tab for a pod. If the object is not a pod,
-// the returned component will be nil with a nil error.
+// TerminalTab generates a terminal tab for a pod. If the object is not a pod or
+// exec is disabled, the returned component will be nil with a nil error.
 func TerminalTab(ctx context.Context, object runtime.Object, options Options) (component.Component, error) {
-	if isPod(object) {
+	if isPod(object) && options.Dash.FeatureGate().Enabled(featuregate.Exec) {
 		logger := log.From(ctx)
 
 		terminalComponent, err := terminalviewer.ToComponent(ctx, object, logger, options.Dash)
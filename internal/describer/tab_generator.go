@@ -64,6 +64,8 @@ func (t ObjectTabsGenerator) Generate(ctx context.Context, config TabsGeneratorC
 	logger := log.From(ctx)
 
 	var indexedComponents []indexedComponent
+	// pending are the indexes of tabs which are being generated.
+	pending := map[int]bool{}
 	var mu sync.Mutex
 
 	var g errgroup.Group
@@ -78,27 +80,68 @@ func (t ObjectTabsGenerator) Generate(ctx context.Context, config TabsGeneratorC
 	for i := range descriptors {
 		i := i
 		descriptor := descriptors[i]
+
+		mu.Lock()
+		if !isTabRequested(descriptor, config.Options.Tab) {
+			indexedComponents = append(indexedComponents, indexedComponent{
+				c:     CreatePlaceholderTab(descriptor),
+				index: i,
+			})
+			mu.Unlock()
+			continue
+		}
+		pending[i] = true
+		mu.Unlock()
+
 		g.Go(func() error {
+			if ctx.Err() != nil {
+				return nil
+			}
+
 			c, err := descriptor.Factory(ctx, config.Object, config.Options)
 			if err != nil {
 				c = CreateErrorTab(descriptor.Name, err)
 			}
 
 			mu.Lock()
-			indexedComponents = append(indexedComponents, indexedComponent{
-				c:     c,
-				index: i,
-			})
+			if pending[i] {
+				indexedComponents = append(indexedComponents, indexedComponent{
+					c:     c,
+					index: i,
+				})
+				delete(pending, i)
+			}
 			mu.Unlock()
 
 			return nil
 		})
 	}
 
-	if err := g.Wait(); err != nil {
-		logger.WithErr(err).Errorf("create tabs")
+	done := make(chan struct{})
+	go func() {
+		if err := g.Wait(); err != nil {
+			logger.WithErr(err).Errorf("create tabs")
+		}
+		close(done)
+	}()
+	awaitContentDeadline(ctx, done)
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
 	}
 
+	mu.Lock()
+	// Tabs which weren't generated by the content deadline are shown as loading, and
+	// aren't added if they finish later.
+	for i := range pending {
+		indexedComponents = append(indexedComponents, indexedComponent{
+			c:     CreatePlaceholderTab(descriptors[i]),
+			index: i,
+		})
+		delete(pending, i)
+	}
+	mu.Unlock()
+
 	sort.Slice(indexedComponents, func(i, j int) bool {
 		return indexedComponents[i].index < indexedComponents[j].index
 	})
@@ -113,6 +156,18 @@ func (t ObjectTabsGenerator) Generate(ctx context.Context, config TabsGeneratorC
 	return list, nil
 }
 
+// isTabRequested returns true if a tab should be generated for the requested tab.
+func isTabRequested(descriptor Tab, requested string) bool {
+	return requested == "" || descriptor.Accessor == "" || descriptor.Accessor == requested
+}
+
+// CreatePlaceholderTab creates a tab which is shown until its content is requested.
+func CreatePlaceholderTab(descriptor Tab) component.Component {
+	placeholder := component.NewLoading(component.TitleFromString(descriptor.Name), fmt.Sprintf("Loading %s", descriptor.Name))
+	placeholder.SetAccessor(descriptor.Accessor)
+	return placeholder
+}
+
 // CreateErrorTab creates an error tab given a name and an error.
 func CreateErrorTab(name string, err error) component.Component {
 	errComponent := component.NewError(component.TitleFromString(name), err)
@@ -135,6 +190,9 @@ type TabFactory func(
 type Tab struct {
 	// Name is the name of the tab.
 	Name string
+	// Accessor is the accessor of the tab's component. Tabs with an accessor are only
+	// generated when they are requested; tabs without one are always generated.
+	Accessor string
 	// Factory is a function that generates the contents for a tab (as a component).
 	Factory TabFactory
 }
@@ -10,12 +10,14 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 	"k8s.io/apimachinery/pkg/runtime"
 
 	configFake "github.com/vmware-tanzu/octant/internal/config/fake"
+	ocontext "github.com/vmware-tanzu/octant/internal/context"
 	pluginFake "github.com/vmware-tanzu/octant/pkg/plugin/fake"
 
 	"github.com/vmware-tanzu/octant/internal/testutil"
@@ -54,6 +56,112 @@ func TestObjectTabsGenerator_Generate(t *testing.T) {
 	testutil.AssertJSONEqual(t, wanted, actual)
 }
 
+func TestObjectTabsGenerator_Generate_requestedTab(t *testing.T) {
+	g := NewObjectTabsGenerator()
+
+	summary := component.NewText("summary")
+	summary.SetAccessor("summary")
+	logs := component.NewText("logs")
+	logs.SetAccessor("logs")
+
+	factory := func(c component.Component) TabFactory {
+		return func(ctx context.Context, object runtime.Object, options Options) (component.Component, error) {
+			return c, nil
+		}
+	}
+
+	resourceViewer := Tab{
+		Name:     "Resource Viewer",
+		Accessor: "resourceViewer",
+		Factory: func(ctx context.Context, object runtime.Object, options Options) (component.Component, error) {
+			return nil, fmt.Errorf("resource viewer should not be generated")
+		},
+	}
+
+	config := TabsGeneratorConfig{
+		Object: testutil.CreatePod("pod"),
+		TabsFactory: func() ([]Tab, error) {
+			return []Tab{
+				{Name: "Summary", Accessor: "summary", Factory: factory(summary)},
+				resourceViewer,
+				{Name: "Logs", Factory: factory(logs)},
+			}, nil
+		},
+		Options: Options{Tab: "summary"},
+	}
+
+	actual, err := g.Generate(context.Background(), config)
+	require.NoError(t, err)
+
+	wanted := []component.Component{summary, CreatePlaceholderTab(resourceViewer), logs}
+	testutil.AssertJSONEqual(t, wanted, actual)
+}
+
+func TestObjectTabsGenerator_Generate_canceled(t *testing.T) {
+	g := NewObjectTabsGenerator()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	config := TabsGeneratorConfig{
+		Object: testutil.CreatePod("pod"),
+		TabsFactory: func() ([]Tab, error) {
+			return []Tab{
+				{
+					Name: "tab",
+					Factory: func(ctx context.Context, object runtime.Object, options Options) (component.Component, error) {
+						return nil, fmt.Errorf("tab should not be generated")
+					},
+				},
+			}, nil
+		},
+	}
+
+	_, err := g.Generate(ctx, config)
+	require.Equal(t, context.Canceled, err)
+}
+
+func TestObjectTabsGenerator_Generate_contentDeadline(t *testing.T) {
+	g := NewObjectTabsGenerator()
+
+	summary := component.NewText("summary")
+	summary.SetAccessor("summary")
+
+	release := make(chan struct{})
+	defer close(release)
+
+	logs := Tab{
+		Name: "Logs",
+		Factory: func(ctx context.Context, object runtime.Object, options Options) (component.Component, error) {
+			<-release
+			return component.NewText("logs"), nil
+		},
+	}
+
+	config := TabsGeneratorConfig{
+		Object: testutil.CreatePod("pod"),
+		TabsFactory: func() ([]Tab, error) {
+			return []Tab{
+				{
+					Name:     "Summary",
+					Accessor: "summary",
+					Factory: func(ctx context.Context, object runtime.Object, options Options) (component.Component, error) {
+						return summary, nil
+					},
+				},
+				logs,
+			}, nil
+		},
+	}
+
+	ctx := ocontext.WithContentDeadline(context.Background(), time.Now().Add(10*time.Millisecond))
+	actual, err := g.Generate(ctx, config)
+	require.NoError(t, err)
+
+	wanted := []component.Component{summary, CreatePlaceholderTab(logs)}
+	testutil.AssertJSONEqual(t, wanted, actual)
+}
+
 func TestCreateErrorTab(t *testing.T) {
 	actual := CreateErrorTab("Name", fmt.Errorf("error"))
 	wanted := component.NewError(component.TitleFromString("Name"), fmt.Errorf("error"))
@@ -0,0 +1,265 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package doctor
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+
+	"github.com/vmware-tanzu/octant/internal/cluster"
+	"github.com/vmware-tanzu/octant/internal/objectstore"
+	"github.com/vmware-tanzu/octant/pkg/plugin"
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+// Status is the result of a check.
+type Status string
+
+const (
+	// StatusOK means the check passed.
+	StatusOK Status = "ok"
+	// StatusWarning means Octant will work with reduced functionality.
+	StatusWarning Status = "warning"
+	// StatusError means Octant will not work until the problem is fixed.
+	StatusError Status = "error"
+)
+
+// Finding is the outcome of a single check.
+type Finding struct {
+	// Check is the name of the check.
+	Check string `json:"check"`
+	// Status is the status of the check.
+	Status Status `json:"status"`
+	// Message describes what was found.
+	Message string `json:"message"`
+	// Remedy suggests how the problem can be fixed.
+	Remedy string `json:"remedy,omitempty"`
+}
+
+// Findings is a list of findings.
+type Findings []Finding
+
+// HasErrors returns true if any finding is an error.
+func (f Findings) HasErrors() bool {
+	for _, finding := range f {
+		if finding.Status == StatusError {
+			return true
+		}
+	}
+	return false
+}
+
+// Print writes findings in a human readable format.
+func (f Findings) Print(w io.Writer) {
+	for _, finding := range f {
+		fmt.Fprintf(w, "[%s] %s: %s\n", strings.ToUpper(string(finding.Status)), finding.Check, finding.Message)
+		if finding.Remedy != "" {
+			fmt.Fprintf(w, "    %s\n", finding.Remedy)
+		}
+	}
+}
+
+var (
+	// coreKinds are the kinds Octant needs to list to show a useful overview.
+	coreKinds = []schema.GroupVersionKind{
+		{Version: "v1", Kind: "Pod"},
+		{Version: "v1", Kind: "Service"},
+		{Version: "v1", Kind: "ConfigMap"},
+		{Version: "v1", Kind: "Secret"},
+		{Version: "v1", Kind: "Event"},
+		{Group: "apps", Version: "v1", Kind: "Deployment"},
+		{Group: "apps", Version: "v1", Kind: "ReplicaSet"},
+		{Group: "apps", Version: "v1", Kind: "StatefulSet"},
+		{Group: "apps", Version: "v1", Kind: "DaemonSet"},
+		{Group: "batch", Version: "v1", Kind: "Job"},
+	}
+
+	// clusterKinds are cluster scoped kinds used by the cluster overview.
+	clusterKinds = []schema.GroupVersionKind{
+		{Version: "v1", Kind: "Namespace"},
+		{Version: "v1", Kind: "Node"},
+	}
+
+	metricsGroupVersion = "metrics.k8s.io/v1beta1"
+)
+
+// CheckCluster runs checks against a cluster. It checks the API server can be
+// reached, discovery is healthy, the user can list core kinds in namespace, and
+// whether metrics-server is installed.
+func CheckCluster(ctx context.Context, client cluster.ClientInterface, namespace string) Findings {
+	var findings Findings
+
+	discoveryClient, err := client.DiscoveryClient()
+	if err != nil {
+		return append(findings, Finding{
+			Check:   "api server",
+			Status:  StatusError,
+			Message: fmt.Sprintf("unable to create discovery client: %v", err),
+		})
+	}
+
+	version, err := discoveryClient.ServerVersion()
+	if err != nil {
+		return append(findings, Finding{
+			Check:   "api server",
+			Status:  StatusError,
+			Message: fmt.Sprintf("unable to reach the API server: %v", err),
+			Remedy:  "check the cluster is running and the server address in your kube config is reachable",
+		})
+	}
+	findings = append(findings, Finding{
+		Check:   "api server",
+		Status:  StatusOK,
+		Message: fmt.Sprintf("reachable, Kubernetes %s", version.GitVersion),
+	})
+
+	findings = append(findings, checkDiscovery(discoveryClient))
+	findings = append(findings, checkAccess(ctx, objectstore.NewResourceAccess(client), namespace)...)
+	findings = append(findings, checkMetricsServer(discoveryClient))
+
+	return findings
+}
+
+func checkDiscovery(discoveryClient discovery.DiscoveryInterface) Finding {
+	_, err := discoveryClient.ServerPreferredResources()
+	if err == nil {
+		return Finding{Check: "discovery", Status: StatusOK, Message: "all API groups are available"}
+	}
+
+	if failed, ok := err.(*discovery.ErrGroupDiscoveryFailed); ok {
+		var groups []string
+		for gv := range failed.Groups {
+			groups = append(groups, gv.String())
+		}
+		return Finding{
+			Check:   "discovery",
+			Status:  StatusWarning,
+			Message: fmt.Sprintf("unable to discover API groups: %s", strings.Join(groups, ", ")),
+			Remedy:  "check the API services backing these groups with `kubectl get apiservices`",
+		}
+	}
+
+	return Finding{
+		Check:   "discovery",
+		Status:  StatusError,
+		Message: fmt.Sprintf("discovery failed: %v", err),
+	}
+}
+
+func checkAccess(ctx context.Context, access objectstore.ResourceAccess, namespace string) Findings {
+	var findings Findings
+
+	check := func(gvk schema.GroupVersionKind, namespace string) {
+		key := store.KeyFromGroupVersionKind(gvk)
+		key.Namespace = namespace
+
+		name := fmt.Sprintf("rbac %s", gvk.Kind)
+		if err := access.HasAccess(ctx, key, "list"); err != nil {
+			scope := "cluster wide"
+			if namespace != "" {
+				scope = fmt.Sprintf("in namespace %q", namespace)
+			}
+			findings = append(findings, Finding{
+				Check:   name,
+				Status:  StatusWarning,
+				Message: fmt.Sprintf("unable to list %s %s: %v", gvk.Kind, scope, err),
+				Remedy:  "ask your cluster administrator for list access or use --namespace-list to restrict Octant",
+			})
+			return
+		}
+
+		findings = append(findings, Finding{
+			Check:   name,
+			Status:  StatusOK,
+			Message: "list allowed",
+		})
+	}
+
+	for _, gvk := range coreKinds {
+		check(gvk, namespace)
+	}
+	for _, gvk := range clusterKinds {
+		check(gvk, "")
+	}
+
+	return findings
+}
+
+func checkMetricsServer(discoveryClient discovery.DiscoveryInterface) Finding {
+	resources, err := discoveryClient.ServerResourcesForGroupVersion(metricsGroupVersion)
+	if err != nil || resources == nil || len(resources.APIResources) == 0 {
+		return Finding{
+			Check:   "metrics server",
+			Status:  StatusWarning,
+			Message: "metrics-server was not found; resource usage will not be shown",
+			Remedy:  "install metrics-server: https://github.com/kubernetes-sigs/metrics-server",
+		}
+	}
+
+	return Finding{Check: "metrics server", Status: StatusOK, Message: fmt.Sprintf("%s is available", metricsGroupVersion)}
+}
+
+// CheckPlugins finds installed plugins and performs a handshake with each binary
+// plugin. JavaScript plugins are listed but not started.
+func CheckPlugins(ctx context.Context, config plugin.Config, clientFactory plugin.ClientFactory) Findings {
+	var findings Findings
+
+	list, err := plugin.AvailablePlugins(config)
+	if err != nil {
+		return append(findings, Finding{
+			Check:   "plugins",
+			Status:  StatusWarning,
+			Message: fmt.Sprintf("unable to list plugins: %v", err),
+		})
+	}
+
+	if len(list) == 0 {
+		return append(findings, Finding{Check: "plugins", Status: StatusOK, Message: "no plugins installed"})
+	}
+
+	for _, pluginPath := range list {
+		name := fmt.Sprintf("plugin %s", filepath.Base(pluginPath))
+
+		if plugin.IsJavaScriptPlugin(pluginPath) {
+			findings = append(findings, Finding{Check: name, Status: StatusOK, Message: "JavaScript plugin found"})
+			continue
+		}
+
+		findings = append(findings, handshake(name, clientFactory.Init(ctx, pluginPath)))
+	}
+
+	return findings
+}
+
+func handshake(name string, client plugin.Client) Finding {
+	defer client.Kill()
+
+	rpcClient, err := client.Client()
+	if err != nil {
+		return Finding{
+			Check:   name,
+			Status:  StatusError,
+			Message: fmt.Sprintf("handshake failed: %v", err),
+			Remedy:  fmt.Sprintf("rebuild the plugin against plugin API version %d", plugin.Handshake.ProtocolVersion),
+		}
+	}
+
+	if _, err := rpcClient.Dispense("plugin"); err != nil {
+		return Finding{
+			Check:   name,
+			Status:  StatusError,
+			Message: fmt.Sprintf("unable to dispense plugin: %v", err),
+		}
+	}
+
+	return Finding{Check: name, Status: StatusOK, Message: "handshake succeeded"}
+}
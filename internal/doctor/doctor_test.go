@@ -0,0 +1,170 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package doctor
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	goplugin "github.com/hashicorp/go-plugin"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+
+	clusterFake "github.com/vmware-tanzu/octant/internal/cluster/fake"
+	"github.com/vmware-tanzu/octant/internal/objectstore"
+	"github.com/vmware-tanzu/octant/pkg/plugin"
+	pluginFake "github.com/vmware-tanzu/octant/pkg/plugin/fake"
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+func TestCheckCluster_unreachable(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	discoveryClient := clusterFake.NewMockDiscoveryInterface(controller)
+	discoveryClient.EXPECT().ServerVersion().Return(nil, errors.New("connection refused"))
+
+	client := clusterFake.NewMockClientInterface(controller)
+	client.EXPECT().DiscoveryClient().Return(discoveryClient, nil)
+
+	findings := CheckCluster(context.Background(), client, "default")
+	require.Len(t, findings, 1)
+	assert.Equal(t, StatusError, findings[0].Status)
+	assert.True(t, findings.HasErrors())
+}
+
+func Test_checkDiscovery(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	discoveryClient := clusterFake.NewMockDiscoveryInterface(controller)
+	discoveryClient.EXPECT().ServerPreferredResources().Return(nil, &discovery.ErrGroupDiscoveryFailed{
+		Groups: map[schema.GroupVersion]error{
+			{Group: "metrics.k8s.io", Version: "v1beta1"}: errors.New("unavailable"),
+		},
+	})
+
+	got := checkDiscovery(discoveryClient)
+	assert.Equal(t, StatusWarning, got.Status)
+	assert.Contains(t, got.Message, "metrics.k8s.io/v1beta1")
+}
+
+func Test_checkMetricsServer(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	discoveryClient := clusterFake.NewMockDiscoveryInterface(controller)
+	discoveryClient.EXPECT().ServerResourcesForGroupVersion(metricsGroupVersion).Return(&metav1.APIResourceList{
+		APIResources: []metav1.APIResource{{Name: "pods"}},
+	}, nil)
+	assert.Equal(t, StatusOK, checkMetricsServer(discoveryClient).Status)
+
+	discoveryClient.EXPECT().ServerResourcesForGroupVersion(metricsGroupVersion).Return(nil, errors.New("not found"))
+	assert.Equal(t, StatusWarning, checkMetricsServer(discoveryClient).Status)
+}
+
+func Test_checkAccess(t *testing.T) {
+	access := &fakeAccess{denied: map[string]bool{"Secret": true, "Node": true}}
+
+	findings := checkAccess(context.Background(), access, "default")
+	require.Len(t, findings, len(coreKinds)+len(clusterKinds))
+
+	statuses := map[string]Status{}
+	for _, finding := range findings {
+		statuses[finding.Check] = finding.Status
+	}
+
+	assert.Equal(t, StatusOK, statuses["rbac Pod"])
+	assert.Equal(t, StatusWarning, statuses["rbac Secret"])
+	assert.Equal(t, StatusWarning, statuses["rbac Node"])
+	assert.False(t, findings.HasErrors())
+}
+
+func TestCheckPlugins(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	fs := afero.NewMemMapFs()
+	require.NoError(t, afero.WriteFile(fs, "/home/.config/octant/plugins/good", []byte(""), 0755))
+	require.NoError(t, afero.WriteFile(fs, "/home/.config/octant/plugins/bad", []byte(""), 0755))
+	require.NoError(t, afero.WriteFile(fs, "/home/.config/octant/plugins/script.js", []byte(""), 0644))
+
+	protocol := pluginFake.NewMockClientProtocol(controller)
+	protocol.EXPECT().Dispense("plugin").Return(nil, nil)
+
+	clientFactory := pluginFake.NewMockClientFactory(controller)
+	clientFactory.EXPECT().Init(gomock.Any(), "/home/.config/octant/plugins/good").
+		Return(&fakeClient{protocol: protocol})
+	clientFactory.EXPECT().Init(gomock.Any(), "/home/.config/octant/plugins/bad").
+		Return(&fakeClient{err: errors.New("incompatible API version")})
+
+	findings := CheckPlugins(context.Background(), &fakeConfig{fs: fs}, clientFactory)
+
+	var buf bytes.Buffer
+	findings.Print(&buf)
+
+	assert.Contains(t, buf.String(), "[OK] plugin good: handshake succeeded")
+	assert.Contains(t, buf.String(), "[ERROR] plugin bad: handshake failed: incompatible API version")
+	assert.Contains(t, buf.String(), "[OK] plugin script.js: JavaScript plugin found")
+	assert.True(t, findings.HasErrors())
+}
+
+type fakeAccess struct {
+	objectstore.ResourceAccess
+	denied map[string]bool
+}
+
+func (f *fakeAccess) HasAccess(_ context.Context, key store.Key, _ string) error {
+	if f.denied[key.Kind] {
+		return errors.New("forbidden")
+	}
+	return nil
+}
+
+type fakeClient struct {
+	protocol goplugin.ClientProtocol
+	err      error
+	killed   bool
+}
+
+var _ plugin.Client = (*fakeClient)(nil)
+
+func (f *fakeClient) Client() (goplugin.ClientProtocol, error) {
+	return f.protocol, f.err
+}
+
+func (f *fakeClient) Kill() {
+	f.killed = true
+}
+
+type fakeConfig struct {
+	fs afero.Fs
+}
+
+var _ plugin.Config = (*fakeConfig)(nil)
+
+func (f *fakeConfig) PluginDirs(home string) ([]string, error) {
+	return []string{home + "/.config/octant/plugins"}, nil
+}
+
+func (f *fakeConfig) Home() string {
+	return "/home"
+}
+
+func (f *fakeConfig) Fs() afero.Fs {
+	return f.fs
+}
+
+func (f *fakeConfig) OS() string {
+	return "linux"
+}
@@ -0,0 +1,246 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package explain
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	openapi_v2 "github.com/googleapis/gnostic/openapiv2"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/kube-openapi/pkg/util/proto"
+)
+
+//go:generate mockgen -destination=./fake/mock_explainer.go -package=fake github.com/vmware-tanzu/octant/internal/explain Explainer
+
+// groupVersionKindExtension is the OpenAPI extension which names the kinds a
+// model is used for.
+const groupVersionKindExtension = "x-kubernetes-group-version-kind"
+
+// Field is documentation for a field of an object, like kubectl explain prints.
+type Field struct {
+	// Name is the name of the field. It is the kind for the top level of an object.
+	Name string `json:"name"`
+	// Type is the type of the field, e.g. Object, string, or []Object.
+	Type string `json:"type"`
+	// Description describes the field.
+	Description string `json:"description"`
+	// Required is true if the field is required by its parent.
+	Required bool `json:"required,omitempty"`
+	// Fields are the fields of an object field.
+	Fields []Field `json:"fields,omitempty"`
+}
+
+// Explainer explains fields of objects using the OpenAPI schema of a cluster.
+type Explainer interface {
+	// Explain returns documentation for a field. The field is a dotted path,
+	// e.g. spec.replicas. A blank field explains the object itself.
+	Explain(gvk schema.GroupVersionKind, field string) (*Field, error)
+	// Reset discards the cached schema. It is loaded again when it is next used.
+	Reset()
+}
+
+// SchemaLoader loads the OpenAPI schema of a cluster.
+type SchemaLoader func() (*openapi_v2.Document, error)
+
+// Service is an Explainer which loads the schema once and caches it.
+type Service struct {
+	load SchemaLoader
+
+	mu     sync.Mutex
+	models proto.Models
+	kinds  map[schema.GroupVersionKind]string
+	// err is the error loading the schema. It is kept so a cluster without a
+	// schema isn't asked for it every time a field is explained.
+	err error
+}
+
+var _ Explainer = (*Service)(nil)
+
+// NewService creates an instance of Service.
+func NewService(load SchemaLoader) *Service {
+	return &Service{
+		load: load,
+	}
+}
+
+// Explain returns documentation for a field.
+func (s *Service) Explain(gvk schema.GroupVersionKind, field string) (*Field, error) {
+	models, kinds, err := s.schema()
+	if err != nil {
+		return nil, err
+	}
+
+	modelName, ok := kinds[gvk]
+	if !ok {
+		return nil, fmt.Errorf("no schema for %s", gvk)
+	}
+
+	current := models.LookupModel(modelName)
+	if current == nil {
+		return nil, fmt.Errorf("no schema for %s", gvk)
+	}
+
+	explained := Field{
+		Name:        gvk.Kind,
+		Type:        typeName(current),
+		Description: current.GetDescription(),
+	}
+
+	if field != "" {
+		for _, name := range strings.Split(field, ".") {
+			kind, ok := resolveKind(current)
+			if !ok {
+				return nil, fmt.Errorf("field %q of %s does not exist", field, gvk)
+			}
+
+			fieldSchema, ok := kind.Fields[name]
+			if !ok {
+				return nil, fmt.Errorf("field %q of %s does not exist", field, gvk)
+			}
+
+			description := fieldSchema.GetDescription()
+			if description == "" {
+				description = resolve(fieldSchema).GetDescription()
+			}
+
+			explained = Field{
+				Name:        name,
+				Type:        typeName(fieldSchema),
+				Description: description,
+				Required:    kind.IsRequired(name),
+			}
+			current = fieldSchema
+		}
+	}
+
+	if kind, ok := resolveKind(current); ok {
+		for _, name := range kind.Keys() {
+			explained.Fields = append(explained.Fields, Field{
+				Name:        name,
+				Type:        typeName(kind.Fields[name]),
+				Description: kind.Fields[name].GetDescription(),
+				Required:    kind.IsRequired(name),
+			})
+		}
+	}
+
+	return &explained, nil
+}
+
+// Reset discards the cached schema and any error loading it.
+func (s *Service) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.models = nil
+	s.kinds = nil
+	s.err = nil
+}
+
+func (s *Service) schema() (proto.Models, map[schema.GroupVersionKind]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.models != nil || s.err != nil {
+		return s.models, s.kinds, s.err
+	}
+
+	doc, err := s.load()
+	if err != nil {
+		s.err = fmt.Errorf("load OpenAPI schema: %w", err)
+		return nil, nil, s.err
+	}
+
+	models, err := proto.NewOpenAPIData(doc)
+	if err != nil {
+		s.err = fmt.Errorf("parse OpenAPI schema: %w", err)
+		return nil, nil, s.err
+	}
+
+	kinds := map[schema.GroupVersionKind]string{}
+	for _, modelName := range models.ListModels() {
+		model := models.LookupModel(modelName)
+		if model == nil {
+			continue
+		}
+		for _, gvk := range modelGroupVersionKinds(model) {
+			kinds[gvk] = modelName
+		}
+	}
+
+	s.models = models
+	s.kinds = kinds
+
+	return models, kinds, nil
+}
+
+// modelGroupVersionKinds returns the kinds a model is used for.
+func modelGroupVersionKinds(model proto.Schema) []schema.GroupVersionKind {
+	extension, ok := model.GetExtensions()[groupVersionKindExtension].([]interface{})
+	if !ok {
+		return nil
+	}
+
+	var gvks []schema.GroupVersionKind
+	for _, item := range extension {
+		values, ok := item.(map[interface{}]interface{})
+		if !ok {
+			continue
+		}
+
+		group, _ := values["group"].(string)
+		version, _ := values["version"].(string)
+		kind, _ := values["kind"].(string)
+		if version == "" || kind == "" {
+			continue
+		}
+
+		gvks = append(gvks, schema.GroupVersionKind{Group: group, Version: version, Kind: kind})
+	}
+
+	return gvks
+}
+
+// resolve follows references to the schema they refer to.
+func resolve(s proto.Schema) proto.Schema {
+	for {
+		ref, ok := s.(proto.Reference)
+		if !ok {
+			return s
+		}
+		s = ref.SubSchema()
+	}
+}
+
+// resolveKind returns the kind of an object, or of the items of an array or map.
+func resolveKind(s proto.Schema) (*proto.Kind, bool) {
+	switch t := resolve(s).(type) {
+	case *proto.Kind:
+		return t, true
+	case *proto.Array:
+		return resolveKind(t.SubType)
+	case *proto.Map:
+		return resolveKind(t.SubType)
+	default:
+		return nil, false
+	}
+}
+
+// typeName names the type of a schema the way kubectl explain does.
+func typeName(s proto.Schema) string {
+	switch t := resolve(s).(type) {
+	case *proto.Array:
+		return "[]" + typeName(t.SubType)
+	case *proto.Map:
+		return "map[string]" + typeName(t.SubType)
+	case *proto.Primitive:
+		return t.Type
+	default:
+		return "Object"
+	}
+}
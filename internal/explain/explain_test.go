@@ -0,0 +1,191 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package explain
+
+import (
+	"errors"
+	"testing"
+
+	openapi_v2 "github.com/googleapis/gnostic/openapiv2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const testSchema = `
+swagger: "2.0"
+info:
+  title: Kubernetes
+  version: v1.19.3
+paths: {}
+definitions:
+  io.k8s.api.apps.v1.Deployment:
+    description: Deployment enables declarative updates for Pods and ReplicaSets.
+    properties:
+      apiVersion:
+        description: APIVersion defines the versioned schema of this representation of an object.
+        type: string
+      spec:
+        $ref: '#/definitions/io.k8s.api.apps.v1.DeploymentSpec'
+        description: Specification of the desired behavior of the Deployment.
+    type: object
+    x-kubernetes-group-version-kind:
+    - group: apps
+      kind: Deployment
+      version: v1
+  io.k8s.api.apps.v1.DeploymentSpec:
+    description: DeploymentSpec is the specification of the desired behavior of the Deployment.
+    properties:
+      replicas:
+        description: Number of desired pods.
+        format: int32
+        type: integer
+      selector:
+        description: Label selector for pods.
+        type: object
+        additionalProperties:
+          type: string
+      volumes:
+        description: Volumes for the pods.
+        items:
+          $ref: '#/definitions/io.k8s.api.core.v1.Volume'
+        type: array
+    required:
+    - selector
+    type: object
+  io.k8s.api.core.v1.Volume:
+    description: Volume represents a named volume in a pod.
+    properties:
+      name:
+        description: Volume's name.
+        type: string
+    type: object
+`
+
+func loadTestSchema() (*openapi_v2.Document, error) {
+	return openapi_v2.ParseDocument([]byte(testSchema))
+}
+
+func TestService_Explain(t *testing.T) {
+	deployment := schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}
+
+	tests := []struct {
+		name     string
+		gvk      schema.GroupVersionKind
+		field    string
+		expected *Field
+		wantErr  bool
+	}{
+		{
+			name: "object",
+			gvk:  deployment,
+			expected: &Field{
+				Name:        "Deployment",
+				Type:        "Object",
+				Description: "Deployment enables declarative updates for Pods and ReplicaSets.",
+				Fields: []Field{
+					{Name: "apiVersion", Type: "string", Description: "APIVersion defines the versioned schema of this representation of an object."},
+					{Name: "spec", Type: "Object", Description: "Specification of the desired behavior of the Deployment."},
+				},
+			},
+		},
+		{
+			name:  "nested field",
+			gvk:   deployment,
+			field: "spec.replicas",
+			expected: &Field{
+				Name:        "replicas",
+				Type:        "integer",
+				Description: "Number of desired pods.",
+			},
+		},
+		{
+			name:  "required map field",
+			gvk:   deployment,
+			field: "spec.selector",
+			expected: &Field{
+				Name:        "selector",
+				Type:        "map[string]string",
+				Description: "Label selector for pods.",
+				Required:    true,
+			},
+		},
+		{
+			name:  "field of array items",
+			gvk:   deployment,
+			field: "spec.volumes.name",
+			expected: &Field{
+				Name:        "name",
+				Type:        "string",
+				Description: "Volume's name.",
+			},
+		},
+		{
+			name:    "unknown field",
+			gvk:     deployment,
+			field:   "spec.missing",
+			wantErr: true,
+		},
+		{
+			name:    "unknown kind",
+			gvk:     schema.GroupVersionKind{Version: "v1", Kind: "Missing"},
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := NewService(loadTestSchema)
+
+			got, err := s.Explain(test.gvk, test.field)
+			if test.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, got)
+		})
+	}
+}
+
+func TestService_Reset(t *testing.T) {
+	loads := 0
+	s := NewService(func() (*openapi_v2.Document, error) {
+		loads++
+		return loadTestSchema()
+	})
+
+	gvk := schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}
+
+	_, err := s.Explain(gvk, "spec")
+	require.NoError(t, err)
+	_, err = s.Explain(gvk, "spec.replicas")
+	require.NoError(t, err)
+	assert.Equal(t, 1, loads)
+
+	s.Reset()
+
+	_, err = s.Explain(gvk, "spec")
+	require.NoError(t, err)
+	assert.Equal(t, 2, loads)
+}
+
+func TestService_Explain_load_error(t *testing.T) {
+	loads := 0
+	s := NewService(func() (*openapi_v2.Document, error) {
+		loads++
+		return nil, errors.New("unavailable")
+	})
+
+	gvk := schema.GroupVersionKind{Version: "v1", Kind: "Pod"}
+
+	_, err := s.Explain(gvk, "")
+	require.Error(t, err)
+	_, err = s.Explain(gvk, "spec")
+	require.Error(t, err)
+	assert.Equal(t, 1, loads)
+}
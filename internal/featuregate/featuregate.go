@@ -0,0 +1,209 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package featuregate allows experimental parts of Octant to be shipped disabled
+// and enabled by users with a flag or the config file.
+package featuregate
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Feature is the name of a feature which can be enabled or disabled.
+type Feature string
+
+const (
+	// Exec allows starting terminals in containers.
+	Exec Feature = "Exec"
+	// JavaScriptPlugins allows loading plugins written in JavaScript.
+	JavaScriptPlugins Feature = "JavaScriptPlugins"
+	// KubectlPlugins allows running installed kubectl plugins against objects.
+	KubectlPlugins Feature = "KubectlPlugins"
+	// MultiCluster allows viewing more than one cluster at a time.
+	MultiCluster Feature = "MultiCluster"
+)
+
+// Stage is the maturity of a feature.
+type Stage string
+
+const (
+	// Alpha features are disabled by default and may change or be removed.
+	Alpha Stage = "alpha"
+	// Beta features are enabled by default but may still change.
+	Beta Stage = "beta"
+	// GA features are stable. Their gates will be removed in a later release.
+	GA Stage = "ga"
+)
+
+// Spec describes a feature.
+type Spec struct {
+	// Default is true if the feature is enabled unless configured otherwise.
+	Default bool
+	// Stage is the maturity of the feature.
+	Stage Stage
+	// Description describes what the feature does.
+	Description string
+}
+
+// defaultFeatures are the features known to Octant.
+var defaultFeatures = map[Feature]Spec{
+	Exec:              {Default: true, Stage: Beta, Description: "start terminals in containers"},
+	JavaScriptPlugins: {Default: true, Stage: Beta, Description: "load plugins written in JavaScript"},
+	KubectlPlugins:    {Default: false, Stage: Alpha, Description: "run installed kubectl plugins against objects"},
+	MultiCluster:      {Default: false, Stage: Alpha, Description: "view more than one cluster at a time"},
+}
+
+// Status is the state of a feature.
+type Status struct {
+	Name        Feature `json:"name"`
+	Enabled     bool    `json:"enabled"`
+	Default     bool    `json:"default"`
+	Stage       Stage   `json:"stage"`
+	Description string  `json:"description"`
+}
+
+// Gate reports whether features are enabled.
+type Gate interface {
+	// Enabled returns true if a feature is enabled.
+	Enabled(feature Feature) bool
+	// Statuses returns the state of every known feature sorted by name.
+	Statuses() []Status
+}
+
+// FeatureGate is a Gate which can be configured.
+type FeatureGate struct {
+	known   map[Feature]Spec
+	enabled map[Feature]bool
+
+	mu sync.RWMutex
+}
+
+var _ Gate = (*FeatureGate)(nil)
+
+// New creates a FeatureGate with Octant's features set to their defaults.
+func New() *FeatureGate {
+	return NewWithSpecs(defaultFeatures)
+}
+
+// NewWithSpecs creates a FeatureGate for a set of features.
+func NewWithSpecs(specs map[Feature]Spec) *FeatureGate {
+	g := &FeatureGate{
+		known:   map[Feature]Spec{},
+		enabled: map[Feature]bool{},
+	}
+
+	for feature, spec := range specs {
+		g.known[feature] = spec
+	}
+
+	return g
+}
+
+// Set enables or disables features. It returns an error if a feature is unknown.
+func (g *FeatureGate) Set(values map[string]bool) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	for name, enabled := range values {
+		feature, ok := g.lookup(name)
+		if !ok {
+			return fmt.Errorf("unknown feature gate %q: known gates are %s", name, strings.Join(g.names(), ", "))
+		}
+		g.enabled[feature] = enabled
+	}
+
+	return nil
+}
+
+// SetFromStrings enables or disables features from a list of "Feature=bool" values.
+// Each value may also be a comma separated list of features.
+func (g *FeatureGate) SetFromStrings(values []string) error {
+	m := map[string]bool{}
+
+	for _, value := range values {
+		for _, entry := range strings.Split(value, ",") {
+			entry = strings.TrimSpace(entry)
+			if entry == "" {
+				continue
+			}
+
+			parts := strings.SplitN(entry, "=", 2)
+			if len(parts) != 2 {
+				return fmt.Errorf("invalid feature gate %q: expected Feature=true or Feature=false", entry)
+			}
+
+			enabled, err := strconv.ParseBool(strings.TrimSpace(parts[1]))
+			if err != nil {
+				return fmt.Errorf("invalid value for feature gate %q: %w", parts[0], err)
+			}
+
+			m[strings.TrimSpace(parts[0])] = enabled
+		}
+	}
+
+	return g.Set(m)
+}
+
+// Enabled returns true if a feature is enabled. Unknown features are disabled.
+func (g *FeatureGate) Enabled(feature Feature) bool {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	if enabled, ok := g.enabled[feature]; ok {
+		return enabled
+	}
+
+	return g.known[feature].Default
+}
+
+// Statuses returns the state of every known feature sorted by name.
+func (g *FeatureGate) Statuses() []Status {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	var list []Status
+	for _, name := range g.names() {
+		feature := Feature(name)
+		spec := g.known[feature]
+
+		enabled, ok := g.enabled[feature]
+		if !ok {
+			enabled = spec.Default
+		}
+
+		list = append(list, Status{
+			Name:        feature,
+			Enabled:     enabled,
+			Default:     spec.Default,
+			Stage:       spec.Stage,
+			Description: spec.Description,
+		})
+	}
+
+	return list
+}
+
+// lookup finds a known feature ignoring case.
+func (g *FeatureGate) lookup(name string) (Feature, bool) {
+	for feature := range g.known {
+		if strings.EqualFold(string(feature), name) {
+			return feature, true
+		}
+	}
+	return "", false
+}
+
+func (g *FeatureGate) names() []string {
+	var names []string
+	for feature := range g.known {
+		names = append(names, string(feature))
+	}
+	sort.Strings(names)
+	return names
+}
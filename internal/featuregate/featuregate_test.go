@@ -0,0 +1,84 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package featuregate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testSpecs = map[Feature]Spec{
+	"Alpha": {Default: false, Stage: Alpha, Description: "alpha feature"},
+	"Beta":  {Default: true, Stage: Beta, Description: "beta feature"},
+}
+
+func TestFeatureGate_Enabled(t *testing.T) {
+	g := NewWithSpecs(testSpecs)
+
+	assert.False(t, g.Enabled("Alpha"))
+	assert.True(t, g.Enabled("Beta"))
+	assert.False(t, g.Enabled("Unknown"))
+
+	require.NoError(t, g.Set(map[string]bool{"alpha": true, "Beta": false}))
+
+	assert.True(t, g.Enabled("Alpha"))
+	assert.False(t, g.Enabled("Beta"))
+}
+
+func TestFeatureGate_Set_unknown(t *testing.T) {
+	g := NewWithSpecs(testSpecs)
+
+	err := g.Set(map[string]bool{"Gamma": true})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Alpha, Beta")
+}
+
+func TestFeatureGate_SetFromStrings(t *testing.T) {
+	tests := []struct {
+		name      string
+		values    []string
+		wantAlpha bool
+		wantBeta  bool
+		wantErr   bool
+	}{
+		{name: "empty", values: nil, wantAlpha: false, wantBeta: true},
+		{name: "separate values", values: []string{"Alpha=true", "Beta=false"}, wantAlpha: true, wantBeta: false},
+		{name: "comma separated", values: []string{"Alpha=true, Beta=false"}, wantAlpha: true, wantBeta: false},
+		{name: "missing value", values: []string{"Alpha"}, wantErr: true},
+		{name: "invalid value", values: []string{"Alpha=yes please"}, wantErr: true},
+		{name: "unknown feature", values: []string{"Gamma=true"}, wantErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithSpecs(testSpecs)
+
+			err := g.SetFromStrings(test.values)
+			if test.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, test.wantAlpha, g.Enabled("Alpha"))
+			assert.Equal(t, test.wantBeta, g.Enabled("Beta"))
+		})
+	}
+}
+
+func TestFeatureGate_Statuses(t *testing.T) {
+	g := NewWithSpecs(testSpecs)
+	require.NoError(t, g.Set(map[string]bool{"Alpha": true}))
+
+	expected := []Status{
+		{Name: "Alpha", Enabled: true, Default: false, Stage: Alpha, Description: "alpha feature"},
+		{Name: "Beta", Enabled: true, Default: true, Stage: Beta, Description: "beta feature"},
+	}
+
+	assert.Equal(t, expected, g.Statuses())
+}
@@ -0,0 +1,118 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package filecopy
+
+import (
+	"archive/tar"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/afero"
+)
+
+// File is a file to upload.
+type File struct {
+	Name string
+	Mode int64
+	Data []byte
+}
+
+// WriteArchive writes files to w as a tar archive. Only the base name of each file
+// is used so files can't be written outside of the upload directory.
+func WriteArchive(w io.Writer, files []File) error {
+	tw := tar.NewWriter(w)
+
+	for _, file := range files {
+		name := path.Base(filepath.ToSlash(file.Name))
+		if name == "." || name == ".." || name == "/" {
+			return errors.Errorf("invalid file name %q", file.Name)
+		}
+
+		mode := file.Mode
+		if mode == 0 {
+			mode = 0644
+		}
+
+		header := &tar.Header{
+			Name: name,
+			Mode: mode,
+			Size: int64(len(file.Data)),
+		}
+		if err := tw.WriteHeader(header); err != nil {
+			return errors.Wrapf(err, "write header for %q", name)
+		}
+		if _, err := tw.Write(file.Data); err != nil {
+			return errors.Wrapf(err, "write %q", name)
+		}
+	}
+
+	return tw.Close()
+}
+
+// ArchivePath writes the file or directory at localPath to w as a tar archive. Entries
+// are relative to the parent of localPath, so uploading a directory recreates it
+// inside the destination. Anything besides regular files and directories is skipped.
+func ArchivePath(fs afero.Fs, localPath string, w io.Writer) error {
+	localPath = filepath.Clean(localPath)
+	if _, err := fs.Stat(localPath); err != nil {
+		return errors.Wrapf(err, "read %q", localPath)
+	}
+
+	parent := filepath.Dir(localPath)
+	tw := tar.NewWriter(w)
+
+	err := afero.Walk(fs, localPath, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !info.Mode().IsRegular() && !info.IsDir() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(parent, p)
+		if err != nil {
+			return err
+		}
+
+		header, err := tar.FileInfoHeader(info, "")
+		if err != nil {
+			return err
+		}
+		header.Name = filepath.ToSlash(rel)
+		if info.IsDir() {
+			header.Name += "/"
+		}
+
+		if err := tw.WriteHeader(header); err != nil {
+			return errors.Wrapf(err, "write header for %q", p)
+		}
+
+		if info.IsDir() {
+			return nil
+		}
+
+		f, err := fs.Open(p)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		if _, err := io.Copy(tw, f); err != nil {
+			return errors.Wrapf(err, "write %q", p)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return errors.Wrapf(err, "archive %q", localPath)
+	}
+
+	return tw.Close()
+}
@@ -0,0 +1,120 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package filecopy
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+//go:generate mockgen -destination=./fake/mock_executor.go -package=fake github.com/vmware-tanzu/octant/internal/filecopy Executor
+
+// Target is the container files are copied to or from.
+type Target struct {
+	Namespace string
+	Pod       string
+	Container string
+}
+
+func (t Target) String() string {
+	return fmt.Sprintf("container %s in pod %s/%s", t.Container, t.Namespace, t.Pod)
+}
+
+// Executor runs a command in a container.
+type Executor interface {
+	// Exec runs command in target. stdin is not attached if it is nil.
+	Exec(ctx context.Context, target Target, command []string, stdin io.Reader, stdout, stderr io.Writer) error
+}
+
+// Copier copies files to and from containers. Like kubectl cp, it requires tar
+// to be installed in the container.
+type Copier struct {
+	executor Executor
+}
+
+// NewCopier creates an instance of Copier.
+func NewCopier(executor Executor) *Copier {
+	return &Copier{
+		executor: executor,
+	}
+}
+
+// Download writes a tar archive containing the file or directory at srcPath to w.
+func (c *Copier) Download(ctx context.Context, target Target, srcPath string, w io.Writer) error {
+	srcPath, err := cleanPath(srcPath)
+	if err != nil {
+		return err
+	}
+
+	dir, base := path.Split(srcPath)
+	if base == "" {
+		dir, base = "/", "."
+	}
+	if strings.HasPrefix(base, "-") {
+		return errors.Errorf("can't download %q: names starting with - aren't supported", srcPath)
+	}
+
+	var stderr bytes.Buffer
+	command := []string{"tar", "cf", "-", "-C", dir, "--", base}
+	if err := c.executor.Exec(ctx, target, command, nil, w, &stderr); err != nil {
+		return execError(err, &stderr, "download %q from %s", srcPath, target)
+	}
+
+	return nil
+}
+
+// Upload extracts the tar archive read from r into destDir.
+func (c *Copier) Upload(ctx context.Context, target Target, destDir string, r io.Reader) error {
+	destDir, err := cleanPath(destDir)
+	if err != nil {
+		return err
+	}
+
+	var stdout, stderr bytes.Buffer
+	command := []string{"tar", "xmf", "-", "-C", destDir}
+	if err := c.executor.Exec(ctx, target, command, r, &stdout, &stderr); err != nil {
+		return execError(err, &stderr, "upload to %q in %s", destDir, target)
+	}
+
+	return nil
+}
+
+// ArchiveName returns the file name for a downloaded archive of srcPath.
+func ArchiveName(srcPath string) string {
+	base := path.Base(path.Clean("/" + srcPath))
+	if base == "/" {
+		base = "root"
+	}
+	return base + ".tar"
+}
+
+// cleanPath cleans an absolute path in a container. Relative paths are rejected
+// since the directory they are relative to depends on the container.
+func cleanPath(p string) (string, error) {
+	if strings.TrimSpace(p) == "" {
+		return "", errors.New("path is required")
+	}
+	if !path.IsAbs(p) {
+		return "", errors.Errorf("path %q must be absolute", p)
+	}
+	return path.Clean(p), nil
+}
+
+// execError includes what the command wrote to stderr, since it usually explains
+// why tar failed better than the exit code does.
+func execError(err error, stderr *bytes.Buffer, format string, args ...interface{}) error {
+	message := strings.TrimSpace(stderr.String())
+	if message != "" {
+		err = errors.Errorf("%v: %s", err, message)
+	}
+	return errors.Wrapf(err, format, args...)
+}
@@ -0,0 +1,172 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package filecopy_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/octant/internal/filecopy"
+	"github.com/vmware-tanzu/octant/internal/filecopy/fake"
+)
+
+var target = filecopy.Target{Namespace: "default", Pod: "pod", Container: "app"}
+
+func TestCopier_Download(t *testing.T) {
+	tests := []struct {
+		name     string
+		srcPath  string
+		expected []string
+	}{
+		{
+			name:     "file",
+			srcPath:  "/var/log/app.log",
+			expected: []string{"tar", "cf", "-", "-C", "/var/log/", "--", "app.log"},
+		},
+		{
+			name:     "directory",
+			srcPath:  "/var/log/",
+			expected: []string{"tar", "cf", "-", "-C", "/var/", "--", "log"},
+		},
+		{
+			name:     "root",
+			srcPath:  "/",
+			expected: []string{"tar", "cf", "-", "-C", "/", "--", "."},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			executor := fake.NewMockExecutor(controller)
+			executor.EXPECT().
+				Exec(gomock.Any(), target, test.expected, nil, gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, _ filecopy.Target, _ []string, _ io.Reader, stdout, _ io.Writer) error {
+					_, err := stdout.Write([]byte("archive"))
+					return err
+				})
+
+			var buf bytes.Buffer
+			require.NoError(t, filecopy.NewCopier(executor).Download(context.Background(), target, test.srcPath, &buf))
+			assert.Equal(t, "archive", buf.String())
+		})
+	}
+}
+
+func TestCopier_Download_error(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	executor := fake.NewMockExecutor(controller)
+	executor.EXPECT().
+		Exec(gomock.Any(), target, gomock.Any(), nil, gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ filecopy.Target, _ []string, _ io.Reader, _, stderr io.Writer) error {
+			_, _ = stderr.Write([]byte("tar: missing: No such file or directory\n"))
+			return errors.New("command terminated with exit code 2")
+		})
+
+	err := filecopy.NewCopier(executor).Download(context.Background(), target, "/missing", ioutil.Discard)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "No such file or directory")
+
+	for _, srcPath := range []string{" ", "app.log", "/tmp/-rf"} {
+		err = filecopy.NewCopier(executor).Download(context.Background(), target, srcPath, ioutil.Discard)
+		require.Error(t, err, srcPath)
+	}
+}
+
+func TestCopier_Upload(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	archive := bytes.NewBufferString("archive")
+
+	executor := fake.NewMockExecutor(controller)
+	executor.EXPECT().
+		Exec(gomock.Any(), target, []string{"tar", "xmf", "-", "-C", "/tmp"}, archive, gomock.Any(), gomock.Any()).
+		Return(nil)
+
+	require.NoError(t, filecopy.NewCopier(executor).Upload(context.Background(), target, "/tmp/", archive))
+
+	require.Error(t, filecopy.NewCopier(executor).Upload(context.Background(), target, "tmp", archive))
+}
+
+func TestArchiveName(t *testing.T) {
+	assert.Equal(t, "app.log.tar", filecopy.ArchiveName("/var/log/app.log"))
+	assert.Equal(t, "log.tar", filecopy.ArchiveName("/var/log/"))
+	assert.Equal(t, "root.tar", filecopy.ArchiveName("/"))
+}
+
+func readArchive(t *testing.T, r io.Reader) map[string]string {
+	entries := map[string]string{}
+
+	tr := tar.NewReader(r)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+
+		data, err := ioutil.ReadAll(tr)
+		require.NoError(t, err)
+		entries[header.Name] = string(data)
+	}
+
+	return entries
+}
+
+func TestWriteArchive(t *testing.T) {
+	var buf bytes.Buffer
+	files := []filecopy.File{
+		{Name: "config.yaml", Data: []byte("a: b")},
+		{Name: "../../etc/passwd", Data: []byte("root")},
+	}
+	require.NoError(t, filecopy.WriteArchive(&buf, files))
+
+	expected := map[string]string{
+		"config.yaml": "a: b",
+		"passwd":      "root",
+	}
+	assert.Equal(t, expected, readArchive(t, &buf))
+
+	require.Error(t, filecopy.WriteArchive(ioutil.Discard, []filecopy.File{{Name: ".."}}))
+}
+
+func TestArchivePath(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	require.NoError(t, afero.WriteFile(fs, "/home/user/debug/a.txt", []byte("a"), 0644))
+	require.NoError(t, afero.WriteFile(fs, "/home/user/debug/nested/b.txt", []byte("b"), 0644))
+
+	var buf bytes.Buffer
+	require.NoError(t, filecopy.ArchivePath(fs, "/home/user/debug", &buf))
+
+	expected := map[string]string{
+		"debug/":             "",
+		"debug/a.txt":        "a",
+		"debug/nested/":      "",
+		"debug/nested/b.txt": "b",
+	}
+	assert.Equal(t, expected, readArchive(t, &buf))
+
+	buf.Reset()
+	require.NoError(t, filecopy.ArchivePath(fs, "/home/user/debug/a.txt", &buf))
+	assert.Equal(t, map[string]string{"a.txt": "a"}, readArchive(t, &buf))
+
+	require.Error(t, filecopy.ArchivePath(fs, "/missing", ioutil.Discard))
+}
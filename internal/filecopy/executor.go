@@ -0,0 +1,79 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package filecopy
+
+import (
+	"context"
+	"io"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/remotecommand"
+
+	"github.com/vmware-tanzu/octant/internal/cluster"
+)
+
+// remoteExecutor runs commands using the pod exec API.
+type remoteExecutor struct {
+	client cluster.ClientInterface
+}
+
+var _ Executor = (*remoteExecutor)(nil)
+
+// NewExecutor creates an Executor which runs commands in a cluster.
+func NewExecutor(client cluster.ClientInterface) Executor {
+	return &remoteExecutor{
+		client: client,
+	}
+}
+
+// Exec runs command in target. It returns when the command exits or ctx is cancelled.
+func (e *remoteExecutor) Exec(ctx context.Context, target Target, command []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	if e.client == nil {
+		return errors.New("cluster client is nil")
+	}
+
+	restClient, err := e.client.RESTClient()
+	if err != nil {
+		return errors.Wrap(err, "fetching RESTClient")
+	}
+
+	request := restClient.Post().
+		Resource("pods").
+		Name(target.Pod).
+		Namespace(target.Namespace).
+		SubResource("exec")
+
+	request.VersionedParams(&corev1.PodExecOptions{
+		Container: target.Container,
+		Command:   command,
+		Stdin:     stdin != nil,
+		Stdout:    true,
+		Stderr:    true,
+	}, scheme.ParameterCodec)
+
+	executor, err := remotecommand.NewSPDYExecutor(e.client.RESTConfig(), "POST", request.URL())
+	if err != nil {
+		return errors.Wrap(err, "create executor")
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- executor.Stream(remotecommand.StreamOptions{
+			Stdin:  stdin,
+			Stdout: stdout,
+			Stderr: stderr,
+		})
+	}()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case err := <-done:
+		return err
+	}
+}
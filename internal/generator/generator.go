@@ -38,6 +38,8 @@ var _ Interface = (*Generator)(nil)
 // Options are additional options to pass a Generator
 type Options struct {
 	LabelSet *kLabels.Set
+	Tab      string
+	Limit    int
 }
 
 // NewGenerator creates a Generator.
@@ -97,6 +99,8 @@ func (g *Generator) Generate(ctx context.Context, contentPath string, opts Optio
 		Fields:   fields,
 		Printer:  g.printer,
 		LabelSet: opts.LabelSet,
+		Tab:      opts.Tab,
+		Limit:    opts.Limit,
 		Dash:     g.dashConfig,
 		Link:     linkGenerator,
 
@@ -20,6 +20,8 @@ var (
 	ClusterRole                    = schema.GroupVersionKind{Group: "rbac.authorization.k8s.io", Version: "v1", Kind: "ClusterRole"}
 	ConfigMap                      = schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}
 	CronJob                        = schema.GroupVersionKind{Group: "batch", Version: "v1beta1", Kind: "CronJob"}
+	CSIDriver                      = schema.GroupVersionKind{Group: "storage.k8s.io", Version: "v1", Kind: "CSIDriver"}
+	CSINode                        = schema.GroupVersionKind{Group: "storage.k8s.io", Version: "v1", Kind: "CSINode"}
 	CustomResourceDefinition       = schema.GroupVersionKind{Group: "apiextensions.k8s.io", Version: "v1", Kind: "CustomResourceDefinition"}
 	DaemonSet                      = schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "DaemonSet"}
 	Deployment                     = schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}
@@ -39,7 +41,9 @@ var (
 	Pod                            = schema.GroupVersionKind{Version: "v1", Kind: "Pod"}
 	PodMetrics                     = schema.GroupVersionKind{Group: "metrics.k8s.io", Version: "v1beta1", Kind: "PodMetrics"}
 	PersistentVolume               = schema.GroupVersionKind{Version: "v1", Kind: "PersistentVolume"}
+	Lease                          = schema.GroupVersionKind{Group: "coordination.k8s.io", Version: "v1", Kind: "Lease"}
 	PersistentVolumeClaim          = schema.GroupVersionKind{Version: "v1", Kind: "PersistentVolumeClaim"}
+	PriorityClass                  = schema.GroupVersionKind{Group: "scheduling.k8s.io", Version: "v1", Kind: "PriorityClass"}
 	ReplicationController          = schema.GroupVersionKind{Version: "v1", Kind: "ReplicationController"}
 	StatefulSet                    = schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "StatefulSet"}
 	RoleBinding                    = schema.GroupVersionKind{Group: "rbac.authorization.k8s.io", Version: "v1", Kind: "RoleBinding"}
@@ -0,0 +1,157 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package i18n
+
+// BuiltinCatalogs are the catalogs shipped with Octant. They translate the
+// most common tab names, section titles, column headers, and statuses.
+// Messages which are the same in English are left out.
+var BuiltinCatalogs = map[string]Catalog{
+	"de": {
+		"Address":         "Adresse",
+		"Age":             "Alter",
+		"Annotations":     "Annotationen",
+		"Available":       "Verfügbar",
+		"Capacity":        "Kapazität",
+		"Compare":         "Vergleichen",
+		"Conditions":      "Bedingungen",
+		"Configuration":   "Konfiguration",
+		"Containers":      "Container",
+		"Data":            "Daten",
+		"Delete":          "Löschen",
+		"Description":     "Beschreibung",
+		"Edit":            "Bearbeiten",
+		"Endpoints":       "Endpunkte",
+		"Events":          "Ereignisse",
+		"Export YAML":     "YAML exportieren",
+		"Failed":          "Fehlgeschlagen",
+		"Key":             "Schlüssel",
+		"Kind":            "Art",
+		"Last Seen":       "Zuletzt gesehen",
+		"Last Transition": "Letzter Übergang",
+		"Logs":            "Protokolle",
+		"Message":         "Meldung",
+		"Metadata":        "Metadaten",
+		"Node":            "Knoten",
+		"Nodes":           "Knoten",
+		"Object":          "Objekt",
+		"Pending":         "Ausstehend",
+		"Ready":           "Bereit",
+		"Reason":          "Grund",
+		"Replica Status":  "Replikatstatus",
+		"Replicas":        "Replikate",
+		"Resource Viewer": "Ressourcenansicht",
+		"Resources":       "Ressourcen",
+		"Restarts":        "Neustarts",
+		"Rules":           "Regeln",
+		"Running":         "Läuft",
+		"Selector":        "Selektor",
+		"Selectors":       "Selektoren",
+		"Source":          "Quelle",
+		"Succeeded":       "Erfolgreich",
+		"Summary":         "Übersicht",
+		"Type":            "Typ",
+		"Unknown":         "Unbekannt",
+		"Update Strategy": "Aktualisierungsstrategie",
+		"Value":           "Wert",
+	},
+	"es": {
+		"Address":         "Dirección",
+		"Age":             "Antigüedad",
+		"Annotations":     "Anotaciones",
+		"Available":       "Disponible",
+		"Capacity":        "Capacidad",
+		"Compare":         "Comparar",
+		"Conditions":      "Condiciones",
+		"Configuration":   "Configuración",
+		"Containers":      "Contenedores",
+		"Data":            "Datos",
+		"Delete":          "Eliminar",
+		"Description":     "Descripción",
+		"Edit":            "Editar",
+		"Events":          "Eventos",
+		"Export YAML":     "Exportar YAML",
+		"Failed":          "Fallido",
+		"Image":           "Imagen",
+		"Key":             "Clave",
+		"Labels":          "Etiquetas",
+		"Last Seen":       "Visto por última vez",
+		"Last Transition": "Última transición",
+		"Logs":            "Registros",
+		"Message":         "Mensaje",
+		"Metadata":        "Metadatos",
+		"Name":            "Nombre",
+		"Namespace":       "Espacio de nombres",
+		"Node":            "Nodo",
+		"Nodes":           "Nodos",
+		"Object":          "Objeto",
+		"Pending":         "Pendiente",
+		"Phase":           "Fase",
+		"Ports":           "Puertos",
+		"Ready":           "Listo",
+		"Reason":          "Motivo",
+		"Replica Status":  "Estado de réplicas",
+		"Replicas":        "Réplicas",
+		"Resource Viewer": "Visor de recursos",
+		"Resources":       "Recursos",
+		"Restarts":        "Reinicios",
+		"Rules":           "Reglas",
+		"Running":         "En ejecución",
+		"Selectors":       "Selectores",
+		"Service":         "Servicio",
+		"Source":          "Origen",
+		"Status":          "Estado",
+		"Succeeded":       "Completado",
+		"Summary":         "Resumen",
+		"Type":            "Tipo",
+		"Unknown":         "Desconocido",
+		"Update Strategy": "Estrategia de actualización",
+		"Value":           "Valor",
+		"Version":         "Versión",
+	},
+	"fr": {
+		"Address":         "Adresse",
+		"Age":             "Âge",
+		"Available":       "Disponible",
+		"Capacity":        "Capacité",
+		"Compare":         "Comparer",
+		"Containers":      "Conteneurs",
+		"Data":            "Données",
+		"Delete":          "Supprimer",
+		"Edit":            "Modifier",
+		"Endpoints":       "Points de terminaison",
+		"Events":          "Événements",
+		"Export YAML":     "Exporter en YAML",
+		"Failed":          "Échoué",
+		"Key":             "Clé",
+		"Labels":          "Libellés",
+		"Last Seen":       "Vu pour la dernière fois",
+		"Last Transition": "Dernière transition",
+		"Logs":            "Journaux",
+		"Metadata":        "Métadonnées",
+		"Name":            "Nom",
+		"Namespace":       "Espace de noms",
+		"Node":            "Nœud",
+		"Nodes":           "Nœuds",
+		"Object":          "Objet",
+		"Pending":         "En attente",
+		"Ready":           "Prêt",
+		"Reason":          "Raison",
+		"Replica Status":  "Statut des réplicas",
+		"Resource Viewer": "Visionneuse de ressources",
+		"Resources":       "Ressources",
+		"Restarts":        "Redémarrages",
+		"Rules":           "Règles",
+		"Running":         "En cours d'exécution",
+		"Selector":        "Sélecteur",
+		"Selectors":       "Sélecteurs",
+		"Status":          "Statut",
+		"Succeeded":       "Réussi",
+		"Summary":         "Résumé",
+		"Unknown":         "Inconnu",
+		"Update Strategy": "Stratégie de mise à jour",
+		"Value":           "Valeur",
+	},
+}
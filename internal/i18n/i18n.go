@@ -0,0 +1,178 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package i18n translates the text Octant generates, such as section titles,
+// column headers, and statuses, into the language a client prefers. Messages
+// are identified by their English text, so printers and describers don't need
+// to change to be translated.
+package i18n
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/spf13/afero"
+	"golang.org/x/text/language"
+)
+
+//go:generate mockgen -destination=./fake/mock_translator.go -package=fake github.com/vmware-tanzu/octant/internal/i18n Translator
+
+// DefaultLocale is the locale messages are generated in.
+const DefaultLocale = "en"
+
+// Catalog maps English messages to their translations.
+type Catalog map[string]string
+
+// Translator translates messages into supported locales.
+type Translator interface {
+	// Locales returns the supported locales. The default locale is first.
+	Locales() []string
+	// Negotiate returns the supported locale which best matches an
+	// Accept-Language header. It returns the default locale if none match.
+	Negotiate(acceptLanguage string) string
+	// Translate translates a message into a locale. Messages without a
+	// translation are returned unchanged.
+	Translate(locale, message string) string
+}
+
+// Catalogs is a Translator which uses a catalog per locale.
+type Catalogs struct {
+	locales  []string
+	catalogs map[string]Catalog
+	matcher  language.Matcher
+}
+
+var _ Translator = (*Catalogs)(nil)
+
+// NewCatalogs creates an instance of Catalogs. The default locale is always
+// supported.
+func NewCatalogs(catalogs map[string]Catalog) *Catalogs {
+	c := &Catalogs{
+		locales:  []string{DefaultLocale},
+		catalogs: map[string]Catalog{},
+	}
+
+	var locales []string
+	for locale, catalog := range catalogs {
+		if locale == DefaultLocale {
+			continue
+		}
+		locales = append(locales, locale)
+		c.catalogs[locale] = catalog
+	}
+	sort.Strings(locales)
+	c.locales = append(c.locales, locales...)
+
+	var tags []language.Tag
+	for _, locale := range c.locales {
+		tags = append(tags, language.Make(locale))
+	}
+	c.matcher = language.NewMatcher(tags)
+
+	return c
+}
+
+// LoadCatalogs creates Catalogs from the built in catalogs and the catalogs in
+// a directory. Each file in the directory is named after its locale, such as
+// pt-BR.json, and contains a JSON object of English messages and their
+// translations. Translations in the directory replace built in translations.
+// Only built in catalogs are used if the directory is blank.
+func LoadCatalogs(fs afero.Fs, dir string) (*Catalogs, error) {
+	catalogs := map[string]Catalog{}
+	for locale, catalog := range BuiltinCatalogs {
+		catalogs[locale] = merge(nil, catalog)
+	}
+
+	if dir == "" {
+		return NewCatalogs(catalogs), nil
+	}
+
+	paths, err := afero.Glob(fs, filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, fmt.Errorf("find catalogs in %s: %w", dir, err)
+	}
+
+	for _, path := range paths {
+		data, err := afero.ReadFile(fs, path)
+		if err != nil {
+			return nil, fmt.Errorf("read catalog: %w", err)
+		}
+
+		var catalog Catalog
+		if err := json.Unmarshal(data, &catalog); err != nil {
+			return nil, fmt.Errorf("parse catalog %s: %w", path, err)
+		}
+
+		locale := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+		tag, err := language.Parse(locale)
+		if err != nil {
+			return nil, fmt.Errorf("catalog %s is not named after a locale: %w", path, err)
+		}
+		locale = tag.String()
+
+		catalogs[locale] = merge(catalogs[locale], catalog)
+	}
+
+	return NewCatalogs(catalogs), nil
+}
+
+// Locales returns the supported locales. The default locale is first.
+func (c *Catalogs) Locales() []string {
+	return c.locales
+}
+
+// Negotiate returns the supported locale which best matches an Accept-Language
+// header.
+func (c *Catalogs) Negotiate(acceptLanguage string) string {
+	tags, _, err := language.ParseAcceptLanguage(acceptLanguage)
+	if err != nil || len(tags) == 0 {
+		return DefaultLocale
+	}
+
+	_, index, confidence := c.matcher.Match(tags...)
+	if confidence == language.No {
+		return DefaultLocale
+	}
+
+	return c.locales[index]
+}
+
+// Translate translates a message into a locale.
+func (c *Catalogs) Translate(locale, message string) string {
+	if translated, ok := c.catalogs[locale][message]; ok && translated != "" {
+		return translated
+	}
+	return message
+}
+
+func merge(dst, src Catalog) Catalog {
+	if dst == nil {
+		dst = Catalog{}
+	}
+	for message, translated := range src {
+		dst[message] = translated
+	}
+	return dst
+}
+
+type localeKey struct{}
+
+// WithLocale returns a context with the locale content is generated in.
+func WithLocale(ctx context.Context, locale string) context.Context {
+	return context.WithValue(ctx, localeKey{}, locale)
+}
+
+// LocaleFrom returns the locale content is generated in. It returns the
+// default locale if the context doesn't have a locale.
+func LocaleFrom(ctx context.Context) string {
+	if locale, ok := ctx.Value(localeKey{}).(string); ok && locale != "" {
+		return locale
+	}
+	return DefaultLocale
+}
@@ -0,0 +1,91 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package i18n
+
+import (
+	"context"
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCatalogs_Negotiate(t *testing.T) {
+	c := NewCatalogs(map[string]Catalog{
+		"es":    {"Summary": "Resumen"},
+		"pt-BR": {"Summary": "Resumo"},
+	})
+
+	assert.Equal(t, []string{"en", "es", "pt-BR"}, c.Locales())
+
+	tests := []struct {
+		acceptLanguage string
+		expected       string
+	}{
+		{acceptLanguage: "", expected: "en"},
+		{acceptLanguage: "es", expected: "es"},
+		{acceptLanguage: "es-MX,es;q=0.9,en;q=0.8", expected: "es"},
+		{acceptLanguage: "pt-BR", expected: "pt-BR"},
+		{acceptLanguage: "de-DE,en-US;q=0.5", expected: "en"},
+		{acceptLanguage: "ja", expected: "en"},
+		{acceptLanguage: "not a locale;;", expected: "en"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.acceptLanguage, func(t *testing.T) {
+			assert.Equal(t, test.expected, c.Negotiate(test.acceptLanguage))
+		})
+	}
+}
+
+func TestCatalogs_Translate(t *testing.T) {
+	c := NewCatalogs(map[string]Catalog{
+		"es": {"Summary": "Resumen", "Logs": ""},
+	})
+
+	assert.Equal(t, "Resumen", c.Translate("es", "Summary"))
+	assert.Equal(t, "Logs", c.Translate("es", "Logs"))
+	assert.Equal(t, "Metadata", c.Translate("es", "Metadata"))
+	assert.Equal(t, "Summary", c.Translate("en", "Summary"))
+	assert.Equal(t, "Summary", c.Translate("ja", "Summary"))
+}
+
+func TestLoadCatalogs(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	require.NoError(t, afero.WriteFile(fs, "/locales/es.json", []byte(`{"Summary": "Sumario", "Pods": "Pods del espacio"}`), 0600))
+	require.NoError(t, afero.WriteFile(fs, "/locales/pt-br.json", []byte(`{"Summary": "Resumo"}`), 0600))
+	require.NoError(t, afero.WriteFile(fs, "/locales/README.md", []byte(`# Translations`), 0600))
+
+	c, err := LoadCatalogs(fs, "/locales")
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"en", "de", "es", "fr", "pt-BR"}, c.Locales())
+	assert.Equal(t, "Sumario", c.Translate("es", "Summary"))
+	assert.Equal(t, "Pods del espacio", c.Translate("es", "Pods"))
+	assert.Equal(t, "Metadatos", c.Translate("es", "Metadata"))
+	assert.Equal(t, "Resumo", c.Translate("pt-BR", "Summary"))
+
+	// Built in catalogs aren't modified.
+	assert.Equal(t, "Resumen", BuiltinCatalogs["es"]["Summary"])
+
+	c, err = LoadCatalogs(fs, "")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"en", "de", "es", "fr"}, c.Locales())
+
+	require.NoError(t, afero.WriteFile(fs, "/invalid/es.json", []byte(`["Summary"]`), 0600))
+	_, err = LoadCatalogs(fs, "/invalid")
+	require.Error(t, err)
+
+	require.NoError(t, afero.WriteFile(fs, "/unnamed/translations.json", []byte(`{}`), 0600))
+	_, err = LoadCatalogs(fs, "/unnamed")
+	require.Error(t, err)
+}
+
+func TestLocaleFrom(t *testing.T) {
+	assert.Equal(t, DefaultLocale, LocaleFrom(context.Background()))
+	assert.Equal(t, "es", LocaleFrom(WithLocale(context.Background(), "es")))
+}
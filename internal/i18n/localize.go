@@ -0,0 +1,197 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package i18n
+
+import (
+	"reflect"
+
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+// Localize returns a content response with its text translated into a locale.
+// Only text Octant generates is translated: titles, table column names, summary
+// section headers, button names, and text components with a status. Other text
+// components usually show object data, so they are left alone. Table columns keep
+// their accessor, so rows and filters still match them. The content response is
+// not modified, so content shared by clients with different locales can be
+// localized.
+func Localize(contentResponse component.ContentResponse, translator Translator, locale string) component.ContentResponse {
+	if translator == nil || locale == DefaultLocale {
+		return contentResponse
+	}
+
+	l := localizer{
+		translate: func(message string) string {
+			return translator.Translate(locale, message)
+		},
+	}
+
+	localized := contentResponse
+
+	localized.Title, _ = l.titles(contentResponse.Title)
+
+	localized.Components = make([]component.Component, len(contentResponse.Components))
+	for i := range contentResponse.Components {
+		localized.Components[i] = l.component(contentResponse.Components[i])
+	}
+
+	if contentResponse.ExtensionComponent != nil {
+		localized.ExtensionComponent = l.component(contentResponse.ExtensionComponent)
+	}
+
+	if contentResponse.ButtonGroup != nil {
+		if buttonGroup, ok := l.component(contentResponse.ButtonGroup).(*component.ButtonGroup); ok {
+			localized.ButtonGroup = buttonGroup
+		}
+	}
+
+	return localized
+}
+
+// localizer translates the text in components.
+type localizer struct {
+	translate func(string) string
+}
+
+// component translates the text in a component and the components nested in
+// it. Containers whose text isn't in a nested component are translated first,
+// then titles and status text are.
+func (l localizer) component(c component.Component) component.Component {
+	return component.Rewrite(component.Rewrite(c, l.container), l.labels)
+}
+
+// container translates text stored in the config of tables, summaries, and
+// button groups. It translates nested containers itself since Rewrite doesn't
+// search the components it replaces.
+func (l localizer) container(c component.Component) component.Component {
+	switch c := c.(type) {
+	case *component.Table:
+		config := c.Config
+		config.EmptyContent = l.translate(config.EmptyContent)
+
+		config.Columns = make([]component.TableCol, len(c.Config.Columns))
+		for i, col := range c.Config.Columns {
+			col.Name = l.translate(col.Name)
+			config.Columns[i] = col
+		}
+
+		config.Rows = make([]component.TableRow, len(c.Config.Rows))
+		for i, row := range c.Config.Rows {
+			localizedRow := component.TableRow{}
+			for accessor, cell := range row {
+				localizedRow[accessor] = component.Rewrite(cell, l.container)
+			}
+			config.Rows[i] = localizedRow
+		}
+
+		if config.ButtonGroup != nil {
+			if buttonGroup, ok := l.container(config.ButtonGroup).(*component.ButtonGroup); ok {
+				config.ButtonGroup = buttonGroup
+			}
+		}
+
+		return &component.Table{Base: c.Base, Config: config}
+	case *component.Summary:
+		config := c.Config
+		config.Sections = make(component.SummarySections, len(c.Config.Sections))
+		for i, section := range c.Config.Sections {
+			section.Header = l.translate(section.Header)
+			section.Content = component.Rewrite(section.Content, l.container)
+			config.Sections[i] = section
+		}
+
+		return &component.Summary{Base: c.Base, Config: config}
+	case *component.ButtonGroup:
+		config := c.Config
+		if len(c.Config.Buttons) == 0 {
+			return c
+		}
+
+		config.Buttons = make([]component.Button, len(c.Config.Buttons))
+		for i, button := range c.Config.Buttons {
+			button.Name = l.translate(button.Name)
+			config.Buttons[i] = button
+		}
+
+		return &component.ButtonGroup{Base: c.Base, Config: config}
+	}
+
+	return c
+}
+
+// labels translates status text and component titles. A component whose title
+// is translated is copied, and the components nested in the copy are searched
+// since Rewrite doesn't search the components it replaces.
+func (l localizer) labels(c component.Component) component.Component {
+	if text, ok := c.(*component.Text); ok {
+		return l.status(text)
+	}
+
+	metadata := c.GetMetadata()
+	titles, changed := l.titles(metadata.Title)
+	if !changed {
+		return c
+	}
+
+	v := reflect.ValueOf(c)
+	if v.Kind() != reflect.Ptr {
+		return c
+	}
+
+	copied := reflect.New(v.Elem().Type())
+	copied.Elem().Set(v.Elem())
+	localized := copied.Interface().(component.Component)
+
+	metadata.Title = titles
+	localized.SetMetadata(metadata)
+
+	return component.Rewrite(localized, func(nested component.Component) component.Component {
+		if nested == localized {
+			return nested
+		}
+		return l.labels(nested)
+	})
+}
+
+// titles translates the text in titles. changed is false if none of the titles
+// were translated.
+func (l localizer) titles(titles []component.TitleComponent) (localized []component.TitleComponent, changed bool) {
+	if titles == nil {
+		return nil, false
+	}
+
+	localized = make([]component.TitleComponent, len(titles))
+	for i, title := range titles {
+		localized[i] = title
+		if text, ok := title.(*component.Text); ok {
+			localized[i] = l.text(text)
+			changed = changed || localized[i] != title
+		}
+	}
+
+	return localized, changed
+}
+
+// status translates text components with a status. Octant sets the status on
+// text it generates to describe the state of an object.
+func (l localizer) status(text *component.Text) component.Component {
+	if text.Config.Status == 0 {
+		return text
+	}
+	return l.text(text)
+}
+
+// text translates a text component.
+func (l localizer) text(text *component.Text) *component.Text {
+	translated := l.translate(text.Config.Text)
+	if translated == text.Config.Text {
+		return text
+	}
+
+	localized := *text
+	localized.Config.Text = translated
+	return &localized
+}
@@ -0,0 +1,104 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package i18n
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func TestLocalize(t *testing.T) {
+	translator := NewCatalogs(map[string]Catalog{
+		"es": {
+			"Summary":              "Resumen",
+			"Status":               "Estado",
+			"Running":              "En ejecución",
+			"Containers":           "Contenedores",
+			"Name":                 "Nombre",
+			"Kind":                 "Tipo",
+			"Node":                 "Nodo",
+			"There are no events!": "¡No hay eventos!",
+			"Delete":               "Eliminar",
+			"Configuration":        "Configuración",
+		},
+	})
+
+	status := func(s string) *component.Text {
+		text := component.NewText(s)
+		text.SetStatus(component.TextStatusOK)
+		return text
+	}
+
+	contentResponse := func() component.ContentResponse {
+		table := component.NewTable("Containers", "There are no events!", component.NewTableCols("Name", "Kind", "Status"))
+		table.Add(component.TableRow{
+			"Name":   component.NewText("nginx"),
+			"Kind":   component.NewText("Node"),
+			"Status": status("Running"),
+		})
+		table.AddFilter("Status", component.TableFilter{Values: []string{"Running"}})
+
+		summary := component.NewSummary("Configuration", component.SummarySection{
+			Header:  "Status",
+			Content: component.NewText("Running"),
+		})
+
+		tab := component.NewFlexLayout("Summary")
+		tab.AddSections(component.FlexLayoutSection{
+			{Width: component.WidthFull, View: summary},
+			{Width: component.WidthFull, View: table},
+		})
+
+		cr := component.NewContentResponse(component.TitleFromString("Summary"))
+		cr.Add(tab)
+		cr.AddButton("Delete", action.CreatePayload("action", nil))
+		return *cr
+	}
+
+	original := contentResponse()
+	got := Localize(original, translator, "es")
+
+	// Columns keep their accessor, so the rows and filters aren't rekeyed. Only
+	// text with a status is translated.
+	expectedTable := component.NewTable("Contenedores", "¡No hay eventos!", []component.TableCol{
+		{Name: "Nombre", Accessor: "Name"},
+		{Name: "Tipo", Accessor: "Kind"},
+		{Name: "Estado", Accessor: "Status"},
+	})
+	expectedTable.Add(component.TableRow{
+		"Name":   component.NewText("nginx"),
+		"Kind":   component.NewText("Node"),
+		"Status": status("En ejecución"),
+	})
+	expectedTable.AddFilter("Status", component.TableFilter{Values: []string{"Running"}})
+
+	expectedSummary := component.NewSummary("Configuración", component.SummarySection{
+		Header:  "Estado",
+		Content: component.NewText("Running"),
+	})
+
+	expectedTab := component.NewFlexLayout("Resumen")
+	expectedTab.AddSections(component.FlexLayoutSection{
+		{Width: component.WidthFull, View: expectedSummary},
+		{Width: component.WidthFull, View: expectedTable},
+	})
+
+	expected := component.NewContentResponse(component.TitleFromString("Resumen"))
+	expected.Add(expectedTab)
+	expected.AddButton("Eliminar", action.CreatePayload("action", nil))
+
+	assert.Equal(t, *expected, got)
+
+	// The original content is not modified.
+	assert.Equal(t, contentResponse(), original)
+
+	assert.Equal(t, original, Localize(original, translator, DefaultLocale))
+	assert.Equal(t, original, Localize(original, nil, "es"))
+}
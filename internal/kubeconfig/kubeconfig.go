@@ -7,11 +7,14 @@ package kubeconfig
 
 import (
 	"context"
+	"fmt"
 	"path/filepath"
 	"sort"
+	gostrings "strings"
 	"sync/atomic"
 
 	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 
 	"github.com/pkg/errors"
 
@@ -75,15 +78,21 @@ func NewKubeConfigContextManager(ctx context.Context, opts ...KubeConfigOption)
 		overrides.CurrentContext = options.ContextName
 	}
 	clientConfig := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(rules, overrides)
-	clusterClient, err := cluster.FromClientConfig(ctx, clientConfig, clusterOptions...)
-	if err != nil {
-		return nil, errors.Wrap(err, "unable to create cluster client")
-	}
 
 	config, err := clientConfig.RawConfig()
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to load kube config")
 	}
+
+	if err := validateContextName(config, options.ContextName); err != nil {
+		return nil, err
+	}
+
+	clusterClient, err := cluster.FromClientConfig(ctx, clientConfig, clusterOptions...)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create cluster client")
+	}
+
 	var contextList []Context
 
 	for name := range config.Contexts {
@@ -127,6 +136,48 @@ type Context struct {
 // UseFSContext is used to indicate a context switch to the file system Kubeconfig context
 const UseFSContext = ""
 
+// ContextNotFoundError is returned when a requested context does not exist in the kube config.
+type ContextNotFoundError struct {
+	// Name is the name of the requested context.
+	Name string
+	// Available are the names of the contexts found in the kube config.
+	Available []string
+}
+
+var _ error = (*ContextNotFoundError)(nil)
+
+// Error returns the error string.
+func (e *ContextNotFoundError) Error() string {
+	if len(e.Available) == 0 {
+		return fmt.Sprintf("context %q does not exist: kube config has no contexts", e.Name)
+	}
+	return fmt.Sprintf("context %q does not exist, available contexts: %s",
+		e.Name, gostrings.Join(e.Available, ", "))
+}
+
+// validateContextName returns a ContextNotFoundError if contextName is set and not
+// present in the kube config.
+func validateContextName(config clientcmdapi.Config, contextName string) error {
+	if contextName == "" {
+		return nil
+	}
+
+	if _, ok := config.Contexts[contextName]; ok {
+		return nil
+	}
+
+	var available []string
+	for name := range config.Contexts {
+		available = append(available, name)
+	}
+	sort.Strings(available)
+
+	return &ContextNotFoundError{
+		Name:      contextName,
+		Available: available,
+	}
+}
+
 func (k *KubeConfigContextManager) CurrentContext() string {
 	return k.currentContext
 }
@@ -136,6 +187,16 @@ func (k *KubeConfigContextManager) Contexts() []Context {
 }
 
 func (k *KubeConfigContextManager) SwitchContext(ctx context.Context, contextName string) error {
+	if contextName != UseFSContext {
+		rawConfig, err := k.configLoadingRules.Load()
+		if err != nil {
+			return errors.Wrap(err, "unable to load kube config")
+		}
+		if err := validateContextName(*rawConfig, contextName); err != nil {
+			return err
+		}
+	}
+
 	v := k.clusterClient.Load()
 	if v != nil {
 		clusterClient := v.(cluster.ClientInterface)
@@ -166,6 +227,30 @@ func (k *KubeConfigContextManager) SwitchContext(ctx context.Context, contextNam
 	return nil
 }
 
+// ClusterClientForContext creates a cluster client for a context without switching
+// to it. The client should be closed when it is no longer needed.
+func (k *KubeConfigContextManager) ClusterClientForContext(ctx context.Context, contextName string) (cluster.ClientInterface, error) {
+	rawConfig, err := k.configLoadingRules.Load()
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to load kube config")
+	}
+	if err := validateContextName(*rawConfig, contextName); err != nil {
+		return nil, err
+	}
+
+	clientConfig := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
+		k.configLoadingRules,
+		&clientcmd.ConfigOverrides{CurrentContext: contextName},
+	)
+
+	clusterClient, err := cluster.FromClientConfig(ctx, clientConfig, k.clusterOptions...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to create cluster client for context %q", contextName)
+	}
+
+	return clusterClient, nil
+}
+
 func (k *KubeConfigContextManager) ClusterClient() cluster.ClientInterface {
 	v := k.clusterClient.Load()
 	if v == nil {
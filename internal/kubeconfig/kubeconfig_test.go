@@ -7,6 +7,7 @@ package kubeconfig
 
 import (
 	"context"
+	"errors"
 	"io/ioutil"
 	"os"
 	"path/filepath"
@@ -97,3 +98,57 @@ func TestFSLoader_Load(t *testing.T) {
 		{Name: "exp-scratch"},
 	}, kc.Contexts())
 }
+
+func Test_NewKubeConfigsWithContext(t *testing.T) {
+	kubeConfigs, err := NewKubeConfigContextManager(
+		context.TODO(),
+		WithKubeConfigList(filepath.Join("testdata", "kubeconfig.yaml")),
+		WithContextName("other-context"),
+	)
+	require.NoError(t, err)
+	require.Equal(t, "other-context", kubeConfigs.CurrentContext())
+}
+
+func Test_NewKubeConfigsWithMissingContext(t *testing.T) {
+	_, err := NewKubeConfigContextManager(
+		context.TODO(),
+		WithKubeConfigList(filepath.Join("testdata", "kubeconfig.yaml")),
+		WithContextName("missing"),
+	)
+	require.Error(t, err)
+
+	var cnf *ContextNotFoundError
+	require.True(t, errors.As(err, &cnf))
+	assert.Equal(t, "missing", cnf.Name)
+	assert.Equal(t, []string{"my-cluster", "other-context"}, cnf.Available)
+	assert.Contains(t, err.Error(), "available contexts: my-cluster, other-context")
+}
+
+func Test_SwitchContextToMissingContext(t *testing.T) {
+	kubeConfigs, err := NewKubeConfigContextManager(
+		context.TODO(),
+		WithKubeConfigList(filepath.Join("testdata", "kubeconfig.yaml")),
+	)
+	require.NoError(t, err)
+
+	require.Error(t, kubeConfigs.SwitchContext(context.TODO(), "missing"))
+	require.Equal(t, "my-cluster", kubeConfigs.CurrentContext())
+}
+
+func Test_ClusterClientForContextDoesNotSwitchContext(t *testing.T) {
+	kubeConfigs, err := NewKubeConfigContextManager(
+		context.TODO(),
+		WithKubeConfigList(filepath.Join("testdata", "kubeconfig.yaml")),
+	)
+	require.NoError(t, err)
+
+	clusterClient, err := kubeConfigs.ClusterClientForContext(context.TODO(), "other-context")
+	require.NoError(t, err)
+	defer clusterClient.Close()
+
+	require.Equal(t, "non-default", clusterClient.DefaultNamespace())
+	require.Equal(t, "my-cluster", kubeConfigs.CurrentContext())
+
+	_, err = kubeConfigs.ClusterClientForContext(context.TODO(), "missing")
+	require.Error(t, err)
+}
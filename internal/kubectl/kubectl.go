@@ -0,0 +1,144 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package kubectl generates kubectl commands which are equivalent to actions
+// performed by the dashboard, so they can be reproduced in scripts or runbooks.
+package kubectl
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+// safeArgument matches arguments which don't need to be quoted in a shell.
+var safeArgument = regexp.MustCompile(`^[A-Za-z0-9_@%+=:,./-]+$`)
+
+// Command is a kubectl command.
+type Command []string
+
+// String returns the command as it would be typed in a shell.
+func (c Command) String() string {
+	args := []string{"kubectl"}
+	for _, arg := range c {
+		args = append(args, quote(arg))
+	}
+	return strings.Join(args, " ")
+}
+
+// Describe appends the command to a message describing an action.
+func Describe(message string, command Command) string {
+	return fmt.Sprintf("%s (kubectl equivalent: %s)", message, command)
+}
+
+// Delete deletes an object.
+func Delete(key store.Key) Command {
+	return withNamespace(Command{"delete", Resource(key.GroupVersionKind(), key.Name)}, key.Namespace)
+}
+
+// Scale sets the replicas of an object.
+func Scale(key store.Key, replicas int64) Command {
+	command := Command{"scale", Resource(key.GroupVersionKind(), key.Name), fmt.Sprintf("--replicas=%d", replicas)}
+	return withNamespace(command, key.Namespace)
+}
+
+// Patch applies a merge patch to an object.
+func Patch(key store.Key, patch string) Command {
+	command := Command{"patch", Resource(key.GroupVersionKind(), key.Name), "--type", "merge", "--patch", patch}
+	return withNamespace(command, key.Namespace)
+}
+
+// RolloutPause pauses the rollout of an object.
+func RolloutPause(key store.Key) Command {
+	command := Command{"rollout", "pause", Resource(key.GroupVersionKind(), key.Name)}
+	return withNamespace(command, key.Namespace)
+}
+
+// RolloutResume resumes the rollout of an object.
+func RolloutResume(key store.Key) Command {
+	command := Command{"rollout", "resume", Resource(key.GroupVersionKind(), key.Name)}
+	return withNamespace(command, key.Namespace)
+}
+
+// Cordon marks a node as unschedulable.
+func Cordon(name string) Command {
+	return Command{"cordon", name}
+}
+
+// Uncordon marks a node as schedulable.
+func Uncordon(name string) Command {
+	return Command{"uncordon", name}
+}
+
+// Taint adds or updates a taint on a node.
+func Taint(name string, taint corev1.Taint) Command {
+	value := taint.Key
+	if taint.Value != "" {
+		value = fmt.Sprintf("%s=%s", taint.Key, taint.Value)
+	}
+	return Command{"taint", "nodes", name, fmt.Sprintf("%s:%s", value, taint.Effect), "--overwrite"}
+}
+
+// RemoveTaint removes a taint from a node.
+func RemoveTaint(name string, taint corev1.Taint) Command {
+	return Command{"taint", "nodes", name, fmt.Sprintf("%s:%s-", taint.Key, taint.Effect)}
+}
+
+// Drain cordons a node and evicts its pods.
+func Drain(name string) Command {
+	return Command{"drain", name, "--ignore-daemonsets"}
+}
+
+// CreateJobFromCronJob creates a job from the template of a cron job.
+func CreateJobFromCronJob(namespace, cronJobName, jobName string) Command {
+	return withNamespace(Command{"create", "job", jobName, "--from=cronjob/" + cronJobName}, namespace)
+}
+
+// SuspendCronJob suspends or resumes a cron job.
+func SuspendCronJob(namespace, name string, suspend bool) Command {
+	patch := fmt.Sprintf(`{"spec":{"suspend":%t}}`, suspend)
+	return withNamespace(Command{"patch", "cronjob", name, "--patch", patch}, namespace)
+}
+
+// ResizePersistentVolumeClaim requests a new size for a persistent volume claim.
+func ResizePersistentVolumeClaim(namespace, name, size string) Command {
+	patch := fmt.Sprintf(`{"spec":{"resources":{"requests":{"storage":"%s"}}}}`, size)
+	return withNamespace(Command{"patch", "pvc", name, "--patch", patch}, namespace)
+}
+
+// PortForward forwards a local port to a port of an object.
+func PortForward(gvk schema.GroupVersionKind, namespace, name string, localPort, remotePort uint16) Command {
+	command := Command{"port-forward", Resource(gvk, name), fmt.Sprintf("%d:%d", localPort, remotePort)}
+	return withNamespace(command, namespace)
+}
+
+// Resource names an object the way kubectl arguments do, e.g. deployment.apps/web.
+// The group is included so the name is unambiguous.
+func Resource(gvk schema.GroupVersionKind, name string) string {
+	resource := strings.ToLower(gvk.Kind)
+	if gvk.Group != "" {
+		resource += "." + gvk.Group
+	}
+	return resource + "/" + name
+}
+
+func withNamespace(command Command, namespace string) Command {
+	if namespace == "" {
+		return command
+	}
+	return append(command, "--namespace", namespace)
+}
+
+func quote(arg string) string {
+	if safeArgument.MatchString(arg) {
+		return arg
+	}
+	return "'" + strings.ReplaceAll(arg, "'", `'\''`) + "'"
+}
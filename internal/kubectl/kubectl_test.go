@@ -0,0 +1,119 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package kubectl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+func TestCommand_String(t *testing.T) {
+	deployment := store.Key{Namespace: "default", APIVersion: "apps/v1", Kind: "Deployment", Name: "web"}
+	node := store.Key{APIVersion: "v1", Kind: "Node", Name: "node-1"}
+
+	tests := []struct {
+		name     string
+		command  Command
+		expected string
+	}{
+		{
+			name:     "delete namespaced object",
+			command:  Delete(deployment),
+			expected: "kubectl delete deployment.apps/web --namespace default",
+		},
+		{
+			name:     "delete cluster scoped object",
+			command:  Delete(node),
+			expected: "kubectl delete node/node-1",
+		},
+		{
+			name:     "scale",
+			command:  Scale(deployment, 3),
+			expected: "kubectl scale deployment.apps/web --replicas=3 --namespace default",
+		},
+		{
+			name:     "patch",
+			command:  Patch(deployment, `{"spec":{"replicas":3}}`),
+			expected: `kubectl patch deployment.apps/web --type merge --patch '{"spec":{"replicas":3}}' --namespace default`,
+		},
+		{
+			name:     "rollout pause",
+			command:  RolloutPause(deployment),
+			expected: "kubectl rollout pause deployment.apps/web --namespace default",
+		},
+		{
+			name:     "rollout resume",
+			command:  RolloutResume(deployment),
+			expected: "kubectl rollout resume deployment.apps/web --namespace default",
+		},
+		{
+			name:     "cordon",
+			command:  Cordon("node-1"),
+			expected: "kubectl cordon node-1",
+		},
+		{
+			name:     "uncordon",
+			command:  Uncordon("node-1"),
+			expected: "kubectl uncordon node-1",
+		},
+		{
+			name:     "taint",
+			command:  Taint("node-1", corev1.Taint{Key: "dedicated", Value: "gpu", Effect: corev1.TaintEffectNoSchedule}),
+			expected: "kubectl taint nodes node-1 dedicated=gpu:NoSchedule --overwrite",
+		},
+		{
+			name:     "taint without value",
+			command:  Taint("node-1", corev1.Taint{Key: "dedicated", Effect: corev1.TaintEffectNoExecute}),
+			expected: "kubectl taint nodes node-1 dedicated:NoExecute --overwrite",
+		},
+		{
+			name:     "remove taint",
+			command:  RemoveTaint("node-1", corev1.Taint{Key: "dedicated", Effect: corev1.TaintEffectNoSchedule}),
+			expected: "kubectl taint nodes node-1 dedicated:NoSchedule-",
+		},
+		{
+			name:     "drain",
+			command:  Drain("node-1"),
+			expected: "kubectl drain node-1 --ignore-daemonsets",
+		},
+		{
+			name:     "create job from cron job",
+			command:  CreateJobFromCronJob("default", "backup", "backup-abc12"),
+			expected: "kubectl create job backup-abc12 --from=cronjob/backup --namespace default",
+		},
+		{
+			name:     "suspend cron job",
+			command:  SuspendCronJob("default", "backup", true),
+			expected: `kubectl patch cronjob backup --patch '{"spec":{"suspend":true}}' --namespace default`,
+		},
+		{
+			name:     "resize persistent volume claim",
+			command:  ResizePersistentVolumeClaim("default", "data", "20Gi"),
+			expected: `kubectl patch pvc data --patch '{"spec":{"resources":{"requests":{"storage":"20Gi"}}}}' --namespace default`,
+		},
+		{
+			name:     "port forward",
+			command:  PortForward(schema.GroupVersionKind{Version: "v1", Kind: "Pod"}, "default", "web-1", 50123, 8080),
+			expected: "kubectl port-forward pod/web-1 50123:8080 --namespace default",
+		},
+		{
+			name:     "quotes single quotes",
+			command:  Command{"annotate", "pod/web-1", "note=it's"},
+			expected: `kubectl annotate pod/web-1 'note=it'\''s'`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, test.command.String())
+		})
+	}
+}
@@ -0,0 +1,134 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package kubectlplugin discovers installed kubectl plugins and runs them
+// against objects.
+package kubectlplugin
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+)
+
+// executablePrefix is the prefix of a kubectl plugin's executable name.
+const executablePrefix = "kubectl-"
+
+// Plugin is an installed kubectl plugin.
+type Plugin struct {
+	// Name is the name the plugin is invoked with. kubectl-view_secret is
+	// named view-secret.
+	Name string `json:"name"`
+	// Path is the path to the plugin's executable.
+	Path string `json:"path"`
+	// Krew is true if the plugin was installed by krew.
+	Krew bool `json:"krew"`
+}
+
+// KrewRoot returns the directory krew installs plugins to. It is $KREW_ROOT if
+// set and ~/.krew otherwise.
+func KrewRoot() string {
+	if root := os.Getenv("KREW_ROOT"); root != "" {
+		return root
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+
+	return filepath.Join(home, ".krew")
+}
+
+// Discover finds the kubectl plugins in the directories of a path list, such
+// as $PATH, and in krew's bin directory. Like kubectl, the first plugin found
+// with a name wins.
+func Discover(pathList, krewRoot string) []Plugin {
+	dirs := filepath.SplitList(pathList)
+
+	krewBin := ""
+	if krewRoot != "" {
+		krewBin = filepath.Join(krewRoot, "bin")
+		dirs = append(dirs, krewBin)
+	}
+
+	found := map[string]Plugin{}
+	for _, dir := range dirs {
+		if dir == "" {
+			continue
+		}
+
+		entries, err := ioutil.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+
+		for _, entry := range entries {
+			if !strings.HasPrefix(entry.Name(), executablePrefix) {
+				continue
+			}
+
+			name := pluginName(entry.Name())
+			if _, ok := found[name]; ok {
+				continue
+			}
+
+			path := filepath.Join(dir, entry.Name())
+			// krew installs plugins as symlinks, so the link is followed.
+			fi, err := os.Stat(path)
+			if err != nil || !isExecutable(fi) {
+				continue
+			}
+
+			found[name] = Plugin{
+				Name: name,
+				Path: path,
+				Krew: filepath.Clean(dir) == filepath.Clean(krewBin) || hasKrewReceipt(krewRoot, name),
+			}
+		}
+	}
+
+	var plugins []Plugin
+	for _, plugin := range found {
+		plugins = append(plugins, plugin)
+	}
+
+	sort.Slice(plugins, func(i, j int) bool {
+		return plugins[i].Name < plugins[j].Name
+	})
+
+	return plugins
+}
+
+// pluginName converts an executable name to the name kubectl invokes it with.
+func pluginName(executable string) string {
+	name := strings.TrimPrefix(executable, executablePrefix)
+	if runtime.GOOS == "windows" {
+		name = strings.TrimSuffix(name, filepath.Ext(name))
+	}
+	return strings.ReplaceAll(name, "_", "-")
+}
+
+func isExecutable(fi os.FileInfo) bool {
+	if fi.IsDir() {
+		return false
+	}
+	if runtime.GOOS == "windows" {
+		return strings.EqualFold(filepath.Ext(fi.Name()), ".exe")
+	}
+	return fi.Mode()&0111 != 0
+}
+
+// hasKrewReceipt returns true if krew has a receipt for a plugin.
+func hasKrewReceipt(krewRoot, name string) bool {
+	if krewRoot == "" {
+		return false
+	}
+	_, err := os.Stat(filepath.Join(krewRoot, "receipts", name+".yaml"))
+	return err == nil
+}
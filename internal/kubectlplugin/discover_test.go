@@ -0,0 +1,72 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package kubectlplugin
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writePlugin writes an executable shell script to a directory.
+func writePlugin(t *testing.T, dir, name, script string) string {
+	require.NoError(t, os.MkdirAll(dir, 0755))
+	path := filepath.Join(dir, name)
+	require.NoError(t, ioutil.WriteFile(path, []byte("#!/bin/sh\n"+script+"\n"), 0755))
+	return path
+}
+
+func TestDiscover(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("plugins are shell scripts")
+	}
+
+	dir, err := ioutil.TempDir("", "kubectlplugin")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	bin := filepath.Join(dir, "bin")
+	local := filepath.Join(dir, "local")
+	krewRoot := filepath.Join(dir, "krew")
+
+	tree := writePlugin(t, bin, "kubectl-tree", "")
+	writePlugin(t, local, "kubectl-tree", "")
+	writePlugin(t, bin, "kubectl", "")
+	require.NoError(t, ioutil.WriteFile(filepath.Join(bin, "kubectl-notes"), nil, 0644))
+
+	viewSecret := writePlugin(t, filepath.Join(krewRoot, "store", "view-secret"), "kubectl-view_secret", "")
+	require.NoError(t, os.MkdirAll(filepath.Join(krewRoot, "bin"), 0755))
+	krewViewSecret := filepath.Join(krewRoot, "bin", "kubectl-view_secret")
+	require.NoError(t, os.Symlink(viewSecret, krewViewSecret))
+
+	got := Discover(strings.Join([]string{bin, "", local, filepath.Join(dir, "missing")}, string(os.PathListSeparator)), krewRoot)
+
+	expected := []Plugin{
+		{Name: "tree", Path: tree},
+		{Name: "view-secret", Path: krewViewSecret, Krew: true},
+	}
+	assert.Equal(t, expected, got)
+}
+
+func TestKrewRoot(t *testing.T) {
+	previous, ok := os.LookupEnv("KREW_ROOT")
+	defer func() {
+		if ok {
+			_ = os.Setenv("KREW_ROOT", previous)
+		} else {
+			_ = os.Unsetenv("KREW_ROOT")
+		}
+	}()
+
+	require.NoError(t, os.Setenv("KREW_ROOT", "/opt/krew"))
+	assert.Equal(t, "/opt/krew", KrewRoot())
+}
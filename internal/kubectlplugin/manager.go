@@ -0,0 +1,244 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package kubectlplugin
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+//go:generate mockgen -destination=./fake/mock_manager.go -package=fake github.com/vmware-tanzu/octant/internal/kubectlplugin Manager
+
+const (
+	// DefaultRunTimeout is how long a plugin can run before it is stopped.
+	DefaultRunTimeout = 5 * time.Minute
+	// maxOutput is the number of bytes of output kept for a run. Earlier
+	// output is discarded.
+	maxOutput = 256 * 1024
+	// maxRuns is the number of runs kept. The oldest finished runs are
+	// discarded first.
+	maxRuns = 50
+)
+
+// Manager runs kubectl plugins against objects.
+type Manager interface {
+	// ForKind returns the installed plugins mapped to a kind.
+	ForKind(kind string) []Plugin
+	// Run starts a plugin against the object with a key in a kube config
+	// context. The plugin runs in the background.
+	Run(name string, key store.Key, contextName string) (RunStatus, error)
+	// Runs returns the runs against the object with a key, newest first.
+	Runs(key store.Key) []RunStatus
+}
+
+// RunStatus is the state of a plugin run.
+type RunStatus struct {
+	ID       string
+	Plugin   string
+	Args     []string
+	Key      store.Key
+	Started  time.Time
+	Finished time.Time
+	// Err is the reason a finished run failed. It is blank if the plugin
+	// exited successfully.
+	Err string
+	// Output is the combined stdout and stderr of the plugin so far.
+	Output string
+}
+
+// Running returns true if the plugin hasn't exited.
+func (s RunStatus) Running() bool {
+	return s.Finished.IsZero()
+}
+
+// Plugins runs installed kubectl plugins using a set of mappings.
+type Plugins struct {
+	ctx        context.Context
+	installed  map[string]Plugin
+	mappings   []Mapping
+	kubeConfig string
+	timeout    time.Duration
+
+	mu     sync.Mutex
+	runs   []*run
+	nextID int
+}
+
+var _ Manager = (*Plugins)(nil)
+
+// NewPlugins creates an instance of Plugins. Plugins are run with KUBECONFIG
+// set to the kube config path list and are stopped when the context is done.
+func NewPlugins(ctx context.Context, installed []Plugin, mappings []Mapping, kubeConfig string) *Plugins {
+	p := &Plugins{
+		ctx:        ctx,
+		installed:  map[string]Plugin{},
+		mappings:   mappings,
+		kubeConfig: kubeConfig,
+		timeout:    DefaultRunTimeout,
+	}
+
+	for _, plugin := range installed {
+		p.installed[plugin.Name] = plugin
+	}
+
+	return p
+}
+
+// ForKind returns the installed plugins mapped to a kind in mapping order.
+func (p *Plugins) ForKind(kind string) []Plugin {
+	var list []Plugin
+	for _, mapping := range p.mappings {
+		plugin, ok := p.installed[mapping.Plugin]
+		if ok && mapping.Matches(kind) {
+			list = append(list, plugin)
+		}
+	}
+	return list
+}
+
+// Run starts a plugin against an object. It returns an error if the plugin
+// isn't installed, isn't mapped to the object's kind, or can't be started.
+func (p *Plugins) Run(name string, key store.Key, contextName string) (RunStatus, error) {
+	plugin, ok := p.installed[name]
+	if !ok {
+		return RunStatus{}, fmt.Errorf("kubectl plugin %q is not installed", name)
+	}
+
+	var mapping *Mapping
+	for i := range p.mappings {
+		if p.mappings[i].Plugin == name && p.mappings[i].Matches(key.Kind) {
+			mapping = &p.mappings[i]
+			break
+		}
+	}
+	if mapping == nil {
+		return RunStatus{}, fmt.Errorf("kubectl plugin %q can't run against %s", name, key.Kind)
+	}
+
+	args := mapping.Arguments(key, contextName)
+
+	ctx, cancel := context.WithTimeout(p.ctx, p.timeout)
+	cmd := exec.CommandContext(ctx, plugin.Path, args...)
+	cmd.Env = os.Environ()
+	if p.kubeConfig != "" {
+		cmd.Env = append(cmd.Env, "KUBECONFIG="+p.kubeConfig)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.nextID++
+	r := &run{
+		status: RunStatus{
+			ID:      strconv.Itoa(p.nextID),
+			Plugin:  name,
+			Args:    args,
+			Key:     key,
+			Started: time.Now(),
+		},
+	}
+	cmd.Stdout = r
+	cmd.Stderr = r
+
+	if err := cmd.Start(); err != nil {
+		cancel()
+		return RunStatus{}, fmt.Errorf("start kubectl plugin %q: %w", name, err)
+	}
+	p.add(r)
+
+	go func() {
+		defer cancel()
+		err := cmd.Wait()
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			err = fmt.Errorf("stopped after %s", p.timeout)
+		}
+		r.finish(err)
+	}()
+
+	return r.Status(), nil
+}
+
+// Runs returns the runs against an object, newest first.
+func (p *Plugins) Runs(key store.Key) []RunStatus {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var list []RunStatus
+	for i := len(p.runs) - 1; i >= 0; i-- {
+		if status := p.runs[i].Status(); status.Key == key {
+			list = append(list, status)
+		}
+	}
+	return list
+}
+
+// add adds a run, discarding the oldest finished run if there are too many.
+// The caller must hold the lock.
+func (p *Plugins) add(r *run) {
+	p.runs = append(p.runs, r)
+	if len(p.runs) <= maxRuns {
+		return
+	}
+
+	for i := range p.runs {
+		if !p.runs[i].Status().Running() {
+			p.runs = append(p.runs[:i], p.runs[i+1:]...)
+			return
+		}
+	}
+}
+
+// run is a plugin run. It collects the plugin's output.
+type run struct {
+	mu     sync.Mutex
+	status RunStatus
+	output bytes.Buffer
+}
+
+var _ io.Writer = (*run)(nil)
+
+// Write appends output, keeping the last maxOutput bytes.
+func (r *run) Write(p []byte) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.output.Write(p)
+	if extra := r.output.Len() - maxOutput; extra > 0 {
+		r.output.Next(extra)
+	}
+
+	return len(p), nil
+}
+
+func (r *run) finish(err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.status.Finished = time.Now()
+	if err != nil {
+		r.status.Err = err.Error()
+	}
+}
+
+// Status returns the run's status including its output so far.
+func (r *run) Status() RunStatus {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	status := r.status
+	status.Output = r.output.String()
+	return status
+}
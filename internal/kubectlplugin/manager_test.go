@@ -0,0 +1,107 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package kubectlplugin
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+// awaitRun waits for a run to finish.
+func awaitRun(t *testing.T, p *Plugins, key store.Key, id string) RunStatus {
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		for _, status := range p.Runs(key) {
+			if status.ID == id && !status.Running() {
+				return status
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	t.Fatalf("run %s did not finish", id)
+	return RunStatus{}
+}
+
+func TestPlugins(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("plugins are shell scripts")
+	}
+
+	dir, err := ioutil.TempDir("", "kubectlplugin")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	installed := []Plugin{
+		{Name: "tree", Path: writePlugin(t, dir, "kubectl-tree", `echo "$KUBECONFIG $@"`)},
+		{Name: "view-secret", Path: writePlugin(t, dir, "kubectl-view_secret", "echo denied >&2; exit 3")},
+		{Name: "unmapped", Path: writePlugin(t, dir, "kubectl-unmapped", "")},
+	}
+
+	mappings := []Mapping{
+		{Plugin: "view-secret", Kinds: []string{"Secret"}, Args: "$NAME"},
+		{Plugin: "tree", Kinds: []string{AnyKind}, Args: defaultArgs},
+		{Plugin: "neat", Kinds: []string{AnyKind}, Args: defaultArgs},
+	}
+
+	p := NewPlugins(context.Background(), installed, mappings, "/kube/config")
+
+	assert.Equal(t, []Plugin{installed[0]}, p.ForKind("Deployment"))
+	assert.Equal(t, []Plugin{installed[1], installed[0]}, p.ForKind("Secret"))
+
+	deployment := store.Key{APIVersion: "apps/v1", Kind: "Deployment", Namespace: "default", Name: "nginx"}
+
+	status, err := p.Run("tree", deployment, "dev")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"--namespace=default", "--context=dev", "--", "Deployment", "nginx"}, status.Args)
+
+	status = awaitRun(t, p, deployment, status.ID)
+	assert.Equal(t, "/kube/config --namespace=default --context=dev -- Deployment nginx\n", status.Output)
+	assert.Empty(t, status.Err)
+
+	secret := store.Key{APIVersion: "v1", Kind: "Secret", Namespace: "default", Name: "token"}
+	status, err = p.Run("view-secret", secret, "dev")
+	require.NoError(t, err)
+
+	status = awaitRun(t, p, secret, status.ID)
+	assert.Equal(t, "denied\n", status.Output)
+	assert.Equal(t, "exit status 3", status.Err)
+
+	assert.Len(t, p.Runs(deployment), 1)
+
+	_, err = p.Run("view-secret", deployment, "dev")
+	require.Error(t, err)
+	_, err = p.Run("neat", deployment, "dev")
+	require.Error(t, err)
+	_, err = p.Run("unmapped", deployment, "dev")
+	require.Error(t, err)
+}
+
+func TestRun_Write(t *testing.T) {
+	r := &run{}
+
+	chunk := make([]byte, maxOutput)
+	for i := range chunk {
+		chunk[i] = 'a'
+	}
+	_, err := r.Write(chunk)
+	require.NoError(t, err)
+	_, err = r.Write([]byte("b"))
+	require.NoError(t, err)
+
+	output := r.Status().Output
+	assert.Len(t, output, maxOutput)
+	assert.Equal(t, byte('b'), output[len(output)-1])
+}
@@ -0,0 +1,158 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package kubectlplugin
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+// AnyKind is the kind of a mapping which matches every kind.
+const AnyKind = "*"
+
+// Mapping exposes a plugin as an action on objects of some kinds.
+type Mapping struct {
+	// Plugin is the name of the plugin.
+	Plugin string
+	// Kinds are the kinds the plugin can run against.
+	Kinds []string
+	// Args are the arguments the plugin is run with. $APIVERSION, $KIND,
+	// $NAME, $NAMESPACE, and $CONTEXT are replaced with the object's values.
+	// An argument which refers to a blank value is left out, so flags are
+	// written as --namespace=$NAMESPACE. Operands are passed after flags and
+	// a -- separator unless Args has its own separator.
+	Args string
+}
+
+// DefaultMappings are the mappings for popular krew plugins.
+var DefaultMappings = []Mapping{
+	{Plugin: "tree", Kinds: []string{AnyKind}, Args: "$KIND $NAME --namespace=$NAMESPACE --context=$CONTEXT"},
+	{Plugin: "neat", Kinds: []string{AnyKind}, Args: "get -- $KIND $NAME --namespace=$NAMESPACE --context=$CONTEXT"},
+	{Plugin: "view-secret", Kinds: []string{"Secret"}, Args: "$NAME --all --namespace=$NAMESPACE --context=$CONTEXT"},
+	{Plugin: "rolesum", Kinds: []string{"ServiceAccount"}, Args: "$NAME --namespace=$NAMESPACE --context=$CONTEXT"},
+}
+
+// defaultArgs are the arguments of a configured mapping without arguments.
+const defaultArgs = "$KIND $NAME --namespace=$NAMESPACE --context=$CONTEXT"
+
+// ParseMappings parses mappings from a list of "plugin=Kind|Kind[:args]"
+// values. Mappings replace the default mapping for their plugin, and a
+// mapping without kinds, such as "neat=", removes it.
+func ParseMappings(values []string) ([]Mapping, error) {
+	mappings := map[string]Mapping{}
+	var order []string
+	for _, mapping := range DefaultMappings {
+		mappings[mapping.Plugin] = mapping
+		order = append(order, mapping.Plugin)
+	}
+
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+
+		parts := strings.SplitN(value, "=", 2)
+		plugin := strings.TrimSpace(parts[0])
+		if len(parts) != 2 || plugin == "" {
+			return nil, fmt.Errorf("invalid kubectl plugin mapping %q: expected plugin=Kind|Kind[:args]", value)
+		}
+
+		if _, ok := mappings[plugin]; !ok {
+			order = append(order, plugin)
+		}
+
+		kinds, args := parts[1], defaultArgs
+		if i := strings.Index(kinds, ":"); i != -1 {
+			kinds, args = kinds[:i], strings.TrimSpace(kinds[i+1:])
+		}
+
+		mapping := Mapping{Plugin: plugin, Args: args}
+		for _, kind := range strings.Split(kinds, "|") {
+			if kind = strings.TrimSpace(kind); kind != "" {
+				mapping.Kinds = append(mapping.Kinds, kind)
+			}
+		}
+
+		mappings[plugin] = mapping
+	}
+
+	var list []Mapping
+	for _, plugin := range order {
+		if mapping := mappings[plugin]; len(mapping.Kinds) > 0 {
+			list = append(list, mapping)
+		}
+	}
+
+	return list, nil
+}
+
+// Matches returns true if the mapping exposes its plugin on a kind.
+func (m Mapping) Matches(kind string) bool {
+	for _, k := range m.Kinds {
+		if k == AnyKind || strings.EqualFold(k, kind) {
+			return true
+		}
+	}
+	return false
+}
+
+// Arguments expands the mapping's arguments for an object in a context. Words
+// before the first operand, such as subcommands, come first, then flags, then a
+// -- separator and the operands, so object values are never read as flags.
+func (m Mapping) Arguments(key store.Key, contextName string) []string {
+	values := map[string]string{
+		"APIVERSION": key.APIVersion,
+		"KIND":       key.Kind,
+		"NAME":       key.Name,
+		"NAMESPACE":  key.Namespace,
+		"CONTEXT":    contextName,
+	}
+
+	fields := strings.Fields(m.Args)
+	separated := false
+	for _, field := range fields {
+		if field == "--" {
+			separated = true
+		}
+	}
+
+	var words, flags, operands []string
+	for _, field := range fields {
+		blank := false
+		arg := os.Expand(field, func(name string) string {
+			value := values[name]
+			blank = blank || value == ""
+			return value
+		})
+		if blank {
+			continue
+		}
+
+		switch {
+		case separated:
+			// The mapping separates its own operands.
+			words = append(words, arg)
+		case strings.HasPrefix(field, "-"):
+			flags = append(flags, arg)
+		case !strings.Contains(field, "$") && len(operands) == 0:
+			words = append(words, arg)
+		default:
+			operands = append(operands, arg)
+		}
+	}
+
+	args := append(words, flags...)
+	if len(operands) > 0 {
+		args = append(args, "--")
+		args = append(args, operands...)
+	}
+
+	return args
+}
@@ -0,0 +1,71 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package kubectlplugin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+func TestParseMappings(t *testing.T) {
+	got, err := ParseMappings(nil)
+	require.NoError(t, err)
+	assert.Equal(t, DefaultMappings, got)
+
+	got, err = ParseMappings([]string{
+		"neat=",
+		"tree=Deployment|StatefulSet",
+		" ",
+		"images=Pod:$NAME -n $NAMESPACE",
+	})
+	require.NoError(t, err)
+
+	expected := []Mapping{
+		{Plugin: "tree", Kinds: []string{"Deployment", "StatefulSet"}, Args: defaultArgs},
+		DefaultMappings[2],
+		DefaultMappings[3],
+		{Plugin: "images", Kinds: []string{"Pod"}, Args: "$NAME -n $NAMESPACE"},
+	}
+	assert.Equal(t, expected, got)
+
+	_, err = ParseMappings([]string{"tree"})
+	require.Error(t, err)
+}
+
+func TestMapping_Matches(t *testing.T) {
+	mapping := Mapping{Plugin: "view-secret", Kinds: []string{"Secret"}}
+	assert.True(t, mapping.Matches("Secret"))
+	assert.True(t, mapping.Matches("secret"))
+	assert.False(t, mapping.Matches("ConfigMap"))
+
+	assert.True(t, Mapping{Kinds: []string{AnyKind}}.Matches("ConfigMap"))
+}
+
+func TestMapping_Arguments(t *testing.T) {
+	mapping := Mapping{Plugin: "tree", Args: defaultArgs}
+
+	key := store.Key{APIVersion: "apps/v1", Kind: "Deployment", Namespace: "default", Name: "nginx"}
+	assert.Equal(t,
+		[]string{"--namespace=default", "--context=dev", "--", "Deployment", "nginx"},
+		mapping.Arguments(key, "dev"))
+
+	// Arguments with blank values are left out.
+	key = store.Key{APIVersion: "v1", Kind: "Node", Name: "node-1"}
+	assert.Equal(t, []string{"--", "Node", "node-1"}, mapping.Arguments(key, ""))
+
+	// Subcommands come before flags.
+	mapping = Mapping{Plugin: "example", Args: "get $NAME --namespace=$NAMESPACE"}
+	key = store.Key{APIVersion: "v1", Kind: "Pod", Namespace: "default", Name: "--help"}
+	assert.Equal(t, []string{"get", "--namespace=default", "--", "--help"}, mapping.Arguments(key, ""))
+
+	// Mappings with their own separator are expanded in order.
+	mapping = Mapping{Plugin: "neat", Args: "get -- $KIND $NAME --namespace=$NAMESPACE"}
+	assert.Equal(t, []string{"get", "--", "Pod", "--help", "--namespace=default"}, mapping.Arguments(key, ""))
+}
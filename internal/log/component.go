@@ -0,0 +1,114 @@
+/*
+ * Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package log
+
+import (
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap/zapcore"
+)
+
+// componentKey is the field loggers use to identify the component logging a message.
+const componentKey = "component"
+
+// ComponentLevels maps a component name to the minimum level logged for it. A
+// component is identified by the logger name or the "component" field.
+type ComponentLevels map[string]zapcore.Level
+
+// ParseComponentLevels parses a list of component=level pairs, e.g. "api=debug".
+func ParseComponentLevels(values []string) (ComponentLevels, error) {
+	levels := ComponentLevels{}
+
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+
+		parts := strings.SplitN(value, "=", 2)
+		if len(parts) != 2 || strings.TrimSpace(parts[0]) == "" {
+			return nil, fmt.Errorf("invalid component log level %q: expected component=level", value)
+		}
+
+		level, err := ParseLevel(strings.TrimSpace(parts[1]))
+		if err != nil {
+			return nil, fmt.Errorf("component %q: %w", parts[0], err)
+		}
+
+		levels[strings.TrimSpace(parts[0])] = level
+	}
+
+	return levels, nil
+}
+
+// componentCore filters entries using a per component minimum level. Entries from
+// components without a level use the default level.
+type componentCore struct {
+	zapcore.Core
+
+	defaultLevel zapcore.Level
+	levels       ComponentLevels
+	component    string
+}
+
+var _ zapcore.Core = &componentCore{}
+
+func newComponentCore(core zapcore.Core, defaultLevel zapcore.Level, levels ComponentLevels) *componentCore {
+	return &componentCore{
+		Core:         core,
+		defaultLevel: defaultLevel,
+		levels:       levels,
+	}
+}
+
+func (c *componentCore) minLevel(loggerName string) zapcore.Level {
+	if level, ok := c.levels[c.component]; ok && c.component != "" {
+		return level
+	}
+	if level, ok := c.levels[loggerName]; ok && loggerName != "" {
+		return level
+	}
+	return c.defaultLevel
+}
+
+// Enabled returns true if any component could log at level.
+func (c *componentCore) Enabled(level zapcore.Level) bool {
+	if level >= c.minLevel("") {
+		return true
+	}
+	for _, componentLevel := range c.levels {
+		if level >= componentLevel {
+			return true
+		}
+	}
+	return false
+}
+
+// With adds fields to the core while tracking the component they identify.
+func (c *componentCore) With(fields []zapcore.Field) zapcore.Core {
+	component := c.component
+	for _, field := range fields {
+		if field.Key == componentKey && field.Type == zapcore.StringType {
+			component = field.String
+		}
+	}
+
+	return &componentCore{
+		Core:         c.Core.With(fields),
+		defaultLevel: c.defaultLevel,
+		levels:       c.levels,
+		component:    component,
+	}
+}
+
+// Check adds the core to the checked entry if the entry's component allows its level.
+func (c *componentCore) Check(entry zapcore.Entry, checked *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if entry.Level < c.minLevel(entry.LoggerName) {
+		return checked
+	}
+	return c.Core.Check(entry, checked)
+}
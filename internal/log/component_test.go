@@ -0,0 +1,80 @@
+/*
+ * Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package log
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestParseComponentLevels(t *testing.T) {
+	got, err := ParseComponentLevels([]string{"api=debug", " objectstore = warn ", ""})
+	require.NoError(t, err)
+	assert.Equal(t, ComponentLevels{
+		"api":         zapcore.DebugLevel,
+		"objectstore": zapcore.WarnLevel,
+	}, got)
+
+	_, err = ParseComponentLevels([]string{"api"})
+	require.Error(t, err)
+
+	_, err = ParseComponentLevels([]string{"api=loud"})
+	require.Error(t, err)
+}
+
+func Test_componentCore(t *testing.T) {
+	var buf bytes.Buffer
+	base := zapcore.NewCore(
+		zapcore.NewConsoleEncoder(zapcore.EncoderConfig{MessageKey: "msg"}),
+		zapcore.AddSync(&buf),
+		zapcore.DebugLevel)
+
+	core := newComponentCore(base, zapcore.InfoLevel, ComponentLevels{
+		"api":         zapcore.DebugLevel,
+		"objectstore": zapcore.ErrorLevel,
+	})
+	logger := zap.New(core).Sugar()
+
+	logger.Debug("default debug")
+	logger.Info("default info")
+	logger.With("component", "api").Debug("api debug")
+	logger.With("component", "objectstore").Warn("objectstore warn")
+	logger.With("component", "objectstore").Error("objectstore error")
+	logger.Named("api").Debug("named api debug")
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	var messages []string
+	for _, line := range lines {
+		messages = append(messages, strings.SplitN(line, "\t", 2)[0])
+	}
+
+	assert.Equal(t, []string{
+		"default info",
+		"api debug",
+		"objectstore error",
+		"named api debug",
+	}, messages)
+}
+
+func TestParseLevel(t *testing.T) {
+	level, err := ParseLevel("WARN")
+	require.NoError(t, err)
+	assert.Equal(t, zapcore.WarnLevel, level)
+
+	_, err = ParseLevel("verbose")
+	require.Error(t, err)
+}
+
+func Test_newZapLogger_unsupportedFormat(t *testing.T) {
+	_, err := newZapLogger(0, WithFormat("xml"))
+	require.Error(t, err)
+}
@@ -9,6 +9,8 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"os"
+	"strings"
 
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
@@ -105,7 +107,52 @@ func From(ctx context.Context) log.Logger {
 }
 
 // InitOption is a functional option for configuring a logger.
-type InitOption func(config zap.Config) zap.Config
+type InitOption func(o *initOptions)
+
+type initOptions struct {
+	config          zap.Config
+	format          string
+	componentLevels ComponentLevels
+}
+
+const (
+	// FormatConsole writes human readable log lines.
+	FormatConsole = "console"
+	// FormatJSON writes a JSON object per log line.
+	FormatJSON = "json"
+)
+
+// WithLevel sets the minimum level for log messages. It overrides the verbosity
+// level passed to Init.
+func WithLevel(level zapcore.Level) InitOption {
+	return func(o *initOptions) {
+		o.config.Level = zap.NewAtomicLevelAt(level)
+	}
+}
+
+// WithFormat sets the format of log messages written to stderr. Messages
+// streamed to the dashboard are always written in the console format.
+func WithFormat(format string) InitOption {
+	return func(o *initOptions) {
+		o.format = format
+	}
+}
+
+// WithComponentLevels sets minimum log levels for individual components.
+func WithComponentLevels(levels ComponentLevels) InitOption {
+	return func(o *initOptions) {
+		o.componentLevels = levels
+	}
+}
+
+// ParseLevel parses a log level name such as "debug" or "warn".
+func ParseLevel(name string) (zapcore.Level, error) {
+	var level zapcore.Level
+	if err := level.UnmarshalText([]byte(strings.ToLower(name))); err != nil {
+		return level, fmt.Errorf("invalid log level %q", name)
+	}
+	return level, nil
+}
 
 // Init initializes a logger with options.
 func Init(logLevel int, options ...InitOption) (log.LoggerCloser, error) {
@@ -141,18 +188,66 @@ func newZapLogger(verboseLevel int, options ...InitOption) (*zap.Logger, error)
 		level = zapcore.DebugLevel
 	}
 
-	cfg := zap.Config{
-		Level:            zap.NewAtomicLevelAt(level),
-		Development:      true,
-		Encoding:         "console",
-		EncoderConfig:    zap.NewDevelopmentEncoderConfig(),
-		OutputPaths:      []string{"stderr", "octant-stream://output"},
-		ErrorOutputPaths: []string{"stderr", "octant-stream://output"},
+	opts := initOptions{
+		config: zap.Config{
+			Level:            zap.NewAtomicLevelAt(level),
+			Development:      true,
+			Encoding:         "console",
+			EncoderConfig:    zap.NewDevelopmentEncoderConfig(),
+			OutputPaths:      []string{"stderr", "octant-stream://output"},
+			ErrorOutputPaths: []string{"stderr", "octant-stream://output"},
+		},
+		format: FormatConsole,
 	}
 
 	for _, option := range options {
-		cfg = option(cfg)
+		option(&opts)
+	}
+
+	cfg := opts.config
+	minLevel := cfg.Level.Level()
+
+	var zapOptions []zap.Option
+
+	switch opts.format {
+	case FormatConsole, "":
+	case FormatJSON:
+		// the dashboard parses console output, so only stderr switches to JSON.
+		cfg.OutputPaths = []string{"octant-stream://output"}
+		jsonCore := zapcore.NewCore(
+			zapcore.NewJSONEncoder(jsonEncoderConfig()),
+			zapcore.Lock(os.Stderr),
+			cfg.Level)
+		zapOptions = append(zapOptions, zap.WrapCore(func(core zapcore.Core) zapcore.Core {
+			return zapcore.NewTee(core, jsonCore)
+		}))
+	default:
+		return nil, fmt.Errorf("unsupported log format %q", opts.format)
 	}
 
-	return cfg.Build()
+	if len(opts.componentLevels) > 0 {
+		// cores accept everything and the component core decides what is written.
+		cfg.Level.SetLevel(zapcore.DebugLevel)
+		zapOptions = append(zapOptions, zap.WrapCore(func(core zapcore.Core) zapcore.Core {
+			return newComponentCore(core, minLevel, opts.componentLevels)
+		}))
+	}
+
+	return cfg.Build(zapOptions...)
+}
+
+func jsonEncoderConfig() zapcore.EncoderConfig {
+	return zapcore.EncoderConfig{
+		TimeKey:        "ts",
+		LevelKey:       "level",
+		NameKey:        "logger",
+		CallerKey:      "caller",
+		MessageKey:     "msg",
+		StacktraceKey:  "stacktrace",
+		LineEnding:     zapcore.DefaultLineEnding,
+		EncodeLevel:    zapcore.LowercaseLevelEncoder,
+		EncodeTime:     zapcore.ISO8601TimeEncoder,
+		EncodeDuration: zapcore.StringDurationEncoder,
+		EncodeCaller:   zapcore.ShortCallerEncoder,
+	}
 }
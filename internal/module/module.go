@@ -22,6 +22,11 @@ import (
 // ContentOptions are additional options for content generation
 type ContentOptions struct {
 	LabelSet *labels.Set
+	// Tab is the accessor of the tab to generate on a detail page. Other tabs only
+	// have placeholders. Every tab is generated if it is blank.
+	Tab string
+	// Limit is the most rows a list table has. Lists are not limited if it is zero.
+	Limit int
 }
 
 // Module is an octant plugin.
@@ -14,6 +14,7 @@ import (
 
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 
 	"github.com/vmware-tanzu/octant/pkg/store"
 )
@@ -75,11 +76,12 @@ func (a *application) Path(prefix, namespace string) string {
 	return path_util.NamespacedPath(path_util.PrefixedPath(prefix), namespace, a.Name, a.Instance, a.Version)
 }
 
-func listApplications(ctx context.Context, objectStore store.Store, namespace string) ([]application, error) {
+func listApplications(ctx context.Context, objectStore store.Store, namespace string, selector *labels.Set) ([]application, error) {
 	key := store.Key{
 		Namespace:  namespace,
 		APIVersion: "v1",
 		Kind:       "Pod",
+		Selector:   selector,
 	}
 
 	pods, _, err := objectStore.List(ctx, key)
@@ -238,11 +238,15 @@ func overviewFactory(ctx context.Context, namespace string, options describer.Op
 
 	rootDescriber := describer.NamespacedOverview()
 
-	options.LabelSet = &labels.Set{
-		appLabelName:     d.name,
-		appLabelInstance: d.instance,
-		appLabelVersion:  d.version,
+	// Keep the session's label filter so it also scopes the application overview.
+	set := labels.Set{}
+	if options.LabelSet != nil {
+		set = labels.Merge(set, *options.LabelSet)
 	}
+	set[appLabelName] = d.name
+	set[appLabelInstance] = d.instance
+	set[appLabelVersion] = d.version
+	options.LabelSet = &set
 
 	overview, err := rootDescriber.Component(ctx, namespace, options)
 	if err != nil {
@@ -9,6 +9,7 @@ import (
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
+	labels "k8s.io/apimachinery/pkg/labels"
 
 	applications "github.com/vmware-tanzu/octant/internal/modules/applications"
 	component "github.com/vmware-tanzu/octant/pkg/view/component"
@@ -38,16 +39,16 @@ func (m *MockSummarizer) EXPECT() *MockSummarizerMockRecorder {
 }
 
 // Summarize mocks base method
-func (m *MockSummarizer) Summarize(arg0 context.Context, arg1 string, arg2 applications.SummarizerConfig) (*component.Table, error) {
+func (m *MockSummarizer) Summarize(arg0 context.Context, arg1 string, arg2 applications.SummarizerConfig, arg3 *labels.Set) (*component.Table, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Summarize", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "Summarize", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(*component.Table)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Summarize indicates an expected call of Summarize
-func (mr *MockSummarizerMockRecorder) Summarize(arg0, arg1, arg2 interface{}) *gomock.Call {
+func (mr *MockSummarizerMockRecorder) Summarize(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Summarize", reflect.TypeOf((*MockSummarizer)(nil).Summarize), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Summarize", reflect.TypeOf((*MockSummarizer)(nil).Summarize), arg0, arg1, arg2, arg3)
 }
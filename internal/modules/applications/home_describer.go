@@ -48,7 +48,7 @@ func NewHomeDescriber(options ...HomeDescriberOption) *HomeDescriber {
 
 // Describe prints a summary of applications.
 func (l *HomeDescriber) Describe(ctx context.Context, namespace string, options describer.Options) (component.ContentResponse, error) {
-	table, err := l.summarizer.Summarize(ctx, namespace, options)
+	table, err := l.summarizer.Summarize(ctx, namespace, options, options.LabelSet)
 	if err != nil {
 		return component.EmptyContentResponse, errors.Wrap(err, "summarize applications")
 	}
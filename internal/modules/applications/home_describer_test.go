@@ -27,7 +27,7 @@ func Test_homeDescriber_Describe(t *testing.T) {
 
 	s := fake.NewMockSummarizer(controller)
 	s.EXPECT().
-		Summarize(gomock.Any(), "default", gomock.Any()).
+		Summarize(gomock.Any(), "default", gomock.Any(), nil).
 		Return(table, nil)
 
 	dashConfig := configFake.NewMockDash(controller)
@@ -76,7 +76,11 @@ func (m *Module) Content(ctx context.Context, contentPath string, opts module.Co
 		return component.EmptyContentResponse, err
 	}
 
-	return g.Generate(ctx, contentPath, generator.Options{})
+	return g.Generate(ctx, contentPath, generator.Options{
+		LabelSet: opts.LabelSet,
+		Tab:      opts.Tab,
+		Limit:    opts.Limit,
+	})
 }
 
 // ContentPath returns the root content path for the module.
@@ -88,7 +92,7 @@ func (m *Module) ContentPath() string {
 func (m *Module) Navigation(ctx context.Context, namespace, root string) ([]navigation.Navigation, error) {
 	rootPath := path_util.NamespacedPath(m.ContentPath(), namespace)
 
-	applications, err := listApplications(ctx, m.DashConfig.ObjectStore(), namespace)
+	applications, err := listApplications(ctx, m.DashConfig.ObjectStore(), namespace, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/labels"
 
 	"github.com/vmware-tanzu/octant/pkg/store"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
@@ -33,19 +34,20 @@ type SummarizerConfig interface {
 //   * app.kubernetes.io/instance
 //   * app.kubernetes.io/version
 type Summarizer interface {
-	// Summarize generates a table summary.
-	Summarize(ctx context.Context, namespace string, config SummarizerConfig) (*component.Table, error)
+	// Summarize generates a table summary. If selector is not nil, only applications
+	// with pods matching it are included.
+	Summarize(ctx context.Context, namespace string, config SummarizerConfig, selector *labels.Set) (*component.Table, error)
 }
 
 type summarizer struct{}
 
 // Summarize converts applications in namespace to a table.
-func (s *summarizer) Summarize(ctx context.Context, namespace string, config SummarizerConfig) (*component.Table, error) {
+func (s *summarizer) Summarize(ctx context.Context, namespace string, config SummarizerConfig, selector *labels.Set) (*component.Table, error) {
 	if config == nil {
 		return nil, errors.Errorf("config is nil")
 	}
 
-	applications, err := listApplications(ctx, config.ObjectStore(), namespace)
+	applications, err := listApplications(ctx, config.ObjectStore(), namespace, selector)
 	if err != nil {
 		return nil, err
 	}
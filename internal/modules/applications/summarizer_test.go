@@ -45,7 +45,7 @@ func Test_summarizer(t *testing.T) {
 	dashConfig.EXPECT().ObjectStore().Return(objectStore)
 
 	s := summarizer{}
-	actual, err := s.Summarize(ctx, "default", dashConfig)
+	actual, err := s.Summarize(ctx, "default", dashConfig, nil)
 	require.NoError(t, err)
 
 	expected := component.NewTableWithRows("Applications", "applications", applicationListColumns, []component.TableRow{
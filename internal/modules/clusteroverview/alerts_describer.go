@@ -0,0 +1,105 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package clusteroverview
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vmware-tanzu/octant/internal/alerts"
+	"github.com/vmware-tanzu/octant/internal/describer"
+	"github.com/vmware-tanzu/octant/internal/printer"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+// AlertsDescriber describes the alerts firing in the cluster.
+type AlertsDescriber struct {
+}
+
+var _ describer.Describer = (*AlertsDescriber)(nil)
+
+// NewAlertsDescriber creates an instance of AlertsDescriber.
+func NewAlertsDescriber() *AlertsDescriber {
+	return &AlertsDescriber{}
+}
+
+// Describe describes firing alerts as content. Alerts are linked to the
+// objects they are about.
+func (d *AlertsDescriber) Describe(ctx context.Context, namespace string, options describer.Options) (component.ContentResponse, error) {
+	title := component.TitleFromString("Alerts")
+
+	provider := options.Alerts()
+	if provider == nil {
+		text := component.NewMarkdownText("No alert source is configured. Set `alertmanager-url` or `prometheus-url` in the config file to show firing alerts.")
+		return component.ContentResponse{
+			Title:      title,
+			Components: []component.Component{text},
+		}, nil
+	}
+
+	firing, err := provider.List(ctx)
+	if err != nil {
+		return component.ContentResponse{
+			Title: title,
+			Components: []component.Component{
+				component.NewError(component.TitleFromString("Unable to load alerts"), err),
+			},
+		}, nil
+	}
+
+	cols := component.NewTableCols("Alert", "Severity", "Object", "Namespace", "Summary", "Since")
+	table := component.NewTable("Firing Alerts", "There are no firing alerts!", cols)
+
+	for _, alert := range firing {
+		severity := component.NewText(alert.Severity)
+		severity.SetStatus(printer.AlertSeverityStatus(alert.Severity))
+
+		table.Add(component.TableRow{
+			"Alert":     component.NewText(alert.Name),
+			"Severity":  severity,
+			"Object":    alertObjectLink(alert, options),
+			"Namespace": component.NewText(alert.Namespace()),
+			"Summary":   component.NewText(alert.Summary),
+			"Since":     component.NewTimestamp(alert.StartsAt),
+		})
+	}
+
+	return component.ContentResponse{
+		Title:      title,
+		Components: []component.Component{table},
+	}, nil
+}
+
+func (d *AlertsDescriber) PathFilters() []describer.PathFilter {
+	filter := describer.NewPathFilter("/alerts", d)
+	return []describer.PathFilter{*filter}
+}
+
+func (d *AlertsDescriber) Reset(ctx context.Context) error {
+	return nil
+}
+
+// alertObjectLink links to the object an alert is about. Objects octant has no
+// view for are shown without a link.
+func alertObjectLink(alert alerts.Alert, options describer.Options) component.Component {
+	apiVersion, kind, name, ok := alert.Object()
+	if !ok {
+		return component.NewText("")
+	}
+
+	text := fmt.Sprintf("%s %s", kind, name)
+
+	namespace := alert.Namespace()
+	if kind == "Namespace" || kind == "Node" {
+		namespace = ""
+	}
+
+	objectLink, err := options.Link.ForGVK(namespace, apiVersion, kind, name, text)
+	if err != nil {
+		return component.NewText(text)
+	}
+	return objectLink
+}
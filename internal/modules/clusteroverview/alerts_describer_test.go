@@ -0,0 +1,152 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package clusteroverview
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/octant/internal/alerts"
+	alertsFake "github.com/vmware-tanzu/octant/internal/alerts/fake"
+	configFake "github.com/vmware-tanzu/octant/internal/config/fake"
+	"github.com/vmware-tanzu/octant/internal/describer"
+	linkFake "github.com/vmware-tanzu/octant/internal/link/fake"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func TestAlertsDescriber(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	startsAt := time.Unix(1600000000, 0)
+
+	provider := alertsFake.NewMockProvider(controller)
+	provider.EXPECT().
+		List(gomock.Any()).
+		Return([]alerts.Alert{
+			{
+				Name:     "KubePodCrashLooping",
+				Severity: "critical",
+				Summary:  "Pod is crash looping.",
+				Labels:   map[string]string{"namespace": "default", "pod": "pod"},
+				StartsAt: startsAt,
+			},
+			{
+				Name:     "KubeNodeNotReady",
+				Severity: "warning",
+				Summary:  "Node is not ready.",
+				Labels:   map[string]string{"namespace": "monitoring", "node": "node"},
+				StartsAt: startsAt,
+			},
+			{
+				Name:     "Watchdog",
+				Severity: "none",
+				Summary:  "Alerting pipeline is working.",
+				StartsAt: startsAt,
+			},
+		}, nil)
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().Alerts().Return(provider)
+
+	linker := linkFake.NewMockInterface(controller)
+	linker.EXPECT().
+		ForGVK("default", "v1", "Pod", "pod", "Pod pod").
+		Return(component.NewLink("", "Pod pod", "/pod"), nil)
+	linker.EXPECT().
+		ForGVK("", "v1", "Node", "node", "Node node").
+		Return(component.NewLink("", "Node node", "/node"), nil)
+
+	options := describer.Options{
+		Dash: dashConfig,
+		Link: linker,
+	}
+
+	d := NewAlertsDescriber()
+	got, err := d.Describe(context.Background(), "default", options)
+	require.NoError(t, err)
+
+	critical := component.NewText("critical")
+	critical.SetStatus(component.TextStatusError)
+	warning := component.NewText("warning")
+	warning.SetStatus(component.TextStatusWarning)
+	none := component.NewText("none")
+	none.SetStatus(component.TextStatusWarning)
+
+	cols := component.NewTableCols("Alert", "Severity", "Object", "Namespace", "Summary", "Since")
+	table := component.NewTable("Firing Alerts", "There are no firing alerts!", cols)
+	table.Add(
+		component.TableRow{
+			"Alert":     component.NewText("KubePodCrashLooping"),
+			"Severity":  critical,
+			"Object":    component.NewLink("", "Pod pod", "/pod"),
+			"Namespace": component.NewText("default"),
+			"Summary":   component.NewText("Pod is crash looping."),
+			"Since":     component.NewTimestamp(startsAt),
+		},
+		component.TableRow{
+			"Alert":     component.NewText("KubeNodeNotReady"),
+			"Severity":  warning,
+			"Object":    component.NewLink("", "Node node", "/node"),
+			"Namespace": component.NewText("monitoring"),
+			"Summary":   component.NewText("Node is not ready."),
+			"Since":     component.NewTimestamp(startsAt),
+		},
+		component.TableRow{
+			"Alert":     component.NewText("Watchdog"),
+			"Severity":  none,
+			"Object":    component.NewText(""),
+			"Namespace": component.NewText(""),
+			"Summary":   component.NewText("Alerting pipeline is working."),
+			"Since":     component.NewTimestamp(startsAt),
+		},
+	)
+
+	expected := component.ContentResponse{
+		Title:      component.TitleFromString("Alerts"),
+		Components: []component.Component{table},
+	}
+	assert.Equal(t, expected, got)
+}
+
+func TestAlertsDescriber_not_configured(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().Alerts().Return(nil)
+
+	d := NewAlertsDescriber()
+	got, err := d.Describe(context.Background(), "default", describer.Options{Dash: dashConfig})
+	require.NoError(t, err)
+
+	require.Len(t, got.Components, 1)
+	assert.IsType(t, &component.Text{}, got.Components[0])
+}
+
+func TestAlertsDescriber_source_error(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	provider := alertsFake.NewMockProvider(controller)
+	provider.EXPECT().List(gomock.Any()).Return(nil, errors.New("connection refused"))
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().Alerts().Return(provider)
+
+	d := NewAlertsDescriber()
+	got, err := d.Describe(context.Background(), "default", describer.Options{Dash: dashConfig})
+	require.NoError(t, err)
+
+	require.Len(t, got.Components, 1)
+	assert.IsType(t, &component.Error{}, got.Components[0])
+}
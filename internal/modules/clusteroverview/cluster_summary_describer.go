@@ -0,0 +1,270 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package clusteroverview
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/vmware-tanzu/octant/internal/cluster"
+	"github.com/vmware-tanzu/octant/internal/describer"
+	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/internal/objectstatus"
+	"github.com/vmware-tanzu/octant/internal/printer"
+	"github.com/vmware-tanzu/octant/internal/util/kubernetes"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+// recentWarningEventLimit is the most warning events shown on the cluster overview.
+const recentWarningEventLimit = 10
+
+// ClusterSummaryDescriber describes the health of the cluster. It is shown at
+// the top of the cluster overview and is built from cached objects.
+type ClusterSummaryDescriber struct {
+	mu sync.Mutex
+	// versionClient is the cluster client version was fetched with. Changing
+	// contexts changes the cluster client, so the version is fetched again.
+	versionClient cluster.ClientInterface
+	version       string
+}
+
+// NewClusterSummaryDescriber creates an instance of ClusterSummaryDescriber.
+func NewClusterSummaryDescriber() *ClusterSummaryDescriber {
+	return &ClusterSummaryDescriber{}
+}
+
+var _ describer.Describer = (*ClusterSummaryDescriber)(nil)
+
+// Describe describes the cluster as content.
+func (d *ClusterSummaryDescriber) Describe(ctx context.Context, namespace string, options describer.Options) (component.ContentResponse, error) {
+	objectStore := options.ObjectStore()
+
+	nodes, err := listNodes(ctx, objectStore)
+	if err != nil {
+		return component.EmptyContentResponse, err
+	}
+
+	namespaces, _, err := objectStore.List(ctx, store.Key{APIVersion: "v1", Kind: "Namespace"})
+	if err != nil {
+		return component.EmptyContentResponse, errors.Wrap(err, "list namespaces")
+	}
+
+	podSegments, err := podStatusSegments(ctx, objectStore)
+	if err != nil {
+		return component.EmptyContentResponse, err
+	}
+
+	readyNodes := 0
+	for i := range nodes {
+		if isNodeReady(&nodes[i]) {
+			readyNodes++
+		}
+	}
+
+	sections := component.SummarySections{}
+	sections.AddText("Kubernetes Version", d.serverVersion(ctx, options))
+	sections.AddText("Nodes", fmt.Sprintf("%d (%d ready)", len(nodes), readyNodes))
+	sections.AddText("Namespaces", fmt.Sprintf("%d", len(namespaces.Items)))
+
+	list := component.NewList(component.TitleFromString("Cluster Overview"), nil)
+	list.Add(component.NewSummary("Cluster", sections...))
+
+	var nodeSegments []component.DonutSegment
+	if readyNodes > 0 {
+		nodeSegments = append(nodeSegments, component.DonutSegment{Count: readyNodes, Status: component.NodeStatusOK})
+	}
+	if notReady := len(nodes) - readyNodes; notReady > 0 {
+		nodeSegments = append(nodeSegments, component.DonutSegment{Count: notReady, Status: component.NodeStatusError})
+	}
+
+	list.Add(summaryDonutCard("Node Readiness", "Nodes", "Node", nodeSegments))
+	list.Add(summaryDonutCard("Pod Health", "Pods", "Pod", podSegments))
+
+	events, err := recentWarningEvents(ctx, objectStore, options)
+	if err != nil {
+		return component.EmptyContentResponse, err
+	}
+	list.Add(events)
+
+	return component.ContentResponse{
+		Components: []component.Component{list},
+	}, nil
+}
+
+// PathFilters returns nil. The summary is part of the cluster overview root.
+func (d *ClusterSummaryDescriber) PathFilters() []describer.PathFilter {
+	return nil
+}
+
+// Reset forgets the cached server version.
+func (d *ClusterSummaryDescriber) Reset(ctx context.Context) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.versionClient = nil
+	d.version = ""
+
+	return nil
+}
+
+// serverVersion returns the version of the API server. It is cached for each
+// cluster client, so it is only fetched again after a context change. The
+// overview is still useful if discovery fails, so the version is unknown in
+// that case and fetched again next time.
+func (d *ClusterSummaryDescriber) serverVersion(ctx context.Context, options describer.Options) string {
+	clusterClient := options.ClusterClient()
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.versionClient != nil && d.versionClient == clusterClient {
+		return d.version
+	}
+
+	discoveryClient, err := clusterClient.DiscoveryClient()
+	if err != nil {
+		log.From(ctx).WithErr(err).Errorf("create discovery client")
+		return "Unknown"
+	}
+
+	info, err := discoveryClient.ServerVersion()
+	if err != nil {
+		log.From(ctx).WithErr(err).Errorf("get server version")
+		return "Unknown"
+	}
+
+	d.versionClient = clusterClient
+	d.version = info.GitVersion
+
+	return d.version
+}
+
+func listNodes(ctx context.Context, objectStore store.Store) ([]corev1.Node, error) {
+	list, _, err := objectStore.List(ctx, store.Key{APIVersion: "v1", Kind: "Node"})
+	if err != nil {
+		return nil, errors.Wrap(err, "list nodes")
+	}
+
+	nodes := make([]corev1.Node, len(list.Items))
+	for i := range list.Items {
+		if err := kubernetes.FromUnstructured(&list.Items[i], &nodes[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	return nodes, nil
+}
+
+func isNodeReady(node *corev1.Node) bool {
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == corev1.NodeReady {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// podStatusSegments counts pods in all namespaces by their object status.
+func podStatusSegments(ctx context.Context, objectStore store.Store) ([]component.DonutSegment, error) {
+	list, _, err := objectStore.List(ctx, store.Key{APIVersion: "v1", Kind: "Pod"})
+	if err != nil {
+		return nil, errors.Wrap(err, "list pods")
+	}
+
+	counts := make(map[component.NodeStatus]int)
+	for i := range list.Items {
+		status, err := objectstatus.Status(ctx, &list.Items[i], objectStore)
+		if err != nil {
+			return nil, errors.Wrapf(err, "get status for pod %s", list.Items[i].GetName())
+		}
+		counts[status.Status()]++
+	}
+
+	var segments []component.DonutSegment
+	for status, count := range counts {
+		segments = append(segments, component.DonutSegment{Count: count, Status: status})
+	}
+
+	sort.Slice(segments, func(i, j int) bool {
+		return segments[i].Status < segments[j].Status
+	})
+
+	return segments, nil
+}
+
+func summaryDonutCard(title, plural, singular string, segments []component.DonutSegment) *component.Card {
+	chart := component.NewDonutChart()
+	chart.SetSegments(segments)
+	chart.SetLabels(plural, singular)
+	chart.SetSize(component.DonutChartSizeMedium)
+
+	card := component.NewCard(component.TitleFromString(title))
+	card.SetBody(chart)
+	return card
+}
+
+// recentWarningEvents lists the latest warning events in all namespaces.
+func recentWarningEvents(ctx context.Context, objectStore store.Store, options describer.Options) (*component.Table, error) {
+	list, _, err := objectStore.List(ctx, store.Key{APIVersion: "v1", Kind: "Event"})
+	if err != nil {
+		return nil, errors.Wrap(err, "list events")
+	}
+
+	var events []corev1.Event
+	for i := range list.Items {
+		event := corev1.Event{}
+		if err := kubernetes.FromUnstructured(&list.Items[i], &event); err != nil {
+			return nil, err
+		}
+
+		if event.Type != corev1.EventTypeWarning {
+			continue
+		}
+		events = append(events, event)
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		return printer.EventLastSeen(&events[i]).After(printer.EventLastSeen(&events[j]))
+	})
+
+	if len(events) > recentWarningEventLimit {
+		events = events[:recentWarningEventLimit]
+	}
+
+	cols := component.NewTableCols("Object", "Namespace", "Reason", "Message", "Last Seen")
+	table := component.NewTable("Recent Warning Events", "There are no warning events!", cols)
+
+	for i := range events {
+		event := &events[i]
+		involved := event.InvolvedObject
+
+		// Objects octant has no view for are shown without a link.
+		var objectComponent component.Component
+		objectLink, err := options.Link.ForGVK(involved.Namespace, involved.APIVersion, involved.Kind, involved.Name,
+			fmt.Sprintf("%s %s", involved.Kind, involved.Name))
+		if err != nil {
+			objectComponent = component.NewText(fmt.Sprintf("%s %s", involved.Kind, involved.Name))
+		} else {
+			objectComponent = objectLink
+		}
+
+		table.Add(component.TableRow{
+			"Object":    objectComponent,
+			"Namespace": component.NewText(event.Namespace),
+			"Reason":    component.NewText(event.Reason),
+			"Message":   component.NewText(event.Message),
+			"Last Seen": component.NewTimestamp(printer.EventLastSeen(event)),
+		})
+	}
+
+	return table, nil
+}
@@ -0,0 +1,182 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package clusteroverview
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/version"
+
+	clusterFake "github.com/vmware-tanzu/octant/internal/cluster/fake"
+	configFake "github.com/vmware-tanzu/octant/internal/config/fake"
+	"github.com/vmware-tanzu/octant/internal/describer"
+	linkFake "github.com/vmware-tanzu/octant/internal/link/fake"
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	storeFake "github.com/vmware-tanzu/octant/pkg/store/fake"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func TestClusterSummaryDescriber(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	readyNode := testutil.CreateNode("ready")
+	readyNode.Status.Conditions = []corev1.NodeCondition{
+		{Type: corev1.NodeReady, Status: corev1.ConditionTrue},
+	}
+	notReadyNode := testutil.CreateNode("not-ready")
+	notReadyNode.Status.Conditions = []corev1.NodeCondition{
+		{Type: corev1.NodeReady, Status: corev1.ConditionUnknown},
+	}
+
+	running := testutil.CreatePod("running")
+	running.Status.Phase = corev1.PodRunning
+	pending := testutil.CreatePod("pending")
+	pending.Status.Phase = corev1.PodPending
+
+	now := time.Unix(1600000000, 0)
+
+	older := testutil.CreateEvent("older")
+	older.Type = corev1.EventTypeWarning
+	older.Reason = "BackOff"
+	older.Message = "Back-off restarting failed container"
+	older.LastTimestamp = metav1.NewTime(now.Add(-time.Hour))
+	older.InvolvedObject = corev1.ObjectReference{APIVersion: "v1", Kind: "Pod", Namespace: "namespace", Name: "pending"}
+
+	newer := testutil.CreateEvent("newer")
+	newer.Type = corev1.EventTypeWarning
+	newer.Reason = "NodeNotReady"
+	newer.Message = "Node is not ready"
+	newer.LastTimestamp = metav1.NewTime(now)
+	newer.InvolvedObject = corev1.ObjectReference{APIVersion: "v1", Kind: "Node", Name: "not-ready"}
+
+	normal := testutil.CreateEvent("normal")
+	normal.Type = corev1.EventTypeNormal
+
+	objectStore := storeFake.NewMockStore(controller)
+	objectStore.EXPECT().
+		List(gomock.Any(), store.Key{APIVersion: "v1", Kind: "Node"}).
+		Return(testutil.ToUnstructuredList(t, readyNode, notReadyNode), false, nil)
+	objectStore.EXPECT().
+		List(gomock.Any(), store.Key{APIVersion: "v1", Kind: "Namespace"}).
+		Return(testutil.ToUnstructuredList(t, testutil.CreateNamespace("default")), false, nil)
+	objectStore.EXPECT().
+		List(gomock.Any(), store.Key{APIVersion: "v1", Kind: "Pod"}).
+		Return(testutil.ToUnstructuredList(t, running, pending), false, nil)
+	objectStore.EXPECT().
+		List(gomock.Any(), store.Key{APIVersion: "v1", Kind: "Event"}).
+		Return(testutil.ToUnstructuredList(t, older, normal, newer), false, nil)
+
+	discoveryClient := clusterFake.NewMockDiscoveryInterface(controller)
+	discoveryClient.EXPECT().ServerVersion().Return(&version.Info{GitVersion: "v1.19.3"}, nil)
+
+	clusterClient := clusterFake.NewMockClientInterface(controller)
+	clusterClient.EXPECT().DiscoveryClient().Return(discoveryClient, nil)
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().ObjectStore().Return(objectStore).AnyTimes()
+	dashConfig.EXPECT().ClusterClient().Return(clusterClient)
+
+	linker := linkFake.NewMockInterface(controller)
+	linker.EXPECT().
+		ForGVK("", "v1", "Node", "not-ready", "Node not-ready").
+		Return(component.NewLink("", "Node not-ready", "/node"), nil)
+	linker.EXPECT().
+		ForGVK("namespace", "v1", "Pod", "pending", "Pod pending").
+		Return(component.NewLink("", "Pod pending", "/pod"), nil)
+
+	options := describer.Options{
+		Dash: dashConfig,
+		Link: linker,
+	}
+
+	d := NewClusterSummaryDescriber()
+	got, err := d.Describe(context.Background(), "default", options)
+	require.NoError(t, err)
+
+	sections := component.SummarySections{}
+	sections.AddText("Kubernetes Version", "v1.19.3")
+	sections.AddText("Nodes", "2 (1 ready)")
+	sections.AddText("Namespaces", "1")
+
+	cols := component.NewTableCols("Object", "Namespace", "Reason", "Message", "Last Seen")
+	events := component.NewTable("Recent Warning Events", "There are no warning events!", cols)
+	events.Add(component.TableRow{
+		"Object":    component.NewLink("", "Node not-ready", "/node"),
+		"Namespace": component.NewText("namespace"),
+		"Reason":    component.NewText("NodeNotReady"),
+		"Message":   component.NewText("Node is not ready"),
+		"Last Seen": component.NewTimestamp(now),
+	})
+	events.Add(component.TableRow{
+		"Object":    component.NewLink("", "Pod pending", "/pod"),
+		"Namespace": component.NewText("namespace"),
+		"Reason":    component.NewText("BackOff"),
+		"Message":   component.NewText("Back-off restarting failed container"),
+		"Last Seen": component.NewTimestamp(now.Add(-time.Hour)),
+	})
+
+	list := component.NewList(component.TitleFromString("Cluster Overview"), nil)
+	list.Add(component.NewSummary("Cluster", sections...))
+	list.Add(summaryDonutCard("Node Readiness", "Nodes", "Node", []component.DonutSegment{
+		{Count: 1, Status: component.NodeStatusOK},
+		{Count: 1, Status: component.NodeStatusError},
+	}))
+	list.Add(summaryDonutCard("Pod Health", "Pods", "Pod", []component.DonutSegment{
+		{Count: 1, Status: component.NodeStatusOK},
+		{Count: 1, Status: component.NodeStatusWarning},
+	}))
+	list.Add(events)
+
+	expected := component.ContentResponse{
+		Components: []component.Component{list},
+	}
+
+	assert.Equal(t, expected, got)
+}
+
+func TestClusterSummaryDescriber_serverVersion(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	discoveryClient := clusterFake.NewMockDiscoveryInterface(controller)
+	gomock.InOrder(
+		discoveryClient.EXPECT().ServerVersion().Return(nil, errors.New("unavailable")),
+		discoveryClient.EXPECT().ServerVersion().Return(&version.Info{GitVersion: "v1.19.3"}, nil),
+		discoveryClient.EXPECT().ServerVersion().Return(&version.Info{GitVersion: "v1.20.0"}, nil),
+	)
+
+	staging := clusterFake.NewMockClientInterface(controller)
+	staging.EXPECT().DiscoveryClient().Return(discoveryClient, nil).Times(2)
+	production := clusterFake.NewMockClientInterface(controller)
+	production.EXPECT().DiscoveryClient().Return(discoveryClient, nil)
+
+	optionsFor := func(clusterClient *clusterFake.MockClientInterface) describer.Options {
+		dashConfig := configFake.NewMockDash(controller)
+		dashConfig.EXPECT().ClusterClient().Return(clusterClient)
+		return describer.Options{Dash: dashConfig}
+	}
+
+	d := NewClusterSummaryDescriber()
+	ctx := context.Background()
+
+	// Failures aren't cached.
+	assert.Equal(t, "Unknown", d.serverVersion(ctx, optionsFor(staging)))
+	assert.Equal(t, "v1.19.3", d.serverVersion(ctx, optionsFor(staging)))
+	assert.Equal(t, "v1.19.3", d.serverVersion(ctx, optionsFor(staging)))
+
+	// Changing contexts changes the cluster client.
+	assert.Equal(t, "v1.20.0", d.serverVersion(ctx, optionsFor(production)))
+}
@@ -162,6 +162,8 @@ func (co *ClusterOverview) Content(ctx context.Context, contentPath string, opts
 		Fields:   pf.Fields(contentPath),
 		Printer:  p,
 		LabelSet: opts.LabelSet,
+		Tab:      opts.Tab,
+		Limit:    opts.Limit,
 		Dash:     co.DashConfig,
 		Link:     linkGenerator,
 
@@ -191,7 +193,9 @@ func (co *ClusterOverview) Navigation(ctx context.Context, _ string, root string
 			"Webhooks":                    "webhooks",
 			"Nodes":                       "nodes",
 			"Storage":                     "storage",
+			"Scheduling":                  "scheduling",
 			"Port Forwards":               "port-forward",
+			"Alerts":                      "alerts",
 		},
 		EntriesFuncs: map[string]octant.EntriesFunc{
 			"Cluster Overview":            nil,
@@ -202,7 +206,9 @@ func (co *ClusterOverview) Navigation(ctx context.Context, _ string, root string
 			"Webhooks":                    webhookEntries,
 			"Nodes":                       nil,
 			"Storage":                     storageEntries,
+			"Scheduling":                  schedulingEntries,
 			"Port Forwards":               nil,
+			"Alerts":                      nil,
 		},
 		IconMap: map[string]string{
 			"Cluster Overview":            icon.Cluster,
@@ -213,7 +219,9 @@ func (co *ClusterOverview) Navigation(ctx context.Context, _ string, root string
 			"Webhooks":                    icon.Webhooks,
 			"Nodes":                       icon.Nodes,
 			"Storage":                     icon.ConfigAndStorage,
+			"Scheduling":                  icon.Scheduling,
 			"Port Forwards":               icon.PortForwards,
+			"Alerts":                      icon.Alerts,
 		},
 		Order: []string{
 			"Cluster Overview",
@@ -224,7 +232,9 @@ func (co *ClusterOverview) Navigation(ctx context.Context, _ string, root string
 			"Webhooks",
 			"Nodes",
 			"Storage",
+			"Scheduling",
 			"Port Forwards",
+			"Alerts",
 		},
 	}
 
@@ -293,6 +303,24 @@ func storageEntries(ctx context.Context, prefix, namespace string, objectStore s
 
 	neh.Add("Persistent Volumes", "persistent-volumes",
 		loading.IsObjectLoading(ctx, namespace, store.KeyFromGroupVersionKind(gvk.PersistentVolume), objectStore))
+	neh.Add("CSI Drivers", "csi-drivers",
+		loading.IsObjectLoading(ctx, namespace, store.KeyFromGroupVersionKind(gvk.CSIDriver), objectStore))
+	neh.Add("CSI Nodes", "csi-nodes",
+		loading.IsObjectLoading(ctx, namespace, store.KeyFromGroupVersionKind(gvk.CSINode), objectStore))
+
+	children, err := neh.Generate(prefix, namespace, "")
+	if err != nil {
+		return nil, false, err
+	}
+
+	return children, false, nil
+}
+
+func schedulingEntries(ctx context.Context, prefix, namespace string, objectStore store.Store, _ bool) ([]navigation.Navigation, bool, error) {
+	neh := navigation.EntriesHelper{}
+
+	neh.Add("Priority Classes", "priority-classes",
+		loading.IsObjectLoading(ctx, namespace, store.KeyFromGroupVersionKind(gvk.PriorityClass), objectStore))
 
 	children, err := neh.Generate(prefix, namespace, "")
 	if err != nil {
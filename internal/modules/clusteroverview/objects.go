@@ -9,6 +9,8 @@ import (
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	storagev1 "k8s.io/api/storage/v1"
 	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	apiregistrationv1 "k8s.io/kube-aggregator/pkg/apis/apiregistration/v1"
 
@@ -108,10 +110,48 @@ var (
 		IconName:       icon.ClusterOverviewPersistentVolume,
 	})
 
+	storageCSIDriverDescriber = describer.NewResource(describer.ResourceOptions{
+		Path:           "/storage/csi-drivers",
+		ObjectStoreKey: store.Key{APIVersion: "storage.k8s.io/v1", Kind: "CSIDriver"},
+		ListType:       &storagev1.CSIDriverList{},
+		ObjectType:     &storagev1.CSIDriver{},
+		Titles:         describer.ResourceTitle{List: "CSI Drivers", Object: "CSI Drivers"},
+		ClusterWide:    true,
+		IconName:       icon.ClusterOverviewCSIDriver,
+	})
+
+	storageCSINodeDescriber = describer.NewResource(describer.ResourceOptions{
+		Path:           "/storage/csi-nodes",
+		ObjectStoreKey: store.Key{APIVersion: "storage.k8s.io/v1", Kind: "CSINode"},
+		ListType:       &storagev1.CSINodeList{},
+		ObjectType:     &storagev1.CSINode{},
+		Titles:         describer.ResourceTitle{List: "CSI Nodes", Object: "CSI Nodes"},
+		ClusterWide:    true,
+		IconName:       icon.ClusterOverviewCSINode,
+	})
+
 	storageDescriber = describer.NewSection(
 		"/storage",
 		"Storage",
 		storagePersistentVolumeDescriber,
+		storageCSIDriverDescriber,
+		storageCSINodeDescriber,
+	)
+
+	schedulingPriorityClasses = describer.NewResource(describer.ResourceOptions{
+		Path:           "/scheduling/priority-classes",
+		ObjectStoreKey: store.Key{APIVersion: "scheduling.k8s.io/v1", Kind: "PriorityClass"},
+		ListType:       &schedulingv1.PriorityClassList{},
+		ObjectType:     &schedulingv1.PriorityClass{},
+		Titles:         describer.ResourceTitle{List: "Priority Classes", Object: "Priority Classes"},
+		ClusterWide:    true,
+		IconName:       icon.ClusterOverviewPriorityClass,
+	})
+
+	schedulingDescriber = describer.NewSection(
+		"/scheduling",
+		"Scheduling",
+		schedulingPriorityClasses,
 	)
 
 	namespacesDescriber = describer.NewResource(describer.ResourceOptions{
@@ -146,6 +186,7 @@ var (
 	rootDescriber = describer.NewSection(
 		"/",
 		"Cluster Overview",
+		NewClusterSummaryDescriber(),
 		namespacesDescriber,
 		customResourcesDescriber,
 		crdsDescriber,
@@ -153,7 +194,9 @@ var (
 		webhooksDescriber,
 		nodesDescriber,
 		storageDescriber,
+		schedulingDescriber,
 		portForwardDescriber,
+		NewAlertsDescriber(),
 		apiServerDescriber,
 	)
 )
@@ -22,11 +22,14 @@ var (
 		gvk.ClusterRole,
 		gvk.Node,
 		gvk.PersistentVolume,
+		gvk.CSIDriver,
+		gvk.CSINode,
 		gvk.Namespace,
 		gvk.CustomResourceDefinition,
 		gvk.APIService,
 		gvk.MutatingWebhookConfiguration,
 		gvk.ValidatingWebhookConfiguration,
+		gvk.PriorityClass,
 	}
 )
 
@@ -48,6 +51,10 @@ func gvkPath(namespace, apiVersion, kind, name string) (string, error) {
 		p = "/nodes"
 	case apiVersion == "v1" && kind == "PersistentVolume":
 		p = "/storage/persistent-volumes"
+	case apiVersion == "storage.k8s.io/v1" && kind == "CSIDriver":
+		p = "/storage/csi-drivers"
+	case apiVersion == "storage.k8s.io/v1" && kind == "CSINode":
+		p = "/storage/csi-nodes"
 	case apiVersion == "v1" && kind == "Namespace":
 		p = "/namespaces"
 	case apiVersion == gvk.CustomResourceDefinition.GroupVersion().String() &&
@@ -59,6 +66,8 @@ func gvkPath(namespace, apiVersion, kind, name string) (string, error) {
 		p = "/webhooks/mutating-webhooks"
 	case apiVersion == "admissionregistration.k8s.io/v1" && kind == "ValidatingWebhookConfiguration":
 		p = "/webhooks/validating-webhooks"
+	case apiVersion == "scheduling.k8s.io/v1" && kind == "PriorityClass":
+		p = "/scheduling/priority-classes"
 	default:
 		return "", fmt.Errorf("unknown object %s %s", apiVersion, kind)
 	}
@@ -77,6 +86,10 @@ func gvkReversePath(contentPath, _ string) (schema.GroupVersionKind, error) {
 		return gvk.Node, nil
 	case contentPath == "cluster-overview/storage/persistent-volumes":
 		return gvk.PersistentVolume, nil
+	case contentPath == "cluster-overview/storage/csi-drivers":
+		return gvk.CSIDriver, nil
+	case contentPath == "cluster-overview/storage/csi-nodes":
+		return gvk.CSINode, nil
 	case contentPath == "cluster-overview/namespaces":
 		return gvk.Namespace, nil
 	case contentPath == "cluster-overview/custom-resource-definitions":
@@ -87,6 +100,8 @@ func gvkReversePath(contentPath, _ string) (schema.GroupVersionKind, error) {
 		return gvk.MutatingWebhookConfiguration, nil
 	case contentPath == "cluster-overview/webhooks/validating-webhooks":
 		return gvk.ValidatingWebhookConfiguration, nil
+	case contentPath == "cluster-overview/scheduling/priority-classes":
+		return gvk.PriorityClass, nil
 	default:
 		return schema.GroupVersionKind{}, errors.Errorf("unknown gvk %s", contentPath)
 	}
@@ -44,6 +44,20 @@ func Test_gvk_path(t *testing.T) {
 			objectName: "cluster-role-binding",
 			expected:   path.Join("/cluster-overview", "rbac", "cluster-role-bindings", "cluster-role-binding"),
 		},
+		{
+			name:       "CSIDriver",
+			apiVersion: "storage.k8s.io/v1",
+			kind:       "CSIDriver",
+			objectName: "ebs.csi.aws.com",
+			expected:   path.Join("/cluster-overview", "storage", "csi-drivers", "ebs.csi.aws.com"),
+		},
+		{
+			name:       "PriorityClass",
+			apiVersion: "scheduling.k8s.io/v1",
+			kind:       "PriorityClass",
+			objectName: "high-priority",
+			expected:   path.Join("/cluster-overview", "scheduling", "priority-classes", "high-priority"),
+		},
 		{
 			name:       "unknown",
 			apiVersion: "unknown",
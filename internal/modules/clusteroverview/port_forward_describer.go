@@ -7,11 +7,15 @@ package clusteroverview
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 
 	"github.com/vmware-tanzu/octant/internal/describer"
+	"github.com/vmware-tanzu/octant/internal/octant"
 	"github.com/vmware-tanzu/octant/internal/portforward"
+	"github.com/vmware-tanzu/octant/pkg/action"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
 
@@ -32,7 +36,7 @@ func (d *PortForwardListDescriber) Describe(ctx context.Context, namespace strin
 	title := component.Title(component.NewText("Port Forwards"))
 	list := component.NewList(title, nil)
 
-	tblCols := component.NewTableCols("Name", "Namespace", "Ports", "Age")
+	tblCols := component.NewTableCols("Name", "Kind", "Namespace", "Pod", "Ports", "Status", "Age")
 	tbl := component.NewTable("Port Forwards", "There are no port forwards!", tblCols)
 	list.Add(tbl)
 
@@ -44,12 +48,23 @@ func (d *PortForwardListDescriber) Describe(ctx context.Context, namespace strin
 			return component.EmptyContentResponse, err
 		}
 
+		p := &pf.Pod
+		podAPIVersion, podKind := p.GVK.ToAPIVersionAndKind()
+		podLink, err := options.Link.ForGVK(p.Namespace, podAPIVersion, podKind, p.Name, p.Name)
+		if err != nil {
+			return component.EmptyContentResponse, err
+		}
+
 		pfRow := component.TableRow{
 			"Name":      nameLink,
+			"Kind":      component.NewText(kind),
 			"Namespace": component.NewText(t.Namespace),
+			"Pod":       podLink,
 			"Ports":     component.NewPorts(describePortForwardPorts(pf)),
+			"Status":    describePortForwardStatus(pf),
 			"Age":       component.NewTimestamp(pf.CreatedAt),
 		}
+		pfRow.AddAction(stopPortForwardAction(pf))
 		tbl.Add(pfRow)
 	}
 
@@ -82,3 +97,45 @@ func describePortForwardPorts(pf portforward.State) []component.Port {
 	}
 	return list
 }
+
+// describePortForwardStatus describes whether a port forward is accepting traffic.
+// A port forward is accepting traffic once its local ports are listening.
+func describePortForwardStatus(pf portforward.State) *component.Text {
+	if pf.Status == portforward.StatusReconnecting {
+		status := component.NewText(fmt.Sprintf("Reconnecting: %s", pf.Message))
+		status.SetStatus(component.TextStatusWarning)
+		return status
+	}
+
+	if len(pf.Ports) == 0 {
+		status := component.NewText("Starting")
+		status.SetStatus(component.TextStatusWarning)
+		return status
+	}
+
+	text := "Forwarding"
+	if pf.Reconnects == 1 {
+		text = fmt.Sprintf("Forwarding (reconnected once, last %s)", pf.ReconnectedAt.Format(time.RFC3339))
+	} else if pf.Reconnects > 1 {
+		text = fmt.Sprintf("Forwarding (reconnected %d times, last %s)", pf.Reconnects, pf.ReconnectedAt.Format(time.RFC3339))
+	}
+
+	status := component.NewText(text)
+	status.SetStatus(component.TextStatusOK)
+	return status
+}
+
+func stopPortForwardAction(pf portforward.State) component.GridAction {
+	_, kind := pf.Target.GVK.ToAPIVersionAndKind()
+
+	return component.GridAction{
+		Name:       "Stop",
+		ActionPath: octant.ActionStopPortForward,
+		Payload:    action.Payload{"id": pf.ID},
+		Confirmation: &component.Confirmation{
+			Title: "Stop Port Forward",
+			Body:  fmt.Sprintf("Are you sure you want to stop forwarding to %s **%s**?", kind, pf.Target.Name),
+		},
+		Type: component.GridActionDanger,
+	}
+}
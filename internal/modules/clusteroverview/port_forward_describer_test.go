@@ -0,0 +1,130 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package clusteroverview
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	configFake "github.com/vmware-tanzu/octant/internal/config/fake"
+	"github.com/vmware-tanzu/octant/internal/describer"
+	linkFake "github.com/vmware-tanzu/octant/internal/link/fake"
+	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/internal/portforward"
+	portForwardFake "github.com/vmware-tanzu/octant/internal/portforward/fake"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func TestPortForwardListDescriber(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	createdAt := time.Unix(1600000000, 0)
+	state := portforward.State{
+		ID:        "id",
+		CreatedAt: createdAt,
+		Ports:     []portforward.ForwardedPort{{Local: 40000, Remote: 8080}},
+		Target: portforward.Target{
+			GVK:       schema.GroupVersionKind{Version: "v1", Kind: "Service"},
+			Namespace: "default",
+			Name:      "service",
+		},
+		Pod: portforward.Target{
+			GVK:       schema.GroupVersionKind{Version: "v1", Kind: "Pod"},
+			Namespace: "default",
+			Name:      "pod",
+		},
+	}
+
+	portForwarder := portForwardFake.NewMockPortForwarder(controller)
+	portForwarder.EXPECT().List(gomock.Any()).Return([]portforward.State{state})
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().PortForwarder().Return(portForwarder)
+
+	serviceLink := component.NewLink("", "service", "/service")
+	podLink := component.NewLink("", "pod", "/pod")
+
+	linker := linkFake.NewMockInterface(controller)
+	linker.EXPECT().ForGVK("default", "v1", "Service", "service", "service").Return(serviceLink, nil)
+	linker.EXPECT().ForGVK("default", "v1", "Pod", "pod", "pod").Return(podLink, nil)
+
+	options := describer.Options{
+		Dash: dashConfig,
+		Link: linker,
+	}
+
+	d := NewPortForwardListDescriber()
+	got, err := d.Describe(context.Background(), "default", options)
+	require.NoError(t, err)
+
+	require.Len(t, got.Components, 1)
+	list, ok := got.Components[0].(*component.List)
+	require.True(t, ok)
+	require.Len(t, list.Config.Items, 1)
+	table, ok := list.Config.Items[0].(*component.Table)
+	require.True(t, ok)
+
+	status := component.NewText("Forwarding")
+	status.SetStatus(component.TextStatusOK)
+
+	row := component.TableRow{
+		"Name":      serviceLink,
+		"Kind":      component.NewText("Service"),
+		"Namespace": component.NewText("default"),
+		"Pod":       podLink,
+		"Ports":     component.NewPorts(describePortForwardPorts(state)),
+		"Status":    status,
+		"Age":       component.NewTimestamp(createdAt),
+	}
+	row.AddAction(component.GridAction{
+		Name:       "Stop",
+		ActionPath: octant.ActionStopPortForward,
+		Payload:    action.Payload{"id": "id"},
+		Confirmation: &component.Confirmation{
+			Title: "Stop Port Forward",
+			Body:  "Are you sure you want to stop forwarding to Service **service**?",
+		},
+		Type: component.GridActionDanger,
+	})
+
+	assert.Equal(t, []component.TableRow{row}, table.Rows())
+}
+
+func Test_describePortForwardStatus(t *testing.T) {
+	starting := describePortForwardStatus(portforward.State{})
+	assert.Equal(t, "Starting", starting.Config.Text)
+	assert.Equal(t, component.TextStatusWarning, starting.Config.Status)
+
+	forwarding := describePortForwardStatus(portforward.State{Ports: []portforward.ForwardedPort{{Local: 1, Remote: 2}}})
+	assert.Equal(t, "Forwarding", forwarding.Config.Text)
+	assert.Equal(t, component.TextStatusOK, forwarding.Config.Status)
+
+	reconnectedAt := time.Date(2020, 9, 13, 12, 0, 0, 0, time.UTC)
+	reconnected := describePortForwardStatus(portforward.State{
+		Ports:         []portforward.ForwardedPort{{Local: 1, Remote: 2}},
+		Status:        portforward.StatusActive,
+		Reconnects:    2,
+		ReconnectedAt: reconnectedAt,
+	})
+	assert.Equal(t, "Forwarding (reconnected 2 times, last 2020-09-13T12:00:00Z)", reconnected.Config.Text)
+	assert.Equal(t, component.TextStatusOK, reconnected.Config.Status)
+
+	reconnecting := describePortForwardStatus(portforward.State{
+		Ports:   []portforward.ForwardedPort{{Local: 1, Remote: 2}},
+		Status:  portforward.StatusReconnecting,
+		Message: "no ready pods found",
+	})
+	assert.Equal(t, "Reconnecting: no ready pods found", reconnecting.Config.Text)
+	assert.Equal(t, component.TextStatusWarning, reconnecting.Config.Status)
+}
@@ -0,0 +1,100 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package configuration
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vmware-tanzu/octant/internal/audit"
+	"github.com/vmware-tanzu/octant/internal/describer"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+// auditLogLimit is the most audit entries shown.
+const auditLogLimit = 500
+
+// AuditLogDescriber describes the mutating actions performed through the dashboard.
+type AuditLogDescriber struct {
+}
+
+var _ describer.Describer = (*AuditLogDescriber)(nil)
+
+// NewAuditLogDescriber creates an instance of AuditLogDescriber.
+func NewAuditLogDescriber() *AuditLogDescriber {
+	return &AuditLogDescriber{}
+}
+
+// Describe describes the audit log, most recent entries first.
+func (d *AuditLogDescriber) Describe(ctx context.Context, namespace string, options describer.Options) (component.ContentResponse, error) {
+	entries, err := options.Dash.AuditLog().Entries(audit.Filter{Limit: auditLogLimit})
+	if err != nil {
+		return component.EmptyContentResponse, fmt.Errorf("read audit log: %w", err)
+	}
+
+	title := append([]component.TitleComponent{}, component.NewText("Audit Log"))
+	list := component.NewList(title, nil)
+	tableCols := component.NewTableCols("Time", "User", "Context", "Action", "Object", "Resource Version", "Result")
+	tbl := component.NewTable("Audit Log", "No changes have been made through the dashboard", tableCols)
+	list.Add(tbl)
+
+	for _, entry := range entries {
+		result := "Succeeded"
+		if entry.Error != "" {
+			result = entry.Error
+		}
+
+		tbl.Add(component.TableRow{
+			"Time":             component.NewTimestamp(entry.Time),
+			"User":             component.NewText(entry.User),
+			"Context":          component.NewText(entry.Context),
+			"Action":           component.NewText(entry.Action),
+			"Object":           component.NewText(auditEntryObject(entry)),
+			"Resource Version": component.NewText(auditEntryResourceVersion(entry)),
+			"Result":           component.NewText(result),
+		})
+	}
+
+	return component.ContentResponse{
+		Components: []component.Component{list},
+	}, nil
+}
+
+// PathFilters returns the path filters for the audit log.
+func (d *AuditLogDescriber) PathFilters() []describer.PathFilter {
+	filter := describer.NewPathFilter("/audit-log", d)
+	return []describer.PathFilter{*filter}
+}
+
+// Reset does nothing.
+func (d *AuditLogDescriber) Reset(ctx context.Context) error {
+	return nil
+}
+
+func auditEntryObject(entry audit.Entry) string {
+	if entry.Kind == "" {
+		return ""
+	}
+	if entry.Namespace == "" {
+		return fmt.Sprintf("%s %s", entry.Kind, entry.Name)
+	}
+	return fmt.Sprintf("%s %s/%s", entry.Kind, entry.Namespace, entry.Name)
+}
+
+func auditEntryResourceVersion(entry audit.Entry) string {
+	if entry.BeforeResourceVersion == "" && entry.AfterResourceVersion == "" {
+		return ""
+	}
+
+	before, after := entry.BeforeResourceVersion, entry.AfterResourceVersion
+	if before == "" {
+		before = "none"
+	}
+	if after == "" {
+		after = "none"
+	}
+	return fmt.Sprintf("%s → %s", before, after)
+}
@@ -82,6 +82,8 @@ func (c *Configuration) Content(ctx context.Context, contentPath string, opts mo
 	options := describer.Options{
 		Fields:   pf.Fields(contentPath),
 		LabelSet: opts.LabelSet,
+		Tab:      opts.Tab,
+		Limit:    opts.Limit,
 		Dash:     c.DashConfig,
 	}
 
@@ -105,6 +107,24 @@ func (c *Configuration) Navigation(ctx context.Context, namespace, root string)
 			Path:     path.Join(c.ContentPath(), "plugins"),
 			IconName: icon.ConfigurationPlugin,
 		},
+		{
+			Module:   "Configuration",
+			Title:    "Webhooks",
+			Path:     path.Join(c.ContentPath(), "webhooks"),
+			IconName: icon.ConfigurationWebhooks,
+		},
+		{
+			Module:   "Configuration",
+			Title:    "Audit Log",
+			Path:     path.Join(c.ContentPath(), "audit-log"),
+			IconName: icon.ConfigurationAuditLog,
+		},
+		{
+			Module:   "Configuration",
+			Title:    "Recording",
+			Path:     path.Join(c.ContentPath(), "recording"),
+			IconName: icon.ConfigurationRecording,
+		},
 	}, nil
 }
 
@@ -148,9 +168,17 @@ func (c Configuration) Generators() []octant.Generator {
 
 func (c *Configuration) ActionPaths() map[string]action.DispatcherFunc {
 	objectDeleter := NewObjectDeleter(c.DashConfig.Logger(), c.DashConfig.ObjectStore())
+	webhookRuleAdder := NewWebhookRuleAdder(c.DashConfig.Webhooks())
+	webhookRuleRemover := NewWebhookRuleRemover(c.DashConfig.Webhooks())
+	recordingReplayer := NewRecordingReplayer(c.DashConfig.Replay())
+	recordingLive := NewRecordingLive(c.DashConfig.Replay())
 
 	return map[string]action.DispatcherFunc{
-		objectDeleter.ActionName(): objectDeleter.Handle,
+		objectDeleter.ActionName():      objectDeleter.Handle,
+		webhookRuleAdder.ActionName():   webhookRuleAdder.Handle,
+		webhookRuleRemover.ActionName(): webhookRuleRemover.Handle,
+		recordingReplayer.ActionName():  recordingReplayer.Handle,
+		recordingLive.ActionName():      recordingLive.Handle,
 	}
 }
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/vmware-tanzu/octant/internal/kubectl"
 	"github.com/vmware-tanzu/octant/internal/octant"
 	"github.com/vmware-tanzu/octant/pkg/action"
 	"github.com/vmware-tanzu/octant/pkg/log"
@@ -35,7 +36,7 @@ func (d *ObjectDeleter) Handle(ctx context.Context, alerter action.Alerter, payl
 	}
 
 	alertType := action.AlertTypeInfo
-	message := fmt.Sprintf("Deleted %s %q", key.Kind, key.Name)
+	message := kubectl.Describe(fmt.Sprintf("Deleted %s %q", key.Kind, key.Name), kubectl.Delete(key))
 	if err := d.store.Delete(ctx, key); err != nil {
 		alertType = action.AlertTypeWarning
 		message = fmt.Sprintf("Unable to deleted %s %q: %s", key.Kind, key.Name, err)
@@ -48,7 +48,7 @@ func TestObjectDeleter_Handle(t *testing.T) {
 		SendAlert(gomock.Any()).
 		DoAndReturn(func(alert action.Alert) {
 			assert.Equal(t, action.AlertTypeInfo, alert.Type)
-			assert.Equal(t, `Deleted Pod "pod"`, alert.Message)
+			assert.Equal(t, `Deleted Pod "pod" (kubectl equivalent: kubectl delete pod/pod --namespace namespace)`, alert.Message)
 			assert.NotNil(t, alert.Expiration)
 		})
 
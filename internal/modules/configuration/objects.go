@@ -8,11 +8,17 @@ package configuration
 import "github.com/vmware-tanzu/octant/internal/describer"
 
 var (
-	pluginDescriber = NewPluginListDescriber()
+	pluginDescriber    = NewPluginListDescriber()
+	webhooksDescriber  = NewWebhooksDescriber()
+	auditLogDescriber  = NewAuditLogDescriber()
+	recordingDescriber = NewRecordingDescriber()
 
 	rootDescriber = describer.NewSection(
 		"/",
 		"Configuration",
 		pluginDescriber,
+		webhooksDescriber,
+		auditLogDescriber,
+		recordingDescriber,
 	)
 )
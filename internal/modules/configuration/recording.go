@@ -0,0 +1,143 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package configuration
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/internal/replay"
+	"github.com/vmware-tanzu/octant/pkg/action"
+)
+
+// recordingDisabledMessage is shown when a recording is replayed but nothing is
+// recorded.
+const recordingDisabledMessage = "Nothing is recorded. Start Octant with --record to record changes, e.g. --record v1/Pod,apps/v1/Deployment."
+
+// RecordingReplayer is an action which sets the time of the recording the
+// client which dispatched it shows.
+type RecordingReplayer struct {
+	manager replay.Manager
+	now     func() time.Time
+}
+
+var _ action.Dispatcher = (*RecordingReplayer)(nil)
+
+// NewRecordingReplayer creates an instance of RecordingReplayer.
+func NewRecordingReplayer(manager replay.Manager) *RecordingReplayer {
+	return &RecordingReplayer{
+		manager: manager,
+		now:     time.Now,
+	}
+}
+
+// ActionName returns the name of this action.
+func (r *RecordingReplayer) ActionName() string {
+	return octant.ActionReplayRecording
+}
+
+// Handle replays the recording at the time in the payload. The payload has a
+// time, a number of minutes ago, or a step from the time being replayed, such
+// as -5m. Only the client which dispatched the action replays the recording.
+func (r *RecordingReplayer) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	if r.manager == nil {
+		alerter.SendAlert(action.CreateAlert(action.AlertTypeWarning, recordingDisabledMessage, action.DefaultAlertExpiration))
+		return nil
+	}
+
+	position := replay.PositionFrom(ctx)
+	if position == nil {
+		return fmt.Errorf("replay position is missing from the context")
+	}
+
+	at, err := r.replayTime(position, payload)
+	if err != nil {
+		return err
+	}
+
+	replayed, err := r.manager.Seek(at)
+	if err != nil {
+		message := fmt.Sprintf("Unable to replay the recording: %s", err)
+		alerter.SendAlert(action.CreateAlert(action.AlertTypeWarning, message, action.DefaultAlertExpiration))
+		return nil
+	}
+
+	position.Set(replayed)
+
+	message := "Showing live objects"
+	if !replayed.IsZero() {
+		message = fmt.Sprintf("Replaying the recording at %s", replayed.Format("15:04:05"))
+	}
+
+	alerter.SendAlert(action.CreateAlert(action.AlertTypeInfo, message, action.DefaultAlertExpiration))
+	return nil
+}
+
+// replayTime returns the time the payload replays.
+func (r *RecordingReplayer) replayTime(position *replay.Position, payload action.Payload) (time.Time, error) {
+	if value, _ := payload.OptionalString("time"); value != "" {
+		at, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("parse time: %w", err)
+		}
+		return at, nil
+	}
+
+	if _, ok := payload["minutesAgo"]; ok {
+		minutes, err := payload.Float64("minutesAgo")
+		if err != nil {
+			return time.Time{}, fmt.Errorf("parse minutes ago: %w", err)
+		}
+		return r.now().Add(-time.Duration(minutes * float64(time.Minute))), nil
+	}
+
+	value, err := payload.String("step")
+	if err != nil {
+		return time.Time{}, err
+	}
+	step, err := time.ParseDuration(value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("parse step: %w", err)
+	}
+
+	at, ok := position.Time()
+	if !ok {
+		at = r.now()
+	}
+	return at.Add(step), nil
+}
+
+// RecordingLive is an action which returns the client which dispatched it to
+// live objects.
+type RecordingLive struct {
+	manager replay.Manager
+}
+
+var _ action.Dispatcher = (*RecordingLive)(nil)
+
+// NewRecordingLive creates an instance of RecordingLive.
+func NewRecordingLive(manager replay.Manager) *RecordingLive {
+	return &RecordingLive{
+		manager: manager,
+	}
+}
+
+// ActionName returns the name of this action.
+func (r *RecordingLive) ActionName() string {
+	return octant.ActionReplayLive
+}
+
+// Handle stops replaying the recording.
+func (r *RecordingLive) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	if position := replay.PositionFrom(ctx); position != nil {
+		position.Set(time.Time{})
+	}
+
+	alerter.SendAlert(action.CreateAlert(action.AlertTypeInfo, "Showing live objects", action.DefaultAlertExpiration))
+	return nil
+}
@@ -0,0 +1,179 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package configuration
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/vmware-tanzu/octant/internal/describer"
+	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/internal/replay"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+const (
+	// recordingTimelineMarks is the number of marks in the recording timeline.
+	recordingTimelineMarks = 12
+	// recordingChangesLimit is the most recorded changes shown.
+	recordingChangesLimit = 50
+)
+
+// RecordingDescriber describes the recording of informer events and the time
+// being replayed.
+type RecordingDescriber struct {
+	now func() time.Time
+}
+
+var _ describer.Describer = (*RecordingDescriber)(nil)
+
+// NewRecordingDescriber creates an instance of RecordingDescriber.
+func NewRecordingDescriber() *RecordingDescriber {
+	return &RecordingDescriber{
+		now: time.Now,
+	}
+}
+
+// Describe describes the recording with buttons which step through it, a
+// timeline to replay it from, and the changes recorded before the time shown.
+func (d *RecordingDescriber) Describe(ctx context.Context, namespace string, options describer.Options) (component.ContentResponse, error) {
+	title := append([]component.TitleComponent{}, component.NewText("Recording"))
+	list := component.NewList(title, nil)
+
+	manager := options.Dash.Replay()
+	if manager == nil {
+		list.Add(component.NewMarkdownText(recordingDisabledMessage))
+		return component.ContentResponse{
+			Components: []component.Component{list},
+		}, nil
+	}
+
+	at, replaying := replay.TimeFrom(ctx)
+
+	list.Add(recordingSummary(manager, at, replaying))
+	list.Add(recordingTimeline(manager))
+
+	changesAt := d.now()
+	if replaying {
+		changesAt = at
+	}
+	list.Add(recordingChanges(manager.Changes(changesAt, recordingChangesLimit)))
+
+	cr := component.NewContentResponse(nil)
+	cr.Components = []component.Component{list}
+	for _, step := range []string{"-20m", "-5m", "-1m", "1m", "5m"} {
+		cr.AddButton(recordingStepName(step), action.CreatePayload(octant.ActionReplayRecording, action.Payload{"step": step}))
+	}
+	cr.AddButton("Replay At", action.CreatePayload(octant.ActionReplayRecording, nil), replayAtModal())
+	if replaying {
+		cr.AddButton("Live", action.CreatePayload(octant.ActionReplayLive, nil))
+	}
+
+	return *cr, nil
+}
+
+// PathFilters returns the path filters for the recording.
+func (d *RecordingDescriber) PathFilters() []describer.PathFilter {
+	filter := describer.NewPathFilter("/recording", d)
+	return []describer.PathFilter{*filter}
+}
+
+// Reset does nothing.
+func (d *RecordingDescriber) Reset(ctx context.Context) error {
+	return nil
+}
+
+func recordingSummary(manager replay.Manager, at time.Time, replaying bool) *component.Summary {
+	var kinds []string
+	for _, gvk := range manager.Kinds() {
+		apiVersion, kind := gvk.ToAPIVersionAndKind()
+		kinds = append(kinds, fmt.Sprintf("%s %s", apiVersion, kind))
+	}
+
+	recorded := "Nothing has been recorded yet"
+	if start, end, ok := manager.Range(); ok {
+		recorded = fmt.Sprintf("%s to %s", start.Format(time.RFC3339), end.Format(time.RFC3339))
+	}
+
+	showing := "Live"
+	if replaying {
+		showing = fmt.Sprintf("Replaying %s", at.Format(time.RFC3339))
+	}
+
+	var sections component.SummarySections
+	sections.Add("Kinds", component.NewText(strings.Join(kinds, ", ")))
+	sections.Add("Recorded", component.NewText(recorded))
+	sections.Add("Showing", component.NewText(showing))
+
+	return component.NewSummary("Recording", sections...)
+}
+
+func recordingTimeline(manager replay.Manager) *component.Table {
+	tableCols := component.NewTableCols("Time", "Changes")
+	tbl := component.NewTable("Timeline", "Nothing has been recorded yet", tableCols)
+
+	for _, mark := range manager.Timeline(recordingTimelineMarks) {
+		row := component.TableRow{
+			"Time":    component.NewTimestamp(mark.Time),
+			"Changes": component.NewText(fmt.Sprintf("%d", mark.Changes)),
+		}
+		row.AddAction(component.GridAction{
+			Name:       "Replay",
+			ActionPath: octant.ActionReplayRecording,
+			Payload:    action.Payload{"time": mark.Time.Format(time.RFC3339)},
+			Type:       component.GridActionPrimary,
+		})
+		tbl.Add(row)
+	}
+
+	return tbl
+}
+
+func recordingChanges(events []replay.Event) *component.Table {
+	tableCols := component.NewTableCols("Time", "Change", "Kind", "Namespace", "Name")
+	tbl := component.NewTable("Changes", "No changes have been recorded", tableCols)
+
+	for _, event := range events {
+		tbl.Add(component.TableRow{
+			"Time":      component.NewTimestamp(event.Time),
+			"Change":    component.NewText(string(event.Type)),
+			"Kind":      component.NewText(fmt.Sprintf("%s %s", event.Object.GetAPIVersion(), event.Object.GetKind())),
+			"Namespace": component.NewText(event.Object.GetNamespace()),
+			"Name":      component.NewText(event.Object.GetName()),
+		})
+	}
+
+	return tbl
+}
+
+// recordingStepName returns the name of the button which steps the replayed
+// time.
+func recordingStepName(step string) string {
+	if strings.HasPrefix(step, "-") {
+		return fmt.Sprintf("Back %s", strings.TrimPrefix(step, "-"))
+	}
+	return fmt.Sprintf("Forward %s", step)
+}
+
+// replayAtModal configures a button to open a form which replays the recording
+// a number of minutes ago.
+func replayAtModal() component.ButtonOption {
+	form := component.Form{
+		Fields: []component.FormField{
+			component.NewFormFieldNumber("Minutes Ago", "minutesAgo", "20"),
+		},
+		Action: octant.ActionReplayRecording,
+	}
+
+	modal := component.NewModal(component.TitleFromString("Replay At"))
+	modal.SetBody(component.NewMarkdownText("Recorded kinds are shown as they were at the time. Other kinds are live."))
+	modal.AddForm(form)
+
+	return component.WithModal(modal)
+}
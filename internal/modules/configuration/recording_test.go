@@ -0,0 +1,249 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package configuration
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	configFake "github.com/vmware-tanzu/octant/internal/config/fake"
+	"github.com/vmware-tanzu/octant/internal/describer"
+	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/internal/replay"
+	replayFake "github.com/vmware-tanzu/octant/internal/replay/fake"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	actionFake "github.com/vmware-tanzu/octant/pkg/action/fake"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func TestRecordingReplayer_Handle(t *testing.T) {
+	now := time.Date(2020, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		replaying time.Time
+		payload   action.Payload
+		init      func(manager *replayFake.MockManager)
+		message   string
+		expected  time.Time
+	}{
+		{
+			name:    "time",
+			payload: action.Payload{"time": "2020-05-01T11:30:00Z"},
+			init: func(manager *replayFake.MockManager) {
+				at := now.Add(-30 * time.Minute)
+				manager.EXPECT().Seek(at).Return(at, nil)
+			},
+			message:  "Replaying the recording at 11:30:00",
+			expected: now.Add(-30 * time.Minute),
+		},
+		{
+			name:    "minutes ago",
+			payload: action.Payload{"minutesAgo": "20"},
+			init: func(manager *replayFake.MockManager) {
+				at := now.Add(-20 * time.Minute)
+				manager.EXPECT().Seek(at).Return(at, nil)
+			},
+			message:  "Replaying the recording at 11:40:00",
+			expected: now.Add(-20 * time.Minute),
+		},
+		{
+			name:    "step while live",
+			payload: action.Payload{"step": "-5m"},
+			init: func(manager *replayFake.MockManager) {
+				at := now.Add(-5 * time.Minute)
+				manager.EXPECT().Seek(at).Return(at, nil)
+			},
+			message:  "Replaying the recording at 11:55:00",
+			expected: now.Add(-5 * time.Minute),
+		},
+		{
+			name:      "step past now",
+			replaying: now.Add(-time.Minute),
+			payload:   action.Payload{"step": "5m"},
+			init: func(manager *replayFake.MockManager) {
+				manager.EXPECT().Seek(now.Add(4*time.Minute)).Return(time.Time{}, nil)
+			},
+			message: "Showing live objects",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			manager := replayFake.NewMockManager(controller)
+			test.init(manager)
+
+			alerter := actionFake.NewMockAlerter(controller)
+			alerter.EXPECT().
+				SendAlert(gomock.Any()).
+				Do(func(alert action.Alert) {
+					assert.Equal(t, action.AlertTypeInfo, alert.Type)
+					assert.Equal(t, test.message, alert.Message)
+				})
+
+			position := replay.NewPosition(test.replaying)
+			ctx := replay.WithPosition(context.Background(), position)
+
+			r := NewRecordingReplayer(manager)
+			r.now = func() time.Time { return now }
+			require.Equal(t, octant.ActionReplayRecording, r.ActionName())
+			require.NoError(t, r.Handle(ctx, alerter, test.payload))
+
+			got, _ := position.Time()
+			assert.Equal(t, test.expected, got)
+		})
+	}
+}
+
+func TestRecordingReplayer_Handle_errors(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	manager := replayFake.NewMockManager(controller)
+	manager.EXPECT().Seek(gomock.Any()).Return(time.Time{}, errors.New("nothing has been recorded"))
+
+	alerter := actionFake.NewMockAlerter(controller)
+	alerter.EXPECT().
+		SendAlert(gomock.Any()).
+		Do(func(alert action.Alert) {
+			assert.Equal(t, action.AlertTypeWarning, alert.Type)
+			assert.Equal(t, "Unable to replay the recording: nothing has been recorded", alert.Message)
+		})
+
+	position := replay.NewPosition(time.Time{})
+	ctx := replay.WithPosition(context.Background(), position)
+
+	r := NewRecordingReplayer(manager)
+	require.NoError(t, r.Handle(ctx, alerter, action.Payload{"time": "2020-05-01T11:30:00Z"}))
+	_, ok := position.Time()
+	assert.False(t, ok)
+
+	require.Error(t, r.Handle(ctx, alerter, action.Payload{"time": "noon"}))
+	require.Error(t, r.Handle(ctx, alerter, action.Payload{"step": "soon"}))
+	require.Error(t, r.Handle(ctx, alerter, action.Payload{}))
+	require.Error(t, r.Handle(context.Background(), alerter, action.Payload{"step": "-5m"}), "the context has no replay position")
+}
+
+func TestRecordingReplayer_Handle_disabled(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	alerter := actionFake.NewMockAlerter(controller)
+	alerter.EXPECT().
+		SendAlert(gomock.Any()).
+		Do(func(alert action.Alert) {
+			assert.Equal(t, action.AlertTypeWarning, alert.Type)
+			assert.Equal(t, recordingDisabledMessage, alert.Message)
+		})
+
+	r := NewRecordingReplayer(nil)
+	require.NoError(t, r.Handle(context.Background(), alerter, action.Payload{"step": "-5m"}))
+}
+
+func TestRecordingLive_Handle(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	manager := replayFake.NewMockManager(controller)
+
+	alerter := actionFake.NewMockAlerter(controller)
+	alerter.EXPECT().SendAlert(gomock.Any())
+
+	position := replay.NewPosition(time.Date(2020, 5, 1, 12, 0, 0, 0, time.UTC))
+	ctx := replay.WithPosition(context.Background(), position)
+
+	r := NewRecordingLive(manager)
+	require.Equal(t, octant.ActionReplayLive, r.ActionName())
+	require.NoError(t, r.Handle(ctx, alerter, action.Payload{}))
+
+	_, ok := position.Time()
+	assert.False(t, ok)
+}
+
+func TestRecordingDescriber(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	start := time.Date(2020, 5, 1, 11, 0, 0, 0, time.UTC)
+	at := start.Add(30 * time.Minute)
+	podGVK := schema.GroupVersionKind{Version: "v1", Kind: "Pod"}
+
+	pod := &unstructured.Unstructured{}
+	pod.SetAPIVersion("v1")
+	pod.SetKind("Pod")
+	pod.SetNamespace("default")
+	pod.SetName("web")
+
+	manager := replayFake.NewMockManager(controller)
+	manager.EXPECT().Kinds().Return([]schema.GroupVersionKind{podGVK})
+	manager.EXPECT().Range().Return(start, start.Add(time.Hour), true)
+	manager.EXPECT().Timeline(recordingTimelineMarks).Return([]replay.Mark{{Time: at, Changes: 2}})
+	manager.EXPECT().Changes(at, recordingChangesLimit).Return([]replay.Event{
+		{Time: start, Type: replay.EventAdded, Object: pod},
+	})
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().Replay().Return(manager)
+
+	ctx := replay.WithPosition(context.Background(), replay.NewPosition(at))
+
+	d := NewRecordingDescriber()
+	got, err := d.Describe(ctx, "", describer.Options{Dash: dashConfig})
+	require.NoError(t, err)
+
+	require.Len(t, got.Components, 1)
+	list, ok := got.Components[0].(*component.List)
+	require.True(t, ok)
+	require.Len(t, list.Config.Items, 3)
+
+	timeline, ok := list.Config.Items[1].(*component.Table)
+	require.True(t, ok)
+	require.Len(t, timeline.Rows(), 1)
+
+	changes, ok := list.Config.Items[2].(*component.Table)
+	require.True(t, ok)
+	assert.Equal(t, []component.TableRow{
+		{
+			"Time":      component.NewTimestamp(start),
+			"Change":    component.NewText("added"),
+			"Kind":      component.NewText("v1 Pod"),
+			"Namespace": component.NewText("default"),
+			"Name":      component.NewText("web"),
+		},
+	}, changes.Rows())
+
+	var names []string
+	for _, button := range got.ButtonGroup.Config.Buttons {
+		names = append(names, button.Name)
+	}
+	assert.Equal(t, []string{"Back 20m", "Back 5m", "Back 1m", "Forward 1m", "Forward 5m", "Replay At", "Live"}, names)
+}
+
+func TestRecordingDescriber_disabled(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().Replay().Return(nil)
+
+	d := NewRecordingDescriber()
+	got, err := d.Describe(context.Background(), "", describer.Options{Dash: dashConfig})
+	require.NoError(t, err)
+
+	require.Len(t, got.Components, 1)
+	assert.Nil(t, got.ButtonGroup)
+}
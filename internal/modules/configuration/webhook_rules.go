@@ -0,0 +1,110 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package configuration
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vmware-tanzu/octant/internal/notification"
+	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/pkg/action"
+)
+
+// WebhookRuleAdder is an action which adds a webhook rule.
+type WebhookRuleAdder struct {
+	webhooks notification.WebhookManager
+}
+
+var _ action.Dispatcher = (*WebhookRuleAdder)(nil)
+
+// NewWebhookRuleAdder creates an instance of WebhookRuleAdder.
+func NewWebhookRuleAdder(webhooks notification.WebhookManager) *WebhookRuleAdder {
+	return &WebhookRuleAdder{
+		webhooks: webhooks,
+	}
+}
+
+// ActionName returns the name of this action.
+func (a *WebhookRuleAdder) ActionName() string {
+	return octant.ActionAddWebhookRule
+}
+
+// Handle adds the webhook rule in the payload. Invalid rules are reported as
+// warnings.
+func (a *WebhookRuleAdder) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	rule := webhookRuleFromPayload(payload)
+
+	alertType := action.AlertTypeInfo
+	added, err := a.webhooks.AddRule(rule)
+	message := fmt.Sprintf("Added webhook %q", added.Name)
+	if err != nil {
+		alertType = action.AlertTypeWarning
+		message = fmt.Sprintf("Unable to add webhook: %s", err)
+	}
+
+	alerter.SendAlert(action.CreateAlert(alertType, message, action.DefaultAlertExpiration))
+	return nil
+}
+
+// WebhookRuleRemover is an action which removes a webhook rule.
+type WebhookRuleRemover struct {
+	webhooks notification.WebhookManager
+}
+
+var _ action.Dispatcher = (*WebhookRuleRemover)(nil)
+
+// NewWebhookRuleRemover creates an instance of WebhookRuleRemover.
+func NewWebhookRuleRemover(webhooks notification.WebhookManager) *WebhookRuleRemover {
+	return &WebhookRuleRemover{
+		webhooks: webhooks,
+	}
+}
+
+// ActionName returns the name of this action.
+func (r *WebhookRuleRemover) ActionName() string {
+	return octant.ActionRemoveWebhookRule
+}
+
+// Handle removes the webhook rule with the id in the payload.
+func (r *WebhookRuleRemover) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	id, err := payload.String("id")
+	if err != nil {
+		return err
+	}
+
+	alertType := action.AlertTypeInfo
+	message := "Removed webhook"
+	if err := r.webhooks.RemoveRule(id); err != nil {
+		alertType = action.AlertTypeWarning
+		message = fmt.Sprintf("Unable to remove webhook: %s", err)
+	}
+
+	alerter.SendAlert(action.CreateAlert(alertType, message, action.DefaultAlertExpiration))
+	return nil
+}
+
+// webhookRuleFromPayload creates a webhook rule from the add webhook form.
+func webhookRuleFromPayload(payload action.Payload) notification.WebhookRule {
+	var rule notification.WebhookRule
+	rule.Name, _ = payload.OptionalString("name")
+	rule.APIVersion, _ = payload.OptionalString("apiVersion")
+	rule.Kind, _ = payload.OptionalString("kind")
+	rule.Namespace, _ = payload.OptionalString("namespace")
+	rule.Selector, _ = payload.OptionalString("selector")
+	rule.From, _ = payload.OptionalString("from")
+	rule.To, _ = payload.OptionalString("to")
+	rule.URL, _ = payload.OptionalString("url")
+
+	if rule.From == webhookAnyStatus {
+		rule.From = ""
+	}
+	if rule.To == webhookAnyStatus {
+		rule.To = ""
+	}
+
+	return rule
+}
@@ -0,0 +1,151 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package configuration
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	configFake "github.com/vmware-tanzu/octant/internal/config/fake"
+	"github.com/vmware-tanzu/octant/internal/describer"
+	"github.com/vmware-tanzu/octant/internal/notification"
+	notificationFake "github.com/vmware-tanzu/octant/internal/notification/fake"
+	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	actionFake "github.com/vmware-tanzu/octant/pkg/action/fake"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func TestWebhookRuleAdder_Handle(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	rule := notification.WebhookRule{
+		Name:       "nginx down",
+		APIVersion: "apps/v1",
+		Kind:       "Deployment",
+		Namespace:  "default",
+		Selector:   "app=nginx",
+		To:         "error",
+		URL:        "https://hooks.example.com/services/1",
+	}
+
+	webhooks := notificationFake.NewMockWebhookManager(controller)
+	webhooks.EXPECT().AddRule(rule).Return(rule, nil)
+
+	alerter := actionFake.NewMockAlerter(controller)
+	alerter.EXPECT().
+		SendAlert(gomock.Any()).
+		Do(func(alert action.Alert) {
+			assert.Equal(t, action.AlertTypeInfo, alert.Type)
+			assert.Equal(t, `Added webhook "nginx down"`, alert.Message)
+		})
+
+	a := NewWebhookRuleAdder(webhooks)
+	require.Equal(t, octant.ActionAddWebhookRule, a.ActionName())
+
+	payload := action.Payload{
+		"name":       "nginx down",
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"namespace":  "default",
+		"selector":   "app=nginx",
+		"from":       "any",
+		"to":         "error",
+		"url":        "https://hooks.example.com/services/1",
+	}
+	require.NoError(t, a.Handle(context.Background(), alerter, payload))
+}
+
+func TestWebhookRuleAdder_Handle_invalid(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	webhooks := notificationFake.NewMockWebhookManager(controller)
+	webhooks.EXPECT().AddRule(gomock.Any()).Return(notification.WebhookRule{}, errors.New("api version and kind are required"))
+
+	alerter := actionFake.NewMockAlerter(controller)
+	alerter.EXPECT().
+		SendAlert(gomock.Any()).
+		Do(func(alert action.Alert) {
+			assert.Equal(t, action.AlertTypeWarning, alert.Type)
+			assert.Equal(t, "Unable to add webhook: api version and kind are required", alert.Message)
+		})
+
+	a := NewWebhookRuleAdder(webhooks)
+	require.NoError(t, a.Handle(context.Background(), alerter, action.Payload{}))
+}
+
+func TestWebhookRuleRemover_Handle(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	webhooks := notificationFake.NewMockWebhookManager(controller)
+	webhooks.EXPECT().RemoveRule("1").Return(nil)
+
+	alerter := actionFake.NewMockAlerter(controller)
+	alerter.EXPECT().
+		SendAlert(gomock.Any()).
+		Do(func(alert action.Alert) {
+			assert.Equal(t, action.AlertTypeInfo, alert.Type)
+		})
+
+	r := NewWebhookRuleRemover(webhooks)
+	require.Equal(t, octant.ActionRemoveWebhookRule, r.ActionName())
+	require.NoError(t, r.Handle(context.Background(), alerter, action.Payload{"id": "1"}))
+
+	require.Error(t, r.Handle(context.Background(), alerter, action.Payload{}))
+}
+
+func TestWebhooksDescriber(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	rule := notification.WebhookRule{
+		ID:         "1",
+		Name:       "nginx down",
+		APIVersion: "apps/v1",
+		Kind:       "Deployment",
+		Selector:   "app=nginx",
+		To:         "error",
+		URL:        "https://hooks.example.com/services/1",
+	}
+
+	webhooks := notificationFake.NewMockWebhookManager(controller)
+	webhooks.EXPECT().Rules().Return([]notification.WebhookRule{rule})
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().Webhooks().Return(webhooks)
+
+	d := NewWebhooksDescriber()
+	got, err := d.Describe(context.Background(), "", describer.Options{Dash: dashConfig})
+	require.NoError(t, err)
+
+	require.Len(t, got.Components, 1)
+	list, ok := got.Components[0].(*component.List)
+	require.True(t, ok)
+	table, ok := list.Config.Items[0].(*component.Table)
+	require.True(t, ok)
+
+	row := component.TableRow{
+		"Name":       component.NewText("nginx down"),
+		"Kind":       component.NewText("apps/v1 Deployment"),
+		"Namespace":  component.NewText("All namespaces"),
+		"Selector":   component.NewText("app=nginx"),
+		"Transition": component.NewText("any → error"),
+		"URL":        component.NewText("https://hooks.example.com/services/1"),
+	}
+	row.AddAction(removeWebhookRuleAction(rule))
+	assert.Equal(t, []component.TableRow{row}, table.Rows())
+
+	require.Len(t, got.ButtonGroup.Config.Buttons, 1)
+	assert.Equal(t, "Add Webhook", got.ButtonGroup.Config.Buttons[0].Name)
+}
@@ -0,0 +1,123 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package configuration
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vmware-tanzu/octant/internal/describer"
+	"github.com/vmware-tanzu/octant/internal/notification"
+	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+// webhookAnyStatus is the form value for a transition which matches any status.
+const webhookAnyStatus = "any"
+
+// WebhooksDescriber describes the rules outbound webhooks are sent for.
+type WebhooksDescriber struct {
+}
+
+var _ describer.Describer = (*WebhooksDescriber)(nil)
+
+// NewWebhooksDescriber creates an instance of WebhooksDescriber.
+func NewWebhooksDescriber() *WebhooksDescriber {
+	return &WebhooksDescriber{}
+}
+
+// Describe describes the webhook rules with a button to add a rule.
+func (d *WebhooksDescriber) Describe(ctx context.Context, namespace string, options describer.Options) (component.ContentResponse, error) {
+	title := append([]component.TitleComponent{}, component.NewText("Webhooks"))
+	list := component.NewList(title, nil)
+	tableCols := component.NewTableCols("Name", "Kind", "Namespace", "Selector", "Transition", "URL")
+	tbl := component.NewTable("Webhooks", "There are no webhook rules!", tableCols)
+	list.Add(tbl)
+
+	for _, rule := range options.Dash.Webhooks().Rules() {
+		namespace := rule.Namespace
+		if namespace == "" {
+			namespace = "All namespaces"
+		}
+
+		row := component.TableRow{
+			"Name":       component.NewText(rule.Name),
+			"Kind":       component.NewText(fmt.Sprintf("%s %s", rule.APIVersion, rule.Kind)),
+			"Namespace":  component.NewText(namespace),
+			"Selector":   component.NewText(rule.Selector),
+			"Transition": component.NewText(rule.Transition()),
+			"URL":        component.NewText(rule.URL),
+		}
+		row.AddAction(removeWebhookRuleAction(rule))
+		tbl.Add(row)
+	}
+
+	cr := component.NewContentResponse(nil)
+	cr.Components = []component.Component{list}
+	cr.AddButton("Add Webhook", action.CreatePayload(octant.ActionAddWebhookRule, nil), addWebhookRuleModal())
+
+	return *cr, nil
+}
+
+// PathFilters returns the path filters for webhook rules.
+func (d *WebhooksDescriber) PathFilters() []describer.PathFilter {
+	filter := describer.NewPathFilter("/webhooks", d)
+	return []describer.PathFilter{*filter}
+}
+
+// Reset does nothing.
+func (d *WebhooksDescriber) Reset(ctx context.Context) error {
+	return nil
+}
+
+// addWebhookRuleModal configures a button to open a form which adds a webhook rule.
+func addWebhookRuleModal() component.ButtonOption {
+	statusChoices := func() []component.InputChoice {
+		var choices []component.InputChoice
+		for _, status := range []string{webhookAnyStatus, string(component.NodeStatusOK), string(component.NodeStatusWarning), string(component.NodeStatusError)} {
+			choices = append(choices, component.InputChoice{
+				Label:   status,
+				Value:   status,
+				Checked: status == webhookAnyStatus,
+			})
+		}
+		return choices
+	}
+
+	form := component.Form{
+		Fields: []component.FormField{
+			component.NewFormFieldText("Name", "name", ""),
+			component.NewFormFieldText("API Version", "apiVersion", "apps/v1"),
+			component.NewFormFieldText("Kind", "kind", "Deployment"),
+			component.NewFormFieldText("Namespace (blank for all)", "namespace", ""),
+			component.NewFormFieldText("Label Selector", "selector", ""),
+			component.NewFormFieldSelect("From Status", "from", statusChoices(), false),
+			component.NewFormFieldSelect("To Status", "to", statusChoices(), false),
+			component.NewFormFieldText("Webhook URL", "url", ""),
+		},
+		Action: octant.ActionAddWebhookRule,
+	}
+
+	modal := component.NewModal(component.TitleFromString("Add Webhook"))
+	modal.SetBody(component.NewMarkdownText("A JSON message compatible with Slack incoming webhooks is posted to the URL when a matching object's status changes."))
+	modal.AddForm(form)
+
+	return component.WithModal(modal)
+}
+
+func removeWebhookRuleAction(rule notification.WebhookRule) component.GridAction {
+	return component.GridAction{
+		Name:       "Remove",
+		ActionPath: octant.ActionRemoveWebhookRule,
+		Payload:    action.Payload{"id": rule.ID},
+		Confirmation: &component.Confirmation{
+			Title: "Remove Webhook",
+			Body:  fmt.Sprintf("Are you sure you want to remove the webhook **%s**?", rule.Name),
+		},
+		Type: component.GridActionDanger,
+	}
+}
@@ -10,10 +10,12 @@ import (
 	"sync"
 
 	"github.com/pkg/errors"
+	"github.com/spf13/afero"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"github.com/vmware-tanzu/octant/internal/config"
 	"github.com/vmware-tanzu/octant/internal/describer"
+	"github.com/vmware-tanzu/octant/internal/filecopy"
 	"github.com/vmware-tanzu/octant/internal/generator"
 	internalLog "github.com/vmware-tanzu/octant/internal/log"
 	"github.com/vmware-tanzu/octant/internal/module"
@@ -35,6 +37,7 @@ type Options struct {
 type Overview struct {
 	*octant.ObjectPath
 
+	ctx         context.Context
 	generator   generator.Interface
 	dashConfig  config.Dash
 	contextName string
@@ -60,6 +63,7 @@ func New(ctx context.Context, options Options) (*Overview, error) {
 	}
 
 	co := &Overview{
+		ctx:        ctx,
 		dashConfig: options.DashConfig,
 		logger:     options.DashConfig.Logger().With("module", "overview"),
 	}
@@ -267,25 +271,46 @@ func (co *Overview) Content(ctx context.Context, contentPath string, opts module
 	ctx = internalLog.WithLoggerContext(ctx, co.dashConfig.Logger())
 	genOpts := generator.Options{
 		LabelSet: opts.LabelSet,
+		Tab:      opts.Tab,
+		Limit:    opts.Limit,
 	}
 	return co.generator.Generate(ctx, contentPath, genOpts)
 }
 
 // ActionPaths contain the actions this module is responsible for.
 func (co *Overview) ActionPaths() map[string]action.DispatcherFunc {
+	nodeDrains := octant.NewNodeDrains(co.ctx)
+
 	dispatchers := action.Dispatchers{
 		octant.NewDeploymentConfigurationEditor(co.logger, co.dashConfig.ObjectStore()),
+		octant.NewDeploymentPause(co.dashConfig.ObjectStore()),
+		octant.NewDeploymentResume(co.dashConfig.ObjectStore()),
+		octant.NewHorizontalPodAutoscalerEditor(co.dashConfig.ObjectStore()),
 		octant.NewContainerEditor(co.dashConfig.ObjectStore()),
 		octant.NewServiceConfigurationEditor(co.dashConfig.ObjectStore()),
+		octant.NewServicePortEditor(co.dashConfig.ObjectStore(), co.dashConfig.ClusterClient()),
 		octant.NewPortForward(co.logger, co.dashConfig.ObjectStore(), co.dashConfig.PortForwarder()),
 		octant.NewPortForwardDelete(co.logger, co.dashConfig.ObjectStore(), co.dashConfig.PortForwarder()),
 		octant.NewCordon(co.dashConfig.ObjectStore(), co.dashConfig.ClusterClient()),
 		octant.NewUncordon(co.dashConfig.ObjectStore(), co.dashConfig.ClusterClient()),
+		octant.NewDrain(co.dashConfig.ClusterClient(), nodeDrains, co.dashConfig.Notifier(), co.dashConfig.Auditor()),
+		octant.NewDrainCancel(nodeDrains),
+		octant.NewNodeTaintEditor(co.dashConfig.ObjectStore()),
+		octant.NewNodeTaintRemover(co.dashConfig.ObjectStore()),
 		octant.NewCronJobTrigger(co.dashConfig.ObjectStore(), co.dashConfig.ClusterClient()),
 		octant.NewCronJobSuspend(co.dashConfig.ObjectStore(), co.dashConfig.ClusterClient()),
 		octant.NewCronJobResume(co.dashConfig.ObjectStore(), co.dashConfig.ClusterClient()),
+		octant.NewPersistentVolumeClaimResizer(co.dashConfig.ObjectStore()),
 		octant.NewObjectUpdaterDispatcher(co.dashConfig.ObjectStore()),
+		octant.NewObjectDiffDispatcher(co.dashConfig.ObjectStore(), co.dashConfig.ClusterClient()),
 		octant.NewApplyYaml(co.logger, co.dashConfig.ObjectStore()),
+		octant.NewValidateYaml(co.logger, co.dashConfig.ObjectStore()),
+		octant.NewContainerFileDownloader(filecopy.NewExecutor(co.dashConfig.ClusterClient()), afero.NewOsFs()),
+		octant.NewContainerFileUploader(filecopy.NewExecutor(co.dashConfig.ClusterClient()), afero.NewOsFs()),
+		octant.NewObjectExporter(co.dashConfig.ObjectStore(), afero.NewOsFs()),
+		octant.NewObjectCompareDispatcher(co.dashConfig),
+		octant.NewKubectlPluginRunner(co.dashConfig),
+		octant.NewSecretValueRevealer(co.dashConfig.ClusterClient()),
 	}
 
 	return dispatchers.ToActionPaths()
@@ -33,6 +33,7 @@ var (
 		gvk.Service,
 		gvk.NetworkPolicy,
 		gvk.ConfigMap,
+		gvk.Lease,
 		gvk.Secret,
 		gvk.PersistentVolumeClaim,
 		gvk.ServiceAccount,
@@ -80,6 +81,8 @@ func gvkPath(namespace, apiVersion, kind, name string) (string, error) {
 		p = "/config-and-storage/secrets"
 	case apiVersion == "v1" && kind == "ConfigMap":
 		p = "/config-and-storage/config-maps"
+	case apiVersion == "coordination.k8s.io/v1" && kind == "Lease":
+		p = "/config-and-storage/leases"
 	case apiVersion == "v1" && kind == "PersistentVolumeClaim":
 		p = "/config-and-storage/persistent-volume-claims"
 	case apiVersion == "v1" && kind == "ServiceAccount":
@@ -129,6 +132,8 @@ func gvkReversePath(contentPath, namespace string) (schema.GroupVersionKind, err
 		return gvk.Secret, nil
 	case reducedPath == "/config-and-storage/config-maps":
 		return gvk.ConfigMap, nil
+	case reducedPath == "/config-and-storage/leases":
+		return gvk.Lease, nil
 	case reducedPath == "/config-and-storage/persistent-volume-claims":
 		return gvk.PersistentVolumeClaim, nil
 	case reducedPath == "/config-and-storage/service-accounts":
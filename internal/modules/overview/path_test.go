@@ -39,6 +39,14 @@ func Test_gvk_path(t *testing.T) {
 			objectName: "pod",
 			expected:   path.Join("/overview", "namespace", "default", "workloads", "pods", "pod"),
 		},
+		{
+			name:       "lease",
+			namespace:  "kube-system",
+			apiVersion: "coordination.k8s.io/v1",
+			kind:       "Lease",
+			objectName: "kube-scheduler",
+			expected:   path.Join("/overview", "namespace", "kube-system", "config-and-storage", "leases", "kube-scheduler"),
+		},
 		{
 			name:       "no namespace",
 			apiVersion: "v1",
@@ -9,6 +9,7 @@ import (
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 
+	"github.com/vmware-tanzu/octant/internal/octant"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
 
@@ -42,6 +43,7 @@ func new(object runtime.Object) (*yamlViewer, error) {
 func (yv *yamlViewer) ToComponent() (*component.Editor, error) {
 	y := component.NewEditor(component.TitleFromString("YAML"), "", false)
 	y.Config.Language = "yaml"
+	y.Config.SubmitAction = octant.ActionDiffObject
 	if err := y.SetValueFromObject(yv.object); err != nil {
 		return nil, errors.Wrap(err, "add YAML data")
 	}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/vmware-tanzu/octant/internal/octant"
 	"github.com/vmware-tanzu/octant/internal/testutil"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 
@@ -25,6 +26,7 @@ func Test_ToComponent(t *testing.T) {
 	data := "---\nmetadata:\n  creationTimestamp: null\nspec:\n  containers: null\nstatus: {}\n"
 	expected := component.NewEditor(component.TitleFromString("YAML"), data, false)
 	expected.Config.Language = "yaml"
+	expected.Config.SubmitAction = octant.ActionDiffObject
 	require.NoError(t, expected.SetValueFromObject(object))
 
 	testutil.AssertJSONEqual(t, expected, got)
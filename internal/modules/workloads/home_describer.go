@@ -39,7 +39,8 @@ func loadCards(ctx context.Context, namespace string, options describer.Options)
 		return nil, false, fmt.Errorf("create pod metrics loader")
 	}
 
-	loader, err := octant.NewClusterWorkloadLoader(options.Dash.ObjectStore(), pml)
+	loader, err := octant.NewClusterWorkloadLoader(options.Dash.ObjectStore(), pml,
+		octant.WithWorkloadSelector(options.LabelSet))
 	if err != nil {
 		return nil, false, fmt.Errorf("create workload loader")
 	}
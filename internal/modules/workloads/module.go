@@ -60,6 +60,15 @@ func New(ctx context.Context, options Options) (*Module, error) {
 		pm.Register(ctx, pf)
 	}
 
+	resourceUsageDescriber, err := NewResourceUsageDescriber()
+	if err != nil {
+		return nil, fmt.Errorf("create resource usage describer: %w", err)
+	}
+
+	for _, pf := range resourceUsageDescriber.PathFilters() {
+		pm.Register(ctx, pf)
+	}
+
 	m := &Module{
 		Options:     options,
 		pathMatcher: pm,
@@ -90,7 +99,11 @@ func (m *Module) Content(ctx context.Context, contentPath string, opts module.Co
 		return component.EmptyContentResponse, err
 	}
 
-	return g.Generate(ctx, contentPath, generator.Options{})
+	return g.Generate(ctx, contentPath, generator.Options{
+		LabelSet: opts.LabelSet,
+		Tab:      opts.Tab,
+		Limit:    opts.Limit,
+	})
 }
 
 // ContentPath returns the content path for this module.
@@ -106,6 +119,12 @@ func (m *Module) Navigation(ctx context.Context, namespace, root string) ([]navi
 		Title:    "Applications",
 		Path:     rootPath,
 		IconName: icon.Applications,
+		Children: []navigation.Navigation{
+			{
+				Title: "Resource Usage",
+				Path:  path_util.NamespacedPath(m.ContentPath(), namespace, "resource-usage"),
+			},
+		},
 	}
 
 	return []navigation.Navigation{rootNav}, nil
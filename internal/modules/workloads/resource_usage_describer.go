@@ -0,0 +1,227 @@
+/*
+ * Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package workloads
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/vmware-tanzu/octant/internal/describer"
+	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/internal/util/path_util"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+const (
+	// resourceUsagePath is the path of the resource usage dashboard.
+	resourceUsagePath = "/resource-usage"
+	// quotaWarningPercent is the percent of a quota at which a stat is shown as a warning.
+	quotaWarningPercent = 80
+)
+
+// resourceUsageResources are the resources shown on the resource usage dashboard.
+var resourceUsageResources = []struct {
+	title string
+	name  corev1.ResourceName
+}{
+	{title: "CPU", name: corev1.ResourceCPU},
+	{title: "Memory", name: corev1.ResourceMemory},
+}
+
+// ResourceUsageDescriber describes the requests, limits, and usage of the
+// workloads in a namespace, and how much of the namespace's quota they use.
+type ResourceUsageDescriber struct{}
+
+var _ describer.Describer = (*ResourceUsageDescriber)(nil)
+
+// NewResourceUsageDescriber creates an instance of ResourceUsageDescriber.
+func NewResourceUsageDescriber() (*ResourceUsageDescriber, error) {
+	return &ResourceUsageDescriber{}, nil
+}
+
+func (d *ResourceUsageDescriber) loadUsage(ctx context.Context, namespace string, options describer.Options) (*octant.NamespaceResourceUsage, error) {
+	pml, err := octant.NewClusterPodMetricsLoader(options.Dash.ClusterClient())
+	if err != nil {
+		return nil, fmt.Errorf("create pod metrics loader")
+	}
+
+	loader, err := octant.NewClusterWorkloadLoader(options.Dash.ObjectStore(), pml,
+		octant.WithWorkloadSelector(options.LabelSet))
+	if err != nil {
+		return nil, fmt.Errorf("create workload loader")
+	}
+
+	return octant.LoadNamespaceResourceUsage(ctx, options.Dash.ObjectStore(), loader, namespace)
+}
+
+// Describe creates a content response with a stat for the requests, limits, and
+// usage of each resource, and a table of workloads for each resource ordered by
+// the workloads using the most.
+func (d *ResourceUsageDescriber) Describe(ctx context.Context, namespace string, options describer.Options) (component.ContentResponse, error) {
+	title := component.TitleFromString("Resource Usage")
+
+	usage, err := d.loadUsage(ctx, namespace, options)
+	if err != nil {
+		return component.ContentResponse{
+			Title: title,
+			Components: []component.Component{
+				component.NewError(component.TitleFromString("Unable to load resource usage"), err),
+			},
+		}, nil
+	}
+
+	layout := component.NewFlexLayout("Resource Usage")
+
+	for _, r := range resourceUsageResources {
+		layout.AddSections(resourceUsageStats(usage, r.title, r.name))
+	}
+
+	for _, r := range resourceUsageResources {
+		layout.AddSections(component.FlexLayoutSection{
+			{
+				Width: component.WidthFull,
+				View:  resourceUsageTable(usage, namespace, r.title, r.name),
+			},
+		})
+	}
+
+	return component.ContentResponse{
+		Title:      title,
+		Components: []component.Component{layout},
+	}, nil
+}
+
+// PathFilters returns a path filter for the resource usage path.
+func (d *ResourceUsageDescriber) PathFilters() []describer.PathFilter {
+	return []describer.PathFilter{
+		*describer.NewPathFilter(resourceUsagePath, d),
+	}
+}
+
+// Reset is a no-op.
+func (d *ResourceUsageDescriber) Reset(ctx context.Context) error {
+	return nil
+}
+
+// resourceUsageStats creates stats for the requests, limits, and usage of a
+// resource. Requests and limits are compared with the namespace's quota, and
+// usage is compared with requests and limits.
+func resourceUsageStats(usage *octant.NamespaceResourceUsage, title string, resourceName corev1.ResourceName) component.FlexLayoutSection {
+	requests := usage.Requests[resourceName]
+	limits := usage.Limits[resourceName]
+
+	section := component.FlexLayoutSection{
+		{
+			Width: component.WidthThird,
+			View:  quotaStat(usage, fmt.Sprintf("%s Requests", title), resourceName, requests, false),
+		},
+		{
+			Width: component.WidthThird,
+			View:  quotaStat(usage, fmt.Sprintf("%s Limits", title), resourceName, limits, true),
+		},
+	}
+
+	if usage.MetricsEnabled {
+		actual := usage.Usage[resourceName]
+
+		color := octant.WorkloadStatusColorOK
+		if !requests.IsZero() && actual.Cmp(requests) > 0 {
+			color = octant.WorkloadStatusColorWarning
+		}
+		if !limits.IsZero() && actual.Cmp(limits) > 0 {
+			color = octant.WorkloadStatusColorError
+		}
+
+		section = append(section, component.FlexLayoutItem{
+			Width: component.WidthThird,
+			View:  component.NewSingleStat(fmt.Sprintf("%s Usage", title), octant.PrintResourceUsage(resourceName, actual), color),
+		})
+	}
+
+	return section
+}
+
+// quotaStat creates a stat for the requests or limits of a resource. If the
+// namespace has a quota for them, the stat shows the quota and is colored by
+// how much of it is used.
+func quotaStat(usage *octant.NamespaceResourceUsage, title string, resourceName corev1.ResourceName, amount resource.Quantity, limits bool) *component.SingleStat {
+	text := octant.PrintResourceUsage(resourceName, amount)
+
+	hard, ok := usage.QuotaFor(resourceName, limits)
+	if !ok {
+		return component.NewSingleStat(title, text, octant.WorkloadStatusColorOK)
+	}
+
+	percent := quotaPercent(amount, hard)
+
+	color := octant.WorkloadStatusColorOK
+	switch {
+	case percent >= 100:
+		color = octant.WorkloadStatusColorError
+	case percent >= quotaWarningPercent:
+		color = octant.WorkloadStatusColorWarning
+	}
+
+	text = fmt.Sprintf("%s of %s (%d%%)", text, octant.PrintResourceUsage(resourceName, hard), percent)
+	return component.NewSingleStat(title, text, color)
+}
+
+// resourceUsageTable creates a table of the workloads using the most of a
+// resource. It shows the usage column if the cluster has pod metrics, and the
+// quota column if the namespace has a quota for requests of the resource.
+func resourceUsageTable(usage *octant.NamespaceResourceUsage, namespace, title string, resourceName corev1.ResourceName) *component.Table {
+	hard, hasQuota := usage.QuotaFor(resourceName, false)
+
+	cols := component.NewTableCols("Workload", "Kind", "Pods", "Requests", "Limits")
+	if usage.MetricsEnabled {
+		cols = append(cols, component.NewTableCols("Usage")...)
+	}
+	if hasQuota {
+		cols = append(cols, component.NewTableCols("Quota")...)
+	}
+
+	table := component.NewTable(fmt.Sprintf("%s by Workload", title), "There are no workloads!", cols)
+
+	for _, workloadUsage := range usage.SortWorkloadsByResource(resourceName) {
+		workload := workloadUsage.Workload
+
+		kind := ""
+		if workload.Owner != nil {
+			kind = workload.Owner.GetKind()
+		}
+
+		workloadPath := path_util.NamespacedPath("/workloads", namespace, "detail", workload.Name)
+
+		row := component.TableRow{
+			"Workload": component.NewLink("", workload.Name, workloadPath),
+			"Kind":     component.NewText(kind),
+			"Pods":     component.NewText(fmt.Sprintf("%d", len(workload.PodsWithMetrics()))),
+			"Requests": component.NewText(octant.PrintResourceUsage(resourceName, workloadUsage.Requests[resourceName])),
+			"Limits":   component.NewText(octant.PrintResourceUsage(resourceName, workloadUsage.Limits[resourceName])),
+		}
+		if usage.MetricsEnabled {
+			row["Usage"] = component.NewText(octant.PrintResourceUsage(resourceName, workloadUsage.Usage[resourceName]))
+		}
+		if hasQuota {
+			row["Quota"] = component.NewText(fmt.Sprintf("%d%%", quotaPercent(workloadUsage.Requests[resourceName], hard)))
+		}
+
+		table.Add(row)
+	}
+
+	return table
+}
+
+// quotaPercent returns the percent of a quota an amount uses.
+func quotaPercent(amount, hard resource.Quantity) int64 {
+	if hard.IsZero() {
+		return 0
+	}
+	return amount.MilliValue() * 100 / hard.MilliValue()
+}
@@ -0,0 +1,115 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package notification
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/vmware-tanzu/octant/internal/objectstatus"
+	"github.com/vmware-tanzu/octant/internal/util/kubernetes"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+// detectFunc compares an object before and after an update and describes the change
+// if a user should be notified about it.
+type detectFunc func(ctx context.Context, oldObj, newObj *unstructured.Unstructured, objectStore store.Store) (action.AlertType, string, bool)
+
+type watchedKind struct {
+	apiVersion string
+	kind       string
+	detect     detectFunc
+}
+
+var defaultWatchedKinds = []watchedKind{
+	{apiVersion: "apps/v1", kind: "Deployment", detect: detectStatusChange},
+	{apiVersion: "apps/v1", kind: "StatefulSet", detect: detectStatusChange},
+	{apiVersion: "apps/v1", kind: "DaemonSet", detect: detectStatusChange},
+	{apiVersion: "v1", kind: "PersistentVolumeClaim", detect: detectClaimPhase},
+}
+
+// detectStatusChange notifies when an object's status, as shown in the resource
+// viewer, changes between ok, warning, and error.
+func detectStatusChange(ctx context.Context, oldObj, newObj *unstructured.Unstructured, objectStore store.Store) (action.AlertType, string, bool) {
+	if newObj.GetDeletionTimestamp() != nil {
+		return "", "", false
+	}
+
+	oldStatus, err := objectstatus.Status(ctx, oldObj, objectStore)
+	if err != nil {
+		return "", "", false
+	}
+	newStatus, err := objectstatus.Status(ctx, newObj, objectStore)
+	if err != nil {
+		return "", "", false
+	}
+
+	if oldStatus.Status() == newStatus.Status() {
+		return "", "", false
+	}
+
+	name := fmt.Sprintf("%s %s", newObj.GetKind(), newObj.GetName())
+	detail := statusDetail(newStatus)
+
+	switch newStatus.Status() {
+	case component.NodeStatusError:
+		return action.AlertTypeError, withDetail(name+" became unavailable", detail), true
+	case component.NodeStatusWarning:
+		return action.AlertTypeWarning, withDetail(name+" is degraded", detail), true
+	default:
+		return action.AlertTypeSuccess, name + " is available", true
+	}
+}
+
+// detectClaimPhase notifies when a persistent volume claim is bound or loses its volume.
+func detectClaimPhase(_ context.Context, oldObj, newObj *unstructured.Unstructured, _ store.Store) (action.AlertType, string, bool) {
+	oldClaim := &corev1.PersistentVolumeClaim{}
+	if err := kubernetes.FromUnstructured(oldObj, oldClaim); err != nil {
+		return "", "", false
+	}
+	newClaim := &corev1.PersistentVolumeClaim{}
+	if err := kubernetes.FromUnstructured(newObj, newClaim); err != nil {
+		return "", "", false
+	}
+
+	if oldClaim.Status.Phase == newClaim.Status.Phase {
+		return "", "", false
+	}
+
+	switch newClaim.Status.Phase {
+	case corev1.ClaimBound:
+		message := fmt.Sprintf("PersistentVolumeClaim %s bound", newClaim.Name)
+		if newClaim.Spec.VolumeName != "" {
+			message = fmt.Sprintf("%s to %s", message, newClaim.Spec.VolumeName)
+		}
+		return action.AlertTypeSuccess, message, true
+	case corev1.ClaimLost:
+		return action.AlertTypeError, fmt.Sprintf("PersistentVolumeClaim %s lost its volume", newClaim.Name), true
+	default:
+		return "", "", false
+	}
+}
+
+func statusDetail(status objectstatus.ObjectStatus) string {
+	for _, detail := range status.Details {
+		if text, ok := detail.(*component.Text); ok && text.Config.Text != "" {
+			return text.Config.Text
+		}
+	}
+	return ""
+}
+
+func withDetail(message, detail string) string {
+	if detail == "" {
+		return message
+	}
+	return fmt.Sprintf("%s: %s", message, detail)
+}
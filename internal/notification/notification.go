@@ -0,0 +1,55 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package notification
+
+import (
+	"strings"
+	"time"
+
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+// Notification describes a change to a resource which is worth telling a user about.
+type Notification struct {
+	ID        string           `json:"id"`
+	Type      action.AlertType `json:"type"`
+	Message   string           `json:"message"`
+	Object    store.Key        `json:"object"`
+	CreatedAt time.Time        `json:"createdAt"`
+}
+
+// MuteRule mutes notifications for matching objects. Empty fields match anything, so
+// a rule with only a namespace mutes every notification in that namespace.
+type MuteRule struct {
+	Namespace string `json:"namespace,omitempty"`
+	Kind      string `json:"kind,omitempty"`
+	Name      string `json:"name,omitempty"`
+}
+
+// Matches returns true if the rule mutes notifications for key.
+func (r MuteRule) Matches(key store.Key) bool {
+	if r.Namespace != "" && r.Namespace != key.Namespace {
+		return false
+	}
+	if r.Kind != "" && r.Kind != key.Kind {
+		return false
+	}
+	if r.Name != "" && r.Name != key.Name {
+		return false
+	}
+	return true
+}
+
+func (r MuteRule) String() string {
+	parts := []string{r.Namespace, r.Kind, r.Name}
+	for i := range parts {
+		if parts[i] == "" {
+			parts[i] = "*"
+		}
+	}
+	return strings.Join(parts, "/")
+}
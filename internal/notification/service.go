@@ -0,0 +1,279 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package notification
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	kcache "k8s.io/client-go/tools/cache"
+
+	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+//go:generate mockgen -destination=./fake/mock_notifier.go -package=fake github.com/vmware-tanzu/octant/internal/notification Notifier
+
+// Notifier sends notifications about resource changes to subscribers.
+type Notifier interface {
+	// Watch starts watching resources in a namespace. The returned function stops
+	// watching. A namespace is watched until every watch of it is stopped.
+	Watch(namespace string) func()
+	// Subscribe sends notifications to ch. The returned function unsubscribes ch.
+	Subscribe(ch chan<- Notification) func()
+	// Publish sends a notification which isn't about a resource change, such as
+	// the progress of a long running action, to subscribers.
+	Publish(n Notification)
+}
+
+// namespaceWatch is the watches of a namespace and the number of watchers sharing them.
+type namespaceWatch struct {
+	refs    int
+	watches []store.WatchHandle
+}
+
+// Service is a Notifier which is fed by informer events from the object store.
+type Service struct {
+	ctx         context.Context
+	objectStore store.Store
+	kinds       []watchedKind
+	now         func() time.Time
+
+	// watched are the watches of each namespace being watched. A namespace's entry is
+	// replaced when its watches are added again, so watches added for an entry which
+	// was replaced or released are cancelled.
+	watched map[string]*namespaceWatch
+	// versions are the last resource versions seen for each object, so an object
+	// watched by more than one informer only notifies once per change.
+	versions map[types.UID]string
+
+	subscribers  map[int]chan<- Notification
+	subscriberID int
+
+	mu sync.Mutex
+}
+
+var _ Notifier = (*Service)(nil)
+
+// NewService creates an instance of Service.
+func NewService(ctx context.Context, objectStore store.Store) *Service {
+	s := &Service{
+		ctx:         ctx,
+		objectStore: objectStore,
+		kinds:       defaultWatchedKinds,
+		now:         time.Now,
+		watched:     map[string]*namespaceWatch{},
+		versions:    map[types.UID]string{},
+		subscribers: map[int]chan<- Notification{},
+	}
+
+	if objectStore != nil {
+		// Watches belong to the store they were added to, so they have to be added
+		// again when the store is replaced after a context change.
+		objectStore.RegisterOnUpdate(func(objectStore store.Store) {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+
+			s.objectStore = objectStore
+			s.versions = map[types.UID]string{}
+
+			for namespace, watched := range s.watched {
+				for _, watch := range watched.watches {
+					watch.Cancel()
+				}
+
+				// Watchers keep watching the namespace in the new store.
+				replaced := &namespaceWatch{refs: watched.refs}
+				s.watched[namespace] = replaced
+				go s.watch(objectStore, namespace, replaced)
+			}
+		})
+	}
+
+	return s
+}
+
+// Watch starts watching resources in a namespace.
+func (s *Service) Watch(namespace string) func() {
+	s.mu.Lock()
+	watched, ok := s.watched[namespace]
+	if !ok {
+		watched = &namespaceWatch{}
+		s.watched[namespace] = watched
+	}
+	watched.refs++
+	objectStore := s.objectStore
+	s.mu.Unlock()
+
+	if !ok && objectStore != nil {
+		s.watch(objectStore, namespace, watched)
+	}
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			s.release(namespace)
+		})
+	}
+}
+
+// release stops a watch of a namespace. The namespace's watches are cancelled once it
+// has no watchers left.
+func (s *Service) release(namespace string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	watched, ok := s.watched[namespace]
+	if !ok {
+		return
+	}
+
+	watched.refs--
+	if watched.refs > 0 {
+		return
+	}
+
+	delete(s.watched, namespace)
+	for _, watch := range watched.watches {
+		watch.Cancel()
+	}
+}
+
+// watch adds the watches of a namespace to watched. Adding a watch waits for its
+// informer to sync, so it is called without the lock held.
+func (s *Service) watch(objectStore store.Store, namespace string, watched *namespaceWatch) {
+	logger := log.From(s.ctx).With("context", "notification.Service", "namespace", namespace)
+
+	for i := range s.kinds {
+		kind := s.kinds[i]
+
+		handler := kcache.ResourceEventHandlerFuncs{
+			UpdateFunc: func(oldObj, newObj interface{}) {
+				s.updated(kind, oldObj, newObj)
+			},
+			DeleteFunc: func(obj interface{}) {
+				s.deleted(obj)
+			},
+		}
+
+		key := store.Key{APIVersion: kind.apiVersion, Kind: kind.kind, Namespace: namespace}
+		watch, err := objectStore.Watch(s.ctx, key, handler)
+		if err != nil {
+			logger.WithErr(err).Debugf("unable to watch %s for notifications", kind.kind)
+			continue
+		}
+
+		s.mu.Lock()
+		if s.watched[namespace] == watched {
+			watched.watches = append(watched.watches, watch)
+		} else {
+			// The namespace was released or the store was replaced while watching.
+			watch.Cancel()
+		}
+		s.mu.Unlock()
+	}
+}
+
+func (s *Service) updated(kind watchedKind, oldObj, newObj interface{}) {
+	oldU, ok := oldObj.(*unstructured.Unstructured)
+	if !ok {
+		return
+	}
+	newU, ok := newObj.(*unstructured.Unstructured)
+	if !ok {
+		return
+	}
+
+	if oldU.GetResourceVersion() == newU.GetResourceVersion() {
+		// Resyncs don't change anything.
+		return
+	}
+
+	s.mu.Lock()
+	if s.versions[newU.GetUID()] == newU.GetResourceVersion() {
+		s.mu.Unlock()
+		return
+	}
+	s.versions[newU.GetUID()] = newU.GetResourceVersion()
+	objectStore := s.objectStore
+	s.mu.Unlock()
+
+	alertType, message, ok := kind.detect(s.ctx, oldU, newU, objectStore)
+	if !ok {
+		return
+	}
+
+	key, err := store.KeyFromObject(newU)
+	if err != nil {
+		return
+	}
+
+	s.Publish(Notification{
+		Type:      alertType,
+		Message:   message,
+		Object:    key,
+		CreatedAt: s.now(),
+	})
+}
+
+func (s *Service) deleted(obj interface{}) {
+	if tombstone, ok := obj.(kcache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+
+	u, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	delete(s.versions, u.GetUID())
+	s.mu.Unlock()
+}
+
+// Publish sends a notification to subscribers. Subscribers which aren't keeping up
+// miss notifications rather than blocking informers.
+func (s *Service) Publish(n Notification) {
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return
+	}
+	n.ID = id.String()
+	if n.CreatedAt.IsZero() {
+		n.CreatedAt = s.now()
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, ch := range s.subscribers {
+		select {
+		case ch <- n:
+		default:
+		}
+	}
+}
+
+// Subscribe sends notifications to ch.
+func (s *Service) Subscribe(ch chan<- Notification) func() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.subscriberID++
+	id := s.subscriberID
+	s.subscribers[id] = ch
+
+	return func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		delete(s.subscribers, id)
+	}
+}
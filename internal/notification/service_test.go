@@ -0,0 +1,222 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package notification
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	kcache "k8s.io/client-go/tools/cache"
+
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	storeFake "github.com/vmware-tanzu/octant/pkg/store/fake"
+)
+
+func deployment(t *testing.T, resourceVersion string, replicas, available int32) *unstructured.Unstructured {
+	d := testutil.CreateDeployment("nginx")
+	d.UID = types.UID("nginx-uid")
+	d.ResourceVersion = resourceVersion
+	d.Status.Replicas = replicas
+	d.Status.AvailableReplicas = available
+	d.Status.UnavailableReplicas = replicas - available
+	return testutil.ToUnstructured(t, d)
+}
+
+func claim(t *testing.T, resourceVersion string, phase corev1.PersistentVolumeClaimPhase) *unstructured.Unstructured {
+	pvc := testutil.CreatePersistentVolumeClaim("data")
+	pvc.UID = types.UID("data-uid")
+	pvc.ResourceVersion = resourceVersion
+	pvc.Spec.VolumeName = "pv-1"
+	pvc.Status.Phase = phase
+	return testutil.ToUnstructured(t, pvc)
+}
+
+// watchedService creates a service which watches the default namespace and returns
+// the handlers it registered.
+func watchedService(t *testing.T, controller *gomock.Controller) (*Service, map[string]kcache.ResourceEventHandler) {
+	handlers := map[string]kcache.ResourceEventHandler{}
+
+	objectStore := storeFake.NewMockStore(controller)
+	objectStore.EXPECT().RegisterOnUpdate(gomock.Any())
+	objectStore.EXPECT().
+		Watch(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, key store.Key, handler kcache.ResourceEventHandler) (store.WatchHandle, error) {
+			assert.Equal(t, "default", key.Namespace)
+			handlers[key.Kind] = handler
+			return storeFake.NewMockWatchHandle(controller), nil
+		}).
+		Times(len(defaultWatchedKinds))
+
+	s := NewService(context.Background(), objectStore)
+	s.now = func() time.Time { return testutil.Time() }
+
+	s.Watch("default")
+	s.Watch("default")
+
+	return s, handlers
+}
+
+func receive(t *testing.T, ch <-chan Notification) Notification {
+	select {
+	case n := <-ch:
+		return n
+	default:
+		t.Fatal("expected a notification")
+		return Notification{}
+	}
+}
+
+func TestService_deploymentStatus(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	s, handlers := watchedService(t, controller)
+
+	ch := make(chan Notification, 10)
+	unsubscribe := s.Subscribe(ch)
+
+	handlers["Deployment"].OnUpdate(deployment(t, "1", 3, 3), deployment(t, "2", 3, 0))
+
+	n := receive(t, ch)
+	assert.NotEmpty(t, n.ID)
+	assert.Equal(t, action.AlertTypeError, n.Type)
+	assert.Equal(t, "Deployment nginx became unavailable: No replicas exist for this deployment", n.Message)
+	assert.Equal(t, store.Key{APIVersion: "apps/v1", Kind: "Deployment", Namespace: "namespace", Name: "nginx"}, n.Object)
+	assert.Equal(t, testutil.Time(), n.CreatedAt)
+
+	// Updates which don't change the status are quiet.
+	handlers["Deployment"].OnUpdate(deployment(t, "2", 3, 0), deployment(t, "3", 3, 0))
+	assert.Empty(t, ch)
+
+	// The same change seen by another informer only notifies once.
+	handlers["Deployment"].OnUpdate(deployment(t, "3", 3, 0), deployment(t, "4", 3, 3))
+	handlers["Deployment"].OnUpdate(deployment(t, "3", 3, 0), deployment(t, "4", 3, 3))
+	n = receive(t, ch)
+	assert.Equal(t, action.AlertTypeSuccess, n.Type)
+	assert.Equal(t, "Deployment nginx is available", n.Message)
+	assert.Empty(t, ch)
+
+	unsubscribe()
+	handlers["Deployment"].OnUpdate(deployment(t, "4", 3, 3), deployment(t, "5", 3, 1))
+	assert.Empty(t, ch)
+}
+
+func TestService_claimPhase(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	s, handlers := watchedService(t, controller)
+
+	ch := make(chan Notification, 10)
+	s.Subscribe(ch)
+
+	handlers["PersistentVolumeClaim"].OnUpdate(claim(t, "1", corev1.ClaimPending), claim(t, "2", corev1.ClaimBound))
+
+	n := receive(t, ch)
+	assert.Equal(t, action.AlertTypeSuccess, n.Type)
+	assert.Equal(t, "PersistentVolumeClaim data bound to pv-1", n.Message)
+
+	handlers["PersistentVolumeClaim"].OnUpdate(claim(t, "2", corev1.ClaimBound), claim(t, "3", corev1.ClaimLost))
+
+	n = receive(t, ch)
+	assert.Equal(t, action.AlertTypeError, n.Type)
+	assert.Equal(t, "PersistentVolumeClaim data lost its volume", n.Message)
+}
+
+func TestService_Watch_release(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	watch := storeFake.NewMockWatchHandle(controller)
+
+	objectStore := storeFake.NewMockStore(controller)
+	objectStore.EXPECT().RegisterOnUpdate(gomock.Any())
+	objectStore.EXPECT().
+		Watch(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(watch, nil).
+		Times(len(defaultWatchedKinds))
+
+	s := NewService(context.Background(), objectStore)
+
+	// Namespaces are watched once, and until every watcher releases them.
+	release := s.Watch("default")
+	releaseOther := s.Watch("default")
+
+	release()
+	release()
+
+	watch.EXPECT().Cancel().Times(len(defaultWatchedKinds))
+	releaseOther()
+	assert.Empty(t, s.watched)
+}
+
+func TestService_storeUpdated(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	watch := storeFake.NewMockWatchHandle(controller)
+
+	var onUpdate store.UpdateFn
+	objectStore := storeFake.NewMockStore(controller)
+	objectStore.EXPECT().
+		RegisterOnUpdate(gomock.Any()).
+		Do(func(fn store.UpdateFn) {
+			onUpdate = fn
+		})
+	objectStore.EXPECT().
+		Watch(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(watch, nil).
+		Times(len(defaultWatchedKinds))
+
+	s := NewService(context.Background(), objectStore)
+	s.Watch("default")
+
+	// Watches on the replaced store are cancelled, and namespaces which are still
+	// watched are watched in the new store.
+	watch.EXPECT().Cancel().Times(len(defaultWatchedKinds))
+
+	watched := make(chan store.Key, len(defaultWatchedKinds))
+	newStore := storeFake.NewMockStore(controller)
+	newStore.EXPECT().
+		Watch(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, key store.Key, _ kcache.ResourceEventHandler) (store.WatchHandle, error) {
+			watched <- key
+			return storeFake.NewMockWatchHandle(controller), nil
+		}).
+		Times(len(defaultWatchedKinds))
+	onUpdate(newStore)
+
+	for range defaultWatchedKinds {
+		select {
+		case key := <-watched:
+			assert.Equal(t, "default", key.Namespace)
+		case <-time.After(wait.ForeverTestTimeout):
+			t.Fatal("expected the namespace to be watched in the new store")
+		}
+	}
+}
+
+func TestMuteRule_Matches(t *testing.T) {
+	key := store.Key{APIVersion: "apps/v1", Kind: "Deployment", Namespace: "default", Name: "nginx"}
+
+	assert.True(t, MuteRule{}.Matches(key))
+	assert.True(t, MuteRule{Namespace: "default"}.Matches(key))
+	assert.True(t, MuteRule{Namespace: "default", Kind: "Deployment", Name: "nginx"}.Matches(key))
+	assert.False(t, MuteRule{Namespace: "kube-system"}.Matches(key))
+	assert.False(t, MuteRule{Kind: "StatefulSet"}.Matches(key))
+	assert.False(t, MuteRule{Name: "redis"}.Matches(key))
+
+	assert.Equal(t, "default/*/nginx", MuteRule{Namespace: "default", Name: "nginx"}.String())
+}
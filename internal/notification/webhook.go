@@ -0,0 +1,497 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package notification
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	kcache "k8s.io/client-go/tools/cache"
+
+	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/internal/objectstatus"
+	"github.com/vmware-tanzu/octant/internal/persistence"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+//go:generate mockgen -destination=./fake/mock_webhook_manager.go -package=fake github.com/vmware-tanzu/octant/internal/notification WebhookManager
+
+const (
+	// webhookTimeout is how long a webhook receiver is waited on.
+	webhookTimeout = 10 * time.Second
+)
+
+// webhookStatuses are the statuses a rule can match. They are the statuses shown
+// in the resource viewer.
+var webhookStatuses = map[string]bool{
+	string(component.NodeStatusOK):      true,
+	string(component.NodeStatusWarning): true,
+	string(component.NodeStatusError):   true,
+}
+
+// WebhookRule sends a webhook when a matching object's status changes. Blank
+// fields match anything, so a rule without a namespace matches objects in every
+// namespace and a rule without from or to statuses matches every change.
+type WebhookRule struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Namespace  string `json:"namespace,omitempty"`
+	Selector   string `json:"selector,omitempty"`
+	From       string `json:"from,omitempty"`
+	To         string `json:"to,omitempty"`
+	URL        string `json:"url"`
+}
+
+// Validate returns an error if the rule can't be watched or sent.
+func (r WebhookRule) Validate() error {
+	if r.APIVersion == "" || r.Kind == "" {
+		return fmt.Errorf("api version and kind are required")
+	}
+
+	u, err := url.Parse(r.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("url %q is not an http or https url", r.URL)
+	}
+
+	if _, err := labels.Parse(r.Selector); err != nil {
+		return fmt.Errorf("parse selector: %w", err)
+	}
+
+	for _, status := range []string{r.From, r.To} {
+		if status != "" && !webhookStatuses[status] {
+			return fmt.Errorf("status %q is not ok, warning, or error", status)
+		}
+	}
+
+	return nil
+}
+
+// Transition describes the status changes the rule matches.
+func (r WebhookRule) Transition() string {
+	from, to := r.From, r.To
+	if from == "" {
+		from = "any"
+	}
+	if to == "" {
+		to = "any"
+	}
+	return fmt.Sprintf("%s → %s", from, to)
+}
+
+// matches returns true if an object's status change matches the rule.
+func (r WebhookRule) matches(object *unstructured.Unstructured, from, to component.NodeStatus) bool {
+	if r.Selector != "" {
+		selector, err := labels.Parse(r.Selector)
+		if err != nil || !selector.Matches(labels.Set(object.GetLabels())) {
+			return false
+		}
+	}
+
+	if r.From != "" && r.From != string(from) {
+		return false
+	}
+	if r.To != "" && r.To != string(to) {
+		return false
+	}
+
+	return true
+}
+
+// WebhookManager manages the rules webhooks are sent for.
+type WebhookManager interface {
+	// Rules returns the rules sorted by name.
+	Rules() []WebhookRule
+	// AddRule validates and adds a rule. The added rule is returned with its ID.
+	AddRule(rule WebhookRule) (WebhookRule, error)
+	// RemoveRule removes the rule with an ID.
+	RemoveRule(id string) error
+}
+
+// webhookPayload is compatible with Slack incoming webhooks. Other receivers can
+// read the object fields.
+type webhookPayload struct {
+	Text       string `json:"text"`
+	Rule       string `json:"rule"`
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Namespace  string `json:"namespace,omitempty"`
+	Name       string `json:"name"`
+	From       string `json:"from"`
+	To         string `json:"to"`
+}
+
+// Webhooks is a WebhookManager which watches the objects of each rule in the
+// object store and posts to the rule's URL when a matching object's status
+// changes. Rules are saved to a document so they survive restarts.
+type Webhooks struct {
+	ctx         context.Context
+	objectStore store.Store
+	document    persistence.Document
+	client      *http.Client
+
+	rules   map[string]WebhookRule
+	watches map[string]store.WatchHandle
+	// versions are the last resource versions seen by each rule, by object UID.
+	versions map[string]map[types.UID]string
+	// generation counts the times the object store has been replaced, so
+	// watches added to a replaced store can be discarded.
+	generation int
+
+	mu sync.Mutex
+}
+
+var _ WebhookManager = (*Webhooks)(nil)
+
+// NewWebhooks creates an instance of Webhooks. Rules are loaded from document
+// and watched. Rules are kept in memory if document is nil.
+func NewWebhooks(ctx context.Context, objectStore store.Store, document persistence.Document) *Webhooks {
+	w := &Webhooks{
+		ctx:         ctx,
+		objectStore: objectStore,
+		document:    document,
+		client:      &http.Client{Timeout: webhookTimeout},
+		rules:       map[string]WebhookRule{},
+		watches:     map[string]store.WatchHandle{},
+		versions:    map[string]map[types.UID]string{},
+	}
+
+	logger := log.From(ctx).With("context", "notification.Webhooks")
+
+	rules, err := loadWebhookRules(document)
+	if err != nil {
+		logger.WithErr(err).Errorf("load webhook rules")
+	}
+	for _, rule := range rules {
+		w.rules[rule.ID] = rule
+	}
+
+	if objectStore == nil {
+		return w
+	}
+
+	// Watches belong to the store they were added to, so they have to be added
+	// again when the store is replaced after a context change.
+	objectStore.RegisterOnUpdate(func(objectStore store.Store) {
+		w.mu.Lock()
+		for id, watch := range w.watches {
+			watch.Cancel()
+			delete(w.watches, id)
+		}
+		w.objectStore = objectStore
+		w.versions = map[string]map[types.UID]string{}
+		w.generation++
+		w.mu.Unlock()
+
+		go w.watchAll()
+	})
+
+	// Watching waits for informers to sync, so it shouldn't hold up startup.
+	go w.watchAll()
+
+	return w
+}
+
+// watchAll watches the objects of every rule which isn't being watched.
+func (w *Webhooks) watchAll() {
+	w.mu.Lock()
+	var rules []WebhookRule
+	for _, rule := range w.rules {
+		if _, ok := w.watches[rule.ID]; !ok {
+			rules = append(rules, rule)
+		}
+	}
+	w.mu.Unlock()
+
+	for _, rule := range rules {
+		w.watch(rule)
+	}
+}
+
+// Rules returns the rules sorted by name.
+func (w *Webhooks) Rules() []WebhookRule {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return w.sortedRules()
+}
+
+// AddRule validates, saves, and watches a rule.
+func (w *Webhooks) AddRule(rule WebhookRule) (WebhookRule, error) {
+	if err := rule.Validate(); err != nil {
+		return WebhookRule{}, err
+	}
+
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return WebhookRule{}, err
+	}
+	rule.ID = id.String()
+	if rule.Name == "" {
+		rule.Name = rule.Kind
+	}
+
+	w.mu.Lock()
+	w.rules[rule.ID] = rule
+	if err := w.save(); err != nil {
+		delete(w.rules, rule.ID)
+		w.mu.Unlock()
+		return WebhookRule{}, err
+	}
+	w.mu.Unlock()
+
+	w.watch(rule)
+
+	return rule, nil
+}
+
+// RemoveRule stops watching and removes a rule.
+func (w *Webhooks) RemoveRule(id string) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	rule, ok := w.rules[id]
+	if !ok {
+		return fmt.Errorf("webhook rule %q does not exist", id)
+	}
+
+	delete(w.rules, id)
+	if err := w.save(); err != nil {
+		w.rules[id] = rule
+		return err
+	}
+
+	if watch, ok := w.watches[id]; ok {
+		watch.Cancel()
+		delete(w.watches, id)
+	}
+	delete(w.versions, id)
+
+	return nil
+}
+
+// watch watches the objects of a rule. It must be called without the lock held
+// because adding a watch waits for the store's informers to sync.
+func (w *Webhooks) watch(rule WebhookRule) {
+	w.mu.Lock()
+	objectStore, generation := w.objectStore, w.generation
+	w.mu.Unlock()
+
+	if objectStore == nil {
+		return
+	}
+
+	handler := kcache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			w.updated(rule, oldObj, newObj)
+		},
+		DeleteFunc: func(obj interface{}) {
+			w.deleted(rule, obj)
+		},
+	}
+
+	key := store.Key{APIVersion: rule.APIVersion, Kind: rule.Kind, Namespace: rule.Namespace}
+	watch, err := objectStore.Watch(w.ctx, key, handler)
+	if err != nil {
+		log.From(w.ctx).WithErr(err).With("rule", rule.Name).Errorf("unable to watch %s for webhooks", rule.Kind)
+		return
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	// The rule may have been removed, watched by another caller, or the store
+	// replaced while the watch was being added.
+	_, exists := w.rules[rule.ID]
+	_, watched := w.watches[rule.ID]
+	if !exists || watched || generation != w.generation {
+		watch.Cancel()
+		return
+	}
+
+	w.watches[rule.ID] = watch
+}
+
+func (w *Webhooks) updated(rule WebhookRule, oldObj, newObj interface{}) {
+	oldU, ok := oldObj.(*unstructured.Unstructured)
+	if !ok {
+		return
+	}
+	newU, ok := newObj.(*unstructured.Unstructured)
+	if !ok {
+		return
+	}
+
+	if oldU.GetResourceVersion() == newU.GetResourceVersion() || newU.GetDeletionTimestamp() != nil {
+		return
+	}
+
+	w.mu.Lock()
+	if _, ok := w.rules[rule.ID]; !ok || w.versions[rule.ID][newU.GetUID()] == newU.GetResourceVersion() {
+		w.mu.Unlock()
+		return
+	}
+	if w.versions[rule.ID] == nil {
+		w.versions[rule.ID] = map[types.UID]string{}
+	}
+	w.versions[rule.ID][newU.GetUID()] = newU.GetResourceVersion()
+	objectStore := w.objectStore
+	w.mu.Unlock()
+
+	oldStatus, err := objectstatus.Status(w.ctx, oldU, objectStore)
+	if err != nil {
+		return
+	}
+	newStatus, err := objectstatus.Status(w.ctx, newU, objectStore)
+	if err != nil {
+		return
+	}
+
+	from, to := oldStatus.Status(), newStatus.Status()
+	if from == to || !rule.matches(newU, from, to) {
+		return
+	}
+
+	name := newU.GetName()
+	if newU.GetNamespace() != "" {
+		name = fmt.Sprintf("%s/%s", newU.GetNamespace(), name)
+	}
+	text := withDetail(fmt.Sprintf("%s %s changed from %s to %s", newU.GetKind(), name, from, to), statusDetail(newStatus))
+
+	go w.send(rule, webhookPayload{
+		Text:       fmt.Sprintf("[%s] %s", rule.Name, text),
+		Rule:       rule.Name,
+		APIVersion: newU.GetAPIVersion(),
+		Kind:       newU.GetKind(),
+		Namespace:  newU.GetNamespace(),
+		Name:       newU.GetName(),
+		From:       string(from),
+		To:         string(to),
+	})
+}
+
+// deleted forgets the resource version seen for a deleted object.
+func (w *Webhooks) deleted(rule WebhookRule, obj interface{}) {
+	if tombstone, ok := obj.(kcache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+
+	object, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	delete(w.versions[rule.ID], object.GetUID())
+}
+
+// send posts a payload to a rule's URL. Failures are logged because nobody is
+// waiting on the result.
+func (w *Webhooks) send(rule WebhookRule, payload webhookPayload) {
+	logger := log.From(w.ctx).With("context", "notification.Webhooks", "rule", rule.Name)
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		logger.WithErr(err).Errorf("marshal webhook payload")
+		return
+	}
+
+	req, err := http.NewRequestWithContext(w.ctx, http.MethodPost, rule.URL, bytes.NewReader(data))
+	if err != nil {
+		logger.WithErr(err).Errorf("create webhook request")
+		return
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		logger.WithErr(err).Errorf("send webhook")
+		return
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		logger.Errorf("webhook receiver returned %s", resp.Status)
+	}
+}
+
+// save saves the rules to the document. It must be called with the lock held.
+func (w *Webhooks) save() error {
+	if w.document == nil {
+		return nil
+	}
+
+	data, err := json.MarshalIndent(w.sortedRules(), "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := w.document.Save(data); err != nil {
+		return fmt.Errorf("save webhook rules: %w", err)
+	}
+
+	return nil
+}
+
+func (w *Webhooks) sortedRules() []WebhookRule {
+	rules := make([]WebhookRule, 0, len(w.rules))
+	for _, rule := range w.rules {
+		rules = append(rules, rule)
+	}
+
+	sort.Slice(rules, func(i, j int) bool {
+		a, b := strings.ToLower(rules[i].Name), strings.ToLower(rules[j].Name)
+		if a != b {
+			return a < b
+		}
+		return rules[i].ID < rules[j].ID
+	})
+
+	return rules
+}
+
+// loadWebhookRules loads the rules saved to a document. Invalid rules are skipped.
+func loadWebhookRules(document persistence.Document) ([]WebhookRule, error) {
+	if document == nil {
+		return nil, nil
+	}
+
+	data, err := document.Load()
+	if err != nil || data == nil {
+		return nil, err
+	}
+
+	var rules []WebhookRule
+	if err := json.Unmarshal(data, &rules); err != nil {
+		return nil, fmt.Errorf("decode webhook rules: %w", err)
+	}
+
+	var valid []WebhookRule
+	for _, rule := range rules {
+		if rule.ID == "" || rule.Validate() != nil {
+			continue
+		}
+		valid = append(valid, rule)
+	}
+
+	return valid, nil
+}
@@ -0,0 +1,229 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	kcache "k8s.io/client-go/tools/cache"
+
+	"github.com/vmware-tanzu/octant/internal/persistence"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	storeFake "github.com/vmware-tanzu/octant/pkg/store/fake"
+)
+
+// webhookReceiver starts a server which sends the payloads posted to it to a channel.
+func webhookReceiver(t *testing.T) (*httptest.Server, <-chan webhookPayload) {
+	ch := make(chan webhookPayload, 10)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+		var payload webhookPayload
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+		ch <- payload
+	}))
+
+	return server, ch
+}
+
+func receivePayload(t *testing.T, ch <-chan webhookPayload) webhookPayload {
+	select {
+	case payload := <-ch:
+		return payload
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected a webhook")
+		return webhookPayload{}
+	}
+}
+
+func TestWebhookRule_Validate(t *testing.T) {
+	valid := WebhookRule{APIVersion: "apps/v1", Kind: "Deployment", URL: "https://hooks.example.com/services/1"}
+	require.NoError(t, valid.Validate())
+
+	tests := []struct {
+		name   string
+		modify func(rule *WebhookRule)
+	}{
+		{name: "missing kind", modify: func(rule *WebhookRule) { rule.Kind = "" }},
+		{name: "invalid url", modify: func(rule *WebhookRule) { rule.URL = "hooks.example.com" }},
+		{name: "invalid selector", modify: func(rule *WebhookRule) { rule.Selector = "app in (" }},
+		{name: "invalid status", modify: func(rule *WebhookRule) { rule.To = "broken" }},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rule := valid
+			test.modify(&rule)
+			assert.Error(t, rule.Validate())
+		})
+	}
+}
+
+func TestWebhookRule_Transition(t *testing.T) {
+	assert.Equal(t, "any → error", WebhookRule{To: "error"}.Transition())
+	assert.Equal(t, "ok → warning", WebhookRule{From: "ok", To: "warning"}.Transition())
+}
+
+func TestWebhooks_rules(t *testing.T) {
+	document := persistence.NewFileDocument(afero.NewMemMapFs(), "/state/webhooks.json")
+
+	w := NewWebhooks(context.Background(), nil, document)
+
+	_, err := w.AddRule(WebhookRule{Kind: "Deployment"})
+	require.Error(t, err)
+
+	web, err := w.AddRule(WebhookRule{Name: "web", APIVersion: "apps/v1", Kind: "Deployment", URL: "https://hooks.example.com/web"})
+	require.NoError(t, err)
+	assert.NotEmpty(t, web.ID)
+
+	db, err := w.AddRule(WebhookRule{APIVersion: "apps/v1", Kind: "StatefulSet", To: "error", URL: "https://hooks.example.com/db"})
+	require.NoError(t, err)
+	assert.Equal(t, "StatefulSet", db.Name)
+
+	assert.Equal(t, []WebhookRule{db, web}, w.Rules())
+
+	// Rules are loaded from the document.
+	assert.Equal(t, []WebhookRule{db, web}, NewWebhooks(context.Background(), nil, document).Rules())
+
+	require.NoError(t, w.RemoveRule(db.ID))
+	require.Error(t, w.RemoveRule(db.ID))
+
+	assert.Equal(t, []WebhookRule{web}, NewWebhooks(context.Background(), nil, document).Rules())
+}
+
+func TestWebhooks_statusChange(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	server, ch := webhookReceiver(t)
+	defer server.Close()
+
+	document := persistence.NewFileDocument(afero.NewMemMapFs(), "/state/webhooks.json")
+	saved := NewWebhooks(context.Background(), nil, document)
+	rule, err := saved.AddRule(WebhookRule{Name: "nginx down", APIVersion: "apps/v1", Kind: "Deployment", Namespace: "namespace", Selector: "app=nginx", To: "error", URL: server.URL})
+	require.NoError(t, err)
+
+	handlers := make(chan kcache.ResourceEventHandler, 1)
+	watch := storeFake.NewMockWatchHandle(controller)
+
+	objectStore := storeFake.NewMockStore(controller)
+	objectStore.EXPECT().RegisterOnUpdate(gomock.Any())
+	objectStore.EXPECT().
+		Watch(gomock.Any(), store.Key{APIVersion: "apps/v1", Kind: "Deployment", Namespace: "namespace"}, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ store.Key, handler kcache.ResourceEventHandler) (store.WatchHandle, error) {
+			handlers <- handler
+			return watch, nil
+		})
+
+	w := NewWebhooks(context.Background(), objectStore, document)
+
+	var handler kcache.ResourceEventHandler
+	select {
+	case handler = <-handlers:
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected the rule to be watched")
+	}
+
+	labelled := func(resourceVersion string, replicas, available int32) interface{} {
+		d := deployment(t, resourceVersion, replicas, available)
+		d.SetLabels(map[string]string{"app": "nginx"})
+		return d
+	}
+
+	handler.OnUpdate(labelled("1", 3, 3), labelled("2", 3, 0))
+
+	payload := receivePayload(t, ch)
+	assert.Equal(t, webhookPayload{
+		Text:       "[nginx down] Deployment namespace/nginx changed from ok to error: No replicas exist for this deployment",
+		Rule:       "nginx down",
+		APIVersion: "apps/v1",
+		Kind:       "Deployment",
+		Namespace:  "namespace",
+		Name:       "nginx",
+		From:       "ok",
+		To:         "error",
+	}, payload)
+
+	// Transitions the rule doesn't match and objects the selector doesn't match
+	// are quiet.
+	handler.OnUpdate(labelled("2", 3, 0), labelled("3", 3, 3))
+	handler.OnUpdate(deployment(t, "3", 3, 3), deployment(t, "4", 3, 0))
+
+	handler.OnUpdate(labelled("4", 3, 3), labelled("5", 3, 0))
+	payload = receivePayload(t, ch)
+	assert.Equal(t, "error", payload.To)
+	assert.Empty(t, ch)
+
+	// Versions are forgotten when objects are deleted and when rules are removed.
+	handler.OnDelete(labelled("5", 3, 0))
+	w.mu.Lock()
+	assert.Empty(t, w.versions[rule.ID])
+	w.mu.Unlock()
+
+	handler.OnUpdate(labelled("5", 3, 0), labelled("6", 3, 3))
+	watch.EXPECT().Cancel()
+	require.NoError(t, w.RemoveRule(rule.ID))
+	w.mu.Lock()
+	assert.NotContains(t, w.versions, rule.ID)
+	w.mu.Unlock()
+}
+
+func TestWebhooks_watchOfRemovedRule(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	document := persistence.NewFileDocument(afero.NewMemMapFs(), "/state/webhooks.json")
+	saved := NewWebhooks(context.Background(), nil, document)
+	rule, err := saved.AddRule(WebhookRule{APIVersion: "apps/v1", Kind: "Deployment", URL: "https://hooks.example.com/web"})
+	require.NoError(t, err)
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	cancelled := make(chan struct{})
+
+	watch := storeFake.NewMockWatchHandle(controller)
+	watch.EXPECT().Cancel().Do(func() { close(cancelled) })
+
+	objectStore := storeFake.NewMockStore(controller)
+	objectStore.EXPECT().RegisterOnUpdate(gomock.Any())
+	objectStore.EXPECT().
+		Watch(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ store.Key, _ kcache.ResourceEventHandler) (store.WatchHandle, error) {
+			close(started)
+			<-release
+			return watch, nil
+		})
+
+	w := NewWebhooks(context.Background(), objectStore, document)
+
+	select {
+	case <-started:
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected the rule to be watched")
+	}
+
+	// Rules can be removed while a watch is being added, and the watch is
+	// cancelled once it is added.
+	require.NoError(t, w.RemoveRule(rule.ID))
+	close(release)
+
+	select {
+	case <-cancelled:
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected the watch to be cancelled")
+	}
+}
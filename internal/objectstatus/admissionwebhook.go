@@ -0,0 +1,158 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package objectstatus
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+// validatingWebhookConfiguration creates status for an admissionregistration.k8s.io/v1
+// validating webhook configuration.
+func validatingWebhookConfiguration(ctx context.Context, object runtime.Object, o store.Store) (ObjectStatus, error) {
+	if object == nil {
+		return ObjectStatus{}, errors.Errorf("validating webhook configuration is nil")
+	}
+
+	configuration, ok := object.(*admissionregistrationv1.ValidatingWebhookConfiguration)
+	if !ok {
+		configuration = &admissionregistrationv1.ValidatingWebhookConfiguration{}
+		if err := scheme.Scheme.Convert(object, configuration, 0); err != nil {
+			return ObjectStatus{}, errors.Wrap(err, "convert object to validating webhook configuration")
+		}
+	}
+
+	status := ObjectStatus{}
+	for _, webhook := range configuration.Webhooks {
+		if err := admissionWebhookStatus(ctx, o, webhook.Name, webhook.ClientConfig, webhook.FailurePolicy, &status); err != nil {
+			return ObjectStatus{}, err
+		}
+	}
+
+	if len(status.Details) == 0 {
+		status.AddDetail("Webhooks are OK")
+	}
+
+	return status, nil
+}
+
+// mutatingWebhookConfiguration creates status for an admissionregistration.k8s.io/v1
+// mutating webhook configuration.
+func mutatingWebhookConfiguration(ctx context.Context, object runtime.Object, o store.Store) (ObjectStatus, error) {
+	if object == nil {
+		return ObjectStatus{}, errors.Errorf("mutating webhook configuration is nil")
+	}
+
+	configuration, ok := object.(*admissionregistrationv1.MutatingWebhookConfiguration)
+	if !ok {
+		configuration = &admissionregistrationv1.MutatingWebhookConfiguration{}
+		if err := scheme.Scheme.Convert(object, configuration, 0); err != nil {
+			return ObjectStatus{}, errors.Wrap(err, "convert object to mutating webhook configuration")
+		}
+	}
+
+	status := ObjectStatus{}
+	for _, webhook := range configuration.Webhooks {
+		if err := admissionWebhookStatus(ctx, o, webhook.Name, webhook.ClientConfig, webhook.FailurePolicy, &status); err != nil {
+			return ObjectStatus{}, err
+		}
+	}
+
+	if len(status.Details) == 0 {
+		status.AddDetail("Webhooks are OK")
+	}
+
+	return status, nil
+}
+
+// AdmissionWebhook creates status for the service backing an admission webhook.
+// A webhook which can't be reached is an error if its failure policy rejects
+// requests, and a warning otherwise.
+func AdmissionWebhook(ctx context.Context, o store.Store, name string, clientConfig admissionregistrationv1.WebhookClientConfig, failurePolicy *admissionregistrationv1.FailurePolicyType) (ObjectStatus, error) {
+	status := ObjectStatus{}
+	if err := admissionWebhookStatus(ctx, o, name, clientConfig, failurePolicy, &status); err != nil {
+		return ObjectStatus{}, err
+	}
+
+	if len(status.Details) == 0 {
+		status.AddDetail("Webhook is OK")
+	}
+
+	return status, nil
+}
+
+func admissionWebhookStatus(ctx context.Context, o store.Store, name string, clientConfig admissionregistrationv1.WebhookClientConfig, failurePolicy *admissionregistrationv1.FailurePolicyType, status *ObjectStatus) error {
+	if clientConfig.Service == nil {
+		return nil
+	}
+
+	if o == nil {
+		return errors.New("admission webhook status requires a non nil objectStore")
+	}
+
+	setStatus := status.SetError
+	if failurePolicy != nil && *failurePolicy == admissionregistrationv1.Ignore {
+		setStatus = status.SetWarning
+	}
+
+	serviceRef := clientConfig.Service
+
+	key := store.Key{
+		Namespace:  serviceRef.Namespace,
+		APIVersion: "v1",
+		Kind:       "Service",
+		Name:       serviceRef.Name,
+	}
+
+	service := &corev1.Service{}
+
+	found, err := store.GetAs(ctx, o, key, service)
+	if err != nil {
+		return errors.Wrapf(err, "get service for webhook %s", name)
+	}
+
+	if !found {
+		setStatus()
+		status.AddDetailf("Webhook %q refers to service %s/%s which doesn't exist",
+			name, serviceRef.Namespace, serviceRef.Name)
+		return nil
+	}
+
+	if service.Spec.Type == corev1.ServiceTypeExternalName {
+		return nil
+	}
+
+	key.Kind = "Endpoints"
+
+	endpoints := &corev1.Endpoints{}
+
+	found, err = store.GetAs(ctx, o, key, endpoints)
+	if err != nil {
+		return errors.Wrapf(err, "get endpoints for webhook %s", name)
+	}
+
+	addressCount := 0
+	if found {
+		for _, subset := range endpoints.Subsets {
+			addressCount += len(subset.Addresses)
+		}
+	}
+
+	if addressCount == 0 {
+		setStatus()
+		status.AddDetailf("Webhook %q service %s/%s has no ready endpoints",
+			name, serviceRef.Namespace, serviceRef.Name)
+	}
+
+	return nil
+}
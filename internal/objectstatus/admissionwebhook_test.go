@@ -0,0 +1,138 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package objectstatus
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	storefake "github.com/vmware-tanzu/octant/pkg/store/fake"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func Test_validatingWebhookConfiguration(t *testing.T) {
+	serviceKey := store.Key{Namespace: "default", APIVersion: "v1", Kind: "Service", Name: "stateful"}
+	endpointsKey := store.Key{Namespace: "default", APIVersion: "v1", Kind: "Endpoints", Name: "stateful"}
+
+	ignore := admissionregistrationv1.Ignore
+
+	newConfiguration := func(failurePolicy *admissionregistrationv1.FailurePolicyType) runtime.Object {
+		configuration := testutil.CreateValidatingWebhookConfiguration("configuration")
+		configuration.Webhooks = []admissionregistrationv1.ValidatingWebhook{
+			{
+				Name: "webhook.example.com",
+				ClientConfig: admissionregistrationv1.WebhookClientConfig{
+					Service: &admissionregistrationv1.ServiceReference{Namespace: "default", Name: "stateful"},
+				},
+				FailurePolicy: failurePolicy,
+			},
+		}
+		return configuration
+	}
+
+	cases := []struct {
+		name     string
+		init     func(*testing.T, *storefake.MockStore) runtime.Object
+		expected ObjectStatus
+		isErr    bool
+	}{
+		{
+			name: "in general",
+			init: func(t *testing.T, o *storefake.MockStore) runtime.Object {
+				service := testutil.LoadObjectFromFile(t, "service_ok.yaml")
+				o.EXPECT().Get(gomock.Any(), gomock.Eq(serviceKey)).
+					Return(testutil.ToUnstructured(t, service), nil)
+
+				endpoints := testutil.LoadObjectFromFile(t, "endpoints_ok.yaml")
+				o.EXPECT().Get(gomock.Any(), gomock.Eq(endpointsKey)).
+					Return(testutil.ToUnstructured(t, endpoints), nil)
+
+				return newConfiguration(nil)
+			},
+			expected: ObjectStatus{
+				Details: []component.Component{component.NewText("Webhooks are OK")},
+			},
+		},
+		{
+			name: "service does not exist",
+			init: func(t *testing.T, o *storefake.MockStore) runtime.Object {
+				o.EXPECT().Get(gomock.Any(), gomock.Eq(serviceKey)).Return(nil, nil)
+
+				return newConfiguration(nil)
+			},
+			expected: ObjectStatus{
+				nodeStatus: component.NodeStatusError,
+				Details: []component.Component{
+					component.NewText(`Webhook "webhook.example.com" refers to service default/stateful which doesn't exist`),
+				},
+			},
+		},
+		{
+			name: "no ready endpoints with ignore failure policy",
+			init: func(t *testing.T, o *storefake.MockStore) runtime.Object {
+				service := testutil.LoadObjectFromFile(t, "service_ok.yaml")
+				o.EXPECT().Get(gomock.Any(), gomock.Eq(serviceKey)).
+					Return(testutil.ToUnstructured(t, service), nil)
+
+				endpoints := testutil.LoadObjectFromFile(t, "endpoints_no_subsets.yaml")
+				o.EXPECT().Get(gomock.Any(), gomock.Eq(endpointsKey)).
+					Return(testutil.ToUnstructured(t, endpoints), nil)
+
+				return newConfiguration(&ignore)
+			},
+			expected: ObjectStatus{
+				nodeStatus: component.NodeStatusWarning,
+				Details: []component.Component{
+					component.NewText(`Webhook "webhook.example.com" service default/stateful has no ready endpoints`),
+				},
+			},
+		},
+		{
+			name: "object is nil",
+			init: func(t *testing.T, o *storefake.MockStore) runtime.Object {
+				return nil
+			},
+			isErr: true,
+		},
+		{
+			name: "object is not a validating webhook configuration",
+			init: func(t *testing.T, o *storefake.MockStore) runtime.Object {
+				return &unstructured.Unstructured{}
+			},
+			isErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			o := storefake.NewMockStore(controller)
+
+			object := tc.init(t, o)
+
+			ctx := context.Background()
+			status, err := validatingWebhookConfiguration(ctx, object, o)
+			if tc.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expected, status)
+		})
+	}
+}
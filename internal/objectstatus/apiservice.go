@@ -24,10 +24,12 @@ func apiService(_ context.Context, object runtime.Object, _ store.Store) (Object
 		return ObjectStatus{}, errors.Errorf("apiservice is nil")
 	}
 
-	apiService := &apiregistrationv1.APIService{}
-
-	if err := scheme.Scheme.Convert(object, apiService, 0); err != nil {
-		return ObjectStatus{}, errors.Wrap(err, "convert object to apiregistration.k8s.io/v1 apiservice")
+	apiService, ok := object.(*apiregistrationv1.APIService)
+	if !ok {
+		apiService = &apiregistrationv1.APIService{}
+		if err := scheme.Scheme.Convert(object, apiService, 0); err != nil {
+			return ObjectStatus{}, errors.Wrap(err, "convert object to apiregistration.k8s.io/v1 apiservice")
+		}
 	}
 
 	var availableCondition *apiregistrationv1.APIServiceCondition
@@ -22,10 +22,12 @@ func cronJob(_ context.Context, object runtime.Object, _ store.Store) (ObjectSta
 		return ObjectStatus{}, errors.Errorf("cronjob is nil")
 	}
 
-	cronjob := &batchv1beta1.CronJob{}
-
-	if err := scheme.Scheme.Convert(object, cronjob, 0); err != nil {
-		return ObjectStatus{}, errors.Wrap(err, "convert object to batch/v1beta1 cronjob")
+	cronjob, ok := object.(*batchv1beta1.CronJob)
+	if !ok {
+		cronjob = &batchv1beta1.CronJob{}
+		if err := scheme.Scheme.Convert(object, cronjob, 0); err != nil {
+			return ObjectStatus{}, errors.Wrap(err, "convert object to batch/v1beta1 cronjob")
+		}
 	}
 
 	if cronjob.Spec.Suspend != nil && *cronjob.Spec.Suspend {
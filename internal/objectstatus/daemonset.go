@@ -22,10 +22,12 @@ func daemonSet(_ context.Context, object runtime.Object, _ store.Store) (ObjectS
 		return ObjectStatus{}, errors.Errorf("daemon set is nil")
 	}
 
-	ds := &appsv1.DaemonSet{}
-
-	if err := scheme.Scheme.Convert(object, ds, 0); err != nil {
-		return ObjectStatus{}, errors.Wrap(err, "convert object to daemon set")
+	ds, ok := object.(*appsv1.DaemonSet)
+	if !ok {
+		ds = &appsv1.DaemonSet{}
+		if err := scheme.Scheme.Convert(object, ds, 0); err != nil {
+			return ObjectStatus{}, errors.Wrap(err, "convert object to daemon set")
+		}
 	}
 
 	status := ds.Status
@@ -25,10 +25,12 @@ func deploymentAppsV1(_ context.Context, object runtime.Object, _ store.Store) (
 		return ObjectStatus{}, errors.Errorf("deployment is nil")
 	}
 
-	deployment := &appsv1.Deployment{}
-
-	if err := scheme.Scheme.Convert(object, deployment, 0); err != nil {
-		return ObjectStatus{}, errors.Wrap(err, "convert object to apps/v1 deployment")
+	deployment, ok := object.(*appsv1.Deployment)
+	if !ok {
+		deployment = &appsv1.Deployment{}
+		if err := scheme.Scheme.Convert(object, deployment, 0); err != nil {
+			return ObjectStatus{}, errors.Wrap(err, "convert object to apps/v1 deployment")
+		}
 	}
 
 	status := deployment.Status
@@ -39,6 +41,11 @@ func deploymentAppsV1(_ context.Context, object runtime.Object, _ store.Store) (
 			nodeStatus: component.NodeStatusError,
 			Details:    []component.Component{component.NewText("No replicas exist for this deployment")},
 		}, nil
+	case deployment.Spec.Paused:
+		return ObjectStatus{
+			nodeStatus: component.NodeStatusWarning,
+			Details:    []component.Component{component.NewText("Deployment rollout is paused")},
+		}, nil
 	case status.Replicas == status.AvailableReplicas:
 		return ObjectStatus{
 			nodeStatus: component.NodeStatusOK,
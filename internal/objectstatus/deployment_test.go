@@ -63,6 +63,20 @@ func Test_deploymentAppsV1(t *testing.T) {
 				Details:    []component.Component{component.NewText("Expected 1 replicas, but 0 are available")},
 			},
 		},
+		{
+			name: "paused",
+			init: func(t *testing.T, o *storeFake.MockStore) runtime.Object {
+				deployment := testutil.CreateDeployment("deployment")
+				deployment.Spec.Paused = true
+				deployment.Status.Replicas = 1
+				deployment.Status.AvailableReplicas = 1
+				return deployment
+			},
+			expected: ObjectStatus{
+				nodeStatus: component.NodeStatusWarning,
+				Details:    []component.Component{component.NewText("Deployment rollout is paused")},
+			},
+		},
 		{
 			name: "object is nil",
 			init: func(t *testing.T, o *storeFake.MockStore) runtime.Object {
@@ -29,9 +29,12 @@ func runIngressStatus(ctx context.Context, object runtime.Object, o store.Store)
 		return ObjectStatus{}, errors.Errorf("ingress is nil")
 	}
 
-	ingress := &networkingv1.Ingress{}
-	if err := scheme.Scheme.Convert(object, ingress, 0); err != nil {
-		return ObjectStatus{}, errors.Wrap(err, "convert object to ingress")
+	ingress, ok := object.(*networkingv1.Ingress)
+	if !ok {
+		ingress = &networkingv1.Ingress{}
+		if err := scheme.Scheme.Convert(object, ingress, 0); err != nil {
+			return ObjectStatus{}, errors.Wrap(err, "convert object to ingress")
+		}
 	}
 
 	is := ingressStatus{
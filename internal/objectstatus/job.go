@@ -22,10 +22,12 @@ func runJobStatus(_ context.Context, object runtime.Object, o store.Store) (Obje
 		return ObjectStatus{}, errors.Errorf("job is nil")
 	}
 
-	job := &batchv1.Job{}
-
-	if err := scheme.Scheme.Convert(object, job, 0); err != nil {
-		return ObjectStatus{}, errors.Wrap(err, "convert object to job")
+	job, ok := object.(*batchv1.Job)
+	if !ok {
+		job = &batchv1.Job{}
+		if err := scheme.Scheme.Convert(object, job, 0); err != nil {
+			return ObjectStatus{}, errors.Wrap(err, "convert object to job")
+		}
 	}
 
 	os := ObjectStatus{}
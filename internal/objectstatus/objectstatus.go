@@ -28,18 +28,20 @@ type statusLookup map[statusKey]statusFunc
 
 var (
 	defaultStatusLookup = statusLookup{
-		{apiVersion: "batch/v1beta1", kind: "CronJob"}:                cronJob,
-		{apiVersion: "apps/v1", kind: "DaemonSet"}:                    daemonSet,
-		{apiVersion: "apps/v1", kind: "Deployment"}:                   deploymentAppsV1,
-		{apiVersion: "apps/v1", kind: "ReplicaSet"}:                   replicaSetAppsV1,
-		{apiVersion: "apps/v1", kind: "StatefulSet"}:                  statefulSet,
-		{apiVersion: "batch/v1", kind: "Job"}:                         runJobStatus,
-		{apiVersion: "v1", kind: "Pod"}:                               pod,
-		{apiVersion: "v1", kind: "ReplicationController"}:             replicationController,
-		{apiVersion: "v1", kind: "Service"}:                           service,
-		{apiVersion: "v1", kind: "PersistentVolume"}:                  persistentVolume,
-		{apiVersion: "networking.k8s.io/v1", kind: "Ingress"}:         runIngressStatus,
-		{apiVersion: "apiregistration.k8s.io/v1", kind: "APIService"}: apiService,
+		{apiVersion: "batch/v1beta1", kind: "CronJob"}:                                          cronJob,
+		{apiVersion: "apps/v1", kind: "DaemonSet"}:                                              daemonSet,
+		{apiVersion: "apps/v1", kind: "Deployment"}:                                             deploymentAppsV1,
+		{apiVersion: "apps/v1", kind: "ReplicaSet"}:                                             replicaSetAppsV1,
+		{apiVersion: "apps/v1", kind: "StatefulSet"}:                                            statefulSet,
+		{apiVersion: "batch/v1", kind: "Job"}:                                                   runJobStatus,
+		{apiVersion: "v1", kind: "Pod"}:                                                         pod,
+		{apiVersion: "v1", kind: "ReplicationController"}:                                       replicationController,
+		{apiVersion: "v1", kind: "Service"}:                                                     service,
+		{apiVersion: "v1", kind: "PersistentVolume"}:                                            persistentVolume,
+		{apiVersion: "networking.k8s.io/v1", kind: "Ingress"}:                                   runIngressStatus,
+		{apiVersion: "apiregistration.k8s.io/v1", kind: "APIService"}:                           apiService,
+		{apiVersion: "admissionregistration.k8s.io/v1", kind: "MutatingWebhookConfiguration"}:   mutatingWebhookConfiguration,
+		{apiVersion: "admissionregistration.k8s.io/v1", kind: "ValidatingWebhookConfiguration"}: validatingWebhookConfiguration,
 	}
 )
 
@@ -23,10 +23,12 @@ func persistentVolume(ctx context.Context, object runtime.Object, o store.Store)
 		return ObjectStatus{}, errors.Errorf("cronjob is nil")
 	}
 
-	pv := &corev1.PersistentVolume{}
-
-	if err := scheme.Scheme.Convert(object, pv, 0); err != nil {
-		return ObjectStatus{}, errors.Wrap(err, "convert object to v1 PersistentVolume")
+	pv, ok := object.(*corev1.PersistentVolume)
+	if !ok {
+		pv = &corev1.PersistentVolume{}
+		if err := scheme.Scheme.Convert(object, pv, 0); err != nil {
+			return ObjectStatus{}, errors.Wrap(err, "convert object to v1 PersistentVolume")
+		}
 	}
 
 	if pv.Spec.ClaimRef != nil {
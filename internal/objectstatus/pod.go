@@ -22,10 +22,12 @@ func pod(ctx context.Context, object runtime.Object, o store.Store) (ObjectStatu
 		return ObjectStatus{}, errors.Errorf("pod is nil")
 	}
 
-	pod := &corev1.Pod{}
-
-	if err := scheme.Scheme.Convert(object, pod, 0); err != nil {
-		return ObjectStatus{}, errors.Wrap(err, "convert object to pod")
+	pod, ok := object.(*corev1.Pod)
+	if !ok {
+		pod = &corev1.Pod{}
+		if err := scheme.Scheme.Convert(object, pod, 0); err != nil {
+			return ObjectStatus{}, errors.Wrap(err, "convert object to pod")
+		}
 	}
 
 	status := ObjectStatus{}
@@ -25,10 +25,12 @@ func replicaSetAppsV1(_ context.Context, object runtime.Object, _ store.Store) (
 		return ObjectStatus{}, errors.Errorf("replica set is nil")
 	}
 
-	replicaSet := &appsv1.ReplicaSet{}
-
-	if err := scheme.Scheme.Convert(object, replicaSet, 0); err != nil {
-		return ObjectStatus{}, errors.Wrap(err, "convert object to apps/v1 replica set")
+	replicaSet, ok := object.(*appsv1.ReplicaSet)
+	if !ok {
+		replicaSet = &appsv1.ReplicaSet{}
+		if err := scheme.Scheme.Convert(object, replicaSet, 0); err != nil {
+			return ObjectStatus{}, errors.Wrap(err, "convert object to apps/v1 replica set")
+		}
 	}
 
 	status := replicaSet.Status
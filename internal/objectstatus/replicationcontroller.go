@@ -22,10 +22,12 @@ func replicationController(_ context.Context, object runtime.Object, _ store.Sto
 		return ObjectStatus{}, errors.Errorf("replication controller is nil")
 	}
 
-	rc := &corev1.ReplicationController{}
-
-	if err := scheme.Scheme.Convert(object, rc, 0); err != nil {
-		return ObjectStatus{}, errors.Wrap(err, "convert object to replication controller")
+	rc, ok := object.(*corev1.ReplicationController)
+	if !ok {
+		rc = &corev1.ReplicationController{}
+		if err := scheme.Scheme.Convert(object, rc, 0); err != nil {
+			return ObjectStatus{}, errors.Wrap(err, "convert object to replication controller")
+		}
 	}
 
 	status := rc.Status
@@ -22,10 +22,12 @@ func service(ctx context.Context, object runtime.Object, o store.Store) (ObjectS
 		return ObjectStatus{}, errors.Errorf("service is nil")
 	}
 
-	service := &corev1.Service{}
-
-	if err := scheme.Scheme.Convert(object, service, 0); err != nil {
-		return ObjectStatus{}, errors.Wrap(err, "convert object to service")
+	service, ok := object.(*corev1.Service)
+	if !ok {
+		service = &corev1.Service{}
+		if err := scheme.Scheme.Convert(object, service, 0); err != nil {
+			return ObjectStatus{}, errors.Wrap(err, "convert object to service")
+		}
 	}
 
 	if service.Spec.ExternalName == "" {
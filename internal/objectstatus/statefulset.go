@@ -22,10 +22,12 @@ func statefulSet(_ context.Context, object runtime.Object, _ store.Store) (Objec
 		return ObjectStatus{}, errors.Errorf("stateful set is nil")
 	}
 
-	ss := &appsv1.StatefulSet{}
-
-	if err := scheme.Scheme.Convert(object, ss, 0); err != nil {
-		return ObjectStatus{}, errors.Wrap(err, "convert object to stateful set")
+	ss, ok := object.(*appsv1.StatefulSet)
+	if !ok {
+		ss = &appsv1.StatefulSet{}
+		if err := scheme.Scheme.Convert(object, ss, 0); err != nil {
+			return ObjectStatus{}, errors.Wrap(err, "convert object to stateful set")
+		}
 	}
 
 	status := ss.Status
@@ -139,6 +139,29 @@ func (c *seenGVKsCache) hasSeen(key string, groupVersionKind schema.GroupVersion
 	return seen
 }
 
+// keys returns a key for each namespace and group version kind which has been seen.
+func (c *seenGVKsCache) keys() []store.Key {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	var keys []store.Key
+	for namespace, groupVersionKinds := range c.seenGVKs {
+		for groupVersionKind, seen := range groupVersionKinds {
+			if !seen {
+				continue
+			}
+			apiVersion, kind := groupVersionKind.ToAPIVersionAndKind()
+			keys = append(keys, store.Key{
+				Namespace:  namespace,
+				APIVersion: apiVersion,
+				Kind:       kind,
+			})
+		}
+	}
+
+	return keys
+}
+
 func (c *seenGVKsCache) reset() {
 	c.mu.Lock()
 	defer c.mu.Unlock()
@@ -174,17 +197,35 @@ func (c *informerContextCache) delete(key schema.GroupVersionKind) {
 	defer c.mu.Unlock()
 
 	if stopCh, ok := c.cache[key]; ok {
-		close(stopCh)
+		closeStopCh(stopCh)
 		delete(c.cache, key)
 	}
 }
 
+// closeChild closes a child stop channel if it has not been closed.
+func (c *informerContextCache) closeChild(stopCh chan struct{}) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	closeStopCh(stopCh)
+}
+
 func (c *informerContextCache) reset() {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
 	for k, stopCh := range c.cache {
-		close(stopCh)
+		closeStopCh(stopCh)
 		delete(c.cache, k)
 	}
 }
+
+// closeStopCh closes stopCh unless it is already closed. Callers must hold
+// the informerContextCache lock.
+func closeStopCh(stopCh chan struct{}) {
+	select {
+	case <-stopCh:
+	default:
+		close(stopCh)
+	}
+}
@@ -3,6 +3,7 @@ package objectstore
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
@@ -93,6 +94,19 @@ func Test_seenGVKsCache(t *testing.T) {
 	}
 }
 
+func Test_seenGVKsCache_keys(t *testing.T) {
+	c := initSeenGVKsCache()
+	c.setSeen("test", gvk.Pod, true)
+	c.setSeen("", gvk.Deployment, true)
+	c.setSeen("", gvk.Service, false)
+
+	expected := []store.Key{
+		{Namespace: "test", APIVersion: "v1", Kind: "Pod"},
+		{Namespace: "", APIVersion: "apps/v1", Kind: "Deployment"},
+	}
+	require.ElementsMatch(t, expected, c.keys())
+}
+
 func Test_informerContextCache(t *testing.T) {
 	c := initInformerContextCache()
 
@@ -117,3 +131,32 @@ func Test_informerContextCache(t *testing.T) {
 	c.reset()
 	assert.Len(t, c.cache, 0)
 }
+
+func Test_informerContextCache_closeChild(t *testing.T) {
+	c := initInformerContextCache()
+
+	groupVersionKind := schema.GroupVersionKind{Group: "group", Version: "version", Kind: "resource"}
+	stopCh := c.addChild(groupVersionKind)
+
+	// closing a child which stopped on a watch error must not panic when the cache is reset.
+	c.closeChild(stopCh)
+	c.closeChild(stopCh)
+	c.reset()
+
+	_, ok := <-stopCh
+	assert.False(t, ok)
+}
+
+func Test_newInformerFactory_stop(t *testing.T) {
+	stopCh := make(chan struct{})
+	f := newInformerFactory(stopCh, nil, 0, "")
+
+	childCh := f.informerContextCache.addChild(schema.GroupVersionKind{Version: "v1", Kind: "Pod"})
+	close(stopCh)
+
+	select {
+	case <-childCh:
+	case <-time.After(time.Second):
+		t.Fatal("informer was not stopped with its factory")
+	}
+}
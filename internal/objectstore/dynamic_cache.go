@@ -25,13 +25,16 @@ import (
 	kLabels "k8s.io/apimachinery/pkg/labels"
 	kruntime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/informers"
 	kcache "k8s.io/client-go/tools/cache"
 	kretry "k8s.io/client-go/util/retry"
 	sigyaml "sigs.k8s.io/yaml"
 
 	"github.com/vmware-tanzu/octant/internal/cluster"
+	"github.com/vmware-tanzu/octant/internal/contentcache"
 	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/internal/util/kubernetes"
 	"github.com/vmware-tanzu/octant/pkg/store"
 )
 
@@ -50,6 +53,19 @@ func initInformerFactory(ctx context.Context, client cluster.ClientInterface, na
 // DynamicCacheOpt is an option for configuration DynamicCache.
 type DynamicCacheOpt func(*DynamicCache)
 
+// InformerResync sets the resync period for informers created by a DynamicCache.
+// A period less than one uses the default resync period.
+func InformerResync(resync time.Duration) DynamicCacheOpt {
+	return func(dc *DynamicCache) {
+		if resync < 1 {
+			return
+		}
+		dc.initFactoryFunc = func(ctx context.Context, client cluster.ClientInterface, namespace string) (InformerFactory, error) {
+			return newInformerFactory(ctx.Done(), client, resync, namespace), nil
+		}
+	}
+}
+
 // Access sets the Resource Access cache for a DynamicCache.
 func Access(resourceAccess ResourceAccess) DynamicCacheOpt {
 	return func(dc *DynamicCache) {
@@ -59,6 +75,7 @@ func Access(resourceAccess ResourceAccess) DynamicCacheOpt {
 
 // DynamicCache is a cache based on the dynamic shared informer factory.
 type DynamicCache struct {
+	ctx             context.Context
 	initFactoryFunc func(context.Context, cluster.ClientInterface, string) (InformerFactory, error)
 	factories       *factoriesCache
 	informerSynced  *informerSynced
@@ -68,6 +85,10 @@ type DynamicCache struct {
 	access          ResourceAccess
 	updateFns       []store.UpdateFn
 	updateMu        sync.Mutex
+	indexMu         sync.Mutex
+	watchRegistries *watchRegistries
+	// watchdogInterval is how often informers are checked for stale objects.
+	watchdogInterval time.Duration
 
 	syncTimeoutFunc func(context.Context, store.Key, chan bool)
 	waitForSyncFunc func(context.Context, store.Key, *DynamicCache, informers.GenericInformer, chan bool)
@@ -101,12 +122,15 @@ var _ store.Store = (*DynamicCache)(nil)
 // NewDynamicCache creates an instance of DynamicCache.
 func NewDynamicCache(ctx context.Context, client cluster.ClientInterface, options ...DynamicCacheOpt) (*DynamicCache, error) {
 	c := &DynamicCache{
-		initFactoryFunc: initInformerFactory,
-		syncTimeoutFunc: syncTimeout,
-		waitForSyncFunc: waitForSync,
-		client:          client,
-		seenGVKs:        initSeenGVKsCache(),
-		informerSynced:  initInformerSynced(),
+		ctx:              ctx,
+		initFactoryFunc:  initInformerFactory,
+		syncTimeoutFunc:  syncTimeout,
+		waitForSyncFunc:  waitForSync,
+		client:           client,
+		seenGVKs:         initSeenGVKsCache(),
+		informerSynced:   initInformerSynced(),
+		watchRegistries:  newWatchRegistries(),
+		watchdogInterval: defaultWatchdogInterval,
 	}
 
 	for _, option := range options {
@@ -118,13 +142,15 @@ func NewDynamicCache(ctx context.Context, client cluster.ClientInterface, option
 	c.factories = initFactoriesCache()
 	go initStatusCheck(ctx.Done(), logger, c.factories)
 
-	factory, err := c.initFactoryFunc(context.Background(), client, "")
+	factory, err := c.initFactoryFunc(ctx, client, "")
 	if err != nil {
 		return nil, fmt.Errorf("initialize dynamic shared informer factory: %w", err)
 	}
 
 	c.factories.set("", factory)
 
+	go c.runWatchdog(ctx)
+
 	return c, nil
 }
 
@@ -145,7 +171,8 @@ func (dc *DynamicCache) currentInformer(ctx context.Context, key store.Key) (inf
 	factory, ok := dc.factories.get(key.Namespace)
 	if !ok {
 		if err := dc.access.HasAccess(ctx, store.Key{Namespace: metav1.NamespaceAll}, "watch"); err != nil {
-			factory, err = dc.initFactoryFunc(ctx, dc.client, key.Namespace)
+			// factories live as long as the cache rather than the request.
+			factory, err = dc.initFactoryFunc(dc.ctx, dc.client, key.Namespace)
 			if err != nil {
 				return nil, false, fmt.Errorf("check access watch all namespaces: %w", err)
 			}
@@ -164,6 +191,15 @@ func (dc *DynamicCache) currentInformer(ctx context.Context, key store.Key) (inf
 		return nil, false, fmt.Errorf("find informer for %s: %w", gvk, err)
 	}
 
+	dc.indexMu.Lock()
+	if err := addOwnerUIDIndex(informer.Informer()); err != nil {
+		log.From(ctx).WithErr(err).With("gvk", gvk.String()).Debugf("add owner UID index")
+	}
+	if err := addLabelIndex(informer.Informer()); err != nil {
+		log.From(ctx).WithErr(err).With("gvk", gvk.String()).Debugf("add label index")
+	}
+	dc.indexMu.Unlock()
+
 	dc.checkKeySynced(ctx, informer, key)
 	dc.seenGVKs.setSeen(key.Namespace, gvk, true)
 
@@ -230,10 +266,14 @@ func (dc *DynamicCache) isBackingOff(ctx context.Context, key store.Key) bool {
 }
 
 // List lists objects.
-func (dc *DynamicCache) List(ctx context.Context, key store.Key) (*unstructured.UnstructuredList, bool, error) {
+func (dc *DynamicCache) List(ctx context.Context, key store.Key) (list *unstructured.UnstructuredList, loading bool, err error) {
 	ctx, span := trace.StartSpan(ctx, "dynamicCache:list")
 	defer span.End()
 
+	defer func() {
+		contentcache.RecordList(ctx, key, list, loading, err)
+	}()
+
 	if dc.isBackingOff(ctx, key) {
 		return &unstructured.UnstructuredList{}, false, nil
 	}
@@ -292,9 +332,22 @@ func (dc *DynamicCache) listFromInformer(ctx context.Context, key store.Key) (*u
 		}
 	}
 
-	objects, err := l.List(selector)
-	if err != nil {
-		return nil, false, fmt.Errorf("listing %v: %w", key, err)
+	indexer := informer.Informer().GetIndexer()
+
+	var objects []kruntime.Object
+	indexed := false
+	if key.OwnerUID != "" {
+		objects, err = listByOwner(indexer, key, selector)
+		indexed = err == nil
+	} else {
+		objects, indexed = listBySelector(indexer, key.Namespace, selector)
+	}
+	if !indexed {
+		objects, err = l.List(selector)
+		if err != nil {
+			return nil, false, fmt.Errorf("listing %v: %w", key, err)
+		}
+		objects = filterByOwner(objects, key.OwnerUID)
 	}
 
 	list := &unstructured.UnstructuredList{}
@@ -302,6 +355,9 @@ func (dc *DynamicCache) listFromInformer(ctx context.Context, key store.Key) (*u
 		list.Items = append(list.Items, *objects[i].(*unstructured.Unstructured))
 	}
 
+	// Informers store objects in a map, so they are listed in a different order each time.
+	kubernetes.SortObjects(list.Items)
+
 	return list, !dc.informerSynced.hasSynced(key), nil
 }
 
@@ -333,11 +389,25 @@ func (dc *DynamicCache) listFromDynamicClient(ctx context.Context, key store.Key
 	listOptions := metav1.ListOptions{
 		LabelSelector: selector.String(),
 	}
+	var list *unstructured.UnstructuredList
 	if key.Namespace == "" {
-		return dynamicClient.Resource(gvr).List(ctx, listOptions)
+		list, err = dynamicClient.Resource(gvr).List(ctx, listOptions)
+	} else {
+		list, err = dynamicClient.Resource(gvr).Namespace(key.Namespace).List(ctx, listOptions)
 	}
+	if err != nil || key.OwnerUID == "" {
+		return list, err
+	}
+
+	owned := list.Items[:0]
+	for i := range list.Items {
+		if isOwnedBy(&list.Items[i], key.OwnerUID) {
+			owned = append(owned, list.Items[i])
+		}
+	}
+	list.Items = owned
 
-	return dynamicClient.Resource(gvr).Namespace(key.Namespace).List(ctx, listOptions)
+	return list, nil
 }
 
 type getter interface {
@@ -345,10 +415,14 @@ type getter interface {
 }
 
 // Get retrieves a single object.
-func (dc *DynamicCache) Get(ctx context.Context, key store.Key) (*unstructured.Unstructured, error) {
+func (dc *DynamicCache) Get(ctx context.Context, key store.Key) (object *unstructured.Unstructured, err error) {
 	ctx, span := trace.StartSpan(ctx, "dynamicCacheGet")
 	defer span.End()
 
+	defer func() {
+		contentcache.RecordGet(ctx, key, object, err)
+	}()
+
 	if dc.isBackingOff(ctx, key) {
 		return &unstructured.Unstructured{}, nil
 	}
@@ -370,7 +444,7 @@ func (dc *DynamicCache) Get(ctx context.Context, key store.Key) (*unstructured.U
 		trace.StringAttribute("name", key.Name),
 	}, "get key")
 
-	object, err := dc.getFromInformer(ctx, key)
+	object, err = dc.getFromInformer(ctx, key)
 	if err != nil {
 		if kerrors.IsNotFound(err) {
 			object, err := dc.getFromDynamicClient(ctx, key)
@@ -436,28 +510,31 @@ func (dc *DynamicCache) getFromDynamicClient(ctx context.Context, key store.Key)
 
 // Watch watches the cluster for an event and performs actions with the
 // supplied handler.
-func (dc *DynamicCache) Watch(ctx context.Context, key store.Key, handler kcache.ResourceEventHandler) error {
+func (dc *DynamicCache) Watch(ctx context.Context, key store.Key, handler kcache.ResourceEventHandler) (store.WatchHandle, error) {
 	if dc.isBackingOff(ctx, key) {
-		return nil
+		return removedWatchHandle(), nil
 	}
 
 	if err := dc.access.HasAccess(ctx, key, "watch"); err != nil {
 		if meta.IsNoMatchError(err) {
-			return nil
+			return removedWatchHandle(), nil
 		}
 		if !dc.isBackingOff(ctx, key) {
 			dc.backoff(ctx, key)
 		}
-		return fmt.Errorf("check access to watch %s: %w", key, err)
+		return nil, fmt.Errorf("check access to watch %s: %w", key, err)
 	}
 
 	informer, _, err := dc.currentInformer(ctx, key)
 	if err != nil {
-		return fmt.Errorf("retrieving informer for %s: %w", key, err)
+		return nil, fmt.Errorf("retrieving informer for %s: %w", key, err)
 	}
 
-	informer.Informer().AddEventHandler(handler)
-	return nil
+	registry, id := dc.watchRegistries.add(informer.Informer(), handler)
+
+	return newWatchHandle(ctx, registry.done, func() {
+		registry.remove(id)
+	}), nil
 }
 
 // Unwatch un-watches a key by stopping it's informer.
@@ -523,6 +600,7 @@ func (dc *DynamicCache) UpdateClusterClient(ctx context.Context, client cluster.
 	dc.updateMu.Lock()
 	dc.client = client
 	dc.factories.reset()
+	dc.watchRegistries.reset()
 	dc.seenGVKs.reset()
 	dc.informerSynced.reset()
 	dc.access = NewResourceAccess(client)
@@ -535,6 +613,33 @@ func (dc *DynamicCache) UpdateClusterClient(ctx context.Context, client cluster.
 	return nil
 }
 
+// CachedKeys returns a key for each namespace and group version kind the cache has
+// an informer for. Objects for these keys can be listed without calling the cluster.
+func (dc *DynamicCache) CachedKeys() []store.Key {
+	return dc.seenGVKs.keys()
+}
+
+// StaleInformer returns the status of the informer for key if the objects it has may be
+// out of date, e.g. because its watch stopped.
+func (dc *DynamicCache) StaleInformer(key store.Key) (InformerStatus, bool) {
+	factory, ok := dc.factories.get(key.Namespace)
+	if !ok {
+		return InformerStatus{}, false
+	}
+
+	statuser, ok := factory.(informerStatuser)
+	if !ok {
+		return InformerStatus{}, false
+	}
+
+	status, ok := statuser.InformerStatus(key.GroupVersionKind())
+	if !ok || !status.Stale(time.Now()) {
+		return InformerStatus{}, false
+	}
+
+	return status, true
+}
+
 // RegisterOnUpdate registers a function that will be called when the store updates it's client.
 // TODO: investigate if this needed since object store isn't replaced, it's client is. (GH#496)
 func (dc *DynamicCache) RegisterOnUpdate(fn store.UpdateFn) {
@@ -651,29 +756,9 @@ func CreateOrUpdateFromHandler(
 	create func(context.Context, *unstructured.Unstructured) error,
 	clusterClient cluster.ClientInterface,
 ) ([]string, error) {
-	withDoc := func(cb func(doc map[string]interface{}) error) error {
-		d := yaml.NewYAMLOrJSONDecoder(bytes.NewBufferString(input), 4096)
-		for {
-			doc := map[string]interface{}{}
-			if err := d.Decode(&doc); err != nil {
-				if err == io.EOF {
-					return nil
-				}
-				return fmt.Errorf("unable to parse yaml: %w", err)
-			}
-			if len(doc) == 0 {
-				// skip empty documents
-				continue
-			}
-			if err := cb(doc); err != nil {
-				return err
-			}
-		}
-	}
-
 	logger := log.From(ctx)
 	var results []string
-	err := withDoc(func(doc map[string]interface{}) error {
+	err := withYAMLDocuments(input, func(doc map[string]interface{}) error {
 		logger.Debugf("apply resource %#v", doc)
 
 		unstructuredObj := &unstructured.Unstructured{Object: doc}
@@ -764,3 +849,154 @@ func CreateOrUpdateFromHandler(
 func (dc *DynamicCache) CreateOrUpdateFromYAML(ctx context.Context, namespace, input string) ([]string, error) {
 	return CreateOrUpdateFromHandler(ctx, namespace, input, dc.Get, dc.Create, dc.client)
 }
+
+// DryRunFromHandler validates the resources in YAML input with a server-side dry run.
+// A result is returned for each resource, including those which couldn't be
+// validated. Namespaces created earlier in the input are treated as present.
+func DryRunFromHandler(
+	ctx context.Context, namespace, input string,
+	get func(context.Context, store.Key) (*unstructured.Unstructured, error),
+	clusterClient cluster.ClientInterface,
+) ([]store.DryRunResult, error) {
+	logger := log.From(ctx)
+	var results []store.DryRunResult
+	// createdNamespaces are the namespaces which don't exist yet, but are created
+	// earlier in the input.
+	createdNamespaces := map[string]bool{}
+	err := withYAMLDocuments(input, func(doc map[string]interface{}) error {
+		logger.Debugf("dry run resource %#v", doc)
+
+		unstructuredObj := &unstructured.Unstructured{Object: doc}
+		key := store.Key{
+			Namespace:  unstructuredObj.GetNamespace(),
+			APIVersion: unstructuredObj.GetAPIVersion(),
+			Kind:       unstructuredObj.GetKind(),
+			Name:       unstructuredObj.GetName(),
+		}
+
+		result := dryRun(ctx, namespace, unstructuredObj, key, get, clusterClient, createdNamespaces)
+		if result.Err == nil && !result.Exists && result.Key.APIVersion == "v1" && result.Key.Kind == "Namespace" {
+			createdNamespaces[result.Key.Name] = true
+		}
+		results = append(results, result)
+
+		return nil
+	})
+	return results, err
+}
+
+// dryRun validates a single resource. A create in a namespace which is created
+// earlier in the input fails because the namespace doesn't exist yet, so that
+// failure is ignored.
+func dryRun(
+	ctx context.Context, namespace string, unstructuredObj *unstructured.Unstructured, key store.Key,
+	get func(context.Context, store.Key) (*unstructured.Unstructured, error),
+	clusterClient cluster.ClientInterface,
+	createdNamespaces map[string]bool,
+) store.DryRunResult {
+	if err := key.Validate(); err != nil {
+		return store.DryRunResult{Key: key, Err: err}
+	}
+
+	gvr, namespaced, err := clusterClient.Resource(key.GroupVersionKind().GroupKind())
+	if err != nil {
+		return store.DryRunResult{Key: key, Err: fmt.Errorf("unable to discover resource: %w", err)}
+	}
+	if namespaced && key.Namespace == "" {
+		unstructuredObj.SetNamespace(namespace)
+		key.Namespace = namespace
+	}
+
+	result := store.DryRunResult{Key: key}
+
+	client, err := clusterClient.DynamicClient()
+	if err != nil {
+		result.Err = fmt.Errorf("unable to get dynamic client: %w", err)
+		return result
+	}
+
+	namespaceableClient := client.Resource(gvr)
+	var resourceClient dynamic.ResourceInterface = namespaceableClient
+	if namespaced {
+		resourceClient = namespaceableClient.Namespace(key.Namespace)
+	}
+
+	pendingNamespace := namespaced && createdNamespaces[key.Namespace]
+
+	if !pendingNamespace {
+		_, err = get(ctx, key)
+		if err != nil && !kerrors.IsNotFound(err) {
+			result.Err = fmt.Errorf("unable to get resource: %w", err)
+			return result
+		}
+	}
+
+	if pendingNamespace || err != nil {
+		createOptions := metav1.CreateOptions{DryRun: []string{metav1.DryRunAll}}
+		if _, err := resourceClient.Create(ctx, unstructuredObj, createOptions); err != nil {
+			if !pendingNamespace || !isNamespaceNotFound(err, key.Namespace) {
+				result.Err = err
+			}
+		}
+		return result
+	}
+
+	result.Exists = true
+
+	unstructuredYaml, err := sigyaml.Marshal(unstructuredObj.Object)
+	if err != nil {
+		result.Err = fmt.Errorf("unable to marshal resource as yaml: %w", err)
+		return result
+	}
+
+	withForce := true
+	patchOptions := metav1.PatchOptions{
+		DryRun:       []string{metav1.DryRunAll},
+		FieldManager: "octant",
+		Force:        &withForce,
+	}
+	if _, err := resourceClient.Patch(ctx, key.Name, types.ApplyPatchType, unstructuredYaml, patchOptions); err != nil {
+		result.Err = err
+	}
+
+	return result
+}
+
+// isNamespaceNotFound returns true if err is the API server reporting that namespace
+// doesn't exist.
+func isNamespaceNotFound(err error, namespace string) bool {
+	var status kerrors.APIStatus
+	if !kerrors.IsNotFound(err) || !errors.As(err, &status) {
+		return false
+	}
+
+	details := status.Status().Details
+	return details != nil && details.Kind == "namespaces" && details.Name == namespace
+}
+
+// DryRunFromYAML validates resources in YAML input with a server-side dry run.
+// Resources which don't exist are validated as a create and the others as an apply.
+func (dc *DynamicCache) DryRunFromYAML(ctx context.Context, namespace, input string) ([]store.DryRunResult, error) {
+	return DryRunFromHandler(ctx, namespace, input, dc.Get, dc.client)
+}
+
+// withYAMLDocuments calls cb with each non empty document in YAML or JSON input.
+func withYAMLDocuments(input string, cb func(doc map[string]interface{}) error) error {
+	d := yaml.NewYAMLOrJSONDecoder(bytes.NewBufferString(input), 4096)
+	for {
+		doc := map[string]interface{}{}
+		if err := d.Decode(&doc); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return fmt.Errorf("unable to parse yaml: %w", err)
+		}
+		if len(doc) == 0 {
+			// skip empty documents
+			continue
+		}
+		if err := cb(doc); err != nil {
+			return err
+		}
+	}
+}
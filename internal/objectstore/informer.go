@@ -30,23 +30,34 @@ type informerFactory struct {
 	lock                 sync.Mutex
 	informers            map[schema.GroupVersionKind]informers.GenericInformer
 	informerErrors       map[schema.GroupVersionKind]error
+	health               map[schema.GroupVersionKind]*informerHealth
 	tweakListOptions     dynamicinformer.TweakListOptionsFunc
 	stopCh               <-chan struct{}
 	informerContextCache *informerContextCache
 }
 
 var _ InformerFactory = (*informerFactory)(nil)
+var _ informerStatuser = (*informerFactory)(nil)
 
 func newInformerFactory(stopCh <-chan struct{}, client cluster.ClientInterface, defaultResync time.Duration, namespace string) *informerFactory {
-	return &informerFactory{
+	f := &informerFactory{
 		stopCh:               stopCh,
 		client:               client,
 		defaultResync:        defaultResync,
 		namespace:            namespace,
 		informers:            make(map[schema.GroupVersionKind]informers.GenericInformer),
 		informerErrors:       make(map[schema.GroupVersionKind]error),
+		health:               make(map[schema.GroupVersionKind]*informerHealth),
 		informerContextCache: initInformerContextCache(),
 	}
+
+	// stop the factory's informers when the factory is stopped.
+	go func() {
+		<-stopCh
+		f.informerContextCache.reset()
+	}()
+
+	return f
 }
 
 func (f *informerFactory) watchErrorHandler(gvk schema.GroupVersionKind, stopCh chan struct{}) cache.WatchErrorHandler {
@@ -54,7 +65,10 @@ func (f *informerFactory) watchErrorHandler(gvk schema.GroupVersionKind, stopCh
 		f.lock.Lock()
 		defer f.lock.Unlock()
 		f.informerErrors[gvk] = err
-		close(stopCh)
+		if health, ok := f.health[gvk]; ok {
+			health.recordWatchError(err)
+		}
+		f.informerContextCache.closeChild(stopCh)
 	}
 }
 
@@ -93,6 +107,10 @@ func (f *informerFactory) ForResource(groupVersionKind schema.GroupVersionKind)
 		f.tweakListOptions)
 	f.informers[groupVersionKind] = genericInformer
 
+	health := newInformerHealth(f.namespace, groupVersionKind, f.defaultResync, genericInformer.Informer().GetStore())
+	f.health[groupVersionKind] = health
+	genericInformer.Informer().AddEventHandler(health)
+
 	genericInformer.Informer().SetWatchErrorHandler(f.watchErrorHandler(groupVersionKind, stopCh))
 	go genericInformer.Informer().Run(stopCh)
 
@@ -110,6 +128,8 @@ func (f *informerFactory) Delete(groupVersionKind schema.GroupVersionKind) {
 	defer f.lock.Unlock()
 
 	f.informerContextCache.delete(groupVersionKind)
+	delete(f.health, groupVersionKind)
+	delete(f.informerErrors, groupVersionKind)
 	delete(f.informers, groupVersionKind)
 	f.informers[groupVersionKind] = nil
 }
@@ -133,3 +153,32 @@ func (f *informerFactory) WaitForCacheSync(stopCh <-chan struct{}) map[schema.Gr
 	}
 	return res
 }
+
+// InformerStatus returns the status of the informer for a group/version/kind, if it
+// has been started.
+func (f *informerFactory) InformerStatus(groupVersionKind schema.GroupVersionKind) (InformerStatus, bool) {
+	f.lock.Lock()
+	health, ok := f.health[groupVersionKind]
+	f.lock.Unlock()
+
+	if !ok {
+		return InformerStatus{}, false
+	}
+	return health.status(), true
+}
+
+// InformerStatuses returns the status of each started informer.
+func (f *informerFactory) InformerStatuses() []InformerStatus {
+	f.lock.Lock()
+	list := make([]*informerHealth, 0, len(f.health))
+	for _, health := range f.health {
+		list = append(list, health)
+	}
+	f.lock.Unlock()
+
+	statuses := make([]InformerStatus, 0, len(list))
+	for _, health := range list {
+		statuses = append(statuses, health.status())
+	}
+	return statuses
+}
@@ -0,0 +1,137 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package objectstore
+
+import (
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	kcache "k8s.io/client-go/tools/cache"
+)
+
+// InformerStatus describes how current the objects of an informer are.
+type InformerStatus struct {
+	// Namespace is the namespace the informer watches. It is empty for informers which
+	// watch all namespaces.
+	Namespace string
+	// GroupVersionKind is the group version kind the informer watches.
+	GroupVersionKind schema.GroupVersionKind
+	// Started is when the informer was started.
+	Started time.Time
+	// LastEvent is when the informer last received an event, including resyncs.
+	LastEvent time.Time
+	// Objects is the number of objects the informer has.
+	Objects int
+	// WatchError is the error which stopped the informer's watch, if it stopped.
+	WatchError error
+	// WatchErrorTime is when the watch stopped.
+	WatchErrorTime time.Time
+	// ResyncPeriod is how often the informer sends its objects to its handlers.
+	ResyncPeriod time.Duration
+}
+
+// Stale returns true if the informer's objects may be out of date at now. That is the
+// case if its watch stopped, or if it has objects but missed two resyncs.
+func (s InformerStatus) Stale(now time.Time) bool {
+	if s.WatchError != nil {
+		return true
+	}
+
+	if s.Objects == 0 || s.ResyncPeriod < 1 || s.LastEvent.IsZero() {
+		return false
+	}
+
+	return now.Sub(s.LastEvent) > 2*s.ResyncPeriod
+}
+
+// informerHealth records the events and watch errors of an informer. It is added to the
+// informer as an event handler.
+type informerHealth struct {
+	namespace        string
+	groupVersionKind schema.GroupVersionKind
+	resyncPeriod     time.Duration
+	store            kcache.Store
+	now              func() time.Time
+
+	mu             sync.Mutex
+	started        time.Time
+	lastEvent      time.Time
+	watchError     error
+	watchErrorTime time.Time
+}
+
+var _ kcache.ResourceEventHandler = (*informerHealth)(nil)
+
+func newInformerHealth(namespace string, groupVersionKind schema.GroupVersionKind, resyncPeriod time.Duration, store kcache.Store) *informerHealth {
+	h := &informerHealth{
+		namespace:        namespace,
+		groupVersionKind: groupVersionKind,
+		resyncPeriod:     resyncPeriod,
+		store:            store,
+		now:              time.Now,
+	}
+	h.started = h.now()
+	return h
+}
+
+func (h *informerHealth) recordEvent() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.lastEvent = h.now()
+}
+
+func (h *informerHealth) recordWatchError(err error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.watchError = err
+	h.watchErrorTime = h.now()
+}
+
+// OnAdd implements kcache.ResourceEventHandler.
+func (h *informerHealth) OnAdd(interface{}) {
+	h.recordEvent()
+}
+
+// OnUpdate implements kcache.ResourceEventHandler.
+func (h *informerHealth) OnUpdate(interface{}, interface{}) {
+	h.recordEvent()
+}
+
+// OnDelete implements kcache.ResourceEventHandler.
+func (h *informerHealth) OnDelete(interface{}) {
+	h.recordEvent()
+}
+
+// status returns the current status of the informer.
+func (h *informerHealth) status() InformerStatus {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	status := InformerStatus{
+		Namespace:        h.namespace,
+		GroupVersionKind: h.groupVersionKind,
+		Started:          h.started,
+		LastEvent:        h.lastEvent,
+		WatchError:       h.watchError,
+		WatchErrorTime:   h.watchErrorTime,
+		ResyncPeriod:     h.resyncPeriod,
+	}
+	if h.store != nil {
+		status.Objects = len(h.store.ListKeys())
+	}
+
+	return status
+}
+
+// informerStatuser reports the status of informers. Informer factories which don't
+// implement it are assumed to be current.
+type informerStatuser interface {
+	InformerStatus(groupVersionKind schema.GroupVersionKind) (InformerStatus, bool)
+	InformerStatuses() []InformerStatus
+}
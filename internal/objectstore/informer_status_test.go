@@ -0,0 +1,82 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package objectstore
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	kcache "k8s.io/client-go/tools/cache"
+
+	"github.com/vmware-tanzu/octant/internal/testutil"
+)
+
+func TestInformerStatus_Stale(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name   string
+		status InformerStatus
+		wanted bool
+	}{
+		{
+			name:   "current",
+			status: InformerStatus{Objects: 1, LastEvent: now.Add(-time.Minute), ResyncPeriod: time.Minute},
+			wanted: false,
+		},
+		{
+			name:   "watch stopped",
+			status: InformerStatus{WatchError: fmt.Errorf("connection refused"), WatchErrorTime: now},
+			wanted: true,
+		},
+		{
+			name:   "missed resyncs",
+			status: InformerStatus{Objects: 1, LastEvent: now.Add(-3 * time.Minute), ResyncPeriod: time.Minute},
+			wanted: true,
+		},
+		{
+			name:   "no objects to resync",
+			status: InformerStatus{LastEvent: now.Add(-3 * time.Minute), ResyncPeriod: time.Minute},
+			wanted: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.wanted, test.status.Stale(now))
+		})
+	}
+}
+
+func Test_informerHealth(t *testing.T) {
+	gvk := schema.GroupVersionKind{Version: "v1", Kind: "Pod"}
+
+	store := kcache.NewStore(kcache.MetaNamespaceKeyFunc)
+	pod := testutil.CreatePod("pod")
+	assert.NoError(t, store.Add(pod))
+
+	now := time.Unix(1600000000, 0)
+	health := newInformerHealth("default", gvk, time.Minute, store)
+	health.now = func() time.Time { return now }
+
+	health.OnAdd(pod)
+	status := health.status()
+	assert.Equal(t, "default", status.Namespace)
+	assert.Equal(t, gvk, status.GroupVersionKind)
+	assert.Equal(t, now, status.LastEvent)
+	assert.Equal(t, 1, status.Objects)
+	assert.False(t, status.Stale(now))
+
+	err := fmt.Errorf("connection refused")
+	health.recordWatchError(err)
+	status = health.status()
+	assert.Equal(t, err, status.WatchError)
+	assert.Equal(t, now, status.WatchErrorTime)
+	assert.True(t, status.Stale(now))
+}
@@ -0,0 +1,129 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package objectstore
+
+import (
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	kLabels "k8s.io/apimachinery/pkg/labels"
+	kruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/selection"
+	kcache "k8s.io/client-go/tools/cache"
+)
+
+// LabelIndex is the name of the informer index of objects by their label pairs.
+const LabelIndex = "label"
+
+// labelIndexFunc indexes an object by each of its labels as a key=value pair.
+func labelIndexFunc(obj interface{}) ([]string, error) {
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	labels := accessor.GetLabels()
+	pairs := make([]string, 0, len(labels))
+	for k, v := range labels {
+		pairs = append(pairs, labelPair(k, v))
+	}
+
+	return pairs, nil
+}
+
+func labelPair(k, v string) string {
+	return k + "=" + v
+}
+
+// addLabelIndex adds the label index to an informer. Like the owner UID index, it can
+// only be added before the informer starts.
+func addLabelIndex(informer kcache.SharedIndexInformer) error {
+	if _, ok := informer.GetIndexer().GetIndexers()[LabelIndex]; ok {
+		return nil
+	}
+
+	if informer.LastSyncResourceVersion() != "" {
+		return nil
+	}
+
+	return informer.AddIndexers(kcache.Indexers{LabelIndex: labelIndexFunc})
+}
+
+// listBySelector returns the objects in an indexer in namespace which match selector.
+// Only the objects with the least common label pair the selector requires are checked.
+// It returns false if the indexer has no label index or the selector doesn't require a
+// label pair, e.g. an existence or set based selector.
+func listBySelector(indexer kcache.Indexer, namespace string, selector kLabels.Selector) ([]kruntime.Object, bool) {
+	if _, ok := indexer.GetIndexers()[LabelIndex]; !ok {
+		return nil, false
+	}
+
+	requirements, selectable := selector.Requirements()
+	if !selectable {
+		return nil, false
+	}
+
+	var candidates []interface{}
+	found := false
+	for _, requirement := range requirements {
+		value, ok := requiredValue(requirement)
+		if !ok {
+			continue
+		}
+
+		indexed, err := indexer.ByIndex(LabelIndex, labelPair(requirement.Key(), value))
+		if err != nil {
+			return nil, false
+		}
+
+		if !found || len(indexed) < len(candidates) {
+			candidates = indexed
+			found = true
+		}
+
+		if len(candidates) == 0 {
+			break
+		}
+	}
+
+	if !found {
+		return nil, false
+	}
+
+	var objects []kruntime.Object
+	for _, obj := range candidates {
+		object, ok := obj.(*unstructured.Unstructured)
+		if !ok {
+			continue
+		}
+
+		if namespace != "" && object.GetNamespace() != namespace {
+			continue
+		}
+
+		if !selector.Matches(kLabels.Set(object.GetLabels())) {
+			continue
+		}
+
+		objects = append(objects, object)
+	}
+
+	return objects, true
+}
+
+// requiredValue returns the value a requirement needs its label to have, if there is
+// exactly one.
+func requiredValue(requirement kLabels.Requirement) (string, bool) {
+	switch requirement.Operator() {
+	case selection.Equals, selection.DoubleEquals, selection.In:
+		values := requirement.Values()
+		if values.Len() != 1 {
+			return "", false
+		}
+		return values.List()[0], true
+	default:
+		return "", false
+	}
+}
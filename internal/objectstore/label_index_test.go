@@ -0,0 +1,133 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package objectstore
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	kLabels "k8s.io/apimachinery/pkg/labels"
+	kcache "k8s.io/client-go/tools/cache"
+
+	"github.com/vmware-tanzu/octant/internal/testutil"
+)
+
+func Test_labelIndexFunc(t *testing.T) {
+	pod := testutil.CreatePod("pod")
+	pod.SetLabels(map[string]string{"app": "nginx", "tier": "web"})
+
+	got, err := labelIndexFunc(testutil.ToUnstructured(t, pod))
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"app=nginx", "tier=web"}, got)
+}
+
+func labelIndexer(t testing.TB, pods map[string]map[string]string) kcache.Indexer {
+	indexer := kcache.NewIndexer(kcache.MetaNamespaceKeyFunc, kcache.Indexers{LabelIndex: labelIndexFunc})
+	for name, labels := range pods {
+		pod := testutil.CreatePod(name)
+		pod.SetLabels(labels)
+		require.NoError(t, indexer.Add(testutil.ToUnstructured(t, pod)))
+	}
+	return indexer
+}
+
+func Test_listBySelector(t *testing.T) {
+	indexer := labelIndexer(t, map[string]map[string]string{
+		"web-1": {"app": "nginx", "tier": "web"},
+		"web-2": {"app": "nginx", "tier": "web"},
+		"cache": {"app": "redis", "tier": "cache"},
+		"batch": {"app": "nginx"},
+	})
+
+	other := testutil.CreatePod("other-namespace")
+	other.Namespace = "other"
+	other.SetLabels(map[string]string{"app": "nginx", "tier": "web"})
+	require.NoError(t, indexer.Add(testutil.ToUnstructured(t, other)))
+
+	parse := func(s string) kLabels.Selector {
+		selector, err := kLabels.Parse(s)
+		require.NoError(t, err)
+		return selector
+	}
+
+	cases := []struct {
+		name      string
+		namespace string
+		selector  kLabels.Selector
+		expected  []string
+		isIndexed bool
+	}{
+		{
+			name:      "label pairs",
+			namespace: "namespace",
+			selector:  kLabels.SelectorFromSet(kLabels.Set{"app": "nginx", "tier": "web"}),
+			expected:  []string{"web-1", "web-2"},
+			isIndexed: true,
+		},
+		{
+			name:      "all namespaces",
+			selector:  kLabels.SelectorFromSet(kLabels.Set{"tier": "web"}),
+			expected:  []string{"web-1", "web-2", "other-namespace"},
+			isIndexed: true,
+		},
+		{
+			name:      "label pair and set based requirement",
+			namespace: "namespace",
+			selector:  parse("app=nginx,tier notin (web)"),
+			expected:  []string{"batch"},
+			isIndexed: true,
+		},
+		{
+			name:      "no matching objects",
+			namespace: "namespace",
+			selector:  kLabels.SelectorFromSet(kLabels.Set{"app": "missing"}),
+			isIndexed: true,
+		},
+		{
+			name:      "only set based requirements",
+			namespace: "namespace",
+			selector:  parse("app in (nginx, redis)"),
+		},
+		{
+			name:      "everything",
+			namespace: "namespace",
+			selector:  kLabels.Everything(),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, isIndexed := listBySelector(indexer, tc.namespace, tc.selector)
+			require.Equal(t, tc.isIndexed, isIndexed)
+			assert.ElementsMatch(t, tc.expected, names(t, got))
+		})
+	}
+}
+
+func Test_listBySelector_noIndex(t *testing.T) {
+	indexer := kcache.NewIndexer(kcache.MetaNamespaceKeyFunc, kcache.Indexers{})
+
+	_, isIndexed := listBySelector(indexer, "", kLabels.SelectorFromSet(kLabels.Set{"app": "nginx"}))
+	assert.False(t, isIndexed)
+}
+
+func Benchmark_listBySelector(b *testing.B) {
+	pods := map[string]map[string]string{}
+	for i := 0; i < 5000; i++ {
+		pods[fmt.Sprintf("pod-%d", i)] = map[string]string{"app": fmt.Sprintf("app-%d", i%100)}
+	}
+	indexer := labelIndexer(b, pods)
+	selector := kLabels.SelectorFromSet(kLabels.Set{"app": "app-1"})
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, ok := listBySelector(indexer, "namespace", selector); !ok {
+			b.Fatal("selector is not indexed")
+		}
+	}
+}
@@ -0,0 +1,113 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package objectstore
+
+import (
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	kLabels "k8s.io/apimachinery/pkg/labels"
+	kruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	kcache "k8s.io/client-go/tools/cache"
+
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+// OwnerUIDIndex is the name of the informer index of objects by the UIDs in their
+// owner references.
+const OwnerUIDIndex = "ownerUID"
+
+// ownerUIDIndexFunc indexes an object by the UIDs in its owner references.
+func ownerUIDIndexFunc(obj interface{}) ([]string, error) {
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	ownerReferences := accessor.GetOwnerReferences()
+	uids := make([]string, 0, len(ownerReferences))
+	for _, ownerReference := range ownerReferences {
+		uids = append(uids, string(ownerReference.UID))
+	}
+
+	return uids, nil
+}
+
+// addOwnerUIDIndex adds the owner UID index to an informer. Indexes can't be added
+// once an informer has started, so lists by owner fall back to filtering every object.
+func addOwnerUIDIndex(informer kcache.SharedIndexInformer) error {
+	if _, ok := informer.GetIndexer().GetIndexers()[OwnerUIDIndex]; ok {
+		return nil
+	}
+
+	if informer.LastSyncResourceVersion() != "" {
+		// The informer has started.
+		return nil
+	}
+
+	return informer.AddIndexers(kcache.Indexers{OwnerUIDIndex: ownerUIDIndexFunc})
+}
+
+// listByOwner returns the objects in an indexer which are owned by key.OwnerUID and
+// match the key's namespace and selector.
+func listByOwner(indexer kcache.Indexer, key store.Key, selector kLabels.Selector) ([]kruntime.Object, error) {
+	indexed, err := indexer.ByIndex(OwnerUIDIndex, string(key.OwnerUID))
+	if err != nil {
+		return nil, err
+	}
+
+	var objects []kruntime.Object
+	for _, obj := range indexed {
+		object, ok := obj.(*unstructured.Unstructured)
+		if !ok {
+			continue
+		}
+
+		if key.Namespace != "" && object.GetNamespace() != key.Namespace {
+			continue
+		}
+
+		if !selector.Matches(kLabels.Set(object.GetLabels())) {
+			continue
+		}
+
+		objects = append(objects, object)
+	}
+
+	return objects, nil
+}
+
+// filterByOwner returns the objects which have an owner reference to uid. Every object
+// is returned if uid is blank.
+func filterByOwner(objects []kruntime.Object, uid types.UID) []kruntime.Object {
+	if uid == "" {
+		return objects
+	}
+
+	var owned []kruntime.Object
+	for _, object := range objects {
+		if isOwnedBy(object, uid) {
+			owned = append(owned, object)
+		}
+	}
+
+	return owned
+}
+
+func isOwnedBy(object kruntime.Object, uid types.UID) bool {
+	accessor, err := meta.Accessor(object)
+	if err != nil {
+		return false
+	}
+
+	for _, ownerReference := range accessor.GetOwnerReferences() {
+		if ownerReference.UID == uid {
+			return true
+		}
+	}
+
+	return false
+}
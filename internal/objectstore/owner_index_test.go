@@ -0,0 +1,101 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package objectstore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	kLabels "k8s.io/apimachinery/pkg/labels"
+	kruntime "k8s.io/apimachinery/pkg/runtime"
+	kcache "k8s.io/client-go/tools/cache"
+
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+func Test_ownerUIDIndexFunc(t *testing.T) {
+	deployment := testutil.CreateDeployment("deployment")
+	replicaSet := testutil.CreateAppReplicaSet("replicaset")
+	replicaSet.SetOwnerReferences(testutil.ToOwnerReferences(t, deployment))
+
+	got, err := ownerUIDIndexFunc(testutil.ToUnstructured(t, replicaSet))
+	require.NoError(t, err)
+	assert.Equal(t, []string{string(deployment.UID)}, got)
+
+	got, err = ownerUIDIndexFunc(testutil.ToUnstructured(t, deployment))
+	require.NoError(t, err)
+	assert.Empty(t, got)
+}
+
+func Test_listByOwner(t *testing.T) {
+	replicaSet := testutil.CreateAppReplicaSet("replicaset")
+	ownerReferences := testutil.ToOwnerReferences(t, replicaSet)
+
+	owned := testutil.CreatePod("owned")
+	owned.SetOwnerReferences(ownerReferences)
+	owned.SetLabels(map[string]string{"app": "nginx"})
+
+	otherNamespace := testutil.CreatePod("other-namespace")
+	otherNamespace.Namespace = "other"
+	otherNamespace.SetOwnerReferences(ownerReferences)
+	otherNamespace.SetLabels(map[string]string{"app": "nginx"})
+
+	unlabeled := testutil.CreatePod("unlabeled")
+	unlabeled.SetOwnerReferences(ownerReferences)
+
+	orphan := testutil.CreatePod("orphan")
+	orphan.SetLabels(map[string]string{"app": "nginx"})
+
+	indexer := kcache.NewIndexer(kcache.MetaNamespaceKeyFunc, kcache.Indexers{OwnerUIDIndex: ownerUIDIndexFunc})
+	for _, pod := range []kruntime.Object{owned, otherNamespace, unlabeled, orphan} {
+		require.NoError(t, indexer.Add(testutil.ToUnstructured(t, pod)))
+	}
+
+	key := store.Key{
+		Namespace:  owned.Namespace,
+		APIVersion: "v1",
+		Kind:       "Pod",
+		OwnerUID:   replicaSet.UID,
+	}
+
+	got, err := listByOwner(indexer, key, kLabels.Everything())
+	require.NoError(t, err)
+	assert.ElementsMatch(t, names(t, got), []string{"owned", "unlabeled"})
+
+	got, err = listByOwner(indexer, key, kLabels.SelectorFromSet(kLabels.Set{"app": "nginx"}))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"owned"}, names(t, got))
+
+	key.Namespace = ""
+	got, err = listByOwner(indexer, key, kLabels.Everything())
+	require.NoError(t, err)
+	assert.ElementsMatch(t, names(t, got), []string{"owned", "other-namespace", "unlabeled"})
+}
+
+func Test_filterByOwner(t *testing.T) {
+	replicaSet := testutil.CreateAppReplicaSet("replicaset")
+
+	owned := testutil.CreatePod("owned")
+	owned.SetOwnerReferences(testutil.ToOwnerReferences(t, replicaSet))
+	orphan := testutil.CreatePod("orphan")
+
+	objects := []kruntime.Object{testutil.ToUnstructured(t, owned), testutil.ToUnstructured(t, orphan)}
+
+	assert.Equal(t, []string{"owned"}, names(t, filterByOwner(objects, replicaSet.UID)))
+	assert.Equal(t, objects, filterByOwner(objects, ""))
+}
+
+func names(t *testing.T, objects []kruntime.Object) []string {
+	var got []string
+	for _, object := range objects {
+		key, err := store.KeyFromObject(object)
+		require.NoError(t, err)
+		got = append(got, key.Name)
+	}
+	return got
+}
@@ -0,0 +1,146 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package objectstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	kcache "k8s.io/client-go/tools/cache"
+
+	"github.com/vmware-tanzu/octant/internal/cluster"
+	"github.com/vmware-tanzu/octant/internal/snapshot"
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+// ErrReadOnlySnapshot is returned when attempting to modify objects in a snapshot.
+var ErrReadOnlySnapshot = errors.New("snapshots are read only")
+
+// SnapshotStore is a read only store backed by a cluster snapshot.
+type SnapshotStore struct {
+	snapshot *snapshot.Snapshot
+}
+
+var _ store.Store = (*SnapshotStore)(nil)
+
+// NewSnapshotStore creates an instance of SnapshotStore.
+func NewSnapshotStore(s *snapshot.Snapshot) *SnapshotStore {
+	return &SnapshotStore{
+		snapshot: s,
+	}
+}
+
+// List lists objects in the snapshot matching the key.
+func (s *SnapshotStore) List(_ context.Context, key store.Key) (*unstructured.UnstructuredList, bool, error) {
+	selector, err := keySelector(key)
+	if err != nil {
+		return nil, false, err
+	}
+
+	list := &unstructured.UnstructuredList{}
+	for _, object := range s.snapshot.Objects(key.GroupVersionKind()) {
+		if key.Namespace != "" && object.GetNamespace() != key.Namespace {
+			continue
+		}
+		if key.Name != "" && object.GetName() != key.Name {
+			continue
+		}
+		if !selector.Matches(labels.Set(object.GetLabels())) {
+			continue
+		}
+
+		list.Items = append(list.Items, *object.DeepCopy())
+	}
+
+	return list, false, nil
+}
+
+// Get gets an object from the snapshot.
+func (s *SnapshotStore) Get(_ context.Context, key store.Key) (*unstructured.Unstructured, error) {
+	gvk := key.GroupVersionKind()
+	for _, object := range s.snapshot.Objects(gvk) {
+		if object.GetNamespace() == key.Namespace && object.GetName() == key.Name {
+			return object.DeepCopy(), nil
+		}
+	}
+
+	return nil, kerrors.NewNotFound(schema.GroupResource{Group: gvk.Group, Resource: gvk.Kind}, key.Name)
+}
+
+// Delete is not supported by snapshots.
+func (s *SnapshotStore) Delete(_ context.Context, _ store.Key) error {
+	return ErrReadOnlySnapshot
+}
+
+// Watch is a no-op since snapshots do not change.
+func (s *SnapshotStore) Watch(_ context.Context, _ store.Key, _ kcache.ResourceEventHandler) (store.WatchHandle, error) {
+	return removedWatchHandle(), nil
+}
+
+// Unwatch is a no-op since snapshots do not change.
+func (s *SnapshotStore) Unwatch(_ context.Context, _ ...schema.GroupVersionKind) error {
+	return nil
+}
+
+// UpdateClusterClient is a no-op since snapshots are not backed by a cluster.
+func (s *SnapshotStore) UpdateClusterClient(_ context.Context, _ cluster.ClientInterface) error {
+	return nil
+}
+
+// RegisterOnUpdate is a no-op since snapshots do not change.
+func (s *SnapshotStore) RegisterOnUpdate(_ store.UpdateFn) {
+}
+
+// Update is not supported by snapshots.
+func (s *SnapshotStore) Update(_ context.Context, _ store.Key, _ func(*unstructured.Unstructured) error) error {
+	return ErrReadOnlySnapshot
+}
+
+// IsLoading always returns false since snapshots are loaded before use.
+func (s *SnapshotStore) IsLoading(_ context.Context, _ store.Key) bool {
+	return false
+}
+
+// Create is not supported by snapshots.
+func (s *SnapshotStore) Create(_ context.Context, _ *unstructured.Unstructured) error {
+	return ErrReadOnlySnapshot
+}
+
+// CreateOrUpdateFromYAML is not supported by snapshots.
+func (s *SnapshotStore) CreateOrUpdateFromYAML(_ context.Context, _, _ string) ([]string, error) {
+	return nil, ErrReadOnlySnapshot
+}
+
+// DryRunFromYAML is not supported by snapshots.
+func (s *SnapshotStore) DryRunFromYAML(_ context.Context, _, _ string) ([]store.DryRunResult, error) {
+	return nil, ErrReadOnlySnapshot
+}
+
+func keySelector(key store.Key) (labels.Selector, error) {
+	selector := labels.Everything()
+
+	if key.Selector != nil {
+		selector = labels.SelectorFromSet(*key.Selector)
+	}
+
+	if key.LabelSelector != nil {
+		labelSelector, err := metav1.LabelSelectorAsSelector(key.LabelSelector)
+		if err != nil {
+			return nil, fmt.Errorf("convert label selector: %w", err)
+		}
+
+		requirements, _ := labelSelector.Requirements()
+		selector = selector.Add(requirements...)
+	}
+
+	return selector, nil
+}
@@ -0,0 +1,60 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package objectstore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/vmware-tanzu/octant/internal/snapshot"
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+func TestSnapshotStore(t *testing.T) {
+	newPod := func(namespace, name string, podLabels map[string]string) *unstructured.Unstructured {
+		object := &unstructured.Unstructured{}
+		object.SetAPIVersion("v1")
+		object.SetKind("Pod")
+		object.SetNamespace(namespace)
+		object.SetName(name)
+		object.SetLabels(podLabels)
+		return object
+	}
+
+	s := snapshot.New()
+	s.Add(
+		newPod("default", "pod-1", map[string]string{"app": "web"}),
+		newPod("default", "pod-2", map[string]string{"app": "db"}),
+		newPod("other", "pod-3", map[string]string{"app": "web"}),
+	)
+
+	snapshotStore := NewSnapshotStore(s)
+	ctx := context.Background()
+
+	list, loading, err := snapshotStore.List(ctx, store.Key{APIVersion: "v1", Kind: "Pod", Namespace: "default"})
+	require.NoError(t, err)
+	assert.False(t, loading)
+	assert.Len(t, list.Items, 2)
+
+	list, _, err = snapshotStore.List(ctx, store.Key{APIVersion: "v1", Kind: "Pod", Selector: &labels.Set{"app": "web"}})
+	require.NoError(t, err)
+	assert.Len(t, list.Items, 2)
+
+	object, err := snapshotStore.Get(ctx, store.Key{APIVersion: "v1", Kind: "Pod", Namespace: "other", Name: "pod-3"})
+	require.NoError(t, err)
+	assert.Equal(t, "pod-3", object.GetName())
+
+	_, err = snapshotStore.Get(ctx, store.Key{APIVersion: "v1", Kind: "Pod", Namespace: "other", Name: "missing"})
+	assert.True(t, kerrors.IsNotFound(err))
+
+	assert.Equal(t, ErrReadOnlySnapshot, snapshotStore.Delete(ctx, store.Key{}))
+}
@@ -0,0 +1,245 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package objectstore
+
+import (
+	"context"
+	"sync"
+
+	kcache "k8s.io/client-go/tools/cache"
+
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+// handlerRegistry is the single event handler added to an informer. It passes events
+// to the handlers watching the informer, which can be removed. Informers don't support
+// removing handlers.
+type handlerRegistry struct {
+	mu       sync.RWMutex
+	nextID   uint64
+	handlers map[uint64]*registeredHandler
+
+	closeOnce sync.Once
+	done      chan struct{}
+}
+
+var _ kcache.ResourceEventHandler = (*handlerRegistry)(nil)
+
+// registeredHandler is a handler in a registry. Its lock keeps events from reaching it
+// while the objects which existed before it was added are being passed to it.
+type registeredHandler struct {
+	mu      sync.Mutex
+	handler kcache.ResourceEventHandler
+}
+
+func newHandlerRegistry() *handlerRegistry {
+	return &handlerRegistry{
+		handlers: map[uint64]*registeredHandler{},
+		done:     make(chan struct{}),
+	}
+}
+
+// add adds a handler and passes it an add event for each object existing returns, like
+// an informer does for handlers added after it has synced. existing is called after the
+// handler is added, so no object is missed, but an object added at the same time may be
+// passed to the handler twice.
+func (r *handlerRegistry) add(handler kcache.ResourceEventHandler, existing func() []interface{}) uint64 {
+	registered := &registeredHandler{handler: handler}
+	registered.mu.Lock()
+	defer registered.mu.Unlock()
+
+	r.mu.Lock()
+	r.nextID++
+	id := r.nextID
+	r.handlers[id] = registered
+	r.mu.Unlock()
+
+	if existing != nil {
+		for _, obj := range existing() {
+			handler.OnAdd(obj)
+		}
+	}
+
+	return id
+}
+
+func (r *handlerRegistry) remove(id uint64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.handlers, id)
+}
+
+func (r *handlerRegistry) len() int {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return len(r.handlers)
+}
+
+func (r *handlerRegistry) current() []*registeredHandler {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	handlers := make([]*registeredHandler, 0, len(r.handlers))
+	for _, handler := range r.handlers {
+		handlers = append(handlers, handler)
+	}
+	return handlers
+}
+
+// close closes done, which cancels the handles of the registry's handlers. It is
+// called when the registry's informer is stopped.
+func (r *handlerRegistry) close() {
+	r.closeOnce.Do(func() {
+		close(r.done)
+	})
+}
+
+// OnAdd implements kcache.ResourceEventHandler.
+func (r *handlerRegistry) OnAdd(obj interface{}) {
+	for _, registered := range r.current() {
+		registered.mu.Lock()
+		registered.handler.OnAdd(obj)
+		registered.mu.Unlock()
+	}
+}
+
+// OnUpdate implements kcache.ResourceEventHandler.
+func (r *handlerRegistry) OnUpdate(oldObj, newObj interface{}) {
+	for _, registered := range r.current() {
+		registered.mu.Lock()
+		registered.handler.OnUpdate(oldObj, newObj)
+		registered.mu.Unlock()
+	}
+}
+
+// OnDelete implements kcache.ResourceEventHandler.
+func (r *handlerRegistry) OnDelete(obj interface{}) {
+	for _, registered := range r.current() {
+		registered.mu.Lock()
+		registered.handler.OnDelete(obj)
+		registered.mu.Unlock()
+	}
+}
+
+// watchRegistries are the handler registries of informers.
+type watchRegistries struct {
+	mu         sync.Mutex
+	registries map[kcache.SharedIndexInformer][]*handlerRegistry
+}
+
+func newWatchRegistries() *watchRegistries {
+	return &watchRegistries{
+		registries: map[kcache.SharedIndexInformer][]*handlerRegistry{},
+	}
+}
+
+// add adds a handler to the handler registry of an informer. If the registry doesn't
+// exist, it is created with the handler and added to the informer, which passes the
+// objects it already has to the handler. Otherwise they are passed to the handler by
+// the registry.
+func (w *watchRegistries) add(informer kcache.SharedIndexInformer, handler kcache.ResourceEventHandler) (*handlerRegistry, uint64) {
+	w.mu.Lock()
+	registries, ok := w.registries[informer]
+	if !ok {
+		registry := newHandlerRegistry()
+		id := registry.add(handler, nil)
+		w.registries[informer] = []*handlerRegistry{registry}
+		informer.AddEventHandler(registry)
+		w.mu.Unlock()
+		return registry, id
+	}
+	w.mu.Unlock()
+
+	registry := registries[0]
+	return registry, registry.add(handler, informer.GetStore().List)
+}
+
+// move adds the handler registries of an informer which was replaced to its
+// replacement, so handlers watching the old informer receive the new informer's events.
+func (w *watchRegistries) move(from, to kcache.SharedIndexInformer) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	registries, ok := w.registries[from]
+	if !ok {
+		return
+	}
+	delete(w.registries, from)
+
+	for _, registry := range registries {
+		to.AddEventHandler(registry)
+	}
+	w.registries[to] = append(w.registries[to], registries...)
+}
+
+// reset closes every handler registry and forgets the informers they were added to. It
+// is called when the informers are stopped, e.g. because the cluster client changed.
+func (w *watchRegistries) reset() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	for _, registries := range w.registries {
+		for _, registry := range registries {
+			registry.close()
+		}
+	}
+	w.registries = map[kcache.SharedIndexInformer][]*handlerRegistry{}
+}
+
+// watchHandle removes a handler from its registry when it is cancelled, its context
+// is done, or its registry is closed.
+type watchHandle struct {
+	once   sync.Once
+	remove func()
+	done   chan struct{}
+}
+
+var _ store.WatchHandle = (*watchHandle)(nil)
+
+func newWatchHandle(ctx context.Context, registryDone <-chan struct{}, remove func()) *watchHandle {
+	h := &watchHandle{
+		remove: remove,
+		done:   make(chan struct{}),
+	}
+
+	go func() {
+		select {
+		case <-ctx.Done():
+			h.Cancel()
+		case <-registryDone:
+			h.Cancel()
+		case <-h.done:
+		}
+	}()
+
+	return h
+}
+
+// removedWatchHandle returns a handle for a handler which was never added.
+func removedWatchHandle() *watchHandle {
+	h := &watchHandle{
+		remove: func() {},
+		done:   make(chan struct{}),
+	}
+	h.Cancel()
+	return h
+}
+
+// Cancel removes the handler. Events being passed to handlers when it is called may
+// still reach the handler.
+func (h *watchHandle) Cancel() {
+	h.once.Do(func() {
+		h.remove()
+		close(h.done)
+	})
+}
+
+// Done is closed once the handler is removed.
+func (h *watchHandle) Done() <-chan struct{} {
+	return h.done
+}
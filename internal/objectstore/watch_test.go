@@ -0,0 +1,152 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package objectstore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/wait"
+	kcache "k8s.io/client-go/tools/cache"
+)
+
+func Test_handlerRegistry(t *testing.T) {
+	registry := newHandlerRegistry()
+
+	var added, updated, deleted []interface{}
+	id := registry.add(kcache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			added = append(added, obj)
+		},
+		UpdateFunc: func(_, newObj interface{}) {
+			updated = append(updated, newObj)
+		},
+		DeleteFunc: func(obj interface{}) {
+			deleted = append(deleted, obj)
+		},
+	}, nil)
+
+	registry.OnAdd("a")
+	registry.OnUpdate("a", "b")
+	registry.OnDelete("b")
+	assert.Equal(t, []interface{}{"a"}, added)
+	assert.Equal(t, []interface{}{"b"}, updated)
+	assert.Equal(t, []interface{}{"b"}, deleted)
+
+	registry.remove(id)
+	assert.Equal(t, 0, registry.len())
+
+	registry.OnAdd("c")
+	assert.Equal(t, []interface{}{"a"}, added)
+}
+
+func Test_handlerRegistry_existing(t *testing.T) {
+	registry := newHandlerRegistry()
+
+	var added []interface{}
+	registry.add(kcache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			added = append(added, obj)
+		},
+	}, func() []interface{} {
+		return []interface{}{"a", "b"}
+	})
+
+	registry.OnAdd("c")
+	assert.Equal(t, []interface{}{"a", "b", "c"}, added)
+}
+
+func Test_watchRegistries_add(t *testing.T) {
+	pod := &unstructured.Unstructured{}
+	pod.SetNamespace("default")
+	pod.SetName("a")
+
+	informer := kcache.NewSharedIndexInformer(&kcache.ListWatch{}, nil, 0, kcache.Indexers{})
+	require.NoError(t, informer.GetStore().Add(pod))
+
+	registries := newWatchRegistries()
+	first, _ := registries.add(informer, kcache.ResourceEventHandlerFuncs{})
+
+	// Handlers added to an existing registry are passed the informer's objects, since
+	// the informer only does that for the registry.
+	var added []interface{}
+	second, _ := registries.add(informer, kcache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			added = append(added, obj)
+		},
+	})
+	assert.Equal(t, first, second)
+	assert.Equal(t, []interface{}{pod}, added)
+	assert.Equal(t, 2, first.len())
+}
+
+func Test_watchRegistries_reset(t *testing.T) {
+	informer := kcache.NewSharedIndexInformer(&kcache.ListWatch{}, nil, 0, kcache.Indexers{})
+
+	registries := newWatchRegistries()
+	registry, id := registries.add(informer, kcache.ResourceEventHandlerFuncs{})
+	h := newWatchHandle(context.Background(), registry.done, func() {
+		registry.remove(id)
+	})
+
+	registries.reset()
+	assert.Empty(t, registries.registries)
+
+	select {
+	case <-h.Done():
+	case <-time.After(wait.ForeverTestTimeout):
+		t.Fatal("handle was not removed when its registry was closed")
+	}
+	assert.Equal(t, 0, registry.len())
+}
+
+func Test_watchHandle_Cancel(t *testing.T) {
+	registry := newHandlerRegistry()
+	id := registry.add(kcache.ResourceEventHandlerFuncs{}, nil)
+
+	h := newWatchHandle(context.Background(), registry.done, func() {
+		registry.remove(id)
+	})
+
+	h.Cancel()
+	h.Cancel()
+
+	assert.Equal(t, 0, registry.len())
+	select {
+	case <-h.Done():
+	default:
+		t.Fatal("handle is not done")
+	}
+}
+
+func Test_watchHandle_contextDone(t *testing.T) {
+	registry := newHandlerRegistry()
+	id := registry.add(kcache.ResourceEventHandlerFuncs{}, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	h := newWatchHandle(ctx, registry.done, func() {
+		registry.remove(id)
+	})
+	cancel()
+
+	select {
+	case <-h.Done():
+	case <-time.After(wait.ForeverTestTimeout):
+		t.Fatal("handle was not removed when its context was done")
+	}
+	assert.Equal(t, 0, registry.len())
+}
+
+func Test_removedWatchHandle(t *testing.T) {
+	h := removedWatchHandle()
+	_, open := <-h.Done()
+	require.False(t, open)
+	h.Cancel()
+}
@@ -0,0 +1,151 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package objectstore
+
+import (
+	"context"
+	"expvar"
+	"fmt"
+	"time"
+
+	"go.opencensus.io/trace"
+
+	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+const (
+	// defaultWatchdogInterval is how often informers are checked for stale objects.
+	defaultWatchdogInterval = 30 * time.Second
+)
+
+// informerRestarts counts the informers restarted by the watchdog by group version kind.
+var informerRestarts = expvar.NewMap("octant.objectstore.informerRestarts")
+
+// WatchdogInterval sets how often a DynamicCache checks its informers and restarts the
+// ones whose objects are stale. An interval less than one uses the default interval.
+func WatchdogInterval(interval time.Duration) DynamicCacheOpt {
+	return func(dc *DynamicCache) {
+		if interval < 1 {
+			return
+		}
+		dc.watchdogInterval = interval
+	}
+}
+
+// runWatchdog restarts stale informers until ctx is done.
+func (dc *DynamicCache) runWatchdog(ctx context.Context) {
+	ticker := time.NewTicker(dc.watchdogInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			dc.checkInformers(ctx)
+		}
+	}
+}
+
+// checkInformers restarts the informers whose watch stopped or who stopped receiving
+// events. Restarting an informer lists its objects again and starts a new watch.
+func (dc *DynamicCache) checkInformers(ctx context.Context) {
+	logger := log.From(ctx).With("component", "DynamicCache")
+
+	// A factory can be used for more than one namespace.
+	checked := map[InformerFactory]bool{}
+	for _, namespace := range dc.factories.keys() {
+		factory, ok := dc.factories.get(namespace)
+		if !ok || checked[factory] {
+			continue
+		}
+		checked[factory] = true
+
+		statuser, ok := factory.(informerStatuser)
+		if !ok {
+			continue
+		}
+
+		now := time.Now()
+		for _, status := range statuser.InformerStatuses() {
+			if !status.Stale(now) {
+				continue
+			}
+
+			apiVersion, kind := status.GroupVersionKind.ToAPIVersionAndKind()
+			key := store.Key{Namespace: status.Namespace, APIVersion: apiVersion, Kind: kind}
+			if dc.isBackingOff(ctx, key) {
+				continue
+			}
+
+			reason := fmt.Sprintf("no events since %s", status.LastEvent.Format(time.RFC3339))
+			if status.WatchError != nil {
+				reason = fmt.Sprintf("watch failed: %s", status.WatchError)
+			}
+
+			informerLogger := logger.With(
+				"gvk", status.GroupVersionKind.String(),
+				"namespace", status.Namespace,
+				"reason", reason)
+			informerLogger.Warnf("restarting stale informer")
+
+			if err := dc.restartInformer(ctx, factory, status, reason); err != nil {
+				informerLogger.WithErr(err).Errorf("restart stale informer")
+			}
+		}
+	}
+}
+
+// restartInformer replaces the informer of a factory. Handlers watching the informer
+// are moved to its replacement, and objects are read from the cluster until the
+// replacement has synced.
+func (dc *DynamicCache) restartInformer(ctx context.Context, factory InformerFactory, status InformerStatus, reason string) error {
+	ctx, span := trace.StartSpan(ctx, "dynamicCache:restartInformer")
+	defer span.End()
+
+	groupVersionKind := status.GroupVersionKind
+	span.Annotate([]trace.Attribute{
+		trace.StringAttribute("namespace", status.Namespace),
+		trace.StringAttribute("gvk", groupVersionKind.String()),
+		trace.StringAttribute("reason", reason),
+	}, "restart informer")
+
+	informerRestarts.Add(groupVersionKind.String(), 1)
+
+	previous, err := factory.ForResource(groupVersionKind)
+	if err != nil {
+		return fmt.Errorf("find informer for %s: %w", groupVersionKind, err)
+	}
+
+	factory.Delete(groupVersionKind)
+
+	informer, err := factory.ForResource(groupVersionKind)
+	if err != nil {
+		return fmt.Errorf("create informer for %s: %w", groupVersionKind, err)
+	}
+
+	dc.watchRegistries.move(previous.Informer(), informer.Informer())
+
+	for _, key := range dc.seenGVKs.keys() {
+		if key.GroupVersionKind() != groupVersionKind {
+			continue
+		}
+		if keyFactory, ok := dc.factories.get(key.Namespace); !ok || keyFactory != factory {
+			continue
+		}
+
+		dc.seenGVKs.setSeen(key.Namespace, groupVersionKind, false)
+		dc.informerSynced.setSynced(key, false)
+
+		// Wait for the new informer to sync.
+		if _, _, err := dc.currentInformer(ctx, key); err != nil {
+			return fmt.Errorf("sync informer for %s: %w", key, err)
+		}
+	}
+
+	return nil
+}
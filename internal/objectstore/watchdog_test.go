@@ -0,0 +1,152 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package objectstore
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/informers"
+	kcache "k8s.io/client-go/tools/cache"
+
+	clusterFake "github.com/vmware-tanzu/octant/internal/cluster/fake"
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+type stubGenericInformer struct {
+	informer kcache.SharedIndexInformer
+}
+
+var _ informers.GenericInformer = (*stubGenericInformer)(nil)
+
+func newStubGenericInformer() *stubGenericInformer {
+	informer := kcache.NewSharedIndexInformer(&kcache.ListWatch{}, &unstructured.Unstructured{}, 0, kcache.Indexers{})
+	return &stubGenericInformer{informer: informer}
+}
+
+func (i *stubGenericInformer) Informer() kcache.SharedIndexInformer {
+	return i.informer
+}
+
+func (i *stubGenericInformer) Lister() kcache.GenericLister {
+	return kcache.NewGenericLister(i.informer.GetIndexer(), schema.GroupResource{Resource: "pods"})
+}
+
+// stubInformerFactory is an informer factory with an informer for each group version
+// kind whose status is set by tests.
+type stubInformerFactory struct {
+	informers map[schema.GroupVersionKind]*stubGenericInformer
+	statuses  map[schema.GroupVersionKind]InformerStatus
+	deleted   []schema.GroupVersionKind
+}
+
+var _ InformerFactory = (*stubInformerFactory)(nil)
+var _ informerStatuser = (*stubInformerFactory)(nil)
+
+func (f *stubInformerFactory) ForResource(groupVersionKind schema.GroupVersionKind) (informers.GenericInformer, error) {
+	informer, ok := f.informers[groupVersionKind]
+	if !ok {
+		informer = newStubGenericInformer()
+		f.informers[groupVersionKind] = informer
+		f.statuses[groupVersionKind] = InformerStatus{GroupVersionKind: groupVersionKind}
+	}
+	return informer, nil
+}
+
+func (f *stubInformerFactory) Delete(groupVersionKind schema.GroupVersionKind) {
+	f.deleted = append(f.deleted, groupVersionKind)
+	delete(f.informers, groupVersionKind)
+	delete(f.statuses, groupVersionKind)
+}
+
+func (f *stubInformerFactory) WaitForCacheSync(<-chan struct{}) map[schema.GroupVersionKind]bool {
+	return nil
+}
+
+func (f *stubInformerFactory) InformerStatus(groupVersionKind schema.GroupVersionKind) (InformerStatus, bool) {
+	status, ok := f.statuses[groupVersionKind]
+	return status, ok
+}
+
+func (f *stubInformerFactory) InformerStatuses() []InformerStatus {
+	var statuses []InformerStatus
+	for _, status := range f.statuses {
+		statuses = append(statuses, status)
+	}
+	return statuses
+}
+
+func TestDynamicCache_checkInformers(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	podGVK := schema.GroupVersionKind{Version: "v1", Kind: "Pod"}
+	serviceGVK := schema.GroupVersionKind{Version: "v1", Kind: "Service"}
+
+	factory := &stubInformerFactory{
+		informers: map[schema.GroupVersionKind]*stubGenericInformer{},
+		statuses:  map[schema.GroupVersionKind]InformerStatus{},
+	}
+
+	synced := make(chan store.Key, 1)
+	dc := &DynamicCache{
+		ctx:             context.Background(),
+		client:          clusterFake.NewMockClientInterface(controller),
+		factories:       initFactoriesCache(),
+		seenGVKs:        initSeenGVKsCache(),
+		informerSynced:  initInformerSynced(),
+		watchRegistries: newWatchRegistries(),
+		syncTimeoutFunc: func(context.Context, store.Key, chan bool) {},
+		waitForSyncFunc: func(_ context.Context, key store.Key, _ *DynamicCache, _ informers.GenericInformer, _ chan bool) {
+			synced <- key
+		},
+	}
+	dc.factories.set("", factory)
+	dc.factories.set("default", factory)
+
+	ctx := context.Background()
+	podKey := store.Key{Namespace: "default", APIVersion: "v1", Kind: "Pod"}
+	serviceKey := store.Key{Namespace: "default", APIVersion: "v1", Kind: "Service"}
+
+	previous, _, err := dc.currentInformer(ctx, podKey)
+	require.NoError(t, err)
+	assert.Equal(t, podKey, <-synced)
+	registry, _ := dc.watchRegistries.add(previous.Informer(), kcache.ResourceEventHandlerFuncs{})
+
+	previousService, _, err := dc.currentInformer(ctx, serviceKey)
+	require.NoError(t, err)
+	assert.Equal(t, serviceKey, <-synced)
+	require.NoError(t, err)
+
+	factory.statuses[podGVK] = InformerStatus{
+		GroupVersionKind: podGVK,
+		WatchError:       fmt.Errorf("connection refused"),
+		WatchErrorTime:   time.Now(),
+	}
+
+	dc.checkInformers(ctx)
+
+	assert.Equal(t, []schema.GroupVersionKind{podGVK}, factory.deleted)
+
+	current, ok := factory.informers[podGVK]
+	require.True(t, ok)
+	assert.NotEqual(t, previous, current)
+	assert.Equal(t, []*handlerRegistry{registry}, dc.watchRegistries.registries[current.Informer()])
+
+	// Objects are read from the cluster until the new informer syncs.
+	assert.False(t, dc.informerSynced.hasSynced(podKey))
+	assert.Equal(t, podKey, <-synced)
+
+	// Current informers aren't restarted.
+	assert.Equal(t, previousService, factory.informers[serviceGVK])
+}
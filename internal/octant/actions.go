@@ -7,24 +7,53 @@ import (
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime"
 
+	"github.com/vmware-tanzu/octant/internal/kubectl"
 	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/store"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
 
 const (
-	ActionDeleteObject            = "action.octant.dev/deleteObject"
-	ActionOverviewCordon          = "action.octant.dev/cordon"
-	ActionOverviewUncordon        = "action.octant.dev/uncordon"
-	ActionOverviewContainerEditor = "action.octant.dev/containerEditor"
-	ActionOverviewCronjob         = "action.octant.dev/cronJob"
-	ActionOverviewSuspendCronjob  = "action.octant.dev/suspendCronJob"
-	ActionOverviewResumeCronjob   = "action.octant.dev/resumeCronJob"
-	ActionOverviewServiceEditor   = "action.octant.dev/serviceEditor"
-	ActionDeploymentConfiguration = "action.octant.dev/deploymentConfiguration"
-	ActionUpdateObject            = "action.octant.dev/update"
-	ActionApplyYaml               = "action.octant.dev/apply"
+	ActionDeleteObject                = "action.octant.dev/deleteObject"
+	ActionExportObject                = "action.octant.dev/exportObject"
+	ActionOverviewCordon              = "action.octant.dev/cordon"
+	ActionOverviewUncordon            = "action.octant.dev/uncordon"
+	ActionDrainNode                   = "action.octant.dev/drainNode"
+	ActionCancelDrainNode             = "action.octant.dev/cancelDrainNode"
+	ActionSetNodeTaint                = "action.octant.dev/setNodeTaint"
+	ActionRemoveNodeTaint             = "action.octant.dev/removeNodeTaint"
+	ActionOverviewContainerEditor     = "action.octant.dev/containerEditor"
+	ActionOverviewCronjob             = "action.octant.dev/cronJob"
+	ActionOverviewSuspendCronjob      = "action.octant.dev/suspendCronJob"
+	ActionOverviewResumeCronjob       = "action.octant.dev/resumeCronJob"
+	ActionOverviewServiceEditor       = "action.octant.dev/serviceEditor"
+	ActionDeploymentConfiguration     = "action.octant.dev/deploymentConfiguration"
+	ActionHorizontalPodAutoscalerEdit = "action.octant.dev/horizontalPodAutoscalerEdit"
+	ActionServicePortEditor           = "action.octant.dev/servicePortEditor"
+	ActionPauseDeployment             = "action.octant.dev/pauseDeployment"
+	ActionResumeDeployment            = "action.octant.dev/resumeDeployment"
+	ActionUpdateObject                = "action.octant.dev/update"
+	ActionDiffObject                  = "action.octant.dev/diffObject"
+	ActionCompareObject               = "action.octant.dev/compareObject"
+	ActionApplyYaml                   = "action.octant.dev/apply"
+	ActionValidateYaml                = "action.octant.dev/validateYaml"
+	ActionStopPortForward             = "overview/stopPortForward"
+	ActionDownloadContainerFiles      = "action.octant.dev/downloadContainerFiles"
+	ActionUploadContainerFiles        = "action.octant.dev/uploadContainerFiles"
+	ActionResizePersistentVolumeClaim = "action.octant.dev/resizePersistentVolumeClaim"
+	ActionAddWebhookRule              = "action.octant.dev/addWebhookRule"
+	ActionRemoveWebhookRule           = "action.octant.dev/removeWebhookRule"
+	ActionRunKubectlPlugin            = "action.octant.dev/runKubectlPlugin"
+	ActionReplayRecording             = "action.octant.dev/replayRecording"
+	ActionReplayLive                  = "action.octant.dev/replayLive"
+	ActionRevealSecretValue           = "action.octant.dev/revealSecretValue"
 )
 
+// sendDefaultAlert sends an alert which expires after the default duration.
+func sendDefaultAlert(alerter action.Alerter, alertType action.AlertType, message string) {
+	alerter.SendAlert(action.CreateAlert(alertType, message, action.DefaultAlertExpiration))
+}
+
 func sendAlert(alerter action.Alerter, alertType action.AlertType, message string, expiration *time.Time) {
 	alert := action.Alert{
 		Type:       alertType,
@@ -46,8 +75,13 @@ func DeleteObjectConfirmationButton(object runtime.Object) (component.ButtonOpti
 		return nil, err
 	}
 
+	key, err := store.KeyFromObject(object)
+	if err != nil {
+		return nil, err
+	}
+
 	confirmationTitle := fmt.Sprintf("Delete %s", kind)
-	confirmationBody := fmt.Sprintf("Are you sure you want to delete *%s* **%s**? This action is permanent and cannot be recovered.", kind, accessor.GetName())
+	confirmationBody := fmt.Sprintf("Are you sure you want to delete *%s* **%s**? This action is permanent and cannot be recovered.\n\nkubectl equivalent: `%s`", kind, accessor.GetName(), kubectl.Delete(key))
 	return component.WithButtonConfirmation(confirmationTitle, confirmationBody), nil
 }
 
@@ -62,8 +96,13 @@ func DeleteObjectConfirmation(object runtime.Object) (*component.Confirmation, e
 		return nil, err
 	}
 
+	key, err := store.KeyFromObject(object)
+	if err != nil {
+		return nil, err
+	}
+
 	confirmationTitle := fmt.Sprintf("Delete %s", kind)
-	confirmationBody := fmt.Sprintf("Are you sure you want to delete *%s* **%s**? This action is permanent and cannot be recovered.", kind, accessor.GetName())
+	confirmationBody := fmt.Sprintf("Are you sure you want to delete *%s* **%s**? This action is permanent and cannot be recovered.\n\nkubectl equivalent: `%s`", kind, accessor.GetName(), kubectl.Delete(key))
 
 	return &component.Confirmation{
 		Title: confirmationTitle,
@@ -25,7 +25,7 @@ func Test_DeleteObjectConfirmation(t *testing.T) {
 	expected := component.Button{
 		Confirmation: &component.Confirmation{
 			Title: "Delete Pod",
-			Body:  "Are you sure you want to delete *Pod* **pod**? This action is permanent and cannot be recovered.",
+			Body:  "Are you sure you want to delete *Pod* **pod**? This action is permanent and cannot be recovered.\n\nkubectl equivalent: `kubectl delete pod/pod --namespace namespace`",
 		},
 	}
 
@@ -69,6 +69,61 @@ func (p *ApplyYaml) Handle(ctx context.Context, alerter action.Alerter, payload
 	return nil
 }
 
+// ValidateYaml validates yaml with a server-side dry run before it is applied.
+type ValidateYaml struct {
+	logger      log.Logger
+	objectStore store.Store
+}
+
+var _ action.Dispatcher = (*ValidateYaml)(nil)
+
+// NewValidateYaml creates an instance of ValidateYaml
+func NewValidateYaml(logger log.Logger, objectStore store.Store) *ValidateYaml {
+	return &ValidateYaml{
+		logger:      logger,
+		objectStore: objectStore,
+	}
+}
+
+// ActionName returns the name of this action
+func (v *ValidateYaml) ActionName() string {
+	return ActionValidateYaml
+}
+
+// Handle validates the requested yaml and sends an alert with the result for
+// each resource. Nothing is changed in the cluster.
+func (v *ValidateYaml) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	v.logger.With("payload", payload).Debugf("received action payload")
+
+	request, err := applyYamlRequestFromPayload(payload)
+	if err != nil {
+		return errors.Wrap(err, "convert payload to apply yaml request")
+	}
+
+	results, err := v.objectStore.DryRunFromYAML(ctx, request.Namespace, request.Update)
+	if err != nil {
+		v.logger.Warnf("unable to validate yaml: %s", err)
+		message := fmt.Sprintf("Unable to validate yaml: %s", err)
+		alerter.SendAlert(action.CreateAlert(action.AlertTypeError, message, action.DefaultAlertExpiration))
+		return nil
+	}
+
+	if len(results) == 0 {
+		alerter.SendAlert(action.CreateAlert(action.AlertTypeWarning, "There are no resources to apply", action.DefaultAlertExpiration))
+		return nil
+	}
+
+	for _, result := range results {
+		alertType := action.AlertTypeInfo
+		if result.Err != nil {
+			alertType = action.AlertTypeError
+		}
+		alerter.SendAlert(action.CreateAlert(alertType, result.String(), action.DefaultAlertExpiration))
+	}
+
+	return nil
+}
+
 type applyYamlRequest struct {
 	Namespace string `json:"namespace,omitempty"`
 	Update    string `json:"update,omitempty"`
@@ -18,6 +18,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
@@ -333,3 +334,207 @@ data: {
 
 	require.NoError(t, applyYaml.Handle(ctx, alerter, payload))
 }
+
+func TestNewValidateYaml(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	logger := log.NopLogger()
+	clusterClient := clusterFake.NewMockClientInterface(controller)
+	dynamicClient := clusterFake.NewMockDynamicInterface(controller)
+	nsResourceClient := clusterFake.NewMockNamespaceableResourceInterface(controller)
+	dynamicCache := fake.NewMockStore(controller)
+	alerter := actionFake.NewMockAlerter(controller)
+
+	gvr := schema.GroupVersionResource{
+		Version:  "v1",
+		Resource: "configmaps",
+	}
+
+	update := `
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: greeting
+data:
+  hello: world
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: existing
+---
+apiVersion: example.com/v1
+kind: Widget
+metadata:
+  name: widget
+`
+	notFound := kerrors.NewNotFound(schema.GroupResource{}, "greeting")
+	invalid := kerrors.NewBadRequest("data is invalid")
+
+	clusterClient.EXPECT().Resource(schema.GroupKind{Kind: "ConfigMap"}).Return(gvr, true, nil).Times(2)
+	clusterClient.EXPECT().Resource(schema.GroupKind{Group: "example.com", Kind: "Widget"}).
+		Return(schema.GroupVersionResource{}, false, fmt.Errorf("no matches for kind"))
+	clusterClient.EXPECT().DynamicClient().Return(dynamicClient, nil).Times(2)
+	dynamicClient.EXPECT().Resource(gvr).Return(nsResourceClient).Times(2)
+	nsResourceClient.EXPECT().Namespace("default").Return(nsResourceClient).Times(2)
+
+	greetingKey := store.Key{Namespace: "default", APIVersion: "v1", Kind: "ConfigMap", Name: "greeting"}
+	existingKey := store.Key{Namespace: "default", APIVersion: "v1", Kind: "ConfigMap", Name: "existing"}
+
+	dynamicCache.EXPECT().Get(context.TODO(), greetingKey).Return(nil, notFound)
+	nsResourceClient.EXPECT().
+		Create(context.TODO(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, object *unstructured.Unstructured, options metav1.CreateOptions, _ ...string) (*unstructured.Unstructured, error) {
+			assert.Equal(t, "greeting", object.GetName())
+			assert.Equal(t, []string{metav1.DryRunAll}, options.DryRun)
+			return object, nil
+		})
+
+	dynamicCache.EXPECT().Get(context.TODO(), existingKey).Return(&unstructured.Unstructured{}, nil)
+	nsResourceClient.EXPECT().
+		Patch(context.TODO(), "existing", types.ApplyPatchType, gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, _ types.PatchType, _ []byte, options metav1.PatchOptions, _ ...string) (*unstructured.Unstructured, error) {
+			assert.Equal(t, []string{metav1.DryRunAll}, options.DryRun)
+			return nil, invalid
+		})
+
+	output, err := objectstore.DryRunFromHandler(
+		context.TODO(),
+		"default",
+		update,
+		dynamicCache.Get,
+		clusterClient)
+	require.NoError(t, err)
+	dynamicCache.EXPECT().DryRunFromYAML(gomock.Any(), "default", update).Return(output, err)
+
+	var alerts []action.Alert
+	alerter.EXPECT().
+		SendAlert(gomock.Any()).
+		Do(func(alert action.Alert) {
+			alerts = append(alerts, alert)
+		}).
+		Times(3)
+
+	validateYaml := NewValidateYaml(logger, dynamicCache)
+
+	payload := action.CreatePayload(ActionValidateYaml, map[string]interface{}{
+		"update":    update,
+		"namespace": "default",
+	})
+
+	require.NoError(t, validateYaml.Handle(context.Background(), alerter, payload))
+
+	require.Len(t, alerts, 3)
+	assert.Equal(t, action.AlertTypeInfo, alerts[0].Type)
+	assert.Equal(t, "ConfigMap (v1) greeting in default will be created", alerts[0].Message)
+	assert.Equal(t, action.AlertTypeError, alerts[1].Type)
+	assert.Equal(t, "ConfigMap (v1) existing in default is invalid: data is invalid", alerts[1].Message)
+	assert.Equal(t, action.AlertTypeError, alerts[2].Type)
+	assert.Equal(t, "Widget (example.com/v1) widget is invalid: unable to discover resource: no matches for kind", alerts[2].Message)
+}
+
+func TestNewValidateYaml_createdNamespace(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	clusterClient := clusterFake.NewMockClientInterface(controller)
+	dynamicClient := clusterFake.NewMockDynamicInterface(controller)
+	nsResourceClient := clusterFake.NewMockNamespaceableResourceInterface(controller)
+	dynamicCache := fake.NewMockStore(controller)
+
+	namespacesGVR := schema.GroupVersionResource{Version: "v1", Resource: "namespaces"}
+	configMapsGVR := schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}
+
+	update := `
+---
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: team
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: greeting
+  namespace: team
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: forbidden
+`
+
+	clusterClient.EXPECT().Resource(schema.GroupKind{Kind: "Namespace"}).Return(namespacesGVR, false, nil)
+	clusterClient.EXPECT().Resource(schema.GroupKind{Kind: "ConfigMap"}).Return(configMapsGVR, true, nil).Times(2)
+	clusterClient.EXPECT().DynamicClient().Return(dynamicClient, nil).Times(3)
+	dynamicClient.EXPECT().Resource(namespacesGVR).Return(nsResourceClient)
+	dynamicClient.EXPECT().Resource(configMapsGVR).Return(nsResourceClient).Times(2)
+	nsResourceClient.EXPECT().Namespace("team").Return(nsResourceClient)
+	nsResourceClient.EXPECT().Namespace("default").Return(nsResourceClient)
+
+	namespaceKey := store.Key{APIVersion: "v1", Kind: "Namespace", Name: "team"}
+	dynamicCache.EXPECT().Get(context.TODO(), namespaceKey).
+		Return(nil, kerrors.NewNotFound(schema.GroupResource{Resource: "namespaces"}, "team"))
+	nsResourceClient.EXPECT().
+		Create(context.TODO(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, object *unstructured.Unstructured, _ metav1.CreateOptions, _ ...string) (*unstructured.Unstructured, error) {
+			assert.Equal(t, "team", object.GetName())
+			return object, nil
+		})
+
+	// The namespace doesn't exist yet, so creating the config map in it fails.
+	nsResourceClient.EXPECT().
+		Create(context.TODO(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, object *unstructured.Unstructured, _ metav1.CreateOptions, _ ...string) (*unstructured.Unstructured, error) {
+			assert.Equal(t, "greeting", object.GetName())
+			return nil, kerrors.NewNotFound(schema.GroupResource{Resource: "namespaces"}, "team")
+		})
+
+	forbiddenKey := store.Key{Namespace: "default", APIVersion: "v1", Kind: "ConfigMap", Name: "forbidden"}
+	dynamicCache.EXPECT().Get(context.TODO(), forbiddenKey).Return(nil, fmt.Errorf("access denied"))
+
+	results, err := objectstore.DryRunFromHandler(
+		context.TODO(),
+		"default",
+		update,
+		dynamicCache.Get,
+		clusterClient)
+	require.NoError(t, err)
+
+	require.Len(t, results, 3)
+	assert.Equal(t, "Namespace (v1) team will be created", results[0].String())
+	assert.Equal(t, "ConfigMap (v1) greeting in team will be created", results[1].String())
+	assert.Equal(t, "ConfigMap (v1) forbidden in default is invalid: unable to get resource: access denied", results[2].String())
+}
+
+func TestNewValidateYaml_Error(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	logger := log.NopLogger()
+	objectStore := fake.NewMockStore(controller)
+	alerter := actionFake.NewMockAlerter(controller)
+
+	update := `data: {`
+	objectStore.EXPECT().
+		DryRunFromYAML(gomock.Any(), "default", update).
+		Return(nil, fmt.Errorf("unable to parse yaml"))
+
+	alerter.EXPECT().
+		SendAlert(gomock.Any()).
+		DoAndReturn(func(alert action.Alert) {
+			assert.Equal(t, action.AlertTypeError, alert.Type)
+			assert.Equal(t, "Unable to validate yaml: unable to parse yaml", alert.Message)
+		})
+
+	validateYaml := NewValidateYaml(logger, objectStore)
+
+	payload := action.CreatePayload(ActionValidateYaml, map[string]interface{}{
+		"update":    update,
+		"namespace": "default",
+	})
+
+	require.NoError(t, validateYaml.Handle(context.Background(), alerter, payload))
+}
@@ -0,0 +1,204 @@
+/*
+ * Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package octant
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/afero"
+
+	"github.com/vmware-tanzu/octant/internal/filecopy"
+	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+// DefaultDownloadDirectory is the local directory container files are downloaded to
+// unless another is chosen.
+func DefaultDownloadDirectory() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return os.TempDir()
+	}
+	return filepath.Join(home, "Downloads")
+}
+
+// ContainerFileDownloader downloads files from a container to a local directory.
+type ContainerFileDownloader struct {
+	copier *filecopy.Copier
+	fs     afero.Fs
+}
+
+var _ action.Dispatcher = (*ContainerFileDownloader)(nil)
+
+// NewContainerFileDownloader creates an instance of ContainerFileDownloader.
+func NewContainerFileDownloader(executor filecopy.Executor, fs afero.Fs) *ContainerFileDownloader {
+	return &ContainerFileDownloader{
+		copier: filecopy.NewCopier(executor),
+		fs:     fs,
+	}
+}
+
+// ActionName returns the name of this action.
+func (d *ContainerFileDownloader) ActionName() string {
+	return ActionDownloadContainerFiles
+}
+
+// Handle saves a tar archive of a file or directory in a container to a local directory.
+func (d *ContainerFileDownloader) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	logger := log.From(ctx).With("actionName", d.ActionName())
+	logger.With("payload", payload).Infof("received action payload")
+
+	target, err := containerFileTarget(payload)
+	if err != nil {
+		return err
+	}
+
+	containerPath, err := payload.String("containerPath")
+	if err != nil {
+		return err
+	}
+
+	localDirectory, err := payload.OptionalString("localDirectory")
+	if err != nil {
+		return err
+	}
+	if strings.TrimSpace(localDirectory) == "" {
+		localDirectory = DefaultDownloadDirectory()
+	}
+
+	name := fmt.Sprintf("%s-%s-%s", target.Pod, target.Container, filecopy.ArchiveName(containerPath))
+	localPath := filepath.Join(localDirectory, name)
+
+	message := fmt.Sprintf("Saved %q from container %q to %s", containerPath, target.Container, localPath)
+	alertType := action.AlertTypeInfo
+	if err := d.download(ctx, target, containerPath, localPath); err != nil {
+		message = fmt.Sprintf("Unable to download %q from container %q: %s", containerPath, target.Container, err)
+		alertType = action.AlertTypeWarning
+		logger.WithErr(err).Errorf("download container files")
+	}
+
+	alerter.SendAlert(action.CreateAlert(alertType, message, action.DefaultAlertExpiration))
+	return nil
+}
+
+func (d *ContainerFileDownloader) download(ctx context.Context, target filecopy.Target, containerPath, localPath string) error {
+	if err := d.fs.MkdirAll(filepath.Dir(localPath), 0755); err != nil {
+		return errors.Wrap(err, "create download directory")
+	}
+
+	f, err := d.fs.Create(localPath)
+	if err != nil {
+		return err
+	}
+
+	err = d.copier.Download(ctx, target, containerPath, f)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		// Don't leave a partial archive behind.
+		_ = d.fs.Remove(localPath)
+		return err
+	}
+
+	return nil
+}
+
+// ContainerFileUploader uploads a local file or directory into a container.
+type ContainerFileUploader struct {
+	copier *filecopy.Copier
+	fs     afero.Fs
+}
+
+var _ action.Dispatcher = (*ContainerFileUploader)(nil)
+
+// NewContainerFileUploader creates an instance of ContainerFileUploader.
+func NewContainerFileUploader(executor filecopy.Executor, fs afero.Fs) *ContainerFileUploader {
+	return &ContainerFileUploader{
+		copier: filecopy.NewCopier(executor),
+		fs:     fs,
+	}
+}
+
+// ActionName returns the name of this action.
+func (u *ContainerFileUploader) ActionName() string {
+	return ActionUploadContainerFiles
+}
+
+// Handle copies a local file or directory into a directory in a container.
+func (u *ContainerFileUploader) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	logger := log.From(ctx).With("actionName", u.ActionName())
+	logger.With("payload", payload).Infof("received action payload")
+
+	target, err := containerFileTarget(payload)
+	if err != nil {
+		return err
+	}
+
+	localPath, err := payload.String("localPath")
+	if err != nil {
+		return err
+	}
+
+	containerDirectory, err := payload.String("containerDirectory")
+	if err != nil {
+		return err
+	}
+
+	message := fmt.Sprintf("Uploaded %s to %q in container %q", localPath, containerDirectory, target.Container)
+	alertType := action.AlertTypeInfo
+	if err := u.upload(ctx, target, localPath, containerDirectory); err != nil {
+		message = fmt.Sprintf("Unable to upload %s to container %q: %s", localPath, target.Container, err)
+		alertType = action.AlertTypeWarning
+		logger.WithErr(err).Errorf("upload container files")
+	}
+
+	alerter.SendAlert(action.CreateAlert(alertType, message, action.DefaultAlertExpiration))
+	return nil
+}
+
+func (u *ContainerFileUploader) upload(ctx context.Context, target filecopy.Target, localPath, containerDirectory string) error {
+	if _, err := u.fs.Stat(localPath); err != nil {
+		return err
+	}
+
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(filecopy.ArchivePath(u.fs, localPath, pw))
+	}()
+
+	if err := u.copier.Upload(ctx, target, containerDirectory, pr); err != nil {
+		pr.CloseWithError(err)
+		return err
+	}
+
+	return nil
+}
+
+func containerFileTarget(payload action.Payload) (filecopy.Target, error) {
+	key, err := store.KeyFromPayload(payload)
+	if err != nil {
+		return filecopy.Target{}, err
+	}
+
+	containerName, err := payload.String("containerName")
+	if err != nil {
+		return filecopy.Target{}, err
+	}
+
+	return filecopy.Target{
+		Namespace: key.Namespace,
+		Pod:       key.Name,
+		Container: containerName,
+	}, nil
+}
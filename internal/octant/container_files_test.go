@@ -0,0 +1,164 @@
+/*
+ * Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package octant_test
+
+import (
+	"archive/tar"
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/octant/internal/filecopy"
+	fileCopyFake "github.com/vmware-tanzu/octant/internal/filecopy/fake"
+	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	actionFake "github.com/vmware-tanzu/octant/pkg/action/fake"
+)
+
+var containerFileTarget = filecopy.Target{Namespace: "default", Pod: "pod", Container: "app"}
+
+func containerFilePayload(actionName string, fields map[string]interface{}) action.Payload {
+	payload := map[string]interface{}{
+		"apiVersion":    "v1",
+		"kind":          "Pod",
+		"namespace":     "default",
+		"name":          "pod",
+		"containerName": "app",
+	}
+	for k, v := range fields {
+		payload[k] = v
+	}
+	return action.CreatePayload(actionName, payload)
+}
+
+func expectAlert(t *testing.T, alerter *actionFake.MockAlerter, alertType action.AlertType, message string) {
+	alerter.EXPECT().
+		SendAlert(gomock.Any()).
+		Do(func(alert action.Alert) {
+			assert.Equal(t, alertType, alert.Type)
+			assert.Equal(t, message, alert.Message)
+		})
+}
+
+func TestContainerFileDownloader(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	fs := afero.NewMemMapFs()
+	executor := fileCopyFake.NewMockExecutor(controller)
+	executor.EXPECT().
+		Exec(gomock.Any(), containerFileTarget, []string{"tar", "cf", "-", "-C", "/var/log/", "--", "app.log"}, nil, gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ filecopy.Target, _ []string, _ io.Reader, stdout, _ io.Writer) error {
+			_, err := stdout.Write([]byte("archive"))
+			return err
+		})
+
+	alerter := actionFake.NewMockAlerter(controller)
+	expectAlert(t, alerter, action.AlertTypeInfo, `Saved "/var/log/app.log" from container "app" to /downloads/pod-app-app.log.tar`)
+
+	downloader := octant.NewContainerFileDownloader(executor, fs)
+	assert.Equal(t, octant.ActionDownloadContainerFiles, downloader.ActionName())
+
+	payload := containerFilePayload(octant.ActionDownloadContainerFiles, map[string]interface{}{
+		"containerPath":  "/var/log/app.log",
+		"localDirectory": "/downloads",
+	})
+	require.NoError(t, downloader.Handle(context.Background(), alerter, payload))
+
+	data, err := afero.ReadFile(fs, "/downloads/pod-app-app.log.tar")
+	require.NoError(t, err)
+	assert.Equal(t, "archive", string(data))
+}
+
+func TestContainerFileDownloader_error(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	fs := afero.NewMemMapFs()
+	executor := fileCopyFake.NewMockExecutor(controller)
+	executor.EXPECT().
+		Exec(gomock.Any(), containerFileTarget, gomock.Any(), nil, gomock.Any(), gomock.Any()).
+		Return(errors.New("failed"))
+
+	alerter := actionFake.NewMockAlerter(controller)
+	expectAlert(t, alerter, action.AlertTypeWarning, `Unable to download "/missing" from container "app": download "/missing" from container app in pod default/pod: failed`)
+
+	payload := containerFilePayload(octant.ActionDownloadContainerFiles, map[string]interface{}{
+		"containerPath":  "/missing",
+		"localDirectory": "/downloads",
+	})
+	require.NoError(t, octant.NewContainerFileDownloader(executor, fs).Handle(context.Background(), alerter, payload))
+
+	exists, err := afero.Exists(fs, "/downloads/pod-app-missing.tar")
+	require.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestContainerFileUploader(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	fs := afero.NewMemMapFs()
+	require.NoError(t, afero.WriteFile(fs, "/home/user/debug.sh", []byte("#!/bin/sh"), 0755))
+
+	var uploaded []string
+
+	executor := fileCopyFake.NewMockExecutor(controller)
+	executor.EXPECT().
+		Exec(gomock.Any(), containerFileTarget, []string{"tar", "xmf", "-", "-C", "/tmp"}, gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ filecopy.Target, _ []string, stdin io.Reader, _, _ io.Writer) error {
+			tr := tar.NewReader(stdin)
+			for {
+				header, err := tr.Next()
+				if err == io.EOF {
+					return nil
+				}
+				if err != nil {
+					return err
+				}
+				uploaded = append(uploaded, header.Name)
+			}
+		})
+
+	alerter := actionFake.NewMockAlerter(controller)
+	expectAlert(t, alerter, action.AlertTypeInfo, `Uploaded /home/user/debug.sh to "/tmp" in container "app"`)
+
+	uploader := octant.NewContainerFileUploader(executor, fs)
+	assert.Equal(t, octant.ActionUploadContainerFiles, uploader.ActionName())
+
+	payload := containerFilePayload(octant.ActionUploadContainerFiles, map[string]interface{}{
+		"localPath":          "/home/user/debug.sh",
+		"containerDirectory": "/tmp",
+	})
+	require.NoError(t, uploader.Handle(context.Background(), alerter, payload))
+	assert.Equal(t, []string{"debug.sh"}, uploaded)
+}
+
+func TestContainerFileUploader_missingLocalPath(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	executor := fileCopyFake.NewMockExecutor(controller)
+
+	alerter := actionFake.NewMockAlerter(controller)
+	alerter.EXPECT().
+		SendAlert(gomock.Any()).
+		Do(func(alert action.Alert) {
+			assert.Equal(t, action.AlertTypeWarning, alert.Type)
+		})
+
+	payload := containerFilePayload(octant.ActionUploadContainerFiles, map[string]interface{}{
+		"localPath":          "/missing",
+		"containerDirectory": "/tmp",
+	})
+	require.NoError(t, octant.NewContainerFileUploader(executor, afero.NewMemMapFs()).Handle(context.Background(), alerter, payload))
+}
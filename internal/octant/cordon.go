@@ -18,6 +18,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/strategicpatch"
 
 	"github.com/vmware-tanzu/octant/internal/cluster"
+	"github.com/vmware-tanzu/octant/internal/kubectl"
 	"github.com/vmware-tanzu/octant/internal/log"
 	"github.com/vmware-tanzu/octant/internal/util/kubernetes"
 	"github.com/vmware-tanzu/octant/pkg/action"
@@ -71,7 +72,7 @@ func (c *Cordon) Handle(ctx context.Context, alerter action.Alerter, payload act
 		return err
 	}
 
-	message := fmt.Sprintf("Node %q marked as unschedulable", key.Name)
+	message := kubectl.Describe(fmt.Sprintf("Node %q marked as unschedulable", key.Name), kubectl.Cordon(key.Name))
 	alertType := action.AlertTypeInfo
 	if err := c.Cordon(node); err != nil {
 		message = fmt.Sprintf("Unable to cordon node %q: %s", key.Name, err)
@@ -174,7 +175,7 @@ func (u *Uncordon) Handle(ctx context.Context, alerter action.Alerter, payload a
 		return err
 	}
 
-	message := fmt.Sprintf("Node %q marked as schedulable", key.Name)
+	message := kubectl.Describe(fmt.Sprintf("Node %q marked as schedulable", key.Name), kubectl.Uncordon(key.Name))
 	alertType := action.AlertTypeInfo
 	if err := u.Uncordon(node); err != nil {
 		message = fmt.Sprintf("Unable to uncordon node %q: %s", key.Name, err)
@@ -56,7 +56,7 @@ func Test_Cordon(t *testing.T) {
 				Kind:       "Node",
 				Name:       "unmarked-node",
 			},
-			message:   `Node "unmarked-node" marked as unschedulable`,
+			message:   `Node "unmarked-node" marked as unschedulable (kubectl equivalent: kubectl cordon unmarked-node)`,
 			alertType: action.AlertTypeInfo,
 			cordoned:  false,
 			isErr:     false,
@@ -140,7 +140,7 @@ func Test_Uncordon(t *testing.T) {
 				Kind:       "Node",
 				Name:       "marked-node",
 			},
-			message:   `Node "marked-node" marked as schedulable`,
+			message:   `Node "marked-node" marked as schedulable (kubectl equivalent: kubectl uncordon marked-node)`,
 			alertType: action.AlertTypeInfo,
 			cordoned:  true,
 			isErr:     false,
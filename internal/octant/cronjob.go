@@ -13,13 +13,13 @@ import (
 	batchv1 "k8s.io/api/batch/v1"
 	batchv1beta1 "k8s.io/api/batch/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
-	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/rand"
 
 	"github.com/pkg/errors"
 
 	"github.com/vmware-tanzu/octant/internal/cluster"
+	"github.com/vmware-tanzu/octant/internal/kubectl"
 	"github.com/vmware-tanzu/octant/internal/log"
 	"github.com/vmware-tanzu/octant/internal/util/kubernetes"
 	"github.com/vmware-tanzu/octant/pkg/action"
@@ -77,17 +77,22 @@ func (c *CronJobTrigger) Handle(ctx context.Context, alerter action.Alerter, pay
 	var message string
 	alertType := action.AlertTypeInfo
 	if err := c.Trigger(newJobName, cronjob); err != nil {
+		alertType = action.AlertTypeError
 		message = fmt.Sprintf("Unable to create job %q: %s", key.Name, err)
 		logger := log.From(ctx)
 		logger.WithErr(err).Errorf("trigger cronjob")
+	} else {
+		command := kubectl.CreateJobFromCronJob(cronjob.Namespace, cronjob.Name, newJobName)
+		message = kubectl.Describe(fmt.Sprintf("Job %s created", newJobName), command)
 	}
-	message = fmt.Sprintf("Job %s created", newJobName)
 	alert := action.CreateAlert(alertType, message, action.DefaultAlertExpiration)
 	alerter.SendAlert(alert)
 	return nil
 }
 
-// Trigger manually creates a new job
+// Trigger manually creates a new job from the job template of a cronjob. Like
+// kubectl create job --from=cronjob, the job is annotated as manually
+// instantiated and is owned by the cronjob.
 func (c *CronJobTrigger) Trigger(name string, cronJob *batchv1beta1.CronJob) error {
 	if cronJob == nil {
 		return errors.New("nil cronjob")
@@ -100,6 +105,9 @@ func (c *CronJobTrigger) Trigger(name string, cronJob *batchv1beta1.CronJob) err
 
 	annotations := make(map[string]string)
 	annotations["cronjob.kubernetes.io/instantiate"] = "manual"
+	for k, v := range cronJob.Spec.JobTemplate.Annotations {
+		annotations[k] = v
+	}
 
 	labels := make(map[string]string)
 	for k, v := range cronJob.Spec.JobTemplate.Labels {
@@ -112,6 +120,9 @@ func (c *CronJobTrigger) Trigger(name string, cronJob *batchv1beta1.CronJob) err
 			Namespace:   cronJob.Namespace,
 			Annotations: annotations,
 			Labels:      labels,
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(cronJob, batchv1beta1.SchemeGroupVersion.WithKind("CronJob")),
+			},
 		},
 		Spec: cronJob.Spec.JobTemplate.Spec,
 	}
@@ -158,72 +169,12 @@ func (c *CronJobSuspend) Handle(ctx context.Context, alerter action.Alerter, pay
 	logger := log.From(ctx).With("actionName", c.ActionName())
 	logger.With("payload", payload).Infof("received action payload")
 
-	expiration := time.Now().Add(10 * time.Second)
-
 	key, err := store.KeyFromPayload(payload)
 	if err != nil {
 		return err
 	}
 
-	object, err := c.store.Get(ctx, key)
-	if err != nil {
-		return err
-	}
-
-	if object == nil {
-		return errors.New("object store cannot get cronjob")
-	}
-
-	cronjob := &batchv1beta1.CronJob{}
-	if err := kubernetes.FromUnstructured(object, cronjob); err != nil {
-		return err
-	}
-
-	if cronjob.Spec.Suspend != nil {
-		*cronjob.Spec.Suspend = true
-	}
-
-	m, err := runtime.DefaultUnstructuredConverter.ToUnstructured(cronjob)
-	if err != nil {
-		return nil
-	}
-
-	unstructuredCronJob := &unstructured.Unstructured{Object: m}
-
-	err = c.store.Update(ctx, key, func(u *unstructured.Unstructured) error {
-		if unstructuredCronJob.GetAPIVersion() != u.GetAPIVersion() {
-			return fmt.Errorf("object API version cannot be updated")
-		}
-		if unstructuredCronJob.GetKind() != u.GetKind() {
-			return fmt.Errorf("object kind cannot be updated")
-		}
-		if unstructuredCronJob.GetName() != u.GetName() {
-			return fmt.Errorf("object name cannot be updated")
-		}
-
-		delete(unstructuredCronJob.Object, "status")
-
-		for k := range unstructuredCronJob.Object {
-			u.Object[k] = unstructuredCronJob.Object[k]
-		}
-		return nil
-	})
-
-	if err != nil {
-		sendAlert(
-			alerter,
-			action.AlertTypeError,
-			fmt.Sprintf("update: %s", err.Error()),
-			&expiration,
-		)
-	}
-
-	successMessage := fmt.Sprintf("Suspending %s (%s) %s in %s",
-		unstructuredCronJob.GetKind(),
-		unstructuredCronJob.GetAPIVersion(),
-		unstructuredCronJob.GetName(),
-		unstructuredCronJob.GetNamespace())
-	sendAlert(alerter, action.AlertTypeInfo, successMessage, &expiration)
+	setCronJobSuspend(ctx, c.clusterClient, alerter, key, true)
 	return nil
 }
 
@@ -253,74 +204,43 @@ func (c *CronJobResume) Handle(ctx context.Context, alerter action.Alerter, payl
 	logger := log.From(ctx).With("actionName", c.ActionName())
 	logger.With("payload", payload).Infof("received action payload")
 
-	expiration := time.Now().Add(10 * time.Second)
-
 	key, err := store.KeyFromPayload(payload)
 	if err != nil {
 		return err
 	}
 
-	object, err := c.store.Get(ctx, key)
-	if err != nil {
-		return err
-	}
-
-	if object == nil {
-		return errors.New("object store cannot get cronjob")
-	}
+	setCronJobSuspend(ctx, c.clusterClient, alerter, key, false)
+	return nil
+}
 
-	cronjob := &batchv1beta1.CronJob{}
-	if err := kubernetes.FromUnstructured(object, cronjob); err != nil {
-		return err
-	}
+// ActionName returns the action name
+func (c *CronJobResume) ActionName() string {
+	return ActionOverviewResumeCronjob
+}
 
-	*cronjob.Spec.Suspend = false
+// setCronJobSuspend patches spec.suspend of a cronjob and alerts with the result.
+func setCronJobSuspend(ctx context.Context, clusterClient cluster.ClientInterface, alerter action.Alerter, key store.Key, suspend bool) {
+	expiration := time.Now().Add(10 * time.Second)
 
-	m, err := runtime.DefaultUnstructuredConverter.ToUnstructured(cronjob)
-	if err != nil {
-		return nil
+	verb := "Resuming"
+	if suspend {
+		verb = "Suspending"
 	}
 
-	unstructuredCronJob := &unstructured.Unstructured{Object: m}
-
-	err = c.store.Update(ctx, key, func(u *unstructured.Unstructured) error {
-		if unstructuredCronJob.GetAPIVersion() != u.GetAPIVersion() {
-			return fmt.Errorf("object API version cannot be updated")
-		}
-		if unstructuredCronJob.GetKind() != u.GetKind() {
-			return fmt.Errorf("object kind cannot be updated")
-		}
-		if unstructuredCronJob.GetName() != u.GetName() {
-			return fmt.Errorf("object name cannot be updated")
-		}
-
-		delete(unstructuredCronJob.Object, "status")
-
-		for k := range unstructuredCronJob.Object {
-			u.Object[k] = unstructuredCronJob.Object[k]
-		}
-		return nil
-	})
+	client, err := clusterClient.KubernetesClient()
+	if err == nil {
+		patch := []byte(fmt.Sprintf(`{"spec":{"suspend":%t}}`, suspend))
+		_, err = client.BatchV1beta1().CronJobs(key.Namespace).Patch(ctx, key.Name, types.MergePatchType, patch, metav1.PatchOptions{})
+	}
 
 	if err != nil {
-		sendAlert(
-			alerter,
-			action.AlertTypeError,
-			fmt.Sprintf("update: %s", err.Error()),
-			&expiration,
-		)
+		log.From(ctx).WithErr(err).Errorf("patch cronjob suspend")
+		sendAlert(alerter, action.AlertTypeError, fmt.Sprintf("%s %s %s: %s", verb, key.Kind, key.Name, err), &expiration)
+		return
 	}
 
-	successMessage := fmt.Sprintf("Resuming %s (%s) %s in %s",
-		unstructuredCronJob.GetKind(),
-		unstructuredCronJob.GetAPIVersion(),
-		unstructuredCronJob.GetName(),
-		unstructuredCronJob.GetNamespace())
+	successMessage := kubectl.Describe(fmt.Sprintf("%s %s (%s) %s in %s",
+		verb, key.Kind, key.APIVersion, key.Name, key.Namespace),
+		kubectl.SuspendCronJob(key.Namespace, key.Name, suspend))
 	sendAlert(alerter, action.AlertTypeInfo, successMessage, &expiration)
-	return nil
-}
-
-// ActionName returns the action name
-func (c *CronJobResume) ActionName() string {
-	return ActionOverviewResumeCronjob
 }
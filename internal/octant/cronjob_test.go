@@ -88,6 +88,48 @@ func Test_CronJobTrigger(t *testing.T) {
 	require.NoError(t, trigger.Handle(ctx, alerter, payload))
 }
 
+func Test_CronJobTrigger_Trigger(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	kubernetesClient := clusterFake.NewMockKubernetesInterface(controller)
+	clusterClient := clusterFake.NewMockClientInterface(controller)
+	fakeClientset := testClient.NewSimpleClientset()
+
+	clusterClient.EXPECT().KubernetesClient().Return(kubernetesClient, nil)
+	kubernetesClient.EXPECT().BatchV1().Return(fakeClientset.BatchV1())
+
+	cronjob := testutil.CreateCronJob("cron")
+	cronjob.UID = "uid"
+	cronjob.Spec.JobTemplate.Annotations = map[string]string{"team": "batch"}
+	cronjob.Spec.JobTemplate.Labels = map[string]string{"app": "cron"}
+
+	trigger := octant.NewCronJobTrigger(nil, clusterClient)
+	require.NoError(t, trigger.Trigger("cron-manual-abc", cronjob))
+
+	job, err := fakeClientset.BatchV1().Jobs(cronjob.Namespace).Get(context.TODO(), "cron-manual-abc", metav1.GetOptions{})
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]string{
+		"cronjob.kubernetes.io/instantiate": "manual",
+		"team":                              "batch",
+	}, job.Annotations)
+	assert.Equal(t, map[string]string{"app": "cron"}, job.Labels)
+
+	isController := true
+	blockOwnerDeletion := true
+	assert.Equal(t, []metav1.OwnerReference{
+		{
+			APIVersion:         "batch/v1beta1",
+			Kind:               "CronJob",
+			Name:               "cron",
+			UID:                "uid",
+			Controller:         &isController,
+			BlockOwnerDeletion: &blockOwnerDeletion,
+		},
+	}, job.OwnerReferences)
+}
+
 func Test_CronJobHandler(t *testing.T) {
 	controller := gomock.NewController(t)
 	defer controller.Finish()
@@ -98,21 +140,15 @@ func Test_CronJobHandler(t *testing.T) {
 	alerter := actionFake.NewMockAlerter(controller)
 	kubernetesClient := clusterFake.NewMockKubernetesInterface(controller)
 	clusterClient := clusterFake.NewMockClientInterface(controller)
-	fakeClientset := testClient.NewSimpleClientset()
-
-	clusterClient.EXPECT().KubernetesClient().AnyTimes().Return(kubernetesClient, nil)
-	kubernetesClient.EXPECT().BatchV1().AnyTimes().Return(fakeClientset.BatchV1())
 
 	cronjob := testutil.CreateCronJob("cron")
 	key, err := store.KeyFromObject(cronjob)
 	require.NoError(t, err)
 
-	objectStore.EXPECT().
-		Get(ctx, gomock.Eq(key)).
-		Return(testutil.ToUnstructured(t, cronjob), nil).AnyTimes()
+	fakeClientset := testClient.NewSimpleClientset(cronjob)
 
-	objectStore.EXPECT().
-		Update(gomock.Any(), key, gomock.Any()).Return(nil).AnyTimes()
+	clusterClient.EXPECT().KubernetesClient().AnyTimes().Return(kubernetesClient, nil)
+	kubernetesClient.EXPECT().BatchV1beta1().AnyTimes().Return(fakeClientset.BatchV1beta1())
 
 	alertType := action.AlertTypeInfo
 
@@ -123,27 +159,39 @@ func Test_CronJobHandler(t *testing.T) {
 			assert.NotNil(t, alert.Expiration)
 		}).AnyTimes()
 
-	suspend := octant.NewCronJobSuspend(objectStore, clusterClient)
-	assert.Equal(t, octant.ActionOverviewSuspendCronjob, suspend.ActionName())
+	suspended := func() *bool {
+		got, err := fakeClientset.BatchV1beta1().CronJobs(key.Namespace).Get(ctx, key.Name, metav1.GetOptions{})
+		require.NoError(t, err)
+		return got.Spec.Suspend
+	}
 
-	suspendPayload := action.CreatePayload(octant.ActionOverviewSuspendCronjob, map[string]interface{}{
+	payload := action.CreatePayload(octant.ActionOverviewSuspendCronjob, map[string]interface{}{
 		"namespace":  key.Namespace,
 		"apiVersion": key.APIVersion,
 		"kind":       key.Kind,
 		"name":       key.Name,
 	})
 
-	require.NoError(t, suspend.Handle(ctx, alerter, suspendPayload))
+	suspend := octant.NewCronJobSuspend(objectStore, clusterClient)
+	assert.Equal(t, octant.ActionOverviewSuspendCronjob, suspend.ActionName())
+
+	require.NoError(t, suspend.Handle(ctx, alerter, payload))
+	require.NotNil(t, suspended())
+	assert.True(t, *suspended())
 
 	resume := octant.NewCronJobResume(objectStore, clusterClient)
 	assert.Equal(t, octant.ActionOverviewResumeCronjob, resume.ActionName())
 
-	resumePayload := action.CreatePayload(octant.ActionOverviewSuspendCronjob, map[string]interface{}{
+	require.NoError(t, resume.Handle(ctx, alerter, payload))
+	require.NotNil(t, suspended())
+	assert.False(t, *suspended())
+
+	alertType = action.AlertTypeError
+	missing := action.CreatePayload(octant.ActionOverviewSuspendCronjob, map[string]interface{}{
 		"namespace":  key.Namespace,
 		"apiVersion": key.APIVersion,
 		"kind":       key.Kind,
-		"name":       key.Name,
+		"name":       "missing",
 	})
-
-	require.NoError(t, suspend.Handle(ctx, alerter, resumePayload))
+	require.NoError(t, suspend.Handle(ctx, alerter, missing))
 }
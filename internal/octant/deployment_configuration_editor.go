@@ -11,6 +11,7 @@ import (
 
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
+	"github.com/vmware-tanzu/octant/internal/kubectl"
 	"github.com/vmware-tanzu/octant/pkg/action"
 	"github.com/vmware-tanzu/octant/pkg/log"
 	"github.com/vmware-tanzu/octant/pkg/store"
@@ -65,7 +66,7 @@ func (e *DeploymentConfigurationEditor) Handle(ctx context.Context, alerter acti
 	}
 
 	alertType := action.AlertTypeInfo
-	message := fmt.Sprintf("Updated Deployment %q", name)
+	message := kubectl.Describe(fmt.Sprintf("Updated Deployment %q", name), kubectl.Scale(key, replicaCount))
 	if err := e.store.Update(ctx, key, fn); err != nil {
 		alertType = action.AlertTypeWarning
 		message = fmt.Sprintf("Unable to update Deployment %q: %s", name, err)
@@ -51,7 +51,7 @@ func TestDeploymentConfigurationEditor(t *testing.T) {
 		SendAlert(gomock.Any()).
 		DoAndReturn(func(alert action.Alert) {
 			assert.Equal(t, action.AlertTypeInfo, alert.Type)
-			assert.Equal(t, `Updated Deployment "deployment"`, alert.Message)
+			assert.Equal(t, `Updated Deployment "deployment" (kubectl equivalent: kubectl scale deployment.apps/deployment --replicas=5 --namespace default)`, alert.Message)
 			assert.NotNil(t, alert.Expiration)
 		})
 
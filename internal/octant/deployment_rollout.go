@@ -0,0 +1,105 @@
+/*
+ * Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package octant
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/vmware-tanzu/octant/internal/kubectl"
+	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+// DeploymentPause pauses the rollout of a deployment.
+type DeploymentPause struct {
+	store store.Store
+}
+
+var _ action.Dispatcher = (*DeploymentPause)(nil)
+
+// NewDeploymentPause creates an instance of DeploymentPause.
+func NewDeploymentPause(objectStore store.Store) *DeploymentPause {
+	return &DeploymentPause{
+		store: objectStore,
+	}
+}
+
+// ActionName returns the name of this action.
+func (d *DeploymentPause) ActionName() string {
+	return ActionPauseDeployment
+}
+
+// Handle pauses a deployment.
+func (d *DeploymentPause) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	logger := log.From(ctx).With("actionName", d.ActionName())
+	logger.With("payload", payload).Infof("received action payload")
+
+	key, err := store.KeyFromPayload(payload)
+	if err != nil {
+		return err
+	}
+
+	setDeploymentPaused(ctx, d.store, alerter, key, true)
+	return nil
+}
+
+// DeploymentResume resumes the rollout of a paused deployment.
+type DeploymentResume struct {
+	store store.Store
+}
+
+var _ action.Dispatcher = (*DeploymentResume)(nil)
+
+// NewDeploymentResume creates an instance of DeploymentResume.
+func NewDeploymentResume(objectStore store.Store) *DeploymentResume {
+	return &DeploymentResume{
+		store: objectStore,
+	}
+}
+
+// ActionName returns the name of this action.
+func (d *DeploymentResume) ActionName() string {
+	return ActionResumeDeployment
+}
+
+// Handle resumes a deployment.
+func (d *DeploymentResume) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	logger := log.From(ctx).With("actionName", d.ActionName())
+	logger.With("payload", payload).Infof("received action payload")
+
+	key, err := store.KeyFromPayload(payload)
+	if err != nil {
+		return err
+	}
+
+	setDeploymentPaused(ctx, d.store, alerter, key, false)
+	return nil
+}
+
+// setDeploymentPaused updates spec.paused of a deployment and alerts with the result.
+func setDeploymentPaused(ctx context.Context, objectStore store.Store, alerter action.Alerter, key store.Key, paused bool) {
+	fn := func(object *unstructured.Unstructured) error {
+		return unstructured.SetNestedField(object.Object, paused, "spec", "paused")
+	}
+
+	verb, command := "Resumed", kubectl.RolloutResume(key)
+	if paused {
+		verb, command = "Paused", kubectl.RolloutPause(key)
+	}
+
+	alertType := action.AlertTypeInfo
+	message := kubectl.Describe(fmt.Sprintf("%s rollout of Deployment %q", verb, key.Name), command)
+	if err := objectStore.Update(ctx, key, fn); err != nil {
+		log.From(ctx).WithErr(err).Errorf("update deployment paused")
+		alertType = action.AlertTypeWarning
+		message = fmt.Sprintf("Unable to update Deployment %q: %s", key.Name, err)
+	}
+	alerter.SendAlert(action.CreateAlert(alertType, message, action.DefaultAlertExpiration))
+}
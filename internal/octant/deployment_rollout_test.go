@@ -0,0 +1,84 @@
+/*
+ * Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package octant
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	actionFake "github.com/vmware-tanzu/octant/pkg/action/fake"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/store/fake"
+)
+
+func TestDeploymentPauseAndResume(t *testing.T) {
+	tests := []struct {
+		name            string
+		dispatcher      func(store.Store) action.Dispatcher
+		actionName      string
+		expectedPaused  bool
+		expectedMessage string
+	}{
+		{
+			name:            "pause",
+			dispatcher:      func(s store.Store) action.Dispatcher { return NewDeploymentPause(s) },
+			actionName:      ActionPauseDeployment,
+			expectedPaused:  true,
+			expectedMessage: `Paused rollout of Deployment "deployment" (kubectl equivalent: kubectl rollout pause deployment.apps/deployment --namespace namespace)`,
+		},
+		{
+			name:            "resume",
+			dispatcher:      func(s store.Store) action.Dispatcher { return NewDeploymentResume(s) },
+			actionName:      ActionResumeDeployment,
+			expectedPaused:  false,
+			expectedMessage: `Resumed rollout of Deployment "deployment" (kubectl equivalent: kubectl rollout resume deployment.apps/deployment --namespace namespace)`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			deployment := testutil.CreateDeployment("deployment")
+			deployment.Spec.Paused = !test.expectedPaused
+			object := testutil.ToUnstructured(t, deployment)
+
+			key, err := store.KeyFromObject(object)
+			require.NoError(t, err)
+
+			objectStore := fake.NewMockStore(controller)
+			objectStore.EXPECT().
+				Update(gomock.Any(), key, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, key store.Key, fn func(object *unstructured.Unstructured) error) error {
+					return fn(object)
+				})
+
+			alerter := actionFake.NewMockAlerter(controller)
+			alerter.EXPECT().
+				SendAlert(gomock.Any()).
+				Do(func(alert action.Alert) {
+					assert.Equal(t, action.AlertTypeInfo, alert.Type)
+					assert.Equal(t, test.expectedMessage, alert.Message)
+				})
+
+			dispatcher := test.dispatcher(objectStore)
+			assert.Equal(t, test.actionName, dispatcher.ActionName())
+			require.NoError(t, dispatcher.Handle(context.Background(), alerter, key.ToActionPayload()))
+
+			paused, _, err := unstructured.NestedBool(object.Object, "spec", "paused")
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedPaused, paused)
+		})
+	}
+}
@@ -0,0 +1,239 @@
+/*
+ * Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package octant
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/vmware-tanzu/octant/internal/kubectl"
+	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/internal/util/kubernetes"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+// HorizontalPodAutoscalerEditor edits the replica bounds and CPU utilization target
+// of a horizontal pod autoscaler.
+type HorizontalPodAutoscalerEditor struct {
+	store store.Store
+}
+
+var _ action.Dispatcher = (*HorizontalPodAutoscalerEditor)(nil)
+
+// NewHorizontalPodAutoscalerEditor creates an instance of HorizontalPodAutoscalerEditor.
+func NewHorizontalPodAutoscalerEditor(objectStore store.Store) *HorizontalPodAutoscalerEditor {
+	return &HorizontalPodAutoscalerEditor{
+		store: objectStore,
+	}
+}
+
+// ActionName returns the name of this action.
+func (e *HorizontalPodAutoscalerEditor) ActionName() string {
+	return ActionHorizontalPodAutoscalerEdit
+}
+
+// hpaEdit is an edit of a horizontal pod autoscaler.
+type hpaEdit struct {
+	minReplicas int64
+	maxReplicas int64
+	// targetCPUUtilization is zero if the autoscaler has no CPU target.
+	targetCPUUtilization int64
+}
+
+// Handle edits the minReplicas, maxReplicas, and targetCPUUtilizationPercentage of
+// a horizontal pod autoscaler. The edit is checked against the current state of the scale target, and any
+// problems are included in the alert.
+func (e *HorizontalPodAutoscalerEditor) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	logger := log.From(ctx).With("actionName", e.ActionName())
+	logger.With("payload", payload).Infof("received action payload")
+
+	key, err := store.KeyFromPayload(payload)
+	if err != nil {
+		return err
+	}
+
+	edit, err := hpaEditFromPayload(payload)
+	if err != nil {
+		sendDefaultAlert(alerter, action.AlertTypeWarning,
+			fmt.Sprintf("Unable to update HorizontalPodAutoscaler %q: %s", key.Name, err))
+		return nil
+	}
+
+	object, err := e.store.Get(ctx, key)
+	if err != nil {
+		return err
+	}
+	if object == nil {
+		return errors.Errorf("horizontal pod autoscaler %q not found", key.Name)
+	}
+
+	hpa := &autoscalingv1.HorizontalPodAutoscaler{}
+	if err := kubernetes.FromUnstructured(object, hpa); err != nil {
+		return err
+	}
+
+	notes, err := e.checkScaleTarget(ctx, hpa, edit)
+	if err != nil {
+		sendDefaultAlert(alerter, action.AlertTypeWarning,
+			fmt.Sprintf("Unable to update HorizontalPodAutoscaler %q: %s", key.Name, err))
+		return nil
+	}
+
+	fn := func(object *unstructured.Unstructured) error {
+		if err := unstructured.SetNestedField(object.Object, edit.minReplicas, "spec", "minReplicas"); err != nil {
+			return err
+		}
+		if err := unstructured.SetNestedField(object.Object, edit.maxReplicas, "spec", "maxReplicas"); err != nil {
+			return err
+		}
+		if edit.targetCPUUtilization == 0 {
+			unstructured.RemoveNestedField(object.Object, "spec", "targetCPUUtilizationPercentage")
+			return nil
+		}
+		return unstructured.SetNestedField(object.Object, edit.targetCPUUtilization, "spec", "targetCPUUtilizationPercentage")
+	}
+
+	alertType := action.AlertTypeInfo
+	message := kubectl.Describe(fmt.Sprintf("Updated HorizontalPodAutoscaler %q", key.Name), kubectl.Patch(key, edit.patch()))
+	if len(notes) > 0 {
+		message = fmt.Sprintf("%s. %s", message, strings.Join(notes, " "))
+	}
+	if err := e.store.Update(ctx, key, fn); err != nil {
+		logger.WithErr(err).Errorf("update horizontal pod autoscaler")
+		alertType = action.AlertTypeWarning
+		message = fmt.Sprintf("Unable to update HorizontalPodAutoscaler %q: %s", key.Name, err)
+	}
+	sendDefaultAlert(alerter, alertType, message)
+
+	return nil
+}
+
+// checkScaleTarget checks an edit against the current state of the scale target.
+// It returns an error if the target doesn't exist, and notes describing how the
+// edit will affect the target.
+func (e *HorizontalPodAutoscalerEditor) checkScaleTarget(ctx context.Context, hpa *autoscalingv1.HorizontalPodAutoscaler, edit hpaEdit) ([]string, error) {
+	targetRef := hpa.Spec.ScaleTargetRef
+	key := store.Key{
+		Namespace:  hpa.Namespace,
+		APIVersion: targetRef.APIVersion,
+		Kind:       targetRef.Kind,
+		Name:       targetRef.Name,
+	}
+
+	target, err := e.store.Get(ctx, key)
+	if err != nil {
+		return nil, errors.Wrapf(err, "get scale target %s %q", targetRef.Kind, targetRef.Name)
+	}
+	if target == nil {
+		return nil, errors.Errorf("scale target %s %q does not exist", targetRef.Kind, targetRef.Name)
+	}
+
+	var notes []string
+
+	replicas, found, err := unstructured.NestedInt64(target.Object, "spec", "replicas")
+	if err == nil && found {
+		switch {
+		case replicas < edit.minReplicas:
+			notes = append(notes, fmt.Sprintf("%s %q will be scaled up from %d to %d replicas.",
+				targetRef.Kind, targetRef.Name, replicas, edit.minReplicas))
+		case replicas > edit.maxReplicas:
+			notes = append(notes, fmt.Sprintf("%s %q will be scaled down from %d to %d replicas.",
+				targetRef.Kind, targetRef.Name, replicas, edit.maxReplicas))
+		}
+	}
+
+	if edit.targetCPUUtilization > 0 {
+		containers, found, err := unstructured.NestedSlice(target.Object, "spec", "template", "spec", "containers")
+		if err == nil && found && !containersRequestCPU(containers) {
+			notes = append(notes, fmt.Sprintf("Not every container of %s %q requests CPU, so its utilization can't be calculated.",
+				targetRef.Kind, targetRef.Name))
+		}
+	}
+
+	return notes, nil
+}
+
+// patch returns the merge patch kubectl would use to make the edit.
+func (h hpaEdit) patch() string {
+	spec := map[string]interface{}{
+		"minReplicas": h.minReplicas,
+		"maxReplicas": h.maxReplicas,
+	}
+	if h.targetCPUUtilization > 0 {
+		spec["targetCPUUtilizationPercentage"] = h.targetCPUUtilization
+	} else {
+		spec["targetCPUUtilizationPercentage"] = nil
+	}
+
+	data, _ := json.Marshal(map[string]interface{}{"spec": spec})
+	return string(data)
+}
+
+func hpaEditFromPayload(payload action.Payload) (hpaEdit, error) {
+	minReplicas, err := payload.Float64("minReplicas")
+	if err != nil {
+		return hpaEdit{}, errors.New("min replicas is required")
+	}
+
+	maxReplicas, err := payload.Float64("maxReplicas")
+	if err != nil {
+		return hpaEdit{}, errors.New("max replicas is required")
+	}
+
+	edit := hpaEdit{
+		minReplicas: roundToInt(minReplicas),
+		maxReplicas: roundToInt(maxReplicas),
+	}
+
+	// A blank target removes the CPU utilization target.
+	if target, ok := payload["targetCPUUtilizationPercentage"]; ok && target != "" && target != nil {
+		targetCPUUtilization, err := payload.Float64("targetCPUUtilizationPercentage")
+		if err != nil {
+			return hpaEdit{}, errors.Errorf("invalid target CPU utilization %v", target)
+		}
+		edit.targetCPUUtilization = roundToInt(targetCPUUtilization)
+		if edit.targetCPUUtilization < 1 {
+			return hpaEdit{}, errors.New("target CPU utilization must be at least 1%")
+		}
+	}
+
+	if edit.minReplicas < 1 {
+		return hpaEdit{}, errors.New("min replicas must be at least 1")
+	}
+
+	if edit.maxReplicas < edit.minReplicas {
+		return hpaEdit{}, errors.Errorf("max replicas (%d) must not be less than min replicas (%d)",
+			edit.maxReplicas, edit.minReplicas)
+	}
+
+	return edit, nil
+}
+
+// containersRequestCPU returns true if every container in a list of unstructured
+// containers requests CPU.
+func containersRequestCPU(containers []interface{}) bool {
+	for _, item := range containers {
+		m, ok := item.(map[string]interface{})
+		if !ok {
+			return false
+		}
+
+		cpu, found, err := unstructured.NestedString(m, "resources", "requests", string(corev1.ResourceCPU))
+		if err != nil || !found || cpu == "" {
+			return false
+		}
+	}
+
+	return len(containers) > 0
+}
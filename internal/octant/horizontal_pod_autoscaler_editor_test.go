@@ -0,0 +1,186 @@
+/*
+ * Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package octant
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	actionFake "github.com/vmware-tanzu/octant/pkg/action/fake"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/store/fake"
+)
+
+func TestHorizontalPodAutoscalerEditor(t *testing.T) {
+	tests := []struct {
+		name              string
+		payload           action.Payload
+		requestsCPU       bool
+		missingTarget     bool
+		expectedUpdate    bool
+		expectedTargetCPU int64
+		expectedAlertType action.AlertType
+		expectedMessage   string
+	}{
+		{
+			name: "update bounds and target",
+			payload: action.Payload{
+				"minReplicas":                    "2",
+				"maxReplicas":                    "5",
+				"targetCPUUtilizationPercentage": "60",
+			},
+			requestsCPU:       true,
+			expectedUpdate:    true,
+			expectedTargetCPU: 60,
+			expectedAlertType: action.AlertTypeInfo,
+			expectedMessage:   `Updated HorizontalPodAutoscaler "hpa" (kubectl equivalent: kubectl patch horizontalpodautoscaler.autoscaling/hpa --type merge --patch '{"spec":{"maxReplicas":5,"minReplicas":2,"targetCPUUtilizationPercentage":60}}' --namespace namespace)`,
+		},
+		{
+			name: "remove target",
+			payload: action.Payload{
+				"minReplicas":                    "2",
+				"maxReplicas":                    "5",
+				"targetCPUUtilizationPercentage": "",
+			},
+			expectedUpdate:    true,
+			expectedAlertType: action.AlertTypeInfo,
+			expectedMessage:   `Updated HorizontalPodAutoscaler "hpa" (kubectl equivalent: kubectl patch horizontalpodautoscaler.autoscaling/hpa --type merge --patch '{"spec":{"maxReplicas":5,"minReplicas":2,"targetCPUUtilizationPercentage":null}}' --namespace namespace)`,
+		},
+		{
+			name: "notes effect on scale target",
+			payload: action.Payload{
+				"minReplicas":                    "4",
+				"maxReplicas":                    "5",
+				"targetCPUUtilizationPercentage": "60",
+			},
+			expectedUpdate:    true,
+			expectedTargetCPU: 60,
+			expectedAlertType: action.AlertTypeInfo,
+			expectedMessage: `Updated HorizontalPodAutoscaler "hpa" (kubectl equivalent: kubectl patch horizontalpodautoscaler.autoscaling/hpa --type merge --patch '{"spec":{"maxReplicas":5,"minReplicas":4,"targetCPUUtilizationPercentage":60}}' --namespace namespace). ` +
+				`Deployment "deployment" will be scaled up from 3 to 4 replicas. ` +
+				`Not every container of Deployment "deployment" requests CPU, so its utilization can't be calculated.`,
+		},
+		{
+			name: "max less than min",
+			payload: action.Payload{
+				"minReplicas": "5",
+				"maxReplicas": "2",
+			},
+			expectedAlertType: action.AlertTypeWarning,
+			expectedMessage:   `Unable to update HorizontalPodAutoscaler "hpa": max replicas (2) must not be less than min replicas (5)`,
+		},
+		{
+			name: "min less than one",
+			payload: action.Payload{
+				"minReplicas": "0",
+				"maxReplicas": "2",
+			},
+			expectedAlertType: action.AlertTypeWarning,
+			expectedMessage:   `Unable to update HorizontalPodAutoscaler "hpa": min replicas must be at least 1`,
+		},
+		{
+			name: "missing scale target",
+			payload: action.Payload{
+				"minReplicas": "1",
+				"maxReplicas": "2",
+			},
+			missingTarget:     true,
+			expectedAlertType: action.AlertTypeWarning,
+			expectedMessage:   `Unable to update HorizontalPodAutoscaler "hpa": scale target Deployment "deployment" does not exist`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			var replicas int32 = 3
+			deployment := testutil.CreateDeployment("deployment")
+			deployment.Spec.Replicas = &replicas
+			deployment.Spec.Template.Spec.Containers = []corev1.Container{{Name: "app"}}
+			if test.requestsCPU {
+				deployment.Spec.Template.Spec.Containers[0].Resources.Requests = corev1.ResourceList{
+					corev1.ResourceCPU: resource.MustParse("100m"),
+				}
+			}
+
+			var minReplicas int32 = 1
+			var targetCPU int32 = 80
+			hpa := testutil.CreateHorizontalPodAutoscaler("hpa")
+			hpa.Spec.MinReplicas = &minReplicas
+			hpa.Spec.MaxReplicas = 3
+			hpa.Spec.TargetCPUUtilizationPercentage = &targetCPU
+			hpa.Spec.ScaleTargetRef = autoscalingv1.CrossVersionObjectReference{
+				APIVersion: deployment.APIVersion,
+				Kind:       deployment.Kind,
+				Name:       deployment.Name,
+			}
+			object := testutil.ToUnstructured(t, hpa)
+
+			key, err := store.KeyFromObject(object)
+			require.NoError(t, err)
+			deploymentKey, err := store.KeyFromObject(deployment)
+			require.NoError(t, err)
+
+			objectStore := fake.NewMockStore(controller)
+			objectStore.EXPECT().Get(gomock.Any(), key).Return(object, nil).AnyTimes()
+			if test.missingTarget {
+				objectStore.EXPECT().Get(gomock.Any(), deploymentKey).Return(nil, nil).AnyTimes()
+			} else {
+				objectStore.EXPECT().Get(gomock.Any(), deploymentKey).Return(testutil.ToUnstructured(t, deployment), nil).AnyTimes()
+			}
+			if test.expectedUpdate {
+				objectStore.EXPECT().
+					Update(gomock.Any(), key, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, key store.Key, fn func(object *unstructured.Unstructured) error) error {
+						return fn(object)
+					})
+			}
+
+			alerter := actionFake.NewMockAlerter(controller)
+			alerter.EXPECT().
+				SendAlert(gomock.Any()).
+				Do(func(alert action.Alert) {
+					assert.Equal(t, test.expectedAlertType, alert.Type)
+					assert.Equal(t, test.expectedMessage, alert.Message)
+				})
+
+			payload := key.ToActionPayload()
+			for k, v := range test.payload {
+				payload[k] = v
+			}
+
+			editor := NewHorizontalPodAutoscalerEditor(objectStore)
+			assert.Equal(t, ActionHorizontalPodAutoscalerEdit, editor.ActionName())
+			require.NoError(t, editor.Handle(context.Background(), alerter, payload))
+
+			if !test.expectedUpdate {
+				return
+			}
+
+			spec, _, err := unstructured.NestedMap(object.Object, "spec")
+			require.NoError(t, err)
+			assert.Equal(t, test.payload["minReplicas"], fmt.Sprint(spec["minReplicas"]))
+			assert.Equal(t, test.payload["maxReplicas"], fmt.Sprint(spec["maxReplicas"]))
+
+			targetCPUUtilization, _, err := unstructured.NestedInt64(object.Object, "spec", "targetCPUUtilizationPercentage")
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedTargetCPU, targetCPUUtilization)
+		})
+	}
+}
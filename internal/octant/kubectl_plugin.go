@@ -0,0 +1,92 @@
+/*
+ * Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package octant
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/vmware-tanzu/octant/internal/kubectlplugin"
+	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+// KubectlPluginConfig is the configuration kubectl plugins are run with.
+type KubectlPluginConfig interface {
+	KubectlPlugins() kubectlplugin.Manager
+	CurrentContext() string
+	ObjectStore() store.Store
+}
+
+// KubectlPluginRunner is an action which runs an installed kubectl plugin
+// against an object. The plugin runs in the background in the current kube
+// config context, and its output is shown on the object's Kubectl Plugins tab.
+type KubectlPluginRunner struct {
+	config KubectlPluginConfig
+}
+
+var _ action.Dispatcher = (*KubectlPluginRunner)(nil)
+
+// NewKubectlPluginRunner creates an instance of KubectlPluginRunner.
+func NewKubectlPluginRunner(config KubectlPluginConfig) *KubectlPluginRunner {
+	return &KubectlPluginRunner{
+		config: config,
+	}
+}
+
+// ActionName returns the name of this action.
+func (k *KubectlPluginRunner) ActionName() string {
+	return ActionRunKubectlPlugin
+}
+
+// Handle starts the plugin in the payload against the object in the payload.
+func (k *KubectlPluginRunner) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	logger := log.From(ctx).With("actionName", k.ActionName())
+	logger.With("payload", payload).Infof("received action payload")
+
+	key, err := store.KeyFromPayload(payload)
+	if err != nil {
+		return err
+	}
+
+	name, err := payload.String("plugin")
+	if err != nil {
+		return err
+	}
+
+	manager := k.config.KubectlPlugins()
+	if manager == nil {
+		sendDefaultAlert(alerter, action.AlertTypeWarning, "kubectl plugins are disabled. Enable the KubectlPlugins feature gate to run them.")
+		return nil
+	}
+
+	// Plugins are run against objects which exist, so arguments are built from
+	// the object rather than from whatever the payload contains.
+	object, err := k.config.ObjectStore().Get(ctx, key)
+	if err != nil || object == nil {
+		sendDefaultAlert(alerter, action.AlertTypeWarning, fmt.Sprintf("Unable to run kubectl %s: %s %q was not found", name, key.Kind, key.Name))
+		return nil
+	}
+
+	key, err = store.KeyFromObject(object)
+	if err != nil {
+		return err
+	}
+
+	status, err := manager.Run(name, key, k.config.CurrentContext())
+	if err != nil {
+		logger.WithErr(err).Errorf("run kubectl plugin")
+		sendDefaultAlert(alerter, action.AlertTypeWarning, fmt.Sprintf("Unable to run kubectl %s: %s", name, err))
+		return nil
+	}
+
+	message := fmt.Sprintf("Running kubectl %s %s. Its output is shown on the Kubectl Plugins tab.",
+		name, strings.Join(status.Args, " "))
+	sendDefaultAlert(alerter, action.AlertTypeInfo, message)
+	return nil
+}
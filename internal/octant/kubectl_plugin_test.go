@@ -0,0 +1,125 @@
+/*
+ * Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package octant
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/vmware-tanzu/octant/internal/kubectlplugin"
+	kubectlPluginFake "github.com/vmware-tanzu/octant/internal/kubectlplugin/fake"
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	actionFake "github.com/vmware-tanzu/octant/pkg/action/fake"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	storeFake "github.com/vmware-tanzu/octant/pkg/store/fake"
+)
+
+type fakeKubectlPluginConfig struct {
+	manager     kubectlplugin.Manager
+	objectStore store.Store
+}
+
+func (f fakeKubectlPluginConfig) KubectlPlugins() kubectlplugin.Manager {
+	return f.manager
+}
+
+func (f fakeKubectlPluginConfig) CurrentContext() string {
+	return "dev"
+}
+
+func (f fakeKubectlPluginConfig) ObjectStore() store.Store {
+	return f.objectStore
+}
+
+func TestKubectlPluginRunner(t *testing.T) {
+	key := store.Key{APIVersion: "apps/v1", Kind: "Deployment", Namespace: "default", Name: "nginx"}
+
+	payload := key.ToActionPayload()
+	payload["plugin"] = "tree"
+
+	deployment := testutil.ToUnstructured(t, testutil.CreateDeployment("nginx"))
+	deployment.SetNamespace("default")
+
+	tests := []struct {
+		name      string
+		manager   func(controller *gomock.Controller) kubectlplugin.Manager
+		object    *unstructured.Unstructured
+		alertType action.AlertType
+		message   string
+	}{
+		{
+			name: "run",
+			manager: func(controller *gomock.Controller) kubectlplugin.Manager {
+				manager := kubectlPluginFake.NewMockManager(controller)
+				manager.EXPECT().
+					Run("tree", key, "dev").
+					Return(kubectlplugin.RunStatus{ID: "1", Plugin: "tree", Args: []string{"Deployment", "nginx"}}, nil)
+				return manager
+			},
+			object:    deployment,
+			alertType: action.AlertTypeInfo,
+			message:   "Running kubectl tree Deployment nginx. Its output is shown on the Kubectl Plugins tab.",
+		},
+		{
+			name: "unable to run",
+			manager: func(controller *gomock.Controller) kubectlplugin.Manager {
+				manager := kubectlPluginFake.NewMockManager(controller)
+				manager.EXPECT().
+					Run("tree", key, "dev").
+					Return(kubectlplugin.RunStatus{}, errors.New(`kubectl plugin "tree" is not installed`))
+				return manager
+			},
+			object:    deployment,
+			alertType: action.AlertTypeWarning,
+			message:   `Unable to run kubectl tree: kubectl plugin "tree" is not installed`,
+		},
+		{
+			name: "object not found",
+			manager: func(controller *gomock.Controller) kubectlplugin.Manager {
+				return kubectlPluginFake.NewMockManager(controller)
+			},
+			alertType: action.AlertTypeWarning,
+			message:   `Unable to run kubectl tree: Deployment "nginx" was not found`,
+		},
+		{
+			name: "disabled",
+			manager: func(controller *gomock.Controller) kubectlplugin.Manager {
+				return nil
+			},
+			alertType: action.AlertTypeWarning,
+			message:   "kubectl plugins are disabled. Enable the KubectlPlugins feature gate to run them.",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			alerter := actionFake.NewMockAlerter(controller)
+			alerter.EXPECT().
+				SendAlert(gomock.Any()).
+				Do(func(alert action.Alert) {
+					assert.Equal(t, test.alertType, alert.Type)
+					assert.Equal(t, test.message, alert.Message)
+				})
+
+			objectStore := storeFake.NewMockStore(controller)
+			objectStore.EXPECT().Get(gomock.Any(), key).Return(test.object, nil).AnyTimes()
+
+			runner := NewKubectlPluginRunner(fakeKubectlPluginConfig{manager: test.manager(controller), objectStore: objectStore})
+			require.Equal(t, ActionRunKubectlPlugin, runner.ActionName())
+			require.NoError(t, runner.Handle(context.Background(), alerter, payload))
+		})
+	}
+}
@@ -0,0 +1,329 @@
+/*
+ * Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package octant
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/vmware-tanzu/octant/internal/cluster"
+	"github.com/vmware-tanzu/octant/internal/kubectl"
+	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/internal/notification"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+const (
+	// defaultEvictionRetryInterval is how long a drain waits before retrying an
+	// eviction blocked by a pod disruption budget.
+	defaultEvictionRetryInterval = 5 * time.Second
+
+	mirrorPodAnnotation = "kubernetes.io/config.mirror"
+)
+
+// NodeDrains tracks the nodes being drained so a drain can be cancelled.
+type NodeDrains struct {
+	ctx     context.Context
+	mu      sync.Mutex
+	cancels map[string]context.CancelFunc
+}
+
+// NewNodeDrains creates an instance of NodeDrains. Drains are cancelled when ctx
+// is done, so they don't outlive the dashboard which started them.
+func NewNodeDrains(ctx context.Context) *NodeDrains {
+	return &NodeDrains{
+		ctx:     ctx,
+		cancels: make(map[string]context.CancelFunc),
+	}
+}
+
+// start returns a context for draining a node. It returns false if the node is
+// already being drained.
+func (d *NodeDrains) start(name string) (context.Context, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if _, ok := d.cancels[name]; ok {
+		return nil, false
+	}
+
+	ctx, cancel := context.WithCancel(d.ctx)
+	d.cancels[name] = cancel
+	return ctx, true
+}
+
+// finish releases the context for draining a node.
+func (d *NodeDrains) finish(name string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if cancel, ok := d.cancels[name]; ok {
+		cancel()
+		delete(d.cancels, name)
+	}
+}
+
+// cancel stops draining a node. It returns false if the node isn't being drained.
+func (d *NodeDrains) cancel(name string) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	cancel, ok := d.cancels[name]
+	if ok {
+		cancel()
+	}
+	return ok
+}
+
+// Auditor records a change which finishes after the action which started it.
+type Auditor interface {
+	Audit(ctx context.Context, actionName string, key store.Key, f func() error) error
+}
+
+// Drain cordons a node and evicts its pods.
+type Drain struct {
+	clusterClient cluster.ClientInterface
+	drains        *NodeDrains
+	notifier      notification.Notifier
+	auditor       Auditor
+	retryInterval time.Duration
+}
+
+var _ action.Dispatcher = (*Drain)(nil)
+
+// NewDrain creates an instance of Drain. Progress is published to notifier and
+// the outcome of each drain is recorded by auditor.
+func NewDrain(clusterClient cluster.ClientInterface, drains *NodeDrains, notifier notification.Notifier, auditor Auditor) *Drain {
+	return &Drain{
+		clusterClient: clusterClient,
+		drains:        drains,
+		notifier:      notifier,
+		auditor:       auditor,
+		retryInterval: defaultEvictionRetryInterval,
+	}
+}
+
+// ActionName returns the name of this action
+func (d *Drain) ActionName() string {
+	return ActionDrainNode
+}
+
+// Handle starts draining a node. The drain continues in the background and
+// publishes the progress of each eviction as a notification.
+func (d *Drain) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	logger := log.From(ctx).With("actionName", d.ActionName())
+	logger.With("payload", payload).Infof("received action payload")
+
+	name, err := payload.String("name")
+	if err != nil {
+		return err
+	}
+
+	drainCtx, ok := d.drains.start(name)
+	if !ok {
+		sendDefaultAlert(alerter, action.AlertTypeWarning, fmt.Sprintf("Node %q is already being drained", name))
+		return nil
+	}
+
+	drainCtx = log.WithLoggerContext(drainCtx, logger)
+
+	go func() {
+		defer d.drains.finish(name)
+
+		// The action is recorded when the drain starts, so its outcome is recorded
+		// once it finishes.
+		err := d.auditor.Audit(drainCtx, d.ActionName(), nodeKey(name), func() error {
+			return d.Drain(drainCtx, name)
+		})
+		if err != nil {
+			logger.WithErr(err).Errorf("drain node")
+		}
+	}()
+
+	return nil
+}
+
+// Drain cordons a node, then evicts the pods running on it. Pods managed by daemon
+// sets and mirror pods are skipped. Evictions blocked by a pod disruption budget
+// are retried until they succeed or the context is cancelled. An error is returned
+// if the drain is cancelled or a pod can't be evicted.
+func (d *Drain) Drain(ctx context.Context, name string) error {
+	node := nodeKey(name)
+
+	client, err := d.clusterClient.KubernetesClient()
+	if err != nil {
+		d.notify(action.AlertTypeError, node, fmt.Sprintf("Unable to drain node %q: %s", name, err))
+		return err
+	}
+
+	patch := []byte(`{"spec":{"unschedulable":true}}`)
+	if _, err := client.CoreV1().Nodes().Patch(ctx, name, types.StrategicMergePatchType, patch, metav1.PatchOptions{}); err != nil {
+		d.notify(action.AlertTypeError, node, fmt.Sprintf("Unable to cordon node %q: %s", name, err))
+		return err
+	}
+
+	podList, err := client.CoreV1().Pods(metav1.NamespaceAll).List(ctx, metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("spec.nodeName", name).String(),
+	})
+	if err != nil {
+		d.notify(action.AlertTypeError, node, fmt.Sprintf("Unable to list pods on node %q: %s", name, err))
+		return err
+	}
+
+	var pods []corev1.Pod
+	for _, pod := range podList.Items {
+		if isDrainable(pod) {
+			pods = append(pods, pod)
+		}
+	}
+
+	d.notify(action.AlertTypeInfo, node,
+		fmt.Sprintf("Node %q cordoned, evicting %d pods", name, len(pods)))
+
+	failed := 0
+	for i, pod := range pods {
+		progress := fmt.Sprintf("(%d/%d)", i+1, len(pods))
+		if err := d.evict(ctx, client, pod); err != nil {
+			if ctx.Err() != nil {
+				message := fmt.Sprintf("Drain of node %q cancelled, %d of %d pods evicted", name, i-failed, len(pods))
+				d.notify(action.AlertTypeWarning, node, message)
+				return fmt.Errorf("drain cancelled, %d of %d pods evicted", i-failed, len(pods))
+			}
+
+			failed++
+			d.notify(action.AlertTypeError, podKey(pod),
+				fmt.Sprintf("Unable to evict pod %s/%s %s: %s", pod.Namespace, pod.Name, progress, err))
+			continue
+		}
+
+		d.notify(action.AlertTypeInfo, podKey(pod),
+			fmt.Sprintf("Evicted pod %s/%s %s", pod.Namespace, pod.Name, progress))
+	}
+
+	if failed > 0 {
+		d.notify(action.AlertTypeWarning, node,
+			fmt.Sprintf("Drained node %q, %d of %d pods could not be evicted", name, failed, len(pods)))
+		return fmt.Errorf("%d of %d pods could not be evicted", failed, len(pods))
+	}
+
+	d.notify(action.AlertTypeInfo, node,
+		kubectl.Describe(fmt.Sprintf("Drained node %q", name), kubectl.Drain(name)))
+	return nil
+}
+
+// evict evicts a pod, retrying while a pod disruption budget doesn't allow it.
+func (d *Drain) evict(ctx context.Context, client kubernetes.Interface, pod corev1.Pod) error {
+	eviction := &policyv1beta1.Eviction{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      pod.Name,
+			Namespace: pod.Namespace,
+		},
+	}
+
+	waiting := false
+	for {
+		err := client.PolicyV1beta1().Evictions(pod.Namespace).Evict(ctx, eviction)
+		switch {
+		case err == nil, kerrors.IsNotFound(err):
+			return nil
+		case !kerrors.IsTooManyRequests(err):
+			return err
+		}
+
+		if !waiting {
+			waiting = true
+			d.notify(action.AlertTypeInfo, podKey(pod),
+				fmt.Sprintf("Waiting for a disruption budget to allow eviction of pod %s/%s", pod.Namespace, pod.Name))
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(d.retryInterval):
+		}
+	}
+}
+
+// notify publishes the progress of a drain. Clients which muted notifications for
+// the node or pod don't see it.
+func (d *Drain) notify(alertType action.AlertType, key store.Key, message string) {
+	d.notifier.Publish(notification.Notification{
+		Type:    alertType,
+		Message: message,
+		Object:  key,
+	})
+}
+
+func nodeKey(name string) store.Key {
+	return store.Key{APIVersion: "v1", Kind: "Node", Name: name}
+}
+
+func podKey(pod corev1.Pod) store.Key {
+	return store.Key{Namespace: pod.Namespace, APIVersion: "v1", Kind: "Pod", Name: pod.Name}
+}
+
+// isDrainable returns true if a pod should be evicted when its node is drained.
+func isDrainable(pod corev1.Pod) bool {
+	if _, ok := pod.Annotations[mirrorPodAnnotation]; ok {
+		return false
+	}
+
+	for _, ownerReference := range pod.OwnerReferences {
+		if ownerReference.Controller != nil && *ownerReference.Controller && ownerReference.Kind == "DaemonSet" {
+			return false
+		}
+	}
+
+	return pod.Status.Phase != corev1.PodSucceeded && pod.Status.Phase != corev1.PodFailed
+}
+
+// DrainCancel cancels the drain of a node.
+type DrainCancel struct {
+	drains *NodeDrains
+}
+
+var _ action.Dispatcher = (*DrainCancel)(nil)
+
+// NewDrainCancel creates an instance of DrainCancel.
+func NewDrainCancel(drains *NodeDrains) *DrainCancel {
+	return &DrainCancel{
+		drains: drains,
+	}
+}
+
+// ActionName returns the name of this action
+func (d *DrainCancel) ActionName() string {
+	return ActionCancelDrainNode
+}
+
+// Handle cancels the drain of a node. Pods which have been evicted aren't
+// restored, and the node remains cordoned.
+func (d *DrainCancel) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	logger := log.From(ctx).With("actionName", d.ActionName())
+	logger.With("payload", payload).Infof("received action payload")
+
+	name, err := payload.String("name")
+	if err != nil {
+		return err
+	}
+
+	if !d.drains.cancel(name) {
+		sendDefaultAlert(alerter, action.AlertTypeInfo, fmt.Sprintf("Node %q is not being drained", name))
+	}
+
+	return nil
+}
@@ -0,0 +1,213 @@
+/*
+ * Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package octant
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	testClient "k8s.io/client-go/kubernetes/fake"
+	clientTesting "k8s.io/client-go/testing"
+	"k8s.io/utils/pointer"
+
+	clusterFake "github.com/vmware-tanzu/octant/internal/cluster/fake"
+	"github.com/vmware-tanzu/octant/internal/notification"
+	notificationFake "github.com/vmware-tanzu/octant/internal/notification/fake"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	actionFake "github.com/vmware-tanzu/octant/pkg/action/fake"
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+func TestDrain_Drain(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node"}}
+	pod := func(name string, options ...func(*corev1.Pod)) *corev1.Pod {
+		p := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec:       corev1.PodSpec{NodeName: "node"},
+			Status:     corev1.PodStatus{Phase: corev1.PodRunning},
+		}
+		for _, option := range options {
+			option(p)
+		}
+		return p
+	}
+
+	fakeClientset := testClient.NewSimpleClientset(
+		node,
+		pod("web"),
+		pod("guarded"),
+		pod("daemon", func(p *corev1.Pod) {
+			p.OwnerReferences = []metav1.OwnerReference{{Kind: "DaemonSet", Name: "daemon", Controller: pointer.BoolPtr(true)}}
+		}),
+		pod("mirror", func(p *corev1.Pod) {
+			p.Annotations = map[string]string{mirrorPodAnnotation: "mirror"}
+		}),
+		pod("completed", func(p *corev1.Pod) {
+			p.Status.Phase = corev1.PodSucceeded
+		}),
+	)
+
+	var evicted []string
+	blocked := false
+	fakeClientset.PrependReactor("create", "pods", func(a clientTesting.Action) (bool, runtime.Object, error) {
+		createAction, ok := a.(clientTesting.CreateAction)
+		if !ok || createAction.GetSubresource() != "eviction" {
+			return false, nil, nil
+		}
+		eviction := createAction.GetObject().(*policyv1beta1.Eviction)
+		if eviction.Name == "guarded" && !blocked {
+			blocked = true
+			return true, nil, kerrors.NewTooManyRequests("disruption budget", 1)
+		}
+		evicted = append(evicted, eviction.Name)
+		return true, nil, nil
+	})
+
+	kubernetesClient := clusterFake.NewMockKubernetesInterface(controller)
+	kubernetesClient.EXPECT().CoreV1().Return(fakeClientset.CoreV1()).AnyTimes()
+	kubernetesClient.EXPECT().PolicyV1beta1().Return(fakeClientset.PolicyV1beta1()).AnyTimes()
+
+	clusterClient := clusterFake.NewMockClientInterface(controller)
+	clusterClient.EXPECT().KubernetesClient().Return(kubernetesClient, nil)
+
+	var messages []string
+	notifier := notificationFake.NewMockNotifier(controller)
+	notifier.EXPECT().
+		Publish(gomock.Any()).
+		Do(func(n notification.Notification) {
+			assert.Equal(t, action.AlertTypeInfo, n.Type)
+			messages = append(messages, n.Message)
+		}).
+		AnyTimes()
+
+	drain := NewDrain(clusterClient, NewNodeDrains(context.Background()), notifier, nil)
+	drain.retryInterval = time.Millisecond
+	assert.Equal(t, ActionDrainNode, drain.ActionName())
+
+	require.NoError(t, drain.Drain(context.Background(), "node"))
+
+	assert.ElementsMatch(t, []string{"web", "guarded"}, evicted)
+
+	got, err := fakeClientset.CoreV1().Nodes().Get(context.Background(), "node", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.True(t, got.Spec.Unschedulable)
+
+	require.NotEmpty(t, messages)
+	assert.Equal(t, `Node "node" cordoned, evicting 2 pods`, messages[0])
+	assert.Contains(t, messages, "Waiting for a disruption budget to allow eviction of pod default/guarded")
+	assert.Equal(t, `Drained node "node" (kubectl equivalent: kubectl drain node --ignore-daemonsets)`, messages[len(messages)-1])
+}
+
+func TestDrain_Handle(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	fakeClientset := testClient.NewSimpleClientset()
+	fakeClientset.PrependReactor("patch", "nodes", func(a clientTesting.Action) (bool, runtime.Object, error) {
+		return true, nil, fmt.Errorf("forbidden")
+	})
+
+	kubernetesClient := clusterFake.NewMockKubernetesInterface(controller)
+	kubernetesClient.EXPECT().CoreV1().Return(fakeClientset.CoreV1()).AnyTimes()
+
+	clusterClient := clusterFake.NewMockClientInterface(controller)
+	clusterClient.EXPECT().KubernetesClient().Return(kubernetesClient, nil)
+
+	notifier := notificationFake.NewMockNotifier(controller)
+	notifier.EXPECT().
+		Publish(gomock.Any()).
+		Do(func(n notification.Notification) {
+			assert.Equal(t, action.AlertTypeError, n.Type)
+			assert.Equal(t, store.Key{APIVersion: "v1", Kind: "Node", Name: "node"}, n.Object)
+		})
+
+	audited := make(chan error, 1)
+	auditor := auditorFunc(func(ctx context.Context, actionName string, key store.Key, f func() error) error {
+		assert.Equal(t, ActionDrainNode, actionName)
+		err := f()
+		audited <- err
+		return err
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	drain := NewDrain(clusterClient, NewNodeDrains(ctx), notifier, auditor)
+	require.NoError(t, drain.Handle(context.Background(), actionFake.NewMockAlerter(controller), action.Payload{"name": "node"}))
+
+	select {
+	case err := <-audited:
+		assert.Error(t, err)
+	case <-time.After(wait.ForeverTestTimeout):
+		t.Fatal("drain wasn't audited")
+	}
+}
+
+type auditorFunc func(ctx context.Context, actionName string, key store.Key, f func() error) error
+
+func (fn auditorFunc) Audit(ctx context.Context, actionName string, key store.Key, f func() error) error {
+	return fn(ctx, actionName, key, f)
+}
+
+func TestNodeDrains(t *testing.T) {
+	drains := NewNodeDrains(context.Background())
+
+	ctx, ok := drains.start("node")
+	require.True(t, ok)
+
+	_, ok = drains.start("node")
+	assert.False(t, ok, "a node can only be drained once at a time")
+
+	assert.True(t, drains.cancel("node"))
+	assert.Error(t, ctx.Err())
+
+	drains.finish("node")
+	assert.False(t, drains.cancel("node"))
+
+	// Drains end when the context they were started from is done.
+	parent, cancel := context.WithCancel(context.Background())
+	ctx, ok = NewNodeDrains(parent).start("node")
+	require.True(t, ok)
+	cancel()
+	assert.Error(t, ctx.Err())
+}
+
+func TestDrainCancel_Handle(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	drains := NewNodeDrains(context.Background())
+	ctx, ok := drains.start("node")
+	require.True(t, ok)
+
+	alerter := actionFake.NewMockAlerter(controller)
+
+	cancel := NewDrainCancel(drains)
+	assert.Equal(t, ActionCancelDrainNode, cancel.ActionName())
+	require.NoError(t, cancel.Handle(context.Background(), alerter, action.Payload{"name": "node"}))
+	assert.Error(t, ctx.Err())
+
+	alerter.EXPECT().
+		SendAlert(gomock.Any()).
+		Do(func(alert action.Alert) {
+			assert.Equal(t, `Node "other" is not being drained`, alert.Message)
+		})
+	require.NoError(t, cancel.Handle(context.Background(), alerter, action.Payload{"name": "other"}))
+}
@@ -0,0 +1,306 @@
+/*
+ * Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package octant
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	"github.com/vmware-tanzu/octant/internal/kubectl"
+	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/internal/util/kubernetes"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+const (
+	// TaintModeApply applies a taint to a node.
+	TaintModeApply = "apply"
+	// TaintModePreview lists the pods which don't tolerate a taint without
+	// changing the node.
+	TaintModePreview = "preview"
+)
+
+// TaintEffects are the valid effects of a taint.
+var TaintEffects = []corev1.TaintEffect{
+	corev1.TaintEffectNoSchedule,
+	corev1.TaintEffectPreferNoSchedule,
+	corev1.TaintEffectNoExecute,
+}
+
+// NodeTaintEditor adds or updates a taint on a node.
+type NodeTaintEditor struct {
+	store store.Store
+}
+
+var _ action.Dispatcher = (*NodeTaintEditor)(nil)
+
+// NewNodeTaintEditor creates an instance of NodeTaintEditor.
+func NewNodeTaintEditor(objectStore store.Store) *NodeTaintEditor {
+	return &NodeTaintEditor{
+		store: objectStore,
+	}
+}
+
+// ActionName returns the name of this action.
+func (e *NodeTaintEditor) ActionName() string {
+	return ActionSetNodeTaint
+}
+
+// Handle sets a taint on a node. A taint with the same key and effect is
+// replaced. In preview mode, the node isn't changed and the pods scheduled on the
+// node which don't tolerate the taint are listed instead.
+func (e *NodeTaintEditor) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	logger := log.From(ctx).With("actionName", e.ActionName())
+	logger.With("payload", payload).Infof("received action payload")
+
+	key, err := store.KeyFromPayload(payload)
+	if err != nil {
+		return err
+	}
+
+	taint, err := taintFromPayload(payload)
+	if err != nil {
+		sendDefaultAlert(alerter, action.AlertTypeWarning, fmt.Sprintf("Unable to taint node %q: %s", key.Name, err))
+		return nil
+	}
+
+	pods, err := nodePods(ctx, e.store, key.Name)
+	if err != nil {
+		return err
+	}
+	untolerated := describePods(UntoleratedPods(pods, taint))
+
+	mode, err := payload.OptionalString("mode")
+	if err != nil {
+		return err
+	}
+
+	if mode == TaintModePreview {
+		sendDefaultAlert(alerter, action.AlertTypeInfo,
+			fmt.Sprintf("Taint %s on node %q is not tolerated by %s", taint.ToString(), key.Name, untolerated))
+		return nil
+	}
+
+	fn := func(object *unstructured.Unstructured) error {
+		return updateNodeTaints(object, func(taints []corev1.Taint) []corev1.Taint {
+			return append(removeTaint(taints, taint), taint)
+		})
+	}
+
+	alertType := action.AlertTypeInfo
+	message := kubectl.Describe(
+		fmt.Sprintf("Set taint %s on node %q, it is not tolerated by %s", taint.ToString(), key.Name, untolerated),
+		kubectl.Taint(key.Name, taint))
+	if err := e.store.Update(ctx, key, fn); err != nil {
+		logger.WithErr(err).Errorf("set node taint")
+		alertType = action.AlertTypeWarning
+		message = fmt.Sprintf("Unable to taint node %q: %s", key.Name, err)
+	}
+	sendDefaultAlert(alerter, alertType, message)
+
+	return nil
+}
+
+// NodeTaintRemover removes a taint from a node.
+type NodeTaintRemover struct {
+	store store.Store
+}
+
+var _ action.Dispatcher = (*NodeTaintRemover)(nil)
+
+// NewNodeTaintRemover creates an instance of NodeTaintRemover.
+func NewNodeTaintRemover(objectStore store.Store) *NodeTaintRemover {
+	return &NodeTaintRemover{
+		store: objectStore,
+	}
+}
+
+// ActionName returns the name of this action.
+func (r *NodeTaintRemover) ActionName() string {
+	return ActionRemoveNodeTaint
+}
+
+// Handle removes the taint with a key and effect from a node.
+func (r *NodeTaintRemover) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	logger := log.From(ctx).With("actionName", r.ActionName())
+	logger.With("payload", payload).Infof("received action payload")
+
+	key, err := store.KeyFromPayload(payload)
+	if err != nil {
+		return err
+	}
+
+	taintKey, err := payload.String("taintKey")
+	if err != nil {
+		return err
+	}
+
+	effect, err := payload.String("effect")
+	if err != nil {
+		return err
+	}
+
+	taint := corev1.Taint{Key: taintKey, Effect: corev1.TaintEffect(effect)}
+
+	fn := func(object *unstructured.Unstructured) error {
+		return updateNodeTaints(object, func(taints []corev1.Taint) []corev1.Taint {
+			return removeTaint(taints, taint)
+		})
+	}
+
+	alertType := action.AlertTypeInfo
+	message := kubectl.Describe(
+		fmt.Sprintf("Removed taint %s:%s from node %q", taint.Key, taint.Effect, key.Name),
+		kubectl.RemoveTaint(key.Name, taint))
+	if err := r.store.Update(ctx, key, fn); err != nil {
+		logger.WithErr(err).Errorf("remove node taint")
+		alertType = action.AlertTypeWarning
+		message = fmt.Sprintf("Unable to remove taint from node %q: %s", key.Name, err)
+	}
+	sendDefaultAlert(alerter, alertType, message)
+
+	return nil
+}
+
+// UntoleratedPods returns the pods which don't have a toleration for a taint.
+func UntoleratedPods(pods []corev1.Pod, taint corev1.Taint) []corev1.Pod {
+	var untolerated []corev1.Pod
+	for _, pod := range pods {
+		tolerated := false
+		for i := range pod.Spec.Tolerations {
+			if pod.Spec.Tolerations[i].ToleratesTaint(&taint) {
+				tolerated = true
+				break
+			}
+		}
+
+		if !tolerated {
+			untolerated = append(untolerated, pod)
+		}
+	}
+
+	return untolerated
+}
+
+// taintFromPayload creates a taint from a payload and validates it.
+func taintFromPayload(payload action.Payload) (corev1.Taint, error) {
+	taintKey, err := payload.String("taintKey")
+	if err != nil {
+		return corev1.Taint{}, err
+	}
+
+	value, err := payload.OptionalString("value")
+	if err != nil {
+		return corev1.Taint{}, err
+	}
+
+	effect, err := payload.String("effect")
+	if err != nil {
+		return corev1.Taint{}, err
+	}
+
+	taint := corev1.Taint{
+		Key:    strings.TrimSpace(taintKey),
+		Value:  strings.TrimSpace(value),
+		Effect: corev1.TaintEffect(effect),
+	}
+
+	if errs := validation.IsQualifiedName(taint.Key); len(errs) > 0 {
+		return corev1.Taint{}, errors.Errorf("invalid key %q: %s", taint.Key, strings.Join(errs, "; "))
+	}
+
+	if errs := validation.IsValidLabelValue(taint.Value); len(errs) > 0 {
+		return corev1.Taint{}, errors.Errorf("invalid value %q: %s", taint.Value, strings.Join(errs, "; "))
+	}
+
+	validEffect := false
+	for _, e := range TaintEffects {
+		if taint.Effect == e {
+			validEffect = true
+		}
+	}
+	if !validEffect {
+		return corev1.Taint{}, errors.Errorf("invalid effect %q", taint.Effect)
+	}
+
+	return taint, nil
+}
+
+// updateNodeTaints replaces the taints of a node with the taints returned by fn.
+func updateNodeTaints(object *unstructured.Unstructured, fn func([]corev1.Taint) []corev1.Taint) error {
+	node := &corev1.Node{}
+	if err := kubernetes.FromUnstructured(object, node); err != nil {
+		return err
+	}
+
+	node.Spec.Taints = fn(node.Spec.Taints)
+
+	m, err := runtime.DefaultUnstructuredConverter.ToUnstructured(node)
+	if err != nil {
+		return err
+	}
+
+	spec, _, err := unstructured.NestedMap(m, "spec")
+	if err != nil {
+		return err
+	}
+
+	return unstructured.SetNestedMap(object.Object, spec, "spec")
+}
+
+// removeTaint returns taints without the taint which matches the key and effect of taint.
+func removeTaint(taints []corev1.Taint, taint corev1.Taint) []corev1.Taint {
+	var updated []corev1.Taint
+	for _, t := range taints {
+		if !t.MatchTaint(&taint) {
+			updated = append(updated, t)
+		}
+	}
+	return updated
+}
+
+// nodePods lists the pods scheduled on a node.
+func nodePods(ctx context.Context, objectStore store.Store, nodeName string) ([]corev1.Pod, error) {
+	list, _, err := objectStore.List(ctx, store.Key{APIVersion: "v1", Kind: "Pod"})
+	if err != nil {
+		return nil, errors.Wrap(err, "list pods")
+	}
+
+	var pods []corev1.Pod
+	for i := range list.Items {
+		pod := corev1.Pod{}
+		if err := kubernetes.FromUnstructured(&list.Items[i], &pod); err != nil {
+			return nil, err
+		}
+
+		if pod.Spec.NodeName == nodeName {
+			pods = append(pods, pod)
+		}
+	}
+
+	return pods, nil
+}
+
+func describePods(pods []corev1.Pod) string {
+	if len(pods) == 0 {
+		return "no scheduled pods"
+	}
+
+	var names []string
+	for _, pod := range pods {
+		names = append(names, fmt.Sprintf("%s/%s", pod.Namespace, pod.Name))
+	}
+
+	return fmt.Sprintf("%d scheduled pods: %s", len(pods), strings.Join(names, ", "))
+}
@@ -0,0 +1,172 @@
+/*
+ * Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package octant
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/internal/util/kubernetes"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	actionFake "github.com/vmware-tanzu/octant/pkg/action/fake"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/store/fake"
+)
+
+func TestNodeTaintEditor_Handle(t *testing.T) {
+	existing := corev1.Taint{Key: "dedicated", Value: "cpu", Effect: corev1.TaintEffectNoSchedule}
+	other := corev1.Taint{Key: "spot", Effect: corev1.TaintEffectPreferNoSchedule}
+
+	tests := []struct {
+		name            string
+		payload         action.Payload
+		expectUpdate    bool
+		expectedTaints  []corev1.Taint
+		expectedType    action.AlertType
+		expectedMessage string
+	}{
+		{
+			name:            "update taint",
+			payload:         action.Payload{"taintKey": "dedicated", "value": "gpu", "effect": "NoSchedule", "mode": TaintModeApply},
+			expectUpdate:    true,
+			expectedTaints:  []corev1.Taint{other, {Key: "dedicated", Value: "gpu", Effect: corev1.TaintEffectNoSchedule}},
+			expectedType:    action.AlertTypeInfo,
+			expectedMessage: `Set taint dedicated=gpu:NoSchedule on node "node", it is not tolerated by 1 scheduled pods: namespace/pod (kubectl equivalent: kubectl taint nodes node dedicated=gpu:NoSchedule --overwrite)`,
+		},
+		{
+			name:            "preview",
+			payload:         action.Payload{"taintKey": "dedicated", "value": "gpu", "effect": "NoExecute", "mode": TaintModePreview},
+			expectedType:    action.AlertTypeInfo,
+			expectedMessage: `Taint dedicated=gpu:NoExecute on node "node" is not tolerated by 1 scheduled pods: namespace/pod`,
+		},
+		{
+			name:            "invalid effect",
+			payload:         action.Payload{"taintKey": "dedicated", "value": "gpu", "effect": "Sometimes"},
+			expectedType:    action.AlertTypeWarning,
+			expectedMessage: `Unable to taint node "node": invalid effect "Sometimes"`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			node := testutil.CreateNode("node")
+			node.Spec.Taints = []corev1.Taint{existing, other}
+			object := testutil.ToUnstructured(t, node)
+
+			key, err := store.KeyFromObject(object)
+			require.NoError(t, err)
+
+			pod := testutil.CreatePod("pod")
+			pod.Spec.NodeName = "node"
+
+			objectStore := fake.NewMockStore(controller)
+			objectStore.EXPECT().
+				List(gomock.Any(), store.Key{APIVersion: "v1", Kind: "Pod"}).
+				Return(testutil.ToUnstructuredList(t, pod), false, nil).
+				AnyTimes()
+			if test.expectUpdate {
+				objectStore.EXPECT().
+					Update(gomock.Any(), key, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, key store.Key, fn func(object *unstructured.Unstructured) error) error {
+						return fn(object)
+					})
+			}
+
+			alerter := actionFake.NewMockAlerter(controller)
+			alerter.EXPECT().
+				SendAlert(gomock.Any()).
+				Do(func(alert action.Alert) {
+					assert.Equal(t, test.expectedType, alert.Type)
+					assert.Equal(t, test.expectedMessage, alert.Message)
+				})
+
+			payload := key.ToActionPayload()
+			for k, v := range test.payload {
+				payload[k] = v
+			}
+
+			editor := NewNodeTaintEditor(objectStore)
+			assert.Equal(t, ActionSetNodeTaint, editor.ActionName())
+			require.NoError(t, editor.Handle(context.Background(), alerter, payload))
+
+			if test.expectUpdate {
+				assertNodeTaints(t, object, test.expectedTaints)
+			}
+		})
+	}
+}
+
+func TestNodeTaintRemover_Handle(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	remaining := corev1.Taint{Key: "dedicated", Value: "gpu", Effect: corev1.TaintEffectNoExecute}
+
+	node := testutil.CreateNode("node")
+	node.Spec.Taints = []corev1.Taint{
+		{Key: "dedicated", Value: "gpu", Effect: corev1.TaintEffectNoSchedule},
+		remaining,
+	}
+	object := testutil.ToUnstructured(t, node)
+
+	key, err := store.KeyFromObject(object)
+	require.NoError(t, err)
+
+	objectStore := fake.NewMockStore(controller)
+	objectStore.EXPECT().
+		Update(gomock.Any(), key, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, key store.Key, fn func(object *unstructured.Unstructured) error) error {
+			return fn(object)
+		})
+
+	alerter := actionFake.NewMockAlerter(controller)
+	alerter.EXPECT().
+		SendAlert(gomock.Any()).
+		Do(func(alert action.Alert) {
+			assert.Equal(t, action.AlertTypeInfo, alert.Type)
+			assert.Equal(t, `Removed taint dedicated:NoSchedule from node "node" (kubectl equivalent: kubectl taint nodes node dedicated:NoSchedule-)`, alert.Message)
+		})
+
+	payload := key.ToActionPayload()
+	payload["taintKey"] = "dedicated"
+	payload["effect"] = "NoSchedule"
+
+	remover := NewNodeTaintRemover(objectStore)
+	assert.Equal(t, ActionRemoveNodeTaint, remover.ActionName())
+	require.NoError(t, remover.Handle(context.Background(), alerter, payload))
+
+	assertNodeTaints(t, object, []corev1.Taint{remaining})
+}
+
+func TestUntoleratedPods(t *testing.T) {
+	taint := corev1.Taint{Key: "dedicated", Value: "gpu", Effect: corev1.TaintEffectNoSchedule}
+
+	tolerating := testutil.CreatePod("tolerating")
+	tolerating.Spec.Tolerations = []corev1.Toleration{{Key: "dedicated", Operator: corev1.TolerationOpExists}}
+
+	untolerating := testutil.CreatePod("untolerating")
+	untolerating.Spec.Tolerations = []corev1.Toleration{{Key: "dedicated", Operator: corev1.TolerationOpEqual, Value: "cpu"}}
+
+	got := UntoleratedPods([]corev1.Pod{*tolerating, *untolerating}, taint)
+	require.Len(t, got, 1)
+	assert.Equal(t, "untolerating", got[0].Name)
+}
+
+func assertNodeTaints(t *testing.T, object *unstructured.Unstructured, expected []corev1.Taint) {
+	got := &corev1.Node{}
+	require.NoError(t, kubernetes.FromUnstructured(object, got))
+	assert.Equal(t, expected, got.Spec.Taints)
+}
@@ -0,0 +1,202 @@
+/*
+ * Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package octant
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/vmware-tanzu/octant/internal/cluster"
+	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+// ContextClusterClients creates cluster clients for kube config contexts.
+type ContextClusterClients interface {
+	ClusterClientForContext(ctx context.Context, contextName string) (cluster.ClientInterface, error)
+}
+
+// CompareObjectModalButton configures a button to open a form which chooses the
+// contexts to compare an object in. The current context is the default base
+// context, and the first other context is the default compare context.
+func CompareObjectModalButton(object runtime.Object, contextNames []string, currentContext string) (component.ButtonOption, error) {
+	if object == nil {
+		return nil, errors.New("object is nil")
+	}
+	_, kind := object.GetObjectKind().GroupVersionKind().ToAPIVersionAndKind()
+
+	var baseChoices, compareChoices []component.InputChoice
+	compareChecked := false
+	for _, name := range contextNames {
+		baseChoices = append(baseChoices, component.InputChoice{
+			Label:   name,
+			Value:   name,
+			Checked: name == currentContext,
+		})
+
+		checked := !compareChecked && name != currentContext
+		compareChecked = compareChecked || checked
+		compareChoices = append(compareChoices, component.InputChoice{
+			Label:   name,
+			Value:   name,
+			Checked: checked,
+		})
+	}
+
+	form, err := component.CreateFormForObject(ActionCompareObject, object,
+		component.NewFormFieldSelect("Base Context", "baseContext", baseChoices, false),
+		component.NewFormFieldSelect("Compare Context", "compareContext", compareChoices, false),
+	)
+	if err != nil {
+		return nil, err
+	}
+	form.Action = ActionCompareObject
+
+	modal := component.NewModal(component.TitleFromString(fmt.Sprintf("Compare %s", kind)))
+	modal.SetBody(component.NewText("Fields which differ between the contexts are shown in a table. Status and fields the API server generates are not compared."))
+	modal.AddForm(form)
+
+	return component.WithModal(modal), nil
+}
+
+// ObjectCompareDispatcher is an action that compares an object with the object
+// of the same namespace, kind, and name in another kube config context. It can
+// be used to check that environments such as staging and production match.
+type ObjectCompareDispatcher struct {
+	clients ContextClusterClients
+}
+
+var _ action.Dispatcher = (*ObjectCompareDispatcher)(nil)
+
+// NewObjectCompareDispatcher creates an instance of ObjectCompareDispatcher.
+func NewObjectCompareDispatcher(clients ContextClusterClients) *ObjectCompareDispatcher {
+	return &ObjectCompareDispatcher{
+		clients: clients,
+	}
+}
+
+// ActionName returns the name of this action.
+func (o *ObjectCompareDispatcher) ActionName() string {
+	return ActionCompareObject
+}
+
+// Handle opens a modal with a table of the fields which differ between the
+// object in the base context and the object in the compare context. Fields the
+// API server generates, such as status, are not compared.
+func (o *ObjectCompareDispatcher) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	logger := log.From(ctx).With("actionName", o.ActionName())
+	logger.With("payload", payload).Infof("received action payload")
+
+	key, err := store.KeyFromPayload(payload)
+	if err != nil {
+		return err
+	}
+
+	baseContext, err := payload.String("baseContext")
+	if err != nil {
+		return err
+	}
+
+	compareContext, err := payload.String("compareContext")
+	if err != nil {
+		return err
+	}
+
+	if baseContext == compareContext {
+		message := fmt.Sprintf("Choose two different contexts to compare %s %q", key.Kind, key.Name)
+		sendDefaultAlert(alerter, action.AlertTypeWarning, message)
+		return nil
+	}
+
+	changes, err := o.compare(ctx, key, baseContext, compareContext)
+	if err != nil {
+		logger.WithErr(err).Errorf("compare object")
+		message := fmt.Sprintf("Unable to compare %s %q: %s", key.Kind, key.Name, err)
+		sendDefaultAlert(alerter, action.AlertTypeWarning, message)
+		return nil
+	}
+
+	if len(changes) == 0 {
+		message := fmt.Sprintf("%s %q is the same in %s and %s", key.Kind, key.Name, baseContext, compareContext)
+		sendDefaultAlert(alerter, action.AlertTypeInfo, message)
+		return nil
+	}
+
+	modalSender, ok := alerter.(ModalSender)
+	if !ok {
+		message := fmt.Sprintf("%s %q has %d differences from %s to %s",
+			key.Kind, key.Name, len(changes), baseContext, compareContext)
+		sendDefaultAlert(alerter, action.AlertTypeInfo, message)
+		return nil
+	}
+
+	modal := component.NewModal(component.TitleFromString(fmt.Sprintf("Compare %s %s", key.Kind, key.Name)))
+	modal.SetBody(objectChangesTable(changes, baseContext, compareContext))
+	modal.SetSize(component.ModalSizeExtraLarge)
+	modal.Open()
+	modalSender.SendModal(modal)
+
+	return nil
+}
+
+// compare fetches the object from both contexts and diffs them.
+func (o *ObjectCompareDispatcher) compare(ctx context.Context, key store.Key, baseContext, compareContext string) ([]objectChange, error) {
+	base, err := o.get(ctx, baseContext, key)
+	if err != nil {
+		return nil, err
+	}
+
+	compare, err := o.get(ctx, compareContext, key)
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []objectChange
+	diffFields(nil, CleanExportObject(base).Object, CleanExportObject(compare).Object, &changes)
+	return changes, nil
+}
+
+// get fetches an object from the cluster of a context.
+func (o *ObjectCompareDispatcher) get(ctx context.Context, contextName string, key store.Key) (*unstructured.Unstructured, error) {
+	clusterClient, err := o.clients.ClusterClientForContext(ctx, contextName)
+	if err != nil {
+		return nil, err
+	}
+	defer clusterClient.Close()
+
+	gvr, namespaced, err := clusterClient.Resource(key.GroupVersionKind().GroupKind())
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to discover resource in %s", contextName)
+	}
+
+	client, err := clusterClient.DynamicClient()
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to get dynamic client for %s", contextName)
+	}
+
+	var object *unstructured.Unstructured
+	if namespaced {
+		object, err = client.Resource(gvr).Namespace(key.Namespace).Get(ctx, key.Name, metav1.GetOptions{})
+	} else {
+		object, err = client.Resource(gvr).Get(ctx, key.Name, metav1.GetOptions{})
+	}
+	if kerrors.IsNotFound(err) {
+		return nil, errors.Errorf("%s %q was not found in %s", key.Kind, key.Name, contextName)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "get object from %s", contextName)
+	}
+
+	return object, nil
+}
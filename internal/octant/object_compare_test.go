@@ -0,0 +1,200 @@
+/*
+ * Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package octant
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware-tanzu/octant/internal/cluster"
+	clusterFake "github.com/vmware-tanzu/octant/internal/cluster/fake"
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	actionFake "github.com/vmware-tanzu/octant/pkg/action/fake"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+type fakeContextClusterClients map[string]cluster.ClientInterface
+
+func (f fakeContextClusterClients) ClusterClientForContext(_ context.Context, contextName string) (cluster.ClientInterface, error) {
+	clusterClient, ok := f[contextName]
+	if !ok {
+		return nil, errors.Errorf("context %q does not exist", contextName)
+	}
+	return clusterClient, nil
+}
+
+func TestObjectCompareDispatcher_Handle(t *testing.T) {
+	deployment := testutil.CreateDeployment("deployment")
+	deployment.Labels = map[string]string{"app": "web"}
+	deployment.ResourceVersion = "1"
+	staging := testutil.ToUnstructured(t, deployment)
+	require.NoError(t, unstructured.SetNestedField(staging.Object, int64(1), "spec", "replicas"))
+
+	key, err := store.KeyFromObject(staging)
+	require.NoError(t, err)
+
+	production := staging.DeepCopy()
+	production.SetResourceVersion("2")
+	require.NoError(t, unstructured.SetNestedField(production.Object, int64(3), "spec", "replicas"))
+	require.NoError(t, unstructured.SetNestedField(production.Object, "Available", "status", "phase"))
+
+	tests := []struct {
+		name           string
+		baseContext    string
+		compareContext string
+		objects        map[string]*unstructured.Unstructured
+		expected       []action.Alert
+		expectedTable  *component.Table
+	}{
+		{
+			name:           "differences",
+			baseContext:    "staging",
+			compareContext: "production",
+			objects:        map[string]*unstructured.Unstructured{"staging": staging, "production": production},
+			expectedTable: component.NewTableWithRows("Changes", "There are no changes",
+				[]component.TableCol{
+					{Name: "Path", Accessor: "Path"},
+					{Name: "staging", Accessor: "Before"},
+					{Name: "production", Accessor: "After"},
+					{Name: "Notes", Accessor: "Notes"},
+				},
+				[]component.TableRow{
+					{
+						"Path":   component.NewText("spec.replicas"),
+						"Before": component.NewText("1"),
+						"After":  component.NewText("3"),
+						"Notes":  component.NewText(""),
+					},
+				}),
+		},
+		{
+			name:           "same",
+			baseContext:    "staging",
+			compareContext: "production",
+			objects:        map[string]*unstructured.Unstructured{"staging": staging, "production": staging},
+			expected: []action.Alert{
+				{Type: action.AlertTypeInfo, Message: `Deployment "deployment" is the same in staging and production`},
+			},
+		},
+		{
+			name:           "same context",
+			baseContext:    "staging",
+			compareContext: "staging",
+			expected: []action.Alert{
+				{Type: action.AlertTypeWarning, Message: `Choose two different contexts to compare Deployment "deployment"`},
+			},
+		},
+		{
+			name:           "unknown context",
+			baseContext:    "staging",
+			compareContext: "unknown",
+			objects:        map[string]*unstructured.Unstructured{"staging": staging},
+			expected: []action.Alert{
+				{Type: action.AlertTypeWarning, Message: `Unable to compare Deployment "deployment": context "unknown" does not exist`},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			gvr := schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}
+
+			clients := fakeContextClusterClients{}
+			for contextName, object := range test.objects {
+				clusterClient := clusterFake.NewMockClientInterface(controller)
+				dynamicClient := clusterFake.NewMockDynamicInterface(controller)
+				resourceClient := clusterFake.NewMockNamespaceableResourceInterface(controller)
+				clusterClient.EXPECT().Resource(schema.GroupKind{Group: "apps", Kind: "Deployment"}).Return(gvr, true, nil)
+				clusterClient.EXPECT().DynamicClient().Return(dynamicClient, nil)
+				clusterClient.EXPECT().Close()
+				dynamicClient.EXPECT().Resource(gvr).Return(resourceClient)
+				resourceClient.EXPECT().Namespace(key.Namespace).Return(resourceClient)
+				resourceClient.EXPECT().Get(gomock.Any(), key.Name, gomock.Any()).Return(object, nil)
+				clients[contextName] = clusterClient
+			}
+
+			var alerts []action.Alert
+			mockAlerter := actionFake.NewMockAlerter(controller)
+			mockAlerter.EXPECT().
+				SendAlert(gomock.Any()).
+				Do(func(alert action.Alert) {
+					alerts = append(alerts, action.Alert{Type: alert.Type, Message: alert.Message})
+				}).
+				AnyTimes()
+			alerter := &modalAlerter{Alerter: mockAlerter}
+
+			payload := key.ToActionPayload()
+			payload["baseContext"] = test.baseContext
+			payload["compareContext"] = test.compareContext
+
+			dispatcher := NewObjectCompareDispatcher(clients)
+			require.NoError(t, dispatcher.Handle(context.Background(), alerter, payload))
+
+			assert.Equal(t, test.expected, alerts)
+
+			if test.expectedTable == nil {
+				assert.Empty(t, alerter.modals)
+				return
+			}
+
+			require.Len(t, alerter.modals, 1)
+			modal := alerter.modals[0]
+			assert.True(t, modal.Config.Opened)
+			assert.Equal(t, test.expectedTable, modal.Config.Body)
+		})
+	}
+}
+
+func TestCompareObjectModalButton(t *testing.T) {
+	deployment := testutil.CreateDeployment("deployment")
+
+	option, err := CompareObjectModalButton(deployment, []string{"staging", "production", "development"}, "production")
+	require.NoError(t, err)
+
+	button := component.NewButton("Compare", action.Payload{}, option)
+	modal, ok := button.Modal.(*component.Modal)
+	require.True(t, ok)
+	require.NotNil(t, modal.Config.Form)
+
+	form := modal.Config.Form
+	assert.Equal(t, ActionCompareObject, form.Action)
+
+	expected := map[string][]component.InputChoice{
+		"baseContext": {
+			{Label: "staging", Value: "staging"},
+			{Label: "production", Value: "production", Checked: true},
+			{Label: "development", Value: "development"},
+		},
+		"compareContext": {
+			{Label: "staging", Value: "staging", Checked: true},
+			{Label: "production", Value: "production"},
+			{Label: "development", Value: "development"},
+		},
+	}
+	for name, choices := range expected {
+		var found bool
+		for _, field := range form.Fields {
+			if field.Name() != name {
+				continue
+			}
+			found = true
+			assert.Equal(t, component.NewFormFieldSelect(field.Label(), name, choices, false), field)
+		}
+		assert.True(t, found, "form has field %s", name)
+	}
+}
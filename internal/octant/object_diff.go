@@ -0,0 +1,359 @@
+/*
+ *  Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+ *  SPDX-License-Identifier: Apache-2.0
+ *
+ */
+
+package octant
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/vmware-tanzu/octant/internal/cluster"
+	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+const (
+	// objectDiffValueLength is the longest value shown for a change.
+	objectDiffValueLength = 120
+	// octantFieldManager is the field manager octant applies objects as.
+	octantFieldManager = "octant"
+)
+
+// ModalSender opens modals in the client which dispatched an action. Alerters
+// which are also modal senders can show a dispatcher's results in a modal.
+type ModalSender interface {
+	SendModal(modal *component.Modal)
+}
+
+// objectDiffIgnoredFields are fields the API server sets on every write.
+var objectDiffIgnoredFields = map[string]bool{
+	"metadata.managedFields":     true,
+	"metadata.resourceVersion":   true,
+	"metadata.generation":        true,
+	"metadata.creationTimestamp": true,
+	"metadata.uid":               true,
+	"metadata.selfLink":          true,
+	"status":                     true,
+}
+
+// ObjectDiffDispatcher is an action that shows how an edit will change an
+// object without updating it. The edit is sent to the API server as a dry run,
+// so the diff includes defaults and changes made by admission webhooks.
+type ObjectDiffDispatcher struct {
+	store             store.Store
+	clusterClient     cluster.ClientInterface
+	objectFromPayload func(payload action.Payload) (*unstructured.Unstructured, error)
+}
+
+var _ action.Dispatcher = &ObjectDiffDispatcher{}
+
+// NewObjectDiffDispatcher creates an instance of ObjectDiffDispatcher.
+func NewObjectDiffDispatcher(objectStore store.Store, clusterClient cluster.ClientInterface) *ObjectDiffDispatcher {
+	return &ObjectDiffDispatcher{
+		store:             objectStore,
+		clusterClient:     clusterClient,
+		objectFromPayload: ObjectUpdateFromPayload,
+	}
+}
+
+// ActionName returns the action name this dispatcher responds to.
+func (o ObjectDiffDispatcher) ActionName() string {
+	return ActionDiffObject
+}
+
+// Handle opens a modal with a table of the fields an edit changes. Removed
+// fields and fields owned by another field manager are marked as warnings. The
+// modal applies the edit, so edits are reviewed before they are applied.
+func (o ObjectDiffDispatcher) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	logger := log.From(ctx)
+
+	object, err := o.objectFromPayload(payload)
+	if err != nil {
+		message := fmt.Sprintf("load object from payload: %v", err)
+		alerter.SendAlert(action.CreateAlert(action.AlertTypeError, message, action.DefaultAlertExpiration))
+		return nil
+	}
+
+	key, err := store.KeyFromPayload(payload)
+	if err != nil {
+		return err
+	}
+
+	changes, err := o.diff(ctx, key, object)
+	if err != nil {
+		logger.WithErr(err).Errorf("diff object")
+		message := fmt.Sprintf("diff object: %s", err)
+		alerter.SendAlert(action.CreateAlert(action.AlertTypeError, message, action.DefaultAlertExpiration))
+		return nil
+	}
+
+	if len(changes) == 0 {
+		alerter.SendAlert(action.CreateAlert(action.AlertTypeInfo, "There are no changes to apply", action.DefaultAlertExpiration))
+		return nil
+	}
+
+	modalSender, ok := alerter.(ModalSender)
+	if !ok {
+		message := fmt.Sprintf("%s %q has %d changes which can't be reviewed in this client", key.Kind, key.Name, len(changes))
+		alerter.SendAlert(action.CreateAlert(action.AlertTypeWarning, message, action.DefaultAlertExpiration))
+		return nil
+	}
+
+	applyPayload := action.Payload{}
+	for k, v := range payload {
+		applyPayload[k] = v
+	}
+	applyPayload["action"] = ActionUpdateObject
+
+	modal := component.NewModal(component.TitleFromString(fmt.Sprintf("Review Changes to %s %s", key.Kind, key.Name)))
+	modal.SetBody(objectChangesTable(changes, "Live", "Proposed"))
+	modal.SetSize(component.ModalSizeExtraLarge)
+	modal.AddButton(component.NewButton("Apply", applyPayload))
+	modal.Open()
+	modalSender.SendModal(modal)
+
+	return nil
+}
+
+// diff updates the object with a dry run and compares the result with the live object.
+func (o ObjectDiffDispatcher) diff(ctx context.Context, key store.Key, object *unstructured.Unstructured) ([]objectChange, error) {
+	live, err := o.store.Get(ctx, key)
+	if err != nil {
+		return nil, fmt.Errorf("get object: %w", err)
+	}
+	if live == nil {
+		return nil, fmt.Errorf("%s was not found", key)
+	}
+
+	proposed := live.DeepCopy()
+	if err := mergeObjectUpdate(object, proposed); err != nil {
+		return nil, err
+	}
+
+	gvr, namespaced, err := o.clusterClient.Resource(key.GroupVersionKind().GroupKind())
+	if err != nil {
+		return nil, fmt.Errorf("unable to discover resource: %w", err)
+	}
+
+	client, err := o.clusterClient.DynamicClient()
+	if err != nil {
+		return nil, fmt.Errorf("unable to get dynamic client: %w", err)
+	}
+
+	namespaceableClient := client.Resource(gvr)
+	updateOptions := metav1.UpdateOptions{DryRun: []string{metav1.DryRunAll}}
+
+	var result *unstructured.Unstructured
+	if namespaced {
+		result, err = namespaceableClient.Namespace(key.Namespace).Update(ctx, proposed, updateOptions)
+	} else {
+		result, err = namespaceableClient.Update(ctx, proposed, updateOptions)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("dry run update: %w", err)
+	}
+
+	return diffObjects(live, result), nil
+}
+
+// objectChange is a change to a field of an object.
+type objectChange struct {
+	// path is the dotted path of the field.
+	path string
+	// fields are the names in the path. Names may contain dots.
+	fields []string
+	from   interface{}
+	to     interface{}
+	// managers are the field managers which own the field in the live object.
+	managers []string
+}
+
+func (c objectChange) isRemoval() bool {
+	return c.to == nil
+}
+
+// isDestructive returns true if the change removes a field or takes it over
+// from another field manager.
+func (c objectChange) isDestructive() bool {
+	return c.isRemoval() || len(c.managers) > 0
+}
+
+// notes describes why a change is added, removed, or destructive. Destructive
+// changes have a warning status.
+func (c objectChange) notes() *component.Text {
+	var notes []string
+	switch {
+	case c.from == nil:
+		notes = append(notes, "Added")
+	case c.isRemoval():
+		notes = append(notes, "Removed")
+	}
+
+	if len(c.managers) > 0 {
+		notes = append(notes, fmt.Sprintf("Managed by %s", strings.Join(c.managers, ", ")))
+	}
+
+	text := component.NewText(strings.Join(notes, ". "))
+	if c.isDestructive() {
+		text.SetStatus(component.TextStatusWarning)
+	}
+
+	return text
+}
+
+// objectChangesTable creates a table with a row for each change. before and
+// after name the columns with the values of a field before and after the change.
+func objectChangesTable(changes []objectChange, before, after string) *component.Table {
+	cols := []component.TableCol{
+		{Name: "Path", Accessor: "Path"},
+		{Name: before, Accessor: "Before"},
+		{Name: after, Accessor: "After"},
+		{Name: "Notes", Accessor: "Notes"},
+	}
+
+	table := component.NewTable("Changes", "There are no changes", cols)
+	for _, change := range changes {
+		table.Add(component.TableRow{
+			"Path":   component.NewText(change.path),
+			"Before": component.NewText(objectChangeValue(change.from)),
+			"After":  component.NewText(objectChangeValue(change.to)),
+			"Notes":  change.notes(),
+		})
+	}
+
+	return table
+}
+
+// diffObjects compares two objects field by field. Lists are compared as a
+// whole. Fields the API server sets on every write are ignored.
+func diffObjects(live, proposed *unstructured.Unstructured) []objectChange {
+	var changes []objectChange
+	diffFields(nil, live.Object, proposed.Object, &changes)
+
+	managedFields := live.GetManagedFields()
+	for i := range changes {
+		changes[i].managers = objectFieldManagers(managedFields, changes[i].fields)
+	}
+
+	return changes
+}
+
+func diffFields(path []string, from, to map[string]interface{}, changes *[]objectChange) {
+	keys := map[string]bool{}
+	for k := range from {
+		keys[k] = true
+	}
+	for k := range to {
+		keys[k] = true
+	}
+
+	var names []string
+	for k := range keys {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		fieldPath := append(append([]string{}, path...), name)
+		joined := strings.Join(fieldPath, ".")
+		if objectDiffIgnoredFields[joined] {
+			continue
+		}
+
+		fromValue, toValue := from[name], to[name]
+
+		fromMap, fromIsMap := fromValue.(map[string]interface{})
+		toMap, toIsMap := toValue.(map[string]interface{})
+		if fromIsMap && toIsMap {
+			diffFields(fieldPath, fromMap, toMap, changes)
+			continue
+		}
+
+		if reflect.DeepEqual(fromValue, toValue) {
+			continue
+		}
+
+		*changes = append(*changes, objectChange{
+			path:   joined,
+			fields: fieldPath,
+			from:   fromValue,
+			to:     toValue,
+		})
+	}
+}
+
+// objectFieldManagers returns the managers other than octant which own a
+// field according to the managed fields of an object.
+func objectFieldManagers(managedFields []metav1.ManagedFieldsEntry, path []string) []string {
+	var managers []string
+	for _, entry := range managedFields {
+		if entry.FieldsV1 == nil || entry.Manager == octantFieldManager {
+			continue
+		}
+
+		fields := map[string]interface{}{}
+		if err := json.Unmarshal(entry.FieldsV1.Raw, &fields); err != nil {
+			continue
+		}
+
+		owned := true
+		for _, name := range path {
+			next, ok := fields["f:"+name].(map[string]interface{})
+			if !ok {
+				owned = false
+				break
+			}
+			fields = next
+		}
+
+		if owned {
+			managers = append(managers, entry.Manager)
+		}
+	}
+
+	return managers
+}
+
+// objectChangeValue describes the value of a field which a change adds or
+// removes. It is empty when the field is not set.
+func objectChangeValue(value interface{}) string {
+	if value == nil {
+		return ""
+	}
+	return objectDiffValue(value)
+}
+
+func objectDiffValue(value interface{}) string {
+	var s string
+	switch v := value.(type) {
+	case string:
+		s = fmt.Sprintf("%q", v)
+	case map[string]interface{}, []interface{}:
+		data, err := json.Marshal(v)
+		if err != nil {
+			s = fmt.Sprintf("%v", v)
+		} else {
+			s = string(data)
+		}
+	default:
+		s = fmt.Sprintf("%v", v)
+	}
+
+	if len(s) > objectDiffValueLength {
+		s = s[:objectDiffValueLength] + "…"
+	}
+
+	return s
+}
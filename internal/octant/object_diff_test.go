@@ -0,0 +1,250 @@
+/*
+ *  Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+ *  SPDX-License-Identifier: Apache-2.0
+ *
+ */
+
+package octant
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	clusterFake "github.com/vmware-tanzu/octant/internal/cluster/fake"
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/internal/util/kubernetes"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	actionFake "github.com/vmware-tanzu/octant/pkg/action/fake"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	storeFake "github.com/vmware-tanzu/octant/pkg/store/fake"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func TestObjectDiffDispatcher_Handle(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	deployment := testutil.CreateDeployment("deployment")
+	deployment.Labels = map[string]string{"app": "web", "tier": "frontend"}
+	deployment.ManagedFields = []metav1.ManagedFieldsEntry{
+		{
+			Manager:  "kubectl",
+			FieldsV1: &metav1.FieldsV1{Raw: []byte(`{"f:spec":{"f:replicas":{}}}`)},
+		},
+		{
+			Manager:  octantFieldManager,
+			FieldsV1: &metav1.FieldsV1{Raw: []byte(`{"f:metadata":{"f:labels":{"f:tier":{}}}}`)},
+		},
+	}
+	live := testutil.ToUnstructured(t, deployment)
+	require.NoError(t, unstructured.SetNestedField(live.Object, int64(3), "spec", "replicas"))
+
+	edited := live.DeepCopy()
+	require.NoError(t, unstructured.SetNestedField(edited.Object, int64(1), "spec", "replicas"))
+	require.NoError(t, unstructured.SetNestedStringMap(edited.Object, map[string]string{"app": "web"}, "metadata", "labels"))
+	update, err := kubernetes.SerializeToString(edited)
+	require.NoError(t, err)
+
+	key, err := store.KeyFromObject(live)
+	require.NoError(t, err)
+
+	objectStore := storeFake.NewMockStore(controller)
+	objectStore.EXPECT().Get(gomock.Any(), key).Return(live, nil)
+
+	gvr := schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}
+	clusterClient := clusterFake.NewMockClientInterface(controller)
+	dynamicClient := clusterFake.NewMockDynamicInterface(controller)
+	resourceClient := clusterFake.NewMockNamespaceableResourceInterface(controller)
+	clusterClient.EXPECT().Resource(schema.GroupKind{Group: "apps", Kind: "Deployment"}).Return(gvr, true, nil)
+	clusterClient.EXPECT().DynamicClient().Return(dynamicClient, nil)
+	dynamicClient.EXPECT().Resource(gvr).Return(resourceClient)
+	resourceClient.EXPECT().Namespace(key.Namespace).Return(resourceClient)
+	resourceClient.EXPECT().
+		Update(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, object *unstructured.Unstructured, options metav1.UpdateOptions, _ ...string) (*unstructured.Unstructured, error) {
+			assert.Equal(t, []string{metav1.DryRunAll}, options.DryRun)
+			result := object.DeepCopy()
+			result.SetResourceVersion("2")
+			return result, nil
+		})
+
+	alerter := &modalAlerter{Alerter: actionFake.NewMockAlerter(controller)}
+
+	payload := action.Payload{
+		"action":     ActionDiffObject,
+		"namespace":  key.Namespace,
+		"apiVersion": key.APIVersion,
+		"kind":       key.Kind,
+		"name":       key.Name,
+		"update":     update,
+	}
+
+	dispatcher := NewObjectDiffDispatcher(objectStore, clusterClient)
+	require.NoError(t, dispatcher.Handle(context.Background(), alerter, payload))
+
+	require.Len(t, alerter.modals, 1)
+	modal := alerter.modals[0]
+	assert.True(t, modal.Config.Opened)
+
+	expected := component.NewTable("Changes", "There are no changes", []component.TableCol{
+		{Name: "Path", Accessor: "Path"},
+		{Name: "Live", Accessor: "Before"},
+		{Name: "Proposed", Accessor: "After"},
+		{Name: "Notes", Accessor: "Notes"},
+	})
+	removed := component.NewText("Removed")
+	removed.SetStatus(component.TextStatusWarning)
+	managed := component.NewText("Managed by kubectl")
+	managed.SetStatus(component.TextStatusWarning)
+	expected.Add(
+		component.TableRow{
+			"Path":   component.NewText("metadata.labels.tier"),
+			"Before": component.NewText(`"frontend"`),
+			"After":  component.NewText(""),
+			"Notes":  removed,
+		},
+		component.TableRow{
+			"Path":   component.NewText("spec.replicas"),
+			"Before": component.NewText("3"),
+			"After":  component.NewText("1"),
+			"Notes":  managed,
+		},
+	)
+	assert.Equal(t, expected, modal.Config.Body)
+
+	require.Len(t, modal.Config.Buttons, 1)
+	apply := modal.Config.Buttons[0]
+	assert.Equal(t, "Apply", apply.Name)
+	assert.Equal(t, ActionUpdateObject, apply.Payload["action"])
+	assert.Equal(t, update, apply.Payload["update"])
+	assert.Equal(t, ActionDiffObject, payload["action"])
+}
+
+func TestObjectDiffDispatcher_Handle_no_changes(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	live := testutil.ToUnstructured(t, testutil.CreateDeployment("deployment"))
+	update, err := kubernetes.SerializeToString(live)
+	require.NoError(t, err)
+
+	key, err := store.KeyFromObject(live)
+	require.NoError(t, err)
+
+	objectStore := storeFake.NewMockStore(controller)
+	objectStore.EXPECT().Get(gomock.Any(), key).Return(live, nil)
+
+	gvr := schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}
+	clusterClient := clusterFake.NewMockClientInterface(controller)
+	dynamicClient := clusterFake.NewMockDynamicInterface(controller)
+	resourceClient := clusterFake.NewMockNamespaceableResourceInterface(controller)
+	clusterClient.EXPECT().Resource(schema.GroupKind{Group: "apps", Kind: "Deployment"}).Return(gvr, true, nil)
+	clusterClient.EXPECT().DynamicClient().Return(dynamicClient, nil)
+	dynamicClient.EXPECT().Resource(gvr).Return(resourceClient)
+	resourceClient.EXPECT().Namespace(key.Namespace).Return(resourceClient)
+	resourceClient.EXPECT().
+		Update(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, object *unstructured.Unstructured, _ metav1.UpdateOptions, _ ...string) (*unstructured.Unstructured, error) {
+			return object.DeepCopy(), nil
+		})
+
+	mockAlerter := actionFake.NewMockAlerter(controller)
+	mockAlerter.EXPECT().
+		SendAlert(gomock.Any()).
+		Do(func(alert action.Alert) {
+			assert.Equal(t, action.AlertTypeInfo, alert.Type)
+			assert.Equal(t, "There are no changes to apply", alert.Message)
+		})
+	alerter := &modalAlerter{Alerter: mockAlerter}
+
+	payload := key.ToActionPayload()
+	payload["update"] = update
+
+	dispatcher := NewObjectDiffDispatcher(objectStore, clusterClient)
+	require.NoError(t, dispatcher.Handle(context.Background(), alerter, payload))
+
+	assert.Empty(t, alerter.modals)
+}
+
+func Test_diffObjects(t *testing.T) {
+	live := &unstructured.Unstructured{Object: map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"name":            "object",
+			"resourceVersion": "1",
+			"annotations": map[string]interface{}{
+				"app.kubernetes.io/name": "app",
+			},
+		},
+		"spec": map[string]interface{}{
+			"ports": []interface{}{int64(80)},
+		},
+		"status": map[string]interface{}{
+			"phase": "Running",
+		},
+	}}
+	live.SetManagedFields([]metav1.ManagedFieldsEntry{
+		{
+			Manager:  "helm",
+			FieldsV1: &metav1.FieldsV1{Raw: []byte(`{"f:metadata":{"f:annotations":{"f:app.kubernetes.io/name":{}}}}`)},
+		},
+	})
+
+	proposed := &unstructured.Unstructured{Object: map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"name":            "object",
+			"resourceVersion": "2",
+			"annotations": map[string]interface{}{
+				"app.kubernetes.io/name": "other",
+			},
+		},
+		"spec": map[string]interface{}{
+			"ports":    []interface{}{int64(80), int64(443)},
+			"selector": map[string]interface{}{"app": "app"},
+		},
+	}}
+
+	got := diffObjects(live, proposed)
+
+	expected := []objectChange{
+		{
+			path:     "metadata.annotations.app.kubernetes.io/name",
+			fields:   []string{"metadata", "annotations", "app.kubernetes.io/name"},
+			from:     "app",
+			to:       "other",
+			managers: []string{"helm"},
+		},
+		{
+			path:   "spec.ports",
+			fields: []string{"spec", "ports"},
+			from:   []interface{}{int64(80)},
+			to:     []interface{}{int64(80), int64(443)},
+		},
+		{
+			path:   "spec.selector",
+			fields: []string{"spec", "selector"},
+			to:     map[string]interface{}{"app": "app"},
+		},
+	}
+	assert.Equal(t, expected, got)
+	assert.True(t, got[0].isDestructive())
+	assert.False(t, got[1].isDestructive())
+}
+
+// modalAlerter is an alerter which records the modals it is sent.
+type modalAlerter struct {
+	action.Alerter
+	modals []*component.Modal
+}
+
+var _ ModalSender = (*modalAlerter)(nil)
+
+func (m *modalAlerter) SendModal(modal *component.Modal) {
+	m.modals = append(m.modals, modal)
+}
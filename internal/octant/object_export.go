@@ -0,0 +1,139 @@
+/*
+ * Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package octant
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/afero"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/internal/util/kubernetes"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+// lastAppliedConfigAnnotation is the annotation kubectl apply stores the applied object in.
+const lastAppliedConfigAnnotation = "kubectl.kubernetes.io/last-applied-configuration"
+
+// exportIgnoredFields are fields the API server generates. They are removed from
+// exported objects so the YAML can be applied again or committed to Git.
+var exportIgnoredFields = [][]string{
+	{"status"},
+	{"metadata", "managedFields"},
+	{"metadata", "creationTimestamp"},
+	{"metadata", "resourceVersion"},
+	{"metadata", "uid"},
+	{"metadata", "generation"},
+	{"metadata", "selfLink"},
+}
+
+// ObjectExporter saves the YAML of an object to a local directory.
+type ObjectExporter struct {
+	store store.Store
+	fs    afero.Fs
+}
+
+var _ action.Dispatcher = (*ObjectExporter)(nil)
+
+// NewObjectExporter creates an instance of ObjectExporter.
+func NewObjectExporter(objectStore store.Store, fs afero.Fs) *ObjectExporter {
+	return &ObjectExporter{
+		store: objectStore,
+		fs:    fs,
+	}
+}
+
+// ActionName returns the name of this action.
+func (e *ObjectExporter) ActionName() string {
+	return ActionExportObject
+}
+
+// Handle saves an object as YAML without server generated fields.
+func (e *ObjectExporter) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	logger := log.From(ctx).With("actionName", e.ActionName())
+	logger.With("payload", payload).Infof("received action payload")
+
+	key, err := store.KeyFromPayload(payload)
+	if err != nil {
+		return err
+	}
+
+	localDirectory, err := payload.OptionalString("localDirectory")
+	if err != nil {
+		return err
+	}
+	if strings.TrimSpace(localDirectory) == "" {
+		localDirectory = DefaultDownloadDirectory()
+	}
+
+	localPath := filepath.Join(localDirectory, exportFileName(key))
+
+	message := fmt.Sprintf("Exported %s %q to %s", key.Kind, key.Name, localPath)
+	alertType := action.AlertTypeInfo
+	if err := e.export(ctx, key, localPath); err != nil {
+		message = fmt.Sprintf("Unable to export %s %q: %s", key.Kind, key.Name, err)
+		alertType = action.AlertTypeWarning
+		logger.WithErr(err).Errorf("export object")
+	}
+
+	alerter.SendAlert(action.CreateAlert(alertType, message, action.DefaultAlertExpiration))
+	return nil
+}
+
+func (e *ObjectExporter) export(ctx context.Context, key store.Key, localPath string) error {
+	object, err := e.store.Get(ctx, key)
+	if err != nil {
+		return err
+	}
+	if object == nil {
+		return errors.Errorf("%s was not found", key)
+	}
+
+	data, err := kubernetes.SerializeToString(CleanExportObject(object))
+	if err != nil {
+		return err
+	}
+
+	if err := e.fs.MkdirAll(filepath.Dir(localPath), 0755); err != nil {
+		return errors.Wrap(err, "create export directory")
+	}
+
+	return afero.WriteFile(e.fs, localPath, []byte(data), 0644)
+}
+
+// CleanExportObject returns a copy of an object without the fields the API
+// server generates, or the annotation kubectl apply records.
+func CleanExportObject(object *unstructured.Unstructured) *unstructured.Unstructured {
+	cleaned := object.DeepCopy()
+	for _, fields := range exportIgnoredFields {
+		unstructured.RemoveNestedField(cleaned.Object, fields...)
+	}
+
+	annotations := cleaned.GetAnnotations()
+	delete(annotations, lastAppliedConfigAnnotation)
+	if len(annotations) == 0 {
+		unstructured.RemoveNestedField(cleaned.Object, "metadata", "annotations")
+	} else {
+		cleaned.SetAnnotations(annotations)
+	}
+
+	return cleaned
+}
+
+// exportFileName names the file an object is exported to, e.g. default-deployment-web.yaml.
+func exportFileName(key store.Key) string {
+	parts := []string{strings.ToLower(key.Kind), key.Name}
+	if key.Namespace != "" {
+		parts = append([]string{key.Namespace}, parts...)
+	}
+	return strings.Join(parts, "-") + ".yaml"
+}
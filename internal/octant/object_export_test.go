@@ -0,0 +1,92 @@
+/*
+ * Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package octant
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	actionFake "github.com/vmware-tanzu/octant/pkg/action/fake"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	storeFake "github.com/vmware-tanzu/octant/pkg/store/fake"
+)
+
+func TestObjectExporter_Handle(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	configMap := testutil.CreateConfigMap("config")
+	configMap.Namespace = "default"
+	configMap.UID = "uid"
+	configMap.ResourceVersion = "1"
+	configMap.Data = map[string]string{"key": "value"}
+	object := testutil.ToUnstructured(t, configMap)
+
+	key, err := store.KeyFromObject(object)
+	require.NoError(t, err)
+
+	objectStore := storeFake.NewMockStore(controller)
+	objectStore.EXPECT().Get(gomock.Any(), key).Return(object, nil)
+
+	alerter := actionFake.NewMockAlerter(controller)
+	localPath := filepath.Join("/exports", "default-configmap-config.yaml")
+	alerter.EXPECT().
+		SendAlert(gomock.Any()).
+		Do(func(alert action.Alert) {
+			assert.Equal(t, action.AlertTypeInfo, alert.Type)
+			assert.Equal(t, `Exported ConfigMap "config" to `+localPath, alert.Message)
+		})
+
+	fs := afero.NewMemMapFs()
+	payload := key.ToActionPayload()
+	payload["localDirectory"] = "/exports"
+
+	exporter := NewObjectExporter(objectStore, fs)
+	require.NoError(t, exporter.Handle(context.Background(), alerter, payload))
+
+	data, err := afero.ReadFile(fs, localPath)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "name: config")
+	assert.Contains(t, string(data), "key: value")
+	assert.NotContains(t, string(data), "uid:")
+	assert.NotContains(t, string(data), "resourceVersion:")
+}
+
+func TestCleanExportObject(t *testing.T) {
+	deployment := testutil.CreateDeployment("deployment")
+	deployment.UID = "uid"
+	deployment.ResourceVersion = "1"
+	deployment.Generation = 2
+	deployment.CreationTimestamp = metav1.Now()
+	deployment.ManagedFields = []metav1.ManagedFieldsEntry{{Manager: "kubectl"}}
+	deployment.Annotations = map[string]string{lastAppliedConfigAnnotation: "{}"}
+	deployment.Status.Replicas = 1
+	object := testutil.ToUnstructured(t, deployment)
+
+	got := CleanExportObject(object)
+
+	metadata, _, err := unstructured.NestedMap(got.Object, "metadata")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"name":      "deployment",
+		"namespace": "namespace",
+	}, metadata)
+
+	_, found := got.Object["status"]
+	assert.False(t, found)
+
+	assert.Equal(t, "uid", string(object.GetUID()), "the object should not be modified")
+}
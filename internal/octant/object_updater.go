@@ -82,22 +82,7 @@ func (o ObjectUpdaterDispatcher) Handle(ctx context.Context, alerter action.Aler
 
 	key, _ := store.KeyFromPayload(payload)
 	err = o.store.Update(ctx, key, func(u *unstructured.Unstructured) error {
-		if object.GetAPIVersion() != u.GetAPIVersion() {
-			return fmt.Errorf("object API version cannot be updated")
-		}
-		if object.GetKind() != u.GetKind() {
-			return fmt.Errorf("object kind cannot be updated")
-		}
-		if object.GetName() != u.GetName() {
-			return fmt.Errorf("object name cannot be updated")
-		}
-
-		delete(object.Object, "status")
-
-		for k := range object.Object {
-			u.Object[k] = object.Object[k]
-		}
-		return nil
+		return mergeObjectUpdate(object, u)
 	})
 
 	if err != nil {
@@ -120,3 +105,24 @@ func (o ObjectUpdaterDispatcher) Handle(ctx context.Context, alerter action.Aler
 
 	return nil
 }
+
+// mergeObjectUpdate copies the top level fields of an edited object other
+// than status to the live object.
+func mergeObjectUpdate(object, u *unstructured.Unstructured) error {
+	if object.GetAPIVersion() != u.GetAPIVersion() {
+		return fmt.Errorf("object API version cannot be updated")
+	}
+	if object.GetKind() != u.GetKind() {
+		return fmt.Errorf("object kind cannot be updated")
+	}
+	if object.GetName() != u.GetName() {
+		return fmt.Errorf("object name cannot be updated")
+	}
+
+	delete(object.Object, "status")
+
+	for k := range object.Object {
+		u.Object[k] = object.Object[k]
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+/*
+ * Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package octant
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/vmware-tanzu/octant/internal/kubectl"
+	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+// PersistentVolumeClaimResizer requests a new size for a persistent volume claim.
+type PersistentVolumeClaimResizer struct {
+	store store.Store
+}
+
+var _ action.Dispatcher = (*PersistentVolumeClaimResizer)(nil)
+
+// NewPersistentVolumeClaimResizer creates an instance of PersistentVolumeClaimResizer.
+func NewPersistentVolumeClaimResizer(objectStore store.Store) *PersistentVolumeClaimResizer {
+	return &PersistentVolumeClaimResizer{
+		store: objectStore,
+	}
+}
+
+// ActionName returns the name of this action.
+func (r *PersistentVolumeClaimResizer) ActionName() string {
+	return ActionResizePersistentVolumeClaim
+}
+
+// Handle sets spec.resources.requests.storage of a persistent volume claim. Volumes
+// can only be expanded, so sizes smaller than the current request are rejected.
+func (r *PersistentVolumeClaimResizer) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	logger := log.From(ctx).With("actionName", r.ActionName())
+	logger.With("payload", payload).Infof("received action payload")
+
+	key, err := store.KeyFromPayload(payload)
+	if err != nil {
+		return err
+	}
+
+	storage, err := payload.String("storage")
+	if err != nil {
+		return err
+	}
+
+	size, err := resource.ParseQuantity(storage)
+	if err != nil {
+		alerter.SendAlert(action.CreateAlert(action.AlertTypeWarning,
+			fmt.Sprintf("Unable to resize PersistentVolumeClaim %q: invalid size %q", key.Name, storage),
+			action.DefaultAlertExpiration))
+		return nil
+	}
+
+	fn := func(object *unstructured.Unstructured) error {
+		current, _, err := unstructured.NestedString(object.Object, "spec", "resources", "requests", "storage")
+		if err != nil {
+			return err
+		}
+
+		if current != "" {
+			currentSize, err := resource.ParseQuantity(current)
+			if err == nil && size.Cmp(currentSize) < 0 {
+				return fmt.Errorf("size %s is smaller than the current request of %s", size.String(), current)
+			}
+		}
+
+		return unstructured.SetNestedField(object.Object, size.String(), "spec", "resources", "requests", "storage")
+	}
+
+	alertType := action.AlertTypeInfo
+	message := kubectl.Describe(
+		fmt.Sprintf("Requested %s for PersistentVolumeClaim %q", size.String(), key.Name),
+		kubectl.ResizePersistentVolumeClaim(key.Namespace, key.Name, size.String()))
+	if err := r.store.Update(ctx, key, fn); err != nil {
+		logger.WithErr(err).Errorf("resize persistent volume claim")
+		alertType = action.AlertTypeWarning
+		message = fmt.Sprintf("Unable to resize PersistentVolumeClaim %q: %s", key.Name, err)
+	}
+	alerter.SendAlert(action.CreateAlert(alertType, message, action.DefaultAlertExpiration))
+
+	return nil
+}
@@ -0,0 +1,94 @@
+/*
+ * Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package octant
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	actionFake "github.com/vmware-tanzu/octant/pkg/action/fake"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/store/fake"
+)
+
+func TestPersistentVolumeClaimResizer_Handle(t *testing.T) {
+	tests := []struct {
+		name            string
+		storage         string
+		expectUpdate    bool
+		expectedStorage string
+		expectedType    action.AlertType
+		expectedMessage string
+	}{
+		{
+			name:            "expand",
+			storage:         "20Gi",
+			expectUpdate:    true,
+			expectedStorage: "20Gi",
+			expectedType:    action.AlertTypeInfo,
+			expectedMessage: `Requested 20Gi for PersistentVolumeClaim "pvc" (kubectl equivalent: kubectl patch pvc pvc --patch '{"spec":{"resources":{"requests":{"storage":"20Gi"}}}}' --namespace namespace)`,
+		},
+		{
+			name:            "shrink",
+			storage:         "1Gi",
+			expectUpdate:    true,
+			expectedStorage: "3Gi",
+			expectedType:    action.AlertTypeWarning,
+			expectedMessage: `Unable to resize PersistentVolumeClaim "pvc": size 1Gi is smaller than the current request of 3Gi`,
+		},
+		{
+			name:            "invalid size",
+			storage:         "lots",
+			expectedType:    action.AlertTypeWarning,
+			expectedMessage: `Unable to resize PersistentVolumeClaim "pvc": invalid size "lots"`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			pvc := testutil.ToUnstructured(t, testutil.CreatePersistentVolumeClaim("pvc"))
+			key, err := store.KeyFromObject(pvc)
+			require.NoError(t, err)
+
+			objectStore := fake.NewMockStore(controller)
+			if test.expectUpdate {
+				objectStore.EXPECT().
+					Update(gomock.Any(), key, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, key store.Key, fn func(object *unstructured.Unstructured) error) error {
+						err := fn(pvc)
+						storage, _, _ := unstructured.NestedString(pvc.Object, "spec", "resources", "requests", "storage")
+						assert.Equal(t, test.expectedStorage, storage)
+						return err
+					})
+			}
+
+			alerter := actionFake.NewMockAlerter(controller)
+			alerter.EXPECT().
+				SendAlert(gomock.Any()).
+				Do(func(alert action.Alert) {
+					assert.Equal(t, test.expectedType, alert.Type)
+					assert.Equal(t, test.expectedMessage, alert.Message)
+				})
+
+			payload := key.ToActionPayload()
+			payload["storage"] = test.storage
+
+			resizer := NewPersistentVolumeClaimResizer(objectStore)
+			assert.Equal(t, ActionResizePersistentVolumeClaim, resizer.ActionName())
+			require.NoError(t, resizer.Handle(context.Background(), alerter, payload))
+		})
+	}
+}
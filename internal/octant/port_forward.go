@@ -7,10 +7,12 @@ package octant
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
+	"github.com/vmware-tanzu/octant/internal/kubectl"
 	"github.com/vmware-tanzu/octant/internal/portforward"
 	"github.com/vmware-tanzu/octant/pkg/action"
 	"github.com/vmware-tanzu/octant/pkg/log"
@@ -49,10 +51,18 @@ func (p *PortForward) Handle(ctx context.Context, alerter action.Alerter, payloa
 	}
 	p.logger.Debugf("%s", request)
 
-	_, err = p.portForwarder.Create(ctx, alerter, request.gvk(), request.Name, request.Namespace, request.Port)
+	resp, err := p.portForwarder.Create(ctx, alerter, request.gvk(), request.Name, request.Namespace, request.Port)
 	if err != nil {
 		return errors.Wrap(err, "create port forwarder")
 	}
+
+	for _, port := range resp.Ports {
+		message := kubectl.Describe(
+			fmt.Sprintf("Forwarding localhost:%d to %s %q port %d", port.Local, request.Kind, request.Name, port.Remote),
+			kubectl.PortForward(request.gvk(), request.Namespace, request.Name, port.Local, port.Remote))
+		alerter.SendAlert(action.CreateAlert(action.AlertTypeInfo, message, action.DefaultAlertExpiration))
+	}
+
 	return nil
 }
 
@@ -76,7 +86,7 @@ func NewPortForwardDelete(logger log.Logger, objectStore store.Store, portForwar
 
 // ActionName returns the name of this action
 func (p *PortForwardDelete) ActionName() string {
-	return "overview/stopPortForward"
+	return ActionStopPortForward
 }
 
 // Handle stops a port forward
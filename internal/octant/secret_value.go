@@ -0,0 +1,111 @@
+/*
+ * Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package octant
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"unicode/utf8"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vmware-tanzu/octant/internal/cluster"
+	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/pkg/action"
+)
+
+// SecretValueRevealer is an action which shows the value of a single key in a
+// secret to the user who asked for it. Secret values aren't part of generated
+// content, so they are only read when revealed.
+type SecretValueRevealer struct {
+	clusterClient cluster.ClientInterface
+}
+
+var _ action.Dispatcher = (*SecretValueRevealer)(nil)
+
+// NewSecretValueRevealer creates an instance of SecretValueRevealer.
+func NewSecretValueRevealer(clusterClient cluster.ClientInterface) *SecretValueRevealer {
+	return &SecretValueRevealer{
+		clusterClient: clusterClient,
+	}
+}
+
+// ActionName returns the name of this action.
+func (s *SecretValueRevealer) ActionName() string {
+	return ActionRevealSecretValue
+}
+
+// Handle reveals a secret value. The user must be able to get the secret, which is
+// checked before it is read from the cluster.
+func (s *SecretValueRevealer) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	logger := log.From(ctx).With("actionName", s.ActionName())
+
+	namespace, err := payload.String("namespace")
+	if err != nil {
+		return err
+	}
+	name, err := payload.String("name")
+	if err != nil {
+		return err
+	}
+	key, err := payload.String("key")
+	if err != nil {
+		return err
+	}
+
+	message, err := s.reveal(ctx, namespace, name, key)
+	if err != nil {
+		logger.WithErr(err).Errorf("reveal secret value")
+		sendDefaultAlert(alerter, action.AlertTypeWarning, fmt.Sprintf("Unable to reveal %q in secret %q: %s", key, name, err))
+		return nil
+	}
+
+	sendDefaultAlert(alerter, action.AlertTypeInfo, message)
+	return nil
+}
+
+func (s *SecretValueRevealer) reveal(ctx context.Context, namespace, name, key string) (string, error) {
+	client, err := s.clusterClient.KubernetesClient()
+	if err != nil {
+		return "", err
+	}
+
+	review := &authorizationv1.SelfSubjectAccessReview{
+		Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Namespace: namespace,
+				Resource:  "secrets",
+				Name:      name,
+				Verb:      "get",
+			},
+		},
+	}
+	review, err = client.AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, review, metav1.CreateOptions{})
+	if err != nil {
+		return "", fmt.Errorf("check access: %w", err)
+	}
+	if !review.Status.Allowed {
+		return "", fmt.Errorf("you aren't allowed to get this secret")
+	}
+
+	secret, err := client.CoreV1().Secrets(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+
+	value, ok := secret.Data[key]
+	if !ok {
+		return "", fmt.Errorf("the secret has no key %q", key)
+	}
+
+	if !utf8.Valid(value) || bytes.IndexByte(value, 0) >= 0 {
+		return fmt.Sprintf("%s is binary (%d bytes)", key, len(value)), nil
+	}
+
+	return fmt.Sprintf("%s: %s", key, value), nil
+}
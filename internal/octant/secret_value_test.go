@@ -0,0 +1,112 @@
+/*
+ * Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package octant_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	testClient "k8s.io/client-go/kubernetes/fake"
+	ktesting "k8s.io/client-go/testing"
+
+	clusterFake "github.com/vmware-tanzu/octant/internal/cluster/fake"
+	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	actionFake "github.com/vmware-tanzu/octant/pkg/action/fake"
+)
+
+func TestSecretValueRevealer(t *testing.T) {
+	tests := []struct {
+		name      string
+		allowed   bool
+		key       string
+		alertType action.AlertType
+		message   string
+	}{
+		{
+			name:      "text value",
+			allowed:   true,
+			key:       "password",
+			alertType: action.AlertTypeInfo,
+			message:   "password: secret",
+		},
+		{
+			name:      "binary value",
+			allowed:   true,
+			key:       "keystore",
+			alertType: action.AlertTypeInfo,
+			message:   "keystore is binary (4 bytes)",
+		},
+		{
+			name:      "missing key",
+			allowed:   true,
+			key:       "token",
+			alertType: action.AlertTypeWarning,
+			message:   `Unable to reveal "token" in secret "db": the secret has no key "token"`,
+		},
+		{
+			name:      "not allowed",
+			key:       "password",
+			alertType: action.AlertTypeWarning,
+			message:   `Unable to reveal "password" in secret "db": you aren't allowed to get this secret`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			clientset := testClient.NewSimpleClientset(&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "db"},
+				Data: map[string][]byte{
+					"password": []byte("secret"),
+					"keystore": {0, 1, 2, 3},
+				},
+			})
+
+			var reviewed *authorizationv1.ResourceAttributes
+			clientset.PrependReactor("create", "selfsubjectaccessreviews", func(a ktesting.Action) (bool, runtime.Object, error) {
+				review := a.(ktesting.CreateAction).GetObject().(*authorizationv1.SelfSubjectAccessReview)
+				reviewed = review.Spec.ResourceAttributes
+				review.Status.Allowed = test.allowed
+				return true, review, nil
+			})
+
+			clusterClient := clusterFake.NewMockClientInterface(controller)
+			clusterClient.EXPECT().KubernetesClient().Return(clientset, nil)
+
+			alerter := actionFake.NewMockAlerter(controller)
+			alerter.EXPECT().
+				SendAlert(gomock.Any()).
+				Do(func(alert action.Alert) {
+					assert.Equal(t, test.alertType, alert.Type)
+					assert.Equal(t, test.message, alert.Message)
+				})
+
+			r := octant.NewSecretValueRevealer(clusterClient)
+			assert.Equal(t, octant.ActionRevealSecretValue, r.ActionName())
+
+			payload := action.Payload{"namespace": "default", "name": "db", "key": test.key}
+			require.NoError(t, r.Handle(context.Background(), alerter, payload))
+
+			require.NotNil(t, reviewed)
+			assert.Equal(t, authorizationv1.ResourceAttributes{
+				Namespace: "default",
+				Resource:  "secrets",
+				Name:      "db",
+				Verb:      "get",
+			}, *reviewed)
+		})
+	}
+}
@@ -0,0 +1,305 @@
+/*
+ * Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package octant
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/vmware-tanzu/octant/internal/cluster"
+	"github.com/vmware-tanzu/octant/internal/kubectl"
+	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+// ServiceTypes are the service types which can be set by ServicePortEditor.
+var ServiceTypes = []corev1.ServiceType{
+	corev1.ServiceTypeClusterIP,
+	corev1.ServiceTypeNodePort,
+	corev1.ServiceTypeLoadBalancer,
+}
+
+// ServicePortEditor edits the type and ports of a service. Edits are validated
+// with a server-side dry run before the service is updated.
+type ServicePortEditor struct {
+	store         store.Store
+	clusterClient cluster.ClientInterface
+}
+
+var _ action.Dispatcher = (*ServicePortEditor)(nil)
+
+// NewServicePortEditor creates an instance of ServicePortEditor.
+func NewServicePortEditor(objectStore store.Store, clusterClient cluster.ClientInterface) *ServicePortEditor {
+	return &ServicePortEditor{
+		store:         objectStore,
+		clusterClient: clusterClient,
+	}
+}
+
+// ActionName returns the name of this action.
+func (s *ServicePortEditor) ActionName() string {
+	return ActionServicePortEditor
+}
+
+// Handle sets the type and ports of a service. Ports are given one per line in
+// the format described by ParseServicePorts.
+func (s *ServicePortEditor) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	logger := log.From(ctx).With("actionName", s.ActionName())
+	logger.With("payload", payload).Debugf("received action payload")
+
+	key, err := store.KeyFromPayload(payload)
+	if err != nil {
+		return err
+	}
+
+	edit, err := servicePortEditFromPayload(payload)
+	if err != nil {
+		sendDefaultAlert(alerter, action.AlertTypeWarning,
+			fmt.Sprintf("Unable to update Service %q: %s", key.Name, err))
+		return nil
+	}
+
+	object, err := s.store.Get(ctx, key)
+	if err != nil {
+		return err
+	}
+	if object == nil {
+		return errors.Errorf("service %q not found", key.Name)
+	}
+
+	proposed := object.DeepCopy()
+	if err := edit.apply(proposed); err != nil {
+		return err
+	}
+
+	if err := s.dryRun(ctx, proposed); err != nil {
+		logger.WithErr(err).Errorf("dry run service update")
+		sendDefaultAlert(alerter, action.AlertTypeWarning,
+			fmt.Sprintf("Unable to update Service %q: %s", key.Name, err))
+		return nil
+	}
+
+	fn := func(object *unstructured.Unstructured) error {
+		return edit.apply(object)
+	}
+
+	alertType := action.AlertTypeInfo
+	message := kubectl.Describe(fmt.Sprintf("Updated Service %q", key.Name), kubectl.Patch(key, edit.patch()))
+	if err := s.store.Update(ctx, key, fn); err != nil {
+		alertType = action.AlertTypeWarning
+		message = fmt.Sprintf("Unable to update Service %q: %s", key.Name, err)
+	}
+	sendDefaultAlert(alerter, alertType, message)
+
+	return nil
+}
+
+// dryRun validates an updated service with the API server.
+func (s *ServicePortEditor) dryRun(ctx context.Context, service *unstructured.Unstructured) error {
+	client, err := s.clusterClient.DynamicClient()
+	if err != nil {
+		return errors.Wrap(err, "get dynamic client")
+	}
+
+	gvr := corev1.SchemeGroupVersion.WithResource("services")
+	updateOptions := metav1.UpdateOptions{DryRun: []string{metav1.DryRunAll}}
+	if _, err := client.Resource(gvr).Namespace(service.GetNamespace()).Update(ctx, service, updateOptions); err != nil {
+		return errors.Wrap(err, "dry run update")
+	}
+
+	return nil
+}
+
+// servicePortEdit is an edit of the type and ports of a service.
+type servicePortEdit struct {
+	serviceType corev1.ServiceType
+	ports       []corev1.ServicePort
+}
+
+// apply applies the edit to a service. Only the type and ports are set, so
+// fields octant doesn't know about are kept. Node ports are kept for ports
+// which are unchanged, and node port settings are removed from ClusterIP
+// services.
+func (e servicePortEdit) apply(service *unstructured.Unstructured) error {
+	current, _, err := unstructured.NestedSlice(service.Object, "spec", "ports")
+	if err != nil {
+		return errors.Wrap(err, "get service ports")
+	}
+
+	nodePorts := make(map[string]int64)
+	for _, item := range current {
+		port, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		number, _, _ := unstructured.NestedInt64(port, "port")
+		protocol, _, _ := unstructured.NestedString(port, "protocol")
+		nodePort, _, _ := unstructured.NestedInt64(port, "nodePort")
+		nodePorts[servicePortID(number, protocol)] = nodePort
+	}
+
+	ports := make([]interface{}, len(e.ports))
+	for i, port := range e.ports {
+		m := map[string]interface{}{
+			"port":     int64(port.Port),
+			"protocol": string(port.Protocol),
+		}
+		if port.Name != "" {
+			m["name"] = port.Name
+		}
+		if port.TargetPort.Type == intstr.String {
+			m["targetPort"] = port.TargetPort.StrVal
+		} else {
+			m["targetPort"] = int64(port.TargetPort.IntVal)
+		}
+		nodePort := nodePorts[servicePortID(int64(port.Port), string(port.Protocol))]
+		if e.serviceType != corev1.ServiceTypeClusterIP && nodePort != 0 {
+			m["nodePort"] = nodePort
+		}
+		ports[i] = m
+	}
+
+	if err := unstructured.SetNestedField(service.Object, string(e.serviceType), "spec", "type"); err != nil {
+		return errors.Wrap(err, "set service type")
+	}
+	if err := unstructured.SetNestedField(service.Object, ports, "spec", "ports"); err != nil {
+		return errors.Wrap(err, "set service ports")
+	}
+
+	if e.serviceType == corev1.ServiceTypeClusterIP {
+		unstructured.RemoveNestedField(service.Object, "spec", "externalTrafficPolicy")
+		unstructured.RemoveNestedField(service.Object, "spec", "healthCheckNodePort")
+	}
+
+	return nil
+}
+
+// patch returns the merge patch kubectl would use to make the edit.
+func (e servicePortEdit) patch() string {
+	data, _ := json.Marshal(map[string]interface{}{
+		"spec": map[string]interface{}{
+			"type":  e.serviceType,
+			"ports": e.ports,
+		},
+	})
+	return string(data)
+}
+
+func servicePortEditFromPayload(payload action.Payload) (servicePortEdit, error) {
+	serviceType, err := payload.String("type")
+	if err != nil {
+		return servicePortEdit{}, errors.New("service type is required")
+	}
+
+	edit := servicePortEdit{serviceType: corev1.ServiceType(serviceType)}
+
+	isValidType := false
+	for _, t := range ServiceTypes {
+		isValidType = isValidType || t == edit.serviceType
+	}
+	if !isValidType {
+		return servicePortEdit{}, errors.Errorf("unsupported service type %q", serviceType)
+	}
+
+	ports, err := payload.String("ports")
+	if err != nil {
+		return servicePortEdit{}, errors.New("ports are required")
+	}
+
+	edit.ports, err = ParseServicePorts(ports)
+	if err != nil {
+		return servicePortEdit{}, err
+	}
+	if len(edit.ports) == 0 {
+		return servicePortEdit{}, errors.New("at least one port is required")
+	}
+
+	return edit, nil
+}
+
+// ParseServicePorts parses service ports given one per line in the format
+// [name=]port[:targetPort][/protocol]. The target port may be a name, and like
+// the API server, it defaults to the port. The protocol defaults to TCP. Blank
+// lines are ignored.
+func ParseServicePorts(text string) ([]corev1.ServicePort, error) {
+	var ports []corev1.ServicePort
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		port := corev1.ServicePort{Protocol: corev1.ProtocolTCP}
+
+		spec := line
+		if parts := strings.SplitN(spec, "=", 2); len(parts) == 2 {
+			port.Name = parts[0]
+			spec = parts[1]
+		}
+
+		if parts := strings.SplitN(spec, "/", 2); len(parts) == 2 {
+			port.Protocol = corev1.Protocol(strings.ToUpper(parts[1]))
+			spec = parts[0]
+		}
+
+		parts := strings.SplitN(spec, ":", 2)
+		number, err := strconv.ParseInt(parts[0], 10, 32)
+		if err != nil || number < 1 || number > 65535 {
+			return nil, errors.Errorf("invalid port %q in %q", parts[0], line)
+		}
+		port.Port = int32(number)
+		port.TargetPort = intstr.FromInt(int(number))
+
+		if len(parts) == 2 {
+			port.TargetPort = intstr.Parse(parts[1])
+			if port.TargetPort.Type == intstr.String && port.TargetPort.StrVal == "" {
+				return nil, errors.Errorf("invalid target port in %q", line)
+			}
+		}
+
+		ports = append(ports, port)
+	}
+
+	return ports, nil
+}
+
+// FormatServicePorts formats service ports in the format parsed by ParseServicePorts.
+func FormatServicePorts(ports []corev1.ServicePort) string {
+	lines := make([]string, len(ports))
+	for i, port := range ports {
+		line := fmt.Sprintf("%d", port.Port)
+		if port.Name != "" {
+			line = fmt.Sprintf("%s=%s", port.Name, line)
+		}
+		if targetPort := port.TargetPort.String(); targetPort != "0" && targetPort != fmt.Sprintf("%d", port.Port) {
+			line = fmt.Sprintf("%s:%s", line, targetPort)
+		}
+		if port.Protocol != "" {
+			line = fmt.Sprintf("%s/%s", line, port.Protocol)
+		}
+		lines[i] = line
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// servicePortID identifies a service port by its number and protocol.
+func servicePortID(port int64, protocol string) string {
+	if protocol == "" {
+		protocol = string(corev1.ProtocolTCP)
+	}
+	return fmt.Sprintf("%d/%s", port, protocol)
+}
@@ -0,0 +1,238 @@
+/*
+ * Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package octant
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	clusterFake "github.com/vmware-tanzu/octant/internal/cluster/fake"
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/internal/util/kubernetes"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	actionFake "github.com/vmware-tanzu/octant/pkg/action/fake"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	storeFake "github.com/vmware-tanzu/octant/pkg/store/fake"
+)
+
+func TestServicePortEditor_Handle(t *testing.T) {
+	tests := []struct {
+		name              string
+		payload           action.Payload
+		dryRunErr         error
+		expectedUpdate    bool
+		expectedService   func(service *corev1.Service)
+		expectedAlertType action.AlertType
+		expectedMessage   string
+	}{
+		{
+			name: "change type and ports",
+			payload: action.Payload{
+				"type":  "ClusterIP",
+				"ports": "http=80:8080\nmetrics=9090:metrics",
+			},
+			expectedUpdate: true,
+			expectedService: func(service *corev1.Service) {
+				service.Spec.Type = corev1.ServiceTypeClusterIP
+				service.Spec.ExternalTrafficPolicy = ""
+				service.Spec.Ports = []corev1.ServicePort{
+					{Name: "http", Port: 80, TargetPort: intstr.FromInt(8080), Protocol: corev1.ProtocolTCP},
+					{Name: "metrics", Port: 9090, TargetPort: intstr.FromString("metrics"), Protocol: corev1.ProtocolTCP},
+				}
+			},
+			expectedAlertType: action.AlertTypeInfo,
+			expectedMessage:   `Updated Service "service" (kubectl equivalent: kubectl patch service/service --type merge --patch '{"spec":{"ports":[{"name":"http","protocol":"TCP","port":80,"targetPort":8080},{"name":"metrics","protocol":"TCP","port":9090,"targetPort":"metrics"}],"type":"ClusterIP"}}' --namespace namespace)`,
+		},
+		{
+			name: "keep node ports of unchanged ports",
+			payload: action.Payload{
+				"type":  "NodePort",
+				"ports": "http=80:8080/TCP\nudp=53/UDP",
+			},
+			expectedUpdate: true,
+			expectedService: func(service *corev1.Service) {
+				service.Spec.Ports = []corev1.ServicePort{
+					{Name: "http", Port: 80, TargetPort: intstr.FromInt(8080), Protocol: corev1.ProtocolTCP, NodePort: 30080},
+					{Name: "udp", Port: 53, TargetPort: intstr.FromInt(53), Protocol: corev1.ProtocolUDP},
+				}
+			},
+			expectedAlertType: action.AlertTypeInfo,
+			expectedMessage:   `Updated Service "service" (kubectl equivalent: kubectl patch service/service --type merge --patch '{"spec":{"ports":[{"name":"http","protocol":"TCP","port":80,"targetPort":8080},{"name":"udp","protocol":"UDP","port":53,"targetPort":53}],"type":"NodePort"}}' --namespace namespace)`,
+		},
+		{
+			name: "dry run fails",
+			payload: action.Payload{
+				"type":  "LoadBalancer",
+				"ports": "80",
+			},
+			dryRunErr:         kerrors.NewBadRequest("load balancers are not allowed"),
+			expectedAlertType: action.AlertTypeWarning,
+			expectedMessage:   `Unable to update Service "service": dry run update: load balancers are not allowed`,
+		},
+		{
+			name: "unsupported type",
+			payload: action.Payload{
+				"type":  "ExternalName",
+				"ports": "80",
+			},
+			expectedAlertType: action.AlertTypeWarning,
+			expectedMessage:   `Unable to update Service "service": unsupported service type "ExternalName"`,
+		},
+		{
+			name: "no ports",
+			payload: action.Payload{
+				"type":  "ClusterIP",
+				"ports": "\n",
+			},
+			expectedAlertType: action.AlertTypeWarning,
+			expectedMessage:   `Unable to update Service "service": at least one port is required`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			service := testutil.CreateService("service")
+			service.Spec.Type = corev1.ServiceTypeNodePort
+			service.Spec.ExternalTrafficPolicy = corev1.ServiceExternalTrafficPolicyTypeLocal
+			service.Spec.Ports = []corev1.ServicePort{
+				{Name: "http", Port: 80, TargetPort: intstr.FromInt(8080), Protocol: corev1.ProtocolTCP, NodePort: 30080},
+			}
+			object := testutil.ToUnstructured(t, service)
+			// Fields octant doesn't know about are kept.
+			require.NoError(t, unstructured.SetNestedField(object.Object, "SingleStack", "spec", "ipFamilyPolicy"))
+
+			key, err := store.KeyFromObject(object)
+			require.NoError(t, err)
+
+			objectStore := storeFake.NewMockStore(controller)
+			objectStore.EXPECT().Get(gomock.Any(), key).Return(object, nil).AnyTimes()
+			if test.expectedUpdate {
+				objectStore.EXPECT().
+					Update(gomock.Any(), key, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, key store.Key, fn func(object *unstructured.Unstructured) error) error {
+						return fn(object)
+					})
+			}
+
+			gvr := schema.GroupVersionResource{Version: "v1", Resource: "services"}
+			dynamicClient := clusterFake.NewMockDynamicInterface(controller)
+			resourceClient := clusterFake.NewMockNamespaceableResourceInterface(controller)
+			dynamicClient.EXPECT().Resource(gvr).Return(resourceClient).AnyTimes()
+			resourceClient.EXPECT().Namespace(key.Namespace).Return(resourceClient).AnyTimes()
+			resourceClient.EXPECT().
+				Update(gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, object *unstructured.Unstructured, options metav1.UpdateOptions, _ ...string) (*unstructured.Unstructured, error) {
+					assert.Equal(t, []string{metav1.DryRunAll}, options.DryRun)
+					return object, test.dryRunErr
+				}).
+				AnyTimes()
+
+			clusterClient := clusterFake.NewMockClientInterface(controller)
+			clusterClient.EXPECT().DynamicClient().Return(dynamicClient, nil).AnyTimes()
+
+			alerter := actionFake.NewMockAlerter(controller)
+			alerter.EXPECT().
+				SendAlert(gomock.Any()).
+				Do(func(alert action.Alert) {
+					assert.Equal(t, test.expectedAlertType, alert.Type)
+					assert.Equal(t, test.expectedMessage, alert.Message)
+				})
+
+			payload := key.ToActionPayload()
+			for k, v := range test.payload {
+				payload[k] = v
+			}
+
+			editor := NewServicePortEditor(objectStore, clusterClient)
+			assert.Equal(t, ActionServicePortEditor, editor.ActionName())
+			require.NoError(t, editor.Handle(context.Background(), alerter, payload))
+
+			if !test.expectedUpdate {
+				return
+			}
+
+			expected := service.DeepCopy()
+			test.expectedService(expected)
+
+			got := &corev1.Service{}
+			require.NoError(t, kubernetes.FromUnstructured(object, got))
+			assert.Equal(t, expected.Spec, got.Spec)
+
+			ipFamilyPolicy, _, err := unstructured.NestedString(object.Object, "spec", "ipFamilyPolicy")
+			require.NoError(t, err)
+			assert.Equal(t, "SingleStack", ipFamilyPolicy)
+		})
+	}
+}
+
+func TestParseServicePorts(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		expected []corev1.ServicePort
+		isErr    bool
+	}{
+		{
+			name: "port",
+			text: "80",
+			expected: []corev1.ServicePort{
+				{Port: 80, TargetPort: intstr.FromInt(80), Protocol: corev1.ProtocolTCP},
+			},
+		},
+		{
+			name: "name, target port, and protocol",
+			text: " dns=53:dns/udp \n\nhttp=80:8080",
+			expected: []corev1.ServicePort{
+				{Name: "dns", Port: 53, TargetPort: intstr.FromString("dns"), Protocol: corev1.ProtocolUDP},
+				{Name: "http", Port: 80, TargetPort: intstr.FromInt(8080), Protocol: corev1.ProtocolTCP},
+			},
+		},
+		{
+			name:  "invalid port",
+			text:  "http=eighty",
+			isErr: true,
+		},
+		{
+			name:  "port out of range",
+			text:  "70000",
+			isErr: true,
+		},
+		{
+			name:  "blank target port",
+			text:  "80:",
+			isErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := ParseServicePorts(test.text)
+			if test.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, got)
+
+			reparsed, err := ParseServicePorts(FormatServicePorts(got))
+			require.NoError(t, err)
+			assert.Equal(t, got, reparsed)
+		})
+	}
+}
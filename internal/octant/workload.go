@@ -17,6 +17,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes/scheme"
@@ -150,7 +151,9 @@ func sortWorkloads(workloads []Workload) {
 	})
 }
 
-func printResourceUsage(resourceType corev1.ResourceName, quantity resource.Quantity) string {
+// PrintResourceUsage formats a quantity of a resource, e.g. CPU as millicores
+// and memory as mebibytes.
+func PrintResourceUsage(resourceType corev1.ResourceName, quantity resource.Quantity) string {
 	switch resourceType {
 	case corev1.ResourceCPU:
 		return fmt.Sprintf("%vm", quantity.MilliValue())
@@ -323,6 +326,15 @@ type ClusterWorkloadLoader struct {
 	ObjectStatuser   func(context.Context, runtime.Object, store.Store) (objectstatus.ObjectStatus, error)
 	ObjectStore      store.Store
 	PodMetricsLoader PodMetricsLoader
+	// Selector limits workloads to those with pods matching it.
+	Selector *labels.Set
+}
+
+// WithWorkloadSelector limits loaded workloads to those with pods matching selector.
+func WithWorkloadSelector(selector *labels.Set) ClusterWorkloadLoaderOption {
+	return func(wl *ClusterWorkloadLoader) {
+		wl.Selector = selector
+	}
 }
 
 // NewWorkloadLoader creates an instance of ClusterWorkloadLoader.
@@ -358,6 +370,7 @@ func (wl *ClusterWorkloadLoader) Load(ctx context.Context, namespace string) ([]
 		Namespace:  namespace,
 		APIVersion: "v1",
 		Kind:       "Pod",
+		Selector:   wl.Selector,
 	}
 
 	podList, _, err := wl.ObjectStore.List(ctx, podKey)
@@ -494,7 +507,7 @@ func PodMemoryStat(workload *Workload) (*component.SingleStat, error) {
 }
 
 func podStat(workload *Workload, resourceName corev1.ResourceName, title string) (*component.SingleStat, error) {
-	resources, metrics, err := summarizePodWithMetric(workload)
+	resources, metrics, err := SummarizeWorkloadResources(workload)
 	if err != nil {
 		return nil, err
 	}
@@ -523,11 +536,13 @@ func podStat(workload *Workload, resourceName corev1.ResourceName, title string)
 	}
 
 	m := metrics[resourceName]
-	stat := component.NewSingleStat(title, printResourceUsage(resourceName, m), background)
+	stat := component.NewSingleStat(title, PrintResourceUsage(resourceName, m), background)
 	return stat, nil
 }
 
-func summarizePodWithMetric(workload *Workload) (corev1.ResourceRequirements, corev1.ResourceList, error) {
+// SummarizeWorkloadResources returns the combined requests and limits of the
+// containers in a workload's pods, and the combined usage of the pods.
+func SummarizeWorkloadResources(workload *Workload) (corev1.ResourceRequirements, corev1.ResourceList, error) {
 	if workload == nil {
 		return corev1.ResourceRequirements{}, corev1.ResourceList{}, fmt.Errorf("workload is nil")
 	}
@@ -0,0 +1,172 @@
+/*
+ * Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package octant
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/vmware-tanzu/octant/internal/util/kubernetes"
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+// WorkloadResourceUsage is the resources requested, limited, and used by the pods
+// of a workload.
+type WorkloadResourceUsage struct {
+	Workload *Workload
+	Requests corev1.ResourceList
+	Limits   corev1.ResourceList
+	// Usage is the actual usage reported by metrics server. It is empty if the
+	// cluster has no pod metrics.
+	Usage corev1.ResourceList
+}
+
+// NamespaceResourceUsage is the resource usage of the workloads in a namespace.
+type NamespaceResourceUsage struct {
+	Workloads []WorkloadResourceUsage
+	Requests  corev1.ResourceList
+	Limits    corev1.ResourceList
+	Usage     corev1.ResourceList
+	// Quota is the most restrictive hard limit of the resource quotas in the
+	// namespace, keyed by quota resource name, e.g. requests.cpu.
+	Quota corev1.ResourceList
+	// MetricsEnabled is true if usage was reported for the pods.
+	MetricsEnabled bool
+}
+
+// LoadNamespaceResourceUsage combines the requests, limits, and usage of the pods
+// of each workload in a namespace.
+func LoadNamespaceResourceUsage(ctx context.Context, objectStore store.Store, loader WorkloadLoaderInterface, namespace string) (*NamespaceResourceUsage, error) {
+	workloads, err := loader.Load(ctx, namespace)
+	if err != nil {
+		return nil, fmt.Errorf("load workloads: %w", err)
+	}
+
+	usage := &NamespaceResourceUsage{
+		Requests: corev1.ResourceList{},
+		Limits:   corev1.ResourceList{},
+		Usage:    corev1.ResourceList{},
+	}
+
+	for i := range workloads {
+		workload := &workloads[i]
+
+		resources, metrics, err := SummarizeWorkloadResources(workload)
+		if err != nil {
+			return nil, fmt.Errorf("summarize resources for workload '%s': %w", workload.Name, err)
+		}
+
+		workloadUsage := WorkloadResourceUsage{
+			Workload: workload,
+			Requests: resources.Requests,
+			Limits:   resources.Limits,
+			Usage:    corev1.ResourceList{},
+		}
+		if workload.PodMetricsEnabled() {
+			workloadUsage.Usage = metrics
+			usage.MetricsEnabled = true
+		}
+
+		for _, resourceName := range []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory} {
+			usage.Requests = addResourceList(usage.Requests, workloadUsage.Requests[resourceName], resourceName)
+			usage.Limits = addResourceList(usage.Limits, workloadUsage.Limits[resourceName], resourceName)
+			usage.Usage = addResourceList(usage.Usage, workloadUsage.Usage[resourceName], resourceName)
+		}
+
+		usage.Workloads = append(usage.Workloads, workloadUsage)
+	}
+
+	quota, err := namespaceQuota(ctx, objectStore, namespace)
+	if err != nil {
+		return nil, err
+	}
+	usage.Quota = quota
+
+	return usage, nil
+}
+
+// SortWorkloadsByResource sorts workloads by the most of a resource they use,
+// or request if the cluster has no pod metrics. Workloads using the same amount
+// are sorted by name.
+func (u *NamespaceResourceUsage) SortWorkloadsByResource(resourceName corev1.ResourceName) []WorkloadResourceUsage {
+	workloads := make([]WorkloadResourceUsage, len(u.Workloads))
+	copy(workloads, u.Workloads)
+
+	amount := func(workload WorkloadResourceUsage) resource.Quantity {
+		if u.MetricsEnabled {
+			return workload.Usage[resourceName]
+		}
+		return workload.Requests[resourceName]
+	}
+
+	sort.SliceStable(workloads, func(i, j int) bool {
+		a, b := amount(workloads[i]), amount(workloads[j])
+		if c := a.Cmp(b); c != 0 {
+			return c > 0
+		}
+		return workloads[i].Workload.Name < workloads[j].Workload.Name
+	})
+
+	return workloads
+}
+
+// QuotaFor returns the hard limit a quota sets for the requests or limits of a
+// resource. Quotas for cpu and memory are the same as quotas for requests.cpu
+// and requests.memory.
+func (u *NamespaceResourceUsage) QuotaFor(resourceName corev1.ResourceName, limits bool) (resource.Quantity, bool) {
+	prefix := "requests."
+	if limits {
+		prefix = "limits."
+	}
+
+	if hard, ok := u.Quota[corev1.ResourceName(prefix+string(resourceName))]; ok {
+		return hard, true
+	}
+
+	if !limits {
+		if hard, ok := u.Quota[resourceName]; ok {
+			return hard, true
+		}
+	}
+
+	return resource.Quantity{}, false
+}
+
+// namespaceQuota returns the smallest hard limit of each resource across the
+// resource quotas in a namespace.
+func namespaceQuota(ctx context.Context, objectStore store.Store, namespace string) (corev1.ResourceList, error) {
+	key := store.Key{
+		Namespace:  namespace,
+		APIVersion: "v1",
+		Kind:       "ResourceQuota",
+	}
+
+	list, _, err := objectStore.List(ctx, key)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list resource quotas in '%s': %w", namespace, err)
+	}
+
+	quota := corev1.ResourceList{}
+	for i := range list.Items {
+		resourceQuota := &corev1.ResourceQuota{}
+		if err := kubernetes.FromUnstructured(&list.Items[i], resourceQuota); err != nil {
+			return nil, err
+		}
+
+		for resourceName, hard := range resourceQuota.Spec.Hard {
+			if current, ok := quota[resourceName]; ok && current.Cmp(hard) <= 0 {
+				continue
+			}
+			quota[resourceName] = hard
+		}
+	}
+
+	return quota, nil
+}
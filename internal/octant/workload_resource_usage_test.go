@@ -0,0 +1,138 @@
+/*
+ * Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package octant_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	storeFake "github.com/vmware-tanzu/octant/pkg/store/fake"
+)
+
+type staticWorkloadLoader []octant.Workload
+
+func (l staticWorkloadLoader) Load(ctx context.Context, namespace string) ([]octant.Workload, error) {
+	return l, nil
+}
+
+func TestLoadNamespaceResourceUsage(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	// Each workload created by createWorkload has three pods.
+	api := createWorkload(t, "100m", "10Mi", podOptionWithLimitAndRequestValues("500m", "64Mi", "200m", "32Mi"))
+	api.Name = "api"
+	web := createWorkload(t, "300m", "5Mi", podOptionWithRequestValues("100m", "16Mi"))
+	web.Name = "web"
+
+	quota := &corev1.ResourceQuota{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ResourceQuota"},
+		ObjectMeta: metav1.ObjectMeta{Name: "quota", Namespace: "default"},
+		Spec: corev1.ResourceQuotaSpec{
+			Hard: corev1.ResourceList{
+				corev1.ResourceRequestsCPU: resource.MustParse("2"),
+				corev1.ResourceLimitsCPU:   resource.MustParse("4"),
+			},
+		},
+	}
+	smallerQuota := &corev1.ResourceQuota{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ResourceQuota"},
+		ObjectMeta: metav1.ObjectMeta{Name: "smaller-quota", Namespace: "default"},
+		Spec: corev1.ResourceQuotaSpec{
+			Hard: corev1.ResourceList{
+				corev1.ResourceRequestsCPU: resource.MustParse("1"),
+				corev1.ResourceMemory:      resource.MustParse("1Gi"),
+			},
+		},
+	}
+
+	objectStore := storeFake.NewMockStore(controller)
+	objectStore.EXPECT().
+		List(gomock.Any(), store.Key{Namespace: "default", APIVersion: "v1", Kind: "ResourceQuota"}).
+		Return(testutil.ToUnstructuredList(t, quota, smallerQuota), false, nil)
+
+	loader := staticWorkloadLoader{*api, *web}
+
+	got, err := octant.LoadNamespaceResourceUsage(context.Background(), objectStore, loader, "default")
+	require.NoError(t, err)
+
+	assertQuantity := func(expected string, list corev1.ResourceList, resourceName corev1.ResourceName) {
+		expectedQuantity, actual := resource.MustParse(expected), list[resourceName]
+		assert.Equal(t, 0, expectedQuantity.Cmp(actual), "expected %s, got %s", expected, actual.String())
+	}
+
+	require.Len(t, got.Workloads, 2)
+	assert.True(t, got.MetricsEnabled)
+	assertQuantity("600m", got.Workloads[0].Requests, corev1.ResourceCPU)
+	assertQuantity("1500m", got.Workloads[0].Limits, corev1.ResourceCPU)
+	assertQuantity("300m", got.Workloads[0].Usage, corev1.ResourceCPU)
+
+	assertQuantity("900m", got.Requests, corev1.ResourceCPU)
+	assertQuantity("144Mi", got.Requests, corev1.ResourceMemory)
+	assertQuantity("1500m", got.Limits, corev1.ResourceCPU)
+	assertQuantity("1200m", got.Usage, corev1.ResourceCPU)
+	assertQuantity("45Mi", got.Usage, corev1.ResourceMemory)
+
+	requestsQuota, ok := got.QuotaFor(corev1.ResourceCPU, false)
+	require.True(t, ok)
+	assert.Equal(t, "1", requestsQuota.String())
+
+	limitsQuota, ok := got.QuotaFor(corev1.ResourceCPU, true)
+	require.True(t, ok)
+	assert.Equal(t, "4", limitsQuota.String())
+
+	memoryQuota, ok := got.QuotaFor(corev1.ResourceMemory, false)
+	require.True(t, ok)
+	assert.Equal(t, "1Gi", memoryQuota.String())
+
+	_, ok = got.QuotaFor(corev1.ResourceMemory, true)
+	assert.False(t, ok)
+
+	var names []string
+	for _, workload := range got.SortWorkloadsByResource(corev1.ResourceCPU) {
+		names = append(names, workload.Workload.Name)
+	}
+	assert.Equal(t, []string{"web", "api"}, names)
+
+	names = nil
+	for _, workload := range got.SortWorkloadsByResource(corev1.ResourceMemory) {
+		names = append(names, workload.Workload.Name)
+	}
+	assert.Equal(t, []string{"api", "web"}, names)
+}
+
+func TestNamespaceResourceUsage_SortWorkloadsByResource_withoutMetrics(t *testing.T) {
+	small := octant.NewWorkload("small", "application")
+	large := octant.NewWorkload("large", "application")
+
+	usage := octant.NamespaceResourceUsage{
+		Workloads: []octant.WorkloadResourceUsage{
+			{
+				Workload: small,
+				Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m")},
+			},
+			{
+				Workload: large,
+				Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")},
+			},
+		},
+	}
+
+	sorted := usage.SortWorkloadsByResource(corev1.ResourceCPU)
+	require.Len(t, sorted, 2)
+	assert.Equal(t, "large", sorted[0].Workload.Name)
+	assert.Equal(t, "small", sorted[1].Workload.Name)
+}
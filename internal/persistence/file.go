@@ -0,0 +1,92 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package persistence
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/afero"
+)
+
+// FileStore saves each document to a JSON file in a directory.
+type FileStore struct {
+	fs  afero.Fs
+	dir string
+}
+
+var _ Store = (*FileStore)(nil)
+
+// NewFileStore creates an instance of FileStore.
+func NewFileStore(fs afero.Fs, dir string) *FileStore {
+	return &FileStore{
+		fs:  fs,
+		dir: dir,
+	}
+}
+
+// Document returns the document saved to name.json.
+func (s *FileStore) Document(name string) Document {
+	return NewFileDocument(s.fs, filepath.Join(s.dir, name+".json"))
+}
+
+// FileDocument is a document saved to a file.
+type FileDocument struct {
+	fs   afero.Fs
+	path string
+
+	mu sync.Mutex
+}
+
+var _ Document = (*FileDocument)(nil)
+
+// NewFileDocument creates an instance of FileDocument.
+func NewFileDocument(fs afero.Fs, path string) *FileDocument {
+	return &FileDocument{
+		fs:   fs,
+		path: path,
+	}
+}
+
+// Load loads the document. A missing file is nil.
+func (d *FileDocument) Load() ([]byte, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	data, err := afero.ReadFile(d.fs, d.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "read %q", d.path)
+	}
+
+	return data, nil
+}
+
+// Save replaces the document. The file is written atomically so an interrupted save
+// doesn't lose the existing document.
+func (d *FileDocument) Save(data []byte) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if err := d.fs.MkdirAll(filepath.Dir(d.path), 0700); err != nil {
+		return errors.Wrapf(err, "create directory for %q", d.path)
+	}
+
+	tmp := d.path + ".tmp"
+	if err := afero.WriteFile(d.fs, tmp, data, 0600); err != nil {
+		return errors.Wrapf(err, "write %q", tmp)
+	}
+
+	if err := d.fs.Rename(tmp, d.path); err != nil {
+		return errors.Wrapf(err, "save %q", d.path)
+	}
+
+	return nil
+}
@@ -0,0 +1,52 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package persistence
+
+import (
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileStore(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	document := NewFileStore(fs, "/home/user/.octant").Document(DocumentPreferences)
+
+	got, err := document.Load()
+	require.NoError(t, err)
+	assert.Nil(t, got)
+
+	require.NoError(t, document.Save([]byte(`{"ui":{}}`)))
+
+	got, err = afero.ReadFile(fs, "/home/user/.octant/preferences.json")
+	require.NoError(t, err)
+	assert.Equal(t, `{"ui":{}}`, string(got))
+
+	got, err = document.Load()
+	require.NoError(t, err)
+	assert.Equal(t, `{"ui":{}}`, string(got))
+
+	exists, err := afero.Exists(fs, "/home/user/.octant/preferences.json.tmp")
+	require.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestFileDocument_Save_readOnly(t *testing.T) {
+	fs := afero.NewReadOnlyFs(afero.NewMemMapFs())
+
+	require.Error(t, NewFileDocument(fs, "/preferences.json").Save([]byte("{}")))
+}
+
+func TestParseBackend(t *testing.T) {
+	got, err := ParseBackend("ConfigMap")
+	require.NoError(t, err)
+	assert.Equal(t, BackendConfigMap, got)
+
+	_, err = ParseBackend("etcd")
+	require.Error(t, err)
+}
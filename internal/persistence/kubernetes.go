@@ -0,0 +1,201 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package persistence
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+)
+
+const (
+	managedByLabel = "app.kubernetes.io/managed-by"
+	managedByValue = "octant"
+)
+
+// resource is a Kubernetes object with a map of keys to data.
+type resource interface {
+	// get returns the data saved to a key.
+	get(ctx context.Context, key string) ([]byte, error)
+	// put saves data to a key. The object is created if it doesn't exist.
+	put(ctx context.Context, key string, data []byte) error
+	// String describes the object in errors.
+	String() string
+}
+
+// KubernetesStore saves each document to a key of a ConfigMap or Secret.
+type KubernetesStore struct {
+	ctx      context.Context
+	resource resource
+}
+
+var _ Store = (*KubernetesStore)(nil)
+
+// NewConfigMapStore creates a KubernetesStore which saves documents to a ConfigMap.
+func NewConfigMapStore(ctx context.Context, client kubernetes.Interface, namespace, name string) *KubernetesStore {
+	return &KubernetesStore{
+		ctx:      ctx,
+		resource: &configMapResource{client: client, namespace: namespace, name: name},
+	}
+}
+
+// NewSecretStore creates a KubernetesStore which saves documents to a Secret. Use it
+// instead of a ConfigMap when state should only be readable by users who can read
+// secrets.
+func NewSecretStore(ctx context.Context, client kubernetes.Interface, namespace, name string) *KubernetesStore {
+	return &KubernetesStore{
+		ctx:      ctx,
+		resource: &secretResource{client: client, namespace: namespace, name: name},
+	}
+}
+
+// Document returns the document saved to the key name.json.
+func (s *KubernetesStore) Document(name string) Document {
+	return &kubernetesDocument{
+		ctx:      s.ctx,
+		resource: s.resource,
+		key:      name + ".json",
+	}
+}
+
+type kubernetesDocument struct {
+	ctx      context.Context
+	resource resource
+	key      string
+}
+
+var _ Document = (*kubernetesDocument)(nil)
+
+// Load loads the document. A missing object or key is nil.
+func (d *kubernetesDocument) Load() ([]byte, error) {
+	data, err := d.resource.get(d.ctx, d.key)
+	if err != nil {
+		return nil, errors.Wrapf(err, "read %q from %s", d.key, d.resource)
+	}
+	return data, nil
+}
+
+// Save replaces the document. Other documents in the object are left alone.
+func (d *kubernetesDocument) Save(data []byte) error {
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		return d.resource.put(d.ctx, d.key, data)
+	})
+	if err != nil {
+		return errors.Wrapf(err, "save %q to %s", d.key, d.resource)
+	}
+	return nil
+}
+
+type configMapResource struct {
+	client    kubernetes.Interface
+	namespace string
+	name      string
+}
+
+func (r *configMapResource) get(ctx context.Context, key string) ([]byte, error) {
+	configMap, err := r.client.CoreV1().ConfigMaps(r.namespace).Get(ctx, r.name, metav1.GetOptions{})
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	data, ok := configMap.Data[key]
+	if !ok {
+		return nil, nil
+	}
+	return []byte(data), nil
+}
+
+func (r *configMapResource) put(ctx context.Context, key string, data []byte) error {
+	configMaps := r.client.CoreV1().ConfigMaps(r.namespace)
+
+	configMap, err := configMaps.Get(ctx, r.name, metav1.GetOptions{})
+	if kerrors.IsNotFound(err) {
+		configMap = &corev1.ConfigMap{
+			ObjectMeta: objectMeta(r.namespace, r.name),
+			Data:       map[string]string{key: string(data)},
+		}
+		_, err = configMaps.Create(ctx, configMap, metav1.CreateOptions{})
+		return err
+	} else if err != nil {
+		return err
+	}
+
+	if configMap.Data == nil {
+		configMap.Data = map[string]string{}
+	}
+	configMap.Data[key] = string(data)
+
+	_, err = configMaps.Update(ctx, configMap, metav1.UpdateOptions{})
+	return err
+}
+
+func (r *configMapResource) String() string {
+	return fmt.Sprintf("ConfigMap %s/%s", r.namespace, r.name)
+}
+
+type secretResource struct {
+	client    kubernetes.Interface
+	namespace string
+	name      string
+}
+
+func (r *secretResource) get(ctx context.Context, key string) ([]byte, error) {
+	secret, err := r.client.CoreV1().Secrets(r.namespace).Get(ctx, r.name, metav1.GetOptions{})
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return secret.Data[key], nil
+}
+
+func (r *secretResource) put(ctx context.Context, key string, data []byte) error {
+	secrets := r.client.CoreV1().Secrets(r.namespace)
+
+	secret, err := secrets.Get(ctx, r.name, metav1.GetOptions{})
+	if kerrors.IsNotFound(err) {
+		secret = &corev1.Secret{
+			ObjectMeta: objectMeta(r.namespace, r.name),
+			Type:       corev1.SecretTypeOpaque,
+			Data:       map[string][]byte{key: data},
+		}
+		_, err = secrets.Create(ctx, secret, metav1.CreateOptions{})
+		return err
+	} else if err != nil {
+		return err
+	}
+
+	if secret.Data == nil {
+		secret.Data = map[string][]byte{}
+	}
+	secret.Data[key] = data
+
+	_, err = secrets.Update(ctx, secret, metav1.UpdateOptions{})
+	return err
+}
+
+func (r *secretResource) String() string {
+	return fmt.Sprintf("Secret %s/%s", r.namespace, r.name)
+}
+
+func objectMeta(namespace, name string) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Namespace: namespace,
+		Name:      name,
+		Labels:    map[string]string{managedByLabel: managedByValue},
+	}
+}
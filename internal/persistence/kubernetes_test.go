@@ -0,0 +1,72 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package persistence
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestConfigMapStore(t *testing.T) {
+	ctx := context.Background()
+	client := fake.NewSimpleClientset()
+
+	s := NewConfigMapStore(ctx, client, "octant", DefaultName)
+	preferences := s.Document(DocumentPreferences)
+	portForwards := s.Document(DocumentPortForwards)
+
+	got, err := preferences.Load()
+	require.NoError(t, err)
+	assert.Nil(t, got)
+
+	require.NoError(t, preferences.Save([]byte(`{"ui":{}}`)))
+	require.NoError(t, portForwards.Save([]byte(`{"portForwards":[]}`)))
+	require.NoError(t, preferences.Save([]byte(`{"bookmarks":[]}`)))
+
+	configMap, err := client.CoreV1().ConfigMaps("octant").Get(ctx, DefaultName, metav1.GetOptions{})
+	require.NoError(t, err)
+
+	expected := map[string]string{
+		"preferences.json":   `{"bookmarks":[]}`,
+		"port-forwards.json": `{"portForwards":[]}`,
+	}
+	assert.Equal(t, expected, configMap.Data)
+	assert.Equal(t, managedByValue, configMap.Labels[managedByLabel])
+
+	got, err = preferences.Load()
+	require.NoError(t, err)
+	assert.Equal(t, `{"bookmarks":[]}`, string(got))
+}
+
+func TestSecretStore(t *testing.T) {
+	ctx := context.Background()
+	client := fake.NewSimpleClientset(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "octant", Name: DefaultName},
+		Data:       map[string][]byte{"other": []byte("kept")},
+	})
+
+	document := NewSecretStore(ctx, client, "octant", DefaultName).Document(DocumentPreferences)
+
+	got, err := document.Load()
+	require.NoError(t, err)
+	assert.Nil(t, got)
+
+	require.NoError(t, document.Save([]byte(`{"ui":{}}`)))
+
+	got, err = document.Load()
+	require.NoError(t, err)
+	assert.Equal(t, `{"ui":{}}`, string(got))
+
+	secret, err := client.CoreV1().Secrets("octant").Get(ctx, DefaultName, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, []byte("kept"), secret.Data["other"])
+}
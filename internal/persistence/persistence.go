@@ -0,0 +1,91 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package persistence saves per-user state such as preferences, bookmarks, and port
+// forward definitions. State is saved to files when Octant runs on a workstation and to
+// a ConfigMap or Secret when Octant runs in a cluster.
+package persistence
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"k8s.io/client-go/rest"
+)
+
+const (
+	// DocumentPreferences is the document preferences and bookmarks are saved to.
+	DocumentPreferences = "preferences"
+	// DocumentPortForwards is the document port forward definitions are saved to.
+	DocumentPortForwards = "port-forwards"
+	// DocumentWebhooks is the document outbound webhook rules are saved to.
+	DocumentWebhooks = "webhooks"
+
+	// DefaultName is the name of the ConfigMap or Secret state is saved to.
+	DefaultName = "octant-state"
+
+	serviceAccountNamespaceFile = "/var/run/secrets/kubernetes.io/serviceaccount/namespace"
+)
+
+// Document is a blob of saved state.
+type Document interface {
+	// Load loads the document. A document which was never saved is nil.
+	Load() ([]byte, error)
+	// Save replaces the document.
+	Save(data []byte) error
+}
+
+// Store saves documents by name.
+type Store interface {
+	// Document returns the named document. Names are made of letters, digits, '-', '_',
+	// and '.'.
+	Document(name string) Document
+}
+
+// Backend is where state is saved.
+type Backend string
+
+const (
+	// BackendFile saves state to files.
+	BackendFile Backend = "file"
+	// BackendConfigMap saves state to a ConfigMap.
+	BackendConfigMap Backend = "configmap"
+	// BackendSecret saves state to a Secret.
+	BackendSecret Backend = "secret"
+)
+
+// ParseBackend parses a backend name. A blank name chooses a ConfigMap when Octant
+// runs in a cluster and files otherwise.
+func ParseBackend(name string) (Backend, error) {
+	switch backend := Backend(strings.ToLower(name)); backend {
+	case "":
+		if InCluster() {
+			return BackendConfigMap, nil
+		}
+		return BackendFile, nil
+	case BackendFile, BackendConfigMap, BackendSecret:
+		return backend, nil
+	default:
+		return "", fmt.Errorf("unknown state backend %q (expected %s, %s, or %s)",
+			name, BackendFile, BackendConfigMap, BackendSecret)
+	}
+}
+
+// InCluster returns true if Octant is running in a pod.
+func InCluster() bool {
+	_, err := rest.InClusterConfig()
+	return err == nil
+}
+
+// InClusterNamespace returns the namespace of the pod Octant is running in. It is blank
+// if Octant is not running in a pod.
+func InClusterNamespace() string {
+	data, err := ioutil.ReadFile(serviceAccountNamespaceFile)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(data))
+}
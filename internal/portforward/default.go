@@ -10,13 +10,15 @@ import (
 	"os"
 
 	"github.com/vmware-tanzu/octant/internal/cluster"
+	"github.com/vmware-tanzu/octant/pkg/action"
 	"github.com/vmware-tanzu/octant/pkg/store"
 
 	"github.com/pkg/errors"
 )
 
-// Default create a port forward instance.
-func Default(ctx context.Context, client cluster.ClientInterface, objectStore store.Store) (PortForwarder, error) {
+// Default create a port forward instance. If definitions is not nil, port forwards
+// are saved to it and port forwards saved previously are restored in the background.
+func Default(ctx context.Context, client cluster.ClientInterface, objectStore store.Store, definitions DefinitionStore) (PortForwarder, error) {
 	restClient, err := client.RESTClient()
 	if err != nil {
 		return nil, errors.Wrap(err, "fetching RESTClient")
@@ -33,9 +35,14 @@ func Default(ctx context.Context, client cluster.ClientInterface, objectStore st
 				ErrOut: os.Stderr,
 			},
 		},
+		Definitions: definitions,
 	}
 
 	svc := New(ctx, pfOpts)
 
+	if pfOpts.Definitions != nil {
+		go svc.Restore(ctx, action.NewAlerter())
+	}
+
 	return svc, nil
 }
@@ -0,0 +1,119 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package portforward
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/afero"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware-tanzu/octant/internal/persistence"
+)
+
+// Definition is a port forward a user created. Definitions are saved so port forwards
+// can be re-established when Octant restarts.
+type Definition struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Namespace  string `json:"namespace"`
+	Name       string `json:"name"`
+	RemotePort uint16 `json:"remotePort"`
+	// LocalPort is the local port the forward was listening on. A restored forward
+	// tries to reuse it.
+	LocalPort uint16 `json:"localPort,omitempty"`
+}
+
+// GroupVersionKind returns the group version kind of the definition's target.
+func (d Definition) GroupVersionKind() schema.GroupVersionKind {
+	return schema.FromAPIVersionAndKind(d.APIVersion, d.Kind)
+}
+
+// key identifies a definition. Port forwards for the same object and remote port
+// share a definition.
+func (d Definition) key() string {
+	return fmt.Sprintf("%s/%s/%s/%s/%d", d.APIVersion, d.Kind, d.Namespace, d.Name, d.RemotePort)
+}
+
+// DefinitionStore loads and saves port forward definitions.
+type DefinitionStore interface {
+	// Load loads saved definitions.
+	Load() ([]Definition, error)
+	// Save replaces saved definitions.
+	Save(definitions []Definition) error
+}
+
+type definitionFile struct {
+	PortForwards []Definition `json:"portForwards"`
+}
+
+// DocumentDefinitionStore saves port forward definitions to a persistence document
+// as JSON.
+type DocumentDefinitionStore struct {
+	document persistence.Document
+}
+
+var _ DefinitionStore = (*DocumentDefinitionStore)(nil)
+
+// NewDocumentDefinitionStore creates an instance of DocumentDefinitionStore.
+func NewDocumentDefinitionStore(document persistence.Document) *DocumentDefinitionStore {
+	return &DocumentDefinitionStore{
+		document: document,
+	}
+}
+
+// NewFileDefinitionStore creates a DocumentDefinitionStore which saves definitions to
+// a JSON file.
+func NewFileDefinitionStore(fs afero.Fs, path string) *DocumentDefinitionStore {
+	return NewDocumentDefinitionStore(persistence.NewFileDocument(fs, path))
+}
+
+// Load loads saved definitions. A missing document has no definitions.
+func (s *DocumentDefinitionStore) Load() ([]Definition, error) {
+	data, err := s.document.Load()
+	if err != nil {
+		return nil, errors.Wrap(err, "load port forwards")
+	}
+	if data == nil {
+		return nil, nil
+	}
+
+	var file definitionFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, errors.Wrap(err, "decode port forwards")
+	}
+
+	return file.PortForwards, nil
+}
+
+// Save replaces saved definitions.
+func (s *DocumentDefinitionStore) Save(definitions []Definition) error {
+	file := definitionFile{PortForwards: definitions}
+	if file.PortForwards == nil {
+		file.PortForwards = []Definition{}
+	}
+
+	data, err := json.MarshalIndent(file, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "encode port forwards")
+	}
+
+	if err := s.document.Save(data); err != nil {
+		return errors.Wrap(err, "save port forwards")
+	}
+
+	return nil
+}
+
+// sortDefinitions sorts definitions so saved files are stable.
+func sortDefinitions(definitions []Definition) {
+	sort.Slice(definitions, func(i, j int) bool {
+		return definitions[i].key() < definitions[j].key()
+	})
+}
@@ -0,0 +1,140 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package portforward
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/rest"
+
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	storeFake "github.com/vmware-tanzu/octant/pkg/store/fake"
+)
+
+func TestFileDefinitionStore(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	s := NewFileDefinitionStore(fs, "/home/.octant/port-forwards.json")
+
+	got, err := s.Load()
+	require.NoError(t, err)
+	assert.Empty(t, got)
+
+	definitions := []Definition{
+		{APIVersion: "v1", Kind: "Pod", Namespace: "default", Name: "pod", RemotePort: 8080, LocalPort: 40000},
+	}
+	require.NoError(t, s.Save(definitions))
+
+	got, err = s.Load()
+	require.NoError(t, err)
+	assert.Equal(t, definitions, got)
+
+	exists, err := afero.Exists(fs, "/home/.octant/port-forwards.json.tmp")
+	require.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestFileDefinitionStore_Load_invalid(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	require.NoError(t, afero.WriteFile(fs, "/port-forwards.json", []byte("{"), 0600))
+
+	_, err := NewFileDefinitionStore(fs, "/port-forwards.json").Load()
+	require.Error(t, err)
+}
+
+func TestService_definitions(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	pod := runningPod("default", "pod")
+
+	objectStore := storeFake.NewMockStore(controller)
+	objectStore.EXPECT().
+		Get(gomock.Any(), podKey("default", "pod")).
+		Return(testutil.ToUnstructured(t, pod), nil).
+		AnyTimes()
+
+	definitions := NewFileDefinitionStore(afero.NewMemMapFs(), "/port-forwards.json")
+
+	ctx := context.Background()
+	newService := func() *Service {
+		return New(ctx, ServiceOptions{
+			RESTClient:    &rest.RESTClient{},
+			ObjectStore:   objectStore,
+			PortForwarder: &stubForwarder{},
+			Definitions:   definitions,
+		})
+	}
+
+	s := newService()
+	resp, err := s.Create(ctx, action.NewAlerter(), schema.GroupVersionKind{Version: "v1", Kind: "Pod"}, "pod", "default", 8080)
+	require.NoError(t, err)
+
+	expected := []Definition{
+		{APIVersion: "v1", Kind: "Pod", Namespace: "default", Name: "pod", RemotePort: 8080, LocalPort: 40000},
+	}
+	got, err := definitions.Load()
+	require.NoError(t, err)
+	assert.Equal(t, expected, got)
+	assert.NotEmpty(t, resp.ID)
+
+	// Stopping the service, as Octant does when it exits, keeps definitions.
+	s.Stop()
+
+	got, err = definitions.Load()
+	require.NoError(t, err)
+	assert.Equal(t, expected, got)
+
+	restored := newService()
+	defer restored.Stop()
+	restored.Restore(ctx, action.NewAlerter())
+
+	list := restored.List(ctx)
+	require.Len(t, list, 1)
+	assert.Equal(t, "pod", list[0].Target.Name)
+	assert.Equal(t, []ForwardedPort{{Local: 40000, Remote: 8080}}, list[0].Ports)
+
+	// Stopping a port forward removes its definition.
+	restored.StopForwarder(list[0].ID)
+
+	got, err = definitions.Load()
+	require.NoError(t, err)
+	assert.Empty(t, got)
+}
+
+func TestService_Restore_failed(t *testing.T) {
+	definitions := NewFileDefinitionStore(afero.NewMemMapFs(), "/port-forwards.json")
+	saved := []Definition{
+		{APIVersion: "v1", Kind: "Pod", Namespace: "default", Name: "pod", RemotePort: 8080, LocalPort: 40000},
+	}
+	require.NoError(t, definitions.Save(saved))
+
+	ctx := context.Background()
+	s := New(ctx, ServiceOptions{
+		PortForwarder: &stubForwarder{},
+		Definitions:   definitions,
+	})
+	defer s.Stop()
+
+	// Without an object store the pod can't be resolved.
+	s.Restore(ctx, action.NewAlerter())
+	assert.Empty(t, s.List(ctx))
+
+	// Unrestored definitions are kept when other port forwards are saved.
+	s.saveDefinition(newForwardRequest(schema.GroupVersionKind{Version: "v1", Kind: "Pod"}, "other", "default", 80), CreateResponse{ID: "id"})
+
+	got, err := definitions.Load()
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	assert.Equal(t, "other", got[0].Name)
+	assert.Equal(t, saved[0], got[1])
+}
@@ -0,0 +1,217 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package portforward
+
+import (
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	kcache "k8s.io/client-go/tools/cache"
+
+	"github.com/vmware-tanzu/octant/internal/util/kubernetes"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+const (
+	// defaultReconnectDelay is how long a port forward waits before looking for a new pod.
+	defaultReconnectDelay = time.Second
+	// maxReconnectDelay is the longest a port forward waits between reconnect attempts.
+	maxReconnectDelay = 30 * time.Second
+)
+
+// canReconnect returns true if port forwards to a kind can be moved to another pod.
+// Pods can't be replaced, but services and workloads select equivalent pods.
+func canReconnect(gvk schema.GroupVersionKind) bool {
+	return IsForwardable(gvk) && !(gvk.Group == "" && gvk.Kind == "Pod")
+}
+
+// watchPods watches pods in a namespace so port forwards are reconnected as soon as
+// their pod is deleted or stops being ready.
+func (s *Service) watchPods(namespace string) {
+	logger := s.logger.With("context", "PortForwardService.watchPods", "namespace", namespace)
+
+	if s.opts.ObjectStore == nil {
+		return
+	}
+
+	s.watchMu.Lock()
+	defer s.watchMu.Unlock()
+
+	if s.watchedNamespaces[namespace] {
+		return
+	}
+
+	handler := kcache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(_, newObj interface{}) {
+			s.podChanged(newObj, false)
+		},
+		DeleteFunc: func(obj interface{}) {
+			s.podChanged(obj, true)
+		},
+	}
+
+	key := store.Key{APIVersion: "v1", Kind: "Pod", Namespace: namespace}
+	// The handler is removed when the service is stopped.
+	if _, err := s.opts.ObjectStore.Watch(s.ctx, key, handler); err != nil {
+		// Port forwards still reconnect when their connection ends.
+		logger.WithErr(err).Warnf("unable to watch pods for port forwards")
+		return
+	}
+
+	s.watchedNamespaces[namespace] = true
+}
+
+// podChanged disconnects port forwards from a pod which was deleted or is no longer ready.
+func (s *Service) podChanged(obj interface{}, deleted bool) {
+	if tombstone, ok := obj.(kcache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+
+	u, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return
+	}
+
+	if !deleted {
+		pod := &corev1.Pod{}
+		if err := kubernetes.FromUnstructured(u, pod); err != nil || isPodReady(pod) {
+			return
+		}
+	}
+
+	var disconnects []func()
+
+	s.state.Lock()
+	for _, state := range s.state.portForwards {
+		pod := state.Pod
+		if pod.Namespace != u.GetNamespace() || pod.Name != u.GetName() {
+			continue
+		}
+		if state.Status != StatusActive || !canReconnect(state.Target.GVK) || state.disconnect == nil {
+			continue
+		}
+		disconnects = append(disconnects, state.disconnect)
+	}
+	s.state.Unlock()
+
+	for _, disconnect := range disconnects {
+		disconnect()
+	}
+}
+
+// disconnected handles a port forward's connection ending. Port forwards which were
+// stopped or can't reconnect are removed.
+func (s *Service) disconnected(alerter action.Alerter, id string, targetRequest CreateRequest, err error) {
+	s.state.Lock()
+	state, ok := s.state.portForwards[id]
+	s.state.Unlock()
+
+	if !ok || state.ctx.Err() != nil || !canReconnect(state.Target.GVK) {
+		// Cleanup state for terminated port-forward
+		s.removeForwarder(id)
+		return
+	}
+
+	message := fmt.Sprintf("lost connection to pod %s", state.Pod.Name)
+	if err != nil {
+		message = fmt.Sprintf("%s: %v", message, err)
+	}
+
+	s.reconnect(alerter, id, targetRequest, message)
+}
+
+// reconnect forwards to another ready pod behind a port forward's target. It retries
+// with a backoff until it succeeds or the port forward is stopped. Local ports are
+// kept so clients can continue to use the same address.
+func (s *Service) reconnect(alerter action.Alerter, id string, targetRequest CreateRequest, message string) {
+	logger := s.logger.With("context", "PortForwardService.reconnect", "id", id)
+
+	state, ok := s.setReconnecting(id, message)
+	if !ok {
+		return
+	}
+	logger.Infof("reconnecting port forward: %s", message)
+
+	req := targetRequest
+	req.Ports = make([]PortForwardPortSpec, len(targetRequest.Ports))
+	copy(req.Ports, targetRequest.Ports)
+	for i := range req.Ports {
+		if i < len(state.Ports) {
+			req.Ports[i].Local = state.Ports[i].Local
+		}
+	}
+
+	delay := s.reconnectDelay
+	for {
+		select {
+		case <-state.ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+
+		podRequest, err := s.resolvePod(state.ctx, req)
+		if err == nil {
+			err = s.forward(alerter, id, targetRequest, podRequest)
+		}
+
+		if err == nil {
+			s.setReconnected(id)
+
+			text := fmt.Sprintf("Port forward to %s %s reconnected to pod %s", targetRequest.Kind, targetRequest.Name, podRequest.Name)
+			alerter.SendAlert(action.CreateAlert(action.AlertTypeInfo, text, action.DefaultAlertExpiration))
+			logger.With("pod", podRequest.Name).Infof("reconnected port forward")
+			return
+		}
+
+		logger.WithErr(err).Debugf("unable to reconnect port forward")
+		if _, ok := s.setReconnecting(id, err.Error()); !ok {
+			return
+		}
+
+		delay *= 2
+		if delay > maxReconnectDelay {
+			delay = maxReconnectDelay
+		}
+	}
+}
+
+// setReconnecting marks a port forward as reconnecting.
+func (s *Service) setReconnecting(id, message string) (State, bool) {
+	s.state.Lock()
+	defer s.state.Unlock()
+
+	state, ok := s.state.portForwards[id]
+	if !ok {
+		return State{}, false
+	}
+
+	state.Status = StatusReconnecting
+	state.Message = message
+	s.state.portForwards[id] = state
+
+	return state.Clone(), true
+}
+
+// setReconnected marks a port forward as active after reconnecting.
+func (s *Service) setReconnected(id string) {
+	s.state.Lock()
+	defer s.state.Unlock()
+
+	state, ok := s.state.portForwards[id]
+	if !ok {
+		return
+	}
+
+	state.Status = StatusActive
+	state.Message = ""
+	state.Reconnects++
+	state.ReconnectedAt = time.Now()
+	s.state.portForwards[id] = state
+}
@@ -0,0 +1,148 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package portforward
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/rest"
+	kcache "k8s.io/client-go/tools/cache"
+
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	storeFake "github.com/vmware-tanzu/octant/pkg/store/fake"
+)
+
+func Test_canReconnect(t *testing.T) {
+	assert.False(t, canReconnect(schema.GroupVersionKind{Version: "v1", Kind: "Pod"}))
+	assert.True(t, canReconnect(schema.GroupVersionKind{Version: "v1", Kind: "Service"}))
+	assert.True(t, canReconnect(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}))
+	assert.False(t, canReconnect(schema.GroupVersionKind{Group: "batch", Version: "v1", Kind: "Job"}))
+}
+
+func TestService_reconnect(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	podLabels := map[string]string{"app": "api"}
+	selector := &metav1.LabelSelector{MatchLabels: podLabels}
+
+	deployment := testutil.CreateDeployment("api")
+	deployment.Namespace = "default"
+	deployment.Spec.Selector = selector
+
+	oldPod := readyPod("default", "api-old", time.Now(), podLabels)
+	newPod := readyPod("default", "api-new", time.Now(), podLabels)
+
+	deploymentKey := store.Key{APIVersion: "apps/v1", Kind: "Deployment", Namespace: "default", Name: "api"}
+	podsKey := store.Key{APIVersion: "v1", Kind: "Pod", Namespace: "default", LabelSelector: selector}
+
+	var handler kcache.ResourceEventHandler
+
+	objectStore := storeFake.NewMockStore(controller)
+	objectStore.EXPECT().
+		Get(gomock.Any(), deploymentKey).
+		Return(testutil.ToUnstructured(t, deployment), nil).
+		Times(2)
+	gomock.InOrder(
+		objectStore.EXPECT().
+			List(gomock.Any(), podsKey).
+			Return(testutil.ToUnstructuredList(t, oldPod), false, nil),
+		objectStore.EXPECT().
+			List(gomock.Any(), podsKey).
+			Return(testutil.ToUnstructuredList(t, newPod), false, nil),
+	)
+	objectStore.EXPECT().
+		Watch(gomock.Any(), store.Key{APIVersion: "v1", Kind: "Pod", Namespace: "default"}, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ store.Key, h kcache.ResourceEventHandler) (store.WatchHandle, error) {
+			handler = h
+			return storeFake.NewMockWatchHandle(controller), nil
+		})
+
+	forwarder := &stubForwarder{urls: make(chan *url.URL, 2)}
+
+	ctx := context.Background()
+	s := New(ctx, ServiceOptions{
+		RESTClient:    &rest.RESTClient{},
+		ObjectStore:   objectStore,
+		PortForwarder: forwarder,
+	})
+	s.reconnectDelay = time.Millisecond
+	defer s.Stop()
+
+	gvk := schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}
+	resp, err := s.Create(ctx, action.NewAlerter(), gvk, "api", "default", 8080)
+	require.NoError(t, err)
+	assert.Equal(t, "/namespaces/default/pods/api-old/portforward", (<-forwarder.urls).Path)
+	require.NotNil(t, handler)
+
+	// Pods which are not forwarded are ignored.
+	handler.OnDelete(testutil.ToUnstructured(t, readyPod("default", "other", time.Now(), podLabels)))
+
+	state, ok := s.Get(resp.ID)
+	require.True(t, ok)
+	assert.Equal(t, StatusActive, state.Status)
+
+	handler.OnDelete(testutil.ToUnstructured(t, oldPod))
+	assert.Equal(t, "/namespaces/default/pods/api-new/portforward", (<-forwarder.urls).Path)
+
+	require.Eventually(t, func() bool {
+		state, ok := s.Get(resp.ID)
+		return ok && state.Status == StatusActive && state.Reconnects == 1
+	}, time.Second, time.Millisecond)
+
+	state, ok = s.Get(resp.ID)
+	require.True(t, ok)
+	assert.Equal(t, "api-new", state.Pod.Name)
+	assert.Equal(t, []ForwardedPort{{Local: 40000, Remote: 8080}}, state.Ports)
+	assert.Empty(t, state.Message)
+	assert.False(t, state.ReconnectedAt.IsZero())
+}
+
+func TestService_podChanged_notReady(t *testing.T) {
+	disconnected := make(chan struct{})
+
+	s := New(context.Background(), ServiceOptions{})
+	defer s.Stop()
+
+	s.state.portForwards["id"] = State{
+		ID:     "id",
+		Target: Target{GVK: schema.GroupVersionKind{Version: "v1", Kind: "Service"}, Namespace: "default", Name: "service"},
+		Pod:    Target{GVK: schema.GroupVersionKind{Version: "v1", Kind: "Pod"}, Namespace: "default", Name: "pod"},
+		Status: StatusActive,
+		disconnect: func() {
+			close(disconnected)
+		},
+	}
+
+	pod := readyPod("default", "pod", time.Now(), nil)
+	s.podChanged(testutil.ToUnstructured(t, pod), false)
+
+	select {
+	case <-disconnected:
+		t.Fatal("ready pod was disconnected")
+	default:
+	}
+
+	pod.Status.Conditions[0].Status = corev1.ConditionFalse
+	s.podChanged(testutil.ToUnstructured(t, pod), false)
+
+	select {
+	case <-disconnected:
+	default:
+		t.Fatal("pod which is not ready was not disconnected")
+	}
+}
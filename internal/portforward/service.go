@@ -17,8 +17,12 @@ import (
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/rest"
 	restclient "k8s.io/client-go/rest"
 
@@ -73,6 +77,16 @@ type Target struct {
 	Name      string
 }
 
+// Status is the status of a port-forward's connection to its pod.
+type Status string
+
+const (
+	// StatusActive port forwards are forwarding traffic to a pod.
+	StatusActive Status = "Active"
+	// StatusReconnecting port forwards lost their pod and are looking for another.
+	StatusReconnecting Status = "Reconnecting"
+)
+
 // State describes a single port-forward's runtime state
 type State struct {
 	ID        string
@@ -80,9 +94,17 @@ type State struct {
 	Ports     []ForwardedPort
 	Target    Target
 	Pod       Target
-
-	cancel context.CancelFunc
-	ctx    context.Context
+	Status    Status
+	// Message describes why a port forward is reconnecting.
+	Message string
+	// Reconnects is the number of times the port forward reconnected to a new pod.
+	Reconnects int
+	// ReconnectedAt is when the port forward last reconnected.
+	ReconnectedAt time.Time
+
+	cancel     context.CancelFunc
+	ctx        context.Context
+	disconnect context.CancelFunc
 }
 
 // Clone clones a port forward state.
@@ -93,8 +115,15 @@ func (pf *State) Clone() State {
 		Ports:     make([]ForwardedPort, len(pf.Ports)),
 		Target:    pf.Target,
 		Pod:       pf.Pod,
-		cancel:    pf.cancel,
-		ctx:       pf.ctx,
+
+		Status:        pf.Status,
+		Message:       pf.Message,
+		Reconnects:    pf.Reconnects,
+		ReconnectedAt: pf.ReconnectedAt,
+
+		cancel:     pf.cancel,
+		ctx:        pf.ctx,
+		disconnect: pf.disconnect,
 	}
 	copy(pfCpy.Ports, pf.Ports)
 	return pfCpy
@@ -112,6 +141,9 @@ type ServiceOptions struct {
 	Config        *restclient.Config
 	ObjectStore   store.Store
 	PortForwarder portForwarder
+	// Definitions saves port forwards so they can be restored. Port forwards
+	// are not saved if it is nil.
+	Definitions DefinitionStore
 }
 
 type forwarderEvent struct {
@@ -127,6 +159,18 @@ type Service struct {
 	cancel   context.CancelFunc
 	notifyCh chan forwarderEvent
 	state    States
+
+	// definitions are the port forwards to save keyed by Definition.key.
+	// definitionIDs maps forwarder ids to their definition.
+	definitions   map[string]Definition
+	definitionIDs map[string]string
+	definitionsMu sync.Mutex
+
+	// watchedNamespaces are namespaces whose pods are watched for port forwards
+	// to reconnect.
+	watchedNamespaces map[string]bool
+	watchMu           sync.Mutex
+	reconnectDelay    time.Duration
 }
 
 // Check that struct satisfies interface
@@ -144,15 +188,30 @@ func New(ctx context.Context, opts ServiceOptions) *Service {
 		state: States{
 			portForwards: make(map[string]State),
 		},
+		definitions:   make(map[string]Definition),
+		definitionIDs: make(map[string]string),
+
+		watchedNamespaces: make(map[string]bool),
+		reconnectDelay:    defaultReconnectDelay,
 	}
 }
 
 // Stop stops all forwarders. The portForwardService is invalid after calling stop.
 func (s *Service) Stop() {
-	// TODO wait on goroutines to complete after calling cancel. (GH#494)
 	if s.cancel != nil {
 		s.cancel()
 	}
+
+	s.state.Lock()
+	defer s.state.Unlock()
+
+	for id, pf := range s.state.portForwards {
+		if pf.cancel != nil {
+			pf.cancel()
+			<-pf.ctx.Done() // Wait for PortForward context to finish.
+		}
+		delete(s.state.portForwards, id)
+	}
 }
 
 func (s *Service) validateCreateRequest(r CreateRequest) error {
@@ -163,8 +222,8 @@ func (s *Service) validateCreateRequest(r CreateRequest) error {
 		return errors.New("name field required")
 	}
 
-	if r.APIVersion != "v1" || (r.Kind != "Pod" && r.Kind != "Service") {
-		return errors.Errorf("port forwards only work with pods & services")
+	if !IsForwardable(schema.FromAPIVersionAndKind(r.APIVersion, r.Kind)) {
+		return errors.Errorf("port forwards only work with pods, services, deployments, stateful sets, daemon sets, and replica sets")
 	}
 
 	for _, p := range r.Ports {
@@ -177,80 +236,202 @@ func (s *Service) validateCreateRequest(r CreateRequest) error {
 }
 
 // resolvePod attempts to resolve a port forward request into an active pod we can
-// forward to. Service/deployments selectors will be resolved into pods and a random
-// one will be chosen. A pod has to be active.
-// Returns: pod name or error.
-func (s *Service) resolvePod(ctx context.Context, r CreateRequest) (string, error) {
+// forward to. Service and workload selectors will be resolved into pods and a ready
+// one will be chosen. The returned request targets the pod, with service ports
+// translated to the container ports they target.
+func (s *Service) resolvePod(ctx context.Context, r CreateRequest) (CreateRequest, error) {
 	o := s.opts.ObjectStore
 	if o == nil {
-		return "", errors.New("nil objectstore")
+		return CreateRequest{}, errors.New("nil objectstore")
 	}
 
-	switch {
-	case r.APIVersion == "v1" && r.Kind == "Pod":
+	podRequest := CreateRequest{
+		Namespace:  r.Namespace,
+		APIVersion: "v1",
+		Kind:       "Pod",
+		Name:       r.Name,
+		Ports:      r.Ports,
+	}
+
+	if r.APIVersion == "v1" && r.Kind == "Pod" {
 		// Verify pod exists and status is running
 		if ok, err := s.verifyPod(ctx, r.Namespace, r.Name); !ok || err != nil {
-			return "", errors.Errorf("verifying pod %q: %v", r.Name, err)
+			return CreateRequest{}, errors.Errorf("verifying pod %q: %v", r.Name, err)
+		}
+		return podRequest, nil
+	}
+
+	key := store.Key{
+		APIVersion: r.APIVersion,
+		Kind:       r.Kind,
+		Namespace:  r.Namespace,
+		Name:       r.Name,
+	}
+	object, err := o.Get(ctx, key)
+	if err != nil {
+		return CreateRequest{}, err
+	}
+	if object == nil {
+		return CreateRequest{}, errors.Errorf("%s %q not found", r.Kind, r.Name)
+	}
+
+	var service *corev1.Service
+	var selector *metav1.LabelSelector
+
+	if r.APIVersion == "v1" && r.Kind == "Service" {
+		service = &corev1.Service{}
+		if err := kubernetes.FromUnstructured(object, service); err != nil {
+			return CreateRequest{}, err
 		}
-		return r.Name, nil
-	case r.APIVersion == "v1" && r.Kind == "Service":
-		pod, err := s.findPodForService(ctx, r.APIVersion, r.Kind, r.Namespace, r.Name)
+		if len(service.Spec.Selector) == 0 {
+			return CreateRequest{}, errors.Errorf("service %q does not have a selector", r.Name)
+		}
+		selector = &metav1.LabelSelector{MatchLabels: service.Spec.Selector}
+	} else {
+		selector, err = workloadSelector(object)
 		if err != nil {
-			return "", err
+			return CreateRequest{}, err
 		}
+	}
+
+	pod, err := s.findReadyPod(ctx, r.Namespace, selector)
+	if err != nil {
+		return CreateRequest{}, errors.Wrapf(err, "%s %q", r.Kind, r.Name)
+	}
+
+	podRequest.Name = pod.Name
+	if service != nil {
+		podRequest.Ports = servicePodPorts(service, pod, r.Ports)
+	}
+
+	return podRequest, nil
+}
+
+// workloadSelector returns the pod selector for a workload.
+func workloadSelector(object *unstructured.Unstructured) (*metav1.LabelSelector, error) {
+	m, found, err := unstructured.NestedMap(object.Object, "spec", "selector")
+	if err != nil {
+		return nil, errors.Wrap(err, "get workload selector")
+	}
+	if !found {
+		return nil, errors.Errorf("%s %q does not have a selector", object.GetKind(), object.GetName())
+	}
 
-		return pod.Name, nil
-	default:
-		return "", errors.New("not implemented")
+	var selector metav1.LabelSelector
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(m, &selector); err != nil {
+		return nil, errors.Wrap(err, "convert workload selector")
+	}
+	if len(selector.MatchLabels) == 0 && len(selector.MatchExpressions) == 0 {
+		return nil, errors.Errorf("%s %q has an empty selector", object.GetKind(), object.GetName())
 	}
 
+	return &selector, nil
 }
 
-func (s *Service) findPodForService(ctx context.Context, apiVersion, kind, namespace, name string) (*corev1.Pod, error) {
+// findReadyPod finds a running and ready pod matching a selector. If more than one pod
+// matches, the oldest is chosen so repeated requests pick the same pod.
+func (s *Service) findReadyPod(ctx context.Context, namespace string, labelSelector *metav1.LabelSelector) (*corev1.Pod, error) {
 	o := s.opts.ObjectStore
 	if o == nil {
 		return nil, errors.New("nil objectstore")
 	}
 
-	key := store.Key{
-		APIVersion: apiVersion,
-		Kind:       kind,
-		Namespace:  namespace,
-		Name:       name,
-	}
-	var service corev1.Service
-	found, err := store.GetAs(ctx, o, key, &service)
-
+	selector, err := metav1.LabelSelectorAsSelector(labelSelector)
 	if err != nil {
-		return nil, err
-	}
-	if !found {
-		return nil, nil
+		return nil, errors.Wrap(err, "convert selector")
 	}
 
-	lbls := labels.Set(service.Spec.Selector)
-	key = store.Key{
-		APIVersion: apiVersion,
-		Kind:       "Pod",
-		Namespace:  namespace,
-		Selector:   &lbls,
+	key := store.Key{
+		APIVersion:    "v1",
+		Kind:          "Pod",
+		Namespace:     namespace,
+		LabelSelector: labelSelector,
 	}
 	list, _, err := o.List(ctx, key)
 	if err != nil {
 		return nil, err
 	}
 
+	var pods []*corev1.Pod
 	for i := range list.Items {
 		pod := &corev1.Pod{}
-
 		if err := kubernetes.FromUnstructured(&list.Items[i], pod); err != nil {
 			return nil, err
 		}
 
-		return pod, nil
+		if !selector.Matches(labels.Set(pod.Labels)) || !isPodReady(pod) {
+			continue
+		}
+		pods = append(pods, pod)
+	}
+
+	if len(pods) == 0 {
+		return nil, errors.New("no ready pods found")
+	}
+
+	sort.Slice(pods, func(i, j int) bool {
+		ti, tj := pods[i].CreationTimestamp, pods[j].CreationTimestamp
+		if !ti.Equal(&tj) {
+			return ti.Before(&tj)
+		}
+		return pods[i].Name < pods[j].Name
+	})
+
+	return pods[0], nil
+}
+
+// isPodReady returns true if a pod is running, ready, and not being deleted.
+func isPodReady(pod *corev1.Pod) bool {
+	if pod.DeletionTimestamp != nil || pod.Status.Phase != corev1.PodRunning {
+		return false
+	}
+
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodReady {
+			return condition.Status == corev1.ConditionTrue
+		}
 	}
 
-	return nil, errors.New("no matching pod found for service")
+	return false
+}
+
+// servicePodPorts translates service ports into the container ports they target, the
+// same as kubectl port-forward. Ports which are not service ports are assumed to be
+// container ports.
+func servicePodPorts(service *corev1.Service, pod *corev1.Pod, ports []PortForwardPortSpec) []PortForwardPortSpec {
+	var list []PortForwardPortSpec
+	for _, port := range ports {
+		remote := port.Remote
+
+		for _, servicePort := range service.Spec.Ports {
+			if servicePort.Port != int32(port.Remote) || servicePort.Protocol == corev1.ProtocolUDP {
+				continue
+			}
+
+			switch {
+			case servicePort.TargetPort.Type == intstr.String:
+				if containerPort, ok := namedContainerPort(pod, servicePort.TargetPort.StrVal); ok {
+					remote = uint16(containerPort)
+				}
+			case servicePort.TargetPort.IntVal != 0:
+				remote = uint16(servicePort.TargetPort.IntVal)
+			}
+		}
+
+		list = append(list, PortForwardPortSpec{Remote: remote, Local: port.Local})
+	}
+	return list
+}
+
+func namedContainerPort(pod *corev1.Pod, name string) (int32, bool) {
+	for _, container := range pod.Spec.Containers {
+		for _, port := range container.Ports {
+			if port.Name == name {
+				return port.ContainerPort, true
+			}
+		}
+	}
+	return 0, false
 }
 
 // verifyPod returns true if the specified pod can be found and is in the running phase.
@@ -304,11 +485,6 @@ func (s *Service) createForwarder(alerter action.Alerter, targetRequest, podRequ
 	forwarderID := randomUUID.String()
 	logger = logger.With("id", forwarderID)
 
-	var ports []string
-	for _, p := range podRequest.Ports {
-		ports = append(ports, fmt.Sprintf("%d:%d", p.Local, p.Remote))
-	}
-
 	// Target coordinates to preserve in state
 	targetGv, err := schema.ParseGroupVersion(targetRequest.APIVersion)
 	if err != nil {
@@ -316,33 +492,11 @@ func (s *Service) createForwarder(alerter action.Alerter, targetRequest, podRequ
 	}
 	targetGvk := targetGv.WithKind(targetRequest.Kind)
 
-	// Pod coordinates to preserve in state
-	podGv, err := schema.ParseGroupVersion(podRequest.APIVersion)
-	if err != nil {
-		return "", errors.Wrap(err, "parsing APIVersion")
-	}
-	podGvk := podGv.WithKind(podRequest.Kind)
-
 	// This child context will be cancelled if our parent context is cancelled
 	ctx, cancel := context.WithCancel(s.ctx)
 
-	// Spawns goroutine to update state as ports become available
-	portsChannel, portsReady := s.localPortsHandler(ctx, forwarderID)
-
-	o := &s.opts
-	opts := Options{
-		Config:        o.Config,
-		RESTClient:    o.RESTClient,
-		Address:       []string{"localhost"},
-		Ports:         ports,
-		PortForwarder: o.PortForwarder,
-		StopChannel:   ctx.Done(),
-		ReadyChannel:  make(chan struct{}),
-		PortsChannel:  portsChannel,
-	}
-
-	// NOTE: ports will be updated in the state struct by
-	// localPortsHandler when they become available.
+	// NOTE: pod and ports will be updated in the state struct by
+	// forward when they become available.
 	forwardState := State{
 		ID:        forwarderID,
 		CreatedAt: time.Now(),
@@ -351,11 +505,7 @@ func (s *Service) createForwarder(alerter action.Alerter, targetRequest, podRequ
 			Namespace: targetRequest.Namespace,
 			Name:      targetRequest.Name,
 		},
-		Pod: Target{
-			GVK:       podGvk,
-			Namespace: podRequest.Namespace,
-			Name:      podRequest.Name,
-		},
+		Status: StatusActive,
 
 		cancel: cancel,
 		ctx:    ctx,
@@ -365,22 +515,91 @@ func (s *Service) createForwarder(alerter action.Alerter, targetRequest, podRequ
 	s.state.portForwards[forwarderID] = forwardState
 	s.state.Unlock()
 
+	if canReconnect(targetGvk) {
+		s.watchPods(targetRequest.Namespace)
+	}
+
+	if err := s.forward(alerter, forwarderID, targetRequest, podRequest); err != nil {
+		logger.WithErr(err).Debugf("unable to start port-forward")
+		s.removeForwarder(forwarderID)
+		return "", err
+	}
+
+	return forwarderID, nil
+}
+
+// forward connects a port forward to a pod and blocks until its local ports are
+// listening. If the connection ends later, the port forward is reconnected to another
+// pod if its target allows it, otherwise it is removed.
+func (s *Service) forward(alerter action.Alerter, id string, targetRequest, podRequest CreateRequest) error {
+	logger := s.logger.With("context", "PortForwardService.forward", "id", id)
+
+	var ports []string
+	for _, p := range podRequest.Ports {
+		ports = append(ports, fmt.Sprintf("%d:%d", p.Local, p.Remote))
+	}
+
+	// Pod coordinates to preserve in state
+	podGv, err := schema.ParseGroupVersion(podRequest.APIVersion)
+	if err != nil {
+		return errors.Wrap(err, "parsing APIVersion")
+	}
+	podGvk := podGv.WithKind(podRequest.Kind)
+
+	s.state.Lock()
+	state, ok := s.state.portForwards[id]
+	if !ok {
+		s.state.Unlock()
+		return errors.Errorf("portforward stopped: %v", id)
+	}
+
+	// The connection is cancelled when the port forward is stopped or when the
+	// forwarded pod goes away.
+	connCtx, disconnect := context.WithCancel(state.ctx)
+
+	state.Pod = Target{
+		GVK:       podGvk,
+		Namespace: podRequest.Namespace,
+		Name:      podRequest.Name,
+	}
+	state.disconnect = disconnect
+	s.state.portForwards[id] = state
+	s.state.Unlock()
+
+	// Spawns goroutine to update state as ports become available
+	portsChannel, portsReady := s.localPortsHandler(connCtx, id)
+
+	o := &s.opts
+	opts := Options{
+		Config:        o.Config,
+		RESTClient:    o.RESTClient,
+		Address:       []string{"localhost"},
+		Ports:         ports,
+		PortForwarder: o.PortForwarder,
+		StopChannel:   connCtx.Done(),
+		ReadyChannel:  make(chan struct{}),
+		PortsChannel:  portsChannel,
+	}
+
 	req := o.RESTClient.Post().
 		Resource("pods").
 		Namespace(podRequest.Namespace).
 		Name(podRequest.Name).
 		SubResource("portforward")
 
+	terminated := make(chan error, 1)
+
 	go func() {
 		// Blocks until forwarder completes
 		logger.With("url", req.URL()).Debugf("starting port-forward")
 		err := s.opts.PortForwarder.ForwardPorts(alerter, "POST", req.URL(), opts)
 
 		logger.Debugf("forwarding terminated: %v", err)
+		disconnect()
 
 		// Notify the main forwarder of the termination
 		event := forwarderEvent{
-			ID:  forwarderID,
+			ID:  id,
 			err: err,
 		}
 		select {
@@ -388,18 +607,22 @@ func (s *Service) createForwarder(alerter action.Alerter, targetRequest, podRequ
 		default:
 		}
 
-		// Cleanup state for terminated port-forward
-		s.StopForwarder(forwarderID)
+		terminated <- err
 	}()
 
 	// Block until ports state is ready
 	select {
-	case <-ctx.Done():
-		return "", errors.Errorf("portforward terminated due to parent context: %v", forwarderID)
+	case <-connCtx.Done():
+		return errors.Errorf("portforward terminated due to parent context: %v", id)
 	case <-portsReady:
 	}
 
-	return forwarderID, nil
+	go func() {
+		err := <-terminated
+		s.disconnected(alerter, id, targetRequest, err)
+	}()
+
+	return nil
 }
 
 // responseForCreate creates a create response based on the state for the specified forward (by id)
@@ -467,7 +690,12 @@ func (s *Service) List(ctx context.Context) []State {
 
 	result := make([]State, 0, len(s.state.portForwards))
 	for i, pf := range s.state.portForwards {
+		// Port forwards which can reconnect find a new pod on their own.
 		targetPod := &pf.Pod
+		if canReconnect(pf.Target.GVK) {
+			result = append(result, pf.Clone())
+			continue
+		}
 		if verified, err := s.verifyPod(ctx, targetPod.Namespace, targetPod.Name); !verified || err != nil {
 			delete(s.state.portForwards, i)
 			continue
@@ -496,9 +724,120 @@ func (s *Service) Get(id string) (State, bool) {
 // Create creates a new port forward for the specified object and remote port.
 // Implements PortForwardInterface.
 func (s *Service) Create(ctx context.Context, alerter action.Alerter, gvk schema.GroupVersionKind, name string, namespace string, remotePort uint16) (CreateResponse, error) {
-	logger := s.logger.With("context", "PortForwardService.Create")
 	req := newForwardRequest(gvk, name, namespace, remotePort)
 
+	response, err := s.create(ctx, alerter, req)
+	if err != nil {
+		return emptyPortForwardResponse, err
+	}
+
+	s.saveDefinition(req, response)
+
+	return response, nil
+}
+
+// Restore re-establishes saved port forwards. Forwards reuse their previous local
+// port if it is available. Forwards which can't be restored are kept so they can be
+// retried the next time Octant starts.
+func (s *Service) Restore(ctx context.Context, alerter action.Alerter) {
+	logger := s.logger.With("context", "PortForwardService.Restore")
+
+	if s.opts.Definitions == nil {
+		return
+	}
+
+	definitions, err := s.opts.Definitions.Load()
+	if err != nil {
+		logger.WithErr(err).Errorf("loading saved port forwards")
+		return
+	}
+
+	for _, definition := range definitions {
+		req := newForwardRequest(definition.GroupVersionKind(), definition.Name, definition.Namespace, definition.RemotePort)
+		req.Ports[0].Local = definition.LocalPort
+
+		response, err := s.create(ctx, alerter, req)
+		if err != nil && definition.LocalPort != 0 {
+			logger.WithErr(err).Debugf("restoring port forward on local port %d", definition.LocalPort)
+			req.Ports[0].Local = 0
+			response, err = s.create(ctx, alerter, req)
+		}
+
+		if err != nil {
+			logger.WithErr(err).With("definition", definition.key()).Warnf("unable to restore port forward")
+
+			s.definitionsMu.Lock()
+			s.definitions[definition.key()] = definition
+			s.definitionsMu.Unlock()
+			continue
+		}
+
+		logger.With("definition", definition.key()).Infof("restored port forward")
+		s.saveDefinition(req, response)
+	}
+}
+
+// saveDefinition records a port forward a user created and saves definitions.
+func (s *Service) saveDefinition(req CreateRequest, response CreateResponse) {
+	if s.opts.Definitions == nil {
+		return
+	}
+
+	definition := Definition{
+		APIVersion: req.APIVersion,
+		Kind:       req.Kind,
+		Namespace:  req.Namespace,
+		Name:       req.Name,
+		RemotePort: req.Ports[0].Remote,
+	}
+	if len(response.Ports) > 0 {
+		definition.LocalPort = response.Ports[0].Local
+	}
+
+	s.definitionsMu.Lock()
+	defer s.definitionsMu.Unlock()
+
+	s.definitions[definition.key()] = definition
+	s.definitionIDs[response.ID] = definition.key()
+	s.writeDefinitions()
+}
+
+// deleteDefinition removes the definition for a port forward and saves definitions.
+func (s *Service) deleteDefinition(id string) {
+	if s.opts.Definitions == nil {
+		return
+	}
+
+	s.definitionsMu.Lock()
+	defer s.definitionsMu.Unlock()
+
+	key, ok := s.definitionIDs[id]
+	if !ok {
+		return
+	}
+
+	delete(s.definitionIDs, id)
+	delete(s.definitions, key)
+	s.writeDefinitions()
+}
+
+// writeDefinitions saves definitions. The caller must hold definitionsMu.
+func (s *Service) writeDefinitions() {
+	definitions := make([]Definition, 0, len(s.definitions))
+	for _, definition := range s.definitions {
+		definitions = append(definitions, definition)
+	}
+	sortDefinitions(definitions)
+
+	if err := s.opts.Definitions.Save(definitions); err != nil {
+		s.logger.WithErr(err).Errorf("saving port forwards")
+	}
+}
+
+// create resolves a request to a pod and starts forwarding to it.
+func (s *Service) create(ctx context.Context, alerter action.Alerter, req CreateRequest) (CreateResponse, error) {
+	logger := s.logger.With("context", "PortForwardService.Create")
+
 	if err := s.validateCreateRequest(req); err != nil {
 		return emptyPortForwardResponse, errors.Wrap(err, "invalid request")
 	}
@@ -510,22 +849,13 @@ func (s *Service) Create(ctx context.Context, alerter action.Alerter, gvk schema
 		"name", req.Name,
 		"namespace", req.Namespace,
 	).Debugf("resolving pod from object")
-	podName, err := s.resolvePod(ctx, req)
+	podReq, err := s.resolvePod(ctx, req)
 	if err != nil {
 		return emptyPortForwardResponse, errors.Wrap(err, "resolving pod")
 	}
-	logger.Debugf("resolved to pod %q", podName)
-	podReq := req
-	podReq.Name = podName
-	podReq.Kind = "Pod"
+	logger.Debugf("resolved to pod %q", podReq.Name)
 
-	id, err := s.createForwarder(alerter, req, CreateRequest{
-		Namespace:  req.Namespace,
-		APIVersion: req.APIVersion,
-		Kind:       "Pod",
-		Name:       podName,
-		Ports:      req.Ports,
-	})
+	id, err := s.createForwarder(alerter, req, podReq)
 	if err != nil {
 		return emptyPortForwardResponse, errors.Wrap(err, "creating forwarder")
 	}
@@ -539,9 +869,16 @@ func (s *Service) Create(ctx context.Context, alerter action.Alerter, gvk schema
 	return response, nil
 }
 
-// StopForwarder stops an individual port forward specified by id.
+// StopForwarder stops an individual port forward specified by id. The port forward
+// will not be restored when Octant restarts.
 // Implements PortForwardInterface.
 func (s *Service) StopForwarder(id string) {
+	s.deleteDefinition(id)
+	s.removeForwarder(id)
+}
+
+// removeForwarder stops a port forward and removes its state.
+func (s *Service) removeForwarder(id string) {
 	s.state.Lock()
 	defer s.state.Unlock()
 
@@ -606,6 +943,21 @@ func (s *Service) FindPod(namespace string, gvk schema.GroupVersionKind, name st
 	return result, &notFound{}
 }
 
+// forwardableKinds are the kinds port forwards can be created for.
+var forwardableKinds = map[schema.GroupVersionKind]bool{
+	{Version: "v1", Kind: "Pod"}:                        true,
+	{Version: "v1", Kind: "Service"}:                    true,
+	{Group: "apps", Version: "v1", Kind: "Deployment"}:  true,
+	{Group: "apps", Version: "v1", Kind: "StatefulSet"}: true,
+	{Group: "apps", Version: "v1", Kind: "DaemonSet"}:   true,
+	{Group: "apps", Version: "v1", Kind: "ReplicaSet"}:  true,
+}
+
+// IsForwardable returns true if port forwards can be created for a kind.
+func IsForwardable(gvk schema.GroupVersionKind) bool {
+	return forwardableKinds[gvk]
+}
+
 // newForwardRequest constructs a port forwarding request based on the provided parameters
 func newForwardRequest(gvk schema.GroupVersionKind, name string, namespace string, remotePort uint16) CreateRequest {
 	APIVersion, kind := gvk.ToAPIVersionAndKind()
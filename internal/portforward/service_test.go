@@ -0,0 +1,338 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package portforward
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/rest"
+
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	storeFake "github.com/vmware-tanzu/octant/pkg/store/fake"
+)
+
+// stubForwarder reports a local port for each requested port and blocks until stopped.
+type stubForwarder struct {
+	urls chan *url.URL
+}
+
+var _ portForwarder = (*stubForwarder)(nil)
+
+func (f *stubForwarder) ForwardPorts(_ action.Alerter, _ string, u *url.URL, opts Options) error {
+	if f.urls != nil {
+		f.urls <- u
+	}
+
+	var ports []ForwardedPort
+	for i := range opts.Ports {
+		ports = append(ports, ForwardedPort{Local: uint16(40000 + i), Remote: remotePort(opts.Ports[i])})
+	}
+
+	close(opts.ReadyChannel)
+	opts.PortsChannel <- ports
+
+	<-opts.StopChannel
+	return nil
+}
+
+// remotePort returns the remote port of a local:remote port spec.
+func remotePort(spec string) uint16 {
+	parts := strings.Split(spec, ":")
+	port, _ := strconv.ParseUint(parts[len(parts)-1], 10, 16)
+	return uint16(port)
+}
+
+func runningPod(namespace, name string) *corev1.Pod {
+	pod := testutil.CreatePod(name)
+	pod.Namespace = namespace
+	pod.Status.Phase = corev1.PodRunning
+	return pod
+}
+
+func readyPod(namespace, name string, created time.Time, podLabels map[string]string) *corev1.Pod {
+	pod := runningPod(namespace, name)
+	pod.CreationTimestamp = metav1.NewTime(created)
+	pod.Labels = podLabels
+	pod.Status.Conditions = []corev1.PodCondition{
+		{Type: corev1.PodReady, Status: corev1.ConditionTrue},
+	}
+	return pod
+}
+
+func podKey(namespace, name string) store.Key {
+	return store.Key{APIVersion: "v1", Kind: "Pod", Namespace: namespace, Name: name}
+}
+
+func TestService_Create(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	pod := runningPod("default", "pod")
+
+	objectStore := storeFake.NewMockStore(controller)
+	objectStore.EXPECT().
+		Get(gomock.Any(), podKey("default", "pod")).
+		Return(testutil.ToUnstructured(t, pod), nil).
+		AnyTimes()
+
+	forwarder := &stubForwarder{urls: make(chan *url.URL, 1)}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s := New(ctx, ServiceOptions{
+		RESTClient:    &rest.RESTClient{},
+		ObjectStore:   objectStore,
+		PortForwarder: forwarder,
+	})
+	defer s.Stop()
+
+	resp, err := s.Create(ctx, action.NewAlerter(), schema.GroupVersionKind{Version: "v1", Kind: "Pod"}, "pod", "default", 8080)
+	require.NoError(t, err)
+
+	assert.NotEmpty(t, resp.ID)
+	assert.Equal(t, []PortForwardPortSpec{{Remote: 8080, Local: 40000}}, resp.Ports)
+	assert.Equal(t, "/namespaces/default/pods/pod/portforward", (<-forwarder.urls).Path)
+
+	state, ok := s.Get(resp.ID)
+	require.True(t, ok)
+	assert.Equal(t, "pod", state.Pod.Name)
+	assert.Equal(t, "Pod", state.Target.GVK.Kind)
+
+	list := s.List(ctx)
+	require.Len(t, list, 1)
+	assert.Equal(t, resp.ID, list[0].ID)
+
+	found, _ := s.FindPod("default", schema.GroupVersionKind{Version: "v1", Kind: "Pod"}, "pod")
+	require.Len(t, found, 1)
+
+	s.StopForwarder(resp.ID)
+
+	_, ok = s.Get(resp.ID)
+	assert.False(t, ok)
+	assert.Empty(t, s.List(ctx))
+}
+
+func TestService_Create_podNotRunning(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	pod := runningPod("default", "pod")
+	pod.Status.Phase = corev1.PodPending
+
+	objectStore := storeFake.NewMockStore(controller)
+	objectStore.EXPECT().
+		Get(gomock.Any(), podKey("default", "pod")).
+		Return(testutil.ToUnstructured(t, pod), nil)
+
+	ctx := context.Background()
+	s := New(ctx, ServiceOptions{
+		RESTClient:    &rest.RESTClient{},
+		ObjectStore:   objectStore,
+		PortForwarder: &stubForwarder{},
+	})
+	defer s.Stop()
+
+	_, err := s.Create(ctx, action.NewAlerter(), schema.GroupVersionKind{Version: "v1", Kind: "Pod"}, "pod", "default", 8080)
+	require.Error(t, err)
+}
+
+func TestService_Create_service(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	podLabels := map[string]string{"app": "web"}
+	now := time.Now()
+
+	service := testutil.CreateService("web")
+	service.Namespace = "default"
+	service.Spec.Selector = podLabels
+	service.Spec.Ports = []corev1.ServicePort{
+		{Port: 80, Protocol: corev1.ProtocolTCP, TargetPort: intstr.FromString("http")},
+	}
+
+	notReady := readyPod("default", "not-ready", now.Add(-time.Hour), podLabels)
+	notReady.Status.Conditions[0].Status = corev1.ConditionFalse
+
+	newer := readyPod("default", "newer", now, podLabels)
+	older := readyPod("default", "older", now.Add(-time.Minute), podLabels)
+	older.Spec.Containers = []corev1.Container{
+		{Name: "web", Ports: []corev1.ContainerPort{{Name: "http", ContainerPort: 8080}}},
+	}
+
+	objectStore := storeFake.NewMockStore(controller)
+	objectStore.EXPECT().
+		Get(gomock.Any(), store.Key{APIVersion: "v1", Kind: "Service", Namespace: "default", Name: "web"}).
+		Return(testutil.ToUnstructured(t, service), nil)
+	objectStore.EXPECT().
+		List(gomock.Any(), store.Key{
+			APIVersion:    "v1",
+			Kind:          "Pod",
+			Namespace:     "default",
+			LabelSelector: &metav1.LabelSelector{MatchLabels: podLabels},
+		}).
+		Return(testutil.ToUnstructuredList(t, newer, notReady, older), false, nil)
+	objectStore.EXPECT().
+		Watch(gomock.Any(), store.Key{APIVersion: "v1", Kind: "Pod", Namespace: "default"}, gomock.Any()).
+		Return(storeFake.NewMockWatchHandle(controller), nil)
+
+	forwarder := &stubForwarder{urls: make(chan *url.URL, 1)}
+
+	ctx := context.Background()
+	s := New(ctx, ServiceOptions{
+		RESTClient:    &rest.RESTClient{},
+		ObjectStore:   objectStore,
+		PortForwarder: forwarder,
+	})
+	defer s.Stop()
+
+	resp, err := s.Create(ctx, action.NewAlerter(), schema.GroupVersionKind{Version: "v1", Kind: "Service"}, "web", "default", 80)
+	require.NoError(t, err)
+
+	assert.Equal(t, []PortForwardPortSpec{{Remote: 8080, Local: 40000}}, resp.Ports)
+	assert.Equal(t, "/namespaces/default/pods/older/portforward", (<-forwarder.urls).Path)
+
+	state, ok := s.Get(resp.ID)
+	require.True(t, ok)
+	assert.Equal(t, "Service", state.Target.GVK.Kind)
+	assert.Equal(t, "older", state.Pod.Name)
+}
+
+func TestService_Create_deployment(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	podLabels := map[string]string{"app": "api"}
+	selector := &metav1.LabelSelector{MatchLabels: podLabels}
+
+	deployment := testutil.CreateDeployment("api")
+	deployment.Namespace = "default"
+	deployment.Spec.Selector = selector
+
+	pod := readyPod("default", "api-1234", time.Now(), podLabels)
+
+	objectStore := storeFake.NewMockStore(controller)
+	objectStore.EXPECT().
+		Get(gomock.Any(), store.Key{APIVersion: "apps/v1", Kind: "Deployment", Namespace: "default", Name: "api"}).
+		Return(testutil.ToUnstructured(t, deployment), nil)
+	objectStore.EXPECT().
+		List(gomock.Any(), store.Key{APIVersion: "v1", Kind: "Pod", Namespace: "default", LabelSelector: selector}).
+		Return(testutil.ToUnstructuredList(t, pod), false, nil)
+	objectStore.EXPECT().
+		Watch(gomock.Any(), store.Key{APIVersion: "v1", Kind: "Pod", Namespace: "default"}, gomock.Any()).
+		Return(storeFake.NewMockWatchHandle(controller), nil)
+
+	forwarder := &stubForwarder{urls: make(chan *url.URL, 1)}
+
+	ctx := context.Background()
+	s := New(ctx, ServiceOptions{
+		RESTClient:    &rest.RESTClient{},
+		ObjectStore:   objectStore,
+		PortForwarder: forwarder,
+	})
+	defer s.Stop()
+
+	gvk := schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}
+	resp, err := s.Create(ctx, action.NewAlerter(), gvk, "api", "default", 8080)
+	require.NoError(t, err)
+
+	assert.Equal(t, []PortForwardPortSpec{{Remote: 8080, Local: 40000}}, resp.Ports)
+	assert.Equal(t, "/namespaces/default/pods/api-1234/portforward", (<-forwarder.urls).Path)
+
+	found, _ := s.FindTarget("default", gvk, "api")
+	require.Len(t, found, 1)
+}
+
+func TestService_Create_noReadyPods(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	podLabels := map[string]string{"app": "api"}
+	selector := &metav1.LabelSelector{MatchLabels: podLabels}
+
+	deployment := testutil.CreateDeployment("api")
+	deployment.Namespace = "default"
+	deployment.Spec.Selector = selector
+
+	deleting := readyPod("default", "api-1234", time.Now(), podLabels)
+	deletedAt := metav1.Now()
+	deleting.DeletionTimestamp = &deletedAt
+
+	objectStore := storeFake.NewMockStore(controller)
+	objectStore.EXPECT().
+		Get(gomock.Any(), store.Key{APIVersion: "apps/v1", Kind: "Deployment", Namespace: "default", Name: "api"}).
+		Return(testutil.ToUnstructured(t, deployment), nil)
+	objectStore.EXPECT().
+		List(gomock.Any(), gomock.Any()).
+		Return(testutil.ToUnstructuredList(t, deleting), false, nil)
+
+	ctx := context.Background()
+	s := New(ctx, ServiceOptions{
+		RESTClient:    &rest.RESTClient{},
+		ObjectStore:   objectStore,
+		PortForwarder: &stubForwarder{},
+	})
+	defer s.Stop()
+
+	gvk := schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}
+	_, err := s.Create(ctx, action.NewAlerter(), gvk, "api", "default", 8080)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no ready pods found")
+}
+
+func TestService_Create_invalid(t *testing.T) {
+	ctx := context.Background()
+	s := New(ctx, ServiceOptions{PortForwarder: &stubForwarder{}})
+	defer s.Stop()
+
+	gvk := schema.GroupVersionKind{Group: "batch", Version: "v1", Kind: "Job"}
+	_, err := s.Create(ctx, action.NewAlerter(), gvk, "job", "default", 8080)
+	require.Error(t, err)
+}
+
+func TestService_Stop(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	pod := runningPod("default", "pod")
+
+	objectStore := storeFake.NewMockStore(controller)
+	objectStore.EXPECT().
+		Get(gomock.Any(), podKey("default", "pod")).
+		Return(testutil.ToUnstructured(t, pod), nil).
+		AnyTimes()
+
+	ctx := context.Background()
+	s := New(ctx, ServiceOptions{
+		RESTClient:    &rest.RESTClient{},
+		ObjectStore:   objectStore,
+		PortForwarder: &stubForwarder{},
+	})
+
+	for _, port := range []uint16{80, 443} {
+		_, err := s.Create(ctx, action.NewAlerter(), schema.GroupVersionKind{Version: "v1", Kind: "Pod"}, "pod", "default", port)
+		require.NoError(t, err)
+	}
+	require.Len(t, s.List(ctx), 2)
+
+	s.Stop()
+
+	assert.Empty(t, s.List(ctx))
+}
@@ -0,0 +1,280 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package preferences
+
+import (
+	"fmt"
+	"sync"
+
+	internalLog "github.com/vmware-tanzu/octant/internal/log"
+	dashstrings "github.com/vmware-tanzu/octant/internal/util/strings"
+	"github.com/vmware-tanzu/octant/pkg/log"
+)
+
+//go:generate mockgen -source=manager.go -destination=./fake/mock_manager.go -package=fake github.com/vmware-tanzu/octant/internal/preferences Manager
+
+// DefaultMaxRecentNamespaces is the number of recently visited namespaces which are kept.
+const DefaultMaxRecentNamespaces = 10
+
+// Manager manages a user's preferences.
+type Manager interface {
+	// Get returns the current preferences.
+	Get() Preferences
+	// SetUI replaces the UI preferences.
+	SetUI(ui UIPreferences) error
+	// SetTime replaces the time preferences.
+	SetTime(timePreferences TimePreferences) error
+	// VisitNamespace records a visit to a namespace.
+	VisitNamespace(namespace string) error
+	// FavoriteNamespace pins a namespace.
+	FavoriteNamespace(namespace string) error
+	// UnfavoriteNamespace unpins a namespace.
+	UnfavoriteNamespace(namespace string) error
+	// AddBookmark bookmarks an object.
+	AddBookmark(bookmark Bookmark) error
+	// RemoveBookmark removes a bookmark.
+	RemoveBookmark(bookmark Bookmark) error
+	// Subscribe sends preferences to ch whenever they change. The returned function
+	// unsubscribes ch.
+	Subscribe(ch chan<- Preferences) func()
+}
+
+// ServiceOption is an option for configuring Service.
+type ServiceOption func(s *Service)
+
+// WithMaxRecentNamespaces sets the number of recently visited namespaces which are kept.
+func WithMaxRecentNamespaces(max int) ServiceOption {
+	return func(s *Service) {
+		s.maxRecentNamespaces = max
+	}
+}
+
+// WithLogger configures the logger.
+func WithLogger(logger log.Logger) ServiceOption {
+	return func(s *Service) {
+		s.logger = logger
+	}
+}
+
+// Service is a Manager. Changes are saved to a store if one is configured.
+type Service struct {
+	store               Store
+	logger              log.Logger
+	maxRecentNamespaces int
+
+	preferences      Preferences
+	subscribers      map[int]chan<- Preferences
+	nextSubscriberID int
+
+	mu sync.Mutex
+}
+
+var _ Manager = (*Service)(nil)
+
+// NewService creates an instance of Service. Saved preferences are loaded from store.
+// Preferences are only kept in memory if store is nil.
+func NewService(store Store, options ...ServiceOption) *Service {
+	s := &Service{
+		store:               store,
+		logger:              internalLog.NopLogger(),
+		maxRecentNamespaces: DefaultMaxRecentNamespaces,
+		subscribers:         map[int]chan<- Preferences{},
+	}
+
+	for _, option := range options {
+		option(s)
+	}
+
+	if store != nil {
+		preferences, err := store.Load()
+		if err != nil {
+			s.logger.WithErr(err).Warnf("unable to load preferences")
+		}
+		s.preferences = preferences
+	}
+	s.preferences.UI = s.preferences.UI.withDefaults()
+	s.preferences.Time = s.preferences.Time.withDefaults()
+
+	return s
+}
+
+// Get returns the current preferences.
+func (s *Service) Get() Preferences {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.preferences.Clone()
+}
+
+// SetUI replaces the UI preferences. Blank settings are reset to their defaults.
+func (s *Service) SetUI(ui UIPreferences) error {
+	if err := ui.Validate(); err != nil {
+		return err
+	}
+	ui = ui.withDefaults()
+
+	return s.update(func(p *Preferences) bool {
+		if p.UI == ui {
+			return false
+		}
+		p.UI = ui
+		return true
+	})
+}
+
+// SetTime replaces the time preferences. A blank format is reset to relative.
+func (s *Service) SetTime(timePreferences TimePreferences) error {
+	if err := timePreferences.Validate(); err != nil {
+		return err
+	}
+	timePreferences = timePreferences.withDefaults()
+
+	return s.update(func(p *Preferences) bool {
+		if p.Time == timePreferences {
+			return false
+		}
+		p.Time = timePreferences
+		return true
+	})
+}
+
+// VisitNamespace moves a namespace to the front of the recent namespaces.
+func (s *Service) VisitNamespace(namespace string) error {
+	if namespace == "" {
+		return nil
+	}
+
+	return s.update(func(p *Preferences) bool {
+		recent := p.Namespaces.Recent
+		if len(recent) > 0 && recent[0] == namespace {
+			return false
+		}
+
+		recent = append([]string{namespace}, remove(recent, namespace)...)
+		if s.maxRecentNamespaces > 0 && len(recent) > s.maxRecentNamespaces {
+			recent = recent[:s.maxRecentNamespaces]
+		}
+		p.Namespaces.Recent = recent
+		return true
+	})
+}
+
+// FavoriteNamespace pins a namespace.
+func (s *Service) FavoriteNamespace(namespace string) error {
+	if namespace == "" {
+		return fmt.Errorf("namespace is blank")
+	}
+
+	return s.update(func(p *Preferences) bool {
+		if dashstrings.Contains(namespace, p.Namespaces.Favorites) {
+			return false
+		}
+		p.Namespaces.Favorites = append(p.Namespaces.Favorites, namespace)
+		return true
+	})
+}
+
+// UnfavoriteNamespace unpins a namespace.
+func (s *Service) UnfavoriteNamespace(namespace string) error {
+	return s.update(func(p *Preferences) bool {
+		if !dashstrings.Contains(namespace, p.Namespaces.Favorites) {
+			return false
+		}
+		p.Namespaces.Favorites = remove(p.Namespaces.Favorites, namespace)
+		return true
+	})
+}
+
+// AddBookmark bookmarks an object. Bookmarks are kept in the order they were added.
+func (s *Service) AddBookmark(bookmark Bookmark) error {
+	if err := bookmark.Validate(); err != nil {
+		return err
+	}
+
+	return s.update(func(p *Preferences) bool {
+		for i := range p.Bookmarks {
+			if p.Bookmarks[i] == bookmark {
+				return false
+			}
+		}
+		p.Bookmarks = append(p.Bookmarks, bookmark)
+		return true
+	})
+}
+
+// RemoveBookmark removes a bookmark.
+func (s *Service) RemoveBookmark(bookmark Bookmark) error {
+	return s.update(func(p *Preferences) bool {
+		var bookmarks []Bookmark
+		for i := range p.Bookmarks {
+			if p.Bookmarks[i] != bookmark {
+				bookmarks = append(bookmarks, p.Bookmarks[i])
+			}
+		}
+		if len(bookmarks) == len(p.Bookmarks) {
+			return false
+		}
+		p.Bookmarks = bookmarks
+		return true
+	})
+}
+
+// Subscribe sends preferences to ch whenever they change. Updates are dropped if ch
+// is not ready to receive them.
+func (s *Service) Subscribe(ch chan<- Preferences) func() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.nextSubscriberID++
+	id := s.nextSubscriberID
+	s.subscribers[id] = ch
+
+	return func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		delete(s.subscribers, id)
+	}
+}
+
+// update changes the preferences with fn. The preferences are saved and sent to
+// subscribers if fn returns true.
+func (s *Service) update(fn func(p *Preferences) bool) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	preferences := s.preferences.Clone()
+	if !fn(&preferences) {
+		return nil
+	}
+
+	if s.store != nil {
+		if err := s.store.Save(preferences); err != nil {
+			return err
+		}
+	}
+
+	s.preferences = preferences
+
+	for _, ch := range s.subscribers {
+		select {
+		case ch <- preferences.Clone():
+		default:
+		}
+	}
+
+	return nil
+}
+
+func remove(list []string, s string) []string {
+	var out []string
+	for i := range list {
+		if list[i] != s {
+			out = append(out, list[i])
+		}
+	}
+	return out
+}
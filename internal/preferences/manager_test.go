@@ -0,0 +1,142 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package preferences
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type errorStore struct{}
+
+func (errorStore) Load() (Preferences, error) {
+	return Preferences{}, nil
+}
+
+func (errorStore) Save(Preferences) error {
+	return fmt.Errorf("save failed")
+}
+
+func TestService_VisitNamespace(t *testing.T) {
+	s := NewService(nil, WithMaxRecentNamespaces(3))
+
+	for _, namespace := range []string{"a", "b", "c", "b", "d", ""} {
+		require.NoError(t, s.VisitNamespace(namespace))
+	}
+
+	assert.Equal(t, []string{"d", "b", "c"}, s.Get().Namespaces.Recent)
+}
+
+func TestService_FavoriteNamespace(t *testing.T) {
+	s := NewService(nil)
+
+	require.NoError(t, s.FavoriteNamespace("payments"))
+	require.NoError(t, s.FavoriteNamespace("default"))
+	require.NoError(t, s.FavoriteNamespace("payments"))
+	assert.Equal(t, []string{"payments", "default"}, s.Get().Namespaces.Favorites)
+
+	require.NoError(t, s.UnfavoriteNamespace("payments"))
+	require.NoError(t, s.UnfavoriteNamespace("missing"))
+	assert.Equal(t, []string{"default"}, s.Get().Namespaces.Favorites)
+
+	require.Error(t, s.FavoriteNamespace(""))
+}
+
+func TestService_persists(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	store := NewFileStore(fs, "/preferences.json")
+
+	s := NewService(store)
+	require.NoError(t, s.VisitNamespace("default"))
+	require.NoError(t, s.FavoriteNamespace("payments"))
+
+	restored := NewService(store)
+	assert.Equal(t, s.Get(), restored.Get())
+}
+
+func TestService_saveError(t *testing.T) {
+	s := NewService(errorStore{})
+
+	require.Error(t, s.FavoriteNamespace("payments"))
+	assert.Empty(t, s.Get().Namespaces.Favorites)
+}
+
+func TestService_Subscribe(t *testing.T) {
+	s := NewService(nil)
+
+	ch := make(chan Preferences, 1)
+	unsubscribe := s.Subscribe(ch)
+
+	require.NoError(t, s.VisitNamespace("default"))
+	got := <-ch
+	assert.Equal(t, []string{"default"}, got.Namespaces.Recent)
+
+	// Visiting the current namespace again is not a change.
+	require.NoError(t, s.VisitNamespace("default"))
+	assert.Len(t, ch, 0)
+
+	unsubscribe()
+	require.NoError(t, s.VisitNamespace("other"))
+	assert.Len(t, ch, 0)
+}
+
+func TestService_AddBookmark(t *testing.T) {
+	s := NewService(nil)
+
+	deployment := Bookmark{APIVersion: "apps/v1", Kind: "Deployment", Namespace: "default", Name: "nginx"}
+	node := Bookmark{APIVersion: "v1", Kind: "Node", Name: "node"}
+
+	require.NoError(t, s.AddBookmark(deployment))
+	require.NoError(t, s.AddBookmark(node))
+	require.NoError(t, s.AddBookmark(deployment))
+	assert.Equal(t, []Bookmark{deployment, node}, s.Get().Bookmarks)
+
+	require.NoError(t, s.RemoveBookmark(deployment))
+	assert.Equal(t, []Bookmark{node}, s.Get().Bookmarks)
+
+	require.Error(t, s.AddBookmark(Bookmark{Kind: "Deployment", Name: "nginx"}))
+}
+
+func TestService_SetUI(t *testing.T) {
+	s := NewService(nil)
+	assert.Equal(t, DefaultUIPreferences(), s.Get().UI)
+
+	ch := make(chan Preferences, 1)
+	defer s.Subscribe(ch)()
+
+	require.NoError(t, s.SetUI(UIPreferences{Theme: ThemeDark, LandingPage: "/workloads/"}))
+
+	expected := UIPreferences{
+		Theme:        ThemeDark,
+		TableDensity: TableDensityComfortable,
+		LandingPage:  "workloads",
+		ListLimit:    DefaultListLimit,
+	}
+	assert.Equal(t, expected, s.Get().UI)
+	assert.Equal(t, expected, (<-ch).UI)
+
+	require.Error(t, s.SetUI(UIPreferences{Theme: "sepia"}))
+	require.Error(t, s.SetUI(UIPreferences{TableDensity: "tiny"}))
+	require.Error(t, s.SetUI(UIPreferences{ListLimit: -1}))
+	assert.Equal(t, expected, s.Get().UI)
+}
+
+func TestService_SetTime(t *testing.T) {
+	s := NewService(nil)
+	assert.Equal(t, TimePreferences{Format: TimeFormatRelative}, s.Get().Time)
+
+	expected := TimePreferences{Format: TimeFormatAbsolute, TimeZone: "America/New_York"}
+	require.NoError(t, s.SetTime(expected))
+	assert.Equal(t, expected, s.Get().Time)
+
+	require.Error(t, s.SetTime(TimePreferences{Format: "fuzzy"}))
+	require.Error(t, s.SetTime(TimePreferences{TimeZone: "Mars/Olympus_Mons"}))
+	assert.Equal(t, expected, s.Get().Time)
+}
@@ -0,0 +1,263 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package preferences
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/afero"
+
+	"github.com/vmware-tanzu/octant/internal/persistence"
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+const (
+	// ThemeLight is the light theme.
+	ThemeLight = "light"
+	// ThemeDark is the dark theme.
+	ThemeDark = "dark"
+
+	// TableDensityComfortable shows table rows with padding.
+	TableDensityComfortable = "comfortable"
+	// TableDensityCompact shows table rows without padding.
+	TableDensityCompact = "compact"
+
+	// DefaultLandingPage is the content path the dashboard opens to.
+	DefaultLandingPage = "overview"
+
+	// DefaultListLimit is the most rows a list table shows before the rest are hidden
+	// behind "Show more".
+	DefaultListLimit = 500
+
+	// TimeFormatRelative shows times as the time since they happened.
+	TimeFormatRelative = "relative"
+	// TimeFormatAbsolute shows times as a date and time.
+	TimeFormatAbsolute = "absolute"
+)
+
+// Preferences are settings a user made in the dashboard.
+type Preferences struct {
+	UI         UIPreferences        `json:"ui"`
+	Time       TimePreferences      `json:"time"`
+	Namespaces NamespacePreferences `json:"namespaces"`
+	Bookmarks  []Bookmark           `json:"bookmarks"`
+}
+
+// UIPreferences are settings for the dashboard's appearance.
+type UIPreferences struct {
+	Theme        string `json:"theme"`
+	TableDensity string `json:"tableDensity"`
+	// LandingPage is the content path the dashboard opens to.
+	LandingPage string `json:"landingPage"`
+	// ListLimit is the most rows a list table shows before the rest are hidden behind
+	// "Show more".
+	ListLimit int `json:"listLimit"`
+}
+
+// DefaultUIPreferences returns the UI preferences used when the user hasn't changed them.
+func DefaultUIPreferences() UIPreferences {
+	return UIPreferences{
+		Theme:        ThemeLight,
+		TableDensity: TableDensityComfortable,
+		LandingPage:  DefaultLandingPage,
+		ListLimit:    DefaultListLimit,
+	}
+}
+
+// Validate returns an error if a setting has an unknown value.
+func (u UIPreferences) Validate() error {
+	switch u.Theme {
+	case "", ThemeLight, ThemeDark:
+	default:
+		return fmt.Errorf("unknown theme %q", u.Theme)
+	}
+
+	switch u.TableDensity {
+	case "", TableDensityComfortable, TableDensityCompact:
+	default:
+		return fmt.Errorf("unknown table density %q", u.TableDensity)
+	}
+
+	if u.ListLimit < 0 {
+		return fmt.Errorf("list limit %d is negative", u.ListLimit)
+	}
+
+	return nil
+}
+
+// withDefaults fills in settings which the user hasn't made.
+func (u UIPreferences) withDefaults() UIPreferences {
+	defaults := DefaultUIPreferences()
+	if u.Theme == "" {
+		u.Theme = defaults.Theme
+	}
+	if u.TableDensity == "" {
+		u.TableDensity = defaults.TableDensity
+	}
+	u.LandingPage = strings.Trim(u.LandingPage, "/")
+	if u.LandingPage == "" {
+		u.LandingPage = defaults.LandingPage
+	}
+	if u.ListLimit == 0 {
+		u.ListLimit = defaults.ListLimit
+	}
+	return u
+}
+
+// TimePreferences are settings for how times are shown.
+type TimePreferences struct {
+	// Format is relative or absolute.
+	Format string `json:"format"`
+	// TimeZone is the IANA time zone absolute times are shown in, e.g. America/New_York.
+	// The browser's time zone is used if it is blank.
+	TimeZone string `json:"timeZone,omitempty"`
+}
+
+// Validate returns an error if the format or time zone is unknown.
+func (t TimePreferences) Validate() error {
+	switch t.Format {
+	case "", TimeFormatRelative, TimeFormatAbsolute:
+	default:
+		return fmt.Errorf("unknown time format %q", t.Format)
+	}
+
+	if t.TimeZone != "" {
+		if _, err := time.LoadLocation(t.TimeZone); err != nil {
+			return fmt.Errorf("unknown time zone %q", t.TimeZone)
+		}
+	}
+
+	return nil
+}
+
+// withDefaults fills in settings which the user hasn't made.
+func (t TimePreferences) withDefaults() TimePreferences {
+	if t.Format == "" {
+		t.Format = TimeFormatRelative
+	}
+	return t
+}
+
+// NamespacePreferences are namespaces the user visited recently or pinned.
+type NamespacePreferences struct {
+	// Recent lists recently visited namespaces, most recent first.
+	Recent []string `json:"recent"`
+	// Favorites lists pinned namespaces in the order they were pinned.
+	Favorites []string `json:"favorites"`
+}
+
+// Bookmark is an object the user bookmarked. Namespace is blank for cluster scoped objects.
+type Bookmark struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Namespace  string `json:"namespace,omitempty"`
+	Name       string `json:"name"`
+}
+
+// Validate returns an error if the bookmark doesn't identify an object.
+func (b Bookmark) Validate() error {
+	if b.APIVersion == "" || b.Kind == "" || b.Name == "" {
+		return fmt.Errorf("bookmark requires an apiVersion, kind, and name")
+	}
+	return nil
+}
+
+// Key returns the object store key for the bookmarked object.
+func (b Bookmark) Key() store.Key {
+	return store.Key{
+		APIVersion: b.APIVersion,
+		Kind:       b.Kind,
+		Namespace:  b.Namespace,
+		Name:       b.Name,
+	}
+}
+
+// String returns the bookmark as kind namespace/name.
+func (b Bookmark) String() string {
+	if b.Namespace == "" {
+		return fmt.Sprintf("%s %s", b.Kind, b.Name)
+	}
+	return fmt.Sprintf("%s %s/%s", b.Kind, b.Namespace, b.Name)
+}
+
+// Clone returns a deep copy of the preferences.
+func (p Preferences) Clone() Preferences {
+	out := p
+	out.Namespaces.Recent = cloneStrings(p.Namespaces.Recent)
+	out.Namespaces.Favorites = cloneStrings(p.Namespaces.Favorites)
+	out.Bookmarks = make([]Bookmark, len(p.Bookmarks))
+	copy(out.Bookmarks, p.Bookmarks)
+	return out
+}
+
+func cloneStrings(in []string) []string {
+	out := make([]string, len(in))
+	copy(out, in)
+	return out
+}
+
+// Store loads and saves preferences.
+type Store interface {
+	// Load loads saved preferences.
+	Load() (Preferences, error)
+	// Save replaces saved preferences.
+	Save(preferences Preferences) error
+}
+
+// DocumentStore saves preferences to a persistence document as JSON.
+type DocumentStore struct {
+	document persistence.Document
+}
+
+var _ Store = (*DocumentStore)(nil)
+
+// NewDocumentStore creates an instance of DocumentStore.
+func NewDocumentStore(document persistence.Document) *DocumentStore {
+	return &DocumentStore{
+		document: document,
+	}
+}
+
+// NewFileStore creates a DocumentStore which saves preferences to a JSON file.
+func NewFileStore(fs afero.Fs, path string) *DocumentStore {
+	return NewDocumentStore(persistence.NewFileDocument(fs, path))
+}
+
+// Load loads saved preferences. A missing document has default preferences.
+func (s *DocumentStore) Load() (Preferences, error) {
+	data, err := s.document.Load()
+	if err != nil {
+		return Preferences{}, errors.Wrap(err, "load preferences")
+	}
+	if data == nil {
+		return Preferences{}, nil
+	}
+
+	var preferences Preferences
+	if err := json.Unmarshal(data, &preferences); err != nil {
+		return Preferences{}, errors.Wrap(err, "decode preferences")
+	}
+
+	return preferences, nil
+}
+
+// Save replaces saved preferences.
+func (s *DocumentStore) Save(preferences Preferences) error {
+	data, err := json.MarshalIndent(preferences, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "encode preferences")
+	}
+
+	if err := s.document.Save(data); err != nil {
+		return errors.Wrap(err, "save preferences")
+	}
+
+	return nil
+}
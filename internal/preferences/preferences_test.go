@@ -0,0 +1,61 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package preferences
+
+import (
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileStore(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	s := NewFileStore(fs, "/home/user/.octant/preferences.json")
+
+	got, err := s.Load()
+	require.NoError(t, err)
+	assert.Equal(t, Preferences{}, got)
+
+	expected := Preferences{
+		Namespaces: NamespacePreferences{
+			Recent:    []string{"payments", "default"},
+			Favorites: []string{"payments"},
+		},
+	}
+	require.NoError(t, s.Save(expected))
+
+	exists, err := afero.Exists(fs, "/home/user/.octant/preferences.json.tmp")
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	got, err = NewFileStore(fs, "/home/user/.octant/preferences.json").Load()
+	require.NoError(t, err)
+	assert.Equal(t, expected, got)
+}
+
+func TestFileStore_Load_invalid(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	require.NoError(t, afero.WriteFile(fs, "/preferences.json", []byte("{"), 0600))
+
+	_, err := NewFileStore(fs, "/preferences.json").Load()
+	require.Error(t, err)
+}
+
+func TestPreferences_Clone(t *testing.T) {
+	p := Preferences{
+		Namespaces: NamespacePreferences{
+			Recent: []string{"default"},
+		},
+	}
+
+	clone := p.Clone()
+	clone.Namespaces.Recent[0] = "changed"
+
+	assert.Equal(t, "default", p.Namespaces.Recent[0])
+	assert.NotNil(t, clone.Namespaces.Favorites)
+}
@@ -6,7 +6,9 @@ SPDX-License-Identifier: Apache-2.0
 package printer
 
 import (
+	"context"
 	"fmt"
+	"strings"
 
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
 
@@ -14,6 +16,7 @@ import (
 	"sigs.k8s.io/yaml"
 
 	"github.com/vmware-tanzu/octant/internal/gvk"
+	"github.com/vmware-tanzu/octant/internal/objectstatus"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
 
@@ -26,6 +29,67 @@ func printYaml(obj interface{}) string {
 	return string(b)
 }
 
+// admissionWebhookOverview is the subset of a validating or mutating webhook
+// shown in the webhook configuration overview.
+type admissionWebhookOverview struct {
+	name              string
+	clientConfig      admissionregistrationv1.WebhookClientConfig
+	failurePolicy     *admissionregistrationv1.FailurePolicyType
+	namespaceSelector *metav1.LabelSelector
+}
+
+func admissionWebhooksTable(ctx context.Context, webhooks []admissionWebhookOverview, options Options) (*component.Table, error) {
+	columns := component.NewTableCols("Name", "Client", "Failure Policy", "Namespace Selector", "Status")
+	table := component.NewTable("Webhooks", "There are no webhooks!", columns)
+
+	for _, webhook := range webhooks {
+		client, err := admissionWebhookClientConfig(webhook.clientConfig, options)
+		if err != nil {
+			return nil, err
+		}
+
+		status, err := admissionWebhookStatus(ctx, webhook.name, webhook.clientConfig, webhook.failurePolicy, options)
+		if err != nil {
+			return nil, err
+		}
+
+		table.Add(component.TableRow{
+			"Name":               component.NewText(webhook.name),
+			"Client":             client,
+			"Failure Policy":     admissionWebhookFailurePolicy(webhook.failurePolicy),
+			"Namespace Selector": admissionWebhookLabelSelector(webhook.namespaceSelector),
+			"Status":             status,
+		})
+	}
+
+	return table, nil
+}
+
+// admissionWebhookStatus describes the health of the service backing a webhook.
+func admissionWebhookStatus(ctx context.Context, name string, clientConfig admissionregistrationv1.WebhookClientConfig, failurePolicy *admissionregistrationv1.FailurePolicyType, options Options) (component.Component, error) {
+	status, err := objectstatus.AdmissionWebhook(ctx, options.DashConfig.ObjectStore(), name, clientConfig, failurePolicy)
+	if err != nil {
+		return nil, fmt.Errorf("get status for webhook %s: %w", name, err)
+	}
+
+	var details []string
+	for _, detail := range status.Details {
+		details = append(details, fmt.Sprint(detail))
+	}
+
+	text := component.NewText(strings.Join(details, ", "))
+	switch status.Status() {
+	case component.NodeStatusError:
+		text.SetStatus(component.TextStatusError)
+	case component.NodeStatusWarning:
+		text.SetStatus(component.TextStatusWarning)
+	default:
+		text.SetStatus(component.TextStatusOK)
+	}
+
+	return text, nil
+}
+
 func admissionWebhookRules(rules []admissionregistrationv1.RuleWithOperations, options Options) (component.Component, error) {
 	columns := component.NewTableCols("API Groups", "API Versions", "Resources", "Operations", "Scope")
 	table := component.NewTable("Rules", "There are no webhook rules!", columns)
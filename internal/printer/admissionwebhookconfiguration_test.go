@@ -6,9 +6,81 @@ SPDX-License-Identifier: Apache-2.0
 package printer
 
 import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vmware-tanzu/octant/pkg/store"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
 
+func Test_admissionWebhooksTable(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+	printOptions := tpo.ToOptions()
+
+	tpo.PathForGVK("default", "v1", "Service", "service", "default/service", "/service")
+	tpo.objectStore.EXPECT().
+		Get(gomock.Any(), store.Key{Namespace: "default", APIVersion: "v1", Kind: "Service", Name: "service"}).
+		Return(nil, nil)
+
+	url := "https://example.com/validate"
+	ignore := admissionregistrationv1.Ignore
+
+	webhooks := []admissionWebhookOverview{
+		{
+			name: "service.example.com",
+			clientConfig: admissionregistrationv1.WebhookClientConfig{
+				Service: &admissionregistrationv1.ServiceReference{Namespace: "default", Name: "service"},
+			},
+			namespaceSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"intercept": "true"},
+			},
+		},
+		{
+			name: "url.example.com",
+			clientConfig: admissionregistrationv1.WebhookClientConfig{
+				URL: &url,
+			},
+			failurePolicy: &ignore,
+		},
+	}
+
+	got, err := admissionWebhooksTable(context.Background(), webhooks, printOptions)
+	require.NoError(t, err)
+
+	missing := component.NewText(`Webhook "service.example.com" refers to service default/service which doesn't exist`)
+	missing.SetStatus(component.TextStatusError)
+	ok := component.NewText("Webhook is OK")
+	ok.SetStatus(component.TextStatusOK)
+
+	columns := component.NewTableCols("Name", "Client", "Failure Policy", "Namespace Selector", "Status")
+	expected := component.NewTableWithRows("Webhooks", "There are no webhooks!", columns, []component.TableRow{
+		{
+			"Name":               component.NewText("service.example.com"),
+			"Client":             component.NewLink("", "default/service", "/service"),
+			"Failure Policy":     component.NewText("Fail"),
+			"Namespace Selector": component.NewText("intercept:true"),
+			"Status":             missing,
+		},
+		{
+			"Name":               component.NewText("url.example.com"),
+			"Client":             component.NewText(url),
+			"Failure Policy":     component.NewText("Ignore"),
+			"Namespace Selector": component.NewText("*"),
+			"Status":             ok,
+		},
+	})
+
+	component.AssertEqual(t, expected, got)
+}
+
 func testWebhookRulesTable(rows ...component.TableRow) *component.Table {
 	columns := component.NewTableCols("API Groups", "API Versions", "Resources", "Operations", "Scope")
 	table := component.NewTable("Rules", "There are no webhook rules!", columns)
@@ -0,0 +1,66 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/vmware-tanzu/octant/internal/alerts"
+	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+// alertSummarySections creates a status section for each firing alert about an
+// object. Alerts are optional, so no sections are created if no alert source is
+// configured or the source can't be reached.
+func alertSummarySections(ctx context.Context, object runtime.Object, options Options) []component.SummarySection {
+	provider := options.DashConfig.Alerts()
+	if provider == nil || object == nil {
+		return nil
+	}
+
+	firing, err := provider.ForObject(ctx, object)
+	if err != nil {
+		log.From(ctx).WithErr(err).Errorf("list alerts for object")
+		return nil
+	}
+
+	var sections []component.SummarySection
+	for _, alert := range firing {
+		sections = append(sections, component.SummarySection{
+			Header:  fmt.Sprintf("Alert: %s", alert.Name),
+			Content: alertText(alert),
+		})
+	}
+
+	return sections
+}
+
+// alertText creates text describing a firing alert.
+func alertText(alert alerts.Alert) *component.Text {
+	text := alert.Summary
+	if alert.Severity != "" {
+		text = fmt.Sprintf("[%s] %s", alert.Severity, text)
+	}
+
+	view := component.NewText(text)
+	view.SetStatus(AlertSeverityStatus(alert.Severity))
+	return view
+}
+
+// AlertSeverityStatus returns the text status of an alert severity. Critical
+// and error alerts are shown as errors, and all others as warnings.
+func AlertSeverityStatus(severity string) component.TextStatus {
+	switch severity {
+	case "critical", "error":
+		return component.TextStatusError
+	default:
+		return component.TextStatusWarning
+	}
+}
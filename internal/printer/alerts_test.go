@@ -0,0 +1,79 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/vmware-tanzu/octant/internal/alerts"
+	alertsFake "github.com/vmware-tanzu/octant/internal/alerts/fake"
+	configFake "github.com/vmware-tanzu/octant/internal/config/fake"
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func Test_alertSummarySections(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	pod := testutil.CreatePod("pod")
+
+	provider := alertsFake.NewMockProvider(controller)
+	provider.EXPECT().
+		ForObject(gomock.Any(), pod).
+		Return([]alerts.Alert{
+			{Name: "KubePodCrashLooping", Severity: "critical", Summary: "Pod is crash looping."},
+			{Name: "KubePodNotReady", Severity: "warning", Summary: "Pod has been not ready."},
+		}, nil)
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().Alerts().Return(provider)
+
+	got := alertSummarySections(context.Background(), pod, Options{DashConfig: dashConfig})
+
+	crashLooping := component.NewText("[critical] Pod is crash looping.")
+	crashLooping.SetStatus(component.TextStatusError)
+	notReady := component.NewText("[warning] Pod has been not ready.")
+	notReady.SetStatus(component.TextStatusWarning)
+
+	expected := []component.SummarySection{
+		{Header: "Alert: KubePodCrashLooping", Content: crashLooping},
+		{Header: "Alert: KubePodNotReady", Content: notReady},
+	}
+	assert.Equal(t, expected, got)
+}
+
+func Test_alertSummarySections_disabled(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().Alerts().Return(nil)
+
+	got := alertSummarySections(context.Background(), testutil.CreatePod("pod"), Options{DashConfig: dashConfig})
+	assert.Empty(t, got)
+}
+
+func Test_alertSummarySections_source_error(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	provider := alertsFake.NewMockProvider(controller)
+	provider.EXPECT().
+		ForObject(gomock.Any(), gomock.Any()).
+		Return(nil, errors.New("connection refused"))
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().Alerts().Return(provider)
+
+	got := alertSummarySections(context.Background(), testutil.CreatePod("pod"), Options{DashConfig: dashConfig})
+	assert.Empty(t, got)
+}
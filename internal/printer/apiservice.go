@@ -12,8 +12,10 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/vmware-tanzu/octant/internal/gvk"
+	"github.com/vmware-tanzu/octant/pkg/store"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 
+	corev1 "k8s.io/api/core/v1"
 	apiregistrationv1 "k8s.io/kube-aggregator/pkg/apis/apiregistration/v1"
 )
 
@@ -23,7 +25,7 @@ func APIServiceListHandler(ctx context.Context, list *apiregistrationv1.APIServi
 		return nil, errors.New("api service list is nil")
 	}
 
-	cols := component.NewTableCols("Name", "Service", "Age")
+	cols := component.NewTableCols("Name", "Service", "Available", "Age")
 	ot := NewObjectTable("API Services", "We couldn't find any api services!", cols, options.DashConfig.ObjectStore())
 
 	for _, apiService := range list.Items {
@@ -51,6 +53,7 @@ func APIServiceListHandler(ctx context.Context, list *apiregistrationv1.APIServi
 
 		row["Name"] = nameLink
 		row["Service"] = service
+		row["Available"] = apiServiceAvailable(&apiService)
 		ts := apiService.CreationTimestamp.Time
 		row["Age"] = component.NewTimestamp(ts)
 
@@ -79,6 +82,10 @@ func APIServiceHandler(ctx context.Context, apiService *apiregistrationv1.APISer
 		return nil, errors.Wrap(err, "print apiservice status")
 	}
 
+	if err := ch.Endpoints(ctx, options); err != nil {
+		return nil, errors.Wrap(err, "print apiservice endpoints")
+	}
+
 	return o.ToComponent(ctx, options)
 }
 
@@ -143,27 +150,18 @@ func (c *APIServiceStatus) Create(options Options) (*component.Summary, error) {
 
 	sections := component.SummarySections{}
 
-	var availableCond *apiregistrationv1.APIServiceCondition
-	for _, cond := range c.apiService.Status.Conditions {
-		if cond.Type == apiregistrationv1.Available {
-			availableCond = &cond
-			break
-		}
-	}
-	if availableCond == nil {
-		availableCond = &apiregistrationv1.APIServiceCondition{}
-	}
+	sections.Add("Available", apiServiceAvailable(c.apiService))
 
-	if availableCond.Status == "" {
-		sections.AddText("Available", "Unknown")
-	} else {
-		sections.AddText("Available", string(availableCond.Status))
-	}
-	if availableCond.Reason != "" {
-		sections.AddText("Reason", availableCond.Reason)
-	}
-	if availableCond.Message != "" {
-		sections.AddText("Message", availableCond.Message)
+	if availableCond := apiServiceAvailableCondition(c.apiService); availableCond != nil {
+		if availableCond.Reason != "" {
+			sections.AddText("Reason", availableCond.Reason)
+		}
+		if availableCond.Message != "" {
+			sections.AddText("Message", availableCond.Message)
+		}
+		if !availableCond.LastTransitionTime.IsZero() {
+			sections.Add("Last Transition", component.NewTimestamp(availableCond.LastTransitionTime.Time))
+		}
 	}
 
 	summary.Add(sections...)
@@ -174,13 +172,15 @@ func (c *APIServiceStatus) Create(options Options) (*component.Summary, error) {
 type apiServiceObject interface {
 	Config(options Options) error
 	Status(options Options) error
+	Endpoints(ctx context.Context, options Options) error
 }
 
 type apiServiceHandler struct {
-	apiService  *apiregistrationv1.APIService
-	configFunc  func(*apiregistrationv1.APIService, Options) (*component.Summary, error)
-	summaryFunc func(*apiregistrationv1.APIService, Options) (*component.Summary, error)
-	object      *Object
+	apiService    *apiregistrationv1.APIService
+	configFunc    func(*apiregistrationv1.APIService, Options) (*component.Summary, error)
+	summaryFunc   func(*apiregistrationv1.APIService, Options) (*component.Summary, error)
+	endpointsFunc func(context.Context, *apiregistrationv1.APIService, Options) (*component.Summary, error)
+	object        *Object
 }
 
 var _ apiServiceObject = (*apiServiceHandler)(nil)
@@ -195,10 +195,11 @@ func newAPIServiceHandler(apiService *apiregistrationv1.APIService, object *Obje
 	}
 
 	ch := &apiServiceHandler{
-		apiService:  apiService,
-		configFunc:  defaultAPIServiceConfig,
-		summaryFunc: defaultAPIServiceSummary,
-		object:      object,
+		apiService:    apiService,
+		configFunc:    defaultAPIServiceConfig,
+		summaryFunc:   defaultAPIServiceSummary,
+		endpointsFunc: defaultAPIServiceEndpoints,
+		object:        object,
 	}
 	return ch, nil
 }
@@ -221,6 +222,20 @@ func (c *apiServiceHandler) Status(options Options) error {
 	return nil
 }
 
+func (c *apiServiceHandler) Endpoints(ctx context.Context, options Options) error {
+	if c.apiService.Spec.Service == nil {
+		return nil
+	}
+
+	c.object.RegisterItems(ItemDescriptor{
+		Width: component.WidthHalf,
+		Func: func() (component.Component, error) {
+			return c.endpointsFunc(ctx, c.apiService, options)
+		},
+	})
+	return nil
+}
+
 func defaultAPIServiceConfig(apiService *apiregistrationv1.APIService, options Options) (*component.Summary, error) {
 	return NewAPIServiceConfiguration(apiService).Create(options)
 }
@@ -229,6 +244,101 @@ func defaultAPIServiceSummary(apiService *apiregistrationv1.APIService, options
 	return NewAPIServiceStatus(apiService).Create(options)
 }
 
+// defaultAPIServiceEndpoints describes the service backing an api service and
+// whether it has endpoints which can serve requests.
+func defaultAPIServiceEndpoints(ctx context.Context, apiService *apiregistrationv1.APIService, options Options) (*component.Summary, error) {
+	if apiService == nil || apiService.Spec.Service == nil {
+		return nil, errors.New("apiservice has no backing service")
+	}
+
+	var sections component.SummarySections
+
+	service, err := apiServiceService(apiService, options)
+	if err != nil {
+		return nil, err
+	}
+	sections.Add("Service", service)
+
+	objectStore := options.DashConfig.ObjectStore()
+	serviceRef := apiService.Spec.Service
+
+	key := store.Key{
+		Namespace:  serviceRef.Namespace,
+		APIVersion: "v1",
+		Kind:       "Service",
+		Name:       serviceRef.Name,
+	}
+
+	found, err := store.GetAs(ctx, objectStore, key, &corev1.Service{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "get service for key %+v", key)
+	}
+
+	if !found {
+		text := component.NewText("Service does not exist")
+		text.SetStatus(component.TextStatusError)
+		sections.Add("Endpoints", text)
+		return component.NewSummary("Backing Service", sections...), nil
+	}
+
+	key.Kind = "Endpoints"
+	endpoints := &corev1.Endpoints{}
+	found, err = store.GetAs(ctx, objectStore, key, endpoints)
+	if err != nil {
+		return nil, errors.Wrapf(err, "get endpoints for key %+v", key)
+	}
+
+	ready, notReady := 0, 0
+	if found {
+		for _, subset := range endpoints.Subsets {
+			ready += len(subset.Addresses)
+			notReady += len(subset.NotReadyAddresses)
+		}
+	}
+
+	text := component.NewTextf("%d ready, %d not ready", ready, notReady)
+	if ready == 0 {
+		text.SetStatus(component.TextStatusError)
+	} else {
+		text.SetStatus(component.TextStatusOK)
+	}
+	sections.Add("Endpoints", text)
+
+	return component.NewSummary("Backing Service", sections...), nil
+}
+
+// apiServiceAvailableCondition returns the Available condition of an api
+// service, or nil if it has not been reported.
+func apiServiceAvailableCondition(apiService *apiregistrationv1.APIService) *apiregistrationv1.APIServiceCondition {
+	for i := range apiService.Status.Conditions {
+		if apiService.Status.Conditions[i].Type == apiregistrationv1.Available {
+			return &apiService.Status.Conditions[i]
+		}
+	}
+	return nil
+}
+
+// apiServiceAvailable describes the availability of an api service.
+func apiServiceAvailable(apiService *apiregistrationv1.APIService) *component.Text {
+	availableCond := apiServiceAvailableCondition(apiService)
+	if availableCond == nil || availableCond.Status == "" {
+		text := component.NewText("Unknown")
+		text.SetStatus(component.TextStatusWarning)
+		return text
+	}
+
+	text := component.NewText(string(availableCond.Status))
+	switch availableCond.Status {
+	case apiregistrationv1.ConditionTrue:
+		text.SetStatus(component.TextStatusOK)
+	case apiregistrationv1.ConditionFalse:
+		text.SetStatus(component.TextStatusError)
+	default:
+		text.SetStatus(component.TextStatusWarning)
+	}
+	return text
+}
+
 func apiServiceService(apiService *apiregistrationv1.APIService, options Options) (component.Component, error) {
 	if apiService.Spec.Service == nil {
 		return component.NewText("Local"), nil
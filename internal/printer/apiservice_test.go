@@ -11,10 +11,15 @@ import (
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/kubernetes/scheme"
 	apiregistrationv1 "k8s.io/kube-aggregator/pkg/apis/apiregistration/v1"
 
 	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/store"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
 
@@ -45,7 +50,7 @@ func Test_APIServiceListHandler(t *testing.T) {
 	got, err := APIServiceListHandler(ctx, list, printOptions)
 	require.NoError(t, err)
 
-	cols := component.NewTableCols("Name", "Service", "Age")
+	cols := component.NewTableCols("Name", "Service", "Available", "Age")
 	expected := component.NewTable("API Services", "We couldn't find any api services!", cols)
 
 	expected.Add(component.TableRow{
@@ -53,8 +58,9 @@ func Test_APIServiceListHandler(t *testing.T) {
 			genObjectStatus(component.TextStatusOK, []string{
 				"API Service is OK",
 			})),
-		"Service": component.NewLink("", "default/service", "/service"),
-		"Age":     component.NewTimestamp(now),
+		"Service":   component.NewLink("", "default/service", "/service"),
+		"Available": testAPIServiceStatusText("True", component.TextStatusOK),
+		"Age":       component.NewTimestamp(now),
 		component.GridActionKey: gridActionsFactory([]component.GridAction{
 			buildObjectDeleteAction(t, object),
 		}),
@@ -85,7 +91,7 @@ func Test_APIServiceListHandler_local(t *testing.T) {
 	got, err := APIServiceListHandler(ctx, list, printOptions)
 	require.NoError(t, err)
 
-	cols := component.NewTableCols("Name", "Service", "Age")
+	cols := component.NewTableCols("Name", "Service", "Available", "Age")
 	expected := component.NewTable("API Services", "We couldn't find any api services!", cols)
 
 	expected.Add(component.TableRow{
@@ -93,8 +99,9 @@ func Test_APIServiceListHandler_local(t *testing.T) {
 			genObjectStatus(component.TextStatusOK, []string{
 				"API Service is OK",
 			})),
-		"Service": component.NewText("Local"),
-		"Age":     component.NewTimestamp(now),
+		"Service":   component.NewText("Local"),
+		"Available": testAPIServiceStatusText("True", component.TextStatusOK),
+		"Age":       component.NewTimestamp(now),
 		component.GridActionKey: gridActionsFactory([]component.GridAction{
 			buildObjectDeleteAction(t, object),
 		}),
@@ -188,7 +195,7 @@ func Test_APIServerStatus(t *testing.T) {
 			expected: component.NewSummary("Status", []component.SummarySection{
 				{
 					Header:  "Available",
-					Content: component.NewText("True"),
+					Content: testAPIServiceStatusText("True", component.TextStatusOK),
 				},
 				{
 					Header:  "Reason",
@@ -206,7 +213,7 @@ func Test_APIServerStatus(t *testing.T) {
 			expected: component.NewSummary("Status", []component.SummarySection{
 				{
 					Header:  "Available",
-					Content: component.NewText("Unknown"),
+					Content: testAPIServiceStatusText("Unknown", component.TextStatusWarning),
 				},
 			}...),
 		},
@@ -239,6 +246,93 @@ func Test_APIServerStatus(t *testing.T) {
 	}
 }
 
+func Test_defaultAPIServiceEndpoints(t *testing.T) {
+	apiService := testutil.CreateAPIService("v1beta1", "metrics.k8s.io")
+	apiService.Spec.Service = &apiregistrationv1.ServiceReference{
+		Namespace: "kube-system",
+		Name:      "metrics-server",
+	}
+
+	serviceKey := store.Key{Namespace: "kube-system", APIVersion: "v1", Kind: "Service", Name: "metrics-server"}
+	endpointsKey := store.Key{Namespace: "kube-system", APIVersion: "v1", Kind: "Endpoints", Name: "metrics-server"}
+
+	cases := []struct {
+		name     string
+		init     func(t *testing.T, tpo *testPrinterOptions)
+		expected component.Component
+	}{
+		{
+			name: "ready endpoints",
+			init: func(t *testing.T, tpo *testPrinterOptions) {
+				service := testutil.CreateService("metrics-server")
+				tpo.objectStore.EXPECT().Get(gomock.Any(), serviceKey).
+					Return(testutil.ToUnstructured(t, service), nil)
+
+				endpoints := &corev1.Endpoints{
+					TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Endpoints"},
+					Subsets: []corev1.EndpointSubset{
+						{
+							Addresses:         []corev1.EndpointAddress{{IP: "10.0.0.1"}},
+							NotReadyAddresses: []corev1.EndpointAddress{{IP: "10.0.0.2"}},
+						},
+					},
+				}
+				tpo.objectStore.EXPECT().Get(gomock.Any(), endpointsKey).
+					Return(testutil.ToUnstructured(t, endpoints), nil)
+			},
+			expected: component.NewSummary("Backing Service", []component.SummarySection{
+				{
+					Header:  "Service",
+					Content: component.NewLink("", "kube-system/metrics-server", "/service"),
+				},
+				{
+					Header:  "Endpoints",
+					Content: testAPIServiceStatusText("1 ready, 1 not ready", component.TextStatusOK),
+				},
+			}...),
+		},
+		{
+			name: "missing service",
+			init: func(t *testing.T, tpo *testPrinterOptions) {
+				notFound := kerrors.NewNotFound(schema.GroupResource{Resource: "services"}, "metrics-server")
+				tpo.objectStore.EXPECT().Get(gomock.Any(), serviceKey).Return(nil, notFound)
+			},
+			expected: component.NewSummary("Backing Service", []component.SummarySection{
+				{
+					Header:  "Service",
+					Content: component.NewLink("", "kube-system/metrics-server", "/service"),
+				},
+				{
+					Header:  "Endpoints",
+					Content: testAPIServiceStatusText("Service does not exist", component.TextStatusError),
+				},
+			}...),
+		},
+	}
+
+	for _, test := range cases {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			tpo := newTestPrinterOptions(controller)
+			tpo.PathForGVK("kube-system", "v1", "Service", "metrics-server", "kube-system/metrics-server", "/service")
+			test.init(t, tpo)
+
+			summary, err := defaultAPIServiceEndpoints(context.Background(), apiService, tpo.ToOptions())
+			require.NoError(t, err)
+
+			component.AssertEqual(t, test.expected, summary)
+		})
+	}
+}
+
+func testAPIServiceStatusText(s string, status component.TextStatus) *component.Text {
+	text := component.NewText(s)
+	text.SetStatus(status)
+	return text
+}
+
 func init() {
 	apiregistrationv1.AddToScheme(scheme.Scheme)
 }
@@ -0,0 +1,194 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+// certManagerGroup is the API group of cert-manager resources.
+const certManagerGroup = "cert-manager.io"
+
+// certManagerIssuerTypes are the issuer configurations cert-manager supports.
+var certManagerIssuerTypes = []string{"acme", "ca", "selfSigned", "vault", "venafi"}
+
+// CertManagerCertificateHandler prints the readiness, validity and backing
+// secret of a cert-manager Certificate.
+func CertManagerCertificateHandler(_ context.Context, cr *unstructured.Unstructured, options Options) (component.Component, error) {
+	if cr == nil {
+		return nil, fmt.Errorf("certificate is nil")
+	}
+
+	sections := component.SummarySections{}
+	sections.Add("Ready", customResourceReadyText(cr))
+
+	if secretName, _, _ := unstructured.NestedString(cr.Object, "spec", "secretName"); secretName != "" {
+		secretLink, err := options.Link.ForGVK(cr.GetNamespace(), "v1", "Secret", secretName, secretName)
+		if err != nil {
+			return nil, err
+		}
+		sections.Add("Secret", secretLink)
+	}
+
+	if issuerName, _, _ := unstructured.NestedString(cr.Object, "spec", "issuerRef", "name"); issuerName != "" {
+		issuerLink, err := certManagerIssuerLink(cr, issuerName, options)
+		if err != nil {
+			return nil, err
+		}
+		sections.Add("Issuer", issuerLink)
+	}
+
+	if dnsNames, _, _ := unstructured.NestedStringSlice(cr.Object, "spec", "dnsNames"); len(dnsNames) > 0 {
+		sections.AddText("DNS Names", strings.Join(dnsNames, ", "))
+	}
+
+	now := time.Now()
+
+	if notAfter, ok := nestedTime(cr, "status", "notAfter"); ok {
+		text := component.NewText(notAfter.UTC().Format(time.RFC3339))
+		switch {
+		case now.After(notAfter):
+			text.SetStatus(component.TextStatusError)
+		case notAfter.Sub(now) < certificateExpiryWarning:
+			text.SetStatus(component.TextStatusWarning)
+		default:
+			text.SetStatus(component.TextStatusOK)
+		}
+		sections.Add("Not After", text)
+	}
+
+	if renewalTime, ok := nestedTime(cr, "status", "renewalTime"); ok {
+		sections.AddText("Renewal Time", renewalTime.UTC().Format(time.RFC3339))
+	}
+
+	return component.NewSummary("Certificate", sections...), nil
+}
+
+// CertManagerIssuerHandler prints the readiness and configuration of a
+// cert-manager Issuer or ClusterIssuer.
+func CertManagerIssuerHandler(_ context.Context, cr *unstructured.Unstructured, options Options) (component.Component, error) {
+	if cr == nil {
+		return nil, fmt.Errorf("issuer is nil")
+	}
+
+	sections := component.SummarySections{}
+	sections.Add("Ready", customResourceReadyText(cr))
+
+	for _, issuerType := range certManagerIssuerTypes {
+		if _, found, _ := unstructured.NestedMap(cr.Object, "spec", issuerType); !found {
+			continue
+		}
+
+		sections.AddText("Type", issuerType)
+
+		switch issuerType {
+		case "acme":
+			if server, _, _ := unstructured.NestedString(cr.Object, "spec", "acme", "server"); server != "" {
+				sections.AddText("Server", server)
+			}
+			if email, _, _ := unstructured.NestedString(cr.Object, "spec", "acme", "email"); email != "" {
+				sections.AddText("Email", email)
+			}
+		case "ca":
+			if secretName, _, _ := unstructured.NestedString(cr.Object, "spec", "ca", "secretName"); secretName != "" {
+				secretLink, err := options.Link.ForGVK(cr.GetNamespace(), "v1", "Secret", secretName, secretName)
+				if err != nil {
+					return nil, err
+				}
+				sections.Add("Secret", secretLink)
+			}
+		}
+
+		break
+	}
+
+	return component.NewSummary(cr.GetKind(), sections...), nil
+}
+
+// certManagerIssuerLink links to the issuer referenced by a Certificate.
+func certManagerIssuerLink(cr *unstructured.Unstructured, name string, options Options) (*component.Link, error) {
+	kind, _, _ := unstructured.NestedString(cr.Object, "spec", "issuerRef", "kind")
+	if kind == "" {
+		kind = "Issuer"
+	}
+
+	group, _, _ := unstructured.NestedString(cr.Object, "spec", "issuerRef", "group")
+	if group == "" {
+		group = certManagerGroup
+	}
+
+	namespace := cr.GetNamespace()
+	if kind == "ClusterIssuer" {
+		namespace = ""
+	}
+
+	apiVersion := cr.GetAPIVersion()
+	if group != cr.GroupVersionKind().Group {
+		apiVersion = schema.GroupVersion{Group: group, Version: cr.GroupVersionKind().Version}.String()
+	}
+
+	return options.Link.ForGVK(namespace, apiVersion, kind, name, fmt.Sprintf("%s (%s)", name, kind))
+}
+
+// customResourceReadyText describes the Ready condition of a custom resource.
+func customResourceReadyText(cr *unstructured.Unstructured) *component.Text {
+	conditions, _, _ := unstructured.NestedSlice(cr.Object, "status", "conditions")
+	for _, c := range conditions {
+		condition, ok := c.(map[string]interface{})
+		if !ok || condition["type"] != "Ready" {
+			continue
+		}
+
+		status, _ := condition["status"].(string)
+		message, _ := condition["message"].(string)
+		if message == "" {
+			message, _ = condition["reason"].(string)
+		}
+
+		value := status
+		if message != "" {
+			value = fmt.Sprintf("%s: %s", status, message)
+		}
+
+		text := component.NewText(value)
+		switch status {
+		case "True":
+			text.SetStatus(component.TextStatusOK)
+		case "False":
+			text.SetStatus(component.TextStatusError)
+		default:
+			text.SetStatus(component.TextStatusWarning)
+		}
+		return text
+	}
+
+	text := component.NewText("Unknown")
+	text.SetStatus(component.TextStatusWarning)
+	return text
+}
+
+// nestedTime parses a RFC 3339 timestamp field of an unstructured object.
+func nestedTime(cr *unstructured.Unstructured, fields ...string) (time.Time, bool) {
+	s, _, _ := unstructured.NestedString(cr.Object, fields...)
+	if s == "" {
+		return time.Time{}, false
+	}
+
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	return t, true
+}
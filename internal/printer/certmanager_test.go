@@ -0,0 +1,146 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func TestCertManagerCertificateHandler(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+
+	notAfter := time.Now().Add(90 * 24 * time.Hour).UTC().Truncate(time.Second)
+	renewalTime := notAfter.Add(-30 * 24 * time.Hour)
+
+	cr := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "cert-manager.io/v1",
+		"kind":       "Certificate",
+		"metadata": map[string]interface{}{
+			"name":      "example",
+			"namespace": "default",
+		},
+		"spec": map[string]interface{}{
+			"secretName": "example-tls",
+			"dnsNames":   []interface{}{"example.com", "www.example.com"},
+			"issuerRef": map[string]interface{}{
+				"kind": "ClusterIssuer",
+				"name": "letsencrypt",
+			},
+		},
+		"status": map[string]interface{}{
+			"conditions": []interface{}{
+				map[string]interface{}{
+					"type":    "Ready",
+					"status":  "True",
+					"message": "Certificate is up to date and has not expired",
+				},
+			},
+			"notAfter":    notAfter.Format(time.RFC3339),
+			"renewalTime": renewalTime.Format(time.RFC3339),
+		},
+	}}
+
+	secretLink := component.NewLink("", "example-tls", "/secret")
+	tpo.link.EXPECT().
+		ForGVK("default", "v1", "Secret", "example-tls", "example-tls").
+		Return(secretLink, nil)
+	issuerLink := component.NewLink("", "letsencrypt (ClusterIssuer)", "/issuer")
+	tpo.link.EXPECT().
+		ForGVK("", "cert-manager.io/v1", "ClusterIssuer", "letsencrypt", "letsencrypt (ClusterIssuer)").
+		Return(issuerLink, nil)
+
+	printOptions := tpo.ToOptions()
+
+	got, err := CertManagerCertificateHandler(context.Background(), cr, printOptions)
+	require.NoError(t, err)
+
+	ready := component.NewText("True: Certificate is up to date and has not expired")
+	ready.SetStatus(component.TextStatusOK)
+	expiry := component.NewText(notAfter.Format(time.RFC3339))
+	expiry.SetStatus(component.TextStatusOK)
+
+	expected := component.NewSummary("Certificate", []component.SummarySection{
+		{Header: "Ready", Content: ready},
+		{Header: "Secret", Content: secretLink},
+		{Header: "Issuer", Content: issuerLink},
+		{Header: "DNS Names", Content: component.NewText("example.com, www.example.com")},
+		{Header: "Not After", Content: expiry},
+		{Header: "Renewal Time", Content: component.NewText(renewalTime.Format(time.RFC3339))},
+	}...)
+
+	component.AssertEqual(t, expected, got)
+}
+
+func TestCertManagerIssuerHandler(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+
+	cr := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "cert-manager.io/v1",
+		"kind":       "Issuer",
+		"metadata": map[string]interface{}{
+			"name":      "ca-issuer",
+			"namespace": "default",
+		},
+		"spec": map[string]interface{}{
+			"ca": map[string]interface{}{
+				"secretName": "ca-key-pair",
+			},
+		},
+		"status": map[string]interface{}{
+			"conditions": []interface{}{
+				map[string]interface{}{
+					"type":   "Ready",
+					"status": "False",
+					"reason": "ErrGetKeyPair",
+				},
+			},
+		},
+	}}
+
+	secretLink := component.NewLink("", "ca-key-pair", "/secret")
+	tpo.link.EXPECT().
+		ForGVK("default", "v1", "Secret", "ca-key-pair", "ca-key-pair").
+		Return(secretLink, nil)
+
+	printOptions := tpo.ToOptions()
+
+	got, err := CertManagerIssuerHandler(context.Background(), cr, printOptions)
+	require.NoError(t, err)
+
+	ready := component.NewText("False: ErrGetKeyPair")
+	ready.SetStatus(component.TextStatusError)
+
+	expected := component.NewSummary("Issuer", []component.SummarySection{
+		{Header: "Ready", Content: ready},
+		{Header: "Type", Content: component.NewText("ca")},
+		{Header: "Secret", Content: secretLink},
+	}...)
+
+	component.AssertEqual(t, expected, got)
+}
+
+func Test_customResourceReadyText(t *testing.T) {
+	cr := &unstructured.Unstructured{Object: map[string]interface{}{}}
+
+	expected := component.NewText("Unknown")
+	expected.SetStatus(component.TextStatusWarning)
+
+	component.AssertEqual(t, expected, customResourceReadyText(cr))
+}
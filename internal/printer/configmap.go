@@ -10,9 +10,11 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/pkg/errors"
 
+	"github.com/vmware-tanzu/octant/internal/api"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 
 	corev1 "k8s.io/api/core/v1"
@@ -40,7 +42,7 @@ func ConfigMapListHandler(ctx context.Context, list *corev1.ConfigMapList, opts
 
 		row["Labels"] = component.NewLabels(c.Labels)
 
-		data := fmt.Sprintf("%d", len(c.Data))
+		data := fmt.Sprintf("%d", len(c.Data)+len(c.BinaryData))
 		row["Data"] = component.NewText(data)
 
 		ts := c.CreationTimestamp.Time
@@ -149,16 +151,73 @@ func describeConfigMapDataRows(cm *corev1.ConfigMap) ([]component.TableRow, erro
 
 		row["Key"] = component.NewText(k)
 
-		if strings.Contains(data[k], "\n") {
-			row["Value"] = component.NewCodeBlock(data[k])
+		value := data[k]
+		if len(value) > configMapValueLimit {
+			row["Value"] = describeTruncatedConfigMapValue(cm, k, value)
+		} else if strings.Contains(value, "\n") {
+			row["Value"] = component.NewCodeBlock(value)
 		} else {
-			row["Value"] = component.NewText(data[k])
+			row["Value"] = component.NewText(value)
 		}
 	}
 
+	var binaryKeys []string
+	for k := range cm.BinaryData {
+		binaryKeys = append(binaryKeys, k)
+	}
+	sort.Strings(binaryKeys)
+
+	for _, k := range binaryKeys {
+		ref := api.ConfigMapDataPath(cm.Namespace, cm.Name, k)
+		text := fmt.Sprintf("Download binary data (%s)", formatByteSize(len(cm.BinaryData[k])))
+
+		rows = append(rows, component.TableRow{
+			"Key":   component.NewText(k),
+			"Value": component.NewLink("", text, ref, component.DownloadLink()),
+		})
+	}
+
 	return rows, nil
 }
 
+// configMapValueLimit is the largest config map value which is shown in full.
+// Larger values are truncated, and the full value can be downloaded.
+const configMapValueLimit = 16 * 1024
+
+// describeTruncatedConfigMapValue shows the beginning of a large config map
+// value with a link to download all of it.
+func describeTruncatedConfigMapValue(cm *corev1.ConfigMap, key, value string) component.Component {
+	truncated := value[:configMapValueLimit]
+	for !utf8.ValidString(truncated) {
+		truncated = truncated[:len(truncated)-1]
+	}
+
+	ref := api.ConfigMapDataPath(cm.Namespace, cm.Name, key)
+	notice := fmt.Sprintf("Showing the first %s of %s.", formatByteSize(len(truncated)), formatByteSize(len(value)))
+
+	return component.NewList(nil, []component.Component{
+		component.NewCodeBlock(truncated),
+		component.NewText(notice),
+		component.NewLink("", "Download full value", ref, component.DownloadLink()),
+	})
+}
+
+// formatByteSize formats a size in bytes using binary units.
+func formatByteSize(n int) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+
+	div, exp := unit, 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
+
 type configMapObject interface {
 	Config(options Options) error
 	Data(option Options) error
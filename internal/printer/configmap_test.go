@@ -7,9 +7,11 @@ package printer
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -146,3 +148,50 @@ func Test_describeConfigMapData(t *testing.T) {
 
 	component.AssertEqual(t, expected, got)
 }
+
+func Test_describeConfigMapData_binaryAndLargeValues(t *testing.T) {
+	large := strings.Repeat("a", configMapValueLimit+1)
+
+	configMap := testutil.CreateConfigMap("configmap")
+	configMap.Data = map[string]string{
+		"large": large,
+	}
+	configMap.BinaryData = map[string][]byte{
+		"logo.png": make([]byte, 2048),
+	}
+
+	got, err := describeConfigMapData(configMap)
+	require.NoError(t, err)
+
+	cols := component.NewTableCols("Key", "Value")
+	expected := component.NewTable("Data", "No data has been configured for this config map!", cols)
+	expected.Add([]component.TableRow{
+		{
+			"Key": component.NewText("large"),
+			"Value": component.NewList(nil, []component.Component{
+				component.NewCodeBlock(large[:configMapValueLimit]),
+				component.NewText("Showing the first 16.0 KiB of 16.0 KiB."),
+				component.NewLink("", "Download full value", "/api/v1/configmaps/namespace/configmap/data/large", component.DownloadLink()),
+			}),
+		},
+		{
+			"Key":   component.NewText("logo.png"),
+			"Value": component.NewLink("", "Download binary data (2.0 KiB)", "/api/v1/configmaps/namespace/configmap/data/logo.png", component.DownloadLink()),
+		},
+	}...)
+
+	component.AssertEqual(t, expected, got)
+}
+
+func Test_formatByteSize(t *testing.T) {
+	tests := map[int]string{
+		512:             "512 B",
+		1024:            "1.0 KiB",
+		1536:            "1.5 KiB",
+		3 * 1024 * 1024: "3.0 MiB",
+	}
+
+	for n, expected := range tests {
+		assert.Equal(t, expected, formatByteSize(n))
+	}
+}
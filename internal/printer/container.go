@@ -260,8 +260,8 @@ func describeContainerPorts(
 	var name string
 	var err error
 	gvk := parent.GetObjectKind().GroupVersionKind()
-	isPod := isPodGVK(gvk)
-	if isPod {
+	isForwardable := portforward.IsForwardable(gvk)
+	if isForwardable {
 		accessor := meta.NewAccessor()
 		namespace, err = accessor.Namespace(parent)
 		if err != nil {
@@ -274,7 +274,12 @@ func describeContainerPorts(
 		}
 	}
 
-	states, err := portForwardService.FindPod(namespace, gvk, name)
+	findStates := portForwardService.FindTarget
+	if isPodGVK(gvk) {
+		findStates = portForwardService.FindPod
+	}
+
+	states, err := findStates(namespace, gvk, name)
 	if err != nil {
 		if _, ok := err.(notFound); !ok {
 			return nil, errors.Wrap(err, "query port forward service for pod")
@@ -297,7 +302,7 @@ func describeContainerPorts(
 		pfs := component.PortForwardState{}
 		var port *component.Port
 
-		if isPod && cPort.Protocol == corev1.ProtocolTCP {
+		if isForwardable && cPort.Protocol == corev1.ProtocolTCP {
 			pfs.IsForwardable = true
 		}
 
@@ -537,6 +542,57 @@ func editContainerAction(owner runtime.Object, container *corev1.Container) (com
 	return action, nil
 }
 
+func downloadContainerFilesAction(owner runtime.Object, container *corev1.Container) (component.Action, error) {
+	if container == nil {
+		return component.Action{}, errors.New("container is nil")
+	}
+
+	form, err := component.CreateFormForObject(octant.ActionDownloadContainerFiles, owner,
+		component.NewFormFieldText("Container Path", "containerPath", ""),
+		component.NewFormFieldText("Local Directory", "localDirectory", octant.DefaultDownloadDirectory()),
+		component.NewFormFieldHidden("containerName", container.Name),
+	)
+	if err != nil {
+		return component.Action{}, err
+	}
+
+	action := component.Action{
+		Name:  "Download Files",
+		Title: fmt.Sprintf("Download Files from Container %s", container.Name),
+		Form:  form,
+	}
+
+	return action, nil
+}
+
+func uploadContainerFilesAction(owner runtime.Object, container *corev1.Container) (component.Action, error) {
+	if container == nil {
+		return component.Action{}, errors.New("container is nil")
+	}
+
+	workingDir := container.WorkingDir
+	if workingDir == "" {
+		workingDir = "/tmp"
+	}
+
+	form, err := component.CreateFormForObject(octant.ActionUploadContainerFiles, owner,
+		component.NewFormFieldText("Local Path", "localPath", ""),
+		component.NewFormFieldText("Container Directory", "containerDirectory", workingDir),
+		component.NewFormFieldHidden("containerName", container.Name),
+	)
+	if err != nil {
+		return component.Action{}, err
+	}
+
+	action := component.Action{
+		Name:  "Upload Files",
+		Title: fmt.Sprintf("Upload Files to Container %s", container.Name),
+		Form:  form,
+	}
+
+	return action, nil
+}
+
 func containersPathForObject(object runtime.Object) ([]string, error) {
 	if object == nil {
 		return nil, errors.New("object is nil")
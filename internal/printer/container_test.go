@@ -485,6 +485,39 @@ func Test_editContainerAction(t *testing.T) {
 	require.Equal(t, expected, got)
 }
 
+func Test_uploadContainerFilesAction(t *testing.T) {
+	pod := testutil.CreatePod("pod")
+	container := corev1.Container{Name: "app", WorkingDir: "/app"}
+
+	got, err := uploadContainerFilesAction(pod, &container)
+	require.NoError(t, err)
+
+	form, err := component.CreateFormForObject(octant.ActionUploadContainerFiles, pod,
+		component.NewFormFieldText("Local Path", "localPath", ""),
+		component.NewFormFieldText("Container Directory", "containerDirectory", "/app"),
+		component.NewFormFieldHidden("containerName", "app"),
+	)
+	require.NoError(t, err)
+
+	expected := component.Action{
+		Name:  "Upload Files",
+		Title: "Upload Files to Container app",
+		Form:  form,
+	}
+	require.Equal(t, expected, got)
+}
+
+func Test_downloadContainerFilesAction(t *testing.T) {
+	pod := testutil.CreatePod("pod")
+	container := corev1.Container{Name: "app"}
+
+	got, err := downloadContainerFilesAction(pod, &container)
+	require.NoError(t, err)
+
+	require.Equal(t, "Download Files", got.Name)
+	require.Equal(t, "Download Files from Container app", got.Title)
+}
+
 func createPortForwardState(id, namespace, targetName string, gvk schema.GroupVersionKind) portforward.State {
 	return portforward.State{
 		ID:        id,
@@ -27,7 +27,7 @@ func CronJobListHandler(ctx context.Context, list *batchv1beta1.CronJobList, opt
 		return nil, errors.New("nil list")
 	}
 
-	cols := component.NewTableCols("Name", "Labels", "Schedule", "Age")
+	cols := component.NewTableCols("Name", "Labels", "Schedule", "Suspend", "Age")
 	ot := NewObjectTable("CronJobs", "We couldn't find any cron jobs!", cols, opts.DashConfig.ObjectStore())
 
 	for _, c := range list.Items {
@@ -44,6 +44,8 @@ func CronJobListHandler(ctx context.Context, list *batchv1beta1.CronJobList, opt
 
 		row["Schedule"] = component.NewText(c.Spec.Schedule)
 
+		row["Suspend"] = component.NewText(strconv.FormatBool(isCronJobSuspended(c)))
+
 		ts := c.CreationTimestamp.Time
 		row["Age"] = component.NewTimestamp(ts)
 
@@ -91,7 +93,7 @@ func addCronJobActions(c batchv1beta1.CronJob, row component.TableRow) error {
 		Confirmation: nil,
 		Type:         component.GridActionDanger,
 	}
-	if c.Spec.Suspend != nil && *c.Spec.Suspend {
+	if isCronJobSuspended(c) {
 		actions = append(actions, resumeAction)
 	} else {
 		actions = append(actions, suspendAction)
@@ -104,11 +106,35 @@ func addCronJobActions(c batchv1beta1.CronJob, row component.TableRow) error {
 	return nil
 }
 
+// isCronJobSuspended returns true if a cronjob won't schedule new jobs.
+func isCronJobSuspended(c batchv1beta1.CronJob) bool {
+	return c.Spec.Suspend != nil && *c.Spec.Suspend
+}
+
 // CronJobHandler is a printFunc that prints a CronJob
 func CronJobHandler(ctx context.Context, cronJob *batchv1beta1.CronJob, options Options) (component.Component, error) {
 	o := NewObject(cronJob)
 	o.EnableEvents()
 
+	payload := action.Payload{
+		"namespace":  cronJob.Namespace,
+		"apiVersion": cronJob.APIVersion,
+		"kind":       cronJob.Kind,
+		"name":       cronJob.Name,
+	}
+
+	o.AddButton("Run now", action.CreatePayload(octant.ActionOverviewCronjob, payload),
+		component.WithButtonConfirmation(
+			"Run CronJob",
+			fmt.Sprintf("Create a Job from the template of CronJob **%s** now?", cronJob.Name),
+		))
+
+	if isCronJobSuspended(*cronJob) {
+		o.AddButton("Resume", action.CreatePayload(octant.ActionOverviewResumeCronjob, payload))
+	} else {
+		o.AddButton("Suspend", action.CreatePayload(octant.ActionOverviewSuspendCronjob, payload))
+	}
+
 	ch, err := newCronJobHandler(cronJob, o)
 	if err != nil {
 		return nil, err
@@ -147,9 +173,7 @@ func (cc *CronJobConfiguration) Create() (*component.Summary, error) {
 
 	sections.AddText("Schedule", cc.cronjob.Spec.Schedule)
 
-	if suspend := cc.cronjob.Spec.Suspend; suspend != nil {
-		sections.AddText("Suspend", strconv.FormatBool(*suspend))
-	}
+	sections.AddText("Suspend", strconv.FormatBool(isCronJobSuspended(*cc.cronjob)))
 
 	sections.AddText("Concurrency Policy", string(cc.cronjob.Spec.ConcurrencyPolicy))
 
@@ -75,7 +75,7 @@ func Test_CronJobListHandler(t *testing.T) {
 		"name":       cronJob.Name,
 	}
 
-	cols := component.NewTableCols("Name", "Labels", "Schedule", "Age")
+	cols := component.NewTableCols("Name", "Labels", "Schedule", "Suspend", "Age")
 	expected := component.NewTable("CronJobs", "We couldn't find any cron jobs!", cols)
 	expected.Add(component.TableRow{
 		"Name": component.NewLink("", "cron", "/cron", func(l *component.Link) {
@@ -87,6 +87,7 @@ func Test_CronJobListHandler(t *testing.T) {
 		}),
 		"Labels":   component.NewLabels(labels),
 		"Schedule": component.NewText("*/1 * * * *"),
+		"Suspend":  component.NewText("false"),
 		"Age":      component.NewTimestamp(now),
 		component.GridActionKey: gridActionsFactory([]component.GridAction{
 			{
@@ -238,6 +239,7 @@ func Test_createJobListView(t *testing.T) {
 		Namespace:  job.Namespace,
 		APIVersion: "batch/v1beta1",
 		Kind:       "Job",
+		OwnerUID:   cronJob.UID,
 	}
 
 	tpo.objectStore.EXPECT().List(gomock.Any(), gomock.Eq(key)).Return(jobList, false, nil)
@@ -0,0 +1,237 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	storagev1 "k8s.io/api/storage/v1"
+
+	"github.com/vmware-tanzu/octant/internal/util/kubernetes"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+// CSIDriverListHandler is a printFunc that prints CSI drivers
+func CSIDriverListHandler(ctx context.Context, list *storagev1.CSIDriverList, options Options) (component.Component, error) {
+	if list == nil {
+		return nil, errors.New("csi driver list is nil")
+	}
+
+	cols := component.NewTableCols("Name", "Attach Required", "Pod Info On Mount", "Modes", "Age")
+	ot := NewObjectTable("CSI Drivers", "We couldn't find any CSI drivers!", cols, options.DashConfig.ObjectStore())
+
+	for _, csiDriver := range list.Items {
+		row := component.TableRow{}
+		nameLink, err := options.Link.ForObject(&csiDriver, csiDriver.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		row["Name"] = nameLink
+		row["Attach Required"] = component.NewTextf("%t", csiDriverAttachRequired(&csiDriver))
+		row["Pod Info On Mount"] = component.NewTextf("%t", boolValue(csiDriver.Spec.PodInfoOnMount))
+		row["Modes"] = component.NewText(csiDriverVolumeLifecycleModes(&csiDriver))
+		ts := csiDriver.CreationTimestamp.Time
+		row["Age"] = component.NewTimestamp(ts)
+
+		if err := ot.AddRowForObject(ctx, &csiDriver, row); err != nil {
+			return nil, fmt.Errorf("add row for object: %w", err)
+		}
+	}
+
+	return ot.ToComponent()
+}
+
+// CSIDriverHandler is a printFunc that prints a CSI driver
+func CSIDriverHandler(ctx context.Context, csiDriver *storagev1.CSIDriver, options Options) (component.Component, error) {
+	o := NewObject(csiDriver)
+
+	ch, err := newCSIDriverHandler(csiDriver, o)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ch.Config(options); err != nil {
+		return nil, errors.Wrap(err, "print csidriver configuration")
+	}
+
+	if err := ch.Nodes(ctx, options); err != nil {
+		return nil, errors.Wrap(err, "print csidriver nodes")
+	}
+
+	return o.ToComponent(ctx, options)
+}
+
+// CSIDriverConfiguration generates a csidriver configuration
+type CSIDriverConfiguration struct {
+	csiDriver *storagev1.CSIDriver
+}
+
+// NewCSIDriverConfiguration creates an instance of CSIDriverConfiguration
+func NewCSIDriverConfiguration(csiDriver *storagev1.CSIDriver) *CSIDriverConfiguration {
+	return &CSIDriverConfiguration{
+		csiDriver: csiDriver,
+	}
+}
+
+// Create creates a csidriver configuration summary
+func (c *CSIDriverConfiguration) Create(options Options) (*component.Summary, error) {
+	if c == nil || c.csiDriver == nil {
+		return nil, errors.New("csidriver is nil")
+	}
+
+	csiDriver := c.csiDriver
+
+	fsGroupPolicy := storagev1.ReadWriteOnceWithFSTypeFSGroupPolicy
+	if csiDriver.Spec.FSGroupPolicy != nil {
+		fsGroupPolicy = *csiDriver.Spec.FSGroupPolicy
+	}
+
+	var sections component.SummarySections
+
+	sections.AddText("Attach Required", fmt.Sprintf("%t", csiDriverAttachRequired(csiDriver)))
+	sections.AddText("Pod Info On Mount", fmt.Sprintf("%t", boolValue(csiDriver.Spec.PodInfoOnMount)))
+	sections.AddText("Storage Capacity", fmt.Sprintf("%t", boolValue(csiDriver.Spec.StorageCapacity)))
+	sections.AddText("FS Group Policy", string(fsGroupPolicy))
+	sections.AddText("Volume Lifecycle Modes", csiDriverVolumeLifecycleModes(csiDriver))
+
+	summary := component.NewSummary("Configuration", sections...)
+
+	return summary, nil
+}
+
+type csiDriverObject interface {
+	Config(options Options) error
+	Nodes(ctx context.Context, options Options) error
+}
+
+type csiDriverHandler struct {
+	csiDriver  *storagev1.CSIDriver
+	configFunc func(*storagev1.CSIDriver, Options) (*component.Summary, error)
+	nodesFunc  func(context.Context, *storagev1.CSIDriver, Options) (*component.Table, error)
+	object     *Object
+}
+
+var _ csiDriverObject = (*csiDriverHandler)(nil)
+
+func newCSIDriverHandler(csiDriver *storagev1.CSIDriver, object *Object) (*csiDriverHandler, error) {
+	if csiDriver == nil {
+		return nil, errors.New("can't print a nil csidriver")
+	}
+
+	if object == nil {
+		return nil, errors.New("can't print a csidriver using an nil object printer")
+	}
+
+	ch := &csiDriverHandler{
+		csiDriver:  csiDriver,
+		configFunc: defaultCSIDriverConfig,
+		nodesFunc:  defaultCSIDriverNodes,
+		object:     object,
+	}
+	return ch, nil
+}
+
+func (c *csiDriverHandler) Config(options Options) error {
+	out, err := c.configFunc(c.csiDriver, options)
+	if err != nil {
+		return err
+	}
+	c.object.RegisterConfig(out)
+	return nil
+}
+
+func (c *csiDriverHandler) Nodes(ctx context.Context, options Options) error {
+	c.object.RegisterItems(ItemDescriptor{
+		Width: component.WidthFull,
+		Func: func() (component.Component, error) {
+			return c.nodesFunc(ctx, c.csiDriver, options)
+		},
+	})
+	return nil
+}
+
+func defaultCSIDriverConfig(csiDriver *storagev1.CSIDriver, options Options) (*component.Summary, error) {
+	return NewCSIDriverConfiguration(csiDriver).Create(options)
+}
+
+// defaultCSIDriverNodes lists the nodes which have registered a CSI driver.
+func defaultCSIDriverNodes(ctx context.Context, csiDriver *storagev1.CSIDriver, options Options) (*component.Table, error) {
+	if csiDriver == nil {
+		return nil, errors.New("csidriver is nil")
+	}
+
+	key := store.Key{
+		APIVersion: "storage.k8s.io/v1",
+		Kind:       "CSINode",
+	}
+
+	csiNodeList, _, err := options.DashConfig.ObjectStore().List(ctx, key)
+	if err != nil {
+		return nil, errors.Wrapf(err, "list all objects for key %+v", key)
+	}
+
+	cols := component.NewTableCols("Node", "Node ID", "Max Volumes", "Topology Keys")
+	table := component.NewTable("Nodes", "There are no nodes running this driver!", cols)
+
+	for i := range csiNodeList.Items {
+		csiNode := &storagev1.CSINode{}
+		if err := kubernetes.FromUnstructured(&csiNodeList.Items[i], csiNode); err != nil {
+			return nil, err
+		}
+
+		for _, driver := range csiNode.Spec.Drivers {
+			if driver.Name != csiDriver.Name {
+				continue
+			}
+
+			nodeLink, err := options.Link.ForGVK("", "v1", "Node", csiNode.Name, csiNode.Name)
+			if err != nil {
+				return nil, err
+			}
+
+			table.Add(component.TableRow{
+				"Node":          nodeLink,
+				"Node ID":       component.NewText(driver.NodeID),
+				"Max Volumes":   component.NewText(csiNodeDriverMaxVolumes(driver)),
+				"Topology Keys": component.NewText(strings.Join(driver.TopologyKeys, ", ")),
+			})
+		}
+	}
+
+	table.Sort("Node")
+
+	return table, nil
+}
+
+// csiDriverAttachRequired returns true if volumes of a driver need to be
+// attached to a node before they're mounted. Drivers attach unless they opt out.
+func csiDriverAttachRequired(csiDriver *storagev1.CSIDriver) bool {
+	if csiDriver.Spec.AttachRequired == nil {
+		return true
+	}
+	return *csiDriver.Spec.AttachRequired
+}
+
+func csiDriverVolumeLifecycleModes(csiDriver *storagev1.CSIDriver) string {
+	if len(csiDriver.Spec.VolumeLifecycleModes) == 0 {
+		return string(storagev1.VolumeLifecyclePersistent)
+	}
+
+	var modes []string
+	for _, mode := range csiDriver.Spec.VolumeLifecycleModes {
+		modes = append(modes, string(mode))
+	}
+	return strings.Join(modes, ", ")
+}
+
+func boolValue(b *bool) bool {
+	return b != nil && *b
+}
@@ -0,0 +1,164 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func createCSIDriver(name string) *storagev1.CSIDriver {
+	return &storagev1.CSIDriver{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "storage.k8s.io/v1",
+			Kind:       "CSIDriver",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			UID:               "uid",
+			CreationTimestamp: *testutil.CreateTimestamp(),
+		},
+	}
+}
+
+func createCSINode(name string, drivers ...storagev1.CSINodeDriver) *storagev1.CSINode {
+	return &storagev1.CSINode{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "storage.k8s.io/v1",
+			Kind:       "CSINode",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			UID:               "uid",
+			CreationTimestamp: *testutil.CreateTimestamp(),
+		},
+		Spec: storagev1.CSINodeSpec{
+			Drivers: drivers,
+		},
+	}
+}
+
+func Test_CSIDriverListHandler(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+	printOptions := tpo.ToOptions()
+
+	csiDriver := createCSIDriver("ebs.csi.aws.com")
+	csiDriver.Spec.PodInfoOnMount = pointer.BoolPtr(true)
+	csiDriver.Spec.VolumeLifecycleModes = []storagev1.VolumeLifecycleMode{
+		storagev1.VolumeLifecyclePersistent,
+		storagev1.VolumeLifecycleEphemeral,
+	}
+
+	list := &storagev1.CSIDriverList{
+		Items: []storagev1.CSIDriver{*csiDriver},
+	}
+
+	tpo.PathForObject(csiDriver, csiDriver.Name, "/csi-driver")
+
+	now := testutil.Time()
+
+	ctx := context.Background()
+	got, err := CSIDriverListHandler(ctx, list, printOptions)
+	require.NoError(t, err)
+
+	cols := component.NewTableCols("Name", "Attach Required", "Pod Info On Mount", "Modes", "Age")
+	expected := component.NewTable("CSI Drivers", "We couldn't find any CSI drivers!", cols)
+	expected.Add(component.TableRow{
+		"Name": component.NewLink("", csiDriver.Name, "/csi-driver",
+			genObjectStatus(component.TextStatusOK, []string{
+				"storage.k8s.io/v1 CSIDriver is OK",
+			})),
+		"Attach Required":   component.NewText("true"),
+		"Pod Info On Mount": component.NewText("true"),
+		"Modes":             component.NewText("Persistent, Ephemeral"),
+		"Age":               component.NewTimestamp(now),
+		component.GridActionKey: gridActionsFactory([]component.GridAction{
+			buildObjectDeleteAction(t, csiDriver),
+		}),
+	})
+
+	testutil.AssertJSONEqual(t, expected, got)
+}
+
+func Test_CSIDriverConfiguration(t *testing.T) {
+	csiDriver := createCSIDriver("ebs.csi.aws.com")
+	csiDriver.Spec.AttachRequired = pointer.BoolPtr(false)
+
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+	printOptions := tpo.ToOptions()
+
+	cc := NewCSIDriverConfiguration(csiDriver)
+	got, err := cc.Create(printOptions)
+	require.NoError(t, err)
+
+	sections := component.SummarySections{}
+	sections.AddText("Attach Required", "false")
+	sections.AddText("Pod Info On Mount", "false")
+	sections.AddText("Storage Capacity", "false")
+	sections.AddText("FS Group Policy", "ReadWriteOnceWithFSType")
+	sections.AddText("Volume Lifecycle Modes", "Persistent")
+	expected := component.NewSummary("Configuration", sections...)
+
+	component.AssertEqual(t, expected, got)
+}
+
+func Test_defaultCSIDriverNodes(t *testing.T) {
+	csiDriver := createCSIDriver("ebs.csi.aws.com")
+
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+
+	node1 := createCSINode("node-1", storagev1.CSINodeDriver{
+		Name:         "ebs.csi.aws.com",
+		NodeID:       "i-1",
+		TopologyKeys: []string{"topology.ebs.csi.aws.com/zone"},
+		Allocatable:  &storagev1.VolumeNodeResources{Count: pointer.Int32Ptr(25)},
+	})
+	node2 := createCSINode("node-2", storagev1.CSINodeDriver{
+		Name:   "other.csi.example.com",
+		NodeID: "node-2",
+	})
+
+	tpo.objectStore.EXPECT().
+		List(gomock.Any(), store.Key{APIVersion: "storage.k8s.io/v1", Kind: "CSINode"}).
+		Return(testutil.ToUnstructuredList(t, node1, node2), false, nil)
+
+	tpo.PathForGVK("", "v1", "Node", "node-1", "node-1", "/node-1")
+
+	printOptions := tpo.ToOptions()
+
+	got, err := defaultCSIDriverNodes(context.Background(), csiDriver, printOptions)
+	require.NoError(t, err)
+
+	cols := component.NewTableCols("Node", "Node ID", "Max Volumes", "Topology Keys")
+	expected := component.NewTable("Nodes", "There are no nodes running this driver!", cols)
+	expected.Add(component.TableRow{
+		"Node":          component.NewLink("", "node-1", "/node-1"),
+		"Node ID":       component.NewText("i-1"),
+		"Max Volumes":   component.NewText("25"),
+		"Topology Keys": component.NewText("topology.ebs.csi.aws.com/zone"),
+	})
+
+	component.AssertEqual(t, expected, got)
+}
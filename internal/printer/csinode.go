@@ -0,0 +1,192 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	storagev1 "k8s.io/api/storage/v1"
+
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+// CSINodeListHandler is a printFunc that prints CSI nodes
+func CSINodeListHandler(ctx context.Context, list *storagev1.CSINodeList, options Options) (component.Component, error) {
+	if list == nil {
+		return nil, errors.New("csi node list is nil")
+	}
+
+	cols := component.NewTableCols("Name", "Drivers", "Age")
+	ot := NewObjectTable("CSI Nodes", "We couldn't find any CSI nodes!", cols, options.DashConfig.ObjectStore())
+
+	for _, csiNode := range list.Items {
+		row := component.TableRow{}
+		nameLink, err := options.Link.ForObject(&csiNode, csiNode.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		var drivers []string
+		for _, driver := range csiNode.Spec.Drivers {
+			drivers = append(drivers, driver.Name)
+		}
+
+		row["Name"] = nameLink
+		row["Drivers"] = component.NewText(strings.Join(drivers, ", "))
+		ts := csiNode.CreationTimestamp.Time
+		row["Age"] = component.NewTimestamp(ts)
+
+		if err := ot.AddRowForObject(ctx, &csiNode, row); err != nil {
+			return nil, fmt.Errorf("add row for object: %w", err)
+		}
+	}
+
+	return ot.ToComponent()
+}
+
+// CSINodeHandler is a printFunc that prints a CSI node
+func CSINodeHandler(ctx context.Context, csiNode *storagev1.CSINode, options Options) (component.Component, error) {
+	o := NewObject(csiNode)
+
+	ch, err := newCSINodeHandler(csiNode, o)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ch.Config(options); err != nil {
+		return nil, errors.Wrap(err, "print csinode configuration")
+	}
+
+	if err := ch.Drivers(options); err != nil {
+		return nil, errors.Wrap(err, "print csinode drivers")
+	}
+
+	return o.ToComponent(ctx, options)
+}
+
+// CSINodeConfiguration generates a csinode configuration
+type CSINodeConfiguration struct {
+	csiNode *storagev1.CSINode
+}
+
+// NewCSINodeConfiguration creates an instance of CSINodeConfiguration
+func NewCSINodeConfiguration(csiNode *storagev1.CSINode) *CSINodeConfiguration {
+	return &CSINodeConfiguration{
+		csiNode: csiNode,
+	}
+}
+
+// Create creates a csinode configuration summary
+func (c *CSINodeConfiguration) Create(options Options) (*component.Summary, error) {
+	if c == nil || c.csiNode == nil {
+		return nil, errors.New("csinode is nil")
+	}
+
+	var sections component.SummarySections
+
+	nodeLink, err := options.Link.ForGVK("", "v1", "Node", c.csiNode.Name, c.csiNode.Name)
+	if err != nil {
+		return nil, err
+	}
+	sections.Add("Node", nodeLink)
+
+	summary := component.NewSummary("Configuration", sections...)
+
+	return summary, nil
+}
+
+type csiNodeObject interface {
+	Config(options Options) error
+	Drivers(options Options) error
+}
+
+type csiNodeHandler struct {
+	csiNode     *storagev1.CSINode
+	configFunc  func(*storagev1.CSINode, Options) (*component.Summary, error)
+	driversFunc func(*storagev1.CSINode, Options) (*component.Table, error)
+	object      *Object
+}
+
+var _ csiNodeObject = (*csiNodeHandler)(nil)
+
+func newCSINodeHandler(csiNode *storagev1.CSINode, object *Object) (*csiNodeHandler, error) {
+	if csiNode == nil {
+		return nil, errors.New("can't print a nil csinode")
+	}
+
+	if object == nil {
+		return nil, errors.New("can't print a csinode using an nil object printer")
+	}
+
+	ch := &csiNodeHandler{
+		csiNode:     csiNode,
+		configFunc:  defaultCSINodeConfig,
+		driversFunc: defaultCSINodeDrivers,
+		object:      object,
+	}
+	return ch, nil
+}
+
+func (c *csiNodeHandler) Config(options Options) error {
+	out, err := c.configFunc(c.csiNode, options)
+	if err != nil {
+		return err
+	}
+	c.object.RegisterConfig(out)
+	return nil
+}
+
+func (c *csiNodeHandler) Drivers(options Options) error {
+	c.object.RegisterItems(ItemDescriptor{
+		Width: component.WidthFull,
+		Func: func() (component.Component, error) {
+			return c.driversFunc(c.csiNode, options)
+		},
+	})
+	return nil
+}
+
+func defaultCSINodeConfig(csiNode *storagev1.CSINode, options Options) (*component.Summary, error) {
+	return NewCSINodeConfiguration(csiNode).Create(options)
+}
+
+// defaultCSINodeDrivers lists the CSI drivers registered on a node.
+func defaultCSINodeDrivers(csiNode *storagev1.CSINode, options Options) (*component.Table, error) {
+	if csiNode == nil {
+		return nil, errors.New("csinode is nil")
+	}
+
+	cols := component.NewTableCols("Driver", "Node ID", "Max Volumes", "Topology Keys")
+	table := component.NewTable("Drivers", "There are no CSI drivers registered on this node!", cols)
+
+	for _, driver := range csiNode.Spec.Drivers {
+		driverLink, err := options.Link.ForGVK("", "storage.k8s.io/v1", "CSIDriver", driver.Name, driver.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		table.Add(component.TableRow{
+			"Driver":        driverLink,
+			"Node ID":       component.NewText(driver.NodeID),
+			"Max Volumes":   component.NewText(csiNodeDriverMaxVolumes(driver)),
+			"Topology Keys": component.NewText(strings.Join(driver.TopologyKeys, ", ")),
+		})
+	}
+
+	return table, nil
+}
+
+// csiNodeDriverMaxVolumes describes how many volumes of a driver can be used
+// on a node.
+func csiNodeDriverMaxVolumes(driver storagev1.CSINodeDriver) string {
+	if driver.Allocatable == nil || driver.Allocatable.Count == nil {
+		return "Unlimited"
+	}
+	return fmt.Sprintf("%d", *driver.Allocatable.Count)
+}
@@ -0,0 +1,88 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	storagev1 "k8s.io/api/storage/v1"
+
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func Test_CSINodeListHandler(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+	printOptions := tpo.ToOptions()
+
+	csiNode := createCSINode("node",
+		storagev1.CSINodeDriver{Name: "ebs.csi.aws.com"},
+		storagev1.CSINodeDriver{Name: "efs.csi.aws.com"})
+
+	list := &storagev1.CSINodeList{
+		Items: []storagev1.CSINode{*csiNode},
+	}
+
+	tpo.PathForObject(csiNode, csiNode.Name, "/csi-node")
+
+	now := testutil.Time()
+
+	ctx := context.Background()
+	got, err := CSINodeListHandler(ctx, list, printOptions)
+	require.NoError(t, err)
+
+	cols := component.NewTableCols("Name", "Drivers", "Age")
+	expected := component.NewTable("CSI Nodes", "We couldn't find any CSI nodes!", cols)
+	expected.Add(component.TableRow{
+		"Name": component.NewLink("", csiNode.Name, "/csi-node",
+			genObjectStatus(component.TextStatusOK, []string{
+				"storage.k8s.io/v1 CSINode is OK",
+			})),
+		"Drivers": component.NewText("ebs.csi.aws.com, efs.csi.aws.com"),
+		"Age":     component.NewTimestamp(now),
+		component.GridActionKey: gridActionsFactory([]component.GridAction{
+			buildObjectDeleteAction(t, csiNode),
+		}),
+	})
+
+	testutil.AssertJSONEqual(t, expected, got)
+}
+
+func Test_defaultCSINodeDrivers(t *testing.T) {
+	csiNode := createCSINode("node", storagev1.CSINodeDriver{
+		Name:         "ebs.csi.aws.com",
+		NodeID:       "i-1",
+		TopologyKeys: []string{"topology.ebs.csi.aws.com/zone"},
+	})
+
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+	tpo.PathForGVK("", "storage.k8s.io/v1", "CSIDriver", "ebs.csi.aws.com", "ebs.csi.aws.com", "/csi-driver")
+
+	printOptions := tpo.ToOptions()
+
+	got, err := defaultCSINodeDrivers(csiNode, printOptions)
+	require.NoError(t, err)
+
+	cols := component.NewTableCols("Driver", "Node ID", "Max Volumes", "Topology Keys")
+	expected := component.NewTable("Drivers", "There are no CSI drivers registered on this node!", cols)
+	expected.Add(component.TableRow{
+		"Driver":        component.NewLink("", "ebs.csi.aws.com", "/csi-driver"),
+		"Node ID":       component.NewText("i-1"),
+		"Max Volumes":   component.NewText("Unlimited"),
+		"Topology Keys": component.NewText("topology.ebs.csi.aws.com/zone"),
+	})
+
+	component.AssertEqual(t, expected, got)
+}
@@ -149,6 +149,40 @@ func printCustomColumn(m interface{}, column octant.CustomResourceDefinitionPrin
 	return buf.String(), nil
 }
 
+// customResourceItemFunc prints an item for a well known custom resource.
+type customResourceItemFunc func(ctx context.Context, cr *unstructured.Unstructured, options Options) (component.Component, error)
+
+// customResourceItem is an item printed for a well known custom resource.
+type customResourceItem struct {
+	printFunc customResourceItemFunc
+	width     int
+}
+
+// wellKnownCustomResources are custom resources with built in printers. They
+// are shown in addition to the CRD's printer columns.
+var wellKnownCustomResources = map[schema.GroupKind][]customResourceItem{
+	{Group: certManagerGroup, Kind: "Certificate"}: {
+		{printFunc: CertManagerCertificateHandler, width: component.WidthHalf},
+	},
+	{Group: certManagerGroup, Kind: "Issuer"}: {
+		{printFunc: CertManagerIssuerHandler, width: component.WidthHalf},
+	},
+	{Group: certManagerGroup, Kind: "ClusterIssuer"}: {
+		{printFunc: CertManagerIssuerHandler, width: component.WidthHalf},
+	},
+	{Group: istioNetworkingGroup, Kind: "VirtualService"}: {
+		{printFunc: IstioVirtualServiceRoutesHandler, width: component.WidthFull},
+	},
+	{Group: istioNetworkingGroup, Kind: "DestinationRule"}: {
+		{printFunc: IstioDestinationRuleHostHandler, width: component.WidthHalf},
+		{printFunc: IstioDestinationRuleSubsetsHandler, width: component.WidthHalf},
+	},
+	{Group: istioNetworkingGroup, Kind: "Gateway"}: {
+		{printFunc: IstioGatewayServersHandler, width: component.WidthHalf},
+		{printFunc: IstioGatewayWorkloadsHandler, width: component.WidthHalf},
+	},
+}
+
 // CustomResourceHandler prints custom resource objects. If the
 // object has columns specified, it will print those columns as well.
 func CustomResourceHandler(ctx context.Context, crd, cr *unstructured.Unstructured, options Options) (component.Component, error) {
@@ -168,6 +202,16 @@ func CustomResourceHandler(ctx context.Context, crd, cr *unstructured.Unstructur
 		return nil, fmt.Errorf("print custom resource status: %w", err)
 	}
 
+	for _, item := range wellKnownCustomResources[cr.GroupVersionKind().GroupKind()] {
+		printFunc := item.printFunc
+		object.RegisterItems(ItemDescriptor{
+			Func: func() (component.Component, error) {
+				return printFunc(ctx, cr, options)
+			},
+			Width: item.width,
+		})
+	}
+
 	crdTool, err := octant.NewCustomResourceDefinitionTool(crd)
 	if err != nil {
 		return nil, fmt.Errorf("create octant CRD parser: %w", err)
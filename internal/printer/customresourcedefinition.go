@@ -155,6 +155,7 @@ func genViewForCRDVersion(
 
 	key := store.KeyFromGroupVersionKind(crGVK)
 	key.Namespace = namespace
+	key.Selector = options.LabelSet
 
 	customResources, _, err := objectStore.List(ctx, key)
 	if err != nil {
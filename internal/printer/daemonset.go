@@ -11,8 +11,13 @@ import (
 
 	"github.com/pkg/errors"
 	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 
+	"github.com/vmware-tanzu/octant/internal/util/kubernetes"
+	"github.com/vmware-tanzu/octant/pkg/store"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
 
@@ -72,6 +77,10 @@ func DaemonSetHandler(ctx context.Context, daemonSet *appsv1.DaemonSet, options
 		return nil, errors.Wrap(err, "print daemonset pods")
 	}
 
+	if err := dsh.Nodes(ctx, options); err != nil {
+		return nil, errors.Wrap(err, "print daemonset nodes")
+	}
+
 	return o.ToComponent(ctx, options)
 }
 
@@ -146,6 +155,7 @@ type daemonSetObject interface {
 	Config(options Options) error
 	Status(options Options) error
 	Pods(ctx context.Context, object runtime.Object, options Options) error
+	Nodes(ctx context.Context, options Options) error
 }
 
 type daemonSetHandler struct {
@@ -153,6 +163,7 @@ type daemonSetHandler struct {
 	configFunc func(*appsv1.DaemonSet, Options) (*component.Summary, error)
 	statusFunc func(*appsv1.DaemonSet, Options) (*component.Summary, error)
 	podFunc    func(context.Context, runtime.Object, Options) (component.Component, error)
+	nodeFunc   func(context.Context, *appsv1.DaemonSet, Options) (component.Component, error)
 	object     *Object
 }
 
@@ -172,6 +183,7 @@ func newDaemonSetHandler(daemonSet *appsv1.DaemonSet, object *Object) (*daemonSe
 		configFunc: defaultDaemonSetConfig,
 		statusFunc: defaultDaemonSetSummary,
 		podFunc:    defaultDaemonSetPods,
+		nodeFunc:   defaultDaemonSetNodes,
 		object:     object,
 	}
 
@@ -220,3 +232,189 @@ func (d *daemonSetHandler) Pods(ctx context.Context, object runtime.Object, opti
 func defaultDaemonSetPods(ctx context.Context, object runtime.Object, options Options) (component.Component, error) {
 	return createPodListView(ctx, object, options)
 }
+
+func (d *daemonSetHandler) Nodes(ctx context.Context, options Options) error {
+	d.object.RegisterItems(ItemDescriptor{
+		Width: component.WidthFull,
+		Func: func() (component.Component, error) {
+			return d.nodeFunc(ctx, d.daemonSet, options)
+		},
+	})
+	return nil
+}
+
+func defaultDaemonSetNodes(ctx context.Context, daemonSet *appsv1.DaemonSet, options Options) (component.Component, error) {
+	return createDaemonSetNodesView(ctx, daemonSet, options)
+}
+
+// daemonSetDefaultTolerations are the tolerations the daemon set controller
+// adds to every daemon set pod regardless of the pod template.
+var daemonSetDefaultTolerations = []corev1.Toleration{
+	{Key: "node.kubernetes.io/not-ready", Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoExecute},
+	{Key: "node.kubernetes.io/unreachable", Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoExecute},
+	{Key: "node.kubernetes.io/disk-pressure", Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoSchedule},
+	{Key: "node.kubernetes.io/memory-pressure", Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoSchedule},
+	{Key: "node.kubernetes.io/pid-pressure", Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoSchedule},
+	{Key: "node.kubernetes.io/unschedulable", Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoSchedule},
+}
+
+// createDaemonSetNodesView creates a table listing every node in the cluster
+// and whether the daemon set has a pod running or pending on it, or why the
+// node is excluded.
+func createDaemonSetNodesView(ctx context.Context, daemonSet *appsv1.DaemonSet, options Options) (component.Component, error) {
+	if daemonSet == nil {
+		return nil, errors.New("daemon set is nil")
+	}
+
+	objectStore := options.DashConfig.ObjectStore()
+
+	nodeList, _, err := objectStore.List(ctx, store.Key{APIVersion: "v1", Kind: "Node"})
+	if err != nil {
+		return nil, errors.Wrap(err, "list nodes")
+	}
+
+	podKey := store.Key{
+		Namespace:  daemonSet.Namespace,
+		APIVersion: "v1",
+		Kind:       "Pod",
+		OwnerUID:   daemonSet.UID,
+	}
+	podList, _, err := objectStore.List(ctx, podKey)
+	if err != nil {
+		return nil, errors.Wrapf(err, "list all objects for key %+v", podKey)
+	}
+
+	podsByNode := map[string]*corev1.Pod{}
+	for i := range podList.Items {
+		pod := &corev1.Pod{}
+		if err := kubernetes.FromUnstructured(&podList.Items[i], pod); err != nil {
+			return nil, err
+		}
+
+		if !metav1.IsControlledBy(pod, daemonSet) {
+			continue
+		}
+
+		if nodeName := daemonSetPodNodeName(pod); nodeName != "" {
+			podsByNode[nodeName] = pod
+		}
+	}
+
+	cols := component.NewTableCols("Node", "Status", "Pod", "Reason")
+	table := component.NewTable("Nodes", "We couldn't find any nodes!", cols)
+
+	for i := range nodeList.Items {
+		node := &corev1.Node{}
+		if err := kubernetes.FromUnstructured(&nodeList.Items[i], node); err != nil {
+			return nil, err
+		}
+
+		nodeLink, err := options.Link.ForGVK("", "v1", "Node", node.Name, node.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		row := component.TableRow{
+			"Node": nodeLink,
+		}
+
+		if pod, ok := podsByNode[node.Name]; ok {
+			podLink, err := options.Link.ForObject(pod, pod.Name)
+			if err != nil {
+				return nil, err
+			}
+
+			status := component.NewText(string(pod.Status.Phase))
+			switch pod.Status.Phase {
+			case corev1.PodRunning:
+				status.SetStatus(component.TextStatusOK)
+			case corev1.PodFailed, corev1.PodUnknown:
+				status.SetStatus(component.TextStatusError)
+			default:
+				status.SetStatus(component.TextStatusWarning)
+			}
+
+			row["Status"] = status
+			row["Pod"] = podLink
+			row["Reason"] = component.NewText("")
+		} else {
+			status := component.NewText("Excluded")
+			reason := daemonSetExclusionReason(daemonSet, node)
+			if reason == "" {
+				status = component.NewText("Not Scheduled")
+				status.SetStatus(component.TextStatusWarning)
+			}
+
+			row["Status"] = status
+			row["Pod"] = component.NewText("")
+			row["Reason"] = component.NewText(reason)
+		}
+
+		table.Add(row)
+	}
+
+	table.Sort("Node")
+
+	return table, nil
+}
+
+// daemonSetPodNodeName returns the node a daemon set pod is bound to, or the
+// node it targets through the node affinity the daemon set controller adds
+// while the pod is waiting to be scheduled.
+func daemonSetPodNodeName(pod *corev1.Pod) string {
+	if pod.Spec.NodeName != "" {
+		return pod.Spec.NodeName
+	}
+
+	affinity := pod.Spec.Affinity
+	if affinity == nil || affinity.NodeAffinity == nil ||
+		affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution == nil {
+		return ""
+	}
+
+	for _, term := range affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms {
+		for _, field := range term.MatchFields {
+			if field.Key == "metadata.name" && field.Operator == corev1.NodeSelectorOpIn && len(field.Values) == 1 {
+				return field.Values[0]
+			}
+		}
+	}
+
+	return ""
+}
+
+// daemonSetExclusionReason returns why a daemon set will not place a pod on
+// a node. It returns an empty string if the node is eligible.
+func daemonSetExclusionReason(daemonSet *appsv1.DaemonSet, node *corev1.Node) string {
+	podSpec := daemonSet.Spec.Template.Spec
+
+	if !labels.SelectorFromSet(podSpec.NodeSelector).Matches(labels.Set(node.Labels)) {
+		return "Node labels do not match node selector"
+	}
+
+	var tolerations []corev1.Toleration
+	tolerations = append(tolerations, podSpec.Tolerations...)
+	tolerations = append(tolerations, daemonSetDefaultTolerations...)
+	for i := range node.Spec.Taints {
+		taint := node.Spec.Taints[i]
+		if taint.Effect == corev1.TaintEffectPreferNoSchedule {
+			continue
+		}
+
+		if !toleratesTaint(tolerations, &taint) {
+			return fmt.Sprintf("Taint %s is not tolerated", taint.ToString())
+		}
+	}
+
+	return ""
+}
+
+func toleratesTaint(tolerations []corev1.Toleration, taint *corev1.Taint) bool {
+	for i := range tolerations {
+		if tolerations[i].ToleratesTaint(taint) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -208,6 +208,7 @@ func Test_DaemonSetPods(t *testing.T) {
 		Namespace:  "namespace",
 		APIVersion: "v1",
 		Kind:       "Pod",
+		OwnerUID:   daemonSet.UID,
 	}
 
 	tpo.objectStore.EXPECT().List(gomock.Any(), gomock.Eq(key)).Return(podList, false, nil)
@@ -236,3 +237,129 @@ func Test_DaemonSetPods(t *testing.T) {
 
 	component.AssertEqual(t, expected, got)
 }
+
+func Test_createDaemonSetNodesView(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+	printOptions := tpo.ToOptions()
+
+	daemonSet := testutil.CreateDaemonSet("ds")
+	daemonSet.Spec.Template.Spec.NodeSelector = map[string]string{"role": "worker"}
+	daemonSet.Spec.Template.Spec.Tolerations = []corev1.Toleration{
+		{Key: "dedicated", Operator: corev1.TolerationOpEqual, Value: "ds", Effect: corev1.TaintEffectNoSchedule},
+	}
+
+	workerLabels := map[string]string{"role": "worker"}
+
+	running := testutil.CreateNode("node-a")
+	running.Labels = workerLabels
+
+	pending := testutil.CreateNode("node-b")
+	pending.Labels = workerLabels
+
+	selectorExcluded := testutil.CreateNode("node-c")
+	selectorExcluded.Labels = map[string]string{"role": "master"}
+
+	taintExcluded := testutil.CreateNode("node-d")
+	taintExcluded.Labels = workerLabels
+	taintExcluded.Spec.Taints = []corev1.Taint{
+		{Key: "gpu", Value: "true", Effect: corev1.TaintEffectNoSchedule},
+	}
+
+	tolerated := testutil.CreateNode("node-e")
+	tolerated.Labels = workerLabels
+	tolerated.Spec.Taints = []corev1.Taint{
+		{Key: "dedicated", Value: "ds", Effect: corev1.TaintEffectNoSchedule},
+		{Key: "node.kubernetes.io/not-ready", Effect: corev1.TaintEffectNoExecute},
+	}
+
+	runningPod := testutil.CreatePod("ds-a")
+	runningPod.OwnerReferences = testutil.ToOwnerReferences(t, daemonSet)
+	runningPod.Spec.NodeName = running.Name
+	runningPod.Status.Phase = corev1.PodRunning
+
+	pendingPod := testutil.CreatePod("ds-b")
+	pendingPod.OwnerReferences = testutil.ToOwnerReferences(t, daemonSet)
+	pendingPod.Spec.Affinity = &corev1.Affinity{
+		NodeAffinity: &corev1.NodeAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+				NodeSelectorTerms: []corev1.NodeSelectorTerm{
+					{
+						MatchFields: []corev1.NodeSelectorRequirement{
+							{Key: "metadata.name", Operator: corev1.NodeSelectorOpIn, Values: []string{pending.Name}},
+						},
+					},
+				},
+			},
+		},
+	}
+	pendingPod.Status.Phase = corev1.PodPending
+
+	otherPod := testutil.CreatePod("other")
+	otherPod.Spec.NodeName = tolerated.Name
+
+	ctx := context.Background()
+
+	tpo.objectStore.EXPECT().
+		List(gomock.Any(), store.Key{APIVersion: "v1", Kind: "Node"}).
+		Return(testutil.ToUnstructuredList(t, tolerated, taintExcluded, selectorExcluded, pending, running), false, nil)
+	tpo.objectStore.EXPECT().
+		List(gomock.Any(), store.Key{Namespace: "namespace", APIVersion: "v1", Kind: "Pod", OwnerUID: daemonSet.UID}).
+		Return(testutil.ToUnstructuredList(t, runningPod, pendingPod, otherPod), false, nil)
+
+	for _, node := range []*corev1.Node{running, pending, selectorExcluded, taintExcluded, tolerated} {
+		tpo.PathForGVK("", "v1", "Node", node.Name, node.Name, "/"+node.Name)
+	}
+
+	for _, pod := range []*corev1.Pod{runningPod, pendingPod} {
+		l := component.NewLink("", pod.Name, "/"+pod.Name)
+		tpo.link.EXPECT().ForObject(gomock.Any(), pod.Name).Return(l, nil)
+	}
+
+	got, err := createDaemonSetNodesView(ctx, daemonSet, printOptions)
+	require.NoError(t, err)
+
+	statusText := func(s string, status component.TextStatus) *component.Text {
+		text := component.NewText(s)
+		text.SetStatus(status)
+		return text
+	}
+
+	cols := component.NewTableCols("Node", "Status", "Pod", "Reason")
+	expected := component.NewTableWithRows("Nodes", "We couldn't find any nodes!", cols, []component.TableRow{
+		{
+			"Node":   component.NewLink("", "node-a", "/node-a"),
+			"Status": statusText("Running", component.TextStatusOK),
+			"Pod":    component.NewLink("", "ds-a", "/ds-a"),
+			"Reason": component.NewText(""),
+		},
+		{
+			"Node":   component.NewLink("", "node-b", "/node-b"),
+			"Status": statusText("Pending", component.TextStatusWarning),
+			"Pod":    component.NewLink("", "ds-b", "/ds-b"),
+			"Reason": component.NewText(""),
+		},
+		{
+			"Node":   component.NewLink("", "node-c", "/node-c"),
+			"Status": component.NewText("Excluded"),
+			"Pod":    component.NewText(""),
+			"Reason": component.NewText("Node labels do not match node selector"),
+		},
+		{
+			"Node":   component.NewLink("", "node-d", "/node-d"),
+			"Status": component.NewText("Excluded"),
+			"Pod":    component.NewText(""),
+			"Reason": component.NewText("Taint gpu=true:NoSchedule is not tolerated"),
+		},
+		{
+			"Node":   component.NewLink("", "node-e", "/node-e"),
+			"Status": statusText("Not Scheduled", component.TextStatusWarning),
+			"Pod":    component.NewText(""),
+			"Reason": component.NewText(""),
+		},
+	})
+
+	component.AssertEqual(t, expected, got)
+}
@@ -15,8 +15,10 @@ import (
 	"github.com/pkg/errors"
 	appsv1 "k8s.io/api/apps/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 
+	"github.com/vmware-tanzu/octant/pkg/action"
 	"github.com/vmware-tanzu/octant/pkg/store"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
@@ -45,6 +47,9 @@ func DeploymentListHandler(ctx context.Context, list *appsv1.DeploymentList, opt
 		row["Labels"] = component.NewLabels(d.Labels)
 
 		status := fmt.Sprintf("%d/%d", d.Status.AvailableReplicas, d.Status.AvailableReplicas+d.Status.UnavailableReplicas)
+		if d.Spec.Paused {
+			status = fmt.Sprintf("%s (Paused)", status)
+		}
 		row["Status"] = component.NewText(status)
 
 		ts := d.CreationTimestamp.Time
@@ -58,6 +63,8 @@ func DeploymentListHandler(ctx context.Context, list *appsv1.DeploymentList, opt
 		row["Containers"] = containers
 		row["Selector"] = printSelector(d.Spec.Selector)
 
+		row.AddAction(deploymentRolloutGridAction(d))
+
 		if err := ot.AddRowForObject(ctx, &d, row); err != nil {
 			return nil, fmt.Errorf("add row for object: %w", err)
 		}
@@ -71,6 +78,9 @@ func DeploymentHandler(ctx context.Context, deployment *appsv1.Deployment, optio
 	o := NewObject(deployment)
 	o.EnableEvents()
 
+	rolloutAction := deploymentRolloutGridAction(*deployment)
+	o.AddButton(rolloutAction.Name, action.CreatePayload(rolloutAction.ActionPath, rolloutAction.Payload))
+
 	dh, err := newDeploymentHandler(deployment, o)
 	if err != nil {
 		return nil, err
@@ -85,6 +95,9 @@ func DeploymentHandler(ctx context.Context, deployment *appsv1.Deployment, optio
 	if err := dh.Pods(ctx, deployment, options); err != nil {
 		return nil, errors.Wrap(err, "print deployment pods")
 	}
+	if err := dh.ReplicaSets(ctx, options); err != nil {
+		return nil, errors.Wrap(err, "print deployment replica sets")
+	}
 	if err := dh.Conditions(); err != nil {
 		return nil, errors.Wrap(err, "print deployment conditions")
 	}
@@ -99,7 +112,12 @@ func createDeploymentSummaryStatus(deployment *appsv1.Deployment) (*component.Su
 
 	status := deployment.Status
 
-	summary := component.NewSummary("Status", []component.SummarySection{
+	var sections component.SummarySections
+	if deployment.Spec.Paused {
+		sections.AddText("Rollout", "Paused")
+	}
+
+	sections = append(sections, []component.SummarySection{
 		{
 			Header:  "Available Replicas",
 			Content: component.NewText(fmt.Sprintf("%d", status.AvailableReplicas)),
@@ -122,9 +140,38 @@ func createDeploymentSummaryStatus(deployment *appsv1.Deployment) (*component.Su
 		},
 	}...)
 
+	summary := component.NewSummary("Status", sections...)
+
 	return summary, nil
 }
 
+// deploymentRolloutGridAction creates an action which pauses the rollout of a
+// deployment, or resumes it if the deployment is paused.
+func deploymentRolloutGridAction(deployment appsv1.Deployment) component.GridAction {
+	payload := action.Payload{
+		"namespace":  deployment.Namespace,
+		"apiVersion": deployment.APIVersion,
+		"kind":       deployment.Kind,
+		"name":       deployment.Name,
+	}
+
+	if deployment.Spec.Paused {
+		return component.GridAction{
+			Name:       "Resume Rollout",
+			ActionPath: octant.ActionResumeDeployment,
+			Payload:    payload,
+			Type:       component.GridActionDanger,
+		}
+	}
+
+	return component.GridAction{
+		Name:       "Pause Rollout",
+		ActionPath: octant.ActionPauseDeployment,
+		Payload:    payload,
+		Type:       component.GridActionDanger,
+	}
+}
+
 func createDeploymentConditionsView(deployment *appsv1.Deployment) (*component.Table, error) {
 	if deployment == nil {
 		return nil, errors.New("unable to generate conditions from a nil deployment")
@@ -287,6 +334,7 @@ type deploymentObject interface {
 	Config() error
 	Status() error
 	Pods(ctx context.Context, object runtime.Object, options Options) error
+	ReplicaSets(ctx context.Context, options Options) error
 	Conditions() error
 }
 
@@ -295,6 +343,7 @@ type deploymentHandler struct {
 	configFunc     func(*appsv1.Deployment) (*component.Summary, error)
 	summaryFunc    func(*appsv1.Deployment) (*component.Summary, error)
 	podFunc        func(context.Context, []runtime.Object, Options) (component.Component, error)
+	replicaSetFunc func(context.Context, *appsv1.Deployment, Options) (component.Component, error)
 	conditionsFunc func(*appsv1.Deployment) (*component.Table, error)
 	object         *Object
 }
@@ -315,6 +364,7 @@ func newDeploymentHandler(deployment *appsv1.Deployment, object *Object) (*deplo
 		configFunc:     defaultDeploymentConfig,
 		summaryFunc:    defaultDeploymentSummary,
 		podFunc:        defaultDeploymentPods,
+		replicaSetFunc: defaultDeploymentReplicaSets,
 		conditionsFunc: defaultDeploymentConditions,
 		object:         object,
 	}
@@ -396,6 +446,85 @@ func defaultDeploymentPods(ctx context.Context, objects []runtime.Object, option
 	return createRollingPodListView(ctx, objects, options)
 }
 
+func (d *deploymentHandler) ReplicaSets(ctx context.Context, options Options) error {
+	d.object.RegisterItems(ItemDescriptor{
+		Width: component.WidthFull,
+		Func: func() (component.Component, error) {
+			return d.replicaSetFunc(ctx, d.deployment, options)
+		},
+	})
+
+	return nil
+}
+
+func defaultDeploymentReplicaSets(ctx context.Context, deployment *appsv1.Deployment, options Options) (component.Component, error) {
+	return createDeploymentReplicaSetsView(ctx, deployment, options)
+}
+
+// createDeploymentReplicaSetsView creates a table of every replica set
+// controlled by a deployment, including the inactive ones left over from
+// previous rollouts.
+func createDeploymentReplicaSetsView(ctx context.Context, deployment *appsv1.Deployment, options Options) (component.Component, error) {
+	if deployment == nil {
+		return nil, errors.New("deployment is nil")
+	}
+
+	objectStore := options.DashConfig.ObjectStore()
+
+	key := store.Key{
+		Namespace:  deployment.Namespace,
+		APIVersion: "apps/v1",
+		Kind:       "ReplicaSet",
+		OwnerUID:   deployment.UID,
+	}
+
+	list, _, err := objectStore.List(ctx, key)
+	if err != nil {
+		return nil, errors.Wrapf(err, "list all objects for key %+v", key)
+	}
+
+	cols := component.NewTableCols("Name", "Revision", "Status", "Notes", "Age")
+	ot := NewObjectTable("Replica Sets", "We couldn't find any replica sets!", cols, objectStore)
+
+	for i := range list.Items {
+		replicaSet := &appsv1.ReplicaSet{}
+		if err := kubernetes.FromUnstructured(&list.Items[i], replicaSet); err != nil {
+			return nil, err
+		}
+
+		if !metav1.IsControlledBy(replicaSet, deployment) {
+			continue
+		}
+
+		nameLink, err := options.Link.ForObject(replicaSet, replicaSet.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		notes := component.NewText("")
+		if isInactiveReplicaSet(replicaSet) {
+			notes = component.NewText("Inactive")
+			notes.SetStatus(component.TextStatusWarning)
+		}
+
+		row := component.TableRow{
+			"Name":     nameLink,
+			"Revision": component.NewText(replicaSet.Annotations["deployment.kubernetes.io/revision"]),
+			"Status":   component.NewText(fmt.Sprintf("%d/%d", replicaSet.Status.AvailableReplicas, replicaSet.Status.Replicas)),
+			"Notes":    notes,
+			"Age":      component.NewTimestamp(replicaSet.CreationTimestamp.Time),
+		}
+
+		if err := ot.AddRowForObject(ctx, replicaSet, row); err != nil {
+			return nil, fmt.Errorf("add row for object: %w", err)
+		}
+	}
+
+	ot.SetSortOrder("Age", true)
+
+	return ot.ToComponent()
+}
+
 func listReplicaSetsAsObjects(ctx context.Context, object runtime.Object, options Options) ([]runtime.Object, error) {
 	objectStore := options.DashConfig.ObjectStore()
 	var replicaSetList []*appsv1.ReplicaSet
@@ -422,10 +551,16 @@ func listReplicaSetsAsObjects(ctx context.Context, object runtime.Object, option
 		return nil, errors.Wrap(err, "get name for object")
 	}
 
+	uid, err := accessor.UID(object)
+	if err != nil {
+		return nil, errors.Wrap(err, "get uid for object")
+	}
+
 	key := store.Key{
 		Namespace:  namespace,
 		APIVersion: "apps/v1",
 		Kind:       "ReplicaSet",
+		OwnerUID:   uid,
 	}
 
 	list, _, err := objectStore.List(ctx, key)
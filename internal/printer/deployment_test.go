@@ -24,6 +24,7 @@ import (
 
 	"github.com/vmware-tanzu/octant/internal/conversion"
 	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/action"
 	"github.com/vmware-tanzu/octant/pkg/store"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
@@ -109,6 +110,17 @@ func Test_DeploymentListHandler(t *testing.T) {
 		"Status":     component.NewText("2/3"),
 		"Containers": containers,
 		component.GridActionKey: gridActionsFactory([]component.GridAction{
+			{
+				Name:       "Pause Rollout",
+				ActionPath: octant.ActionPauseDeployment,
+				Payload: action.Payload{
+					"namespace":  "default",
+					"apiVersion": "apps/v1",
+					"kind":       "Deployment",
+					"name":       "deployment",
+				},
+				Type: component.GridActionDanger,
+			},
 			buildObjectDeleteAction(t, object),
 		}),
 	})
@@ -245,6 +257,42 @@ func Test_createDeploymentSummaryStatus(t *testing.T) {
 	assert.Equal(t, expected, got)
 }
 
+func Test_createDeploymentSummaryStatus_paused(t *testing.T) {
+	deployment := testutil.CreateDeployment("deployment")
+	deployment.Spec.Paused = true
+
+	got, err := createDeploymentSummaryStatus(deployment)
+	require.NoError(t, err)
+
+	require.NotEmpty(t, got.Sections())
+	assert.Equal(t, component.SummarySection{Header: "Rollout", Content: component.NewText("Paused")}, got.Sections()[0])
+}
+
+func Test_deploymentRolloutGridAction(t *testing.T) {
+	deployment := testutil.CreateDeployment("deployment")
+	payload := action.Payload{
+		"namespace":  deployment.Namespace,
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"name":       "deployment",
+	}
+
+	assert.Equal(t, component.GridAction{
+		Name:       "Pause Rollout",
+		ActionPath: octant.ActionPauseDeployment,
+		Payload:    payload,
+		Type:       component.GridActionDanger,
+	}, deploymentRolloutGridAction(*deployment))
+
+	deployment.Spec.Paused = true
+	assert.Equal(t, component.GridAction{
+		Name:       "Resume Rollout",
+		ActionPath: octant.ActionResumeDeployment,
+		Payload:    payload,
+		Type:       component.GridActionDanger,
+	}, deploymentRolloutGridAction(*deployment))
+}
+
 func Test_createDeploymentConditionsView(t *testing.T) {
 	now := metav1.Time{Time: time.Now()}
 
@@ -319,12 +367,14 @@ func Test_DeploymentPods(t *testing.T) {
 		Namespace:  "namespace",
 		APIVersion: "v1",
 		Kind:       "Pod",
+		OwnerUID:   replicaSet.UID,
 	}
 
 	replicaSetKey := store.Key{
 		Namespace:  "namespace",
 		APIVersion: "apps/v1",
 		Kind:       "ReplicaSet",
+		OwnerUID:   deployment.UID,
 	}
 
 	tpo.objectStore.EXPECT().List(gomock.Any(), replicaSetKey).
@@ -360,6 +410,81 @@ func Test_DeploymentPods(t *testing.T) {
 	component.AssertEqual(t, expected, got)
 }
 
+func Test_createDeploymentReplicaSetsView(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+	printOptions := tpo.ToOptions()
+
+	now := testutil.Time()
+
+	deployment := testutil.CreateDeployment("deployment")
+
+	current := testutil.CreateAppReplicaSet("current")
+	current.CreationTimestamp = metav1.Time{Time: now}
+	current.Annotations = map[string]string{"deployment.kubernetes.io/revision": "2"}
+	current.Spec.Replicas = conversion.PtrInt32(1)
+	current.Status.Replicas = 1
+	current.Status.AvailableReplicas = 1
+	current.SetOwnerReferences(testutil.ToOwnerReferences(t, deployment))
+
+	previous := testutil.CreateAppReplicaSet("previous")
+	previous.CreationTimestamp = metav1.Time{Time: now.Add(-time.Hour)}
+	previous.Annotations = map[string]string{"deployment.kubernetes.io/revision": "1"}
+	previous.Spec.Replicas = conversion.PtrInt32(0)
+	previous.SetOwnerReferences(testutil.ToOwnerReferences(t, deployment))
+
+	replicaSetKey := store.Key{
+		Namespace:  "namespace",
+		APIVersion: "apps/v1",
+		Kind:       "ReplicaSet",
+		OwnerUID:   deployment.UID,
+	}
+
+	tpo.objectStore.EXPECT().List(gomock.Any(), replicaSetKey).
+		Return(testutil.ToUnstructuredList(t, previous, current), false, nil)
+
+	tpo.link.EXPECT().ForObject(gomock.Any(), current.Name).
+		Return(component.NewLink("", current.Name, "/current"), nil)
+	tpo.link.EXPECT().ForObject(gomock.Any(), previous.Name).
+		Return(component.NewLink("", previous.Name, "/previous"), nil)
+
+	got, err := createDeploymentReplicaSetsView(context.Background(), deployment, printOptions)
+	require.NoError(t, err)
+
+	inactive := component.NewText("Inactive")
+	inactive.SetStatus(component.TextStatusWarning)
+
+	cols := component.NewTableCols("Name", "Revision", "Status", "Notes", "Age")
+	expected := component.NewTableWithRows("Replica Sets", "We couldn't find any replica sets!", cols, []component.TableRow{
+		{
+			"Name": component.NewLink("", current.Name, "/current",
+				genObjectStatus(component.TextStatusOK, []string{"Replica Set is OK"})),
+			"Revision": component.NewText("2"),
+			"Status":   component.NewText("1/1"),
+			"Notes":    component.NewText(""),
+			"Age":      component.NewTimestamp(now),
+			component.GridActionKey: gridActionsFactory([]component.GridAction{
+				buildObjectDeleteAction(t, current),
+			}),
+		},
+		{
+			"Name": component.NewLink("", previous.Name, "/previous",
+				genObjectStatus(component.TextStatusOK, []string{"Replica Set is OK"})),
+			"Revision": component.NewText("1"),
+			"Status":   component.NewText("0/0"),
+			"Notes":    inactive,
+			"Age":      component.NewTimestamp(now.Add(-time.Hour)),
+			component.GridActionKey: gridActionsFactory([]component.GridAction{
+				buildObjectDeleteAction(t, previous),
+			}),
+		},
+	})
+
+	component.AssertEqual(t, expected, got)
+}
+
 func Test_editDeploymentAction(t *testing.T) {
 	deployment := testutil.CreateDeployment("deployment")
 	deployment.Spec.Replicas = pointer.Int32Ptr(3)
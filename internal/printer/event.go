@@ -11,6 +11,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
@@ -245,6 +246,18 @@ func createEventsForObject(ctx context.Context, fl *flexlayout.FlexLayout, objec
 		}
 	}
 
+	timeline, err := objectTimeline(ctx, object, eventList, objectStore)
+	if err != nil {
+		return errors.Wrap(err, "create timeline for object")
+	}
+
+	if !timeline.IsEmpty() {
+		timelineSection := fl.AddSection()
+		if err := timelineSection.Add(timeline, 24); err != nil {
+			return errors.Wrap(err, "add timeline to layout")
+		}
+	}
+
 	return nil
 }
 
@@ -271,6 +284,11 @@ func eventsForObject(ctx context.Context, object runtime.Object, o store.Store)
 		return nil, errors.Wrap(err, "get name for object")
 	}
 
+	return eventsForInvolvedObject(ctx, o, namespace, apiVersion, kind, name)
+}
+
+// eventsForInvolvedObject lists the events involving an object, most recent first.
+func eventsForInvolvedObject(ctx context.Context, o store.Store, namespace, apiVersion, kind, name string) (*corev1.EventList, error) {
 	key := store.Key{
 		Namespace:  namespace,
 		APIVersion: "v1",
@@ -317,3 +335,16 @@ func eventsForObject(ctx context.Context, object runtime.Object, o store.Store)
 
 	return eventList, nil
 }
+
+// EventLastSeen returns when an event last occurred. Events created through
+// the events API only set the event time.
+func EventLastSeen(event *corev1.Event) time.Time {
+	switch {
+	case !event.LastTimestamp.IsZero():
+		return event.LastTimestamp.Time
+	case !event.EventTime.IsZero():
+		return event.EventTime.Time
+	default:
+		return event.CreationTimestamp.Time
+	}
+}
@@ -0,0 +1,110 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+// summaryFields maps the headers of configuration and status summaries to the
+// fields they show.
+var summaryFields = map[schema.GroupKind]map[string]string{
+	{Group: "apps", Kind: "Deployment"}: {
+		"Deployment Strategy":     "spec.strategy",
+		"Rolling Update Strategy": "spec.strategy.rollingUpdate",
+		"Selectors":               "spec.selector",
+		"Min Ready Seconds":       "spec.minReadySeconds",
+		"Revision History Limit":  "spec.revisionHistoryLimit",
+		"Replicas":                "spec.replicas",
+		"Available Replicas":      "status.availableReplicas",
+		"Ready Replicas":          "status.readyReplicas",
+		"Total Replicas":          "status.replicas",
+		"Unavailable Replicas":    "status.unavailableReplicas",
+		"Updated Replicas":        "status.updatedReplicas",
+	},
+	{Group: "apps", Kind: "StatefulSet"}: {
+		"Update Strategy":        "spec.updateStrategy",
+		"Selectors":              "spec.selector",
+		"Replicas":               "spec.replicas",
+		"Pod Management Policy":  "spec.podManagementPolicy",
+		"Volume Claim Templates": "spec.volumeClaimTemplates",
+	},
+	{Group: "apps", Kind: "DaemonSet"}: {
+		"Update Strategy":          "spec.updateStrategy",
+		"Revision History Limit":   "spec.revisionHistoryLimit",
+		"Selectors":                "spec.selector",
+		"Node Selectors":           "spec.template.spec.nodeSelector",
+		"Current Number Scheduled": "status.currentNumberScheduled",
+		"Desired Number Scheduled": "status.desiredNumberScheduled",
+		"Number Available":         "status.numberAvailable",
+		"Number Mis-scheduled":     "status.numberMisscheduled",
+		"Number Ready":             "status.numberReady",
+		"Updated Number Scheduled": "status.updatedNumberScheduled",
+	},
+	{Kind: "Service"}: {
+		"Selectors":                   "spec.selector",
+		"Type":                        "spec.type",
+		"Ports":                       "spec.ports",
+		"Session Affinity":            "spec.sessionAffinity",
+		"External Traffic Policy":     "spec.externalTrafficPolicy",
+		"Health Check Node Port":      "spec.healthCheckNodePort",
+		"Load Balancer Source Ranges": "spec.loadBalancerSourceRanges",
+		"Cluster IP":                  "spec.clusterIP",
+		"External IPs":                "spec.externalIPs",
+		"Load Balancer IP":            "spec.loadBalancerIP",
+		"External Name":               "spec.externalName",
+	},
+	{Kind: "Pod"}: {
+		"Priority":          "spec.priority",
+		"PriorityClassName": "spec.priorityClassName",
+		"Preemption Policy": "spec.preemptionPolicy",
+		"Node":              "spec.nodeName",
+		"Service Account":   "spec.serviceAccountName",
+		"QoS":               "status.qosClass",
+		"Phase":             "status.phase",
+		"Pod IP":            "status.podIP",
+		"Host IP":           "status.hostIP",
+	},
+}
+
+// explainSummary sets the tooltip of summary sections to the documentation of
+// the field they show. Documentation is optional, so sections are left alone
+// if the cluster's schema doesn't describe the field.
+func explainSummary(object runtime.Object, summary *component.Summary, options Options) {
+	if object == nil || summary == nil {
+		return
+	}
+
+	gvk := object.GetObjectKind().GroupVersionKind()
+	fields, ok := summaryFields[gvk.GroupKind()]
+	if !ok {
+		return
+	}
+
+	explainer := options.DashConfig.Explainer()
+	if explainer == nil {
+		return
+	}
+
+	for _, section := range summary.Sections() {
+		field, ok := fields[section.Header]
+		if !ok || section.Content == nil {
+			continue
+		}
+
+		explained, err := explainer.Explain(gvk, field)
+		if err != nil {
+			continue
+		}
+
+		metadata := section.Content.GetMetadata()
+		metadata.Tooltip = explained.Description
+		section.Content.SetMetadata(metadata)
+	}
+}
@@ -0,0 +1,68 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	configFake "github.com/vmware-tanzu/octant/internal/config/fake"
+	"github.com/vmware-tanzu/octant/internal/explain"
+	explainFake "github.com/vmware-tanzu/octant/internal/explain/fake"
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func Test_explainSummary(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	gvk := schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}
+
+	explainer := explainFake.NewMockExplainer(controller)
+	explainer.EXPECT().
+		Explain(gvk, "spec.replicas").
+		Return(&explain.Field{Name: "replicas", Description: "Number of desired pods."}, nil)
+	explainer.EXPECT().
+		Explain(gvk, "spec.minReadySeconds").
+		Return(nil, errors.New("does not exist"))
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().Explainer().Return(explainer)
+
+	summary := component.NewSummary("Configuration",
+		component.SummarySection{Header: "Replicas", Content: component.NewText("3")},
+		component.SummarySection{Header: "Min Ready Seconds", Content: component.NewText("10")},
+		component.SummarySection{Header: "Custom", Content: component.NewText("value")},
+	)
+
+	explainSummary(testutil.CreateDeployment("deployment"), summary, Options{DashConfig: dashConfig})
+
+	sections := summary.Sections()
+	assert.Equal(t, "Number of desired pods.", sections[0].Content.GetMetadata().Tooltip)
+	assert.Empty(t, sections[1].Content.GetMetadata().Tooltip)
+	assert.Empty(t, sections[2].Content.GetMetadata().Tooltip)
+}
+
+func Test_explainSummary_unmapped_kind(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	// The explainer isn't used for kinds without documented summaries.
+	dashConfig := configFake.NewMockDash(controller)
+
+	summary := component.NewSummary("Configuration",
+		component.SummarySection{Header: "Replicas", Content: component.NewText("3")},
+	)
+
+	explainSummary(testutil.CreateConfigMap("config-map"), summary, Options{DashConfig: dashConfig})
+
+	assert.Empty(t, summary.Sections()[0].Content.GetMetadata().Tooltip)
+}
@@ -51,6 +51,14 @@ func AddHandlers(p Handler) error {
 		PersistentVolumeListHandler,
 		PersistentVolumeClaimHandler,
 		PersistentVolumeClaimListHandler,
+		CSIDriverHandler,
+		CSIDriverListHandler,
+		CSINodeHandler,
+		CSINodeListHandler,
+		LeaseHandler,
+		LeaseListHandler,
+		PriorityClassHandler,
+		PriorityClassListHandler,
 		ServiceAccountListHandler,
 		ServiceAccountHandler,
 		ServiceHandler,
@@ -45,6 +45,8 @@ func newTestPrinterOptions(controller *gomock.Controller) *testPrinterOptions {
 	dashConfig.EXPECT().ObjectStore().Return(objectStore).AnyTimes()
 	dashConfig.EXPECT().PluginManager().Return(pluginManager).AnyTimes()
 	dashConfig.EXPECT().PortForwarder().Return(portForwarder).AnyTimes()
+	dashConfig.EXPECT().Explainer().Return(nil).AnyTimes()
+	dashConfig.EXPECT().Alerts().Return(nil).AnyTimes()
 
 	tpo := &testPrinterOptions{
 		dashConfig:    dashConfig,
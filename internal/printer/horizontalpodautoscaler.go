@@ -18,6 +18,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime"
 
+	"github.com/vmware-tanzu/octant/internal/octant"
 	"github.com/vmware-tanzu/octant/pkg/store"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
@@ -340,9 +341,40 @@ func (hc *HorizontalPodAutoscalerConfiguration) Create(ctx context.Context, opti
 	}
 
 	summary := component.NewSummary("Configuration", sections...)
+
+	editAction, err := editHorizontalPodAutoscalerAction(hpa)
+	if err != nil {
+		return nil, err
+	}
+	summary.AddAction(editAction)
+
 	return summary, nil
 }
 
+// editHorizontalPodAutoscalerAction creates an action which edits the replica bounds
+// and CPU utilization target of a horizontal pod autoscaler. A blank target removes it.
+func editHorizontalPodAutoscalerAction(hpa *autoscalingv1.HorizontalPodAutoscaler) (component.Action, error) {
+	var targetCPUUtilization string
+	if hpa.Spec.TargetCPUUtilizationPercentage != nil {
+		targetCPUUtilization = fmt.Sprintf("%d", *hpa.Spec.TargetCPUUtilizationPercentage)
+	}
+
+	form, err := component.CreateFormForObject(octant.ActionHorizontalPodAutoscalerEdit, hpa,
+		component.NewFormFieldNumber("Min Replicas", "minReplicas", fmt.Sprintf("%d", *hpa.Spec.MinReplicas)),
+		component.NewFormFieldNumber("Max Replicas", "maxReplicas", fmt.Sprintf("%d", hpa.Spec.MaxReplicas)),
+		component.NewFormFieldNumber("Target CPU Utilization (%)", "targetCPUUtilizationPercentage", targetCPUUtilization),
+	)
+	if err != nil {
+		return component.Action{}, err
+	}
+
+	return component.Action{
+		Name:  "Edit",
+		Title: "Horizontal Pod Autoscaler Editor",
+		Form:  form,
+	}, nil
+}
+
 var _ horizontalPodAutoscalerObject = (*horizontalPodAutoscalerHandler)(nil)
 
 func newHorizontalPodAutoscalerHandler(horizontalPodAutoscaler *autoscalingv1.HorizontalPodAutoscaler, object *Object) (*horizontalPodAutoscalerHandler, error) {
@@ -16,6 +16,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/vmware-tanzu/octant/internal/octant"
 	"github.com/vmware-tanzu/octant/internal/testutil"
 	"github.com/vmware-tanzu/octant/pkg/store"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
@@ -100,6 +101,8 @@ func Test_HorizontalPodAutoscalerConfiguration(t *testing.T) {
 	hpa := testutil.CreateHorizontalPodAutoscaler("hpa")
 	hpa.Spec.MinReplicas = &replicas
 	hpa.Spec.MaxReplicas = 10
+	var targetCPU int32 = 80
+	hpa.Spec.TargetCPUUtilizationPercentage = &targetCPU
 
 	deployment := testutil.CreateDeployment("deployment")
 	hpa.Spec.ScaleTargetRef = autoscalingv1.CrossVersionObjectReference{
@@ -108,6 +111,32 @@ func Test_HorizontalPodAutoscalerConfiguration(t *testing.T) {
 		Name:       deployment.Name,
 	}
 
+	expectedSummary := component.NewSummary("Configuration", []component.SummarySection{
+		{
+			Header:  "Scale target",
+			Content: component.NewLink("", "deployment", "/deployment"),
+		},
+		{
+			Header:  "Min Replicas",
+			Content: component.NewText("1"),
+		},
+		{
+			Header:  "Max Replicas",
+			Content: component.NewText("10"),
+		},
+	}...)
+	editForm, err := component.CreateFormForObject(octant.ActionHorizontalPodAutoscalerEdit, hpa,
+		component.NewFormFieldNumber("Min Replicas", "minReplicas", "1"),
+		component.NewFormFieldNumber("Max Replicas", "maxReplicas", "10"),
+		component.NewFormFieldNumber("Target CPU Utilization (%)", "targetCPUUtilizationPercentage", "80"),
+	)
+	require.NoError(t, err)
+	expectedSummary.AddAction(component.Action{
+		Name:  "Edit",
+		Title: "Horizontal Pod Autoscaler Editor",
+		Form:  editForm,
+	})
+
 	cases := []struct {
 		name                    string
 		horizontalPodAutoscaler *autoscalingv1.HorizontalPodAutoscaler
@@ -117,20 +146,7 @@ func Test_HorizontalPodAutoscalerConfiguration(t *testing.T) {
 		{
 			name:                    "general",
 			horizontalPodAutoscaler: hpa,
-			expected: component.NewSummary("Configuration", []component.SummarySection{
-				{
-					Header:  "Scale target",
-					Content: component.NewLink("", "deployment", "/deployment"),
-				},
-				{
-					Header:  "Min Replicas",
-					Content: component.NewText("1"),
-				},
-				{
-					Header:  "Max Replicas",
-					Content: component.NewText("10"),
-				},
-			}...),
+			expected:                expectedSummary,
 		},
 		{
 			name:                    "nil horizontalpodautoscaler",
@@ -0,0 +1,583 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+// istioNetworkingGroup is the API group of Istio traffic management resources.
+const istioNetworkingGroup = "networking.istio.io"
+
+// istioRouteTypes are the route lists of a VirtualService.
+var istioRouteTypes = []string{"http", "tls", "tcp"}
+
+// IstioVirtualServiceRoutesHandler prints the routes of an Istio
+// VirtualService along with the services and workloads they send traffic to.
+// Routes to hosts which can't be found in the cluster are flagged.
+func IstioVirtualServiceRoutesHandler(ctx context.Context, cr *unstructured.Unstructured, options Options) (component.Component, error) {
+	if cr == nil {
+		return nil, fmt.Errorf("virtual service is nil")
+	}
+
+	cols := component.NewTableCols("Type", "Match", "Destination", "Subset", "Weight", "Workloads")
+	table := component.NewTable("Routes", "There are no routes defined!", cols)
+
+	for _, routeType := range istioRouteTypes {
+		rules, _, _ := unstructured.NestedSlice(cr.Object, "spec", routeType)
+		for _, r := range rules {
+			rule, ok := r.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			match := istioMatchString(rule)
+
+			destinations, _, _ := unstructured.NestedSlice(rule, "route")
+			for _, d := range destinations {
+				destination, ok := d.(map[string]interface{})
+				if !ok {
+					continue
+				}
+
+				host, _, _ := unstructured.NestedString(destination, "destination", "host")
+				subset, _, _ := unstructured.NestedString(destination, "destination", "subset")
+
+				text := host
+				if port, found, _ := unstructured.NestedInt64(destination, "destination", "port", "number"); found {
+					text = fmt.Sprintf("%s:%d", host, port)
+				}
+
+				hostView, service, err := istioHostView(ctx, cr, host, text, options)
+				if err != nil {
+					return nil, err
+				}
+
+				var selector labels.Set
+				if service != nil {
+					selector, err = istioSubsetSelector(ctx, cr, service, subset, options)
+					if err != nil {
+						return nil, err
+					}
+				}
+
+				workloadsView, err := istioWorkloadsView(ctx, service, selector, options)
+				if err != nil {
+					return nil, err
+				}
+
+				weight := ""
+				if w, found, _ := unstructured.NestedInt64(destination, "weight"); found {
+					weight = fmt.Sprintf("%d%%", w)
+				} else if len(destinations) == 1 {
+					weight = "100%"
+				}
+
+				table.Add(component.TableRow{
+					"Type":        component.NewText(strings.ToUpper(routeType)),
+					"Match":       component.NewText(match),
+					"Destination": hostView,
+					"Subset":      component.NewText(subset),
+					"Weight":      component.NewText(weight),
+					"Workloads":   workloadsView,
+				})
+			}
+		}
+	}
+
+	return table, nil
+}
+
+// IstioDestinationRuleHostHandler prints the host and traffic policy of an
+// Istio DestinationRule. A host which can't be found in the cluster is flagged.
+func IstioDestinationRuleHostHandler(ctx context.Context, cr *unstructured.Unstructured, options Options) (component.Component, error) {
+	if cr == nil {
+		return nil, fmt.Errorf("destination rule is nil")
+	}
+
+	host, _, _ := unstructured.NestedString(cr.Object, "spec", "host")
+
+	hostView, service, err := istioHostView(ctx, cr, host, host, options)
+	if err != nil {
+		return nil, err
+	}
+
+	sections := component.SummarySections{}
+	sections.Add("Host", hostView)
+
+	if service != nil {
+		workloadsView, err := istioWorkloadsView(ctx, service, service.Spec.Selector, options)
+		if err != nil {
+			return nil, err
+		}
+		sections.Add("Workloads", workloadsView)
+	}
+
+	if lb, _, _ := unstructured.NestedString(cr.Object, "spec", "trafficPolicy", "loadBalancer", "simple"); lb != "" {
+		sections.AddText("Load Balancer", lb)
+	}
+
+	if mode, _, _ := unstructured.NestedString(cr.Object, "spec", "trafficPolicy", "tls", "mode"); mode != "" {
+		sections.AddText("TLS Mode", mode)
+	}
+
+	return component.NewSummary("Destination", sections...), nil
+}
+
+// IstioDestinationRuleSubsetsHandler prints the subsets of an Istio
+// DestinationRule and the workloads each subset selects.
+func IstioDestinationRuleSubsetsHandler(ctx context.Context, cr *unstructured.Unstructured, options Options) (component.Component, error) {
+	if cr == nil {
+		return nil, fmt.Errorf("destination rule is nil")
+	}
+
+	host, _, _ := unstructured.NestedString(cr.Object, "spec", "host")
+
+	service, err := istioHostService(ctx, cr, host, options)
+	if err != nil {
+		return nil, err
+	}
+
+	cols := component.NewTableCols("Name", "Labels", "Workloads")
+	table := component.NewTable("Subsets", "There are no subsets defined!", cols)
+
+	subsets, _, _ := unstructured.NestedSlice(cr.Object, "spec", "subsets")
+	for _, s := range subsets {
+		subset, ok := s.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		name, _, _ := unstructured.NestedString(subset, "name")
+		subsetLabels, _, _ := unstructured.NestedStringMap(subset, "labels")
+
+		var selector labels.Set
+		if service != nil {
+			selector = labels.Merge(service.Spec.Selector, subsetLabels)
+		}
+
+		workloadsView, err := istioWorkloadsView(ctx, service, selector, options)
+		if err != nil {
+			return nil, err
+		}
+
+		table.Add(component.TableRow{
+			"Name":      component.NewText(name),
+			"Labels":    component.NewLabels(subsetLabels),
+			"Workloads": workloadsView,
+		})
+	}
+
+	return table, nil
+}
+
+// IstioGatewayServersHandler prints the servers exposed by an Istio Gateway.
+func IstioGatewayServersHandler(_ context.Context, cr *unstructured.Unstructured, _ Options) (component.Component, error) {
+	if cr == nil {
+		return nil, fmt.Errorf("gateway is nil")
+	}
+
+	cols := component.NewTableCols("Port", "Protocol", "Hosts", "TLS Mode")
+	table := component.NewTable("Servers", "There are no servers defined!", cols)
+
+	servers, _, _ := unstructured.NestedSlice(cr.Object, "spec", "servers")
+	for _, s := range servers {
+		server, ok := s.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		port := ""
+		if number, found, _ := unstructured.NestedInt64(server, "port", "number"); found {
+			port = fmt.Sprintf("%d", number)
+		}
+		protocol, _, _ := unstructured.NestedString(server, "port", "protocol")
+		hosts, _, _ := unstructured.NestedStringSlice(server, "hosts")
+		tlsMode, _, _ := unstructured.NestedString(server, "tls", "mode")
+
+		table.Add(component.TableRow{
+			"Port":     component.NewText(port),
+			"Protocol": component.NewText(protocol),
+			"Hosts":    component.NewText(strings.Join(hosts, ", ")),
+			"TLS Mode": component.NewText(tlsMode),
+		})
+	}
+
+	return table, nil
+}
+
+// IstioGatewayWorkloadsHandler prints the gateway workloads selected by an
+// Istio Gateway.
+func IstioGatewayWorkloadsHandler(ctx context.Context, cr *unstructured.Unstructured, options Options) (component.Component, error) {
+	if cr == nil {
+		return nil, fmt.Errorf("gateway is nil")
+	}
+
+	cols := component.NewTableCols("Workload", "Namespace", "Pods")
+	table := component.NewTable("Workloads", "There are no workloads matching this gateway's selector!", cols)
+
+	selector, _, _ := unstructured.NestedStringMap(cr.Object, "spec", "selector")
+	if len(selector) == 0 {
+		return table, nil
+	}
+
+	// Gateways select workloads in every namespace.
+	workloads, err := istioWorkloads(ctx, "", selector, options)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, workload := range workloads {
+		workloadLink, err := istioWorkloadLink(workload.object, options)
+		if err != nil {
+			return nil, err
+		}
+
+		table.Add(component.TableRow{
+			"Workload":  workloadLink,
+			"Namespace": component.NewText(workload.object.GetNamespace()),
+			"Pods":      component.NewText(fmt.Sprintf("%d", workload.pods)),
+		})
+	}
+
+	return table, nil
+}
+
+// istioHostView creates a view for a host referenced by an Istio resource. The
+// host is linked to the Service or ServiceEntry it resolves to. A host which
+// can't be resolved is flagged as an error.
+func istioHostView(ctx context.Context, cr *unstructured.Unstructured, host, text string, options Options) (component.Component, *corev1.Service, error) {
+	if host == "" || strings.Contains(host, "*") {
+		return component.NewText(text), nil, nil
+	}
+
+	service, err := istioHostService(ctx, cr, host, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if service != nil {
+		serviceLink, err := options.Link.ForGVK(service.Namespace, "v1", "Service", service.Name, text)
+		if err != nil {
+			return nil, nil, err
+		}
+		return serviceLink, service, nil
+	}
+
+	serviceEntry, err := istioHostServiceEntry(ctx, cr, host, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if serviceEntry != nil {
+		serviceEntryLink, err := options.Link.ForGVK(serviceEntry.GetNamespace(), serviceEntry.GetAPIVersion(),
+			serviceEntry.GetKind(), serviceEntry.GetName(), text)
+		if err != nil {
+			return nil, nil, err
+		}
+		return serviceEntryLink, nil, nil
+	}
+
+	hostText := component.NewText(fmt.Sprintf("%s (host not found)", text))
+	hostText.SetStatus(component.TextStatusError)
+	return hostText, nil, nil
+}
+
+// istioHostService returns the Service a host resolves to. Short names are
+// resolved relative to the namespace of the Istio resource. A nil Service is
+// returned if the host isn't a cluster service or the service doesn't exist.
+func istioHostService(ctx context.Context, cr *unstructured.Unstructured, host string, options Options) (*corev1.Service, error) {
+	name, namespace, ok := istioServiceName(host, cr.GetNamespace())
+	if !ok {
+		return nil, nil
+	}
+
+	key := store.Key{
+		Namespace:  namespace,
+		APIVersion: "v1",
+		Kind:       "Service",
+		Name:       name,
+	}
+
+	service := &corev1.Service{}
+	found, err := store.GetAs(ctx, options.DashConfig.ObjectStore(), key, service)
+	if err != nil {
+		return nil, fmt.Errorf("get service for key %+v: %w", key, err)
+	}
+
+	if !found || service.Name == "" {
+		return nil, nil
+	}
+
+	return service, nil
+}
+
+// istioServiceName converts a host to a service name and namespace. Hosts
+// which are not short names or cluster service names are not converted.
+func istioServiceName(host, namespace string) (string, string, bool) {
+	parts := strings.Split(host, ".")
+	switch {
+	case len(parts) == 1:
+		return parts[0], namespace, true
+	case len(parts) >= 3 && parts[2] == "svc":
+		return parts[0], parts[1], true
+	default:
+		return "", "", false
+	}
+}
+
+// istioHostServiceEntry returns the ServiceEntry which adds a host to the
+// mesh, or nil if there is none.
+func istioHostServiceEntry(ctx context.Context, cr *unstructured.Unstructured, host string, options Options) (*unstructured.Unstructured, error) {
+	key := store.Key{
+		APIVersion: cr.GetAPIVersion(),
+		Kind:       "ServiceEntry",
+	}
+
+	list, _, err := options.DashConfig.ObjectStore().List(ctx, key)
+	if err != nil {
+		return nil, fmt.Errorf("list service entries for key %+v: %w", key, err)
+	}
+
+	for i := range list.Items {
+		hosts, _, _ := unstructured.NestedStringSlice(list.Items[i].Object, "spec", "hosts")
+		for _, entryHost := range hosts {
+			if entryHost == host || (strings.HasPrefix(entryHost, "*.") && strings.HasSuffix(host, entryHost[1:])) {
+				return &list.Items[i], nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// istioSubsetSelector returns the pod selector for a subset of a service. The
+// subset labels are found in the DestinationRules for the service's host.
+func istioSubsetSelector(ctx context.Context, cr *unstructured.Unstructured, service *corev1.Service, subset string, options Options) (labels.Set, error) {
+	if subset == "" {
+		return service.Spec.Selector, nil
+	}
+
+	key := store.Key{
+		Namespace:  cr.GetNamespace(),
+		APIVersion: cr.GetAPIVersion(),
+		Kind:       "DestinationRule",
+	}
+
+	list, _, err := options.DashConfig.ObjectStore().List(ctx, key)
+	if err != nil {
+		return nil, fmt.Errorf("list destination rules for key %+v: %w", key, err)
+	}
+
+	for i := range list.Items {
+		host, _, _ := unstructured.NestedString(list.Items[i].Object, "spec", "host")
+		name, namespace, ok := istioServiceName(host, list.Items[i].GetNamespace())
+		if !ok || name != service.Name || namespace != service.Namespace {
+			continue
+		}
+
+		subsets, _, _ := unstructured.NestedSlice(list.Items[i].Object, "spec", "subsets")
+		for _, s := range subsets {
+			m, ok := s.(map[string]interface{})
+			if !ok || m["name"] != subset {
+				continue
+			}
+
+			subsetLabels, _, _ := unstructured.NestedStringMap(m, "labels")
+			return labels.Merge(service.Spec.Selector, subsetLabels), nil
+		}
+	}
+
+	return service.Spec.Selector, nil
+}
+
+// istioWorkload is a workload which owns pods selected by an Istio resource.
+type istioWorkload struct {
+	object *unstructured.Unstructured
+	pods   int
+}
+
+// istioWorkloads returns the workloads owning the pods matching a selector.
+func istioWorkloads(ctx context.Context, namespace string, selector labels.Set, options Options) ([]istioWorkload, error) {
+	objectStore := options.DashConfig.ObjectStore()
+
+	key := store.Key{
+		Namespace:  namespace,
+		APIVersion: "v1",
+		Kind:       "Pod",
+		Selector:   &selector,
+	}
+
+	podList, _, err := objectStore.List(ctx, key)
+	if err != nil {
+		return nil, fmt.Errorf("list pods for key %+v: %w", key, err)
+	}
+
+	var workloads []istioWorkload
+	indexes := map[string]int{}
+
+	for i := range podList.Items {
+		owner, err := istioWorkloadOwner(ctx, objectStore, &podList.Items[i])
+		if err != nil {
+			return nil, err
+		}
+
+		id := string(owner.GetUID())
+		if id == "" {
+			id = fmt.Sprintf("%s/%s/%s", owner.GetKind(), owner.GetNamespace(), owner.GetName())
+		}
+
+		if index, ok := indexes[id]; ok {
+			workloads[index].pods++
+			continue
+		}
+
+		indexes[id] = len(workloads)
+		workloads = append(workloads, istioWorkload{object: owner, pods: 1})
+	}
+
+	sort.Slice(workloads, func(i, j int) bool {
+		a, b := workloads[i].object, workloads[j].object
+		if a.GetNamespace() != b.GetNamespace() {
+			return a.GetNamespace() < b.GetNamespace()
+		}
+		if a.GetKind() != b.GetKind() {
+			return a.GetKind() < b.GetKind()
+		}
+		return a.GetName() < b.GetName()
+	})
+
+	return workloads, nil
+}
+
+// istioWorkloadOwner follows the controller references of an object to the
+// workload which manages it. If a controller doesn't exist or can't be looked
+// up yet, the object it controls is the workload.
+func istioWorkloadOwner(ctx context.Context, objectStore store.Store, object *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	for {
+		controllerRef := metav1.GetControllerOf(object)
+		if controllerRef == nil {
+			return object, nil
+		}
+
+		key := store.Key{
+			Namespace:  object.GetNamespace(),
+			APIVersion: controllerRef.APIVersion,
+			Kind:       controllerRef.Kind,
+			Name:       controllerRef.Name,
+		}
+
+		owner, err := objectStore.Get(ctx, key)
+		if kerrors.IsNotFound(err) {
+			return object, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("get controller for key %+v: %w", key, err)
+		}
+
+		if owner == nil || owner.GetUID() == "" {
+			return object, nil
+		}
+
+		object = owner
+	}
+}
+
+// istioWorkloadsView creates a view linking to the workloads a service
+// selector matches.
+func istioWorkloadsView(ctx context.Context, service *corev1.Service, selector labels.Set, options Options) (component.Component, error) {
+	if service == nil || len(selector) == 0 {
+		return component.NewText(""), nil
+	}
+
+	workloads, err := istioWorkloads(ctx, service.Namespace, selector, options)
+	if err != nil {
+		return nil, err
+	}
+
+	switch len(workloads) {
+	case 0:
+		text := component.NewText("No workloads")
+		text.SetStatus(component.TextStatusWarning)
+		return text, nil
+	case 1:
+		return istioWorkloadLink(workloads[0].object, options)
+	}
+
+	// multiple workloads, use Markdown instead
+	var links []string
+	for _, workload := range workloads {
+		workloadLink, err := istioWorkloadLink(workload.object, options)
+		if err != nil {
+			return nil, err
+		}
+		links = append(links, fmt.Sprintf("[%s](%s)", workloadLink.Text(), workloadLink.Ref()))
+	}
+
+	return component.NewMarkdownText(strings.Join(links, ", ")), nil
+}
+
+// istioWorkloadLink links to a workload.
+func istioWorkloadLink(object *unstructured.Unstructured, options Options) (*component.Link, error) {
+	return options.Link.ForGVK(object.GetNamespace(), object.GetAPIVersion(), object.GetKind(),
+		object.GetName(), object.GetName())
+}
+
+// istioMatchString describes the match conditions of a route.
+func istioMatchString(rule map[string]interface{}) string {
+	matches, _, _ := unstructured.NestedSlice(rule, "match")
+
+	var conditions []string
+	for _, m := range matches {
+		match, ok := m.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		var parts []string
+		for _, uriType := range []string{"exact", "prefix", "regex"} {
+			if uri, found, _ := unstructured.NestedString(match, "uri", uriType); found {
+				parts = append(parts, fmt.Sprintf("uri %s %s", uriType, uri))
+			}
+		}
+		if headers, _, _ := unstructured.NestedMap(match, "headers"); len(headers) > 0 {
+			var names []string
+			for name := range headers {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			parts = append(parts, fmt.Sprintf("headers %s", strings.Join(names, ", ")))
+		}
+		if port, found, _ := unstructured.NestedInt64(match, "port"); found {
+			parts = append(parts, fmt.Sprintf("port %d", port))
+		}
+		if sniHosts, _, _ := unstructured.NestedStringSlice(match, "sniHosts"); len(sniHosts) > 0 {
+			parts = append(parts, fmt.Sprintf("sni %s", strings.Join(sniHosts, ", ")))
+		}
+
+		if len(parts) > 0 {
+			conditions = append(conditions, strings.Join(parts, " and "))
+		}
+	}
+
+	if len(conditions) == 0 {
+		return "*"
+	}
+
+	return strings.Join(conditions, "; ")
+}
@@ -0,0 +1,274 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func TestIstioVirtualServiceRoutesHandler(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+
+	cr := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "networking.istio.io/v1beta1",
+		"kind":       "VirtualService",
+		"metadata": map[string]interface{}{
+			"name":      "reviews",
+			"namespace": "namespace",
+		},
+		"spec": map[string]interface{}{
+			"hosts": []interface{}{"reviews"},
+			"http": []interface{}{
+				map[string]interface{}{
+					"match": []interface{}{
+						map[string]interface{}{
+							"uri": map[string]interface{}{"prefix": "/v1"},
+						},
+					},
+					"route": []interface{}{
+						map[string]interface{}{
+							"destination": map[string]interface{}{
+								"host":   "reviews",
+								"subset": "v1",
+								"port":   map[string]interface{}{"number": int64(9080)},
+							},
+							"weight": int64(90),
+						},
+						map[string]interface{}{
+							"destination": map[string]interface{}{
+								"host": "ratings.namespace.svc.cluster.local",
+							},
+							"weight": int64(10),
+						},
+					},
+				},
+			},
+		},
+	}}
+
+	service := testutil.CreateService("reviews")
+	service.Spec.Selector = map[string]string{"app": "reviews"}
+
+	destinationRule := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "networking.istio.io/v1beta1",
+		"kind":       "DestinationRule",
+		"metadata": map[string]interface{}{
+			"name":      "reviews",
+			"namespace": "namespace",
+		},
+		"spec": map[string]interface{}{
+			"host": "reviews",
+			"subsets": []interface{}{
+				map[string]interface{}{
+					"name":   "v1",
+					"labels": map[string]interface{}{"version": "v1"},
+				},
+			},
+		},
+	}}
+
+	deployment := testutil.CreateDeployment("reviews-v1")
+	replicaSet := testutil.CreateAppReplicaSet("reviews-v1-abc")
+	replicaSet.SetOwnerReferences(testutil.ToOwnerReferences(t, deployment))
+	pod := testutil.CreatePod("reviews-v1-abc-123")
+	pod.SetOwnerReferences(testutil.ToOwnerReferences(t, replicaSet))
+
+	tpo.objectStore.EXPECT().
+		Get(gomock.Any(), store.Key{Namespace: "namespace", APIVersion: "v1", Kind: "Service", Name: "reviews"}).
+		Return(testutil.ToUnstructured(t, service), nil)
+	tpo.objectStore.EXPECT().
+		List(gomock.Any(), store.Key{Namespace: "namespace", APIVersion: "networking.istio.io/v1beta1", Kind: "DestinationRule"}).
+		Return(testutil.ToUnstructuredList(t, destinationRule), false, nil)
+
+	selector := labels.Set{"app": "reviews", "version": "v1"}
+	tpo.objectStore.EXPECT().
+		List(gomock.Any(), store.Key{Namespace: "namespace", APIVersion: "v1", Kind: "Pod", Selector: &selector}).
+		Return(testutil.ToUnstructuredList(t, pod), false, nil)
+	tpo.objectStore.EXPECT().
+		Get(gomock.Any(), store.Key{Namespace: "namespace", APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "reviews-v1-abc"}).
+		Return(testutil.ToUnstructured(t, replicaSet), nil)
+	tpo.objectStore.EXPECT().
+		Get(gomock.Any(), store.Key{Namespace: "namespace", APIVersion: "apps/v1", Kind: "Deployment", Name: "reviews-v1"}).
+		Return(testutil.ToUnstructured(t, deployment), nil)
+
+	tpo.objectStore.EXPECT().
+		Get(gomock.Any(), store.Key{Namespace: "namespace", APIVersion: "v1", Kind: "Service", Name: "ratings"}).
+		Return(nil, kerrors.NewNotFound(schema.GroupResource{Resource: "services"}, "ratings"))
+	tpo.objectStore.EXPECT().
+		List(gomock.Any(), store.Key{APIVersion: "networking.istio.io/v1beta1", Kind: "ServiceEntry"}).
+		Return(&unstructured.UnstructuredList{}, false, nil)
+
+	tpo.PathForGVK("namespace", "v1", "Service", "reviews", "reviews:9080", "/service")
+	tpo.PathForGVK("namespace", "apps/v1", "Deployment", "reviews-v1", "reviews-v1", "/deployment")
+
+	printOptions := tpo.ToOptions()
+
+	got, err := IstioVirtualServiceRoutesHandler(context.Background(), cr, printOptions)
+	require.NoError(t, err)
+
+	missing := component.NewText("ratings.namespace.svc.cluster.local (host not found)")
+	missing.SetStatus(component.TextStatusError)
+
+	cols := component.NewTableCols("Type", "Match", "Destination", "Subset", "Weight", "Workloads")
+	expected := component.NewTableWithRows("Routes", "There are no routes defined!", cols, []component.TableRow{
+		{
+			"Type":        component.NewText("HTTP"),
+			"Match":       component.NewText("uri prefix /v1"),
+			"Destination": component.NewLink("", "reviews:9080", "/service"),
+			"Subset":      component.NewText("v1"),
+			"Weight":      component.NewText("90%"),
+			"Workloads":   component.NewLink("", "reviews-v1", "/deployment"),
+		},
+		{
+			"Type":        component.NewText("HTTP"),
+			"Match":       component.NewText("uri prefix /v1"),
+			"Destination": missing,
+			"Subset":      component.NewText(""),
+			"Weight":      component.NewText("10%"),
+			"Workloads":   component.NewText(""),
+		},
+	})
+
+	component.AssertEqual(t, expected, got)
+}
+
+func TestIstioGatewayWorkloadsHandler(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+
+	cr := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "networking.istio.io/v1beta1",
+		"kind":       "Gateway",
+		"metadata": map[string]interface{}{
+			"name":      "gateway",
+			"namespace": "namespace",
+		},
+		"spec": map[string]interface{}{
+			"selector": map[string]interface{}{"istio": "ingressgateway"},
+		},
+	}}
+
+	pod1 := testutil.CreatePod("ingressgateway-1")
+	pod2 := testutil.CreatePod("ingressgateway-2")
+
+	selector := labels.Set{"istio": "ingressgateway"}
+	tpo.objectStore.EXPECT().
+		List(gomock.Any(), store.Key{APIVersion: "v1", Kind: "Pod", Selector: &selector}).
+		Return(testutil.ToUnstructuredList(t, pod1, pod2), false, nil)
+
+	tpo.PathForGVK("namespace", "v1", "Pod", "ingressgateway-1", "ingressgateway-1", "/pod-1")
+	tpo.PathForGVK("namespace", "v1", "Pod", "ingressgateway-2", "ingressgateway-2", "/pod-2")
+
+	printOptions := tpo.ToOptions()
+
+	got, err := IstioGatewayWorkloadsHandler(context.Background(), cr, printOptions)
+	require.NoError(t, err)
+
+	cols := component.NewTableCols("Workload", "Namespace", "Pods")
+	expected := component.NewTableWithRows("Workloads", "There are no workloads matching this gateway's selector!", cols, []component.TableRow{
+		{
+			"Workload":  component.NewLink("", "ingressgateway-1", "/pod-1"),
+			"Namespace": component.NewText("namespace"),
+			"Pods":      component.NewText("1"),
+		},
+		{
+			"Workload":  component.NewLink("", "ingressgateway-2", "/pod-2"),
+			"Namespace": component.NewText("namespace"),
+			"Pods":      component.NewText("1"),
+		},
+	})
+
+	component.AssertEqual(t, expected, got)
+}
+
+func Test_istioWorkloadOwner(t *testing.T) {
+	deployment := testutil.CreateDeployment("reviews-v1")
+	replicaSet := testutil.CreateAppReplicaSet("reviews-v1-abc")
+	replicaSet.SetOwnerReferences(testutil.ToOwnerReferences(t, deployment))
+	pod := testutil.CreatePod("reviews-v1-abc-123")
+	pod.SetOwnerReferences(testutil.ToOwnerReferences(t, replicaSet))
+
+	replicaSetKey := store.Key{Namespace: "namespace", APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "reviews-v1-abc"}
+	deploymentKey := store.Key{Namespace: "namespace", APIVersion: "apps/v1", Kind: "Deployment", Name: "reviews-v1"}
+
+	cases := []struct {
+		name          string
+		deployment    *unstructured.Unstructured
+		deploymentErr error
+		expected      *unstructured.Unstructured
+	}{
+		{
+			name:       "controller exists",
+			deployment: testutil.ToUnstructured(t, deployment),
+			expected:   testutil.ToUnstructured(t, deployment),
+		},
+		{
+			name:          "controller not found",
+			deploymentErr: kerrors.NewNotFound(schema.GroupResource{Group: "apps", Resource: "deployments"}, "reviews-v1"),
+			expected:      testutil.ToUnstructured(t, replicaSet),
+		},
+		{
+			name:       "controller unknown",
+			deployment: &unstructured.Unstructured{},
+			expected:   testutil.ToUnstructured(t, replicaSet),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			tpo := newTestPrinterOptions(controller)
+			tpo.objectStore.EXPECT().Get(gomock.Any(), replicaSetKey).Return(testutil.ToUnstructured(t, replicaSet), nil)
+			tpo.objectStore.EXPECT().Get(gomock.Any(), deploymentKey).Return(tc.deployment, tc.deploymentErr)
+
+			got, err := istioWorkloadOwner(context.Background(), tpo.objectStore, testutil.ToUnstructured(t, pod))
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func Test_istioServiceName(t *testing.T) {
+	tests := []struct {
+		host          string
+		wantName      string
+		wantNamespace string
+		wantOK        bool
+	}{
+		{host: "reviews", wantName: "reviews", wantNamespace: "default", wantOK: true},
+		{host: "reviews.prod.svc.cluster.local", wantName: "reviews", wantNamespace: "prod", wantOK: true},
+		{host: "api.example.com"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.host, func(t *testing.T) {
+			name, namespace, ok := istioServiceName(test.host, "default")
+			assert.Equal(t, test.wantName, name)
+			assert.Equal(t, test.wantNamespace, namespace)
+			assert.Equal(t, test.wantOK, ok)
+		})
+	}
+}
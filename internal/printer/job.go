@@ -180,10 +180,16 @@ func createJobListView(ctx context.Context, object runtime.Object, options Optio
 		return nil, errors.Wrap(err, "get name for object")
 	}
 
+	uid, err := accessor.UID(object)
+	if err != nil {
+		return nil, errors.Wrap(err, "get uid for object")
+	}
+
 	key := store.Key{
 		Namespace:  namespace,
 		APIVersion: "batch/v1beta1",
 		Kind:       "Job",
+		OwnerUID:   uid,
 	}
 
 	list, _, err := objectStore.List(ctx, key)
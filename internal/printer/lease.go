@@ -0,0 +1,210 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	coordinationv1 "k8s.io/api/coordination/v1"
+
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+// nodeLeaseNamespace is the namespace kubelets keep their heartbeat leases in.
+const nodeLeaseNamespace = "kube-node-lease"
+
+// LeaseListHandler is a printFunc that prints leases
+func LeaseListHandler(ctx context.Context, list *coordinationv1.LeaseList, options Options) (component.Component, error) {
+	if list == nil {
+		return nil, errors.New("lease list is nil")
+	}
+
+	cols := component.NewTableCols("Name", "Holder", "Last Renewed", "Age")
+	ot := NewObjectTable("Leases", "We couldn't find any leases!", cols, options.DashConfig.ObjectStore())
+
+	now := time.Now()
+
+	for _, lease := range list.Items {
+		row := component.TableRow{}
+		nameLink, err := options.Link.ForObject(&lease, lease.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		holder, err := leaseHolder(&lease, now, options)
+		if err != nil {
+			return nil, err
+		}
+
+		row["Name"] = nameLink
+		row["Holder"] = holder
+		row["Last Renewed"] = leaseRenewTime(&lease)
+		ts := lease.CreationTimestamp.Time
+		row["Age"] = component.NewTimestamp(ts)
+
+		if err := ot.AddRowForObject(ctx, &lease, row); err != nil {
+			return nil, fmt.Errorf("add row for object: %w", err)
+		}
+	}
+
+	return ot.ToComponent()
+}
+
+// LeaseHandler is a printFunc that prints a lease
+func LeaseHandler(ctx context.Context, lease *coordinationv1.Lease, options Options) (component.Component, error) {
+	o := NewObject(lease)
+
+	lh, err := newLeaseHandler(lease, o)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := lh.Config(options); err != nil {
+		return nil, errors.Wrap(err, "print lease configuration")
+	}
+
+	return o.ToComponent(ctx, options)
+}
+
+// LeaseConfiguration generates a lease configuration
+type LeaseConfiguration struct {
+	lease *coordinationv1.Lease
+	now   time.Time
+}
+
+// NewLeaseConfiguration creates an instance of LeaseConfiguration
+func NewLeaseConfiguration(lease *coordinationv1.Lease) *LeaseConfiguration {
+	return &LeaseConfiguration{
+		lease: lease,
+		now:   time.Now(),
+	}
+}
+
+// Create creates a lease configuration summary
+func (c *LeaseConfiguration) Create(options Options) (*component.Summary, error) {
+	if c == nil || c.lease == nil {
+		return nil, errors.New("lease is nil")
+	}
+
+	lease := c.lease
+
+	var sections component.SummarySections
+
+	holder, err := leaseHolder(lease, c.now, options)
+	if err != nil {
+		return nil, err
+	}
+	sections.Add("Holder Identity", holder)
+
+	if lease.Spec.LeaseDurationSeconds != nil {
+		sections.AddText("Lease Duration", fmt.Sprintf("%ds", *lease.Spec.LeaseDurationSeconds))
+	}
+
+	if lease.Spec.AcquireTime != nil {
+		sections.Add("Acquire Time", component.NewTimestamp(lease.Spec.AcquireTime.Time))
+	}
+
+	sections.Add("Renew Time", leaseRenewTime(lease))
+
+	if lease.Spec.LeaseTransitions != nil {
+		sections.AddText("Lease Transitions", fmt.Sprintf("%d", *lease.Spec.LeaseTransitions))
+	}
+
+	summary := component.NewSummary("Configuration", sections...)
+
+	return summary, nil
+}
+
+type leaseObject interface {
+	Config(options Options) error
+}
+
+type leaseHandler struct {
+	lease      *coordinationv1.Lease
+	configFunc func(*coordinationv1.Lease, Options) (*component.Summary, error)
+	object     *Object
+}
+
+var _ leaseObject = (*leaseHandler)(nil)
+
+func newLeaseHandler(lease *coordinationv1.Lease, object *Object) (*leaseHandler, error) {
+	if lease == nil {
+		return nil, errors.New("can't print a nil lease")
+	}
+
+	if object == nil {
+		return nil, errors.New("can't print a lease using an nil object printer")
+	}
+
+	lh := &leaseHandler{
+		lease:      lease,
+		configFunc: defaultLeaseConfig,
+		object:     object,
+	}
+	return lh, nil
+}
+
+func (l *leaseHandler) Config(options Options) error {
+	out, err := l.configFunc(l.lease, options)
+	if err != nil {
+		return err
+	}
+	l.object.RegisterConfig(out)
+	return nil
+}
+
+func defaultLeaseConfig(lease *coordinationv1.Lease, options Options) (*component.Summary, error) {
+	return NewLeaseConfiguration(lease).Create(options)
+}
+
+// leaseHolder describes who holds a lease. Node heartbeat leases link to their
+// node. A holder which hasn't renewed the lease within its duration is flagged,
+// since another candidate is free to take over.
+func leaseHolder(lease *coordinationv1.Lease, now time.Time, options Options) (component.Component, error) {
+	holder := ""
+	if lease.Spec.HolderIdentity != nil {
+		holder = *lease.Spec.HolderIdentity
+	}
+
+	if holder == "" {
+		text := component.NewText("<none>")
+		text.SetStatus(component.TextStatusWarning)
+		return text, nil
+	}
+
+	if leaseExpired(lease, now) {
+		text := component.NewText(fmt.Sprintf("%s (expired)", holder))
+		text.SetStatus(component.TextStatusWarning)
+		return text, nil
+	}
+
+	if lease.Namespace == nodeLeaseNamespace {
+		return options.Link.ForGVK("", "v1", "Node", holder, holder)
+	}
+
+	return component.NewText(holder), nil
+}
+
+// leaseExpired returns true if a lease wasn't renewed within its duration.
+func leaseExpired(lease *coordinationv1.Lease, now time.Time) bool {
+	if lease.Spec.RenewTime == nil || lease.Spec.LeaseDurationSeconds == nil {
+		return false
+	}
+
+	duration := time.Duration(*lease.Spec.LeaseDurationSeconds) * time.Second
+	return now.After(lease.Spec.RenewTime.Add(duration))
+}
+
+func leaseRenewTime(lease *coordinationv1.Lease) component.Component {
+	if lease.Spec.RenewTime == nil {
+		return component.NewText("<not renewed>")
+	}
+
+	return component.NewTimestamp(lease.Spec.RenewTime.Time)
+}
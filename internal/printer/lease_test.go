@@ -0,0 +1,159 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func createLease(namespace, name, holder string, renewTime time.Time) *coordinationv1.Lease {
+	renew := metav1.NewMicroTime(renewTime)
+	return &coordinationv1.Lease{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "coordination.k8s.io/v1",
+			Kind:       "Lease",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         namespace,
+			UID:               "uid",
+			CreationTimestamp: *testutil.CreateTimestamp(),
+		},
+		Spec: coordinationv1.LeaseSpec{
+			HolderIdentity:       pointer.StringPtr(holder),
+			LeaseDurationSeconds: pointer.Int32Ptr(15),
+			RenewTime:            &renew,
+		},
+	}
+}
+
+func Test_LeaseListHandler(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+	printOptions := tpo.ToOptions()
+
+	renewTime := time.Now().Add(-5 * time.Second).Truncate(time.Second)
+	lease := createLease("kube-system", "kube-scheduler", "master_1234", renewTime)
+
+	list := &coordinationv1.LeaseList{
+		Items: []coordinationv1.Lease{*lease},
+	}
+
+	tpo.PathForObject(lease, lease.Name, "/lease")
+
+	now := testutil.Time()
+
+	ctx := context.Background()
+	got, err := LeaseListHandler(ctx, list, printOptions)
+	require.NoError(t, err)
+
+	cols := component.NewTableCols("Name", "Holder", "Last Renewed", "Age")
+	expected := component.NewTable("Leases", "We couldn't find any leases!", cols)
+	expected.Add(component.TableRow{
+		"Name": component.NewLink("", lease.Name, "/lease",
+			genObjectStatus(component.TextStatusOK, []string{
+				"coordination.k8s.io/v1 Lease is OK",
+			})),
+		"Holder":       component.NewText("master_1234"),
+		"Last Renewed": component.NewTimestamp(renewTime),
+		"Age":          component.NewTimestamp(now),
+		component.GridActionKey: gridActionsFactory([]component.GridAction{
+			buildObjectDeleteAction(t, lease),
+		}),
+	})
+
+	testutil.AssertJSONEqual(t, expected, got)
+}
+
+func Test_LeaseConfiguration(t *testing.T) {
+	renewTime := time.Now().Add(-5 * time.Second).Truncate(time.Second)
+	acquireTime := metav1.NewMicroTime(renewTime.Add(-time.Hour))
+
+	lease := createLease("kube-node-lease", "node", "node", renewTime)
+	lease.Spec.AcquireTime = &acquireTime
+	lease.Spec.LeaseTransitions = pointer.Int32Ptr(2)
+
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+	tpo.PathForGVK("", "v1", "Node", "node", "node", "/node")
+	printOptions := tpo.ToOptions()
+
+	lc := NewLeaseConfiguration(lease)
+	got, err := lc.Create(printOptions)
+	require.NoError(t, err)
+
+	sections := component.SummarySections{
+		{Header: "Holder Identity", Content: component.NewLink("", "node", "/node")},
+		{Header: "Lease Duration", Content: component.NewText("15s")},
+		{Header: "Acquire Time", Content: component.NewTimestamp(acquireTime.Time)},
+		{Header: "Renew Time", Content: component.NewTimestamp(renewTime)},
+		{Header: "Lease Transitions", Content: component.NewText("2")},
+	}
+	expected := component.NewSummary("Configuration", sections...)
+
+	component.AssertEqual(t, expected, got)
+}
+
+func Test_leaseHolder(t *testing.T) {
+	now := time.Now()
+
+	expired := component.NewText("master_1234 (expired)")
+	expired.SetStatus(component.TextStatusWarning)
+
+	none := component.NewText("<none>")
+	none.SetStatus(component.TextStatusWarning)
+
+	tests := []struct {
+		name     string
+		lease    *coordinationv1.Lease
+		expected component.Component
+	}{
+		{
+			name:     "held",
+			lease:    createLease("kube-system", "kube-scheduler", "master_1234", now.Add(-5*time.Second)),
+			expected: component.NewText("master_1234"),
+		},
+		{
+			name:     "expired",
+			lease:    createLease("kube-system", "kube-scheduler", "master_1234", now.Add(-time.Minute)),
+			expected: expired,
+		},
+		{
+			name:     "no holder",
+			lease:    createLease("kube-system", "kube-scheduler", "", now),
+			expected: none,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			tpo := newTestPrinterOptions(controller)
+
+			got, err := leaseHolder(test.lease, now, tpo.ToOptions())
+			require.NoError(t, err)
+
+			component.AssertEqual(t, test.expected, got)
+		})
+	}
+}
@@ -22,7 +22,7 @@ func MutatingWebhookConfigurationListHandler(ctx context.Context, list *admissio
 		return nil, errors.New("mutating webhook configuration list is nil")
 	}
 
-	cols := component.NewTableCols("Name", "Age")
+	cols := component.NewTableCols("Name", "Webhooks", "Age")
 	ot := NewObjectTable("Mutating Webhook Configurations", "We couldn't find any mutating webhook configurations!", cols, options.DashConfig.ObjectStore())
 
 	for _, mutatingWebhookConfiguration := range list.Items {
@@ -33,6 +33,7 @@ func MutatingWebhookConfigurationListHandler(ctx context.Context, list *admissio
 		}
 
 		row["Name"] = nameLink
+		row["Webhooks"] = component.NewTextf("%d", len(mutatingWebhookConfiguration.Webhooks))
 		ts := mutatingWebhookConfiguration.CreationTimestamp.Time
 		row["Age"] = component.NewTimestamp(ts)
 
@@ -53,6 +54,10 @@ func MutatingWebhookConfigurationHandler(ctx context.Context, mutatingWebhookCon
 		return nil, err
 	}
 
+	if err := ch.Overview(ctx, options); err != nil {
+		return nil, errors.Wrap(err, "print mutatingwebhookconfiguration overview")
+	}
+
 	if err := ch.Webhooks(options); err != nil {
 		return nil, errors.Wrap(err, "print mutatingwebhookconfiguration webhooks")
 	}
@@ -61,11 +66,13 @@ func MutatingWebhookConfigurationHandler(ctx context.Context, mutatingWebhookCon
 }
 
 type mutatingWebhookConfigurationObject interface {
+	Overview(ctx context.Context, options Options) error
 	Webhooks(options Options) error
 }
 
 type mutatingWebhookConfigurationHandler struct {
 	mutatingWebhookConfiguration *admissionregistrationv1.MutatingWebhookConfiguration
+	overviewFunc                 func(context.Context, *admissionregistrationv1.MutatingWebhookConfiguration, Options) (*component.Table, error)
 	webhookFunc                  func(*admissionregistrationv1.MutatingWebhook, Options) (*component.Summary, error)
 	object                       *Object
 }
@@ -83,12 +90,23 @@ func newMutatingWebhookConfigurationHandler(mutatingWebhookConfiguration *admiss
 
 	ch := &mutatingWebhookConfigurationHandler{
 		mutatingWebhookConfiguration: mutatingWebhookConfiguration,
+		overviewFunc:                 defaultMutatingWebhookConfigurationOverview,
 		webhookFunc:                  defaultMutatingWebhook,
 		object:                       object,
 	}
 	return ch, nil
 }
 
+func (c *mutatingWebhookConfigurationHandler) Overview(ctx context.Context, options Options) error {
+	c.object.RegisterItems(ItemDescriptor{
+		Width: component.WidthFull,
+		Func: func() (component.Component, error) {
+			return c.overviewFunc(ctx, c.mutatingWebhookConfiguration, options)
+		},
+	})
+	return nil
+}
+
 func (c *mutatingWebhookConfigurationHandler) Webhooks(options Options) error {
 	for i := range c.mutatingWebhookConfiguration.Webhooks {
 		webhook := &c.mutatingWebhookConfiguration.Webhooks[i]
@@ -104,6 +122,19 @@ func (c *mutatingWebhookConfigurationHandler) Webhooks(options Options) error {
 	return nil
 }
 
+func defaultMutatingWebhookConfigurationOverview(ctx context.Context, mutatingWebhookConfiguration *admissionregistrationv1.MutatingWebhookConfiguration, options Options) (*component.Table, error) {
+	var webhooks []admissionWebhookOverview
+	for _, webhook := range mutatingWebhookConfiguration.Webhooks {
+		webhooks = append(webhooks, admissionWebhookOverview{
+			name:              webhook.Name,
+			clientConfig:      webhook.ClientConfig,
+			failurePolicy:     webhook.FailurePolicy,
+			namespaceSelector: webhook.NamespaceSelector,
+		})
+	}
+	return admissionWebhooksTable(ctx, webhooks, options)
+}
+
 func defaultMutatingWebhook(mutatingWebhook *admissionregistrationv1.MutatingWebhook, options Options) (*component.Summary, error) {
 	return NewMutatingWebhook(mutatingWebhook).Create(options)
 }
@@ -23,8 +23,30 @@ var (
 	namespaceListCols           = component.NewTableCols("Name", "Labels", "Status", "Age")
 	namespaceResourceQuotasCols = component.NewTableCols("Resource", "Used", "Limit")
 	namespaceResourceLimitsCols = component.NewTableCols("Type", "Resource", "Min", "Max", "Default Request", "Default Limit", "Limit/Request Ratio")
+	namespaceEventsCols         = component.NewTableCols("Object", "Type", "Reason", "Message", "Last Seen")
+	namespaceRestartingPodsCols = component.NewTableCols("Pod", "Restarts", "Phase")
 )
 
+const (
+	// namespaceRecentEventLimit is the most events shown for a namespace.
+	namespaceRecentEventLimit = 10
+	// namespaceRestartingPodLimit is the most restarting pods shown for a namespace.
+	namespaceRestartingPodLimit = 5
+)
+
+// namespaceWorkloadKinds are the workloads counted for a namespace.
+var namespaceWorkloadKinds = []struct {
+	title      string
+	apiVersion string
+	kind       string
+}{
+	{title: "Deployments", apiVersion: "apps/v1", kind: "Deployment"},
+	{title: "Stateful Sets", apiVersion: "apps/v1", kind: "StatefulSet"},
+	{title: "Daemon Sets", apiVersion: "apps/v1", kind: "DaemonSet"},
+	{title: "Jobs", apiVersion: "batch/v1", kind: "Job"},
+	{title: "Cron Jobs", apiVersion: "batch/v1beta1", kind: "CronJob"},
+}
+
 func NamespaceListHandler(ctx context.Context, list *corev1.NamespaceList, options Options) (component.Component, error) {
 	if list == nil {
 		return nil, errors.New("namespace list is nil")
@@ -58,6 +80,15 @@ func NamespaceHandler(ctx context.Context, namespace *corev1.Namespace, options
 	if err := nh.Status(options); err != nil {
 		return nil, errors.Wrap(err, "print namespace status")
 	}
+	if err := nh.Workloads(ctx, options); err != nil {
+		return nil, errors.Wrap(err, "print namespace workloads")
+	}
+	if err := nh.RestartingPods(ctx, options); err != nil {
+		return nil, errors.Wrap(err, "print namespace restarting pods")
+	}
+	if err := nh.RecentEvents(ctx, options); err != nil {
+		return nil, errors.Wrap(err, "print namespace events")
+	}
 	if err := nh.ResourceLimits(ctx, options); err != nil {
 		return nil, errors.Wrap(err, "print namespace resource limits")
 	}
@@ -69,6 +100,9 @@ func NamespaceHandler(ctx context.Context, namespace *corev1.Namespace, options
 
 type namespaceObject interface {
 	Status(options Options) error
+	Workloads(ctx context.Context, options Options) error
+	RestartingPods(ctx context.Context, options Options) error
+	RecentEvents(ctx context.Context, options Options) error
 	ResourceQuotas(ctx context.Context, options Options) error
 	ResourceLimits(ctx context.Context, options Options) error
 }
@@ -76,6 +110,9 @@ type namespaceObject interface {
 type namespaceHandler struct {
 	namespace          *corev1.Namespace
 	statusFunc         func(*corev1.Namespace, Options) (*component.Summary, error)
+	workloadsFunc      func(context.Context, *corev1.Namespace, Options) (*component.Summary, error)
+	restartingPodsFunc func(context.Context, *corev1.Namespace, Options) (*component.Table, error)
+	recentEventsFunc   func(context.Context, *corev1.Namespace, Options) (*component.Table, error)
 	resourceQuotasFunc func(context.Context, *corev1.Namespace, Options) (*component.FlexLayout, error)
 	resourceLimitsFunc func(context.Context, *corev1.Namespace, Options) (*component.Table, error)
 	object             *Object
@@ -95,6 +132,9 @@ func newNamespaceHandler(namespace *corev1.Namespace, object *Object) (*namespac
 	nh := &namespaceHandler{
 		namespace:          namespace,
 		statusFunc:         defaultNamespaceStatus,
+		workloadsFunc:      defaultNamespaceWorkloads,
+		restartingPodsFunc: defaultNamespaceRestartingPods,
+		recentEventsFunc:   defaultNamespaceRecentEvents,
 		resourceQuotasFunc: defaultNamespaceResourceQuotas,
 		resourceLimitsFunc: defaultNamespaceResourceLimits,
 		object:             object,
@@ -111,6 +151,36 @@ func (n *namespaceHandler) Status(options Options) error {
 	return nil
 }
 
+func (n *namespaceHandler) Workloads(ctx context.Context, options Options) error {
+	n.object.RegisterItems(ItemDescriptor{
+		Width: component.WidthHalf,
+		Func: func() (component.Component, error) {
+			return n.workloadsFunc(ctx, n.namespace, options)
+		},
+	})
+	return nil
+}
+
+func (n *namespaceHandler) RestartingPods(ctx context.Context, options Options) error {
+	n.object.RegisterItems(ItemDescriptor{
+		Width: component.WidthHalf,
+		Func: func() (component.Component, error) {
+			return n.restartingPodsFunc(ctx, n.namespace, options)
+		},
+	})
+	return nil
+}
+
+func (n *namespaceHandler) RecentEvents(ctx context.Context, options Options) error {
+	n.object.RegisterItems(ItemDescriptor{
+		Width: component.WidthFull,
+		Func: func() (component.Component, error) {
+			return n.recentEventsFunc(ctx, n.namespace, options)
+		},
+	})
+	return nil
+}
+
 func (n *namespaceHandler) ResourceQuotas(ctx context.Context, options Options) error {
 	n.object.RegisterItems(ItemDescriptor{
 		Width: component.WidthFull,
@@ -163,6 +233,184 @@ func defaultNamespaceStatus(namespace *corev1.Namespace, options Options) (*comp
 	return NewNamespaceStatus(namespace).Create(options)
 }
 
+// defaultNamespaceWorkloads counts the workloads in a namespace.
+func defaultNamespaceWorkloads(ctx context.Context, namespace *corev1.Namespace, options Options) (*component.Summary, error) {
+	if namespace == nil {
+		return nil, errors.New("namespace is nil")
+	}
+
+	objectStore := options.DashConfig.ObjectStore()
+
+	var sections component.SummarySections
+	for _, workloadKind := range namespaceWorkloadKinds {
+		key := store.Key{
+			Namespace:  namespace.Name,
+			APIVersion: workloadKind.apiVersion,
+			Kind:       workloadKind.kind,
+		}
+		list, _, err := objectStore.List(ctx, key)
+		if err != nil {
+			return nil, errors.Wrapf(err, "list all objects for key %+v", key)
+		}
+
+		sections.AddText(workloadKind.title, fmt.Sprintf("%d", len(list.Items)))
+	}
+
+	pods, err := listNamespacePods(ctx, namespace, options)
+	if err != nil {
+		return nil, err
+	}
+
+	running := 0
+	for i := range pods {
+		if pods[i].Status.Phase == corev1.PodRunning {
+			running++
+		}
+	}
+	sections.AddText("Pods", fmt.Sprintf("%d (%d running)", len(pods), running))
+
+	return component.NewSummary("Workloads", sections...), nil
+}
+
+// defaultNamespaceRestartingPods lists the pods in a namespace whose containers
+// restarted the most.
+func defaultNamespaceRestartingPods(ctx context.Context, namespace *corev1.Namespace, options Options) (*component.Table, error) {
+	if namespace == nil {
+		return nil, errors.New("namespace is nil")
+	}
+
+	pods, err := listNamespacePods(ctx, namespace, options)
+	if err != nil {
+		return nil, err
+	}
+
+	type podRestarts struct {
+		pod      *corev1.Pod
+		restarts int32
+	}
+
+	var restarting []podRestarts
+	for i := range pods {
+		var restarts int32
+		for _, containerStatus := range pods[i].Status.ContainerStatuses {
+			restarts += containerStatus.RestartCount
+		}
+		if restarts > 0 {
+			restarting = append(restarting, podRestarts{pod: &pods[i], restarts: restarts})
+		}
+	}
+
+	sort.SliceStable(restarting, func(i, j int) bool {
+		return restarting[i].restarts > restarting[j].restarts
+	})
+
+	if len(restarting) > namespaceRestartingPodLimit {
+		restarting = restarting[:namespaceRestartingPodLimit]
+	}
+
+	table := component.NewTable("Top Restarting Pods", "There are no restarting pods!", namespaceRestartingPodsCols)
+
+	for _, item := range restarting {
+		podLink, err := options.Link.ForObject(item.pod, item.pod.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		table.Add(component.TableRow{
+			"Pod":      podLink,
+			"Restarts": component.NewText(fmt.Sprintf("%d", item.restarts)),
+			"Phase":    component.NewText(string(item.pod.Status.Phase)),
+		})
+	}
+
+	return table, nil
+}
+
+// defaultNamespaceRecentEvents lists the latest events for objects in a namespace.
+func defaultNamespaceRecentEvents(ctx context.Context, namespace *corev1.Namespace, options Options) (*component.Table, error) {
+	if namespace == nil {
+		return nil, errors.New("namespace is nil")
+	}
+
+	key := store.Key{
+		Namespace:  namespace.Name,
+		APIVersion: "v1",
+		Kind:       "Event",
+	}
+	list, _, err := options.DashConfig.ObjectStore().List(ctx, key)
+	if err != nil {
+		return nil, errors.Wrapf(err, "list all objects for key %+v", key)
+	}
+
+	events := make([]corev1.Event, len(list.Items))
+	for i := range list.Items {
+		if err := kubernetes.FromUnstructured(&list.Items[i], &events[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		return EventLastSeen(&events[i]).After(EventLastSeen(&events[j]))
+	})
+
+	if len(events) > namespaceRecentEventLimit {
+		events = events[:namespaceRecentEventLimit]
+	}
+
+	table := component.NewTable("Recent Events", "There are no events!", namespaceEventsCols)
+
+	for i := range events {
+		event := &events[i]
+		involved := event.InvolvedObject
+
+		// Objects octant has no view for are shown without a link.
+		objectText := fmt.Sprintf("%s %s", involved.Kind, involved.Name)
+		var objectComponent component.Component
+		objectLink, err := options.Link.ForGVK(involved.Namespace, involved.APIVersion, involved.Kind, involved.Name, objectText)
+		if err != nil {
+			objectComponent = component.NewText(objectText)
+		} else {
+			objectComponent = objectLink
+		}
+
+		eventType := component.NewText(event.Type)
+		if event.Type == corev1.EventTypeWarning {
+			eventType.SetStatus(component.TextStatusWarning)
+		}
+
+		table.Add(component.TableRow{
+			"Object":    objectComponent,
+			"Type":      eventType,
+			"Reason":    component.NewText(event.Reason),
+			"Message":   component.NewText(event.Message),
+			"Last Seen": component.NewTimestamp(EventLastSeen(event)),
+		})
+	}
+
+	return table, nil
+}
+
+func listNamespacePods(ctx context.Context, namespace *corev1.Namespace, options Options) ([]corev1.Pod, error) {
+	key := store.Key{
+		Namespace:  namespace.Name,
+		APIVersion: "v1",
+		Kind:       "Pod",
+	}
+	list, _, err := options.DashConfig.ObjectStore().List(ctx, key)
+	if err != nil {
+		return nil, errors.Wrapf(err, "list all objects for key %+v", key)
+	}
+
+	pods := make([]corev1.Pod, len(list.Items))
+	for i := range list.Items {
+		if err := kubernetes.FromUnstructured(&list.Items[i], &pods[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	return pods, nil
+}
+
 // NamespaceResourceQuotas creates a namespace resource quota component.
 type NamespaceResourceQuotas struct {
 	namespace *corev1.Namespace
@@ -8,16 +8,20 @@ package printer
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/types"
 
 	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/store"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
 
@@ -232,3 +236,156 @@ func Test_createResourceLimitMemoryRow(t *testing.T) {
 	_, _, created = createResourceLimitMemoryRow(cpu)
 	require.False(t, created)
 }
+
+func Test_defaultNamespaceWorkloads(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+
+	namespace := testutil.CreateNamespace("namespace")
+
+	running := testutil.CreatePod("running")
+	running.Status.Phase = corev1.PodRunning
+	pending := testutil.CreatePod("pending")
+	pending.Status.Phase = corev1.PodPending
+
+	tpo.objectStore.EXPECT().
+		List(gomock.Any(), store.Key{Namespace: "namespace", APIVersion: "apps/v1", Kind: "Deployment"}).
+		Return(testutil.ToUnstructuredList(t, testutil.CreateDeployment("deployment")), false, nil)
+	tpo.objectStore.EXPECT().
+		List(gomock.Any(), store.Key{Namespace: "namespace", APIVersion: "apps/v1", Kind: "StatefulSet"}).
+		Return(&unstructured.UnstructuredList{}, false, nil)
+	tpo.objectStore.EXPECT().
+		List(gomock.Any(), store.Key{Namespace: "namespace", APIVersion: "apps/v1", Kind: "DaemonSet"}).
+		Return(&unstructured.UnstructuredList{}, false, nil)
+	tpo.objectStore.EXPECT().
+		List(gomock.Any(), store.Key{Namespace: "namespace", APIVersion: "batch/v1", Kind: "Job"}).
+		Return(&unstructured.UnstructuredList{}, false, nil)
+	tpo.objectStore.EXPECT().
+		List(gomock.Any(), store.Key{Namespace: "namespace", APIVersion: "batch/v1beta1", Kind: "CronJob"}).
+		Return(&unstructured.UnstructuredList{}, false, nil)
+	tpo.objectStore.EXPECT().
+		List(gomock.Any(), store.Key{Namespace: "namespace", APIVersion: "v1", Kind: "Pod"}).
+		Return(testutil.ToUnstructuredList(t, running, pending), false, nil)
+
+	got, err := defaultNamespaceWorkloads(context.Background(), namespace, tpo.ToOptions())
+	require.NoError(t, err)
+
+	sections := component.SummarySections{}
+	sections.AddText("Deployments", "1")
+	sections.AddText("Stateful Sets", "0")
+	sections.AddText("Daemon Sets", "0")
+	sections.AddText("Jobs", "0")
+	sections.AddText("Cron Jobs", "0")
+	sections.AddText("Pods", "2 (1 running)")
+	expected := component.NewSummary("Workloads", sections...)
+
+	component.AssertEqual(t, expected, got)
+}
+
+func Test_defaultNamespaceRestartingPods(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+
+	namespace := testutil.CreateNamespace("namespace")
+
+	crashing := testutil.CreatePod("crashing")
+	crashing.Status.Phase = corev1.PodRunning
+	crashing.Status.ContainerStatuses = []corev1.ContainerStatus{
+		{Name: "app", RestartCount: 12},
+		{Name: "sidecar", RestartCount: 3},
+	}
+	flaky := testutil.CreatePod("flaky")
+	flaky.Status.Phase = corev1.PodRunning
+	flaky.Status.ContainerStatuses = []corev1.ContainerStatus{{Name: "app", RestartCount: 1}}
+	healthy := testutil.CreatePod("healthy")
+	healthy.Status.ContainerStatuses = []corev1.ContainerStatus{{Name: "app"}}
+
+	tpo.objectStore.EXPECT().
+		List(gomock.Any(), store.Key{Namespace: "namespace", APIVersion: "v1", Kind: "Pod"}).
+		Return(testutil.ToUnstructuredList(t, flaky, healthy, crashing), false, nil)
+
+	tpo.PathForObject(crashing, "crashing", "/crashing")
+	tpo.PathForObject(flaky, "flaky", "/flaky")
+
+	got, err := defaultNamespaceRestartingPods(context.Background(), namespace, tpo.ToOptions())
+	require.NoError(t, err)
+
+	expected := component.NewTableWithRows("Top Restarting Pods", "There are no restarting pods!", namespaceRestartingPodsCols, []component.TableRow{
+		{
+			"Pod":      component.NewLink("", "crashing", "/crashing"),
+			"Restarts": component.NewText("15"),
+			"Phase":    component.NewText("Running"),
+		},
+		{
+			"Pod":      component.NewLink("", "flaky", "/flaky"),
+			"Restarts": component.NewText("1"),
+			"Phase":    component.NewText("Running"),
+		},
+	})
+
+	component.AssertEqual(t, expected, got)
+}
+
+func Test_defaultNamespaceRecentEvents(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+
+	namespace := testutil.CreateNamespace("namespace")
+
+	now := testutil.Time()
+
+	older := testutil.CreateEvent("older")
+	older.Type = corev1.EventTypeNormal
+	older.Reason = "Scheduled"
+	older.Message = "Successfully assigned pod"
+	older.LastTimestamp = metav1.NewTime(now.Add(-time.Minute))
+	older.InvolvedObject = corev1.ObjectReference{APIVersion: "v1", Kind: "Pod", Namespace: "namespace", Name: "pod"}
+
+	newer := testutil.CreateEvent("newer")
+	newer.Type = corev1.EventTypeWarning
+	newer.Reason = "Failed"
+	newer.Message = "Error syncing"
+	// Events created through the events API only set the event time.
+	newer.EventTime = metav1.NewMicroTime(now)
+	newer.InvolvedObject = corev1.ObjectReference{APIVersion: "example.com/v1", Kind: "Widget", Namespace: "namespace", Name: "widget"}
+
+	tpo.objectStore.EXPECT().
+		List(gomock.Any(), store.Key{Namespace: "namespace", APIVersion: "v1", Kind: "Event"}).
+		Return(testutil.ToUnstructuredList(t, older, newer), false, nil)
+
+	tpo.PathForGVK("namespace", "v1", "Pod", "pod", "Pod pod", "/pod")
+	tpo.link.EXPECT().
+		ForGVK("namespace", "example.com/v1", "Widget", "widget", "Widget widget").
+		Return(nil, errors.New("unknown object"))
+
+	got, err := defaultNamespaceRecentEvents(context.Background(), namespace, tpo.ToOptions())
+	require.NoError(t, err)
+
+	warning := component.NewText("Warning")
+	warning.SetStatus(component.TextStatusWarning)
+
+	expected := component.NewTableWithRows("Recent Events", "There are no events!", namespaceEventsCols, []component.TableRow{
+		{
+			"Object":    component.NewText("Widget widget"),
+			"Type":      warning,
+			"Reason":    component.NewText("Failed"),
+			"Message":   component.NewText("Error syncing"),
+			"Last Seen": component.NewTimestamp(now),
+		},
+		{
+			"Object":    component.NewLink("", "Pod pod", "/pod"),
+			"Type":      component.NewText("Normal"),
+			"Reason":    component.NewText("Scheduled"),
+			"Message":   component.NewText("Successfully assigned pod"),
+			"Last Seen": component.NewTimestamp(now.Add(-time.Minute)),
+		},
+	})
+
+	component.AssertEqual(t, expected, got)
+}
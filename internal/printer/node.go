@@ -10,7 +10,9 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/vmware-tanzu/octant/internal/octant"
 	"github.com/vmware-tanzu/octant/internal/util/kubernetes"
+	"github.com/vmware-tanzu/octant/pkg/action"
 	"github.com/vmware-tanzu/octant/pkg/store"
 
 	"github.com/pkg/errors"
@@ -64,6 +66,7 @@ func NodeListHandler(ctx context.Context, list *corev1.NodeList, options Options
 // NodeHandler is a printFunc that prints nodes
 func NodeHandler(ctx context.Context, node *corev1.Node, options Options) (component.Component, error) {
 	o := NewObject(node)
+	addNodeButtons(o, node)
 
 	nh, err := newNodeHandler(node, o)
 	if err != nil {
@@ -82,6 +85,9 @@ func NodeHandler(ctx context.Context, node *corev1.Node, options Options) (compo
 	if err := nh.Conditions(options); err != nil {
 		return nil, errors.Wrap(err, "print node conditions")
 	}
+	if err := nh.Taints(ctx, options); err != nil {
+		return nil, errors.Wrap(err, "print node taints")
+	}
 	if err := nh.Images(options); err != nil {
 		return nil, errors.Wrap(err, "print node images")
 	}
@@ -91,6 +97,32 @@ func NodeHandler(ctx context.Context, node *corev1.Node, options Options) (compo
 	return o.ToComponent(ctx, options)
 }
 
+// addNodeButtons adds buttons to cordon or uncordon and drain a node. A drain
+// cordons the node, so a drain can only be cancelled while the node is cordoned.
+func addNodeButtons(o ObjectInterface, node *corev1.Node) {
+	payload := action.Payload{
+		"apiVersion": node.APIVersion,
+		"kind":       node.Kind,
+		"name":       node.Name,
+	}
+
+	if node.Spec.Unschedulable {
+		o.AddButton("Uncordon", action.CreatePayload(octant.ActionOverviewUncordon, payload))
+	} else {
+		o.AddButton("Cordon", action.CreatePayload(octant.ActionOverviewCordon, payload))
+	}
+
+	o.AddButton("Drain", action.CreatePayload(octant.ActionDrainNode, payload),
+		component.WithButtonConfirmation(
+			"Drain Node",
+			fmt.Sprintf("Cordon node **%s** and evict its pods? Pods managed by daemon sets are not evicted.", node.Name),
+		))
+
+	if node.Spec.Unschedulable {
+		o.AddButton("Cancel Drain", action.CreatePayload(octant.ActionCancelDrainNode, payload))
+	}
+}
+
 type nodeResource struct {
 	CPU              string
 	Memory           string
@@ -299,9 +331,97 @@ func (n *NodeConfiguration) Create(options Options) (*component.Summary, error)
 		},
 	}...)
 
+	taintAction, err := setNodeTaintAction(n.node)
+	if err != nil {
+		return nil, err
+	}
+	summary.AddAction(taintAction)
+
 	return summary, nil
 }
 
+// setNodeTaintAction creates an action with a form which adds or updates a taint.
+func setNodeTaintAction(node *corev1.Node) (component.Action, error) {
+	var effects []component.InputChoice
+	for i, effect := range octant.TaintEffects {
+		effects = append(effects, component.InputChoice{Label: string(effect), Value: string(effect), Checked: i == 0})
+	}
+
+	modes := []component.InputChoice{
+		{Label: "Apply", Value: octant.TaintModeApply, Checked: true},
+		{Label: "Preview untolerated pods", Value: octant.TaintModePreview},
+	}
+
+	form, err := component.CreateFormForObject(octant.ActionSetNodeTaint, node,
+		component.NewFormFieldText("Key", "taintKey", ""),
+		component.NewFormFieldText("Value", "value", ""),
+		component.NewFormFieldRadio("Effect", "effect", effects),
+		component.NewFormFieldRadio("Mode", "mode", modes),
+	)
+	if err != nil {
+		return component.Action{}, err
+	}
+
+	return component.Action{
+		Name:  "Set Taint",
+		Title: "Set Node Taint",
+		Form:  form,
+	}, nil
+}
+
+var (
+	nodeTaintsColumns = component.NewTableCols("Key", "Value", "Effect", "Untolerated Pods")
+)
+
+// createNodeTaintsView creates a table of the taints of a node, and the pods
+// scheduled on the node which don't tolerate each taint.
+func createNodeTaintsView(ctx context.Context, node *corev1.Node, options Options) (*component.Table, error) {
+	if node == nil {
+		return nil, errors.New("cannot generate taints for nil node")
+	}
+
+	table := component.NewTable("Taints", "There are no taints!", nodeTaintsColumns)
+	if len(node.Spec.Taints) == 0 {
+		return table, nil
+	}
+
+	pods, err := listNodePods(ctx, node, options)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, taint := range node.Spec.Taints {
+		var names []string
+		for _, pod := range octant.UntoleratedPods(pods, taint) {
+			names = append(names, fmt.Sprintf("%s/%s", pod.Namespace, pod.Name))
+		}
+
+		row := component.TableRow{
+			"Key":              component.NewText(taint.Key),
+			"Value":            component.NewText(taint.Value),
+			"Effect":           component.NewText(string(taint.Effect)),
+			"Untolerated Pods": component.NewText(strings.Join(names, ", ")),
+		}
+
+		row.AddAction(component.GridAction{
+			Name:       "Remove",
+			ActionPath: octant.ActionRemoveNodeTaint,
+			Payload: action.Payload{
+				"apiVersion": node.APIVersion,
+				"kind":       node.Kind,
+				"name":       node.Name,
+				"taintKey":   taint.Key,
+				"effect":     string(taint.Effect),
+			},
+			Type: component.GridActionDanger,
+		})
+
+		table.Add(row)
+	}
+
+	return table, nil
+}
+
 var (
 	nodeConditionsColumns = component.NewTableCols("Type", "Reason", "Status", "Message", "Last Heartbeat", "Last Transition")
 )
@@ -366,6 +486,28 @@ var (
 func createNodePodsView(ctx context.Context, node *corev1.Node, options Options) (*component.Table, error) {
 	table := component.NewTable("Pods", "There are no pods!", nodePodsColumns)
 
+	pods, err := listNodePods(ctx, node, options)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, pod := range pods {
+
+		row := component.TableRow{
+			"Name":      component.NewText(pod.Name),
+			"Namespace": component.NewText(pod.Namespace),
+		}
+
+		table.Add(row)
+	}
+
+	table.Sort("Name")
+
+	return table, nil
+}
+
+// listNodePods lists the pods scheduled on a node.
+func listNodePods(ctx context.Context, node *corev1.Node, options Options) ([]corev1.Pod, error) {
 	podList := &corev1.PodList{}
 	objectStore := options.DashConfig.ObjectStore()
 
@@ -391,19 +533,7 @@ func createNodePodsView(ctx context.Context, node *corev1.Node, options Options)
 		}
 	}
 
-	for _, pod := range podList.Items {
-
-		row := component.TableRow{
-			"Name":      component.NewText(pod.Name),
-			"Namespace": component.NewText(pod.Namespace),
-		}
-
-		table.Add(row)
-	}
-
-	table.Sort("Name")
-
-	return table, nil
+	return podList.Items, nil
 }
 
 type nodeObject interface {
@@ -411,6 +541,7 @@ type nodeObject interface {
 	Addresses(options Options) error
 	Resources(options Options) error
 	Conditions(options Options) error
+	Taints(ctx context.Context, options Options) error
 	Images(options Options) error
 }
 
@@ -420,6 +551,7 @@ type nodeHandler struct {
 	addressesFunc  func(*corev1.Node, Options) (*component.Table, error)
 	resourcesFunc  func(*corev1.Node, Options) (*component.Table, error)
 	conditionsFunc func(*corev1.Node, Options) (*component.Table, error)
+	taintsFunc     func(context.Context, *corev1.Node, Options) (*component.Table, error)
 	imagesFunc     func(*corev1.Node, Options) (*component.Table, error)
 	podsFunc       func(context.Context, *corev1.Node, Options) (*component.Table, error)
 	object         *Object
@@ -442,6 +574,7 @@ func newNodeHandler(node *corev1.Node, object *Object) (*nodeHandler, error) {
 		addressesFunc:  defaultNodeAddresses,
 		resourcesFunc:  defaultNodeResources,
 		conditionsFunc: defaultNodeConditions,
+		taintsFunc:     defaultNodeTaints,
 		imagesFunc:     defaultNodeImages,
 		podsFunc:       defaultNodePods,
 		object:         object,
@@ -516,6 +649,24 @@ func defaultNodeConditions(node *corev1.Node, options Options) (*component.Table
 	return createNodeConditionsView(node)
 }
 
+func (n *nodeHandler) Taints(ctx context.Context, options Options) error {
+	if n.node == nil {
+		return errors.New("can't display taints for nil node")
+	}
+
+	n.object.RegisterItems(ItemDescriptor{
+		Width: component.WidthFull,
+		Func: func() (component.Component, error) {
+			return n.taintsFunc(ctx, n.node, options)
+		},
+	})
+	return nil
+}
+
+func defaultNodeTaints(ctx context.Context, node *corev1.Node, options Options) (*component.Table, error) {
+	return createNodeTaintsView(ctx, node, options)
+}
+
 func (n *nodeHandler) Images(options Options) error {
 	if n.node == nil {
 		return errors.New("can't display resources for nil node")
@@ -11,7 +11,9 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 
+	"github.com/vmware-tanzu/octant/internal/octant"
 	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/action"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
 
@@ -66,6 +68,56 @@ func Test_NodeConfiguration(t *testing.T) {
 	node.Spec.PodCIDR = "10.244.0.0/24"
 	node.Status.NodeInfo.SystemUUID = "5AF9704C-2606-11B2-A85C-C7F92F2B85CA"
 
+	expected := component.NewSummary("Status", []component.SummarySection{
+		{
+			Header:  "Architecture",
+			Content: component.NewText("amd64"),
+		},
+		{
+			Header:  "Boot ID",
+			Content: component.NewText("7eee89e0-b78a-4c30-a1bc-d43ad479b35a"),
+		},
+		{
+			Header:  "Container Runtime Version",
+			Content: component.NewText("containerd://1.2.6-0ubuntu1"),
+		},
+		{
+			Header:  "Kernel Version",
+			Content: component.NewText("4.15.0-58-generic"),
+		},
+		{
+			Header:  "KubeProxy Version",
+			Content: component.NewText("v1.15.3"),
+		},
+		{
+			Header:  "Kubelet Version",
+			Content: component.NewText("v1.15.3"),
+		},
+		{
+			Header:  "Machine ID",
+			Content: component.NewText("87050f150cca41c0ab58b7672b5dbc11"),
+		},
+		{
+			Header:  "Operating System",
+			Content: component.NewText("linux"),
+		},
+		{
+			Header:  "OS Image",
+			Content: component.NewText("Ubuntu Disco Dingo (development branch)"),
+		},
+		{
+			Header:  "Pod CIDR",
+			Content: component.NewText("10.244.0.0/24"),
+		},
+		{
+			Header:  "System UUID",
+			Content: component.NewText("5AF9704C-2606-11B2-A85C-C7F92F2B85CA"),
+		},
+	}...)
+	taintAction, err := setNodeTaintAction(node)
+	require.NoError(t, err)
+	expected.AddAction(taintAction)
+
 	cases := []struct {
 		name     string
 		node     *corev1.Node
@@ -73,54 +125,9 @@ func Test_NodeConfiguration(t *testing.T) {
 		expected *component.Summary
 	}{
 		{
-			name: "general",
-			node: node,
-			expected: component.NewSummary("Status", []component.SummarySection{
-				{
-					Header:  "Architecture",
-					Content: component.NewText("amd64"),
-				},
-				{
-					Header:  "Boot ID",
-					Content: component.NewText("7eee89e0-b78a-4c30-a1bc-d43ad479b35a"),
-				},
-				{
-					Header:  "Container Runtime Version",
-					Content: component.NewText("containerd://1.2.6-0ubuntu1"),
-				},
-				{
-					Header:  "Kernel Version",
-					Content: component.NewText("4.15.0-58-generic"),
-				},
-				{
-					Header:  "KubeProxy Version",
-					Content: component.NewText("v1.15.3"),
-				},
-				{
-					Header:  "Kubelet Version",
-					Content: component.NewText("v1.15.3"),
-				},
-				{
-					Header:  "Machine ID",
-					Content: component.NewText("87050f150cca41c0ab58b7672b5dbc11"),
-				},
-				{
-					Header:  "Operating System",
-					Content: component.NewText("linux"),
-				},
-				{
-					Header:  "OS Image",
-					Content: component.NewText("Ubuntu Disco Dingo (development branch)"),
-				},
-				{
-					Header:  "Pod CIDR",
-					Content: component.NewText("10.244.0.0/24"),
-				},
-				{
-					Header:  "System UUID",
-					Content: component.NewText("5AF9704C-2606-11B2-A85C-C7F92F2B85CA"),
-				},
-			}...),
+			name:     "general",
+			node:     node,
+			expected: expected,
 		},
 		{
 			name:  "pod is nil",
@@ -195,6 +202,63 @@ func Test_createNodePodsView(t *testing.T) {
 	component.AssertEqual(t, expected, got)
 }
 
+func Test_createNodeTaintsView(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+	printOptions := tpo.ToOptions()
+
+	ctx := context.Background()
+
+	taint := corev1.Taint{Key: "dedicated", Value: "gpu", Effect: corev1.TaintEffectNoSchedule}
+
+	node := testutil.CreateNode("node-1")
+	node.Spec.Taints = []corev1.Taint{taint}
+
+	tolerating := testutil.CreatePod("tolerating")
+	tolerating.Spec.NodeName = "node-1"
+	tolerating.Spec.Tolerations = []corev1.Toleration{
+		{Key: "dedicated", Operator: corev1.TolerationOpEqual, Value: "gpu", Effect: corev1.TaintEffectNoSchedule},
+	}
+
+	untolerating := testutil.CreatePod("untolerating")
+	untolerating.Spec.NodeName = "node-1"
+
+	podKey := store.Key{
+		APIVersion: "v1",
+		Kind:       "Pod",
+	}
+
+	tpo.objectStore.EXPECT().List(gomock.Any(), podKey).
+		Return(testutil.ToUnstructuredList(t, tolerating, untolerating), false, nil)
+
+	got, err := createNodeTaintsView(ctx, node, printOptions)
+	require.NoError(t, err)
+
+	row := component.TableRow{
+		"Key":              component.NewText("dedicated"),
+		"Value":            component.NewText("gpu"),
+		"Effect":           component.NewText("NoSchedule"),
+		"Untolerated Pods": component.NewText("namespace/untolerating"),
+	}
+	row.AddAction(component.GridAction{
+		Name:       "Remove",
+		ActionPath: octant.ActionRemoveNodeTaint,
+		Payload: action.Payload{
+			"apiVersion": "v1",
+			"kind":       "Node",
+			"name":       "node-1",
+			"taintKey":   "dedicated",
+			"effect":     "NoSchedule",
+		},
+		Type: component.GridActionDanger,
+	})
+
+	expected := component.NewTableWithRows("Taints", "There are no taints!", nodeTaintsColumns, []component.TableRow{row})
+	component.AssertEqual(t, expected, got)
+}
+
 func Test_createAddressesView(t *testing.T) {
 	node := testutil.CreateNode("node-1")
 	node.Status.Addresses = []corev1.NodeAddress{
@@ -334,3 +398,49 @@ func Test_createNodeImagesView(t *testing.T) {
 
 	component.AssertEqual(t, expected, got)
 }
+
+func Test_addNodeButtons(t *testing.T) {
+	tests := []struct {
+		name          string
+		unschedulable bool
+		expected      []string
+	}{
+		{
+			name:     "schedulable",
+			expected: []string{"Cordon", "Drain"},
+		},
+		{
+			name:          "unschedulable",
+			unschedulable: true,
+			expected:      []string{"Uncordon", "Drain", "Cancel Drain"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			node := testutil.CreateNode("node")
+			node.Spec.Unschedulable = test.unschedulable
+
+			o := &buttonRecorder{}
+			addNodeButtons(o, node)
+
+			require.Equal(t, test.expected, o.names)
+			for _, payload := range o.payloads {
+				require.Equal(t, "node", payload["name"])
+			}
+		})
+	}
+}
+
+// buttonRecorder records the buttons added to an object.
+type buttonRecorder struct {
+	ObjectInterface
+
+	names    []string
+	payloads []action.Payload
+}
+
+func (r *buttonRecorder) AddButton(name string, payload action.Payload, _ ...component.ButtonOption) {
+	r.names = append(r.names, name)
+	r.payloads = append(r.payloads, payload)
+}
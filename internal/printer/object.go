@@ -9,11 +9,13 @@ import (
 	"context"
 	"fmt"
 
+	"golang.org/x/sync/errgroup"
 	batchv1beta1 "k8s.io/api/batch/v1beta1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 
 	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/plugin"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 	"github.com/vmware-tanzu/octant/pkg/view/flexlayout"
 )
@@ -188,52 +190,109 @@ func (o *Object) summaryComponent(title string, summary *component.Summary, sect
 	return nil
 }
 
-// ToComponent converts Object to a view.
+// ToComponent converts Object to a view. Plugin content, items, templates, and events are
+// generated concurrently and laid out in a stable order. An item or template which fails
+// is shown as an error in its place.
 func (o *Object) ToComponent(ctx context.Context, options Options) (component.Component, error) {
 	if o.object == nil {
 		return nil, fmt.Errorf("object is nil")
 	}
 
-	summarySection := o.flexLayout.AddSection()
-
 	pluginPrinter := options.DashConfig.PluginManager()
 	if pluginPrinter == nil {
 		return nil, fmt.Errorf("plugin printer is nil")
 	}
 
-	pr, err := pluginPrinter.Print(ctx, o.object)
-	if err != nil {
-		return nil, fmt.Errorf("plugin manager: %w", err)
+	var g errgroup.Group
+
+	var pr *plugin.PrintResponse
+	var pluginErr error
+	g.Go(func() error {
+		pr, pluginErr = pluginPrinter.Print(ctx, o.object)
+		return nil
+	})
+
+	itemViews := make([][]component.Component, len(o.itemsLists))
+	for i := range o.itemsLists {
+		itemViews[i] = make([]component.Component, len(o.itemsLists[i]))
+		for j := range o.itemsLists[i] {
+			i, j := i, j
+			item := o.itemsLists[i][j]
+			if item.Component != nil {
+				itemViews[i][j] = item.Component
+				continue
+			}
+
+			g.Go(func() error {
+				vc, err := item.Func()
+				if err != nil {
+					vc = component.NewError(component.TitleFromString("Error"), fmt.Errorf("create item view: %w", err))
+				}
+				itemViews[i][j] = vc
+				return nil
+			})
+		}
+	}
+
+	var generated []*flexlayout.FlexLayout
+	addLayout := func(name string, enabled bool, gen func(*flexlayout.FlexLayout) error) {
+		if !enabled {
+			return
+		}
+
+		fl := flexlayout.New()
+		generated = append(generated, fl)
+		g.Go(func() error {
+			if err := gen(fl); err != nil {
+				// Drop anything generated before the failure.
+				*fl = *flexlayout.New()
+				errorView := component.NewError(component.TitleFromString("Error"), fmt.Errorf("generate %s: %w", name, err))
+				return fl.AddSection().Add(errorView, component.WidthFull)
+			}
+			return nil
+		})
+	}
+
+	addLayout("pod template", o.isPodTemplateEnabled, func(fl *flexlayout.FlexLayout) error {
+		return o.PodTemplateGen(ctx, o.object, o.podTemplateOptions.template, fl, options)
+	})
+	addLayout("job template", o.isJobTemplateEnabled, func(fl *flexlayout.FlexLayout) error {
+		return o.JobTemplateGen(ctx, o.object, o.jobTemplateOptions.template, fl, options)
+	})
+	addLayout("events", o.isEventsEnabled, func(fl *flexlayout.FlexLayout) error {
+		return o.EventsGen(ctx, o.object, fl, options)
+	})
+
+	if err := g.Wait(); err != nil {
+		return nil, fmt.Errorf("generate object sections: %w", err)
+	}
+
+	if pluginErr != nil {
+		return nil, fmt.Errorf("plugin manager: %w", pluginErr)
 	}
 
+	explainSummary(o.object, o.config, options)
+	explainSummary(o.object, o.summary, options)
+
+	summarySection := o.flexLayout.AddSection()
+
 	if err := o.summaryComponent("Configuration", o.config, summarySection, pr.Config...); err != nil {
 		return nil, fmt.Errorf("generate configuration component: %w", err)
 	}
 
-	if err := o.summaryComponent("Status", o.summary, summarySection, pr.Status...); err != nil {
+	status := append(pr.Status, alertSummarySections(ctx, o.object, options)...)
+	if err := o.summaryComponent("Status", o.summary, summarySection, status...); err != nil {
 		return nil, fmt.Errorf("generate summary component: %w", err)
 	}
 
-	for _, items := range o.itemsLists {
+	for i, items := range o.itemsLists {
 		section := o.flexLayout.AddSection()
 
-		for _, item := range items {
-			var c component.Component
-
-			if item.Component != nil {
-				c = item.Component
-			} else {
-				vc, err := item.Func()
-				if err != nil {
-					return nil, fmt.Errorf("failed to create item view: %w", err)
-				}
-
-				if vc == nil {
-					// don't print nil objects
-					continue
-				}
-
-				c = vc
+		for j, item := range items {
+			c := itemViews[i][j]
+			if c == nil {
+				// don't print nil objects
+				continue
 			}
 
 			if err := section.Add(c, item.Width); err != nil {
@@ -252,22 +311,8 @@ func (o *Object) ToComponent(ctx context.Context, options Options) (component.Co
 		}
 	}
 
-	if o.isPodTemplateEnabled {
-		if err := o.PodTemplateGen(ctx, o.object, o.podTemplateOptions.template, o.flexLayout, options); err != nil {
-			return nil, fmt.Errorf("generate pod template: %w", err)
-		}
-	}
-
-	if o.isJobTemplateEnabled {
-		if err := o.JobTemplateGen(ctx, o.object, o.jobTemplateOptions.template, o.flexLayout, options); err != nil {
-			return nil, fmt.Errorf("generate job template: %w", err)
-		}
-	}
-
-	if o.isEventsEnabled {
-		if err := o.EventsGen(ctx, o.object, o.flexLayout, options); err != nil {
-			return nil, fmt.Errorf("add events to layout: %w", err)
-		}
+	for _, fl := range generated {
+		o.flexLayout.Append(fl)
 	}
 
 	return o.flexLayout.ToComponent("Summary"), nil
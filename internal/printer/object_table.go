@@ -49,6 +49,17 @@ func (ol *ObjectTable) AddFilters(filters map[string]component.TableFilter) {
 	}
 }
 
+// Grow makes room for n more rows, so adding them doesn't reallocate the table's rows.
+func (ol *ObjectTable) Grow(n int) {
+	if n <= 0 || cap(ol.rows)-len(ol.rows) >= n {
+		return
+	}
+
+	rows := make([]component.TableRow, len(ol.rows), len(ol.rows)+n)
+	copy(rows, ol.rows)
+	ol.rows = rows
+}
+
 type componentStatus interface {
 	SetStatus(status component.TextStatus, detail component.Component)
 }
@@ -7,6 +7,7 @@ package printer
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -216,6 +217,68 @@ func Test_Object_ToComponent(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:   "item errors are shown in place",
+			object: deployment,
+			initFunc: func(o *Object, options *initOptions) {
+				stubPlugins(options.PluginPrinter)
+				o.RegisterItems([]ItemDescriptor{
+					{
+						Func: func() (component.Component, error) {
+							return nil, fmt.Errorf("failed")
+						},
+						Width: component.WidthHalf,
+					},
+					{
+						Func: func() (component.Component, error) {
+							return component.NewText("item1"), nil
+						},
+						Width: component.WidthHalf,
+					},
+				}...)
+			},
+			sections: []component.FlexLayoutSection{
+				defaultConfigSection,
+				{
+					{
+						Width: component.WidthHalf,
+						View:  component.NewError(component.TitleFromString("Error"), fmt.Errorf("create item view: %w", fmt.Errorf("failed"))),
+					},
+					{
+						Width: component.WidthHalf,
+						View:  component.NewText("item1"),
+					},
+				},
+			},
+		},
+		{
+			name:   "template errors are shown in place",
+			object: deployment,
+			initFunc: func(o *Object, options *initOptions) {
+				o.EnablePodTemplate(deployment.Spec.Template)
+				o.EnableEvents()
+				o.PodTemplateGen = func(_ context.Context, _ runtime.Object, _ corev1.PodTemplateSpec, fl *flexlayout.FlexLayout, _ Options) error {
+					require.NoError(t, fl.AddSection().Add(component.NewText("partial"), 12))
+					return fmt.Errorf("failed")
+				}
+				stubPlugins(options.PluginPrinter)
+			},
+			sections: []component.FlexLayoutSection{
+				defaultConfigSection,
+				{
+					{
+						Width: component.WidthFull,
+						View:  component.NewError(component.TitleFromString("Error"), fmt.Errorf("generate pod template: %w", fmt.Errorf("failed"))),
+					},
+				},
+				{
+					{
+						Width: component.WidthHalf,
+						View:  component.NewText("events"),
+					},
+				},
+			},
+		},
 		{
 			name:   "nil object",
 			object: nil,
@@ -77,12 +77,17 @@ func PersistentVolumeHandler(ctx context.Context, pv *corev1.PersistentVolume, o
 		return nil, errors.Wrap(err, "print persistent volume claims")
 	}
 
+	if err := pvh.Attachments(ctx, options); err != nil {
+		return nil, errors.Wrap(err, "print persistent volume attachments")
+	}
+
 	return obj.ToComponent(ctx, options)
 }
 
 type persistentVolumeHandler struct {
 	configFunc       func(*corev1.PersistentVolume, Options) (*component.Summary, error)
 	statusFunc       func(context.Context, *corev1.PersistentVolume, Options) (*component.Summary, error)
+	attachmentsFunc  func(context.Context, *corev1.PersistentVolume, Options) (*component.Table, error)
 	persistentVolume *corev1.PersistentVolume
 	object           *Object
 }
@@ -98,6 +103,7 @@ func newPersistentVolumeHandler(pv *corev1.PersistentVolume, object *Object) (*p
 	pvh := &persistentVolumeHandler{
 		configFunc:       defaultPersistentVolumeConfig,
 		statusFunc:       defaultPersistentVolumeStatus,
+		attachmentsFunc:  defaultPersistentVolumeAttachments,
 		persistentVolume: pv,
 		object:           object,
 	}
@@ -123,6 +129,16 @@ func (pvh *persistentVolumeHandler) Status(ctx context.Context, options Options)
 	return nil
 }
 
+func (pvh *persistentVolumeHandler) Attachments(ctx context.Context, options Options) error {
+	pvh.object.RegisterItems(ItemDescriptor{
+		Width: component.WidthFull,
+		Func: func() (component.Component, error) {
+			return pvh.attachmentsFunc(ctx, pvh.persistentVolume, options)
+		},
+	})
+	return nil
+}
+
 func defaultPersistentVolumeConfig(pv *corev1.PersistentVolume, options Options) (*component.Summary, error) {
 	return NewPersistentVolumeConfiguration(pv).Create(options)
 }
@@ -208,6 +224,42 @@ func (pvs *PersistentVolumeStatus) Create(ctx context.Context, options Options)
 	return summary, nil
 }
 
+// defaultPersistentVolumeAttachments lists the nodes a persistent volume is
+// attached to by its CSI driver.
+func defaultPersistentVolumeAttachments(ctx context.Context, pv *corev1.PersistentVolume, options Options) (*component.Table, error) {
+	if pv == nil {
+		return nil, errors.New("Persistent Volume is nil")
+	}
+
+	attachments, err := persistentVolumeAttachments(ctx, pv.Name, options)
+	if err != nil {
+		return nil, err
+	}
+
+	cols := component.NewTableCols("Node", "Attacher", "Status")
+	table := component.NewTable("Attachments", "This persistent volume isn't attached to any nodes!", cols)
+
+	for _, attachment := range attachments {
+		nodeLink, err := options.Link.ForGVK("", "v1", "Node", attachment.Spec.NodeName, attachment.Spec.NodeName)
+		if err != nil {
+			return nil, err
+		}
+
+		attacherLink, err := options.Link.ForGVK("", "storage.k8s.io/v1", "CSIDriver", attachment.Spec.Attacher, attachment.Spec.Attacher)
+		if err != nil {
+			return nil, err
+		}
+
+		table.Add(component.TableRow{
+			"Node":     nodeLink,
+			"Attacher": attacherLink,
+			"Status":   volumeAttachmentStatus(attachment),
+		})
+	}
+
+	return table, nil
+}
+
 func createBoundPersistentVolumeClaimLink(ctx context.Context, pv *corev1.PersistentVolume, options Options) (*component.Link, error) {
 	cr := pv.Spec.ClaimRef
 	if cr == nil {
@@ -643,3 +643,40 @@ func Test_printPersistentVolumeSource(t *testing.T) {
 		})
 	}
 }
+
+func Test_defaultPersistentVolumeAttachments(t *testing.T) {
+	pv := testutil.CreatePersistentVolume("pv")
+
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+
+	attachment := createVolumeAttachment("csi-1", "pv", "node", true)
+	other := createVolumeAttachment("csi-2", "other", "node", true)
+
+	tpo.objectStore.EXPECT().
+		List(gomock.Any(), store.Key{APIVersion: "storage.k8s.io/v1", Kind: "VolumeAttachment"}).
+		Return(testutil.ToUnstructuredList(t, attachment, other), false, nil)
+
+	tpo.PathForGVK("", "v1", "Node", "node", "node", "/node")
+	tpo.PathForGVK("", "storage.k8s.io/v1", "CSIDriver", "ebs.csi.aws.com", "ebs.csi.aws.com", "/csi-driver")
+
+	printOptions := tpo.ToOptions()
+
+	got, err := defaultPersistentVolumeAttachments(context.Background(), pv, printOptions)
+	require.NoError(t, err)
+
+	status := component.NewText("Attached")
+	status.SetStatus(component.TextStatusOK)
+
+	cols := component.NewTableCols("Node", "Attacher", "Status")
+	expected := component.NewTable("Attachments", "This persistent volume isn't attached to any nodes!", cols)
+	expected.Add(component.TableRow{
+		"Node":     component.NewLink("", "node", "/node"),
+		"Attacher": component.NewLink("", "ebs.csi.aws.com", "/csi-driver"),
+		"Status":   status,
+	})
+
+	component.AssertEqual(t, expected, got)
+}
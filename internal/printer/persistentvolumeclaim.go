@@ -12,8 +12,10 @@ import (
 
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 
+	"github.com/vmware-tanzu/octant/internal/octant"
 	"github.com/vmware-tanzu/octant/internal/util/kubernetes"
 	"github.com/vmware-tanzu/octant/pkg/store"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
@@ -191,11 +193,86 @@ func createPersistentVolumeClaimStatusView(ctx context.Context, persistentVolume
 		}
 	}
 
+	if resizeStatus := persistentVolumeClaimResizeStatus(persistentVolumeClaim); resizeStatus != "" {
+		sections.AddText("Resize Status", resizeStatus)
+	}
+
 	summary := component.NewSummary("Status", sections...)
 
+	if isPersistentVolumeClaimExpandable(ctx, persistentVolumeClaim, options) {
+		action, err := resizePersistentVolumeClaimAction(persistentVolumeClaim)
+		if err != nil {
+			return nil, err
+		}
+		summary.AddAction(action)
+	}
+
 	return summary, nil
 }
 
+// isPersistentVolumeClaimExpandable returns true if the storage class of a
+// persistent volume claim allows volume expansion. A claim isn't expandable if
+// its storage class doesn't exist or can't be looked up.
+func isPersistentVolumeClaimExpandable(ctx context.Context, persistentVolumeClaim *corev1.PersistentVolumeClaim, options Options) bool {
+	className := printPersistentVolumeClaimClass(persistentVolumeClaim)
+	if className == "" {
+		return false
+	}
+
+	key := store.Key{
+		APIVersion: "storage.k8s.io/v1",
+		Kind:       "StorageClass",
+		Name:       className,
+	}
+
+	storageClass := &storagev1.StorageClass{}
+	found, err := store.GetAs(ctx, options.DashConfig.ObjectStore(), key, storageClass)
+	if err != nil || !found {
+		return false
+	}
+
+	return storageClass.AllowVolumeExpansion != nil && *storageClass.AllowVolumeExpansion
+}
+
+func resizePersistentVolumeClaimAction(persistentVolumeClaim *corev1.PersistentVolumeClaim) (component.Action, error) {
+	storage := persistentVolumeClaim.Spec.Resources.Requests[corev1.ResourceStorage]
+
+	form, err := component.CreateFormForObject(octant.ActionResizePersistentVolumeClaim, persistentVolumeClaim,
+		component.NewFormFieldText("Size", "storage", storage.String()),
+	)
+	if err != nil {
+		return component.Action{}, err
+	}
+
+	return component.Action{
+		Name:  "Resize",
+		Title: "Resize Persistent Volume Claim",
+		Form:  form,
+	}, nil
+}
+
+// persistentVolumeClaimResizeStatus describes the progress of a volume expansion
+// from the resize conditions of a persistent volume claim.
+func persistentVolumeClaimResizeStatus(persistentVolumeClaim *corev1.PersistentVolumeClaim) string {
+	var statuses []string
+	for _, condition := range persistentVolumeClaim.Status.Conditions {
+		if condition.Status != corev1.ConditionTrue {
+			continue
+		}
+
+		switch condition.Type {
+		case corev1.PersistentVolumeClaimResizing, corev1.PersistentVolumeClaimFileSystemResizePending:
+			status := string(condition.Type)
+			if condition.Message != "" {
+				status = fmt.Sprintf("%s: %s", status, condition.Message)
+			}
+			statuses = append(statuses, status)
+		}
+	}
+
+	return strings.Join(statuses, ", ")
+}
+
 func printPersistentVolumeClaimClass(persistentVolumeClaim *corev1.PersistentVolumeClaim) string {
 	if class, found := persistentVolumeClaim.Annotations[corev1.BetaStorageClassAnnotation]; found {
 		return class
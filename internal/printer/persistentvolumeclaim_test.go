@@ -13,13 +13,17 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/vmware-tanzu/octant/internal/octant"
 	"github.com/vmware-tanzu/octant/internal/testutil"
 	"github.com/vmware-tanzu/octant/pkg/store"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 
 	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
 func Test_PersistentVolumeClaimListHandler(t *testing.T) {
@@ -226,6 +230,28 @@ func Test_createPersistentVolumeClaimStatusView(t *testing.T) {
 	tpo.objectStore.EXPECT().Get(ctx, pvKey).
 		Return(testutil.ToUnstructured(t, pv), nil)
 
+	allowVolumeExpansion := true
+	storageClass := &storagev1.StorageClass{
+		TypeMeta:             metav1.TypeMeta{APIVersion: "storage.k8s.io/v1", Kind: "StorageClass"},
+		ObjectMeta:           metav1.ObjectMeta{Name: "manual"},
+		AllowVolumeExpansion: &allowVolumeExpansion,
+	}
+	storageClassKey := store.Key{
+		APIVersion: "storage.k8s.io/v1",
+		Kind:       "StorageClass",
+		Name:       "manual",
+	}
+	tpo.objectStore.EXPECT().Get(ctx, storageClassKey).
+		Return(testutil.ToUnstructured(t, storageClass), nil)
+
+	object.Status.Conditions = []corev1.PersistentVolumeClaimCondition{
+		{
+			Type:    corev1.PersistentVolumeClaimFileSystemResizePending,
+			Status:  corev1.ConditionTrue,
+			Message: "Waiting for user to (re-)start a pod to finish file system resize of volume on node.",
+		},
+	}
+
 	tpo.PathForObject(pv, pv.GetName(), fmt.Sprintf("/%s", pv.GetName()))
 
 	got, err := createPersistentVolumeClaimStatusView(ctx, object, printOptions)
@@ -239,11 +265,75 @@ func Test_createPersistentVolumeClaimStatusView(t *testing.T) {
 		Content: component.NewLink("", pv.GetName(), fmt.Sprintf("/%s", pv.GetName())),
 	})
 	sections.AddText("Total Volume Capacity", "10Gi")
+	sections.AddText("Resize Status", "FileSystemResizePending: Waiting for user to (re-)start a pod to finish file system resize of volume on node.")
 	expected := component.NewSummary("Status", sections...)
 
+	form, err := component.CreateFormForObject(octant.ActionResizePersistentVolumeClaim, object,
+		component.NewFormFieldText("Size", "storage", "3Gi"),
+	)
+	require.NoError(t, err)
+	expected.AddAction(component.Action{
+		Name:  "Resize",
+		Title: "Resize Persistent Volume Claim",
+		Form:  form,
+	})
+
 	component.AssertEqual(t, expected, got)
 }
 
+func Test_isPersistentVolumeClaimExpandable(t *testing.T) {
+	allowVolumeExpansion := true
+	storageClass := &storagev1.StorageClass{
+		TypeMeta:             metav1.TypeMeta{APIVersion: "storage.k8s.io/v1", Kind: "StorageClass"},
+		ObjectMeta:           metav1.ObjectMeta{Name: "manual"},
+		AllowVolumeExpansion: &allowVolumeExpansion,
+	}
+	storageClassKey := store.Key{
+		APIVersion: "storage.k8s.io/v1",
+		Kind:       "StorageClass",
+		Name:       "manual",
+	}
+
+	cases := []struct {
+		name         string
+		storageClass *unstructured.Unstructured
+		err          error
+		expected     bool
+	}{
+		{
+			name:         "expansion allowed",
+			storageClass: testutil.ToUnstructured(t, storageClass),
+			expected:     true,
+		},
+		{
+			name: "storage class not found",
+			err:  kerrors.NewNotFound(schema.GroupResource{Group: "storage.k8s.io", Resource: "storageclasses"}, "manual"),
+		},
+		{
+			name:         "storage class unknown",
+			storageClass: &unstructured.Unstructured{},
+		},
+		{
+			name: "storage class lookup failed",
+			err:  fmt.Errorf("access denied"),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			tpo := newTestPrinterOptions(controller)
+			tpo.objectStore.EXPECT().Get(gomock.Any(), storageClassKey).Return(tc.storageClass, tc.err)
+
+			pvc := testutil.CreatePersistentVolumeClaim("pvc")
+			got := isPersistentVolumeClaimExpandable(context.Background(), pvc, tpo.ToOptions())
+			require.Equal(t, tc.expected, got)
+		})
+	}
+}
+
 func Test_PersistentVolumeClaimMountedPodsList(t *testing.T) {
 	controller := gomock.NewController(t)
 	defer controller.Finish()
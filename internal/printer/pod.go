@@ -8,6 +8,7 @@ package printer
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/pkg/errors"
 	appsv1 "k8s.io/api/apps/v1"
@@ -45,56 +46,67 @@ func PodListHandler(ctx context.Context, list *corev1.PodList, opts Options) (co
 	ot := NewObjectTable("Pods", "We couldn't find any pods!", cols, opts.DashConfig.ObjectStore())
 	ot.AddFilters(podTableFilters())
 
+	ot.Grow(len(list.Items))
+
 	for i := range list.Items {
-		row := component.TableRow{}
-		pod := list.Items[i]
-		nameLink, err := opts.Link.ForObject(&pod, pod.Name)
+		pod := &list.Items[i]
+		row, err := podTableRow(pod, len(cols), opts)
 		if err != nil {
 			return nil, err
 		}
 
-		row["Name"] = nameLink
-
-		if !opts.DisableLabels {
-			row["Labels"] = component.NewLabels(pod.Labels)
+		if err := ot.AddRowForObject(ctx, pod, row); err != nil {
+			return nil, fmt.Errorf("add row for object: %w", err)
 		}
+	}
 
-		readyCounter := 0
-		for _, c := range pod.Status.ContainerStatuses {
-			if c.Ready {
-				readyCounter++
-			}
-		}
-		ready := fmt.Sprintf("%d/%d", readyCounter, len(pod.Spec.Containers))
-		row["Ready"] = component.NewText(ready)
+	ot.SetSortOrder("Name", false)
 
-		row["Phase"] = component.NewText(string(pod.Status.Phase))
+	return ot.ToComponent()
+}
 
-		restartCounter := 0
-		for _, c := range pod.Status.ContainerStatuses {
-			restartCounter += int(c.RestartCount)
-		}
-		restarts := fmt.Sprintf("%d", restartCounter)
-		row["Restarts"] = component.NewText(restarts)
+// podTableRow creates the row describing a pod in a pod table.
+func podTableRow(pod *corev1.Pod, size int, opts Options) (component.TableRow, error) {
+	row := make(component.TableRow, size)
+	nameLink, err := opts.Link.ForObject(pod, pod.Name)
+	if err != nil {
+		return nil, err
+	}
 
-		nodeComponent, err := podNode(&pod, opts.Link)
-		if err != nil {
-			return nil, err
+	row["Name"] = nameLink
+
+	if !opts.DisableLabels {
+		row["Labels"] = component.NewLabels(pod.Labels)
+	}
+
+	readyCounter := 0
+	for _, c := range pod.Status.ContainerStatuses {
+		if c.Ready {
+			readyCounter++
 		}
+	}
+	ready := fmt.Sprintf("%d/%d", readyCounter, len(pod.Spec.Containers))
+	row["Ready"] = component.NewText(ready)
 
-		row["Node"] = nodeComponent
+	row["Phase"] = component.NewText(string(pod.Status.Phase))
 
-		ts := pod.CreationTimestamp.Time
-		row["Age"] = component.NewTimestamp(ts)
+	restartCounter := 0
+	for _, c := range pod.Status.ContainerStatuses {
+		restartCounter += int(c.RestartCount)
+	}
+	row["Restarts"] = component.NewText(strconv.Itoa(restartCounter))
 
-		if err := ot.AddRowForObject(ctx, &pod, row); err != nil {
-			return nil, fmt.Errorf("add row for object: %w", err)
-		}
+	nodeComponent, err := podNode(pod, opts.Link)
+	if err != nil {
+		return nil, err
 	}
 
-	ot.SetSortOrder("Name", false)
+	row["Node"] = nodeComponent
 
-	return ot.ToComponent()
+	ts := pod.CreationTimestamp.Time
+	row["Age"] = component.NewTimestamp(ts)
+
+	return row, nil
 }
 
 func podNode(pod *corev1.Pod, linkGenerator link.Interface) (component.Component, error) {
@@ -136,6 +148,9 @@ func PodHandler(ctx context.Context, pod *corev1.Pod, options Options) (componen
 	if err := ph.Additional(options); err != nil {
 		return nil, errors.Wrap(err, "print pod additional items")
 	}
+	if err := ph.VolumeAttachments(ctx, options); err != nil {
+		return nil, errors.Wrap(err, "print pod volume attachments")
+	}
 
 	return o.ToComponent(ctx, options)
 }
@@ -235,7 +250,15 @@ func (p *PodConfiguration) Create(options Options) (*component.Summary, error) {
 		sections.AddText("Priority", fmt.Sprintf("%d", *pod.Spec.Priority))
 	}
 	if pod.Spec.PriorityClassName != "" {
-		sections.AddText("PriorityClassName", pod.Spec.PriorityClassName)
+		priorityClassLink, err := options.Link.ForGVK("", "scheduling.k8s.io/v1", "PriorityClass",
+			pod.Spec.PriorityClassName, pod.Spec.PriorityClassName)
+		if err != nil {
+			return nil, err
+		}
+		sections.Add("PriorityClassName", priorityClassLink)
+	}
+	if pod.Spec.PreemptionPolicy != nil {
+		sections.AddText("Preemption Policy", string(*pod.Spec.PreemptionPolicy))
 	}
 
 	contentLink, err := options.Link.ForGVK(pod.Namespace, "v1", "ServiceAccount", pod.Spec.ServiceAccountName, pod.Spec.ServiceAccountName)
@@ -369,10 +392,16 @@ func createPodListView(ctx context.Context, object runtime.Object, options Optio
 		return nil, errors.Wrap(err, "get name for object")
 	}
 
+	uid, err := accessor.UID(object)
+	if err != nil {
+		return nil, errors.Wrap(err, "get uid for object")
+	}
+
 	key := store.Key{
 		Namespace:  namespace,
 		APIVersion: "v1",
 		Kind:       "Pod",
+		OwnerUID:   uid,
 	}
 
 	list, _, err := objectStore.List(ctx, key)
@@ -429,10 +458,16 @@ func createRollingPodListView(ctx context.Context, objects []runtime.Object, opt
 			return nil, errors.Wrap(err, "get name for object")
 		}
 
+		uid, err := accessor.UID(object)
+		if err != nil {
+			return nil, errors.Wrap(err, "get uid for object")
+		}
+
 		key := store.Key{
 			Namespace:  namespace,
 			APIVersion: "v1",
 			Kind:       "Pod",
+			OwnerUID:   uid,
 		}
 
 		list, _, err := objectStore.List(ctx, key)
@@ -576,6 +611,7 @@ type podObject interface {
 	InitContainers(ctx context.Context, options Options) error
 	Containers(ctx context.Context, options Options) error
 	Additional(options Options) error
+	VolumeAttachments(ctx context.Context, options Options) error
 }
 
 type podHandler struct {
@@ -584,6 +620,7 @@ type podHandler struct {
 	summaryFunc     func(*corev1.Pod, Options) (*component.Summary, error)
 	conditionsFunc  func(*corev1.Pod, Options) (*component.Table, error)
 	containerFunc   func(ctx context.Context, pod *corev1.Pod, container *corev1.Container, isInit bool, options Options) (*component.Summary, error)
+	attachmentsFunc func(context.Context, *corev1.Pod, Options) (*component.Table, error)
 	additionalFuncs []func(*corev1.Pod, Options) ObjectPrinterFunc
 	object          *Object
 }
@@ -628,6 +665,7 @@ func newPodHandler(pod *corev1.Pod, object *Object) (*podHandler, error) {
 		summaryFunc:     defaultPodSummary,
 		conditionsFunc:  defaultPodConditions,
 		containerFunc:   defaultPodContainers,
+		attachmentsFunc: defaultPodVolumeAttachments,
 		additionalFuncs: defaultPodHandlerAdditionalItems,
 		object:          object,
 	}
@@ -719,7 +757,12 @@ func (p *podHandler) Containers(ctx context.Context, options Options) error {
 
 func defaultPodContainers(ctx context.Context, pod *corev1.Pod, container *corev1.Container, isInit bool, options Options) (*component.Summary, error) {
 	portForwarder := options.DashConfig.PortForwarder()
-	creator := NewContainerConfiguration(ctx, pod, container, portForwarder, IsInit(isInit), WithPrintOptions(options))
+	opts := []ContainerConfigurationOption{IsInit(isInit), WithPrintOptions(options)}
+	if !isInit {
+		// Init containers have exited, so there is nothing to exec in to copy files.
+		opts = append(opts, WithActions(downloadContainerFilesAction, uploadContainerFilesAction))
+	}
+	creator := NewContainerConfiguration(ctx, pod, container, portForwarder, opts...)
 	return creator.Create()
 }
 
@@ -738,6 +781,101 @@ func (p *podHandler) Additional(options Options) error {
 	return nil
 }
 
+// VolumeAttachments registers the attachment status of the pod's persistent
+// volumes. Pods without persistent volume claims don't show it.
+func (p *podHandler) VolumeAttachments(ctx context.Context, options Options) error {
+	hasClaims := false
+	for _, volume := range p.pod.Spec.Volumes {
+		if volume.PersistentVolumeClaim != nil {
+			hasClaims = true
+			break
+		}
+	}
+
+	if !hasClaims || p.pod.Spec.NodeName == "" {
+		return nil
+	}
+
+	p.object.RegisterItems(ItemDescriptor{
+		Width: component.WidthHalf,
+		Func: func() (component.Component, error) {
+			return p.attachmentsFunc(ctx, p.pod, options)
+		},
+	})
+
+	return nil
+}
+
+// defaultPodVolumeAttachments shows whether the persistent volumes claimed by a
+// pod are attached to the pod's node.
+func defaultPodVolumeAttachments(ctx context.Context, pod *corev1.Pod, options Options) (*component.Table, error) {
+	if pod == nil {
+		return nil, errors.New("pod is nil")
+	}
+
+	objectStore := options.DashConfig.ObjectStore()
+
+	cols := component.NewTableCols("Volume", "Persistent Volume", "Status")
+	table := component.NewTable("Volume Attachments", "There are no persistent volumes!", cols)
+
+	for _, volume := range pod.Spec.Volumes {
+		if volume.PersistentVolumeClaim == nil {
+			continue
+		}
+
+		key := store.Key{
+			Namespace:  pod.Namespace,
+			APIVersion: "v1",
+			Kind:       "PersistentVolumeClaim",
+			Name:       volume.PersistentVolumeClaim.ClaimName,
+		}
+
+		pvc := &corev1.PersistentVolumeClaim{}
+		found, err := store.GetAs(ctx, objectStore, key, pvc)
+		if err != nil {
+			return nil, errors.Wrapf(err, "get persistent volume claim %s", key.Name)
+		}
+
+		if !found || pvc.Spec.VolumeName == "" {
+			status := component.NewText("Claim is not bound")
+			status.SetStatus(component.TextStatusWarning)
+
+			table.Add(component.TableRow{
+				"Volume":            component.NewText(volume.Name),
+				"Persistent Volume": component.NewText(""),
+				"Status":            status,
+			})
+			continue
+		}
+
+		pvLink, err := options.Link.ForGVK("", "v1", "PersistentVolume", pvc.Spec.VolumeName, pvc.Spec.VolumeName)
+		if err != nil {
+			return nil, err
+		}
+
+		attachments, err := persistentVolumeAttachments(ctx, pvc.Spec.VolumeName, options)
+		if err != nil {
+			return nil, err
+		}
+
+		status := component.NewText("No attachment")
+		for _, attachment := range attachments {
+			if attachment.Spec.NodeName == pod.Spec.NodeName {
+				status = volumeAttachmentStatus(attachment)
+				break
+			}
+		}
+
+		table.Add(component.TableRow{
+			"Volume":            component.NewText(volume.Name),
+			"Persistent Volume": pvLink,
+			"Status":            status,
+		})
+	}
+
+	return table, nil
+}
+
 func addPodTableFilters(table *component.Table) {
 	for k, v := range podTableFilters() {
 		table.AddFilter(k, v)
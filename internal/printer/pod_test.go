@@ -7,6 +7,8 @@ package printer
 
 import (
 	"context"
+	"fmt"
+	"path"
 	"testing"
 	"time"
 
@@ -18,7 +20,9 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/vmware-tanzu/octant/internal/conversion"
+	"github.com/vmware-tanzu/octant/internal/link"
 	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/store"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
 
@@ -226,6 +230,7 @@ func TestPodListHandler_sorted(t *testing.T) {
 
 func Test_PodConfiguration(t *testing.T) {
 	now := testutil.Time()
+	preemptNever := corev1.PreemptNever
 	validPod := &corev1.Pod{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      "pod",
@@ -250,6 +255,7 @@ func Test_PodConfiguration(t *testing.T) {
 			NodeName:           "node",
 			Priority:           conversion.PtrInt32(1000000),
 			PriorityClassName:  "high-priority",
+			PreemptionPolicy:   &preemptNever,
 			ServiceAccountName: "default",
 		},
 		Status: corev1.PodStatus{
@@ -280,7 +286,11 @@ func Test_PodConfiguration(t *testing.T) {
 				},
 				{
 					Header:  "PriorityClassName",
-					Content: component.NewText("high-priority"),
+					Content: component.NewLink("", "high-priority", "/priority-class"),
+				},
+				{
+					Header:  "Preemption Policy",
+					Content: component.NewText("Never"),
 				},
 				{
 					Header:  "Node",
@@ -308,6 +318,7 @@ func Test_PodConfiguration(t *testing.T) {
 			tpo.link.EXPECT().
 				ForGVK("", "v1", "Node", "node", "node").
 				Return(nodeLink, nil).AnyTimes()
+			tpo.PathForGVK("", "scheduling.k8s.io/v1", "PriorityClass", "high-priority", "high-priority", "/priority-class")
 
 			printOptions := tpo.ToOptions()
 
@@ -431,3 +442,117 @@ func Test_printPodResources(t *testing.T) {
 
 	assert.Equal(t, expected, got)
 }
+
+type benchmarkLinkConfig struct{}
+
+func (benchmarkLinkConfig) ObjectPath(namespace, apiVersion, kind, name string) (string, error) {
+	return path.Join("/overview/namespace", namespace, "workloads/pods", name), nil
+}
+
+func BenchmarkPodListHandler(b *testing.B) {
+	controller := gomock.NewController(b)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+	linkGenerator, err := link.NewFromDashConfig(benchmarkLinkConfig{})
+	require.NoError(b, err)
+
+	printOptions := tpo.ToOptions()
+	printOptions.Link = linkGenerator
+
+	list := &corev1.PodList{}
+	for i := 0; i < 500; i++ {
+		pod := testutil.CreatePod(fmt.Sprintf("pod-%d", i))
+		pod.Labels = map[string]string{"app": "benchmark"}
+		pod.Spec.NodeName = "node"
+		pod.Spec.Containers = []corev1.Container{{Name: "nginx", Image: "nginx:1.15"}}
+		pod.Status = corev1.PodStatus{
+			Phase:             corev1.PodRunning,
+			ContainerStatuses: []corev1.ContainerStatus{{Name: "nginx", Ready: true}},
+		}
+		list.Items = append(list.Items, *pod)
+	}
+
+	ctx := context.Background()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		if _, err := PodListHandler(ctx, list, printOptions); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func Test_defaultPodVolumeAttachments(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+
+	pod := testutil.CreatePod("pod")
+	pod.Spec.NodeName = "node"
+	pod.Spec.Volumes = []corev1.Volume{
+		{
+			Name: "data",
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "data"},
+			},
+		},
+		{
+			Name: "pending",
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "pending"},
+			},
+		},
+		{
+			Name:         "scratch",
+			VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+		},
+	}
+
+	pvc := testutil.CreatePersistentVolumeClaim("data")
+	pvc.Spec.VolumeName = "pv"
+
+	elsewhere := createVolumeAttachment("csi-1", "pv", "other-node", true)
+	attaching := createVolumeAttachment("csi-2", "pv", "node", false)
+
+	tpo.objectStore.EXPECT().
+		Get(gomock.Any(), store.Key{Namespace: "namespace", APIVersion: "v1", Kind: "PersistentVolumeClaim", Name: "data"}).
+		Return(testutil.ToUnstructured(t, pvc), nil)
+	tpo.objectStore.EXPECT().
+		Get(gomock.Any(), store.Key{Namespace: "namespace", APIVersion: "v1", Kind: "PersistentVolumeClaim", Name: "pending"}).
+		Return(nil, nil)
+	tpo.objectStore.EXPECT().
+		List(gomock.Any(), store.Key{APIVersion: "storage.k8s.io/v1", Kind: "VolumeAttachment"}).
+		Return(testutil.ToUnstructuredList(t, elsewhere, attaching), false, nil)
+
+	tpo.PathForGVK("", "v1", "PersistentVolume", "pv", "pv", "/pv")
+
+	printOptions := tpo.ToOptions()
+
+	got, err := defaultPodVolumeAttachments(context.Background(), pod, printOptions)
+	require.NoError(t, err)
+
+	attachingStatus := component.NewText("Attaching")
+	attachingStatus.SetStatus(component.TextStatusWarning)
+
+	unboundStatus := component.NewText("Claim is not bound")
+	unboundStatus.SetStatus(component.TextStatusWarning)
+
+	cols := component.NewTableCols("Volume", "Persistent Volume", "Status")
+	expected := component.NewTable("Volume Attachments", "There are no persistent volumes!", cols)
+	expected.Add(component.TableRow{
+		"Volume":            component.NewText("data"),
+		"Persistent Volume": component.NewLink("", "pv", "/pv"),
+		"Status":            attachingStatus,
+	})
+	expected.Add(component.TableRow{
+		"Volume":            component.NewText("pending"),
+		"Persistent Volume": component.NewText(""),
+		"Status":            unboundStatus,
+	})
+
+	component.AssertEqual(t, expected, got)
+}
@@ -16,6 +16,7 @@ import (
 
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
@@ -30,6 +31,8 @@ type Options struct {
 	DashConfig    config.Dash
 	Link          link.Interface
 	ObjectFactory ObjectFactory
+	// LabelSet limits objects listed by the printer to those matching it.
+	LabelSet *labels.Set
 }
 
 // Printer is an interface for printing runtime objects.
@@ -57,6 +60,10 @@ func NewResource(dashConfig config.Dash) *Resource {
 // Print prints a runtime object. If not handler can be found for the type,
 // it will print using `DefaultPrintFunc`.
 func (p *Resource) Print(ctx context.Context, object runtime.Object) (component.Component, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
 	l, err := link.NewFromDashConfig(p.dashConfig)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,230 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+
+	"github.com/vmware-tanzu/octant/internal/util/kubernetes"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+// PriorityClassListHandler is a printFunc that prints priority classes. Priority
+// classes are ordered by value, highest first, which is the order the scheduler
+// preempts and the kubelet evicts in.
+func PriorityClassListHandler(ctx context.Context, list *schedulingv1.PriorityClassList, options Options) (component.Component, error) {
+	if list == nil {
+		return nil, errors.New("priority class list is nil")
+	}
+
+	cols := component.NewTableCols("Name", "Value", "Global Default", "Preemption Policy", "Age")
+	ot := NewObjectTable("Priority Classes", "We couldn't find any priority classes!", cols, options.DashConfig.ObjectStore())
+
+	items := make([]schedulingv1.PriorityClass, len(list.Items))
+	copy(items, list.Items)
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].Value > items[j].Value
+	})
+
+	for _, priorityClass := range items {
+		row := component.TableRow{}
+		nameLink, err := options.Link.ForObject(&priorityClass, priorityClass.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		row["Name"] = nameLink
+		row["Value"] = component.NewTextf("%d", priorityClass.Value)
+		row["Global Default"] = component.NewTextf("%t", priorityClass.GlobalDefault)
+		row["Preemption Policy"] = component.NewText(priorityClassPreemptionPolicy(&priorityClass))
+		ts := priorityClass.CreationTimestamp.Time
+		row["Age"] = component.NewTimestamp(ts)
+
+		if err := ot.AddRowForObject(ctx, &priorityClass, row); err != nil {
+			return nil, fmt.Errorf("add row for object: %w", err)
+		}
+	}
+
+	return ot.ToComponent()
+}
+
+// PriorityClassHandler is a printFunc that prints a priority class
+func PriorityClassHandler(ctx context.Context, priorityClass *schedulingv1.PriorityClass, options Options) (component.Component, error) {
+	o := NewObject(priorityClass)
+
+	ch, err := newPriorityClassHandler(priorityClass, o)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ch.Config(options); err != nil {
+		return nil, errors.Wrap(err, "print priorityclass configuration")
+	}
+
+	if err := ch.Pods(ctx, options); err != nil {
+		return nil, errors.Wrap(err, "print priorityclass pods")
+	}
+
+	return o.ToComponent(ctx, options)
+}
+
+// PriorityClassConfiguration generates a priorityclass configuration
+type PriorityClassConfiguration struct {
+	priorityClass *schedulingv1.PriorityClass
+}
+
+// NewPriorityClassConfiguration creates an instance of PriorityClassConfiguration
+func NewPriorityClassConfiguration(priorityClass *schedulingv1.PriorityClass) *PriorityClassConfiguration {
+	return &PriorityClassConfiguration{
+		priorityClass: priorityClass,
+	}
+}
+
+// Create creates a priorityclass configuration summary
+func (c *PriorityClassConfiguration) Create(options Options) (*component.Summary, error) {
+	if c == nil || c.priorityClass == nil {
+		return nil, errors.New("priorityclass is nil")
+	}
+
+	var sections component.SummarySections
+
+	sections.AddText("Value", fmt.Sprintf("%d", c.priorityClass.Value))
+	sections.AddText("Global Default", fmt.Sprintf("%t", c.priorityClass.GlobalDefault))
+	sections.AddText("Preemption Policy", priorityClassPreemptionPolicy(c.priorityClass))
+	if c.priorityClass.Description != "" {
+		sections.AddText("Description", c.priorityClass.Description)
+	}
+
+	summary := component.NewSummary("Configuration", sections...)
+
+	return summary, nil
+}
+
+type priorityClassObject interface {
+	Config(options Options) error
+	Pods(ctx context.Context, options Options) error
+}
+
+type priorityClassHandler struct {
+	priorityClass *schedulingv1.PriorityClass
+	configFunc    func(*schedulingv1.PriorityClass, Options) (*component.Summary, error)
+	podsFunc      func(context.Context, *schedulingv1.PriorityClass, Options) (*component.Table, error)
+	object        *Object
+}
+
+var _ priorityClassObject = (*priorityClassHandler)(nil)
+
+func newPriorityClassHandler(priorityClass *schedulingv1.PriorityClass, object *Object) (*priorityClassHandler, error) {
+	if priorityClass == nil {
+		return nil, errors.New("can't print a nil priorityclass")
+	}
+
+	if object == nil {
+		return nil, errors.New("can't print a priorityclass using an nil object printer")
+	}
+
+	ch := &priorityClassHandler{
+		priorityClass: priorityClass,
+		configFunc:    defaultPriorityClassConfig,
+		podsFunc:      defaultPriorityClassPods,
+		object:        object,
+	}
+	return ch, nil
+}
+
+func (c *priorityClassHandler) Config(options Options) error {
+	out, err := c.configFunc(c.priorityClass, options)
+	if err != nil {
+		return err
+	}
+	c.object.RegisterConfig(out)
+	return nil
+}
+
+func (c *priorityClassHandler) Pods(ctx context.Context, options Options) error {
+	c.object.RegisterItems(ItemDescriptor{
+		Width: component.WidthFull,
+		Func: func() (component.Component, error) {
+			return c.podsFunc(ctx, c.priorityClass, options)
+		},
+	})
+	return nil
+}
+
+func defaultPriorityClassConfig(priorityClass *schedulingv1.PriorityClass, options Options) (*component.Summary, error) {
+	return NewPriorityClassConfiguration(priorityClass).Create(options)
+}
+
+// defaultPriorityClassPods lists the pods in all namespaces which run with a
+// priority class. Pods with a nominated node are preempting lower priority
+// pods on that node.
+func defaultPriorityClassPods(ctx context.Context, priorityClass *schedulingv1.PriorityClass, options Options) (*component.Table, error) {
+	if priorityClass == nil {
+		return nil, errors.New("priorityclass is nil")
+	}
+
+	key := store.Key{
+		APIVersion: "v1",
+		Kind:       "Pod",
+	}
+
+	podList, _, err := options.DashConfig.ObjectStore().List(ctx, key)
+	if err != nil {
+		return nil, errors.Wrapf(err, "list all objects for key %+v", key)
+	}
+
+	cols := component.NewTableCols("Name", "Namespace", "Phase", "Node", "Nominated Node")
+	table := component.NewTable("Pods", "There are no pods using this priority class!", cols)
+
+	for i := range podList.Items {
+		pod := &corev1.Pod{}
+		if err := kubernetes.FromUnstructured(&podList.Items[i], pod); err != nil {
+			return nil, err
+		}
+
+		if pod.Spec.PriorityClassName != priorityClass.Name {
+			continue
+		}
+
+		nameLink, err := options.Link.ForObject(pod, pod.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		nodeComponent, err := podNode(pod, options.Link)
+		if err != nil {
+			return nil, err
+		}
+
+		table.Add(component.TableRow{
+			"Name":           nameLink,
+			"Namespace":      component.NewText(pod.Namespace),
+			"Phase":          component.NewText(string(pod.Status.Phase)),
+			"Node":           nodeComponent,
+			"Nominated Node": component.NewText(pod.Status.NominatedNodeName),
+		})
+	}
+
+	table.Sort("Namespace", "Name")
+
+	return table, nil
+}
+
+// priorityClassPreemptionPolicy returns the preemption policy of a priority
+// class. Pods preempt lower priority pods unless the policy says otherwise.
+func priorityClassPreemptionPolicy(priorityClass *schedulingv1.PriorityClass) string {
+	if priorityClass.PreemptionPolicy == nil {
+		return string(corev1.PreemptLowerPriority)
+	}
+	return string(*priorityClass.PreemptionPolicy)
+}
@@ -0,0 +1,171 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func createPriorityClass(name string, value int32) *schedulingv1.PriorityClass {
+	return &schedulingv1.PriorityClass{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "scheduling.k8s.io/v1",
+			Kind:       "PriorityClass",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			UID:               "uid",
+			CreationTimestamp: *testutil.CreateTimestamp(),
+		},
+		Value: value,
+	}
+}
+
+func Test_PriorityClassListHandler(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+	printOptions := tpo.ToOptions()
+
+	low := createPriorityClass("low", 100)
+	high := createPriorityClass("high", 1000)
+	high.GlobalDefault = true
+	preemptNever := corev1.PreemptNever
+	high.PreemptionPolicy = &preemptNever
+
+	list := &schedulingv1.PriorityClassList{
+		Items: []schedulingv1.PriorityClass{*low, *high},
+	}
+
+	tpo.PathForObject(low, low.Name, "/low")
+	tpo.PathForObject(high, high.Name, "/high")
+
+	now := testutil.Time()
+
+	ctx := context.Background()
+	got, err := PriorityClassListHandler(ctx, list, printOptions)
+	require.NoError(t, err)
+
+	cols := component.NewTableCols("Name", "Value", "Global Default", "Preemption Policy", "Age")
+	expected := component.NewTable("Priority Classes", "We couldn't find any priority classes!", cols)
+	expected.Add(component.TableRow{
+		"Name": component.NewLink("", high.Name, "/high",
+			genObjectStatus(component.TextStatusOK, []string{
+				"scheduling.k8s.io/v1 PriorityClass is OK",
+			})),
+		"Value":             component.NewText("1000"),
+		"Global Default":    component.NewText("true"),
+		"Preemption Policy": component.NewText("Never"),
+		"Age":               component.NewTimestamp(now),
+		component.GridActionKey: gridActionsFactory([]component.GridAction{
+			buildObjectDeleteAction(t, high),
+		}),
+	})
+	expected.Add(component.TableRow{
+		"Name": component.NewLink("", low.Name, "/low",
+			genObjectStatus(component.TextStatusOK, []string{
+				"scheduling.k8s.io/v1 PriorityClass is OK",
+			})),
+		"Value":             component.NewText("100"),
+		"Global Default":    component.NewText("false"),
+		"Preemption Policy": component.NewText("PreemptLowerPriority"),
+		"Age":               component.NewTimestamp(now),
+		component.GridActionKey: gridActionsFactory([]component.GridAction{
+			buildObjectDeleteAction(t, low),
+		}),
+	})
+
+	testutil.AssertJSONEqual(t, expected, got)
+}
+
+func Test_PriorityClassConfiguration(t *testing.T) {
+	priorityClass := createPriorityClass("high", 1000)
+	priorityClass.Description = "Critical workloads"
+
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+	printOptions := tpo.ToOptions()
+
+	pcc := NewPriorityClassConfiguration(priorityClass)
+	got, err := pcc.Create(printOptions)
+	require.NoError(t, err)
+
+	sections := component.SummarySections{}
+	sections.AddText("Value", "1000")
+	sections.AddText("Global Default", "false")
+	sections.AddText("Preemption Policy", "PreemptLowerPriority")
+	sections.AddText("Description", "Critical workloads")
+	expected := component.NewSummary("Configuration", sections...)
+
+	component.AssertEqual(t, expected, got)
+}
+
+func Test_defaultPriorityClassPods(t *testing.T) {
+	priorityClass := createPriorityClass("high", 1000)
+
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+
+	scheduled := testutil.CreatePod("scheduled")
+	scheduled.Spec.PriorityClassName = "high"
+	scheduled.Spec.NodeName = "node"
+	scheduled.Status.Phase = corev1.PodRunning
+
+	preempting := testutil.CreatePod("preempting")
+	preempting.Spec.PriorityClassName = "high"
+	preempting.Status.Phase = corev1.PodPending
+	preempting.Status.NominatedNodeName = "node"
+
+	other := testutil.CreatePod("other")
+
+	tpo.objectStore.EXPECT().
+		List(gomock.Any(), store.Key{APIVersion: "v1", Kind: "Pod"}).
+		Return(testutil.ToUnstructuredList(t, scheduled, preempting, other), false, nil)
+
+	tpo.PathForObject(scheduled, scheduled.Name, "/scheduled")
+	tpo.PathForObject(preempting, preempting.Name, "/preempting")
+	tpo.PathForGVK("", "v1", "Node", "node", "node", "/node")
+
+	printOptions := tpo.ToOptions()
+
+	got, err := defaultPriorityClassPods(context.Background(), priorityClass, printOptions)
+	require.NoError(t, err)
+
+	cols := component.NewTableCols("Name", "Namespace", "Phase", "Node", "Nominated Node")
+	expected := component.NewTable("Pods", "There are no pods using this priority class!", cols)
+	expected.Add(component.TableRow{
+		"Name":           component.NewLink("", "preempting", "/preempting"),
+		"Namespace":      component.NewText("namespace"),
+		"Phase":          component.NewText("Pending"),
+		"Node":           component.NewText("<not scheduled>"),
+		"Nominated Node": component.NewText("node"),
+	})
+	expected.Add(component.TableRow{
+		"Name":           component.NewLink("", "scheduled", "/scheduled"),
+		"Namespace":      component.NewText("namespace"),
+		"Phase":          component.NewText("Running"),
+		"Node":           component.NewLink("", "node", "/node"),
+		"Nominated Node": component.NewText(""),
+	})
+
+	component.AssertEqual(t, expected, got)
+}
@@ -8,10 +8,12 @@ package printer
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/pkg/errors"
 
 	appsv1 "k8s.io/api/apps/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -26,7 +28,7 @@ func ReplicaSetListHandler(ctx context.Context, list *appsv1.ReplicaSetList, opt
 		return nil, errors.New("nil list")
 	}
 
-	cols := component.NewTableCols("Name", "Labels", "Status", "Age", "Containers", "Selector")
+	cols := component.NewTableCols("Name", "Labels", "Status", "Notes", "Age", "Containers", "Selector")
 	ot := NewObjectTable("ReplicaSets", "We couldn't find any replica sets!", cols, opts.DashConfig.ObjectStore())
 
 	for _, rs := range list.Items {
@@ -42,6 +44,8 @@ func ReplicaSetListHandler(ctx context.Context, list *appsv1.ReplicaSetList, opt
 		status := fmt.Sprintf("%d/%d", rs.Status.AvailableReplicas, rs.Status.Replicas)
 		row["Status"] = component.NewText(status)
 
+		row["Notes"] = replicaSetCleanupNotes(ctx, &rs, opts.DashConfig.ObjectStore())
+
 		ts := rs.CreationTimestamp.Time
 		row["Age"] = component.NewTimestamp(ts)
 
@@ -60,6 +64,60 @@ func ReplicaSetListHandler(ctx context.Context, list *appsv1.ReplicaSetList, opt
 	return ot.ToComponent()
 }
 
+// replicaSetCleanupNotes creates a component describing why a replica set is
+// a candidate for clean up: it is scaled to zero, or its controller no
+// longer exists.
+func replicaSetCleanupNotes(ctx context.Context, replicaSet *appsv1.ReplicaSet, objectStore store.Store) *component.Text {
+	var notes []string
+
+	if isInactiveReplicaSet(replicaSet) {
+		notes = append(notes, "Inactive")
+	}
+
+	if isOrphanedReplicaSet(ctx, replicaSet, objectStore) {
+		notes = append(notes, "Orphaned")
+	}
+
+	text := component.NewText(strings.Join(notes, ", "))
+	if len(notes) > 0 {
+		text.SetStatus(component.TextStatusWarning)
+	}
+
+	return text
+}
+
+// isInactiveReplicaSet returns true if a replica set wants zero replicas.
+func isInactiveReplicaSet(replicaSet *appsv1.ReplicaSet) bool {
+	return replicaSet.Spec.Replicas != nil && *replicaSet.Spec.Replicas == 0
+}
+
+// isOrphanedReplicaSet returns true if a replica set has a controller which
+// no longer exists. If the controller can't be looked up, e.g. the store is
+// backing off or access is denied, the replica set isn't reported as orphaned.
+func isOrphanedReplicaSet(ctx context.Context, replicaSet *appsv1.ReplicaSet, objectStore store.Store) bool {
+	controllerRef := metav1.GetControllerOf(replicaSet)
+	if controllerRef == nil {
+		return false
+	}
+
+	key := store.Key{
+		Namespace:  replicaSet.Namespace,
+		APIVersion: controllerRef.APIVersion,
+		Kind:       controllerRef.Kind,
+		Name:       controllerRef.Name,
+	}
+
+	owner, err := objectStore.Get(ctx, key)
+	if kerrors.IsNotFound(err) || (err == nil && owner == nil) {
+		return true
+	}
+	if err != nil || owner.GetUID() == "" {
+		return false
+	}
+
+	return owner.GetUID() != controllerRef.UID
+}
+
 // ReplicaSetHandler is a printFunc that prints a ReplicaSets.
 func ReplicaSetHandler(ctx context.Context, replicaSet *appsv1.ReplicaSet, options Options) (component.Component, error) {
 	o := NewObject(replicaSet)
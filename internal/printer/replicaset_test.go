@@ -7,6 +7,7 @@ package printer
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -16,8 +17,11 @@ import (
 	"github.com/stretchr/testify/require"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 
+	"github.com/vmware-tanzu/octant/internal/conversion"
 	"github.com/vmware-tanzu/octant/internal/testutil"
 	"github.com/vmware-tanzu/octant/pkg/store"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
@@ -90,7 +94,7 @@ func Test_ReplicaSetListHandler(t *testing.T) {
 	containers.Add("nginx", "nginx:1.15")
 	containers.Add("kuard", "gcr.io/kuar-demo/kuard-amd64:1")
 
-	cols := component.NewTableCols("Name", "Labels", "Status", "Age", "Containers", "Selector")
+	cols := component.NewTableCols("Name", "Labels", "Status", "Notes", "Age", "Containers", "Selector")
 	expected := component.NewTable("ReplicaSets", "We couldn't find any replica sets!", cols)
 	expected.Add(component.TableRow{
 		"Name": component.NewLink("", "replicaset-test", "/replica-set",
@@ -101,6 +105,7 @@ func Test_ReplicaSetListHandler(t *testing.T) {
 		"Age":        component.NewTimestamp(now),
 		"Selector":   component.NewSelectors([]component.Selector{component.NewLabelSelector("app", "myapp")}),
 		"Status":     component.NewText("2/3"),
+		"Notes":      component.NewText(""),
 		"Containers": containers,
 		component.GridActionKey: gridActionsFactory([]component.GridAction{
 			buildObjectDeleteAction(t, &object.Items[0]),
@@ -110,6 +115,103 @@ func Test_ReplicaSetListHandler(t *testing.T) {
 	component.AssertEqual(t, expected, got)
 }
 
+func Test_replicaSetCleanupNotes(t *testing.T) {
+	deployment := testutil.CreateDeployment("deployment")
+
+	inactive := testutil.CreateAppReplicaSet("inactive")
+	inactive.Spec.Replicas = conversion.PtrInt32(0)
+
+	orphaned := testutil.CreateAppReplicaSet("orphaned")
+	orphaned.SetOwnerReferences(testutil.ToOwnerReferences(t, deployment))
+
+	replaced := testutil.CreateAppReplicaSet("replaced")
+	replaced.SetOwnerReferences(testutil.ToOwnerReferences(t, deployment))
+
+	owned := testutil.CreateAppReplicaSet("owned")
+	owned.Spec.Replicas = conversion.PtrInt32(0)
+	owned.SetOwnerReferences(testutil.ToOwnerReferences(t, deployment))
+
+	newDeployment := testutil.CreateDeployment("deployment")
+	newDeployment.UID = "new-uid"
+
+	cases := []struct {
+		name       string
+		replicaSet *appsv1.ReplicaSet
+		owner      *unstructured.Unstructured
+		ownerErr   error
+		expected   *component.Text
+	}{
+		{
+			name:       "active without controller",
+			replicaSet: testutil.CreateAppReplicaSet("rs"),
+			expected:   component.NewText(""),
+		},
+		{
+			name:       "inactive",
+			replicaSet: inactive,
+			expected:   warningText("Inactive"),
+		},
+		{
+			name:       "controller missing",
+			replicaSet: orphaned,
+			ownerErr:   kerrors.NewNotFound(schema.GroupResource{Group: "apps", Resource: "deployments"}, "deployment"),
+			expected:   warningText("Orphaned"),
+		},
+		{
+			name:       "controller unknown",
+			replicaSet: orphaned,
+			owner:      &unstructured.Unstructured{},
+			expected:   component.NewText(""),
+		},
+		{
+			name:       "controller lookup failed",
+			replicaSet: orphaned,
+			ownerErr:   fmt.Errorf("access denied"),
+			expected:   component.NewText(""),
+		},
+		{
+			name:       "controller recreated",
+			replicaSet: replaced,
+			owner:      testutil.ToUnstructured(t, newDeployment),
+			expected:   warningText("Orphaned"),
+		},
+		{
+			name:       "inactive with controller",
+			replicaSet: owned,
+			owner:      testutil.ToUnstructured(t, deployment),
+			expected:   warningText("Inactive"),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			tpo := newTestPrinterOptions(controller)
+
+			if metav1.GetControllerOf(tc.replicaSet) != nil {
+				key := store.Key{
+					Namespace:  "namespace",
+					APIVersion: "apps/v1",
+					Kind:       "Deployment",
+					Name:       "deployment",
+				}
+				tpo.objectStore.EXPECT().Get(gomock.Any(), key).Return(tc.owner, tc.ownerErr)
+			}
+
+			got := replicaSetCleanupNotes(context.Background(), tc.replicaSet, tpo.objectStore)
+			component.AssertEqual(t, tc.expected, got)
+		})
+	}
+}
+
+func warningText(s string) *component.Text {
+	text := component.NewText(s)
+	text.SetStatus(component.TextStatusWarning)
+	return text
+}
+
 func Test_ReplicaSetConfiguration(t *testing.T) {
 
 	var replicas int32 = 3
@@ -306,6 +408,7 @@ func Test_ReplicaSetPods(t *testing.T) {
 		Namespace:  "namespace",
 		APIVersion: "v1",
 		Kind:       "Pod",
+		OwnerUID:   replicaSet.UID,
 	}
 
 	tpo.objectStore.EXPECT().List(gomock.Any(), gomock.Eq(key)).Return(podList, false, nil)
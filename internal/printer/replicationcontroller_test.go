@@ -279,6 +279,7 @@ func Test_ReplicationControllerPods(t *testing.T) {
 		Namespace:  "namespace",
 		APIVersion: "v1",
 		Kind:       "Pod",
+		OwnerUID:   rc.UID,
 	}
 
 	tpo.objectStore.EXPECT().List(gomock.Any(), gomock.Eq(key)).Return(podList, false, nil)
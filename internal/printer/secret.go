@@ -7,19 +7,33 @@ package printer
 
 import (
 	"context"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 
+	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/pkg/action"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
 
 var (
-	secretTableCols = component.NewTableCols("Name", "Labels", "Type", "Data", "Age")
-	secretDataCols  = component.NewTableCols("Key")
+	secretTableCols       = component.NewTableCols("Name", "Labels", "Type", "Data", "Age")
+	secretDataCols        = component.NewTableCols("Key")
+	secretCertificateCols = component.NewTableCols("Key", "Subject", "Issuer", "Not Before", "Not After", "Subject Alternative Names")
+	secretRegistryCols    = component.NewTableCols("Registry", "Username")
 )
 
+// certificateExpiryWarning is how close to expiry a certificate must be
+// before it is flagged.
+const certificateExpiryWarning = 30 * 24 * time.Hour
+
 // SecretListHandler is a printFunc that lists secrets.
 func SecretListHandler(ctx context.Context, list *corev1.SecretList, options Options) (component.Component, error) {
 	if list == nil {
@@ -67,6 +81,10 @@ func SecretHandler(ctx context.Context, secret *corev1.Secret, options Options)
 		return nil, errors.Wrap(err, "print secret data")
 	}
 
+	if err := sh.Details(options); err != nil {
+		return nil, errors.Wrap(err, "print secret details")
+	}
+
 	return o.ToComponent(ctx, options)
 }
 
@@ -106,6 +124,7 @@ func describeSecretData(secret corev1.Secret) (*component.Table, error) {
 	for key := range secret.Data {
 		row := component.TableRow{}
 		row["Key"] = component.NewText(key)
+		row.AddAction(revealSecretValueAction(secret, key))
 
 		table.Add(row)
 	}
@@ -115,16 +134,139 @@ func describeSecretData(secret corev1.Secret) (*component.Table, error) {
 	return table, nil
 }
 
+// revealSecretValueAction creates an action which reveals a secret value. Values
+// aren't included in the table, so they are only read when the user asks for one.
+func revealSecretValueAction(secret corev1.Secret, key string) component.GridAction {
+	return component.GridAction{
+		Name:       "Reveal",
+		ActionPath: octant.ActionRevealSecretValue,
+		Payload: action.Payload{
+			"namespace": secret.Namespace,
+			"name":      secret.Name,
+			"key":       key,
+		},
+		Type: component.GridActionPrimary,
+	}
+}
+
+// describeSecretCertificates creates a table describing the certificates
+// stored in a TLS secret.
+func describeSecretCertificates(secret corev1.Secret, now time.Time) (*component.Table, error) {
+	table := component.NewTable("Certificates", "This secret has no certificates!", secretCertificateCols)
+
+	for _, key := range []string{corev1.TLSCertKey, corev1.ServiceAccountRootCAKey} {
+		data, ok := secret.Data[key]
+		if !ok {
+			continue
+		}
+
+		for rest := data; ; {
+			var block *pem.Block
+			block, rest = pem.Decode(rest)
+			if block == nil {
+				break
+			}
+			if block.Type != "CERTIFICATE" {
+				continue
+			}
+
+			cert, err := x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				return nil, errors.Wrapf(err, "parse certificate in %s", key)
+			}
+
+			var names []string
+			names = append(names, cert.DNSNames...)
+			for _, ip := range cert.IPAddresses {
+				names = append(names, ip.String())
+			}
+			names = append(names, cert.EmailAddresses...)
+			for _, uri := range cert.URIs {
+				names = append(names, uri.String())
+			}
+
+			notAfter := component.NewText(cert.NotAfter.UTC().Format(time.RFC3339))
+			switch {
+			case now.After(cert.NotAfter):
+				notAfter.SetStatus(component.TextStatusError)
+			case cert.NotAfter.Sub(now) < certificateExpiryWarning:
+				notAfter.SetStatus(component.TextStatusWarning)
+			default:
+				notAfter.SetStatus(component.TextStatusOK)
+			}
+
+			table.Add(component.TableRow{
+				"Key":                       component.NewText(key),
+				"Subject":                   component.NewText(cert.Subject.String()),
+				"Issuer":                    component.NewText(cert.Issuer.String()),
+				"Not Before":                component.NewText(cert.NotBefore.UTC().Format(time.RFC3339)),
+				"Not After":                 notAfter,
+				"Subject Alternative Names": component.NewText(strings.Join(names, ", ")),
+			})
+		}
+	}
+
+	return table, nil
+}
+
+type dockerConfigEntry struct {
+	Username string `json:"username,omitempty"`
+	Auth     string `json:"auth,omitempty"`
+}
+
+// describeSecretRegistries creates a table listing the registries a docker
+// config secret has credentials for.
+func describeSecretRegistries(secret corev1.Secret) (*component.Table, error) {
+	table := component.NewTable("Registries", "This secret has no registries!", secretRegistryCols)
+
+	entries := map[string]dockerConfigEntry{}
+
+	switch secret.Type {
+	case corev1.SecretTypeDockerConfigJson:
+		var config struct {
+			Auths map[string]dockerConfigEntry `json:"auths"`
+		}
+		if err := json.Unmarshal(secret.Data[corev1.DockerConfigJsonKey], &config); err != nil {
+			return nil, errors.Wrap(err, "parse docker config")
+		}
+		entries = config.Auths
+	case corev1.SecretTypeDockercfg:
+		if err := json.Unmarshal(secret.Data[corev1.DockerConfigKey], &entries); err != nil {
+			return nil, errors.Wrap(err, "parse docker config")
+		}
+	}
+
+	for registry, entry := range entries {
+		username := entry.Username
+		if username == "" && entry.Auth != "" {
+			if decoded, err := base64.StdEncoding.DecodeString(entry.Auth); err == nil {
+				username = strings.SplitN(string(decoded), ":", 2)[0]
+			}
+		}
+
+		table.Add(component.TableRow{
+			"Registry": component.NewText(registry),
+			"Username": component.NewText(username),
+		})
+	}
+
+	table.Sort("Registry")
+
+	return table, nil
+}
+
 type secretObject interface {
 	Config(options Options) error
 	Data(options Options) error
+	Details(options Options) error
 }
 
 type secretHandler struct {
-	secret     *corev1.Secret
-	configFunc func(*corev1.Secret, Options) (*component.Summary, error)
-	dataFunc   func(*corev1.Secret, Options) (*component.Table, error)
-	object     *Object
+	secret      *corev1.Secret
+	configFunc  func(*corev1.Secret, Options) (*component.Summary, error)
+	dataFunc    func(*corev1.Secret, Options) (*component.Table, error)
+	detailsFunc func(*corev1.Secret, Options) (*component.Table, error)
+	object      *Object
 }
 
 func newSecretHandler(secret *corev1.Secret, object *Object) (*secretHandler, error) {
@@ -137,10 +279,11 @@ func newSecretHandler(secret *corev1.Secret, object *Object) (*secretHandler, er
 	}
 
 	sh := &secretHandler{
-		secret:     secret,
-		configFunc: defaultSecretConfig,
-		dataFunc:   defaultSecretData,
-		object:     object,
+		secret:      secret,
+		configFunc:  defaultSecretConfig,
+		dataFunc:    defaultSecretData,
+		detailsFunc: defaultSecretDetails,
+		object:      object,
 	}
 
 	return sh, nil
@@ -176,3 +319,30 @@ func (s *secretHandler) Data(options Options) error {
 func defaultSecretData(secret *corev1.Secret, options Options) (*component.Table, error) {
 	return describeSecretData(*secret)
 }
+
+func (s *secretHandler) Details(options Options) error {
+	if s.secret == nil {
+		return errors.New("can't display details for nil secret")
+	}
+
+	switch s.secret.Type {
+	case corev1.SecretTypeTLS, corev1.SecretTypeDockerConfigJson, corev1.SecretTypeDockercfg:
+	default:
+		return nil
+	}
+
+	s.object.RegisterItems(ItemDescriptor{
+		Width: component.WidthFull,
+		Func: func() (component.Component, error) {
+			return s.detailsFunc(s.secret, options)
+		},
+	})
+	return nil
+}
+
+func defaultSecretDetails(secret *corev1.Secret, options Options) (*component.Table, error) {
+	if secret.Type == corev1.SecretTypeTLS {
+		return describeSecretCertificates(*secret, time.Now())
+	}
+	return describeSecretRegistries(*secret)
+}
@@ -7,7 +7,17 @@ package printer
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"net"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
@@ -128,7 +138,7 @@ func Test_describeSecretData(t *testing.T) {
 	secret := testutil.CreateSecret("secret")
 	secret.Data = map[string][]byte{
 		"foo": {0, 1, 2, 3},
-		"bar": {0, 1, 2, 3},
+		"bar": []byte("password"),
 	}
 
 	got, err := describeSecretData(*secret)
@@ -136,10 +146,97 @@ func Test_describeSecretData(t *testing.T) {
 
 	cols := component.NewTableCols("Key")
 	expected := component.NewTable("Data", "This secret has no data!", cols)
-	expected.Add([]component.TableRow{
-		{"Key": component.NewText("bar")},
-		{"Key": component.NewText("foo")},
-	}...)
+	for _, key := range []string{"bar", "foo"} {
+		row := component.TableRow{"Key": component.NewText(key)}
+		row.AddAction(revealSecretValueAction(*secret, key))
+		expected.Add(row)
+	}
+
+	component.AssertEqual(t, expected, got)
+}
+
+func Test_describeSecretCertificates(t *testing.T) {
+	now := testutil.Time()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "example.com"},
+		NotBefore:    now.Add(-24 * time.Hour),
+		NotAfter:     now.Add(7 * 24 * time.Hour),
+		DNSNames:     []string{"example.com", "www.example.com"},
+		IPAddresses:  []net.IP{net.ParseIP("10.0.0.1")},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	secret := testutil.CreateSecret("secret")
+	secret.Type = corev1.SecretTypeTLS
+	secret.Data = map[string][]byte{
+		corev1.TLSCertKey:       pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		corev1.TLSPrivateKeyKey: []byte("key"),
+	}
+
+	got, err := describeSecretCertificates(*secret, now)
+	require.NoError(t, err)
+
+	notAfter := component.NewText(now.Add(7 * 24 * time.Hour).UTC().Format(time.RFC3339))
+	notAfter.SetStatus(component.TextStatusWarning)
+
+	expected := component.NewTable("Certificates", "This secret has no certificates!", secretCertificateCols)
+	expected.Add(component.TableRow{
+		"Key":                       component.NewText("tls.crt"),
+		"Subject":                   component.NewText("CN=example.com"),
+		"Issuer":                    component.NewText("CN=example.com"),
+		"Not Before":                component.NewText(now.Add(-24 * time.Hour).UTC().Format(time.RFC3339)),
+		"Not After":                 notAfter,
+		"Subject Alternative Names": component.NewText("example.com, www.example.com, 10.0.0.1"),
+	})
 
 	component.AssertEqual(t, expected, got)
 }
+
+func Test_describeSecretRegistries(t *testing.T) {
+	auth := base64.StdEncoding.EncodeToString([]byte("robot:password"))
+
+	cases := []struct {
+		name       string
+		secretType corev1.SecretType
+		key        string
+		data       string
+	}{
+		{
+			name:       "docker config json",
+			secretType: corev1.SecretTypeDockerConfigJson,
+			key:        corev1.DockerConfigJsonKey,
+			data:       `{"auths":{"registry.example.com":{"username":"user","password":"password"},"quay.io":{"auth":"` + auth + `"}}}`,
+		},
+		{
+			name:       "docker config",
+			secretType: corev1.SecretTypeDockercfg,
+			key:        corev1.DockerConfigKey,
+			data:       `{"registry.example.com":{"username":"user","password":"password"},"quay.io":{"auth":"` + auth + `"}}`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			secret := testutil.CreateSecret("secret")
+			secret.Type = tc.secretType
+			secret.Data = map[string][]byte{tc.key: []byte(tc.data)}
+
+			got, err := describeSecretRegistries(*secret)
+			require.NoError(t, err)
+
+			expected := component.NewTable("Registries", "This secret has no registries!", secretRegistryCols)
+			expected.Add([]component.TableRow{
+				{"Registry": component.NewText("quay.io"), "Username": component.NewText("robot")},
+				{"Registry": component.NewText("registry.example.com"), "Username": component.NewText("user")},
+			}...)
+
+			component.AssertEqual(t, expected, got)
+		})
+	}
+}
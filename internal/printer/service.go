@@ -173,6 +173,14 @@ func (sc *ServiceConfiguration) Create(ctx context.Context, options Options) (*c
 	}
 	summary.AddAction(configEditor)
 
+	if service.Spec.Type != corev1.ServiceTypeExternalName {
+		portEditor, err := editServicePortsAction(service)
+		if err != nil {
+			return nil, err
+		}
+		summary.AddAction(portEditor)
+	}
+
 	return summary, nil
 }
 
@@ -355,6 +363,34 @@ func editServiceAction(ctx context.Context, service *corev1.Service, options Opt
 	return action, nil
 }
 
+// editServicePortsAction creates an action which edits the type and ports of a service.
+func editServicePortsAction(service *corev1.Service) (component.Action, error) {
+	serviceType := service.Spec.Type
+	if serviceType == "" {
+		serviceType = corev1.ServiceTypeClusterIP
+	}
+
+	var types []component.InputChoice
+	for _, t := range octant.ServiceTypes {
+		types = append(types, component.InputChoice{Label: string(t), Value: string(t), Checked: t == serviceType})
+	}
+
+	form, err := component.CreateFormForObject(octant.ActionServicePortEditor, service,
+		component.NewFormFieldRadio("Type", "type", types),
+		component.NewFormFieldTextarea("Ports ([name=]port[:targetPort][/protocol], one per line)", "ports",
+			octant.FormatServicePorts(service.Spec.Ports)),
+	)
+	if err != nil {
+		return component.Action{}, err
+	}
+
+	return component.Action{
+		Name:  "Edit Ports",
+		Title: "Service Type and Ports Editor",
+		Form:  form,
+	}, nil
+}
+
 func createServiceSummaryStatus(service *corev1.Service) (*component.Summary, error) {
 	if service == nil {
 		return nil, errors.New("service is nil")
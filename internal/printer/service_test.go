@@ -20,6 +20,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/intstr"
 
+	"github.com/vmware-tanzu/octant/internal/octant"
 	"github.com/vmware-tanzu/octant/internal/portforward"
 	"github.com/vmware-tanzu/octant/internal/testutil"
 	"github.com/vmware-tanzu/octant/pkg/action"
@@ -333,6 +334,10 @@ func Test_ServiceConfiguration(t *testing.T) {
 			require.NoError(t, err)
 			tc.expected.AddAction(editAction)
 
+			portsAction, err := editServicePortsAction(tc.service)
+			require.NoError(t, err)
+			tc.expected.AddAction(portsAction)
+
 			component.AssertEqual(t, tc.expected, summary)
 		}()
 	}
@@ -585,3 +590,28 @@ func toUnstructured(t *testing.T, object runtime.Object) *unstructured.Unstructu
 
 	return &unstructured.Unstructured{Object: m}
 }
+
+func Test_editServicePortsAction(t *testing.T) {
+	service := createServiceWithPort(corev1.ServicePort{Name: "http", Port: 80, TargetPort: intstr.FromString("web"), Protocol: corev1.ProtocolTCP})
+	service.Spec.Type = corev1.ServiceTypeNodePort
+
+	got, err := editServicePortsAction(&service)
+	require.NoError(t, err)
+
+	form, err := component.CreateFormForObject(octant.ActionServicePortEditor, &service,
+		component.NewFormFieldRadio("Type", "type", []component.InputChoice{
+			{Label: "ClusterIP", Value: "ClusterIP"},
+			{Label: "NodePort", Value: "NodePort", Checked: true},
+			{Label: "LoadBalancer", Value: "LoadBalancer"},
+		}),
+		component.NewFormFieldTextarea("Ports ([name=]port[:targetPort][/protocol], one per line)", "ports", "http=80:web/TCP"),
+	)
+	require.NoError(t, err)
+
+	expected := component.Action{
+		Name:  "Edit Ports",
+		Title: "Service Type and Ports Editor",
+		Form:  form,
+	}
+	assert.Equal(t, expected, got)
+}
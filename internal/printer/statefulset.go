@@ -8,13 +8,18 @@ package printer
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/pkg/errors"
 	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 
+	"github.com/vmware-tanzu/octant/internal/util/kubernetes"
 	"github.com/vmware-tanzu/octant/pkg/store"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
@@ -79,6 +84,10 @@ func StatefulSetHandler(ctx context.Context, statefulSet *appsv1.StatefulSet, op
 		return nil, errors.Wrap(err, "print statefulset pods")
 	}
 
+	if err := sh.VolumeClaims(ctx, options); err != nil {
+		return nil, errors.Wrap(err, "print statefulset volume claims")
+	}
+
 	return o.ToComponent(ctx, options)
 }
 
@@ -140,6 +149,18 @@ func (sc *StatefulSetConfiguration) Create(options Options) (*component.Summary,
 
 	sections.AddText("Pod Management Policy", string(statefulSet.Spec.PodManagementPolicy))
 
+	if templates := statefulSet.Spec.VolumeClaimTemplates; len(templates) > 0 {
+		var names []string
+		for _, template := range templates {
+			name := template.Name
+			if storage, ok := template.Spec.Resources.Requests[corev1.ResourceStorage]; ok {
+				name = fmt.Sprintf("%s (%s)", name, storage.String())
+			}
+			names = append(names, name)
+		}
+		sections.AddText("Volume Claim Templates", strings.Join(names, ", "))
+	}
+
 	summary := component.NewSummary("Configuration", sections...)
 	return summary, nil
 }
@@ -202,6 +223,7 @@ type statefulSetObject interface {
 	Config(options Options) error
 	Status(ctx context.Context, options Options) error
 	Pods(ctx context.Context, object runtime.Object, options Options) error
+	VolumeClaims(ctx context.Context, options Options) error
 }
 
 type statefulSetHandler struct {
@@ -209,6 +231,7 @@ type statefulSetHandler struct {
 	configFunc  func(*appsv1.StatefulSet, Options) (*component.Summary, error)
 	statusFunc  func(context.Context, *appsv1.StatefulSet, Options) (*component.Quadrant, error)
 	podFunc     func(context.Context, runtime.Object, Options) (component.Component, error)
+	claimFunc   func(context.Context, *appsv1.StatefulSet, Options) (component.Component, error)
 	object      *Object
 }
 
@@ -228,6 +251,7 @@ func newStatufulSetHandler(statefulSet *appsv1.StatefulSet, object *Object) (*st
 		configFunc:  defaultStatefulSetConfig,
 		statusFunc:  defaultStatefulSetStatus,
 		podFunc:     defaultStatefulSetPods,
+		claimFunc:   defaultStatefulSetVolumeClaims,
 		object:      object,
 	}
 
@@ -278,5 +302,236 @@ func (s *statefulSetHandler) Pods(ctx context.Context, object runtime.Object, op
 }
 
 func defaultStatefulSetPods(ctx context.Context, object runtime.Object, options Options) (component.Component, error) {
-	return createPodListView(ctx, object, options)
+	statefulSet, ok := object.(*appsv1.StatefulSet)
+	if !ok {
+		return createPodListView(ctx, object, options)
+	}
+	return createStatefulSetPodListView(ctx, statefulSet, options)
+}
+
+func (s *statefulSetHandler) VolumeClaims(ctx context.Context, options Options) error {
+	if len(s.statefulSet.Spec.VolumeClaimTemplates) == 0 {
+		return nil
+	}
+
+	s.object.RegisterItems(ItemDescriptor{
+		Width: component.WidthFull,
+		Func: func() (component.Component, error) {
+			return s.claimFunc(ctx, s.statefulSet, options)
+		},
+	})
+	return nil
+}
+
+func defaultStatefulSetVolumeClaims(ctx context.Context, statefulSet *appsv1.StatefulSet, options Options) (component.Component, error) {
+	return createStatefulSetVolumeClaimsView(ctx, statefulSet, options)
+}
+
+var statefulSetPodCols = component.NewTableCols("Name", "Ordinal", "Ready", "Phase", "Restarts", "Node", "Age")
+
+// createStatefulSetPodListView creates a table of the pods controlled by a
+// stateful set ordered by their ordinal.
+func createStatefulSetPodListView(ctx context.Context, statefulSet *appsv1.StatefulSet, options Options) (component.Component, error) {
+	options.DisableLabels = true
+
+	key := store.Key{
+		Namespace:  statefulSet.Namespace,
+		APIVersion: "v1",
+		Kind:       "Pod",
+		OwnerUID:   statefulSet.UID,
+	}
+
+	list, _, err := options.DashConfig.ObjectStore().List(ctx, key)
+	if err != nil {
+		return nil, errors.Wrapf(err, "list all objects for key %+v", key)
+	}
+
+	var pods []*corev1.Pod
+	for i := range list.Items {
+		pod := &corev1.Pod{}
+		if err := kubernetes.FromUnstructured(&list.Items[i], pod); err != nil {
+			return nil, err
+		}
+
+		if metav1.IsControlledBy(pod, statefulSet) {
+			pods = append(pods, pod)
+		}
+	}
+
+	sort.SliceStable(pods, func(i, j int) bool {
+		a, b := statefulSetPodOrdinal(statefulSet, pods[i]), statefulSetPodOrdinal(statefulSet, pods[j])
+		if a < 0 || b < 0 {
+			return b < 0 && a >= 0
+		}
+		return a < b
+	})
+
+	ot := NewObjectTable("Pods", "We couldn't find any pods!", statefulSetPodCols, options.DashConfig.ObjectStore())
+	ot.AddFilters(podTableFilters())
+	ot.Grow(len(pods))
+
+	for _, pod := range pods {
+		row, err := podTableRow(pod, len(statefulSetPodCols), options)
+		if err != nil {
+			return nil, err
+		}
+
+		ordinal := ""
+		if n := statefulSetPodOrdinal(statefulSet, pod); n >= 0 {
+			ordinal = strconv.Itoa(n)
+		}
+		row["Ordinal"] = component.NewText(ordinal)
+
+		if err := ot.AddRowForObject(ctx, pod, row); err != nil {
+			return nil, fmt.Errorf("add row for object: %w", err)
+		}
+	}
+
+	return ot.ToComponent()
+}
+
+// statefulSetPodOrdinal returns the ordinal of a stateful set pod, or -1 if
+// the pod name doesn't follow the stateful set naming scheme.
+func statefulSetPodOrdinal(statefulSet *appsv1.StatefulSet, pod *corev1.Pod) int {
+	prefix := statefulSet.Name + "-"
+	if !strings.HasPrefix(pod.Name, prefix) {
+		return -1
+	}
+
+	ordinal, err := strconv.Atoi(strings.TrimPrefix(pod.Name, prefix))
+	if err != nil || ordinal < 0 {
+		return -1
+	}
+
+	return ordinal
+}
+
+var statefulSetVolumeClaimCols = component.NewTableCols("Claim", "Ordinal", "Template", "Status", "Volume", "Capacity", "Reclaim Policy", "Storage Class")
+
+// createStatefulSetVolumeClaimsView creates a table of the persistent volume
+// claims a stateful set's replicas get from its volume claim templates, along
+// with the persistent volumes they are bound to.
+func createStatefulSetVolumeClaimsView(ctx context.Context, statefulSet *appsv1.StatefulSet, options Options) (component.Component, error) {
+	if statefulSet == nil {
+		return nil, errors.New("statefulset is nil")
+	}
+
+	objectStore := options.DashConfig.ObjectStore()
+
+	replicas := 1
+	if statefulSet.Spec.Replicas != nil {
+		replicas = int(*statefulSet.Spec.Replicas)
+	}
+	if current := int(statefulSet.Status.Replicas); current > replicas {
+		replicas = current
+	}
+
+	table := component.NewTable("Volume Claims", "We couldn't find any volume claims!", statefulSetVolumeClaimCols)
+
+	for ordinal := 0; ordinal < replicas; ordinal++ {
+		for _, template := range statefulSet.Spec.VolumeClaimTemplates {
+			claimName := fmt.Sprintf("%s-%s-%d", template.Name, statefulSet.Name, ordinal)
+
+			row := component.TableRow{
+				"Ordinal":  component.NewText(strconv.Itoa(ordinal)),
+				"Template": component.NewText(template.Name),
+			}
+
+			key := store.Key{
+				Namespace:  statefulSet.Namespace,
+				APIVersion: "v1",
+				Kind:       "PersistentVolumeClaim",
+				Name:       claimName,
+			}
+
+			claim := &corev1.PersistentVolumeClaim{}
+			found, err := store.GetAs(ctx, objectStore, key, claim)
+			if err != nil {
+				return nil, errors.Wrapf(err, "get claim for key %+v", key)
+			}
+
+			if !found || claim.Name == "" {
+				status := component.NewText("Missing")
+				status.SetStatus(component.TextStatusWarning)
+
+				row["Claim"] = component.NewText(claimName)
+				row["Status"] = status
+				row["Volume"] = component.NewText("")
+				row["Capacity"] = component.NewText("")
+				row["Reclaim Policy"] = component.NewText("")
+				row["Storage Class"] = component.NewText("")
+				table.Add(row)
+				continue
+			}
+
+			claimLink, err := options.Link.ForObject(claim, claim.Name)
+			if err != nil {
+				return nil, err
+			}
+			row["Claim"] = claimLink
+
+			status := component.NewText(string(claim.Status.Phase))
+			switch claim.Status.Phase {
+			case corev1.ClaimBound:
+				status.SetStatus(component.TextStatusOK)
+			case corev1.ClaimLost:
+				status.SetStatus(component.TextStatusError)
+			default:
+				status.SetStatus(component.TextStatusWarning)
+			}
+			row["Status"] = status
+
+			capacity := ""
+			if storage, ok := claim.Status.Capacity[corev1.ResourceStorage]; ok {
+				capacity = storage.String()
+			}
+			row["Capacity"] = component.NewText(capacity)
+			row["Storage Class"] = component.NewText(printPersistentVolumeClaimClass(claim))
+
+			volume, reclaimPolicy, err := statefulSetClaimVolume(ctx, claim, options)
+			if err != nil {
+				return nil, err
+			}
+			row["Volume"] = volume
+			row["Reclaim Policy"] = component.NewText(reclaimPolicy)
+
+			table.Add(row)
+		}
+	}
+
+	return table, nil
+}
+
+// statefulSetClaimVolume returns a component for the persistent volume a
+// claim is bound to and the volume's reclaim policy.
+func statefulSetClaimVolume(ctx context.Context, claim *corev1.PersistentVolumeClaim, options Options) (component.Component, string, error) {
+	volumeName := claim.Spec.VolumeName
+	if volumeName == "" {
+		return component.NewText(""), "", nil
+	}
+
+	key := store.Key{
+		APIVersion: "v1",
+		Kind:       "PersistentVolume",
+		Name:       volumeName,
+	}
+
+	volume := &corev1.PersistentVolume{}
+	found, err := store.GetAs(ctx, options.DashConfig.ObjectStore(), key, volume)
+	if err != nil {
+		return nil, "", errors.Wrapf(err, "get volume for key %+v", key)
+	}
+
+	if !found || volume.Name == "" {
+		text := component.NewText(fmt.Sprintf("%s (missing)", volumeName))
+		text.SetStatus(component.TextStatusError)
+		return text, "", nil
+	}
+
+	volumeLink, err := options.Link.ForObject(volume, volume.Name)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return volumeLink, string(volume.Spec.PersistentVolumeReclaimPolicy), nil
 }
@@ -14,8 +14,12 @@ import (
 	"github.com/stretchr/testify/require"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	"github.com/vmware-tanzu/octant/internal/conversion"
 	"github.com/vmware-tanzu/octant/internal/testutil"
@@ -210,12 +214,43 @@ func Test_StatefulSetConfiguration(t *testing.T) {
 		},
 	}
 
+	withTemplates := validStatefulSet.DeepCopy()
+	withTemplates.Spec.VolumeClaimTemplates = []corev1.PersistentVolumeClaim{
+		*testutil.CreatePersistentVolumeClaim("data"),
+	}
+
 	cases := []struct {
 		name        string
 		statefulSet *appsv1.StatefulSet
 		isErr       bool
 		expected    *component.Summary
 	}{
+		{
+			name:        "with volume claim templates",
+			statefulSet: withTemplates,
+			expected: component.NewSummary("Configuration", []component.SummarySection{
+				{
+					Header:  "Update Strategy",
+					Content: component.NewText("RollingUpdate"),
+				},
+				{
+					Header:  "Selectors",
+					Content: component.NewSelectors([]component.Selector{component.NewLabelSelector("app", "myapp")}),
+				},
+				{
+					Header:  "Replicas",
+					Content: component.NewText("3 Desired / 1 Total"),
+				},
+				{
+					Header:  "Pod Management Policy",
+					Content: component.NewText("OrderedReady"),
+				},
+				{
+					Header:  "Volume Claim Templates",
+					Content: component.NewText("data (3Gi)"),
+				},
+			}...),
+		},
 		{
 			name:        "default",
 			statefulSet: validStatefulSet,
@@ -328,6 +363,7 @@ func Test_StatefulSetPods(t *testing.T) {
 		Namespace:  "namespace",
 		APIVersion: "v1",
 		Kind:       "Pod",
+		OwnerUID:   statefulSet.UID,
 	}
 
 	tpo.objectStore.EXPECT().List(gomock.Any(), gomock.Eq(key)).Return(podList, false, nil)
@@ -356,3 +392,146 @@ func Test_StatefulSetPods(t *testing.T) {
 
 	component.AssertEqual(t, expected, got)
 }
+
+func Test_createStatefulSetPodListView(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+	printOptions := tpo.ToOptions()
+
+	now := testutil.Time()
+
+	statefulSet := testutil.CreateStatefulSet("web")
+
+	var objects []runtime.Object
+	for _, name := range []string{"web-10", "web-2", "web-0"} {
+		pod := testutil.CreatePod(name)
+		pod.SetOwnerReferences(testutil.ToOwnerReferences(t, statefulSet))
+		pod.CreationTimestamp = metav1.Time{Time: now}
+		pod.Status.Phase = corev1.PodRunning
+		objects = append(objects, pod)
+		tpo.link.EXPECT().ForObject(gomock.Any(), name).Return(component.NewLink("", name, "/"+name), nil)
+	}
+
+	other := testutil.CreatePod("other")
+	objects = append(objects, other)
+
+	key := store.Key{
+		Namespace:  "namespace",
+		APIVersion: "v1",
+		Kind:       "Pod",
+		OwnerUID:   statefulSet.UID,
+	}
+	tpo.objectStore.EXPECT().List(gomock.Any(), key).Return(testutil.ToUnstructuredList(t, objects...), false, nil)
+
+	got, err := createStatefulSetPodListView(context.Background(), statefulSet, printOptions)
+	require.NoError(t, err)
+
+	table, ok := got.(*component.Table)
+	require.True(t, ok)
+
+	var ordinals []string
+	for _, row := range table.Rows() {
+		text, ok := row["Ordinal"].(*component.Text)
+		require.True(t, ok)
+		ordinals = append(ordinals, text.Config.Text)
+	}
+	assert.Equal(t, []string{"0", "2", "10"}, ordinals)
+}
+
+func Test_createStatefulSetVolumeClaimsView(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+	printOptions := tpo.ToOptions()
+
+	statefulSet := testutil.CreateStatefulSet("web")
+	statefulSet.Spec.Replicas = conversion.PtrInt32(3)
+	statefulSet.Spec.VolumeClaimTemplates = []corev1.PersistentVolumeClaim{
+		*testutil.CreatePersistentVolumeClaim("data"),
+	}
+
+	claim := testutil.CreatePersistentVolumeClaim("data-web-0")
+	claim.Spec.VolumeName = "pv-0"
+	claim.Status.Phase = corev1.ClaimBound
+	claim.Status.Capacity = corev1.ResourceList{
+		corev1.ResourceStorage: resource.MustParse("3Gi"),
+	}
+
+	unboundClaim := testutil.CreatePersistentVolumeClaim("data-web-2")
+	unboundClaim.Spec.VolumeName = "pv-2"
+	unboundClaim.Status.Phase = corev1.ClaimLost
+
+	volume := testutil.CreatePersistentVolume("pv-0")
+	volume.Namespace = ""
+	volume.Spec.PersistentVolumeReclaimPolicy = corev1.PersistentVolumeReclaimRetain
+
+	claimsResource := schema.GroupResource{Resource: "persistentvolumeclaims"}
+	volumesResource := schema.GroupResource{Resource: "persistentvolumes"}
+
+	tpo.objectStore.EXPECT().
+		Get(gomock.Any(), store.Key{Namespace: "namespace", APIVersion: "v1", Kind: "PersistentVolumeClaim", Name: "data-web-0"}).
+		Return(testutil.ToUnstructured(t, claim), nil)
+	tpo.objectStore.EXPECT().
+		Get(gomock.Any(), store.Key{Namespace: "namespace", APIVersion: "v1", Kind: "PersistentVolumeClaim", Name: "data-web-1"}).
+		Return(nil, kerrors.NewNotFound(claimsResource, "data-web-1"))
+	tpo.objectStore.EXPECT().
+		Get(gomock.Any(), store.Key{Namespace: "namespace", APIVersion: "v1", Kind: "PersistentVolumeClaim", Name: "data-web-2"}).
+		Return(testutil.ToUnstructured(t, unboundClaim), nil)
+	tpo.objectStore.EXPECT().
+		Get(gomock.Any(), store.Key{APIVersion: "v1", Kind: "PersistentVolume", Name: "pv-0"}).
+		Return(testutil.ToUnstructured(t, volume), nil)
+	tpo.objectStore.EXPECT().
+		Get(gomock.Any(), store.Key{APIVersion: "v1", Kind: "PersistentVolume", Name: "pv-2"}).
+		Return(nil, kerrors.NewNotFound(volumesResource, "pv-2"))
+
+	tpo.link.EXPECT().ForObject(gomock.Any(), "data-web-0").Return(component.NewLink("", "data-web-0", "/data-web-0"), nil)
+	tpo.link.EXPECT().ForObject(gomock.Any(), "data-web-2").Return(component.NewLink("", "data-web-2", "/data-web-2"), nil)
+	tpo.link.EXPECT().ForObject(gomock.Any(), "pv-0").Return(component.NewLink("", "pv-0", "/pv-0"), nil)
+
+	got, err := createStatefulSetVolumeClaimsView(context.Background(), statefulSet, printOptions)
+	require.NoError(t, err)
+
+	statusText := func(s string, status component.TextStatus) *component.Text {
+		text := component.NewText(s)
+		text.SetStatus(status)
+		return text
+	}
+
+	expected := component.NewTableWithRows("Volume Claims", "We couldn't find any volume claims!", statefulSetVolumeClaimCols, []component.TableRow{
+		{
+			"Claim":          component.NewLink("", "data-web-0", "/data-web-0"),
+			"Ordinal":        component.NewText("0"),
+			"Template":       component.NewText("data"),
+			"Status":         statusText("Bound", component.TextStatusOK),
+			"Volume":         component.NewLink("", "pv-0", "/pv-0"),
+			"Capacity":       component.NewText("3Gi"),
+			"Reclaim Policy": component.NewText("Retain"),
+			"Storage Class":  component.NewText("manual"),
+		},
+		{
+			"Claim":          component.NewText("data-web-1"),
+			"Ordinal":        component.NewText("1"),
+			"Template":       component.NewText("data"),
+			"Status":         statusText("Missing", component.TextStatusWarning),
+			"Volume":         component.NewText(""),
+			"Capacity":       component.NewText(""),
+			"Reclaim Policy": component.NewText(""),
+			"Storage Class":  component.NewText(""),
+		},
+		{
+			"Claim":          component.NewLink("", "data-web-2", "/data-web-2"),
+			"Ordinal":        component.NewText("2"),
+			"Template":       component.NewText("data"),
+			"Status":         statusText("Lost", component.TextStatusError),
+			"Volume":         statusText("pv-2 (missing)", component.TextStatusError),
+			"Capacity":       component.NewText("10Gi"),
+			"Reclaim Policy": component.NewText(""),
+			"Storage Class":  component.NewText("manual"),
+		},
+	})
+
+	component.AssertEqual(t, expected, got)
+}
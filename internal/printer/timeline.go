@@ -0,0 +1,162 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+var (
+	objectTimelineCols = component.NewTableCols("Time", "Object", "Source", "Reason", "Message")
+)
+
+// timelineEntry is something which happened to an object or one of its owners.
+type timelineEntry struct {
+	time    time.Time
+	object  string
+	source  string
+	reason  string
+	message string
+}
+
+// objectTimeline creates a table of the events of an object, the events of its
+// owners, and the transitions of its status conditions in chronological order.
+func objectTimeline(ctx context.Context, object runtime.Object, events *corev1.EventList, o store.Store) (*component.Table, error) {
+	accessor, err := meta.Accessor(object)
+	if err != nil {
+		return nil, errors.Wrap(err, "get accessor for object")
+	}
+
+	kind := object.GetObjectKind().GroupVersionKind().Kind
+
+	var entries []timelineEntry
+	entries = append(entries, eventTimelineEntries(events)...)
+
+	for _, ownerReference := range accessor.GetOwnerReferences() {
+		ownerEvents, err := eventsForInvolvedObject(ctx, o, accessor.GetNamespace(),
+			ownerReference.APIVersion, ownerReference.Kind, ownerReference.Name)
+		if err != nil {
+			return nil, errors.Wrapf(err, "list events for owner %s %s", ownerReference.Kind, ownerReference.Name)
+		}
+		entries = append(entries, eventTimelineEntries(ownerEvents)...)
+	}
+
+	conditionEntries, err := conditionTimelineEntries(object, fmt.Sprintf("%s %s", kind, accessor.GetName()))
+	if err != nil {
+		return nil, err
+	}
+	entries = append(entries, conditionEntries...)
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].time.Before(entries[j].time)
+	})
+
+	table := component.NewTable("Timeline", "There is no history for this object", objectTimelineCols)
+	for _, entry := range entries {
+		table.Add(component.TableRow{
+			"Time":    component.NewTimestamp(entry.time),
+			"Object":  component.NewText(entry.object),
+			"Source":  component.NewText(entry.source),
+			"Reason":  component.NewText(entry.reason),
+			"Message": component.NewText(entry.message),
+		})
+	}
+
+	return table, nil
+}
+
+func eventTimelineEntries(events *corev1.EventList) []timelineEntry {
+	if events == nil {
+		return nil
+	}
+
+	var entries []timelineEntry
+	for _, event := range events.Items {
+		eventTime := event.LastTimestamp.Time
+		if eventTime.IsZero() {
+			eventTime = event.EventTime.Time
+		}
+		if eventTime.IsZero() {
+			continue
+		}
+
+		message := event.Message
+		if event.Count > 1 {
+			message = fmt.Sprintf("%s (x%d)", message, event.Count)
+		}
+
+		entries = append(entries, timelineEntry{
+			time:    eventTime,
+			object:  fmt.Sprintf("%s %s", event.InvolvedObject.Kind, event.InvolvedObject.Name),
+			source:  fmt.Sprintf("%s event", event.Type),
+			reason:  event.Reason,
+			message: message,
+		})
+	}
+
+	return entries
+}
+
+// conditionTimelineEntries creates an entry for the last transition of each of
+// an object's status conditions.
+func conditionTimelineEntries(object runtime.Object, name string) ([]timelineEntry, error) {
+	m, err := runtime.DefaultUnstructuredConverter.ToUnstructured(object)
+	if err != nil {
+		return nil, errors.Wrap(err, "convert object to unstructured")
+	}
+
+	conditions, found, err := unstructured.NestedSlice(m, "status", "conditions")
+	if err != nil || !found {
+		// Objects without a list of conditions have no transitions to show.
+		return nil, nil
+	}
+
+	var entries []timelineEntry
+	for _, item := range conditions {
+		condition, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		conditionType, _, _ := unstructured.NestedString(condition, "type")
+		status, _, _ := unstructured.NestedString(condition, "status")
+		reason, _, _ := unstructured.NestedString(condition, "reason")
+		message, _, _ := unstructured.NestedString(condition, "message")
+		lastTransitionTime, _, _ := unstructured.NestedString(condition, "lastTransitionTime")
+
+		transitionTime, err := time.Parse(time.RFC3339, lastTransitionTime)
+		if err != nil {
+			continue
+		}
+
+		description := fmt.Sprintf("%s changed to %s", conditionType, status)
+		if message != "" {
+			description = fmt.Sprintf("%s: %s", description, message)
+		}
+
+		entries = append(entries, timelineEntry{
+			time:    transitionTime,
+			object:  name,
+			source:  "Condition",
+			reason:  reason,
+			message: description,
+		})
+	}
+
+	return entries, nil
+}
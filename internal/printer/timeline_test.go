@@ -0,0 +1,105 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	storefake "github.com/vmware-tanzu/octant/pkg/store/fake"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func Test_objectTimeline(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	start := time.Date(2020, 10, 1, 14, 30, 0, 0, time.UTC)
+
+	pod := testutil.CreatePod("pod")
+	pod.OwnerReferences = testutil.ToOwnerReferences(t, testutil.CreateAppReplicaSet("replica-set"))
+	pod.Status.Conditions = []corev1.PodCondition{
+		{
+			Type:               corev1.PodReady,
+			Status:             corev1.ConditionFalse,
+			Reason:             "ContainersNotReady",
+			Message:            "containers with unready status: [app]",
+			LastTransitionTime: metav1.NewTime(start.Add(2 * time.Minute)),
+		},
+	}
+
+	podEvents := &corev1.EventList{
+		Items: []corev1.Event{
+			{
+				InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: "pod"},
+				Type:           corev1.EventTypeWarning,
+				Reason:         "BackOff",
+				Message:        "Back-off restarting failed container",
+				Count:          3,
+				LastTimestamp:  metav1.NewTime(start.Add(3 * time.Minute)),
+			},
+		},
+	}
+
+	replicaSetEvent := testutil.ToUnstructured(t, &corev1.Event{
+		TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Event"},
+		InvolvedObject: corev1.ObjectReference{
+			Namespace:  "namespace",
+			APIVersion: "apps/v1",
+			Kind:       "ReplicaSet",
+			Name:       "replica-set",
+		},
+		Type:          corev1.EventTypeNormal,
+		Reason:        "SuccessfulCreate",
+		Message:       "Created pod: pod",
+		Count:         1,
+		LastTimestamp: metav1.NewTime(start),
+	})
+
+	objectStore := storefake.NewMockStore(controller)
+	objectStore.EXPECT().
+		List(gomock.Any(), store.Key{Namespace: "namespace", APIVersion: "v1", Kind: "Event"}).
+		Return(&unstructured.UnstructuredList{Items: []unstructured.Unstructured{*replicaSetEvent}}, false, nil)
+
+	got, err := objectTimeline(context.Background(), pod, podEvents, objectStore)
+	require.NoError(t, err)
+
+	expected := component.NewTable("Timeline", "There is no history for this object", objectTimelineCols)
+	expected.Add(
+		component.TableRow{
+			"Time":    component.NewTimestamp(start),
+			"Object":  component.NewText("ReplicaSet replica-set"),
+			"Source":  component.NewText("Normal event"),
+			"Reason":  component.NewText("SuccessfulCreate"),
+			"Message": component.NewText("Created pod: pod"),
+		},
+		component.TableRow{
+			"Time":    component.NewTimestamp(start.Add(2 * time.Minute)),
+			"Object":  component.NewText("Pod pod"),
+			"Source":  component.NewText("Condition"),
+			"Reason":  component.NewText("ContainersNotReady"),
+			"Message": component.NewText("Ready changed to False: containers with unready status: [app]"),
+		},
+		component.TableRow{
+			"Time":    component.NewTimestamp(start.Add(3 * time.Minute)),
+			"Object":  component.NewText("Pod pod"),
+			"Source":  component.NewText("Warning event"),
+			"Reason":  component.NewText("BackOff"),
+			"Message": component.NewText("Back-off restarting failed container (x3)"),
+		},
+	)
+
+	component.AssertEqual(t, expected, got)
+}
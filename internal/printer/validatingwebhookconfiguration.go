@@ -22,7 +22,7 @@ func ValidatingWebhookConfigurationListHandler(ctx context.Context, list *admiss
 		return nil, errors.New("validating webhook configuration list is nil")
 	}
 
-	cols := component.NewTableCols("Name", "Age")
+	cols := component.NewTableCols("Name", "Webhooks", "Age")
 	ot := NewObjectTable("Validating Webhook Configurations", "We couldn't find any validating webhook configurations!", cols, options.DashConfig.ObjectStore())
 
 	for _, validatingWebhookConfiguration := range list.Items {
@@ -33,6 +33,7 @@ func ValidatingWebhookConfigurationListHandler(ctx context.Context, list *admiss
 		}
 
 		row["Name"] = nameLink
+		row["Webhooks"] = component.NewTextf("%d", len(validatingWebhookConfiguration.Webhooks))
 		ts := validatingWebhookConfiguration.CreationTimestamp.Time
 		row["Age"] = component.NewTimestamp(ts)
 
@@ -53,6 +54,10 @@ func ValidatingWebhookConfigurationHandler(ctx context.Context, validatingWebhoo
 		return nil, err
 	}
 
+	if err := ch.Overview(ctx, options); err != nil {
+		return nil, errors.Wrap(err, "print validatingwebhookconfiguration overview")
+	}
+
 	if err := ch.Webhooks(options); err != nil {
 		return nil, errors.Wrap(err, "print validatingwebhookconfiguration webhooks")
 	}
@@ -61,11 +66,13 @@ func ValidatingWebhookConfigurationHandler(ctx context.Context, validatingWebhoo
 }
 
 type validatingWebhookConfigurationObject interface {
+	Overview(ctx context.Context, options Options) error
 	Webhooks(options Options) error
 }
 
 type validatingWebhookConfigurationHandler struct {
 	validatingWebhookConfiguration *admissionregistrationv1.ValidatingWebhookConfiguration
+	overviewFunc                   func(context.Context, *admissionregistrationv1.ValidatingWebhookConfiguration, Options) (*component.Table, error)
 	webhookFunc                    func(*admissionregistrationv1.ValidatingWebhook, Options) (*component.Summary, error)
 	object                         *Object
 }
@@ -83,12 +90,23 @@ func newValidatingWebhookConfigurationHandler(validatingWebhookConfiguration *ad
 
 	ch := &validatingWebhookConfigurationHandler{
 		validatingWebhookConfiguration: validatingWebhookConfiguration,
+		overviewFunc:                   defaultValidatingWebhookConfigurationOverview,
 		webhookFunc:                    defaultValidatingWebhook,
 		object:                         object,
 	}
 	return ch, nil
 }
 
+func (c *validatingWebhookConfigurationHandler) Overview(ctx context.Context, options Options) error {
+	c.object.RegisterItems(ItemDescriptor{
+		Width: component.WidthFull,
+		Func: func() (component.Component, error) {
+			return c.overviewFunc(ctx, c.validatingWebhookConfiguration, options)
+		},
+	})
+	return nil
+}
+
 func (c *validatingWebhookConfigurationHandler) Webhooks(options Options) error {
 	for i := range c.validatingWebhookConfiguration.Webhooks {
 		webhook := &c.validatingWebhookConfiguration.Webhooks[i]
@@ -104,6 +122,19 @@ func (c *validatingWebhookConfigurationHandler) Webhooks(options Options) error
 	return nil
 }
 
+func defaultValidatingWebhookConfigurationOverview(ctx context.Context, validatingWebhookConfiguration *admissionregistrationv1.ValidatingWebhookConfiguration, options Options) (*component.Table, error) {
+	var webhooks []admissionWebhookOverview
+	for _, webhook := range validatingWebhookConfiguration.Webhooks {
+		webhooks = append(webhooks, admissionWebhookOverview{
+			name:              webhook.Name,
+			clientConfig:      webhook.ClientConfig,
+			failurePolicy:     webhook.FailurePolicy,
+			namespaceSelector: webhook.NamespaceSelector,
+		})
+	}
+	return admissionWebhooksTable(ctx, webhooks, options)
+}
+
 func defaultValidatingWebhook(validatingWebhook *admissionregistrationv1.ValidatingWebhook, options Options) (*component.Summary, error) {
 	return NewValidatingWebhook(validatingWebhook).Create(options)
 }
@@ -41,15 +41,16 @@ func Test_ValidatingWebhookConfigurationListHandler(t *testing.T) {
 	got, err := ValidatingWebhookConfigurationListHandler(ctx, list, printOptions)
 	require.NoError(t, err)
 
-	cols := component.NewTableCols("Name", "Age")
+	cols := component.NewTableCols("Name", "Webhooks", "Age")
 	expected := component.NewTable("Validating Webhook Configurations", "We couldn't find any validating webhook configurations!", cols)
 
 	expected.Add(component.TableRow{
 		"Name": component.NewLink("", object.Name, "/path",
 			genObjectStatus(component.TextStatusOK, []string{
-				"admissionregistration.k8s.io/v1 ValidatingWebhookConfiguration is OK",
+				"Webhooks are OK",
 			})),
-		"Age": component.NewTimestamp(now),
+		"Webhooks": component.NewText("0"),
+		"Age":      component.NewTimestamp(now),
 		component.GridActionKey: gridActionsFactory([]component.GridAction{
 			buildObjectDeleteAction(t, object),
 		}),
@@ -0,0 +1,71 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	storagev1 "k8s.io/api/storage/v1"
+
+	"github.com/vmware-tanzu/octant/internal/util/kubernetes"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+// persistentVolumeAttachments lists the volume attachments of a persistent volume.
+func persistentVolumeAttachments(ctx context.Context, persistentVolumeName string, options Options) ([]storagev1.VolumeAttachment, error) {
+	key := store.Key{
+		APIVersion: "storage.k8s.io/v1",
+		Kind:       "VolumeAttachment",
+	}
+
+	list, _, err := options.DashConfig.ObjectStore().List(ctx, key)
+	if err != nil {
+		return nil, errors.Wrapf(err, "list all objects for key %+v", key)
+	}
+
+	var attachments []storagev1.VolumeAttachment
+	for i := range list.Items {
+		attachment := storagev1.VolumeAttachment{}
+		if err := kubernetes.FromUnstructured(&list.Items[i], &attachment); err != nil {
+			return nil, err
+		}
+
+		source := attachment.Spec.Source.PersistentVolumeName
+		if source == nil || *source != persistentVolumeName {
+			continue
+		}
+
+		attachments = append(attachments, attachment)
+	}
+
+	return attachments, nil
+}
+
+// volumeAttachmentStatus describes whether a volume is attached to its node.
+// Attach and detach errors reported by the CSI driver are shown as is.
+func volumeAttachmentStatus(attachment storagev1.VolumeAttachment) *component.Text {
+	status := attachment.Status
+
+	var text *component.Text
+	switch {
+	case status.AttachError != nil:
+		text = component.NewText("Attach error: " + status.AttachError.Message)
+		text.SetStatus(component.TextStatusError)
+	case status.DetachError != nil:
+		text = component.NewText("Detach error: " + status.DetachError.Message)
+		text.SetStatus(component.TextStatusError)
+	case status.Attached:
+		text = component.NewText("Attached")
+		text.SetStatus(component.TextStatusOK)
+	default:
+		text = component.NewText("Attaching")
+		text.SetStatus(component.TextStatusWarning)
+	}
+
+	return text
+}
@@ -0,0 +1,82 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"testing"
+
+	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func createVolumeAttachment(name, persistentVolumeName, nodeName string, attached bool) *storagev1.VolumeAttachment {
+	return &storagev1.VolumeAttachment{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "storage.k8s.io/v1",
+			Kind:       "VolumeAttachment",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+			UID:  "uid",
+		},
+		Spec: storagev1.VolumeAttachmentSpec{
+			Attacher: "ebs.csi.aws.com",
+			Source: storagev1.VolumeAttachmentSource{
+				PersistentVolumeName: pointer.StringPtr(persistentVolumeName),
+			},
+			NodeName: nodeName,
+		},
+		Status: storagev1.VolumeAttachmentStatus{
+			Attached: attached,
+		},
+	}
+}
+
+func Test_volumeAttachmentStatus(t *testing.T) {
+	attached := component.NewText("Attached")
+	attached.SetStatus(component.TextStatusOK)
+
+	attaching := component.NewText("Attaching")
+	attaching.SetStatus(component.TextStatusWarning)
+
+	attachError := component.NewText("Attach error: volume is in use")
+	attachError.SetStatus(component.TextStatusError)
+
+	failed := createVolumeAttachment("failed", "pv", "node", false)
+	failed.Status.AttachError = &storagev1.VolumeError{Message: "volume is in use"}
+
+	tests := []struct {
+		name       string
+		attachment *storagev1.VolumeAttachment
+		expected   *component.Text
+	}{
+		{
+			name:       "attached",
+			attachment: createVolumeAttachment("attached", "pv", "node", true),
+			expected:   attached,
+		},
+		{
+			name:       "attaching",
+			attachment: createVolumeAttachment("attaching", "pv", "node", false),
+			expected:   attaching,
+		},
+		{
+			name:       "attach error",
+			attachment: failed,
+			expected:   attachError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := volumeAttachmentStatus(*test.attachment)
+			component.AssertEqual(t, test.expected, got)
+		})
+	}
+}
@@ -254,6 +254,7 @@ func (osq *ObjectStoreQueryer) Children(ctx context.Context, owner *unstructured
 				Namespace:  owner.GetNamespace(),
 				APIVersion: resourceList.GroupVersion,
 				Kind:       apiResource.Kind,
+				OwnerUID:   owner.GetUID(),
 			}
 
 			if osq.canList(apiResource) {
@@ -87,10 +87,12 @@ func TestCacheQueryer_Children(t *testing.T) {
 	rsKey, err := store.KeyFromObject(rs)
 	require.NoError(t, err)
 	rsKey.Name = ""
+	rsKey.OwnerUID = deployment.GetUID()
 
 	deploymentKey, err := store.KeyFromObject(deployment)
 	require.NoError(t, err)
 	deploymentKey.Name = ""
+	deploymentKey.OwnerUID = deployment.GetUID()
 
 	errGroup := &discovery.ErrGroupDiscoveryFailed{
 		Groups: map[schema.GroupVersion]error{
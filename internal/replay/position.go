@@ -0,0 +1,64 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package replay
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Position is the time of the recording a client is replaying. Each client has
+// its own position, so replaying doesn't change what other clients see. A nil
+// or zero position is live.
+type Position struct {
+	mu sync.Mutex
+	at time.Time
+}
+
+// NewPosition creates an instance of Position at a time. A zero time is live.
+func NewPosition(at time.Time) *Position {
+	return &Position{at: at}
+}
+
+// Time returns the time being replayed. ok is false if the position is live.
+func (p *Position) Time() (at time.Time, ok bool) {
+	if p == nil {
+		return time.Time{}, false
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.at, !p.at.IsZero()
+}
+
+// Set sets the time being replayed. A zero time returns the position to live.
+func (p *Position) Set(at time.Time) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.at = at
+}
+
+type positionKey struct{}
+
+// WithPosition returns a context whose objects are read at a replay position.
+func WithPosition(ctx context.Context, position *Position) context.Context {
+	return context.WithValue(ctx, positionKey{}, position)
+}
+
+// PositionFrom returns the replay position of ctx. It is nil if ctx doesn't have
+// one.
+func PositionFrom(ctx context.Context) *Position {
+	position, _ := ctx.Value(positionKey{}).(*Position)
+	return position
+}
+
+// TimeFrom returns the time ctx is replaying. ok is false if ctx is live.
+func TimeFrom(ctx context.Context) (time.Time, bool) {
+	return PositionFrom(ctx).Time()
+}
@@ -0,0 +1,197 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package replay
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	kcache "k8s.io/client-go/tools/cache"
+
+	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+//go:generate mockgen -destination=./fake/mock_manager.go -package=fake github.com/vmware-tanzu/octant/internal/replay Manager
+
+// Manager records changes to objects and sets the time the dashboard shows.
+type Manager interface {
+	// Kinds returns the kinds which are recorded.
+	Kinds() []schema.GroupVersionKind
+	// Range returns the times of the first and last recorded changes. ok is false
+	// if nothing has been recorded.
+	Range() (start, end time.Time, ok bool)
+	// Timeline divides the time from the start of the recording until now into
+	// marks.
+	Timeline(marks int) []Mark
+	// Changes returns the changes recorded at or before a time, most recent first.
+	Changes(at time.Time, limit int) []Event
+	// Objects returns the recorded objects of a kind which existed at a time.
+	Objects(gvk schema.GroupVersionKind, at time.Time) []*unstructured.Unstructured
+	// Seek returns the time of the recording a position is set to when replaying
+	// a time. Times before the recording are replayed from its start. Times which
+	// haven't happened yet return a zero time, which is live.
+	Seek(at time.Time) (time.Time, error)
+}
+
+// Recorder is a Manager which watches the objects of each recorded kind in the
+// object store and records their changes.
+type Recorder struct {
+	ctx         context.Context
+	objectStore store.Store
+	recording   *Recording
+	kinds       []schema.GroupVersionKind
+	now         func() time.Time
+
+	// watchMu guards the object store and its watches. Adding watches waits for
+	// informers to sync, so it has its own lock.
+	watchMu sync.Mutex
+	watches []store.WatchHandle
+}
+
+var _ Manager = (*Recorder)(nil)
+
+// NewRecorder creates an instance of Recorder. Objects still in the recording
+// from before it was created are recorded as deleted, since their changes since
+// then weren't seen.
+func NewRecorder(ctx context.Context, objectStore store.Store, recording *Recording, kinds []schema.GroupVersionKind) *Recorder {
+	r := &Recorder{
+		ctx:         ctx,
+		objectStore: objectStore,
+		recording:   recording,
+		kinds:       kinds,
+		now:         recording.now,
+	}
+
+	recording.Reset(r.now())
+
+	if objectStore == nil {
+		return r
+	}
+
+	// Watches belong to the store they were added to, so they have to be added
+	// again when the store is replaced after a context change. The objects of
+	// the previous context are recorded as deleted.
+	objectStore.RegisterOnUpdate(func(objectStore store.Store) {
+		r.watchMu.Lock()
+		for _, watch := range r.watches {
+			watch.Cancel()
+		}
+		r.watches = nil
+		r.objectStore = objectStore
+		r.watchMu.Unlock()
+
+		recording.Reset(r.now())
+
+		go r.watchAll()
+	})
+
+	// Watching waits for informers to sync, so it shouldn't hold up startup.
+	go r.watchAll()
+
+	return r
+}
+
+// watchAll watches the objects of every recorded kind.
+func (r *Recorder) watchAll() {
+	r.watchMu.Lock()
+	defer r.watchMu.Unlock()
+
+	handler := kcache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			r.record(EventAdded, obj)
+		},
+		UpdateFunc: func(_, newObj interface{}) {
+			r.record(EventUpdated, newObj)
+		},
+		DeleteFunc: func(obj interface{}) {
+			if tombstone, ok := obj.(kcache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			r.record(EventDeleted, obj)
+		},
+	}
+
+	for _, gvk := range r.kinds {
+		apiVersion, kind := gvk.ToAPIVersionAndKind()
+		key := store.Key{APIVersion: apiVersion, Kind: kind}
+		watch, err := r.objectStore.Watch(r.ctx, key, handler)
+		if err != nil {
+			log.From(r.ctx).WithErr(err).Errorf("unable to watch %s for recording", kind)
+			continue
+		}
+		r.watches = append(r.watches, watch)
+	}
+}
+
+func (r *Recorder) record(eventType EventType, obj interface{}) {
+	object, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return
+	}
+
+	// Managed fields are large and aren't shown, so they aren't recorded.
+	object = object.DeepCopy()
+	object.SetManagedFields(nil)
+
+	event := Event{Time: r.now(), Type: eventType, Object: object}
+	if err := r.recording.Record(event); err != nil {
+		log.From(r.ctx).WithErr(err).Errorf("record %s", object.GetKind())
+	}
+}
+
+// Kinds returns the kinds which are recorded.
+func (r *Recorder) Kinds() []schema.GroupVersionKind {
+	return r.kinds
+}
+
+// Range returns the times of the first and last recorded changes.
+func (r *Recorder) Range() (start, end time.Time, ok bool) {
+	return r.recording.Range()
+}
+
+// Timeline divides the time from the start of the recording until now into
+// marks.
+func (r *Recorder) Timeline(marks int) []Mark {
+	start, _, ok := r.recording.Range()
+	if !ok {
+		return nil
+	}
+	return r.recording.Timeline(start, r.now(), marks)
+}
+
+// Changes returns the changes recorded at or before a time.
+func (r *Recorder) Changes(at time.Time, limit int) []Event {
+	return r.recording.Changes(at, limit)
+}
+
+// Objects returns the recorded objects of a kind which existed at a time.
+func (r *Recorder) Objects(gvk schema.GroupVersionKind, at time.Time) []*unstructured.Unstructured {
+	return r.recording.Objects(gvk, at)
+}
+
+// Seek returns the time of the recording a position is set to when replaying
+// a time.
+func (r *Recorder) Seek(at time.Time) (time.Time, error) {
+	start, _, ok := r.recording.Range()
+	if !ok {
+		return time.Time{}, fmt.Errorf("nothing has been recorded")
+	}
+
+	if !at.Before(r.now()) {
+		return time.Time{}, nil
+	}
+
+	if at.Before(start) {
+		at = start
+	}
+
+	return at, nil
+}
@@ -0,0 +1,83 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package replay
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	kcache "k8s.io/client-go/tools/cache"
+
+	"github.com/vmware-tanzu/octant/pkg/store"
+	storeFake "github.com/vmware-tanzu/octant/pkg/store/fake"
+)
+
+func TestRecorder(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	start := time.Date(2020, 5, 1, 12, 0, 0, 0, time.UTC)
+	now := start
+	recording := NewRecording(afero.NewMemMapFs(), "", WithClock(func() time.Time { return now }))
+
+	handlers := make(chan kcache.ResourceEventHandler, 1)
+
+	objectStore := storeFake.NewMockStore(controller)
+	objectStore.EXPECT().RegisterOnUpdate(gomock.Any())
+	objectStore.EXPECT().
+		Watch(gomock.Any(), store.Key{APIVersion: "v1", Kind: "Pod"}, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ store.Key, handler kcache.ResourceEventHandler) (store.WatchHandle, error) {
+			handlers <- handler
+			return storeFake.NewMockWatchHandle(controller), nil
+		})
+
+	r := NewRecorder(context.Background(), objectStore, recording, []schema.GroupVersionKind{podGVK})
+
+	var handler kcache.ResourceEventHandler
+	select {
+	case handler = <-handlers:
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected pods to be watched")
+	}
+
+	_, err := r.Seek(start)
+	require.Error(t, err, "nothing has been recorded")
+
+	handler.OnAdd(pod("a", "1"))
+
+	now = start.Add(time.Minute)
+	handler.OnUpdate(pod("a", "1"), pod("a", "2"))
+
+	now = start.Add(2 * time.Minute)
+	handler.OnDelete(kcache.DeletedFinalStateUnknown{Key: "default/a", Obj: pod("a", "2")})
+
+	now = start.Add(30 * time.Minute)
+
+	assert.Equal(t, []schema.GroupVersionKind{podGVK}, r.Kinds())
+	assert.Equal(t, []string{"a@2"}, versions(r.Objects(podGVK, start.Add(time.Minute))))
+	assert.Len(t, r.Changes(now, 10), 3)
+	assert.Len(t, r.Timeline(3), 3)
+
+	// Times before the recording are replayed from its start.
+	at, err := r.Seek(start.Add(-time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, start, at)
+
+	at, err = r.Seek(start.Add(time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, start.Add(time.Minute), at)
+
+	// Times which haven't happened yet are live.
+	at, err = r.Seek(now.Add(time.Minute))
+	require.NoError(t, err)
+	assert.True(t, at.IsZero())
+}
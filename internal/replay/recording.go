@@ -0,0 +1,573 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package replay records the changes informers see to objects of selected kinds
+// and replays them, so the dashboard can show what the cluster looked like in
+// the past.
+package replay
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spf13/afero"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/vmware-tanzu/octant/internal/log"
+)
+
+const (
+	// DefaultRetention is how long recorded changes are kept.
+	DefaultRetention = time.Hour
+
+	// pruneInterval is how often changes older than the retention are removed.
+	pruneInterval = time.Minute
+)
+
+// EventType is the type of change to an object.
+type EventType string
+
+const (
+	// EventAdded is recorded when an object is added.
+	EventAdded EventType = "added"
+	// EventUpdated is recorded when an object is updated.
+	EventUpdated EventType = "updated"
+	// EventDeleted is recorded when an object is deleted.
+	EventDeleted EventType = "deleted"
+)
+
+// Event is a change to an object.
+type Event struct {
+	// Time is when the change was seen.
+	Time time.Time `json:"time"`
+	// Type is the type of change.
+	Type EventType `json:"type"`
+	// Object is the object after the change. It is the last state of the object
+	// if it was deleted.
+	Object *unstructured.Unstructured `json:"object"`
+}
+
+// Mark is a point on a recording's timeline.
+type Mark struct {
+	// Time is the time of the mark.
+	Time time.Time
+	// Changes is the number of changes recorded since the previous mark.
+	Changes int
+}
+
+// ParseKinds parses kinds written as apiVersion/Kind, such as v1/Pod or
+// apps/v1/Deployment.
+func ParseKinds(values []string) ([]schema.GroupVersionKind, error) {
+	var kinds []schema.GroupVersionKind
+	seen := map[schema.GroupVersionKind]bool{}
+
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+
+		i := strings.LastIndex(value, "/")
+		if i < 1 || i == len(value)-1 {
+			return nil, fmt.Errorf("kind %q is not written as apiVersion/Kind", value)
+		}
+
+		gv, err := schema.ParseGroupVersion(value[:i])
+		if err != nil {
+			return nil, fmt.Errorf("parse api version of %q: %w", value, err)
+		}
+
+		gvk := gv.WithKind(value[i+1:])
+		if !seen[gvk] {
+			seen[gvk] = true
+			kinds = append(kinds, gvk)
+		}
+	}
+
+	return kinds, nil
+}
+
+// RecordingOption is an option for configuring Recording.
+type RecordingOption func(r *Recording)
+
+// WithRetention sets how long changes are kept.
+func WithRetention(retention time.Duration) RecordingOption {
+	return func(r *Recording) {
+		if retention > 0 {
+			r.retention = retention
+		}
+	}
+}
+
+// WithClock sets the function which returns the current time.
+func WithClock(now func() time.Time) RecordingOption {
+	return func(r *Recording) {
+		r.now = now
+	}
+}
+
+// Recording keeps the changes to objects for the retention period. Changes are
+// appended to a file as JSON lines, so they can be replayed after a restart.
+// Changes are only kept in memory if the path is blank.
+//
+// Changes are recorded from informer handlers, so they are queued and written
+// to the file by Run rather than by Record.
+type Recording struct {
+	fs        afero.Fs
+	path      string
+	retention time.Duration
+	now       func() time.Time
+
+	mu sync.Mutex
+	// histories are the changes to each object by kind and UID, oldest first.
+	histories map[schema.GroupVersionKind]map[types.UID][]Event
+	lastPrune time.Time
+
+	// queueMu guards the changes waiting to be written. If rewrite is true, the
+	// file is replaced with the queued changes rather than appended to.
+	queueMu sync.Mutex
+	queued  []Event
+	rewrite bool
+	wake    chan struct{}
+
+	// fileMu guards the file, which stays open between writes.
+	fileMu sync.Mutex
+	file   afero.File
+}
+
+// NewRecording creates an instance of Recording.
+func NewRecording(fs afero.Fs, path string, options ...RecordingOption) *Recording {
+	r := &Recording{
+		fs:        fs,
+		path:      path,
+		retention: DefaultRetention,
+		now:       time.Now,
+		histories: map[schema.GroupVersionKind]map[types.UID][]Event{},
+		wake:      make(chan struct{}, 1),
+	}
+
+	for _, option := range options {
+		option(r)
+	}
+
+	r.lastPrune = r.now()
+
+	return r
+}
+
+// Load reads the changes saved to the file. Changes to objects which aren't one
+// of kinds are discarded, as are lines which can't be decoded.
+func (r *Recording) Load(kinds []schema.GroupVersionKind) error {
+	if r.path == "" {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	data, err := afero.ReadFile(r.fs, r.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("read %q: %w", r.path, err)
+	}
+
+	recorded := map[schema.GroupVersionKind]bool{}
+	for _, gvk := range kinds {
+		recorded[gvk] = true
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		var event Event
+		if err := json.Unmarshal(scanner.Bytes(), &event); err != nil || event.Object == nil {
+			continue
+		}
+		if !recorded[event.Object.GroupVersionKind()] {
+			continue
+		}
+		_, _ = r.add(event)
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("read %q: %w", r.path, err)
+	}
+
+	r.prune()
+	return nil
+}
+
+// Run writes queued changes to the file until ctx is done, then writes the
+// changes still queued and closes the file.
+func (r *Recording) Run(ctx context.Context) {
+	logger := log.From(ctx).With("context", "replay.Recording")
+
+	for {
+		select {
+		case <-ctx.Done():
+			if err := r.Close(); err != nil {
+				logger.WithErr(err).Errorf("close recording")
+			}
+			return
+		case <-r.wake:
+		}
+
+		if err := r.Flush(); err != nil {
+			logger.WithErr(err).Errorf("write recording")
+		}
+	}
+}
+
+// Record records a change and queues it to be written to the file. Updates
+// which don't change an object's resource version, such as informer resyncs,
+// aren't recorded.
+func (r *Recording) Record(event Event) error {
+	if event.Object == nil {
+		return fmt.Errorf("event has no object")
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	event, ok := r.add(event)
+	if !ok {
+		return nil
+	}
+
+	if r.now().Sub(r.lastPrune) >= pruneInterval {
+		r.prune()
+		return nil
+	}
+
+	r.queue([]Event{event}, false)
+	return nil
+}
+
+// Reset records the deletion of every object which exists at the end of the
+// recording. It is called when objects stop being watched, e.g. because the
+// cluster changed, so objects which can't be seen anymore aren't replayed.
+func (r *Recording) Reset(at time.Time) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var events []Event
+	for _, objects := range r.histories {
+		for _, history := range objects {
+			last := history[len(history)-1]
+			if last.Type == EventDeleted {
+				continue
+			}
+			events = append(events, Event{Time: at, Type: EventDeleted, Object: last.Object})
+		}
+	}
+
+	for i := range events {
+		events[i], _ = r.add(events[i])
+	}
+	r.queue(events, false)
+}
+
+// Objects returns the objects of a kind which existed at a time.
+func (r *Recording) Objects(gvk schema.GroupVersionKind, at time.Time) []*unstructured.Unstructured {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var objects []*unstructured.Unstructured
+	for _, history := range r.histories[gvk] {
+		i := sort.Search(len(history), func(i int) bool {
+			return history[i].Time.After(at)
+		}) - 1
+		if i < 0 || history[i].Type == EventDeleted {
+			continue
+		}
+		objects = append(objects, history[i].Object.DeepCopy())
+	}
+
+	return objects
+}
+
+// Range returns the times of the first and last changes. ok is false if no
+// changes are recorded.
+func (r *Recording) Range() (start, end time.Time, ok bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, objects := range r.histories {
+		for _, history := range objects {
+			first, last := history[0].Time, history[len(history)-1].Time
+			if !ok || first.Before(start) {
+				start = first
+			}
+			if !ok || last.After(end) {
+				end = last
+			}
+			ok = true
+		}
+	}
+
+	return start, end, ok
+}
+
+// Changes returns the changes recorded at or before a time, most recent first.
+// At most limit changes are returned.
+func (r *Recording) Changes(at time.Time, limit int) []Event {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var events []Event
+	for _, event := range r.events() {
+		if !event.Time.After(at) {
+			events = append(events, event)
+		}
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].Time.After(events[j].Time)
+	})
+
+	if limit > 0 && len(events) > limit {
+		events = events[:limit]
+	}
+
+	return events
+}
+
+// Timeline divides the time between start and end into marks, counting the
+// changes recorded before each mark.
+func (r *Recording) Timeline(start, end time.Time, marks int) []Mark {
+	if marks < 1 || !end.After(start) {
+		return nil
+	}
+
+	width := end.Sub(start) / time.Duration(marks)
+	timeline := make([]Mark, marks)
+	for i := range timeline {
+		timeline[i].Time = start.Add(width * time.Duration(i+1))
+	}
+	timeline[marks-1].Time = end
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, event := range r.events() {
+		if event.Time.Before(start) || event.Time.After(end) {
+			continue
+		}
+		i := sort.Search(marks, func(i int) bool {
+			return !timeline[i].Time.Before(event.Time)
+		})
+		if i < marks {
+			timeline[i].Changes++
+		}
+	}
+
+	return timeline
+}
+
+// add adds an event to the history of its object and returns the added event.
+// It returns false if the event doesn't change the object. It must be called
+// with the lock held.
+func (r *Recording) add(event Event) (Event, bool) {
+	gvk := event.Object.GroupVersionKind()
+	uid := event.Object.GetUID()
+
+	objects, ok := r.histories[gvk]
+	if !ok {
+		objects = map[types.UID][]Event{}
+		r.histories[gvk] = objects
+	}
+
+	history := objects[uid]
+	if n := len(history); n > 0 {
+		last := history[n-1]
+		if event.Type != EventDeleted && last.Type != EventDeleted &&
+			last.Object.GetResourceVersion() == event.Object.GetResourceVersion() {
+			return event, false
+		}
+		if event.Type == EventDeleted && last.Type == EventDeleted {
+			return event, false
+		}
+		// Histories are searched by time, so they have to stay in order.
+		if event.Time.Before(last.Time) {
+			event.Time = last.Time
+		}
+	}
+
+	objects[uid] = append(history, event)
+	return event, true
+}
+
+// events returns every recorded change, oldest first. It must be called with
+// the lock held.
+func (r *Recording) events() []Event {
+	var events []Event
+	for _, objects := range r.histories {
+		for _, history := range objects {
+			events = append(events, history...)
+		}
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].Time.Before(events[j].Time)
+	})
+
+	return events
+}
+
+// prune removes changes older than the retention and queues the file to be
+// rewritten. The last change to an object before the retention is kept if the
+// object still existed, so the object can be replayed. It must be called with
+// the lock held.
+func (r *Recording) prune() {
+	now := r.now()
+	cutoff := now.Add(-r.retention)
+	r.lastPrune = now
+
+	for gvk, objects := range r.histories {
+		for uid, history := range objects {
+			i := sort.Search(len(history), func(i int) bool {
+				return history[i].Time.After(cutoff)
+			}) - 1
+			if i >= 0 {
+				if history[i].Type == EventDeleted {
+					history = history[i+1:]
+				} else {
+					history = history[i:]
+				}
+			}
+
+			if len(history) == 0 {
+				delete(objects, uid)
+				continue
+			}
+			objects[uid] = history
+		}
+
+		if len(objects) == 0 {
+			delete(r.histories, gvk)
+		}
+	}
+
+	r.queue(r.events(), true)
+}
+
+// queue queues changes to be written to the file. If rewrite is true, the file
+// is replaced with events, so changes queued before are dropped. It must be
+// called with the lock held, so changes are written in the order they were
+// recorded.
+func (r *Recording) queue(events []Event, rewrite bool) {
+	if r.path == "" {
+		return
+	}
+
+	r.queueMu.Lock()
+	if rewrite {
+		r.queued = nil
+		r.rewrite = true
+	}
+	r.queued = append(r.queued, events...)
+	r.queueMu.Unlock()
+
+	select {
+	case r.wake <- struct{}{}:
+	default:
+	}
+}
+
+// Flush writes the queued changes to the file.
+func (r *Recording) Flush() error {
+	r.fileMu.Lock()
+	defer r.fileMu.Unlock()
+
+	r.queueMu.Lock()
+	events, rewrite := r.queued, r.rewrite
+	r.queued, r.rewrite = nil, false
+	r.queueMu.Unlock()
+
+	if len(events) == 0 && !rewrite {
+		return nil
+	}
+
+	var buf bytes.Buffer
+	for _, event := range events {
+		data, err := json.Marshal(event)
+		if err != nil {
+			return fmt.Errorf("encode event: %w", err)
+		}
+		buf.Write(data)
+		buf.WriteByte('\n')
+	}
+
+	if err := r.fs.MkdirAll(filepath.Dir(r.path), 0700); err != nil {
+		return fmt.Errorf("create directory for %q: %w", r.path, err)
+	}
+
+	if rewrite {
+		return r.replace(buf.Bytes())
+	}
+
+	if r.file == nil {
+		f, err := r.fs.OpenFile(r.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+		if err != nil {
+			return fmt.Errorf("open %q: %w", r.path, err)
+		}
+		r.file = f
+	}
+
+	if _, err := r.file.Write(buf.Bytes()); err != nil {
+		return fmt.Errorf("write %q: %w", r.path, err)
+	}
+
+	return nil
+}
+
+// Close writes the queued changes and closes the file.
+func (r *Recording) Close() error {
+	err := r.Flush()
+
+	r.fileMu.Lock()
+	defer r.fileMu.Unlock()
+
+	if r.file != nil {
+		if closeErr := r.file.Close(); err == nil {
+			err = closeErr
+		}
+		r.file = nil
+	}
+
+	return err
+}
+
+// replace replaces the file with data. The open file is closed, since it refers
+// to the file which was replaced. It must be called with fileMu held.
+func (r *Recording) replace(data []byte) error {
+	if r.file != nil {
+		_ = r.file.Close()
+		r.file = nil
+	}
+
+	tmp := r.path + ".tmp"
+	if err := afero.WriteFile(r.fs, tmp, data, 0600); err != nil {
+		return fmt.Errorf("write %q: %w", tmp, err)
+	}
+
+	if err := r.fs.Rename(tmp, r.path); err != nil {
+		return fmt.Errorf("replace %q: %w", r.path, err)
+	}
+
+	return nil
+}
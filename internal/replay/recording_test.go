@@ -0,0 +1,201 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package replay
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var podGVK = schema.GroupVersionKind{Version: "v1", Kind: "Pod"}
+
+func pod(name, resourceVersion string) *unstructured.Unstructured {
+	u := &unstructured.Unstructured{}
+	u.SetAPIVersion("v1")
+	u.SetKind("Pod")
+	u.SetNamespace("default")
+	u.SetName(name)
+	u.SetUID(types.UID(name + "-uid"))
+	u.SetResourceVersion(resourceVersion)
+	return u
+}
+
+// versions describes objects as name@resourceVersion sorted by name.
+func versions(objects []*unstructured.Unstructured) []string {
+	var got []string
+	for _, object := range objects {
+		got = append(got, object.GetName()+"@"+object.GetResourceVersion())
+	}
+	sort.Strings(got)
+	return got
+}
+
+func TestParseKinds(t *testing.T) {
+	got, err := ParseKinds([]string{"v1/Pod", " apps/v1/Deployment ", "v1/Pod", ""})
+	require.NoError(t, err)
+	assert.Equal(t, []schema.GroupVersionKind{
+		{Version: "v1", Kind: "Pod"},
+		{Group: "apps", Version: "v1", Kind: "Deployment"},
+	}, got)
+
+	for _, value := range []string{"Pod", "v1/", "/Pod", "a/b/c/Pod"} {
+		_, err := ParseKinds([]string{value})
+		assert.Error(t, err, value)
+	}
+}
+
+func TestRecording_Objects(t *testing.T) {
+	start := time.Date(2020, 5, 1, 12, 0, 0, 0, time.UTC)
+	r := NewRecording(afero.NewMemMapFs(), "", WithClock(func() time.Time { return start }))
+
+	require.NoError(t, r.Record(Event{Time: start, Type: EventAdded, Object: pod("a", "1")}))
+	require.NoError(t, r.Record(Event{Time: start.Add(time.Minute), Type: EventUpdated, Object: pod("a", "2")}))
+	require.NoError(t, r.Record(Event{Time: start.Add(time.Minute), Type: EventAdded, Object: pod("b", "3")}))
+	// Resyncs don't change the resource version, so they aren't recorded.
+	require.NoError(t, r.Record(Event{Time: start.Add(90 * time.Second), Type: EventUpdated, Object: pod("b", "3")}))
+	require.NoError(t, r.Record(Event{Time: start.Add(2 * time.Minute), Type: EventDeleted, Object: pod("a", "2")}))
+
+	assert.Empty(t, r.Objects(podGVK, start.Add(-time.Second)))
+	assert.Equal(t, []string{"a@1"}, versions(r.Objects(podGVK, start)))
+	assert.Equal(t, []string{"a@2", "b@3"}, versions(r.Objects(podGVK, start.Add(time.Minute))))
+	assert.Equal(t, []string{"b@3"}, versions(r.Objects(podGVK, start.Add(time.Hour))))
+	assert.Empty(t, r.Objects(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}, start))
+
+	first, last, ok := r.Range()
+	require.True(t, ok)
+	assert.Equal(t, start, first)
+	assert.Equal(t, start.Add(2*time.Minute), last)
+
+	changes := r.Changes(start.Add(time.Minute), 0)
+	require.Len(t, changes, 3)
+	assert.Equal(t, EventAdded, changes[2].Type)
+	assert.Equal(t, start, changes[2].Time)
+	assert.Len(t, r.Changes(start.Add(time.Hour), 2), 2)
+}
+
+func TestRecording_Load(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	start := time.Date(2020, 5, 1, 12, 0, 0, 0, time.UTC)
+	now := func() time.Time { return start.Add(5 * time.Minute) }
+
+	r := NewRecording(fs, "/state/recording.jsonl", WithClock(now))
+	require.NoError(t, r.Record(Event{Time: start, Type: EventAdded, Object: pod("a", "1")}))
+	require.NoError(t, r.Record(Event{Time: start.Add(time.Minute), Type: EventUpdated, Object: pod("a", "2")}))
+	require.NoError(t, r.Flush())
+
+	loaded := NewRecording(fs, "/state/recording.jsonl", WithClock(now))
+	require.NoError(t, loaded.Load([]schema.GroupVersionKind{podGVK}))
+	assert.Equal(t, []string{"a@1"}, versions(loaded.Objects(podGVK, start)))
+	assert.Equal(t, []string{"a@2"}, versions(loaded.Objects(podGVK, start.Add(time.Minute))))
+
+	// Kinds which aren't recorded anymore are discarded.
+	other := NewRecording(fs, "/state/recording.jsonl", WithClock(now))
+	require.NoError(t, other.Load([]schema.GroupVersionKind{{Group: "apps", Version: "v1", Kind: "Deployment"}}))
+	_, _, ok := other.Range()
+	assert.False(t, ok)
+
+	require.NoError(t, NewRecording(fs, "/state/missing.jsonl").Load([]schema.GroupVersionKind{podGVK}))
+}
+
+func TestRecording_prune(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	start := time.Date(2020, 5, 1, 12, 0, 0, 0, time.UTC)
+	now := start
+	clock := func() time.Time { return now }
+
+	r := NewRecording(fs, "/state/recording.jsonl", WithClock(clock), WithRetention(10*time.Minute))
+	require.NoError(t, r.Record(Event{Time: start, Type: EventAdded, Object: pod("a", "1")}))
+	require.NoError(t, r.Record(Event{Time: start.Add(time.Minute), Type: EventAdded, Object: pod("b", "2")}))
+	require.NoError(t, r.Record(Event{Time: start.Add(2 * time.Minute), Type: EventDeleted, Object: pod("b", "2")}))
+
+	now = start.Add(20 * time.Minute)
+	require.NoError(t, r.Record(Event{Time: now, Type: EventAdded, Object: pod("c", "3")}))
+
+	// a existed before the retention, so its last change is kept. b was deleted
+	// before the retention, so it is removed.
+	assert.Equal(t, []string{"a@1", "c@3"}, versions(r.Objects(podGVK, now)))
+	assert.Len(t, r.Changes(now, 0), 2)
+	require.NoError(t, r.Flush())
+
+	loaded := NewRecording(fs, "/state/recording.jsonl", WithClock(clock), WithRetention(10*time.Minute))
+	require.NoError(t, loaded.Load([]schema.GroupVersionKind{podGVK}))
+	assert.Equal(t, []string{"a@1", "c@3"}, versions(loaded.Objects(podGVK, now)))
+	assert.Len(t, loaded.Changes(now, 0), 2)
+}
+
+func TestRecording_Run(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	start := time.Date(2020, 5, 1, 12, 0, 0, 0, time.UTC)
+	now := func() time.Time { return start }
+
+	r := NewRecording(fs, "/state/recording.jsonl", WithClock(now))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		r.Run(ctx)
+	}()
+
+	require.NoError(t, r.Record(Event{Time: start, Type: EventAdded, Object: pod("a", "1")}))
+	require.NoError(t, r.Record(Event{Time: start, Type: EventAdded, Object: pod("b", "2")}))
+
+	// Changes still queued are written when the recording stops.
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("recording didn't stop")
+	}
+	assert.Nil(t, r.file)
+
+	loaded := NewRecording(fs, "/state/recording.jsonl", WithClock(now))
+	require.NoError(t, loaded.Load([]schema.GroupVersionKind{podGVK}))
+	assert.Equal(t, []string{"a@1", "b@2"}, versions(loaded.Objects(podGVK, start)))
+}
+
+func TestRecording_Reset(t *testing.T) {
+	start := time.Date(2020, 5, 1, 12, 0, 0, 0, time.UTC)
+	r := NewRecording(afero.NewMemMapFs(), "", WithClock(func() time.Time { return start }))
+
+	require.NoError(t, r.Record(Event{Time: start, Type: EventAdded, Object: pod("a", "1")}))
+	r.Reset(start.Add(time.Minute))
+
+	assert.Equal(t, []string{"a@1"}, versions(r.Objects(podGVK, start)))
+	assert.Empty(t, r.Objects(podGVK, start.Add(time.Minute)))
+
+	// The object is seen again after the reset.
+	require.NoError(t, r.Record(Event{Time: start.Add(2 * time.Minute), Type: EventAdded, Object: pod("a", "1")}))
+	assert.Equal(t, []string{"a@1"}, versions(r.Objects(podGVK, start.Add(2*time.Minute))))
+}
+
+func TestRecording_Timeline(t *testing.T) {
+	start := time.Date(2020, 5, 1, 12, 0, 0, 0, time.UTC)
+	r := NewRecording(afero.NewMemMapFs(), "", WithClock(func() time.Time { return start }))
+
+	require.NoError(t, r.Record(Event{Time: start, Type: EventAdded, Object: pod("a", "1")}))
+	require.NoError(t, r.Record(Event{Time: start.Add(time.Minute), Type: EventAdded, Object: pod("b", "2")}))
+	require.NoError(t, r.Record(Event{Time: start.Add(90 * time.Second), Type: EventUpdated, Object: pod("b", "3")}))
+
+	got := r.Timeline(start, start.Add(4*time.Minute), 4)
+	assert.Equal(t, []Mark{
+		{Time: start.Add(time.Minute), Changes: 2},
+		{Time: start.Add(2 * time.Minute), Changes: 1},
+		{Time: start.Add(3 * time.Minute), Changes: 0},
+		{Time: start.Add(4 * time.Minute), Changes: 0},
+	}, got)
+
+	assert.Nil(t, r.Timeline(start, start, 4))
+}
@@ -0,0 +1,219 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package replay
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware-tanzu/octant/internal/contentcache"
+	"github.com/vmware-tanzu/octant/internal/objectstore"
+	"github.com/vmware-tanzu/octant/internal/util/kubernetes"
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+// ErrReplaying is returned when objects are changed while a recording is being
+// replayed.
+var ErrReplaying = errors.New("objects can't be changed while a recording is replayed; return to live first")
+
+// Store is an object store which lists and gets the objects of recorded kinds
+// from the recording when the context has a replay position. Everything else is
+// read from the live object store. Objects can't be changed while replaying,
+// since the client doesn't see their current state.
+type Store struct {
+	store.Store
+	manager Manager
+}
+
+var _ store.Store = (*Store)(nil)
+
+// NewStore creates an instance of Store.
+func NewStore(objectStore store.Store, manager Manager) *Store {
+	return &Store{
+		Store:   objectStore,
+		manager: manager,
+	}
+}
+
+// replaying returns the time replayed for key. ok is false if key's objects are
+// read from the live object store.
+func (s *Store) replaying(ctx context.Context, key store.Key) (time.Time, bool) {
+	at, ok := TimeFrom(ctx)
+	if !ok {
+		return time.Time{}, false
+	}
+
+	gvk := key.GroupVersionKind()
+	for _, recorded := range s.manager.Kinds() {
+		if recorded == gvk {
+			return at, true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+// List lists objects.
+func (s *Store) List(ctx context.Context, key store.Key) (list *unstructured.UnstructuredList, loading bool, err error) {
+	at, ok := s.replaying(ctx, key)
+	if !ok {
+		return s.Store.List(ctx, key)
+	}
+
+	defer func() {
+		contentcache.RecordList(ctx, key, list, loading, err)
+	}()
+
+	if key.Selector != nil && key.LabelSelector != nil {
+		return nil, false, fmt.Errorf("must provide only one of Key.Selector and Key.LabelSelector")
+	}
+
+	selector := labels.Everything()
+	if key.Selector != nil {
+		selector = key.Selector.AsSelector()
+	} else if key.LabelSelector != nil {
+		selector, err = metav1.LabelSelectorAsSelector(key.LabelSelector)
+		if err != nil {
+			return nil, false, err
+		}
+	}
+
+	list = &unstructured.UnstructuredList{}
+	for _, object := range s.manager.Objects(key.GroupVersionKind(), at) {
+		if !matches(object, key, selector) {
+			continue
+		}
+		list.Items = append(list.Items, *object)
+	}
+
+	kubernetes.SortObjects(list.Items)
+
+	return list, false, nil
+}
+
+// Get gets an object.
+func (s *Store) Get(ctx context.Context, key store.Key) (object *unstructured.Unstructured, err error) {
+	at, ok := s.replaying(ctx, key)
+	if !ok {
+		return s.Store.Get(ctx, key)
+	}
+
+	defer func() {
+		contentcache.RecordGet(ctx, key, object, err)
+	}()
+
+	for _, object := range s.manager.Objects(key.GroupVersionKind(), at) {
+		if object.GetName() == key.Name && object.GetNamespace() == key.Namespace {
+			return object, nil
+		}
+	}
+
+	gvk := key.GroupVersionKind()
+	resource := schema.GroupResource{Group: gvk.Group, Resource: strings.ToLower(gvk.Kind)}
+	return nil, kerrors.NewNotFound(resource, key.Name)
+}
+
+// IsLoading returns false for replayed keys since recordings are always loaded.
+func (s *Store) IsLoading(ctx context.Context, key store.Key) bool {
+	if _, ok := s.replaying(ctx, key); ok {
+		return false
+	}
+	return s.Store.IsLoading(ctx, key)
+}
+
+// RegisterOnUpdate registers a function which is called with this store when the
+// live object store updates its client.
+func (s *Store) RegisterOnUpdate(fn store.UpdateFn) {
+	s.Store.RegisterOnUpdate(func(store.Store) {
+		fn(s)
+	})
+}
+
+// Delete deletes an object if a recording isn't being replayed.
+func (s *Store) Delete(ctx context.Context, key store.Key) error {
+	if _, ok := TimeFrom(ctx); ok {
+		return ErrReplaying
+	}
+	return s.Store.Delete(ctx, key)
+}
+
+// Update updates an object if a recording isn't being replayed.
+func (s *Store) Update(ctx context.Context, key store.Key, updater func(*unstructured.Unstructured) error) error {
+	if _, ok := TimeFrom(ctx); ok {
+		return ErrReplaying
+	}
+	return s.Store.Update(ctx, key, updater)
+}
+
+// Create creates an object if a recording isn't being replayed.
+func (s *Store) Create(ctx context.Context, object *unstructured.Unstructured) error {
+	if _, ok := TimeFrom(ctx); ok {
+		return ErrReplaying
+	}
+	return s.Store.Create(ctx, object)
+}
+
+// CreateOrUpdateFromYAML creates or updates objects if a recording isn't being
+// replayed.
+func (s *Store) CreateOrUpdateFromYAML(ctx context.Context, namespace, input string) ([]string, error) {
+	if _, ok := TimeFrom(ctx); ok {
+		return nil, ErrReplaying
+	}
+	return s.Store.CreateOrUpdateFromYAML(ctx, namespace, input)
+}
+
+// CachedKeys lists the keys the live object store has cached.
+func (s *Store) CachedKeys() []store.Key {
+	lister, ok := s.Store.(interface{ CachedKeys() []store.Key })
+	if !ok {
+		return nil
+	}
+	return lister.CachedKeys()
+}
+
+// StaleInformer returns the status of the live informer for key if its objects
+// may be out of date. Content shown while replaying says so instead.
+func (s *Store) StaleInformer(key store.Key) (objectstore.InformerStatus, bool) {
+	checker, ok := s.Store.(interface {
+		StaleInformer(key store.Key) (objectstore.InformerStatus, bool)
+	})
+	if !ok {
+		return objectstore.InformerStatus{}, false
+	}
+	return checker.StaleInformer(key)
+}
+
+// matches returns true if an object is selected by a key.
+func matches(object *unstructured.Unstructured, key store.Key, selector labels.Selector) bool {
+	if key.Namespace != "" && object.GetNamespace() != key.Namespace {
+		return false
+	}
+	if key.Name != "" && object.GetName() != key.Name {
+		return false
+	}
+	if !selector.Matches(labels.Set(object.GetLabels())) {
+		return false
+	}
+	if key.OwnerUID == "" {
+		return true
+	}
+
+	for _, ownerReference := range object.GetOwnerReferences() {
+		if ownerReference.UID == key.OwnerUID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,130 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package replay_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware-tanzu/octant/internal/replay"
+	replayFake "github.com/vmware-tanzu/octant/internal/replay/fake"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	storeFake "github.com/vmware-tanzu/octant/pkg/store/fake"
+)
+
+func recordedPod(namespace, name string, podLabels map[string]string) *unstructured.Unstructured {
+	u := &unstructured.Unstructured{}
+	u.SetAPIVersion("v1")
+	u.SetKind("Pod")
+	u.SetNamespace(namespace)
+	u.SetName(name)
+	u.SetLabels(podLabels)
+	return u
+}
+
+func TestStore_replaying(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	at := time.Date(2020, 5, 1, 12, 0, 0, 0, time.UTC)
+	podGVK := schema.GroupVersionKind{Version: "v1", Kind: "Pod"}
+
+	manager := replayFake.NewMockManager(controller)
+	manager.EXPECT().Kinds().Return([]schema.GroupVersionKind{podGVK}).AnyTimes()
+	manager.EXPECT().Objects(podGVK, at).Return([]*unstructured.Unstructured{
+		recordedPod("default", "web", map[string]string{"app": "web"}),
+		recordedPod("default", "db", map[string]string{"app": "db"}),
+		recordedPod("other", "web", map[string]string{"app": "web"}),
+	}).AnyTimes()
+
+	objectStore := storeFake.NewMockStore(controller)
+	s := replay.NewStore(objectStore, manager)
+
+	ctx := replay.WithPosition(context.Background(), replay.NewPosition(at))
+
+	selector := labels.Set{"app": "web"}
+	list, loading, err := s.List(ctx, store.Key{APIVersion: "v1", Kind: "Pod", Namespace: "default", Selector: &selector})
+	require.NoError(t, err)
+	assert.False(t, loading)
+	require.Len(t, list.Items, 1)
+	assert.Equal(t, "web", list.Items[0].GetName())
+	assert.Equal(t, "default", list.Items[0].GetNamespace())
+
+	list, _, err = s.List(ctx, store.Key{APIVersion: "v1", Kind: "Pod"})
+	require.NoError(t, err)
+	assert.Len(t, list.Items, 3)
+
+	object, err := s.Get(ctx, store.Key{APIVersion: "v1", Kind: "Pod", Namespace: "other", Name: "web"})
+	require.NoError(t, err)
+	assert.Equal(t, "other", object.GetNamespace())
+
+	_, err = s.Get(ctx, store.Key{APIVersion: "v1", Kind: "Pod", Namespace: "default", Name: "missing"})
+	assert.True(t, kerrors.IsNotFound(err))
+
+	// Kinds which aren't recorded are live.
+	serviceKey := store.Key{APIVersion: "v1", Kind: "Service", Namespace: "default"}
+	objectStore.EXPECT().List(gomock.Any(), serviceKey).Return(&unstructured.UnstructuredList{}, false, nil)
+	_, _, err = s.List(ctx, serviceKey)
+	require.NoError(t, err)
+
+	assert.False(t, s.IsLoading(ctx, store.Key{APIVersion: "v1", Kind: "Pod"}))
+
+	err = s.Delete(ctx, serviceKey)
+	assert.Equal(t, replay.ErrReplaying, err)
+	_, err = s.CreateOrUpdateFromYAML(ctx, "default", "")
+	assert.Equal(t, replay.ErrReplaying, err)
+
+	// Other clients are live.
+	podKey := store.Key{APIVersion: "v1", Kind: "Pod", Namespace: "default", Name: "web"}
+	objectStore.EXPECT().Get(gomock.Any(), podKey).Return(recordedPod("default", "web", nil), nil)
+	live := replay.WithPosition(context.Background(), replay.NewPosition(time.Time{}))
+	_, err = s.Get(live, podKey)
+	require.NoError(t, err)
+}
+
+func TestStore_live(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	manager := replayFake.NewMockManager(controller)
+
+	key := store.Key{APIVersion: "v1", Kind: "Pod", Namespace: "default", Name: "web"}
+	object := recordedPod("default", "web", nil)
+
+	objectStore := storeFake.NewMockStore(controller)
+	objectStore.EXPECT().Get(gomock.Any(), key).Return(object, nil)
+	objectStore.EXPECT().Delete(gomock.Any(), key).Return(nil)
+
+	s := replay.NewStore(objectStore, manager)
+
+	got, err := s.Get(context.Background(), key)
+	require.NoError(t, err)
+	assert.Equal(t, object, got)
+
+	require.NoError(t, s.Delete(context.Background(), key))
+
+	// The store passes itself to functions registered for updates.
+	var updateFn store.UpdateFn
+	objectStore.EXPECT().RegisterOnUpdate(gomock.Any()).Do(func(fn store.UpdateFn) {
+		updateFn = fn
+	})
+
+	var updated store.Store
+	s.RegisterOnUpdate(func(objectStore store.Store) {
+		updated = objectStore
+	})
+	updateFn(objectStore)
+	assert.Equal(t, s, updated)
+}
@@ -0,0 +1,201 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package search finds objects in the object store's informer caches.
+package search
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+// DefaultLimit is the number of results returned when a query doesn't set a limit.
+const DefaultLimit = 50
+
+// CachedKeyLister lists the keys an object store has cached.
+type CachedKeyLister interface {
+	CachedKeys() []store.Key
+}
+
+// Query is a search query.
+type Query struct {
+	// Text matches objects whose name contains it, ignoring case.
+	Text string
+	// Selector matches objects by label.
+	Selector labels.Selector
+	// Namespace restricts results to a namespace. Cluster scoped objects are
+	// only returned if it is empty.
+	Namespace string
+	// Limit is the maximum number of results.
+	Limit int
+}
+
+// NewQuery creates a query from text and a label selector such as "app=nginx,tier!=db".
+func NewQuery(text, selector, namespace string) (Query, error) {
+	q := Query{
+		Text:      strings.TrimSpace(text),
+		Namespace: namespace,
+		Limit:     DefaultLimit,
+	}
+
+	if strings.TrimSpace(selector) != "" {
+		parsed, err := labels.Parse(selector)
+		if err != nil {
+			return Query{}, errors.Wrapf(err, "parse label selector %q", selector)
+		}
+		q.Selector = parsed
+	}
+
+	if q.Text == "" && q.Selector == nil {
+		return Query{}, errors.New("query requires text or a label selector")
+	}
+
+	return q, nil
+}
+
+func (q Query) matches(object *unstructured.Unstructured) bool {
+	if q.Namespace != "" && object.GetNamespace() != q.Namespace {
+		return false
+	}
+	if q.Text != "" && !strings.Contains(strings.ToLower(object.GetName()), strings.ToLower(q.Text)) {
+		return false
+	}
+	if q.Selector != nil && !q.Selector.Matches(labels.Set(object.GetLabels())) {
+		return false
+	}
+	return true
+}
+
+// Result is an object which matched a query.
+type Result struct {
+	APIVersion string            `json:"apiVersion"`
+	Kind       string            `json:"kind"`
+	Namespace  string            `json:"namespace,omitempty"`
+	Name       string            `json:"name"`
+	Labels     map[string]string `json:"labels,omitempty"`
+	// Ref is the path to the object in Octant. It is empty if Octant has no view
+	// for the object's type.
+	Ref string `json:"ref,omitempty"`
+}
+
+// Searcher searches cached objects.
+type Searcher struct {
+	objectStore store.Store
+	linker      octant.LinkGenerator
+}
+
+// NewSearcher creates an instance of Searcher.
+func NewSearcher(objectStore store.Store, linker octant.LinkGenerator) *Searcher {
+	return &Searcher{
+		objectStore: objectStore,
+		linker:      linker,
+	}
+}
+
+// Search returns objects matching a query. Only types the object store already has
+// informers for are searched, so a search never starts watching new resources.
+func (s *Searcher) Search(ctx context.Context, q Query) ([]Result, error) {
+	logger := log.From(ctx).With("context", "search")
+
+	lister, ok := s.objectStore.(CachedKeyLister)
+	if !ok {
+		return nil, errors.New("object store does not support search")
+	}
+
+	// Namespaced objects are in both the cluster wide and namespace informers.
+	seen := map[string]bool{}
+	var results []Result
+
+	for _, key := range lister.CachedKeys() {
+		if q.Namespace != "" && key.Namespace != "" && key.Namespace != q.Namespace {
+			continue
+		}
+
+		list, _, err := s.objectStore.List(ctx, key)
+		if err != nil {
+			logger.WithErr(err).With("key", key).Debugf("unable to list objects for search")
+			continue
+		}
+
+		for i := range list.Items {
+			object := &list.Items[i]
+			id := strings.Join([]string{key.APIVersion, key.Kind, object.GetNamespace(), object.GetName()}, "/")
+			if seen[id] || !q.matches(object) {
+				continue
+			}
+			seen[id] = true
+
+			results = append(results, s.result(key, object))
+		}
+	}
+
+	sortResults(results, q.Text)
+
+	if q.Limit > 0 && len(results) > q.Limit {
+		results = results[:q.Limit]
+	}
+
+	return results, nil
+}
+
+func (s *Searcher) result(key store.Key, object *unstructured.Unstructured) Result {
+	r := Result{
+		APIVersion: key.APIVersion,
+		Kind:       key.Kind,
+		Namespace:  object.GetNamespace(),
+		Name:       object.GetName(),
+		Labels:     object.GetLabels(),
+	}
+
+	if s.linker != nil {
+		if ref, err := s.linker.ObjectPath(r.Namespace, r.APIVersion, r.Kind, r.Name); err == nil {
+			r.Ref = ref
+		}
+	}
+
+	return r
+}
+
+// sortResults puts exact name matches first, then names starting with text, then
+// everything else. Ties are sorted by kind, namespace, and name.
+func sortResults(results []Result, text string) {
+	text = strings.ToLower(text)
+	rank := func(r Result) int {
+		name := strings.ToLower(r.Name)
+		switch {
+		case text == "":
+			return 0
+		case name == text:
+			return 0
+		case strings.HasPrefix(name, text):
+			return 1
+		default:
+			return 2
+		}
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		a, b := results[i], results[j]
+		if rank(a) != rank(b) {
+			return rank(a) < rank(b)
+		}
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		return a.Name < b.Name
+	})
+}
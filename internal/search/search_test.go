@@ -0,0 +1,180 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package search
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	octantFake "github.com/vmware-tanzu/octant/internal/octant/fake"
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	storeFake "github.com/vmware-tanzu/octant/pkg/store/fake"
+)
+
+type cachedStore struct {
+	*storeFake.MockStore
+	keys []store.Key
+}
+
+func (s *cachedStore) CachedKeys() []store.Key {
+	return s.keys
+}
+
+var (
+	deploymentKey = store.Key{APIVersion: "apps/v1", Kind: "Deployment"}
+	serviceKey    = store.Key{APIVersion: "v1", Kind: "Service", Namespace: "default"}
+	nodeKey       = store.Key{APIVersion: "v1", Kind: "Node"}
+)
+
+func objectList(t *testing.T, objects ...*unstructured.Unstructured) *unstructured.UnstructuredList {
+	list := &unstructured.UnstructuredList{}
+	for _, object := range objects {
+		list.Items = append(list.Items, *object)
+	}
+	return list
+}
+
+func testStore(t *testing.T, controller *gomock.Controller) *cachedStore {
+	nginx := testutil.CreateDeployment("nginx")
+	nginx.Namespace = "default"
+	nginx.Labels = map[string]string{"app": "nginx"}
+
+	nginxCanary := testutil.CreateDeployment("nginx-canary")
+	nginxCanary.Namespace = "staging"
+	nginxCanary.Labels = map[string]string{"app": "nginx", "track": "canary"}
+
+	webNginx := testutil.CreateService("web-nginx")
+	webNginx.Namespace = "default"
+
+	node := testutil.CreateNode("nginx-node")
+
+	objectStore := storeFake.NewMockStore(controller)
+	objectStore.EXPECT().List(gomock.Any(), deploymentKey).
+		Return(objectList(t, testutil.ToUnstructured(t, nginxCanary), testutil.ToUnstructured(t, nginx)), false, nil).AnyTimes()
+	objectStore.EXPECT().List(gomock.Any(), serviceKey).
+		Return(objectList(t, testutil.ToUnstructured(t, webNginx)), false, nil).AnyTimes()
+	objectStore.EXPECT().List(gomock.Any(), nodeKey).
+		Return(objectList(t, testutil.ToUnstructured(t, node)), false, nil).AnyTimes()
+
+	return &cachedStore{
+		MockStore: objectStore,
+		keys:      []store.Key{nodeKey, serviceKey, deploymentKey},
+	}
+}
+
+func TestSearcher_Search(t *testing.T) {
+	tests := []struct {
+		name      string
+		text      string
+		selector  string
+		namespace string
+		limit     int
+		expected  []string
+	}{
+		{
+			name:     "name",
+			text:     "NGINX",
+			expected: []string{"Deployment default/nginx", "Deployment staging/nginx-canary", "Node nginx-node", "Service default/web-nginx"},
+		},
+		{
+			name:     "labels",
+			selector: "app=nginx,track=canary",
+			expected: []string{"Deployment staging/nginx-canary"},
+		},
+		{
+			name:     "name and labels",
+			text:     "nginx",
+			selector: "track!=canary",
+			expected: []string{"Deployment default/nginx", "Node nginx-node", "Service default/web-nginx"},
+		},
+		{
+			name:      "namespace",
+			text:      "nginx",
+			namespace: "default",
+			expected:  []string{"Deployment default/nginx", "Service default/web-nginx"},
+		},
+		{
+			name:     "limit",
+			text:     "nginx",
+			limit:    1,
+			expected: []string{"Deployment default/nginx"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			linker := octantFake.NewMockLinkGenerator(controller)
+			linker.EXPECT().
+				ObjectPath(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(namespace, apiVersion, kind, name string) (string, error) {
+					if kind == "Node" {
+						return "", errors.New("no path")
+					}
+					return "/overview/namespace/" + namespace + "/" + name, nil
+				}).
+				AnyTimes()
+
+			q, err := NewQuery(test.text, test.selector, test.namespace)
+			require.NoError(t, err)
+			if test.limit > 0 {
+				q.Limit = test.limit
+			}
+
+			results, err := NewSearcher(testStore(t, controller), linker).Search(context.Background(), q)
+			require.NoError(t, err)
+
+			var got []string
+			for _, result := range results {
+				name := result.Name
+				if result.Namespace != "" {
+					name = result.Namespace + "/" + name
+				}
+				got = append(got, result.Kind+" "+name)
+
+				if result.Kind == "Node" {
+					assert.Empty(t, result.Ref)
+				} else {
+					assert.Equal(t, "/overview/namespace/"+result.Namespace+"/"+result.Name, result.Ref)
+				}
+			}
+			assert.Equal(t, test.expected, got)
+		})
+	}
+}
+
+func TestSearcher_Search_unsupportedStore(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	q, err := NewQuery("nginx", "", "")
+	require.NoError(t, err)
+
+	_, err = NewSearcher(storeFake.NewMockStore(controller), nil).Search(context.Background(), q)
+	require.Error(t, err)
+}
+
+func TestNewQuery(t *testing.T) {
+	_, err := NewQuery(" ", "", "")
+	require.Error(t, err)
+
+	_, err = NewQuery("", "app in (", "")
+	require.Error(t, err)
+
+	q, err := NewQuery("", "app=nginx", "default")
+	require.NoError(t, err)
+	assert.Equal(t, "app=nginx", q.Selector.String())
+	assert.Equal(t, DefaultLimit, q.Limit)
+}
@@ -0,0 +1,180 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/discovery"
+
+	"github.com/vmware-tanzu/octant/internal/cluster"
+)
+
+type exportOptions struct {
+	namespaces     []string
+	kinds          sets.String
+	includeSecrets bool
+	context        string
+}
+
+// ExportOption is an option for configuring Export.
+type ExportOption func(o *exportOptions)
+
+// WithNamespaces limits the export to namespaced objects in namespaces. Cluster
+// scoped objects are not exported when namespaces are set.
+func WithNamespaces(namespaces ...string) ExportOption {
+	return func(o *exportOptions) {
+		o.namespaces = append(o.namespaces, namespaces...)
+	}
+}
+
+// WithKinds limits the export to kinds. A kind matches by its kind, resource
+// name, or short name, ignoring case.
+func WithKinds(kinds ...string) ExportOption {
+	return func(o *exportOptions) {
+		for _, kind := range kinds {
+			if kind = strings.TrimSpace(kind); kind != "" {
+				o.kinds.Insert(strings.ToLower(kind))
+			}
+		}
+	}
+}
+
+// WithSecrets includes secrets in the export. They are skipped by default because
+// snapshots are often shared.
+func WithSecrets() ExportOption {
+	return func(o *exportOptions) {
+		o.includeSecrets = true
+	}
+}
+
+// WithContextName records the kube config context in the snapshot manifest.
+func WithContextName(name string) ExportOption {
+	return func(o *exportOptions) {
+		o.context = name
+	}
+}
+
+// Export creates a snapshot from the objects the client can list. Resources the
+// client is not authorized to list are recorded in the manifest and skipped.
+func Export(ctx context.Context, client cluster.ClientInterface, options ...ExportOption) (*Snapshot, error) {
+	opts := exportOptions{
+		kinds: sets.NewString(),
+	}
+	for _, option := range options {
+		option(&opts)
+	}
+
+	discoveryClient, err := client.DiscoveryClient()
+	if err != nil {
+		return nil, fmt.Errorf("get discovery client: %w", err)
+	}
+
+	dynamicClient, err := client.DynamicClient()
+	if err != nil {
+		return nil, fmt.Errorf("get dynamic client: %w", err)
+	}
+
+	resourceLists, err := discoveryClient.ServerPreferredResources()
+	if err != nil && !discovery.IsGroupDiscoveryFailedError(err) {
+		return nil, fmt.Errorf("discover server resources: %w", err)
+	}
+
+	s := New()
+	s.Manifest.Context = opts.context
+	s.Manifest.Namespaces = opts.namespaces
+
+	for _, resourceList := range resourceLists {
+		gv, err := schema.ParseGroupVersion(resourceList.GroupVersion)
+		if err != nil {
+			return nil, fmt.Errorf("parse group version %q: %w", resourceList.GroupVersion, err)
+		}
+
+		for _, resource := range resourceList.APIResources {
+			if !opts.shouldExport(gv, resource) {
+				continue
+			}
+
+			gvr := gv.WithResource(resource.Name)
+			gvk := gv.WithKind(resource.Kind)
+
+			namespaces := []string{metav1.NamespaceAll}
+			if resource.Namespaced && len(opts.namespaces) > 0 {
+				namespaces = opts.namespaces
+			}
+
+			for _, namespace := range namespaces {
+				list, err := dynamicClient.Resource(gvr).Namespace(namespace).List(ctx, metav1.ListOptions{})
+				if err != nil {
+					if isSkippable(err) {
+						s.Manifest.Skipped = append(s.Manifest.Skipped, SkippedResource{
+							APIVersion: gv.String(),
+							Kind:       resource.Kind,
+							Namespace:  namespace,
+							Reason:     err.Error(),
+						})
+						continue
+					}
+					return nil, fmt.Errorf("list %s: %w", gvr, err)
+				}
+
+				for i := range list.Items {
+					object := &list.Items[i]
+					object.SetGroupVersionKind(gvk)
+					object.SetManagedFields(nil)
+					s.Add(object)
+				}
+			}
+		}
+	}
+
+	return s, nil
+}
+
+func (o exportOptions) shouldExport(gv schema.GroupVersion, resource metav1.APIResource) bool {
+	// sub resources such as pods/log can't be listed.
+	if strings.Contains(resource.Name, "/") {
+		return false
+	}
+
+	if !sets.NewString(resource.Verbs...).Has("list") {
+		return false
+	}
+
+	if !resource.Namespaced && len(o.namespaces) > 0 {
+		return false
+	}
+
+	if gv.Group == "" && resource.Kind == "Secret" && !o.includeSecrets {
+		return false
+	}
+
+	if o.kinds.Len() == 0 {
+		return true
+	}
+
+	names := append([]string{resource.Kind, resource.Name}, resource.ShortNames...)
+	for _, name := range names {
+		if o.kinds.Has(strings.ToLower(name)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isSkippable(err error) bool {
+	return kerrors.IsForbidden(err) ||
+		kerrors.IsUnauthorized(err) ||
+		kerrors.IsNotFound(err) ||
+		kerrors.IsMethodNotSupported(err)
+}
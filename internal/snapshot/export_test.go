@@ -0,0 +1,110 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package snapshot
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	clienttesting "k8s.io/client-go/testing"
+
+	clusterFake "github.com/vmware-tanzu/octant/internal/cluster/fake"
+)
+
+func TestExport(t *testing.T) {
+	resources := []*metav1.APIResourceList{
+		{
+			GroupVersion: "v1",
+			APIResources: []metav1.APIResource{
+				{Name: "pods", Kind: "Pod", Namespaced: true, Verbs: []string{"list"}, ShortNames: []string{"po"}},
+				{Name: "pods/log", Kind: "Pod", Namespaced: true, Verbs: []string{"get"}},
+				{Name: "secrets", Kind: "Secret", Namespaced: true, Verbs: []string{"list"}},
+				{Name: "namespaces", Kind: "Namespace", Verbs: []string{"list"}},
+				{Name: "bindings", Kind: "Binding", Namespaced: true, Verbs: []string{"create"}},
+			},
+		},
+		{
+			GroupVersion: "apps/v1",
+			APIResources: []metav1.APIResource{
+				{Name: "deployments", Kind: "Deployment", Namespaced: true, Verbs: []string{"list"}},
+			},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		options  []ExportOption
+		expected []string
+		skipped  int
+	}{
+		{
+			name:     "all readable objects",
+			expected: []string{"Deployment/deploy", "Namespace/default", "Pod/pod-1", "Pod/pod-2"},
+		},
+		{
+			name:     "namespace filter",
+			options:  []ExportOption{WithNamespaces("default")},
+			expected: []string{"Deployment/deploy", "Pod/pod-1"},
+		},
+		{
+			name:     "kind filter",
+			options:  []ExportOption{WithKinds("po")},
+			expected: []string{"Pod/pod-1", "Pod/pod-2"},
+		},
+		{
+			name:     "forbidden resources are skipped",
+			options:  []ExportOption{WithSecrets(), WithKinds("secrets")},
+			expected: nil,
+			skipped:  1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			dynamicClient := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(),
+				newObject("v1", "Pod", "default", "pod-1"),
+				newObject("v1", "Pod", "other", "pod-2"),
+				newObject("v1", "Secret", "default", "secret"),
+				newObject("v1", "Namespace", "", "default"),
+				newObject("apps/v1", "Deployment", "default", "deploy"),
+			)
+			dynamicClient.PrependReactor("list", "secrets", func(action clienttesting.Action) (bool, runtime.Object, error) {
+				return true, nil, kerrors.NewForbidden(schema.GroupResource{Resource: "secrets"}, "", nil)
+			})
+
+			discoveryClient := clusterFake.NewMockDiscoveryInterface(controller)
+			discoveryClient.EXPECT().ServerPreferredResources().Return(resources, nil)
+
+			client := clusterFake.NewMockClientInterface(controller)
+			client.EXPECT().DiscoveryClient().Return(discoveryClient, nil)
+			client.EXPECT().DynamicClient().Return(dynamicClient, nil)
+
+			s, err := Export(context.Background(), client, test.options...)
+			require.NoError(t, err)
+
+			var got []string
+			for _, gvk := range s.GroupVersionKinds() {
+				for _, object := range s.Objects(gvk) {
+					got = append(got, object.GetKind()+"/"+object.GetName())
+				}
+			}
+
+			assert.ElementsMatch(t, test.expected, got)
+			assert.Len(t, s.Manifest.Skipped, test.skipped)
+		})
+	}
+}
@@ -0,0 +1,250 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package snapshot
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// FormatVersion is the version of the snapshot archive format.
+	FormatVersion = 1
+
+	manifestName    = "manifest.json"
+	resourcesPrefix = "resources"
+	coreGroupName   = "core"
+)
+
+// Manifest describes the contents of a snapshot.
+type Manifest struct {
+	// Version is the snapshot format version.
+	Version int `json:"version"`
+	// Created is when the snapshot was created.
+	Created time.Time `json:"created"`
+	// Context is the kube config context the snapshot was taken from.
+	Context string `json:"context,omitempty"`
+	// Namespaces are the namespaces the snapshot was limited to. It is empty if
+	// all namespaces were included.
+	Namespaces []string `json:"namespaces,omitempty"`
+	// Skipped lists resources which were not exported and why.
+	Skipped []SkippedResource `json:"skipped,omitempty"`
+}
+
+// SkippedResource is a resource which could not be exported.
+type SkippedResource struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Namespace  string `json:"namespace,omitempty"`
+	Reason     string `json:"reason"`
+}
+
+// Snapshot is a point in time copy of objects from a cluster.
+type Snapshot struct {
+	Manifest Manifest
+
+	objects map[schema.GroupVersionKind][]*unstructured.Unstructured
+	mu      sync.RWMutex
+}
+
+// New creates an empty snapshot.
+func New() *Snapshot {
+	return &Snapshot{
+		Manifest: Manifest{
+			Version: FormatVersion,
+			Created: time.Now().UTC(),
+		},
+		objects: map[schema.GroupVersionKind][]*unstructured.Unstructured{},
+	}
+}
+
+// Add adds objects to the snapshot.
+func (s *Snapshot) Add(objects ...*unstructured.Unstructured) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, object := range objects {
+		if object == nil {
+			continue
+		}
+		gvk := object.GroupVersionKind()
+		s.objects[gvk] = append(s.objects[gvk], object)
+	}
+}
+
+// Objects returns the objects in the snapshot with a group version kind.
+func (s *Snapshot) Objects(gvk schema.GroupVersionKind) []*unstructured.Unstructured {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.objects[gvk]
+}
+
+// GroupVersionKinds returns the group version kinds found in the snapshot in sorted order.
+func (s *Snapshot) GroupVersionKinds() []schema.GroupVersionKind {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var list []schema.GroupVersionKind
+	for gvk := range s.objects {
+		list = append(list, gvk)
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].String() < list[j].String()
+	})
+
+	return list
+}
+
+// Len returns the number of objects in the snapshot.
+func (s *Snapshot) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	count := 0
+	for _, objects := range s.objects {
+		count += len(objects)
+	}
+	return count
+}
+
+// Write writes the snapshot to w as a gzipped tar archive. The archive contains
+// a manifest and a list of objects for each group version kind.
+func Write(w io.Writer, s *Snapshot) error {
+	gw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gw)
+
+	manifest, err := json.MarshalIndent(s.Manifest, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encode manifest: %w", err)
+	}
+
+	if err := writeEntry(tw, manifestName, manifest, s.Manifest.Created); err != nil {
+		return err
+	}
+
+	for _, gvk := range s.GroupVersionKinds() {
+		list := &unstructured.UnstructuredList{}
+		list.SetAPIVersion(gvk.GroupVersion().String())
+		list.SetKind(gvk.Kind + "List")
+		for _, object := range s.Objects(gvk) {
+			list.Items = append(list.Items, *object)
+		}
+
+		data, err := list.MarshalJSON()
+		if err != nil {
+			return fmt.Errorf("encode %s: %w", gvk, err)
+		}
+
+		if err := writeEntry(tw, resourcePath(gvk), data, s.Manifest.Created); err != nil {
+			return err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return fmt.Errorf("close snapshot archive: %w", err)
+	}
+
+	return gw.Close()
+}
+
+// Read reads a snapshot created by Write.
+func Read(r io.Reader) (*Snapshot, error) {
+	gr, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("open snapshot archive: %w", err)
+	}
+	defer gr.Close()
+
+	s := New()
+	foundManifest := false
+
+	tr := tar.NewReader(gr)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("read snapshot archive: %w", err)
+		}
+
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		data, err := ioutil.ReadAll(tr)
+		if err != nil {
+			return nil, fmt.Errorf("read %s: %w", header.Name, err)
+		}
+
+		switch {
+		case header.Name == manifestName:
+			if err := json.Unmarshal(data, &s.Manifest); err != nil {
+				return nil, fmt.Errorf("decode manifest: %w", err)
+			}
+			if s.Manifest.Version > FormatVersion {
+				return nil, fmt.Errorf("snapshot format version %d is newer than supported version %d",
+					s.Manifest.Version, FormatVersion)
+			}
+			foundManifest = true
+		case strings.HasPrefix(header.Name, resourcesPrefix+"/"):
+			list := &unstructured.UnstructuredList{}
+			if err := list.UnmarshalJSON(data); err != nil {
+				return nil, fmt.Errorf("decode %s: %w", header.Name, err)
+			}
+			for i := range list.Items {
+				s.Add(&list.Items[i])
+			}
+		}
+	}
+
+	if !foundManifest {
+		return nil, fmt.Errorf("snapshot archive does not contain %s", manifestName)
+	}
+
+	return s, nil
+}
+
+func resourcePath(gvk schema.GroupVersionKind) string {
+	group := gvk.Group
+	if group == "" {
+		group = coreGroupName
+	}
+	return path.Join(resourcesPrefix, group, gvk.Version, gvk.Kind+".json")
+}
+
+func writeEntry(tw *tar.Writer, name string, data []byte, modTime time.Time) error {
+	header := &tar.Header{
+		Name:    name,
+		Mode:    0600,
+		Size:    int64(len(data)),
+		ModTime: modTime,
+	}
+
+	if err := tw.WriteHeader(header); err != nil {
+		return fmt.Errorf("write %s header: %w", name, err)
+	}
+
+	if _, err := tw.Write(data); err != nil {
+		return fmt.Errorf("write %s: %w", name, err)
+	}
+
+	return nil
+}
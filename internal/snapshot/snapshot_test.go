@@ -0,0 +1,64 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package snapshot
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestWriteRead(t *testing.T) {
+	s := New()
+	s.Manifest.Context = "staging"
+	s.Add(
+		newObject("v1", "Pod", "default", "pod-1"),
+		newObject("v1", "Pod", "default", "pod-2"),
+		newObject("apps/v1", "Deployment", "default", "deployment"),
+		newObject("v1", "Namespace", "", "default"),
+	)
+
+	var buf bytes.Buffer
+	require.NoError(t, Write(&buf, s))
+
+	got, err := Read(&buf)
+	require.NoError(t, err)
+
+	assert.Equal(t, FormatVersion, got.Manifest.Version)
+	assert.Equal(t, "staging", got.Manifest.Context)
+	assert.Equal(t, 4, got.Len())
+	assert.Equal(t, s.GroupVersionKinds(), got.GroupVersionKinds())
+
+	pods := got.Objects(schema.GroupVersionKind{Version: "v1", Kind: "Pod"})
+	require.Len(t, pods, 2)
+	assert.Equal(t, "pod-1", pods[0].GetName())
+}
+
+func TestRead_invalid(t *testing.T) {
+	_, err := Read(bytes.NewBufferString("not a snapshot"))
+	require.Error(t, err)
+
+	var buf bytes.Buffer
+	s := New()
+	s.Manifest.Version = FormatVersion + 1
+	require.NoError(t, Write(&buf, s))
+
+	_, err = Read(&buf)
+	require.Error(t, err)
+}
+
+func newObject(apiVersion, kind, namespace, name string) *unstructured.Unstructured {
+	object := &unstructured.Unstructured{}
+	object.SetAPIVersion(apiVersion)
+	object.SetKind(kind)
+	object.SetNamespace(namespace)
+	object.SetName(name)
+	return object
+}
@@ -0,0 +1,91 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package telemetry
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"go.opencensus.io/trace"
+	"google.golang.org/api/support/bundler"
+
+	"github.com/vmware-tanzu/octant/pkg/log"
+)
+
+// spanEncoder encodes a batch of spans as a request body.
+type spanEncoder func(spans []*trace.SpanData) ([]byte, error)
+
+// httpExporter batches spans and posts them as JSON to an endpoint.
+type httpExporter struct {
+	endpoint string
+	encode   spanEncoder
+	client   *http.Client
+	bundler  *bundler.Bundler
+	logger   log.Logger
+}
+
+var _ Exporter = (*httpExporter)(nil)
+
+func newHTTPExporter(logger log.Logger, endpoint string, encode spanEncoder) *httpExporter {
+	e := &httpExporter{
+		endpoint: endpoint,
+		encode:   encode,
+		client:   &http.Client{Timeout: 10 * time.Second},
+		logger:   logger,
+	}
+
+	b := bundler.NewBundler((*trace.SpanData)(nil), func(bundle interface{}) {
+		if err := e.upload(bundle.([]*trace.SpanData)); err != nil {
+			e.logger.WithErr(err).Warnf("unable to upload spans")
+		}
+	})
+	b.DelayThreshold = 2 * time.Second
+	b.BundleCountThreshold = 100
+	e.bundler = b
+
+	return e
+}
+
+// ExportSpan queues a span for upload.
+func (e *httpExporter) ExportSpan(data *trace.SpanData) {
+	// spans are dropped if the buffer is full rather than blocking the caller.
+	_ = e.bundler.Add(data, 1)
+}
+
+// Flush uploads queued spans.
+func (e *httpExporter) Flush() {
+	e.bundler.Flush()
+}
+
+func (e *httpExporter) upload(spans []*trace.SpanData) error {
+	body, err := e.encode(spans)
+	if err != nil {
+		return fmt.Errorf("encode spans: %w", err)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, e.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("post spans to %s: %w", e.endpoint, err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("post spans to %s: unexpected status %s", e.endpoint, resp.Status)
+	}
+
+	return nil
+}
@@ -0,0 +1,185 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package telemetry
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"go.opencensus.io/trace"
+)
+
+// OTLP span kinds and status codes.
+const (
+	otlpSpanKindInternal = 1
+	otlpSpanKindServer   = 2
+	otlpSpanKindClient   = 3
+
+	otlpStatusCodeOK    = 1
+	otlpStatusCodeError = 2
+)
+
+// otlpRequest is an OTLP/HTTP trace export request in the JSON encoding.
+type otlpRequest struct {
+	ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+}
+
+type otlpResourceSpans struct {
+	Resource   otlpResource     `json:"resource"`
+	ScopeSpans []otlpScopeSpans `json:"scopeSpans"`
+}
+
+type otlpResource struct {
+	Attributes []otlpKeyValue `json:"attributes"`
+}
+
+type otlpScopeSpans struct {
+	Scope otlpScope  `json:"scope"`
+	Spans []otlpSpan `json:"spans"`
+}
+
+type otlpScope struct {
+	Name string `json:"name"`
+}
+
+type otlpSpan struct {
+	TraceID           string         `json:"traceId"`
+	SpanID            string         `json:"spanId"`
+	ParentSpanID      string         `json:"parentSpanId,omitempty"`
+	Name              string         `json:"name"`
+	Kind              int            `json:"kind"`
+	StartTimeUnixNano string         `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string         `json:"endTimeUnixNano"`
+	Attributes        []otlpKeyValue `json:"attributes,omitempty"`
+	Events            []otlpEvent    `json:"events,omitempty"`
+	Status            otlpStatus     `json:"status"`
+}
+
+type otlpEvent struct {
+	TimeUnixNano string         `json:"timeUnixNano"`
+	Name         string         `json:"name"`
+	Attributes   []otlpKeyValue `json:"attributes,omitempty"`
+}
+
+type otlpStatus struct {
+	Code    int    `json:"code"`
+	Message string `json:"message,omitempty"`
+}
+
+type otlpKeyValue struct {
+	Key   string    `json:"key"`
+	Value otlpValue `json:"value"`
+}
+
+type otlpValue struct {
+	StringValue *string  `json:"stringValue,omitempty"`
+	BoolValue   *bool    `json:"boolValue,omitempty"`
+	IntValue    *string  `json:"intValue,omitempty"`
+	DoubleValue *float64 `json:"doubleValue,omitempty"`
+}
+
+func otlpEncoder(serviceName string) spanEncoder {
+	return func(spans []*trace.SpanData) ([]byte, error) {
+		scopeSpans := otlpScopeSpans{
+			Scope: otlpScope{Name: DefaultServiceName},
+		}
+		for _, span := range spans {
+			scopeSpans.Spans = append(scopeSpans.Spans, toOTLPSpan(span))
+		}
+
+		request := otlpRequest{
+			ResourceSpans: []otlpResourceSpans{
+				{
+					Resource: otlpResource{
+						Attributes: []otlpKeyValue{toOTLPKeyValue("service.name", serviceName)},
+					},
+					ScopeSpans: []otlpScopeSpans{scopeSpans},
+				},
+			},
+		}
+
+		return json.Marshal(request)
+	}
+}
+
+func toOTLPSpan(data *trace.SpanData) otlpSpan {
+	span := otlpSpan{
+		TraceID:           data.TraceID.String(),
+		SpanID:            data.SpanID.String(),
+		Name:              data.Name,
+		Kind:              otlpSpanKindInternal,
+		StartTimeUnixNano: unixNano(data.StartTime),
+		EndTimeUnixNano:   unixNano(data.EndTime),
+		Attributes:        toOTLPAttributes(data.Attributes),
+		Status:            otlpStatus{Code: otlpStatusCodeOK},
+	}
+
+	if data.ParentSpanID != (trace.SpanID{}) {
+		span.ParentSpanID = data.ParentSpanID.String()
+	}
+
+	switch data.SpanKind {
+	case trace.SpanKindServer:
+		span.Kind = otlpSpanKindServer
+	case trace.SpanKindClient:
+		span.Kind = otlpSpanKindClient
+	}
+
+	if data.Status.Code != trace.StatusCodeOK {
+		span.Status = otlpStatus{Code: otlpStatusCodeError, Message: data.Status.Message}
+	}
+
+	for _, annotation := range data.Annotations {
+		span.Events = append(span.Events, otlpEvent{
+			TimeUnixNano: unixNano(annotation.Time),
+			Name:         annotation.Message,
+			Attributes:   toOTLPAttributes(annotation.Attributes),
+		})
+	}
+
+	return span
+}
+
+func toOTLPAttributes(attributes map[string]interface{}) []otlpKeyValue {
+	var list []otlpKeyValue
+	for k, v := range attributes {
+		list = append(list, toOTLPKeyValue(k, v))
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Key < list[j].Key
+	})
+
+	return list
+}
+
+func toOTLPKeyValue(key string, value interface{}) otlpKeyValue {
+	kv := otlpKeyValue{Key: key}
+
+	switch v := value.(type) {
+	case string:
+		kv.Value.StringValue = &v
+	case bool:
+		kv.Value.BoolValue = &v
+	case int64:
+		s := strconv.FormatInt(v, 10)
+		kv.Value.IntValue = &s
+	case float64:
+		kv.Value.DoubleValue = &v
+	default:
+		s := fmt.Sprint(v)
+		kv.Value.StringValue = &s
+	}
+
+	return kv
+}
+
+func unixNano(t time.Time) string {
+	return strconv.FormatInt(t.UnixNano(), 10)
+}
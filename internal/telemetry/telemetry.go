@@ -0,0 +1,158 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package telemetry configures where OpenCensus traces created by Octant are sent.
+package telemetry
+
+import (
+	"fmt"
+	"strings"
+
+	"contrib.go.opencensus.io/exporter/jaeger"
+	"go.opencensus.io/trace"
+
+	"github.com/vmware-tanzu/octant/pkg/log"
+)
+
+const (
+	// ExporterJaeger sends spans to a Jaeger agent or collector.
+	ExporterJaeger = "jaeger"
+	// ExporterZipkin sends spans to a Zipkin v2 API.
+	ExporterZipkin = "zipkin"
+	// ExporterOTLP sends spans to an OpenTelemetry collector using OTLP over HTTP.
+	ExporterOTLP = "otlp"
+
+	// DefaultServiceName is the service name spans are reported with.
+	DefaultServiceName = "octant"
+)
+
+// defaultEndpoints are the endpoints used for an exporter when one is not configured.
+var defaultEndpoints = map[string]string{
+	ExporterJaeger: "localhost:6831",
+	ExporterZipkin: "http://localhost:9411/api/v2/spans",
+	ExporterOTLP:   "http://localhost:4318/v1/traces",
+}
+
+// Options configures telemetry.
+type Options struct {
+	// Exporter is the name of the exporter. Telemetry is disabled if it is blank.
+	Exporter string
+	// Endpoint is where spans are sent. For Jaeger, a host:port is an agent address
+	// and a URL is a collector endpoint.
+	Endpoint string
+	// SampleRate is the fraction of traces to sample, between 0 and 1.
+	SampleRate float64
+	// ServiceName is the service name spans are reported with.
+	ServiceName string
+}
+
+// Enabled returns true if an exporter is configured.
+func (o Options) Enabled() bool {
+	return o.Exporter != ""
+}
+
+// Validate validates options.
+func (o Options) Validate() error {
+	if !o.Enabled() {
+		return nil
+	}
+
+	if _, ok := defaultEndpoints[o.Exporter]; !ok {
+		return fmt.Errorf("unknown telemetry exporter %q: expected one of %s, %s, or %s",
+			o.Exporter, ExporterJaeger, ExporterZipkin, ExporterOTLP)
+	}
+
+	if o.SampleRate < 0 || o.SampleRate > 1 {
+		return fmt.Errorf("telemetry sample rate %v must be between 0 and 1", o.SampleRate)
+	}
+
+	return nil
+}
+
+// Exporter is a trace exporter which can be flushed.
+type Exporter interface {
+	trace.Exporter
+	Flush()
+}
+
+// Enable registers an exporter and configures sampling. The returned function flushes
+// buffered spans and unregisters the exporter. It should be called before Octant exits.
+func Enable(logger log.Logger, options Options) (func(), error) {
+	if err := options.Validate(); err != nil {
+		return nil, err
+	}
+
+	if !options.Enabled() {
+		return func() {}, nil
+	}
+
+	exporter, err := NewExporter(logger, options)
+	if err != nil {
+		return nil, err
+	}
+
+	trace.RegisterExporter(exporter)
+	trace.ApplyConfig(trace.Config{DefaultSampler: Sampler(options.SampleRate)})
+
+	return func() {
+		exporter.Flush()
+		trace.UnregisterExporter(exporter)
+	}, nil
+}
+
+// NewExporter creates the exporter configured by options.
+func NewExporter(logger log.Logger, options Options) (Exporter, error) {
+	serviceName := options.ServiceName
+	if serviceName == "" {
+		serviceName = DefaultServiceName
+	}
+
+	endpoint := options.Endpoint
+	if endpoint == "" {
+		endpoint = defaultEndpoints[options.Exporter]
+	}
+
+	switch options.Exporter {
+	case ExporterJaeger:
+		jaegerOptions := jaeger.Options{
+			Process: jaeger.Process{
+				ServiceName: serviceName,
+			},
+		}
+		if isURL(endpoint) {
+			jaegerOptions.CollectorEndpoint = endpoint
+		} else {
+			jaegerOptions.AgentEndpoint = endpoint
+		}
+
+		exporter, err := jaeger.NewExporter(jaegerOptions)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create Jaeger exporter: %w", err)
+		}
+		return exporter, nil
+	case ExporterZipkin:
+		return newHTTPExporter(logger, endpoint, zipkinEncoder(serviceName)), nil
+	case ExporterOTLP:
+		return newHTTPExporter(logger, endpoint, otlpEncoder(serviceName)), nil
+	default:
+		return nil, fmt.Errorf("unknown telemetry exporter %q", options.Exporter)
+	}
+}
+
+// Sampler returns a sampler which samples a fraction of traces.
+func Sampler(rate float64) trace.Sampler {
+	switch {
+	case rate >= 1:
+		return trace.AlwaysSample()
+	case rate <= 0:
+		return trace.NeverSample()
+	default:
+		return trace.ProbabilitySampler(rate)
+	}
+}
+
+func isURL(endpoint string) bool {
+	return strings.HasPrefix(endpoint, "http://") || strings.HasPrefix(endpoint, "https://")
+}
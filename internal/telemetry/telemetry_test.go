@@ -0,0 +1,144 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package telemetry
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opencensus.io/trace"
+
+	"github.com/vmware-tanzu/octant/internal/log"
+)
+
+func TestOptions_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		options Options
+		wantErr bool
+	}{
+		{name: "disabled", options: Options{}},
+		{name: "jaeger", options: Options{Exporter: ExporterJaeger, SampleRate: 1}},
+		{name: "zipkin", options: Options{Exporter: ExporterZipkin, SampleRate: 0.5}},
+		{name: "otlp", options: Options{Exporter: ExporterOTLP}},
+		{name: "unknown exporter", options: Options{Exporter: "datadog"}, wantErr: true},
+		{name: "sample rate too large", options: Options{Exporter: ExporterOTLP, SampleRate: 2}, wantErr: true},
+		{name: "negative sample rate", options: Options{Exporter: ExporterOTLP, SampleRate: -1}, wantErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.options.Validate()
+			if test.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSampler(t *testing.T) {
+	params := trace.SamplingParameters{TraceID: trace.TraceID{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}}
+
+	assert.True(t, Sampler(1)(params).Sample)
+	assert.False(t, Sampler(0)(params).Sample)
+	assert.False(t, Sampler(0.5)(params).Sample)
+	assert.True(t, Sampler(0.5)(trace.SamplingParameters{}).Sample)
+}
+
+func testSpanData() *trace.SpanData {
+	start := time.Unix(1600000000, 0)
+	return &trace.SpanData{
+		SpanContext: trace.SpanContext{
+			TraceID: trace.TraceID{1},
+			SpanID:  trace.SpanID{2},
+		},
+		ParentSpanID: trace.SpanID{3},
+		SpanKind:     trace.SpanKindServer,
+		Name:         "content",
+		StartTime:    start,
+		EndTime:      start.Add(1500 * time.Millisecond),
+		Attributes: map[string]interface{}{
+			"path":  "/overview",
+			"count": int64(3),
+		},
+		Status: trace.Status{Code: trace.StatusCodeUnknown, Message: "failed"},
+	}
+}
+
+func Test_toZipkinSpan(t *testing.T) {
+	got := toZipkinSpan("octant", testSpanData())
+
+	expected := zipkinSpan{
+		TraceID:       "01000000000000000000000000000000",
+		ID:            "0200000000000000",
+		ParentID:      "0300000000000000",
+		Name:          "content",
+		Kind:          "SERVER",
+		Timestamp:     1600000000000000,
+		Duration:      1500000,
+		LocalEndpoint: zipkinEndpoint{ServiceName: "octant"},
+		Tags: map[string]string{
+			"path":  "/overview",
+			"count": "3",
+			"error": "failed",
+		},
+	}
+
+	assert.Equal(t, expected, got)
+}
+
+func Test_toOTLPSpan(t *testing.T) {
+	got := toOTLPSpan(testSpanData())
+
+	assert.Equal(t, "01000000000000000000000000000000", got.TraceID)
+	assert.Equal(t, "0300000000000000", got.ParentSpanID)
+	assert.Equal(t, otlpSpanKindServer, got.Kind)
+	assert.Equal(t, "1600000000000000000", got.StartTimeUnixNano)
+	assert.Equal(t, "1600000001500000000", got.EndTimeUnixNano)
+	assert.Equal(t, otlpStatus{Code: otlpStatusCodeError, Message: "failed"}, got.Status)
+
+	require.Len(t, got.Attributes, 2)
+	assert.Equal(t, "count", got.Attributes[0].Key)
+	assert.Equal(t, "3", *got.Attributes[0].Value.IntValue)
+	assert.Equal(t, "path", got.Attributes[1].Key)
+	assert.Equal(t, "/overview", *got.Attributes[1].Value.StringValue)
+}
+
+func Test_httpExporter(t *testing.T) {
+	bodies := make(chan []byte, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		data, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		bodies <- data
+	}))
+	defer srv.Close()
+
+	exporter, err := NewExporter(log.NopLogger(), Options{Exporter: ExporterOTLP, Endpoint: srv.URL, ServiceName: "test"})
+	require.NoError(t, err)
+
+	exporter.ExportSpan(testSpanData())
+	exporter.Flush()
+
+	var request otlpRequest
+	require.NoError(t, json.Unmarshal(<-bodies, &request))
+	require.Len(t, request.ResourceSpans, 1)
+
+	resourceSpans := request.ResourceSpans[0]
+	assert.Equal(t, "service.name", resourceSpans.Resource.Attributes[0].Key)
+	assert.Equal(t, "test", *resourceSpans.Resource.Attributes[0].Value.StringValue)
+	require.Len(t, resourceSpans.ScopeSpans, 1)
+	require.Len(t, resourceSpans.ScopeSpans[0].Spans, 1)
+	assert.Equal(t, "content", resourceSpans.ScopeSpans[0].Spans[0].Name)
+}
@@ -0,0 +1,91 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package telemetry
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"go.opencensus.io/trace"
+)
+
+// zipkinSpan is a span in the Zipkin v2 JSON format.
+type zipkinSpan struct {
+	TraceID       string             `json:"traceId"`
+	ID            string             `json:"id"`
+	ParentID      string             `json:"parentId,omitempty"`
+	Name          string             `json:"name"`
+	Kind          string             `json:"kind,omitempty"`
+	Timestamp     int64              `json:"timestamp"`
+	Duration      int64              `json:"duration"`
+	LocalEndpoint zipkinEndpoint     `json:"localEndpoint"`
+	Annotations   []zipkinAnnotation `json:"annotations,omitempty"`
+	Tags          map[string]string  `json:"tags,omitempty"`
+}
+
+type zipkinEndpoint struct {
+	ServiceName string `json:"serviceName"`
+}
+
+type zipkinAnnotation struct {
+	Timestamp int64  `json:"timestamp"`
+	Value     string `json:"value"`
+}
+
+func zipkinEncoder(serviceName string) spanEncoder {
+	return func(spans []*trace.SpanData) ([]byte, error) {
+		var list []zipkinSpan
+		for _, span := range spans {
+			list = append(list, toZipkinSpan(serviceName, span))
+		}
+		return json.Marshal(list)
+	}
+}
+
+func toZipkinSpan(serviceName string, data *trace.SpanData) zipkinSpan {
+	span := zipkinSpan{
+		TraceID:       data.TraceID.String(),
+		ID:            data.SpanID.String(),
+		Name:          data.Name,
+		Timestamp:     data.StartTime.UnixNano() / 1e3,
+		Duration:      data.EndTime.Sub(data.StartTime).Microseconds(),
+		LocalEndpoint: zipkinEndpoint{ServiceName: serviceName},
+	}
+
+	if data.ParentSpanID != (trace.SpanID{}) {
+		span.ParentID = data.ParentSpanID.String()
+	}
+
+	switch data.SpanKind {
+	case trace.SpanKindServer:
+		span.Kind = "SERVER"
+	case trace.SpanKindClient:
+		span.Kind = "CLIENT"
+	}
+
+	for _, annotation := range data.Annotations {
+		span.Annotations = append(span.Annotations, zipkinAnnotation{
+			Timestamp: annotation.Time.UnixNano() / 1e3,
+			Value:     annotation.Message,
+		})
+	}
+
+	tags := map[string]string{}
+	for k, v := range data.Attributes {
+		tags[k] = fmt.Sprint(v)
+	}
+	if data.Status.Code != trace.StatusCodeOK {
+		tags["error"] = data.Status.Message
+		if data.Status.Message == "" {
+			tags["error"] = fmt.Sprintf("status code %d", data.Status.Code)
+		}
+	}
+	if len(tags) > 0 {
+		span.Tags = tags
+	}
+
+	return span
+}
@@ -0,0 +1,449 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package terminal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+//go:generate mockgen -source=manager.go -destination=./fake/mock_manager.go -package=fake github.com/vmware-tanzu/octant/internal/terminal Manager
+
+const (
+	// DefaultIdleTimeout is how long a session can go without input or output before it is stopped.
+	DefaultIdleTimeout = 30 * time.Minute
+	// DefaultMaxSessions is the number of sessions which can run at the same time.
+	DefaultMaxSessions = 10
+)
+
+// ErrMaxSessions is returned when a session can't be started because too many are running.
+var ErrMaxSessions = errors.New("too many terminal sessions are running")
+
+// StartFunc starts a terminal instance for a session. The instance must stop when ctx is
+// cancelled and should send itself to activity when it reads or writes.
+type StartFunc func(ctx context.Context, activity chan Instance) (Instance, error)
+
+// SessionInfo describes a terminal session.
+type SessionInfo struct {
+	ID           string    `json:"id"`
+	Key          store.Key `json:"key"`
+	Container    string    `json:"container"`
+	Command      string    `json:"command"`
+	CreatedAt    time.Time `json:"createdAt"`
+	LastActivity time.Time `json:"lastActivity"`
+	Cols         uint16    `json:"cols"`
+	Rows         uint16    `json:"rows"`
+	Attached     bool      `json:"attached"`
+	Active       bool      `json:"active"`
+}
+
+// Manager owns terminal sessions. Sessions are independent of the connection which
+// started them, so a browser which reconnects can reattach to a running shell.
+type Manager interface {
+	// Open returns the active session for a container, or starts a new one. It returns
+	// true if the session was already running.
+	Open(key store.Key, container string, start StartFunc) (SessionInfo, bool, error)
+	// Attach sends a session's instance to ch whenever it has activity. Only one
+	// channel is attached to a session at a time. The returned function detaches ch.
+	Attach(id string, ch chan<- Instance) (func(), error)
+	// Instance returns a session's terminal instance.
+	Instance(id string) (Instance, bool)
+	// Resize resizes a session's terminal.
+	Resize(id string, cols, rows uint16) error
+	// Get returns a session.
+	Get(id string) (SessionInfo, bool)
+	// List lists sessions sorted by creation time.
+	List() []SessionInfo
+	// Stop stops a session.
+	Stop(id string)
+	// StopAll stops every session.
+	StopAll()
+}
+
+type session struct {
+	id        string
+	key       store.Key
+	container string
+	instance  Instance
+	createdAt time.Time
+
+	lastActivity time.Time
+	cols         uint16
+	rows         uint16
+
+	subscriber     chan<- Instance
+	subscriptionID int
+
+	cancel context.CancelFunc
+}
+
+func (s *session) info() SessionInfo {
+	return SessionInfo{
+		ID:           s.id,
+		Key:          s.key,
+		Container:    s.container,
+		Command:      s.instance.Command(),
+		CreatedAt:    s.createdAt,
+		LastActivity: s.lastActivity,
+		Cols:         s.cols,
+		Rows:         s.rows,
+		Attached:     s.subscriber != nil,
+		Active:       s.instance.Active(),
+	}
+}
+
+// ManagerOption is an option for configuring SessionManager.
+type ManagerOption func(m *SessionManager)
+
+// WithIdleTimeout sets how long a session can go without input or output before it is
+// stopped. Sessions are never stopped for being idle if timeout is zero.
+func WithIdleTimeout(timeout time.Duration) ManagerOption {
+	return func(m *SessionManager) {
+		m.idleTimeout = timeout
+	}
+}
+
+// WithMaxSessions sets the number of sessions which can run at the same time. There is
+// no limit if max is zero.
+func WithMaxSessions(max int) ManagerOption {
+	return func(m *SessionManager) {
+		m.maxSessions = max
+	}
+}
+
+// SessionManager is a Manager.
+type SessionManager struct {
+	ctx         context.Context
+	idleTimeout time.Duration
+	maxSessions int
+	now         func() time.Time
+
+	sessions           map[string]*session
+	nextSubscriptionID int
+
+	mu sync.Mutex
+}
+
+var _ Manager = (*SessionManager)(nil)
+
+// NewManager creates an instance of SessionManager. Sessions are stopped when ctx is cancelled.
+func NewManager(ctx context.Context, options ...ManagerOption) *SessionManager {
+	m := &SessionManager{
+		ctx:         ctx,
+		idleTimeout: DefaultIdleTimeout,
+		maxSessions: DefaultMaxSessions,
+		now:         time.Now,
+		sessions:    map[string]*session{},
+	}
+
+	for _, option := range options {
+		option(m)
+	}
+
+	if m.idleTimeout > 0 {
+		go m.reap(ctx)
+	}
+
+	return m
+}
+
+// Open returns the active session for a container, or starts a new one.
+func (m *SessionManager) Open(key store.Key, container string, start StartFunc) (SessionInfo, bool, error) {
+	m.mu.Lock()
+	m.removeInactive()
+	if s, ok := m.find(key, container); ok {
+		info := s.info()
+		m.mu.Unlock()
+		return info, true, nil
+	}
+	if m.full() {
+		m.mu.Unlock()
+		return SessionInfo{}, false, fmt.Errorf("start terminal for %s: %w", key, ErrMaxSessions)
+	}
+	m.mu.Unlock()
+
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return SessionInfo{}, false, fmt.Errorf("generate session id: %w", err)
+	}
+
+	ctx, cancel := context.WithCancel(m.ctx)
+	activity := make(chan Instance, 10)
+
+	// Activity has to be drained while the instance starts or it will block.
+	go m.relay(ctx, id.String(), activity)
+
+	instance, err := start(ctx, activity)
+	if err != nil {
+		cancel()
+		return SessionInfo{}, false, err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	// Another client may have opened a session for the container in the meantime.
+	m.removeInactive()
+	if s, ok := m.find(key, container); ok {
+		instance.Stop()
+		cancel()
+		return s.info(), true, nil
+	}
+
+	// Sessions for other containers may have started in the meantime too, so the
+	// limit is checked again before the session is added.
+	if m.full() {
+		instance.Stop()
+		cancel()
+		return SessionInfo{}, false, fmt.Errorf("start terminal for %s: %w", key, ErrMaxSessions)
+	}
+
+	s := &session{
+		id:           id.String(),
+		key:          key,
+		container:    container,
+		instance:     instance,
+		createdAt:    m.now(),
+		lastActivity: m.now(),
+		cancel:       cancel,
+	}
+	m.sessions[s.id] = s
+
+	return s.info(), false, nil
+}
+
+// full returns true if no more sessions can be started. The caller must hold mu.
+func (m *SessionManager) full() bool {
+	return m.maxSessions > 0 && len(m.sessions) >= m.maxSessions
+}
+
+// find finds the session for a container. The caller must hold mu.
+func (m *SessionManager) find(key store.Key, container string) (*session, bool) {
+	for _, s := range m.sessions {
+		if s.key == key && s.container == container {
+			return s, true
+		}
+	}
+	return nil, false
+}
+
+// relay records activity for a session and forwards it to the attached channel. Activity
+// is dropped while no channel is attached; the scrollback is sent when one attaches.
+func (m *SessionManager) relay(ctx context.Context, id string, activity <-chan Instance) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case instance := <-activity:
+			m.mu.Lock()
+			s, ok := m.sessions[id]
+			var subscriber chan<- Instance
+			if ok {
+				s.lastActivity = m.now()
+				subscriber = s.subscriber
+			}
+			m.mu.Unlock()
+
+			if subscriber == nil {
+				continue
+			}
+
+			select {
+			case subscriber <- instance:
+			default:
+			}
+		}
+	}
+}
+
+// Attach sends a session's instance to ch whenever it has activity. Attaching replaces
+// the channel previously attached to the session.
+func (m *SessionManager) Attach(id string, ch chan<- Instance) (func(), error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	s, ok := m.sessions[id]
+	if !ok {
+		return nil, fmt.Errorf("terminal session %q not found", id)
+	}
+
+	m.nextSubscriptionID++
+	subscriptionID := m.nextSubscriptionID
+
+	s.subscriber = ch
+	s.subscriptionID = subscriptionID
+
+	detach := func() {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+
+		if s, ok := m.sessions[id]; ok && s.subscriptionID == subscriptionID {
+			s.subscriber = nil
+		}
+	}
+
+	return detach, nil
+}
+
+// Instance returns a session's terminal instance.
+func (m *SessionManager) Instance(id string) (Instance, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	s, ok := m.sessions[id]
+	if !ok {
+		return nil, false
+	}
+
+	return s.instance, true
+}
+
+// Resize resizes a session's terminal. The size is kept so it can be reported to
+// clients which reattach.
+func (m *SessionManager) Resize(id string, cols, rows uint16) error {
+	m.mu.Lock()
+	s, ok := m.sessions[id]
+	if ok {
+		s.cols = cols
+		s.rows = rows
+	}
+	m.mu.Unlock()
+
+	if !ok {
+		return fmt.Errorf("terminal session %q not found", id)
+	}
+
+	if s.instance.Active() {
+		s.instance.Resize(cols, rows)
+	}
+
+	return nil
+}
+
+// Get returns a session.
+func (m *SessionManager) Get(id string) (SessionInfo, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	s, ok := m.sessions[id]
+	if !ok {
+		return SessionInfo{}, false
+	}
+
+	return s.info(), true
+}
+
+// List lists sessions sorted by creation time.
+func (m *SessionManager) List() []SessionInfo {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	list := make([]SessionInfo, 0, len(m.sessions))
+	for _, s := range m.sessions {
+		list = append(list, s.info())
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		if !list[i].CreatedAt.Equal(list[j].CreatedAt) {
+			return list[i].CreatedAt.Before(list[j].CreatedAt)
+		}
+		return list[i].ID < list[j].ID
+	})
+
+	return list
+}
+
+// Stop stops a session.
+func (m *SessionManager) Stop(id string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.stop(id)
+}
+
+// StopAll stops every session.
+func (m *SessionManager) StopAll() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for id := range m.sessions {
+		m.stop(id)
+	}
+}
+
+// stop stops a session. The caller must hold mu.
+func (m *SessionManager) stop(id string) {
+	s, ok := m.sessions[id]
+	if !ok {
+		return
+	}
+
+	s.instance.Stop()
+	s.cancel()
+	delete(m.sessions, id)
+}
+
+// removeInactive removes sessions whose process has exited. The caller must hold mu.
+func (m *SessionManager) removeInactive() {
+	for id, s := range m.sessions {
+		if !s.instance.Active() {
+			m.stop(id)
+		}
+	}
+}
+
+// reap stops idle sessions until ctx is cancelled.
+func (m *SessionManager) reap(ctx context.Context) {
+	interval := m.idleTimeout / 2
+	if interval > time.Minute {
+		interval = time.Minute
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			m.StopAll()
+			return
+		case <-ticker.C:
+			m.stopIdle()
+		}
+	}
+}
+
+// stopIdle stops sessions which have been idle longer than the idle timeout.
+func (m *SessionManager) stopIdle() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := m.now()
+	for id, s := range m.sessions {
+		if now.Sub(s.lastActivity) > m.idleTimeout {
+			s.instance.SetExitMessage(fmt.Sprintf("session was idle for more than %s", m.idleTimeout))
+			m.stop(id)
+
+			// Let an attached client show why the session ended.
+			if s.subscriber != nil {
+				select {
+				case s.subscriber <- s.instance:
+				default:
+				}
+			}
+		}
+	}
+
+	m.removeInactive()
+}
@@ -0,0 +1,234 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package terminal_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/octant/internal/terminal"
+	"github.com/vmware-tanzu/octant/internal/terminal/fake"
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+func podKey(name string) store.Key {
+	return store.Key{APIVersion: "v1", Kind: "Pod", Namespace: "default", Name: name}
+}
+
+func mockInstance(controller *gomock.Controller) *fake.MockInstance {
+	instance := fake.NewMockInstance(controller)
+	instance.EXPECT().Command().Return("bash").AnyTimes()
+	instance.EXPECT().Active().Return(true).AnyTimes()
+	return instance
+}
+
+// startWith returns a StartFunc which starts instance and records its activity channel.
+func startWith(instance terminal.Instance, activity *chan terminal.Instance) terminal.StartFunc {
+	return func(ctx context.Context, ch chan terminal.Instance) (terminal.Instance, error) {
+		if activity != nil {
+			*activity = ch
+		}
+		return instance, nil
+	}
+}
+
+func TestSessionManager_Open(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	m := terminal.NewManager(ctx)
+
+	instance := mockInstance(controller)
+
+	info, existing, err := m.Open(podKey("pod"), "container", startWith(instance, nil))
+	require.NoError(t, err)
+	assert.False(t, existing)
+	assert.NotEmpty(t, info.ID)
+	assert.Equal(t, "bash", info.Command)
+	assert.True(t, info.Active)
+	assert.False(t, info.Attached)
+
+	// The running session is reused instead of starting another shell.
+	again, existing, err := m.Open(podKey("pod"), "container", func(context.Context, chan terminal.Instance) (terminal.Instance, error) {
+		t.Fatal("started a second session for the container")
+		return nil, nil
+	})
+	require.NoError(t, err)
+	assert.True(t, existing)
+	assert.Equal(t, info.ID, again.ID)
+
+	got, ok := m.Instance(info.ID)
+	require.True(t, ok)
+	assert.Equal(t, instance, got)
+
+	require.Len(t, m.List(), 1)
+
+	instance.EXPECT().Stop()
+	m.Stop(info.ID)
+
+	_, ok = m.Get(info.ID)
+	assert.False(t, ok)
+}
+
+func TestSessionManager_Open_startError(t *testing.T) {
+	m := terminal.NewManager(context.Background(), terminal.WithIdleTimeout(0))
+
+	_, _, err := m.Open(podKey("pod"), "container", func(context.Context, chan terminal.Instance) (terminal.Instance, error) {
+		return nil, errors.New("no shell")
+	})
+	require.Error(t, err)
+	assert.Empty(t, m.List())
+}
+
+func TestSessionManager_Open_maxSessions(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	m := terminal.NewManager(context.Background(), terminal.WithIdleTimeout(0), terminal.WithMaxSessions(1))
+
+	_, _, err := m.Open(podKey("first"), "container", startWith(mockInstance(controller), nil))
+	require.NoError(t, err)
+
+	_, _, err = m.Open(podKey("second"), "container", startWith(mockInstance(controller), nil))
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, terminal.ErrMaxSessions))
+}
+
+func TestSessionManager_Open_maxSessionsWhileStarting(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	m := terminal.NewManager(context.Background(), terminal.WithIdleTimeout(0), terminal.WithMaxSessions(1))
+
+	// A session for another container starts while the first one is starting.
+	slow := mockInstance(controller)
+	slow.EXPECT().Stop()
+	_, _, err := m.Open(podKey("first"), "container", func(ctx context.Context, ch chan terminal.Instance) (terminal.Instance, error) {
+		_, _, err := m.Open(podKey("second"), "container", startWith(mockInstance(controller), nil))
+		require.NoError(t, err)
+		return slow, nil
+	})
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, terminal.ErrMaxSessions))
+	assert.Len(t, m.List(), 1)
+}
+
+func TestSessionManager_Open_replacesExited(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	m := terminal.NewManager(context.Background(), terminal.WithIdleTimeout(0))
+
+	exited := fake.NewMockInstance(controller)
+	exited.EXPECT().Command().Return("bash").AnyTimes()
+	exited.EXPECT().Active().Return(true)
+	exited.EXPECT().Active().Return(false).AnyTimes()
+	exited.EXPECT().Stop()
+
+	first, _, err := m.Open(podKey("pod"), "container", startWith(exited, nil))
+	require.NoError(t, err)
+
+	second, existing, err := m.Open(podKey("pod"), "container", startWith(mockInstance(controller), nil))
+	require.NoError(t, err)
+	assert.False(t, existing)
+	assert.NotEqual(t, first.ID, second.ID)
+}
+
+func TestSessionManager_Attach(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	m := terminal.NewManager(context.Background(), terminal.WithIdleTimeout(0))
+
+	instance := mockInstance(controller)
+
+	var activity chan terminal.Instance
+	info, _, err := m.Open(podKey("pod"), "container", startWith(instance, &activity))
+	require.NoError(t, err)
+
+	first := make(chan terminal.Instance, 1)
+	detachFirst, err := m.Attach(info.ID, first)
+	require.NoError(t, err)
+
+	got, ok := m.Get(info.ID)
+	require.True(t, ok)
+	assert.True(t, got.Attached)
+
+	activity <- instance
+	assert.Equal(t, instance, <-first)
+
+	// A reconnecting client replaces the previous one.
+	second := make(chan terminal.Instance, 1)
+	detachSecond, err := m.Attach(info.ID, second)
+	require.NoError(t, err)
+
+	// Detaching a replaced client doesn't detach the new one.
+	detachFirst()
+
+	activity <- instance
+	assert.Equal(t, instance, <-second)
+	assert.Empty(t, first)
+
+	detachSecond()
+	got, ok = m.Get(info.ID)
+	require.True(t, ok)
+	assert.False(t, got.Attached)
+
+	_, err = m.Attach("missing", second)
+	require.Error(t, err)
+}
+
+func TestSessionManager_Resize(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	m := terminal.NewManager(context.Background(), terminal.WithIdleTimeout(0))
+
+	instance := mockInstance(controller)
+	instance.EXPECT().Resize(uint16(80), uint16(24))
+
+	info, _, err := m.Open(podKey("pod"), "container", startWith(instance, nil))
+	require.NoError(t, err)
+
+	require.NoError(t, m.Resize(info.ID, 80, 24))
+
+	got, ok := m.Get(info.ID)
+	require.True(t, ok)
+	assert.Equal(t, uint16(80), got.Cols)
+	assert.Equal(t, uint16(24), got.Rows)
+
+	require.Error(t, m.Resize("missing", 80, 24))
+}
+
+func TestSessionManager_idleTimeout(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	m := terminal.NewManager(ctx, terminal.WithIdleTimeout(20*time.Millisecond))
+
+	instance := mockInstance(controller)
+	instance.EXPECT().SetExitMessage(gomock.Any())
+	instance.EXPECT().Stop()
+
+	_, _, err := m.Open(podKey("pod"), "container", startWith(instance, nil))
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		return len(m.List()) == 0
+	}, time.Second, 5*time.Millisecond)
+}
@@ -14,7 +14,7 @@ import (
 )
 
 // ToUnstructured converts an object to an unstructured.
-func ToUnstructured(t *testing.T, object runtime.Object) *unstructured.Unstructured {
+func ToUnstructured(t testing.TB, object runtime.Object) *unstructured.Unstructured {
 	m, err := runtime.DefaultUnstructuredConverter.ToUnstructured(object)
 	require.NoError(t, err)
 
@@ -24,7 +24,7 @@ func ToUnstructured(t *testing.T, object runtime.Object) *unstructured.Unstructu
 }
 
 // ToUnstructuredList converts a list of objects to a list of unstructured.
-func ToUnstructuredList(t *testing.T, objects ...runtime.Object) *unstructured.UnstructuredList {
+func ToUnstructuredList(t testing.TB, objects ...runtime.Object) *unstructured.UnstructuredList {
 	list := &unstructured.UnstructuredList{}
 
 	for _, object := range objects {
@@ -9,6 +9,7 @@ package kubernetes
 import (
 	"fmt"
 	"io"
+	"sort"
 	"strings"
 
 	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
@@ -23,6 +24,18 @@ import (
 	k8sYAML "sigs.k8s.io/yaml"
 )
 
+// SortObjects sorts objects by namespace and then name, so a list has the same order no
+// matter which order its objects were stored in.
+func SortObjects(objects []unstructured.Unstructured) {
+	sort.SliceStable(objects, func(i, j int) bool {
+		a, b := &objects[i], &objects[j]
+		if a.GetNamespace() != b.GetNamespace() {
+			return a.GetNamespace() < b.GetNamespace()
+		}
+		return a.GetName() < b.GetName()
+	})
+}
+
 // ReadObject reads an unstructured object from a reader.
 func ReadObject(r io.Reader) (*unstructured.Unstructured, error) {
 	d := yaml.NewYAMLOrJSONDecoder(r, 4096)
@@ -17,6 +17,7 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/install"
 	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes/scheme"
 
@@ -35,6 +36,32 @@ func TestReadObject(t *testing.T) {
 	require.Equal(t, "nginx-deployment", o.GetName())
 }
 
+func TestSortObjects(t *testing.T) {
+	object := func(namespace, name string) unstructured.Unstructured {
+		u := unstructured.Unstructured{}
+		u.SetNamespace(namespace)
+		u.SetName(name)
+		return u
+	}
+
+	objects := []unstructured.Unstructured{
+		object("b", "a"),
+		object("a", "b"),
+		object("", "c"),
+		object("a", "a"),
+	}
+
+	SortObjects(objects)
+
+	expected := []unstructured.Unstructured{
+		object("", "c"),
+		object("a", "a"),
+		object("a", "b"),
+		object("b", "a"),
+	}
+	assert.Equal(t, expected, objects)
+}
+
 func TestSerializeToString(t *testing.T) {
 	pod := testutil.CreatePod("pod")
 
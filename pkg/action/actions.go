@@ -10,4 +10,8 @@ const (
 	// The ActionRequest.Payload for this action contains a single string entry `namespace` with a value
 	// of the new current namespace.
 	RequestSetNamespace = "action.octant.dev/setNamespace"
+	// RequestShowMore is the action for showing more rows in the list tables of the current
+	// content. The ActionRequest.Payload for this action contains a number entry `limit` with the
+	// new row limit.
+	RequestShowMore = "action.octant.dev/showMore"
 )
@@ -7,8 +7,10 @@ package dash
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net"
 	"net/http"
 	"os"
@@ -18,20 +20,24 @@ import (
 
 	"github.com/vmware-tanzu/octant/internal/util/path_util"
 
-	"contrib.go.opencensus.io/exporter/jaeger"
 	"github.com/skratchdot/open-golang/open"
 	"github.com/soheilhy/cmux"
 	"github.com/spf13/afero"
 	"github.com/spf13/viper"
-	"go.opencensus.io/trace"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 
+	"github.com/vmware-tanzu/octant/internal/alerts"
 	"github.com/vmware-tanzu/octant/internal/api"
+	"github.com/vmware-tanzu/octant/internal/audit"
 	"github.com/vmware-tanzu/octant/internal/cluster"
 	"github.com/vmware-tanzu/octant/internal/config"
 	ocontext "github.com/vmware-tanzu/octant/internal/context"
 	"github.com/vmware-tanzu/octant/internal/describer"
 	oerrors "github.com/vmware-tanzu/octant/internal/errors"
+	"github.com/vmware-tanzu/octant/internal/featuregate"
+	"github.com/vmware-tanzu/octant/internal/i18n"
 	"github.com/vmware-tanzu/octant/internal/kubeconfig"
+	"github.com/vmware-tanzu/octant/internal/kubectlplugin"
 	internalLog "github.com/vmware-tanzu/octant/internal/log"
 	"github.com/vmware-tanzu/octant/internal/module"
 	"github.com/vmware-tanzu/octant/internal/modules/applications"
@@ -40,8 +46,15 @@ import (
 	"github.com/vmware-tanzu/octant/internal/modules/localcontent"
 	"github.com/vmware-tanzu/octant/internal/modules/overview"
 	"github.com/vmware-tanzu/octant/internal/modules/workloads"
+	"github.com/vmware-tanzu/octant/internal/notification"
 	"github.com/vmware-tanzu/octant/internal/objectstore"
+	"github.com/vmware-tanzu/octant/internal/persistence"
 	"github.com/vmware-tanzu/octant/internal/portforward"
+	"github.com/vmware-tanzu/octant/internal/preferences"
+	"github.com/vmware-tanzu/octant/internal/replay"
+	"github.com/vmware-tanzu/octant/internal/snapshot"
+	"github.com/vmware-tanzu/octant/internal/telemetry"
+	"github.com/vmware-tanzu/octant/internal/terminal"
 	"github.com/vmware-tanzu/octant/pkg/action"
 	"github.com/vmware-tanzu/octant/pkg/log"
 	"github.com/vmware-tanzu/octant/pkg/octant"
@@ -52,7 +65,8 @@ import (
 )
 
 type Options struct {
-	EnableOpenCensus       bool
+	Telemetry              telemetry.Options
+	FeatureGate            featuregate.Gate
 	DisableClusterOverview bool
 	KubeConfig             string
 	Namespace              string
@@ -65,6 +79,26 @@ type Options struct {
 	UserAgent              string
 	BuildInfo              config.BuildInfo
 	Listener               net.Listener
+	InformerResync         time.Duration
+	Headless               bool
+	PortForwardStateFile   string
+	PreferencesFile        string
+	WebhooksFile           string
+	AuditLogFile           string
+	AlertmanagerURL        string
+	PrometheusURL          string
+	AlertsRefreshInterval  time.Duration
+	KubectlPluginMappings  []kubectlplugin.Mapping
+	LocaleDir              string
+	RecordKinds            []schema.GroupVersionKind
+	RecordingFile          string
+	RecordingRetention     time.Duration
+	SnapshotFile           string
+	DisablePortForwardSave bool
+	StateBackend           persistence.Backend
+	StateNamespace         string
+	StateName              string
+	TerminalOptions        []terminal.ManagerOption
 	clusterClient          cluster.ClientInterface
 }
 
@@ -73,10 +107,28 @@ type RunnerOption struct {
 	nonClusterOption func(*Options)
 }
 
+// WithOpenCensus sends every trace to a Jaeger agent running on localhost.
 func WithOpenCensus() RunnerOption {
+	return WithTelemetry(telemetry.Options{
+		Exporter:   telemetry.ExporterJaeger,
+		SampleRate: 1,
+	})
+}
+
+// WithTelemetry configures where traces are exported.
+func WithTelemetry(telemetryOptions telemetry.Options) RunnerOption {
 	return RunnerOption{
 		nonClusterOption: func(o *Options) {
-			o.EnableOpenCensus = true
+			o.Telemetry = telemetryOptions
+		},
+	}
+}
+
+// WithFeatureGate sets the feature gate used to enable experimental features.
+func WithFeatureGate(featureGate featuregate.Gate) RunnerOption {
+	return RunnerOption{
+		nonClusterOption: func(o *Options) {
+			o.FeatureGate = featureGate
 		},
 	}
 }
@@ -182,6 +234,166 @@ func WithListener(listener net.Listener) RunnerOption {
 	}
 }
 
+func WithInformerResync(resync time.Duration) RunnerOption {
+	return RunnerOption{
+		kubeConfigOption: kubeconfig.Noop(),
+		nonClusterOption: func(o *Options) {
+			o.InformerResync = resync
+		},
+	}
+}
+
+// WithHeadless runs the dashboard without launching a browser. Once the dashboard
+// is serving, a ready message containing its URL is written to standard output.
+func WithHeadless() RunnerOption {
+	return RunnerOption{
+		kubeConfigOption: kubeconfig.Noop(),
+		nonClusterOption: func(o *Options) {
+			o.Headless = true
+		},
+	}
+}
+
+// WithPortForwardStateFile saves port forwards to a file so they are restored when
+// the dashboard restarts.
+func WithPortForwardStateFile(stateFile string) RunnerOption {
+	return RunnerOption{
+		kubeConfigOption: kubeconfig.Noop(),
+		nonClusterOption: func(o *Options) {
+			o.PortForwardStateFile = stateFile
+		},
+	}
+}
+
+// WithPreferencesFile saves the user's preferences to a file so they survive restarts.
+func WithPreferencesFile(preferencesFile string) RunnerOption {
+	return RunnerOption{
+		kubeConfigOption: kubeconfig.Noop(),
+		nonClusterOption: func(o *Options) {
+			o.PreferencesFile = preferencesFile
+		},
+	}
+}
+
+// WithWebhooksFile saves outbound webhook rules to a file so they survive restarts.
+func WithWebhooksFile(webhooksFile string) RunnerOption {
+	return RunnerOption{
+		kubeConfigOption: kubeconfig.Noop(),
+		nonClusterOption: func(o *Options) {
+			o.WebhooksFile = webhooksFile
+		},
+	}
+}
+
+// WithoutPortForwardSave doesn't save port forwards or restore them when the dashboard
+// restarts.
+func WithoutPortForwardSave() RunnerOption {
+	return RunnerOption{
+		kubeConfigOption: kubeconfig.Noop(),
+		nonClusterOption: func(o *Options) {
+			o.DisablePortForwardSave = true
+		},
+	}
+}
+
+// WithAuditLogFile sets the file mutating actions are recorded to. The audit log
+// is kept in memory if the file is blank.
+func WithAuditLogFile(auditLogFile string) RunnerOption {
+	return RunnerOption{
+		kubeConfigOption: kubeconfig.Noop(),
+		nonClusterOption: func(o *Options) {
+			o.AuditLogFile = auditLogFile
+		},
+	}
+}
+
+// WithAlerts sets where firing alerts are fetched from and how often. Alerts
+// are not shown if both URLs are blank.
+func WithAlerts(alertmanagerURL, prometheusURL string, refreshInterval time.Duration) RunnerOption {
+	return RunnerOption{
+		kubeConfigOption: kubeconfig.Noop(),
+		nonClusterOption: func(o *Options) {
+			o.AlertmanagerURL = alertmanagerURL
+			o.PrometheusURL = prometheusURL
+			o.AlertsRefreshInterval = refreshInterval
+		},
+	}
+}
+
+// WithKubectlPluginMappings sets the kinds installed kubectl plugins can run
+// against. The default mappings are used if it is not set.
+func WithKubectlPluginMappings(mappings []kubectlplugin.Mapping) RunnerOption {
+	return RunnerOption{
+		kubeConfigOption: kubeconfig.Noop(),
+		nonClusterOption: func(o *Options) {
+			o.KubectlPluginMappings = mappings
+		},
+	}
+}
+
+// WithLocaleDir sets the directory catalogs translating generated content are
+// loaded from. Each file is named after its locale, such as pt-BR.json.
+func WithLocaleDir(dir string) RunnerOption {
+	return RunnerOption{
+		kubeConfigOption: kubeconfig.Noop(),
+		nonClusterOption: func(o *Options) {
+			o.LocaleDir = dir
+		},
+	}
+}
+
+// WithRecording sets the kinds whose changes are recorded so they can be
+// replayed, the file they are saved to, and how long they are kept. Nothing is
+// recorded if kinds is empty, and changes are only kept in memory if the file is
+// blank.
+func WithRecording(kinds []schema.GroupVersionKind, file string, retention time.Duration) RunnerOption {
+	return RunnerOption{
+		kubeConfigOption: kubeconfig.Noop(),
+		nonClusterOption: func(o *Options) {
+			o.RecordKinds = kinds
+			o.RecordingFile = file
+			o.RecordingRetention = retention
+		},
+	}
+}
+
+// WithSnapshot shows the objects in a snapshot archive created by `octant snapshot
+// export` instead of the cluster's. Objects can't be changed since snapshots are read
+// only.
+func WithSnapshot(file string) RunnerOption {
+	return RunnerOption{
+		kubeConfigOption: kubeconfig.Noop(),
+		nonClusterOption: func(o *Options) {
+			o.SnapshotFile = file
+		},
+	}
+}
+
+// WithStateBackend configures where preferences and port forwards are saved. The
+// file backend uses the preferences and port forward state files. The ConfigMap and
+// Secret backends save to an object in namespace and name; blank values default to
+// the namespace Octant runs in and persistence.DefaultName.
+func WithStateBackend(backend persistence.Backend, namespace, name string) RunnerOption {
+	return RunnerOption{
+		kubeConfigOption: kubeconfig.Noop(),
+		nonClusterOption: func(o *Options) {
+			o.StateBackend = backend
+			o.StateNamespace = namespace
+			o.StateName = name
+		},
+	}
+}
+
+// WithTerminalOptions configures the manager which owns terminal sessions.
+func WithTerminalOptions(terminalOptions ...terminal.ManagerOption) RunnerOption {
+	return RunnerOption{
+		kubeConfigOption: kubeconfig.Noop(),
+		nonClusterOption: func(o *Options) {
+			o.TerminalOptions = append(o.TerminalOptions, terminalOptions...)
+		},
+	}
+}
+
 func WithClusterClient(client cluster.ClientInterface) RunnerOption {
 	return RunnerOption{
 		nonClusterOption: func(o *Options) {
@@ -197,10 +409,16 @@ type Runner struct {
 	moduleManager          *module.Manager
 	actionManager          *action.Manager
 	websocketClientManager *api.WebsocketClientManager
+	portForwarder          portforward.PortForwarder
+	terminalManager        terminal.Manager
+	stopTelemetry          func()
 	apiCreated             bool
 	fs                     afero.Fs
 }
 
+// shutdownTimeout is how long the runner waits for connections and plugins to stop.
+const shutdownTimeout = 5 * time.Second
+
 func NewRunner(ctx context.Context, logger log.Logger, opts ...RunnerOption) (*Runner, error) {
 	options := Options{}
 	for _, opt := range opts {
@@ -208,6 +426,16 @@ func NewRunner(ctx context.Context, logger log.Logger, opts ...RunnerOption) (*R
 	}
 
 	r := Runner{}
+
+	stopTelemetry, err := telemetry.Enable(logger, options.Telemetry)
+	if err != nil {
+		return nil, fmt.Errorf("enabling telemetry: %w", err)
+	}
+	r.stopTelemetry = stopTelemetry
+	if options.Telemetry.Enabled() {
+		logger.With("exporter", options.Telemetry.Exporter).Infof("Enabling telemetry")
+	}
+
 	ctx = internalLog.WithLoggerContext(ctx, logger)
 	ctx = ocontext.WithKubeConfigCh(ctx)
 	r.ctx = ctx
@@ -223,8 +451,6 @@ func NewRunner(ctx context.Context, logger log.Logger, opts ...RunnerOption) (*R
 	r.websocketClientManager = websocketClientManager
 	go websocketClientManager.Run(ctx)
 
-	var err error
-
 	var pluginService *pluginAPI.GRPCService
 	var apiService api.Service
 	var apiErr error
@@ -245,10 +471,14 @@ func NewRunner(ctx context.Context, logger log.Logger, opts ...RunnerOption) (*R
 		return nil, fmt.Errorf("failed to create dash instance: %w", err)
 	}
 
-	if viper.GetBool("disable-open-browser") {
+	if viper.GetBool("disable-open-browser") || options.Headless {
 		d.willOpenBrowser = false
 	}
 
+	if options.Headless {
+		d.readyWriter = os.Stdout
+	}
+
 	r.dash = d
 
 	return &r, nil
@@ -311,15 +541,41 @@ func (r *Runner) Start(startupCh, shutdownCh chan bool, opts ...RunnerOption) er
 
 	<-r.ctx.Done()
 
-	shutdownCtx := internalLog.WithLoggerContext(context.Background(), logger)
+	shutdownCtx, cancel := context.WithTimeout(internalLog.WithLoggerContext(context.Background(), logger), shutdownTimeout)
+	defer cancel()
+
+	r.shutdown(shutdownCtx)
+
+	shutdownCh <- true
+	return nil
+}
+
+// shutdown stops the dashboard's components after the runner's context is done. Informers
+// stop with the runner's context; browsers are sent a going away message, port forwards
+// are closed, plugin processes are terminated, and buffered traces are flushed.
+func (r *Runner) shutdown(ctx context.Context) {
+	logger := internalLog.From(ctx)
+
+	logger.Debugf("closing websocket connections")
+	r.websocketClientManager.Wait(ctx)
 
 	if r.apiCreated {
+		if r.portForwarder != nil {
+			logger.Debugf("stopping port forwards")
+			r.portForwarder.Stop()
+		}
+		if r.terminalManager != nil {
+			logger.Debugf("stopping terminal sessions")
+			r.terminalManager.StopAll()
+		}
 		r.moduleManager.Unload()
-		r.pluginManager.Stop(shutdownCtx)
+		logger.Debugf("stopping plugins")
+		r.pluginManager.Stop(ctx)
 	}
 
-	shutdownCh <- true
-	return nil
+	if r.stopTelemetry != nil {
+		r.stopTelemetry()
+	}
 }
 
 func (r *Runner) initAPI(ctx context.Context, logger log.Logger, opts ...RunnerOption) (*api.API, *pluginAPI.GRPCService, error) {
@@ -343,13 +599,6 @@ func (r *Runner) initAPI(ctx context.Context, logger log.Logger, opts ...RunnerO
 	}
 	clusterClient := kubeContextDecorator.ClusterClient()
 
-	if options.EnableOpenCensus {
-		if err := enableOpenCensus(); err != nil {
-			logger.Infof("Enabling OpenCensus")
-			return nil, nil, fmt.Errorf("enabling open census: %w", err)
-		}
-	}
-
 	nsClient, err := clusterClient.NamespaceClient()
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to create namespace client: %w", err)
@@ -362,7 +611,7 @@ func (r *Runner) initAPI(ctx context.Context, logger log.Logger, opts ...RunnerO
 
 	logger.Debugf("initial namespace for dashboard is %s", options.Namespace)
 
-	appObjectStore, err := initObjectStore(ctx, clusterClient)
+	appObjectStore, err := initObjectStore(ctx, clusterClient, options.InformerResync)
 	if err != nil {
 		return nil, nil, fmt.Errorf("initializing store: %w", err)
 	}
@@ -384,16 +633,42 @@ func (r *Runner) initAPI(ctx context.Context, logger log.Logger, opts ...RunnerO
 		}
 	}
 
-	portForwarder, err := initPortForwarder(ctx, clusterClient, appObjectStore)
+	preferencesDocument, portForwardsDocument, webhooksDocument, err := initStateDocuments(ctx, clusterClient, options)
+	if err != nil {
+		return nil, nil, fmt.Errorf("initializing state: %w", err)
+	}
+
+	portForwarder, err := initPortForwarder(ctx, clusterClient, appObjectStore, portForwardsDocument)
 	if err != nil {
 		return nil, nil, fmt.Errorf("initializing port forwarder: %w", err)
 	}
+	r.portForwarder = portForwarder
+
+	terminalManager := terminal.NewManager(ctx, options.TerminalOptions...)
+	r.terminalManager = terminalManager
+
+	notifier := notification.NewService(ctx, appObjectStore)
+	webhooks := notification.NewWebhooks(ctx, appObjectStore, webhooksDocument)
+
+	preferencesManager := initPreferences(preferencesDocument, logger)
+
+	auditLog := audit.NewLog(r.fs, options.AuditLogFile)
+	auditor := audit.NewAuditor(auditLog, kubeContextDecorator.ClusterClient, kubeContextDecorator.CurrentContext)
+	// Modules and plugins register actions through the auditor. Actions a plugin
+	// defines itself aren't known to change the cluster, so only the plugin's
+	// handlers for octant's mutating actions are recorded.
+	auditedActions := audit.NewRegistrar(r.actionManager, auditor)
+
+	var alertProvider alerts.Provider
+	if source := alerts.NewSource(options.AlertmanagerURL, options.PrometheusURL); source != nil {
+		alertProvider = alerts.NewService(source, options.AlertsRefreshInterval)
+	}
 
 	mo := &moduleOptions{
 		clusterClient: clusterClient,
 		namespace:     options.Namespace,
 		logger:        logger,
-		actionManager: r.actionManager,
+		actionManager: auditedActions,
 	}
 	moduleManager, err := initModuleManager(mo)
 	if err != nil {
@@ -409,7 +684,51 @@ func (r *Runner) initAPI(ctx context.Context, logger log.Logger, opts ...RunnerO
 		WebsocketClientManager: r.websocketClientManager,
 	}
 
-	pluginManager, err := initPlugin(moduleManager, r.actionManager, r.websocketClientManager, pluginDashboardService)
+	featureGate := options.FeatureGate
+	if featureGate == nil {
+		featureGate = featuregate.New()
+	}
+
+	var kubectlPlugins kubectlplugin.Manager
+	if featureGate.Enabled(featuregate.KubectlPlugins) {
+		mappings := options.KubectlPluginMappings
+		if mappings == nil {
+			mappings = kubectlplugin.DefaultMappings
+		}
+		installed := kubectlplugin.Discover(os.Getenv("PATH"), kubectlplugin.KrewRoot())
+		logger.Debugf("found %d kubectl plugins", len(installed))
+		kubectlPlugins = kubectlplugin.NewPlugins(ctx, installed, mappings, options.KubeConfig)
+	}
+
+	// Content is read through a store which can replay the recording or is backed
+	// by a snapshot, but everything else works with the live objects.
+	var replayManager replay.Manager
+	dashObjectStore := appObjectStore
+	if options.SnapshotFile != "" {
+		snapshotStore, err := initSnapshotStore(r.fs, options.SnapshotFile)
+		if err != nil {
+			return nil, nil, fmt.Errorf("initializing snapshot store: %w", err)
+		}
+		logger.Debugf("showing objects from snapshot %s", options.SnapshotFile)
+		dashObjectStore = snapshotStore
+	} else if len(options.RecordKinds) > 0 {
+		recording := replay.NewRecording(r.fs, options.RecordingFile, replay.WithRetention(options.RecordingRetention))
+		if err := recording.Load(options.RecordKinds); err != nil {
+			logger.WithErr(err).Errorf("load recording")
+		}
+		go recording.Run(ctx)
+		recorder := replay.NewRecorder(ctx, appObjectStore, recording, options.RecordKinds)
+		replayManager = recorder
+		dashObjectStore = replay.NewStore(appObjectStore, recorder)
+	}
+
+	translator, err := i18n.LoadCatalogs(r.fs, options.LocaleDir)
+	if err != nil {
+		return nil, nil, fmt.Errorf("load locale catalogs: %w", err)
+	}
+	logger.Debugf("content can be translated to %s", strings.Join(translator.Locales(), ", "))
+
+	pluginManager, err := initPlugin(moduleManager, auditedActions, r.websocketClientManager, pluginDashboardService, featureGate)
 	if err != nil {
 		return nil, nil, fmt.Errorf("initializing plugin manager: %w", err)
 	}
@@ -432,12 +751,22 @@ func (r *Runner) initAPI(ctx context.Context, logger log.Logger, opts ...RunnerO
 		crdWatcher,
 		logger,
 		moduleManager,
-		appObjectStore,
+		dashObjectStore,
 		errorStore,
 		pluginManager,
 		portForwarder,
+		terminalManager,
+		notifier,
+		webhooks,
+		preferencesManager,
+		auditor,
+		alertProvider,
+		kubectlPlugins,
+		translator,
+		replayManager,
 		restConfigOptions,
 		buildInfo,
+		featureGate,
 		false,
 	)
 
@@ -475,13 +804,15 @@ func (r *Runner) initAPI(ctx context.Context, logger log.Logger, opts ...RunnerO
 }
 
 // initObjectStore initializes the cluster object store interface
-func initObjectStore(ctx context.Context, client cluster.ClientInterface) (store.Store, error) {
+func initObjectStore(ctx context.Context, client cluster.ClientInterface, resync time.Duration) (store.Store, error) {
 	if client == nil {
 		return nil, fmt.Errorf("nil cluster client")
 	}
 
 	resourceAccess := objectstore.NewResourceAccess(client)
-	appObjectStore, err := objectstore.NewDynamicCache(ctx, client, objectstore.Access(resourceAccess))
+	appObjectStore, err := objectstore.NewDynamicCache(ctx, client,
+		objectstore.Access(resourceAccess),
+		objectstore.InformerResync(resync))
 
 	if err != nil {
 		return nil, fmt.Errorf("creating object store for app: %w", err)
@@ -490,8 +821,95 @@ func initObjectStore(ctx context.Context, client cluster.ClientInterface) (store
 	return appObjectStore, nil
 }
 
-func initPortForwarder(ctx context.Context, client cluster.ClientInterface, appObjectStore store.Store) (portforward.PortForwarder, error) {
-	return portforward.Default(ctx, client, appObjectStore)
+// initSnapshotStore initializes an object store with the objects in a snapshot archive.
+func initSnapshotStore(fs afero.Fs, file string) (store.Store, error) {
+	f, err := fs.Open(file)
+	if err != nil {
+		return nil, fmt.Errorf("open snapshot: %w", err)
+	}
+	defer f.Close()
+
+	s, err := snapshot.Read(f)
+	if err != nil {
+		return nil, fmt.Errorf("read snapshot %s: %w", file, err)
+	}
+
+	return objectstore.NewSnapshotStore(s), nil
+}
+
+// initStateDocuments returns the documents preferences, port forwards, and webhook
+// rules are saved to. A document is nil if its state shouldn't be saved.
+func initStateDocuments(ctx context.Context, client cluster.ClientInterface, options Options) (preferencesDocument, portForwardsDocument, webhooksDocument persistence.Document, err error) {
+	var stateStore persistence.Store
+
+	switch options.StateBackend {
+	case "", persistence.BackendFile:
+		fs := afero.NewOsFs()
+		if options.PreferencesFile != "" {
+			preferencesDocument = persistence.NewFileDocument(fs, options.PreferencesFile)
+		}
+		if options.PortForwardStateFile != "" && !options.DisablePortForwardSave {
+			portForwardsDocument = persistence.NewFileDocument(fs, options.PortForwardStateFile)
+		}
+		if options.WebhooksFile != "" {
+			webhooksDocument = persistence.NewFileDocument(fs, options.WebhooksFile)
+		}
+		return preferencesDocument, portForwardsDocument, webhooksDocument, nil
+	case persistence.BackendConfigMap, persistence.BackendSecret:
+		kubernetesClient, err := client.KubernetesClient()
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("create kubernetes client: %w", err)
+		}
+
+		namespace := options.StateNamespace
+		if namespace == "" {
+			namespace = persistence.InClusterNamespace()
+		}
+		if namespace == "" {
+			namespace = client.DefaultNamespace()
+		}
+
+		name := options.StateName
+		if name == "" {
+			name = persistence.DefaultName
+		}
+
+		if options.StateBackend == persistence.BackendSecret {
+			stateStore = persistence.NewSecretStore(ctx, kubernetesClient, namespace, name)
+		} else {
+			stateStore = persistence.NewConfigMapStore(ctx, kubernetesClient, namespace, name)
+		}
+	default:
+		return nil, nil, nil, fmt.Errorf("unknown state backend %q", options.StateBackend)
+	}
+
+	preferencesDocument = stateStore.Document(persistence.DocumentPreferences)
+	if !options.DisablePortForwardSave {
+		portForwardsDocument = stateStore.Document(persistence.DocumentPortForwards)
+	}
+	webhooksDocument = stateStore.Document(persistence.DocumentWebhooks)
+
+	return preferencesDocument, portForwardsDocument, webhooksDocument, nil
+}
+
+// initPreferences creates the preferences manager. Preferences are kept in memory if
+// document is nil.
+func initPreferences(document persistence.Document, logger log.Logger) preferences.Manager {
+	var preferencesStore preferences.Store
+	if document != nil {
+		preferencesStore = preferences.NewDocumentStore(document)
+	}
+
+	return preferences.NewService(preferencesStore, preferences.WithLogger(logger))
+}
+
+func initPortForwarder(ctx context.Context, client cluster.ClientInterface, appObjectStore store.Store, document persistence.Document) (portforward.PortForwarder, error) {
+	var definitions portforward.DefinitionStore
+	if document != nil {
+		definitions = portforward.NewDocumentDefinitionStore(document)
+	}
+
+	return portforward.Default(ctx, client, appObjectStore, definitions)
 }
 
 type moduleOptions struct {
@@ -502,7 +920,7 @@ type moduleOptions struct {
 	pluginManager  *plugin.Manager
 	portForwarder  portforward.PortForwarder
 	kubeConfigPath string
-	actionManager  *action.Manager
+	actionManager  module.ActionRegistrar
 }
 
 func initModules(ctx context.Context, dashConfig config.Dash, namespace string, options Options) ([]module.Module, error) {
@@ -588,6 +1006,7 @@ type dash struct {
 	handlerFactory  *octant.HandlerFactory
 	server          http.Server
 	pluginService   pluginAPI.Service
+	readyWriter     io.Writer
 }
 
 func newDash(listener net.Listener, namespace, uiURL string, browserPath string, apiHandler api.Service, pluginHandler pluginAPI.Service, logger log.Logger) (*dash, error) {
@@ -660,6 +1079,12 @@ func (d *dash) Run(ctx context.Context, startupCh chan bool) error {
 
 	d.logger.Infof("Dashboard is available at %s\n", dashboardURL)
 
+	if d.readyWriter != nil {
+		if err := writeReadyMessage(d.readyWriter, dashboardURL); err != nil {
+			d.logger.Warnf("unable to write ready message: %v", err)
+		}
+	}
+
 	if startupCh != nil {
 		startupCh <- true
 	}
@@ -682,24 +1107,19 @@ func (d *dash) Run(ctx context.Context, startupCh chan bool) error {
 	return d.server.Shutdown(shutdownCtx)
 }
 
-func enableOpenCensus() error {
-	agentEndpointURI := "localhost:6831"
+// readyMessage is written when running headless so wrappers (e.g. systemd units or
+// container entrypoints) can detect when the dashboard is serving and where.
+type readyMessage struct {
+	Status string `json:"status"`
+	URL    string `json:"url"`
+}
 
-	je, err := jaeger.NewExporter(jaeger.Options{
-		AgentEndpoint: agentEndpointURI,
-		Process: jaeger.Process{
-			ServiceName: "octant",
-		},
+// writeReadyMessage writes a single line JSON ready message to w.
+func writeReadyMessage(w io.Writer, dashboardURL string) error {
+	return json.NewEncoder(w).Encode(readyMessage{
+		Status: "ready",
+		URL:    dashboardURL,
 	})
-
-	if err != nil {
-		return fmt.Errorf("failed to create Jaeger exporter: %w", err)
-	}
-
-	trace.RegisterExporter(je)
-	trace.ApplyConfig(trace.Config{DefaultSampler: trace.AlwaysSample()})
-
-	return nil
 }
 
 // ValidateKubeConfig returns a valid file list of kube config(s)
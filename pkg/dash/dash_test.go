@@ -29,11 +29,17 @@ import (
 	"github.com/spf13/afero"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	kubeFake "k8s.io/client-go/kubernetes/fake"
 
 	"github.com/vmware-tanzu/octant/internal/cluster"
 	internalLog "github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/internal/persistence"
+	"github.com/vmware-tanzu/octant/internal/snapshot"
 	"github.com/vmware-tanzu/octant/pkg/event"
+	"github.com/vmware-tanzu/octant/pkg/store"
 
 	clusterFake "github.com/vmware-tanzu/octant/internal/cluster/fake"
 	"github.com/vmware-tanzu/octant/pkg/log"
@@ -201,7 +207,7 @@ func mockClusterClientReturningNamespace(controller *gomock.Controller, namespac
 	nsClient.EXPECT().Names().Return([]string{namespace}, nil)
 	nsClient.EXPECT().ProvidedNamespaces().Return([]string{namespace})
 	ssar := clusterFake.NewMockSelfSubjectAccessReviewInterface(controller)
-	ssar.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).Return(&v1.SelfSubjectAccessReview{}, nil)
+	ssar.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).Return(&v1.SelfSubjectAccessReview{}, nil).MinTimes(1)
 	authClient := clusterFake.NewMockAuthorizationV1Interface(controller)
 	authClient.EXPECT().SelfSubjectAccessReviews().Return(ssar).MinTimes(1)
 	k8sClient := clusterFake.NewMockKubernetesInterface(controller)
@@ -480,3 +486,80 @@ func readNextMessage(conn *websocket.Conn) ([]byte, error) {
 	}
 	return msgBytes, nil
 }
+
+func Test_writeReadyMessage(t *testing.T) {
+	var sb strings.Builder
+	require.NoError(t, writeReadyMessage(&sb, "http://127.0.0.1:7777"))
+
+	assert.Equal(t, `{"status":"ready","url":"http://127.0.0.1:7777"}`+"\n", sb.String())
+}
+
+func Test_initStateDocuments(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	kubernetesClient := kubeFake.NewSimpleClientset()
+
+	clusterClient := clusterFake.NewMockClientInterface(controller)
+	clusterClient.EXPECT().KubernetesClient().Return(kubernetesClient, nil)
+	clusterClient.EXPECT().DefaultNamespace().Return("octant")
+
+	options := Options{
+		StateBackend:           persistence.BackendSecret,
+		DisablePortForwardSave: true,
+	}
+
+	preferencesDocument, portForwardsDocument, webhooksDocument, err := initStateDocuments(context.Background(), clusterClient, options)
+	require.NoError(t, err)
+	assert.Nil(t, portForwardsDocument)
+
+	require.NoError(t, preferencesDocument.Save([]byte("{}")))
+	require.NoError(t, webhooksDocument.Save([]byte("[]")))
+
+	secret, err := kubernetesClient.CoreV1().Secrets("octant").Get(context.Background(), persistence.DefaultName, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, []byte("{}"), secret.Data["preferences.json"])
+	assert.Equal(t, []byte("[]"), secret.Data["webhooks.json"])
+}
+
+func Test_initStateDocuments_file(t *testing.T) {
+	options := Options{
+		PreferencesFile: "/state/preferences.json",
+	}
+
+	preferencesDocument, portForwardsDocument, webhooksDocument, err := initStateDocuments(context.Background(), nil, options)
+	require.NoError(t, err)
+	assert.NotNil(t, preferencesDocument)
+	assert.Nil(t, portForwardsDocument)
+	assert.Nil(t, webhooksDocument)
+
+	_, _, _, err = initStateDocuments(context.Background(), nil, Options{StateBackend: "etcd"})
+	require.Error(t, err)
+}
+
+func Test_initSnapshotStore(t *testing.T) {
+	pod := &unstructured.Unstructured{}
+	pod.SetAPIVersion("v1")
+	pod.SetKind("Pod")
+	pod.SetNamespace("default")
+	pod.SetName("pod")
+
+	s := snapshot.New()
+	s.Add(pod)
+
+	fs := afero.NewMemMapFs()
+	f, err := fs.Create("/snapshot.tar.gz")
+	require.NoError(t, err)
+	require.NoError(t, snapshot.Write(f, s))
+	require.NoError(t, f.Close())
+
+	objectStore, err := initSnapshotStore(fs, "/snapshot.tar.gz")
+	require.NoError(t, err)
+
+	got, err := objectStore.Get(context.Background(), store.Key{APIVersion: "v1", Kind: "Pod", Namespace: "default", Name: "pod"})
+	require.NoError(t, err)
+	assert.Equal(t, "pod", got.GetName())
+
+	_, err = initSnapshotStore(fs, "/missing.tar.gz")
+	require.Error(t, err)
+}
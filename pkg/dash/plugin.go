@@ -8,20 +8,25 @@ package dash
 import (
 	"fmt"
 
+	"github.com/vmware-tanzu/octant/internal/featuregate"
 	"github.com/vmware-tanzu/octant/internal/module"
-	"github.com/vmware-tanzu/octant/pkg/action"
 	"github.com/vmware-tanzu/octant/pkg/event"
 	"github.com/vmware-tanzu/octant/pkg/plugin"
 	"github.com/vmware-tanzu/octant/pkg/plugin/api"
 )
 
-func initPlugin(moduleManager module.ManagerInterface, actionManager *action.Manager, ws event.WSClientGetter, service api.Service) (*plugin.Manager, error) {
+func initPlugin(moduleManager module.ManagerInterface, actionManager plugin.ActionRegistrar, ws event.WSClientGetter, service api.Service, featureGate featuregate.Gate) (*plugin.Manager, error) {
 	apiService, err := api.New(service)
 	if err != nil {
 		return nil, fmt.Errorf("create dashboard api: %w", err)
 	}
 
-	m := plugin.NewManager(apiService, moduleManager, actionManager, ws)
+	var options []plugin.ManagerOption
+	if !featureGate.Enabled(featuregate.JavaScriptPlugins) {
+		options = append(options, plugin.WithoutJavaScriptPlugins())
+	}
+
+	m := plugin.NewManager(apiService, moduleManager, actionManager, ws, options...)
 
 	pluginList, err := plugin.AvailablePlugins(plugin.DefaultConfig)
 	if err != nil {
@@ -51,12 +51,24 @@ const (
 	// EventTypeAlert is an alert event.
 	EventTypeAlert EventType = "event.octant.dev/alert"
 
+	// EventTypeModal is an event with a modal to open.
+	EventTypeModal EventType = "event.octant.dev/modal"
+
+	// EventTypeNotification is a resource change notification event.
+	EventTypeNotification EventType = "event.octant.dev/notification"
+
+	// EventTypePreferences is a user preferences event.
+	EventTypePreferences EventType = "event.octant.dev/preferences"
+
 	// EventTypeRefresh is a refresh event.
 	EventTypeRefresh EventType = "event.octant.dev/refresh"
 
 	// EventTypeLoading is a loading event.
 	EventTypeLoading EventType = "event.octant.dev/loading"
 
+	// EventTypeTableRows is an event with more rows for a streamed table.
+	EventTypeTableRows EventType = "event.octant.dev/tableRows"
+
 	// EventTypeAppLogs is an app logs event.
 	EventTypeAppLogs EventType = "event.octant.dev/app-logs"
 
@@ -22,6 +22,9 @@ const (
 	Webhooks        = "animation"
 	Nodes           = "nodes"
 	PortForwards    = "router"
+	Bookmarks       = "bookmark"
+	Scheduling      = "calendar"
+	Alerts          = "bell"
 
 	ClusterOverview                   = "objects"
 	ClusterOverviewClusterRole        = "c-role"
@@ -29,9 +32,15 @@ const (
 	ClusterOverviewNamespace          = "ns"
 	ClusterOverviewNode               = "node"
 	ClusterOverviewPersistentVolume   = "pv"
+	ClusterOverviewCSIDriver          = "csi"
+	ClusterOverviewCSINode            = "csi-node"
+	ClusterOverviewPriorityClass      = "pc"
 
-	Configuration       = "cog"
-	ConfigurationPlugin = "plugin"
+	Configuration          = "cog"
+	ConfigurationPlugin    = "plugin"
+	ConfigurationAuditLog  = "history"
+	ConfigurationWebhooks  = "share"
+	ConfigurationRecording = "clock"
 
 	CustomResourceDefinition = "dna"
 )
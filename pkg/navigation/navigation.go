@@ -56,6 +56,8 @@ type Navigation struct {
 	Children    []Navigation `json:"children,omitempty"`
 	IconName    string       `json:"iconName,omitempty"`
 	Loading     bool         `json:"isLoading"`
+	// Status is the status of the object an entry links to, e.g. ok, warning, or error.
+	Status string `json:"status,omitempty"`
 }
 
 // New creates a Navigation.
@@ -66,6 +66,8 @@ func (hf *HandlerFactory) Handler(ctx context.Context) (http.Handler, error) {
 	}
 
 	router.PathPrefix(api.PathPrefix).Handler(backendHandler)
+	// Unversioned API paths are served by the current API version with deprecation headers.
+	router.PathPrefix(api.LegacyPathPrefix + "/").Handler(api.CompatHandler(backendHandler))
 
 	router.PathPrefix("/").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		hf.mu.RLock()
@@ -264,6 +264,13 @@ type ActionRegistrar interface {
 // ManagerOption is an option for configuring Manager.
 type ManagerOption func(*Manager)
 
+// WithoutJavaScriptPlugins configures the manager to not load JavaScript plugins.
+func WithoutJavaScriptPlugins() ManagerOption {
+	return func(m *Manager) {
+		m.disableJavaScript = true
+	}
+}
+
 // Manager manages plugins
 type Manager struct {
 	PortForwarder   portforward.PortForwarder
@@ -275,9 +282,10 @@ type Manager struct {
 
 	Runners Runners
 
-	octantClient javascript.OctantClient
-	configs      []config
-	store        ManagerStore
+	octantClient      javascript.OctantClient
+	configs           []config
+	store             ManagerStore
+	disableJavaScript bool
 
 	lock sync.Mutex
 }
@@ -535,11 +543,15 @@ func (m *Manager) Start(ctx context.Context) error {
 	m.lock.Lock()
 	defer m.lock.Unlock()
 
-	if err := m.startJS(ctx); err != nil {
-		return err
-	}
+	if m.disableJavaScript {
+		logger.Debugf("JavaScript plugins are disabled")
+	} else {
+		if err := m.startJS(ctx); err != nil {
+			return err
+		}
 
-	go m.watchJS(ctx)
+		go m.watchJS(ctx)
+	}
 
 	for i := range m.configs {
 		c := m.configs[i]
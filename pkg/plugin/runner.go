@@ -71,6 +71,10 @@ func (pr *DefaultRunner) Run(ctx context.Context, object runtime.Object, clientN
 	for _, name := range clientNames {
 		fn := func(name string) func() error {
 			return func() error {
+				if err := ctx.Err(); err != nil {
+					return err
+				}
+
 				if err := pr.RunFunc(ctx, name, gvk, object); err != nil {
 					return fmt.Errorf("running on %s: %w", name, err)
 				}
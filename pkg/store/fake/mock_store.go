@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/vmware-tanzu/octant/pkg/store (interfaces: Store)
+// Source: github.com/vmware-tanzu/octant/pkg/store (interfaces: Store,WatchHandle)
 
 // Package fake is a generated GoMock package.
 package fake
@@ -69,6 +69,21 @@ func (mr *MockStoreMockRecorder) CreateOrUpdateFromYAML(arg0, arg1, arg2 interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdateFromYAML", reflect.TypeOf((*MockStore)(nil).CreateOrUpdateFromYAML), arg0, arg1, arg2)
 }
 
+// DryRunFromYAML mocks base method
+func (m *MockStore) DryRunFromYAML(arg0 context.Context, arg1, arg2 string) ([]store.DryRunResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DryRunFromYAML", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]store.DryRunResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DryRunFromYAML indicates an expected call of DryRunFromYAML
+func (mr *MockStoreMockRecorder) DryRunFromYAML(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DryRunFromYAML", reflect.TypeOf((*MockStore)(nil).DryRunFromYAML), arg0, arg1, arg2)
+}
+
 // Delete mocks base method
 func (m *MockStore) Delete(arg0 context.Context, arg1 store.Key) error {
 	m.ctrl.T.Helper()
@@ -188,11 +203,12 @@ func (mr *MockStoreMockRecorder) UpdateClusterClient(arg0, arg1 interface{}) *go
 }
 
 // Watch mocks base method
-func (m *MockStore) Watch(arg0 context.Context, arg1 store.Key, arg2 cache.ResourceEventHandler) error {
+func (m *MockStore) Watch(arg0 context.Context, arg1 store.Key, arg2 cache.ResourceEventHandler) (store.WatchHandle, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Watch", arg0, arg1, arg2)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].(store.WatchHandle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // Watch indicates an expected call of Watch
@@ -200,3 +216,52 @@ func (mr *MockStoreMockRecorder) Watch(arg0, arg1, arg2 interface{}) *gomock.Cal
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Watch", reflect.TypeOf((*MockStore)(nil).Watch), arg0, arg1, arg2)
 }
+
+// MockWatchHandle is a mock of WatchHandle interface
+type MockWatchHandle struct {
+	ctrl     *gomock.Controller
+	recorder *MockWatchHandleMockRecorder
+}
+
+// MockWatchHandleMockRecorder is the mock recorder for MockWatchHandle
+type MockWatchHandleMockRecorder struct {
+	mock *MockWatchHandle
+}
+
+// NewMockWatchHandle creates a new mock instance
+func NewMockWatchHandle(ctrl *gomock.Controller) *MockWatchHandle {
+	mock := &MockWatchHandle{ctrl: ctrl}
+	mock.recorder = &MockWatchHandleMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockWatchHandle) EXPECT() *MockWatchHandleMockRecorder {
+	return m.recorder
+}
+
+// Cancel mocks base method
+func (m *MockWatchHandle) Cancel() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Cancel")
+}
+
+// Cancel indicates an expected call of Cancel
+func (mr *MockWatchHandleMockRecorder) Cancel() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cancel", reflect.TypeOf((*MockWatchHandle)(nil).Cancel))
+}
+
+// Done mocks base method
+func (m *MockWatchHandle) Done() <-chan struct{} {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Done")
+	ret0, _ := ret[0].(<-chan struct{})
+	return ret0
+}
+
+// Done indicates an expected call of Done
+func (mr *MockWatchHandleMockRecorder) Done() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Done", reflect.TypeOf((*MockWatchHandle)(nil).Done))
+}
@@ -19,23 +19,34 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/cache"
 
 	"github.com/vmware-tanzu/octant/internal/cluster"
 	"github.com/vmware-tanzu/octant/pkg/action"
 )
 
-//go:generate mockgen  -destination=./fake/mock_store.go -package=fake github.com/vmware-tanzu/octant/pkg/store Store
+//go:generate mockgen  -destination=./fake/mock_store.go -package=fake github.com/vmware-tanzu/octant/pkg/store Store,WatchHandle
 
 // UpdateFn is a function that is called when
 type UpdateFn func(store Store)
 
+// WatchHandle is a handler registered with Store.Watch.
+type WatchHandle interface {
+	// Cancel removes the handler. It is safe to call more than once.
+	Cancel()
+	// Done is closed once the handler is removed.
+	Done() <-chan struct{}
+}
+
 // Store stores Kubernetes objects.
 type Store interface {
 	List(ctx context.Context, key Key) (list *unstructured.UnstructuredList, loading bool, err error)
 	Get(ctx context.Context, key Key) (object *unstructured.Unstructured, err error)
 	Delete(ctx context.Context, key Key) error
-	Watch(ctx context.Context, key Key, handler cache.ResourceEventHandler) error
+	// Watch calls handler when objects matching key change. The handler is removed when
+	// the returned handle is cancelled or ctx is done.
+	Watch(ctx context.Context, key Key, handler cache.ResourceEventHandler) (WatchHandle, error)
 	Unwatch(ctx context.Context, groupVersionKinds ...schema.GroupVersionKind) error
 	UpdateClusterClient(ctx context.Context, client cluster.ClientInterface) error
 	RegisterOnUpdate(fn UpdateFn)
@@ -47,6 +58,39 @@ type Store interface {
 	// An error creating a resource halts resource creation.
 	// A list of created resources is returned. You may have created resources AND a non-nil error.
 	CreateOrUpdateFromYAML(ctx context.Context, namespace, input string) ([]string, error)
+	// DryRunFromYAML validates the resources in YAML input with a server-side dry run.
+	// A result is returned for each resource, including resources which couldn't be
+	// validated because the cluster couldn't be reached. Namespaces created earlier in
+	// the input are treated as present. An error is only returned if the input can't be
+	// parsed.
+	DryRunFromYAML(ctx context.Context, namespace, input string) ([]DryRunResult, error)
+}
+
+// DryRunResult is the result of a server-side dry run for a resource.
+type DryRunResult struct {
+	// Key is the key of the resource.
+	Key Key
+	// Exists is true if the resource would be updated rather than created.
+	Exists bool
+	// Err is the reason the resource failed validation.
+	Err error
+}
+
+// String describes the result of the dry run.
+func (r DryRunResult) String() string {
+	object := fmt.Sprintf("%s (%s) %s", r.Key.Kind, r.Key.APIVersion, r.Key.Name)
+	if r.Key.Namespace != "" {
+		object = fmt.Sprintf("%s in %s", object, r.Key.Namespace)
+	}
+
+	switch {
+	case r.Err != nil:
+		return fmt.Sprintf("%s is invalid: %s", object, r.Err)
+	case r.Exists:
+		return fmt.Sprintf("%s will be updated", object)
+	default:
+		return fmt.Sprintf("%s will be created", object)
+	}
 }
 
 // Key is a key for the object store.
@@ -57,6 +101,8 @@ type Key struct {
 	Name          string                `json:"name"`
 	Selector      *labels.Set           `json:"selector"`
 	LabelSelector *metav1.LabelSelector `json:"labelSelector"`
+	// OwnerUID limits a list to objects with an owner reference to the object with this UID.
+	OwnerUID types.UID `json:"ownerUID,omitempty"`
 }
 
 // Validate validates the key.
@@ -108,6 +154,10 @@ func (k Key) String() string {
 		sb.WriteString("'")
 	}
 
+	if k.OwnerUID != "" {
+		sb.WriteString(fmt.Sprintf(", OwnerUID='%s'", k.OwnerUID))
+	}
+
 	sb.WriteString("]")
 
 	return sb.String()
@@ -23,6 +23,8 @@ type ContentResponse struct {
 	Components         []Component      `json:"viewComponents"`
 	ExtensionComponent Component        `json:"extensionComponent,omitempty"`
 	ButtonGroup        *ButtonGroup     `json:"buttonGroup,omitempty"`
+	// Alert is shown above the content, e.g. when the content may be out of date.
+	Alert *Alert `json:"alert,omitempty"`
 }
 
 // NewContentResponse creates an instance of ContentResponse.
@@ -59,12 +61,15 @@ func (c *ContentResponse) UnmarshalJSON(data []byte) error {
 	stage := struct {
 		Title      []TypedObject `json:"title,omitempty"`
 		Components []TypedObject `json:"viewComponents,omitempty"`
+		Alert      *Alert        `json:"alert,omitempty"`
 	}{}
 
 	if err := json.Unmarshal(data, &stage); err != nil {
 		return err
 	}
 
+	c.Alert = stage.Alert
+
 	for _, t := range stage.Title {
 		title, err := t.ToComponent()
 		if err != nil {
@@ -116,6 +121,9 @@ type Metadata struct {
 	Type     string           `json:"type"`
 	Title    []TitleComponent `json:"title,omitempty"`
 	Accessor string           `json:"accessor,omitempty"`
+	// Tooltip is text shown when the component is hovered, e.g. documentation
+	// for the field the component shows.
+	Tooltip string `json:"tooltip,omitempty"`
 }
 
 // SetTitleText sets the title using text components.
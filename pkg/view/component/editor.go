@@ -31,6 +31,10 @@ type EditorConfig struct {
 	Metadata     map[string]string `json:"metadata"`
 	SubmitAction string            `json:"submitAction,omitempty"`
 	SubmitLabel  string            `json:"submitLabel,omitempty"`
+	// ValidateAction is an action which checks the value without submitting it.
+	// A validate button is shown when it is set.
+	ValidateAction string `json:"validateAction,omitempty"`
+	ValidateLabel  string `json:"validateLabel,omitempty"`
 }
 
 // NewEditor creates an instance of an editor component.
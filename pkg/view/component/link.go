@@ -24,6 +24,9 @@ type LinkConfig struct {
 	// Status sets the status of the component.
 	Status       TextStatus `json:"status,omitempty" tsType:"number"`
 	StatusDetail Component  `json:"statusDetail,omitempty"`
+	// Download sets if the link downloads a file from the API instead of
+	// navigating to a page.
+	Download bool `json:"download,omitempty"`
 }
 
 func (lc *LinkConfig) UnmarshalJSON(data []byte) error {
@@ -32,6 +35,7 @@ func (lc *LinkConfig) UnmarshalJSON(data []byte) error {
 		Ref          string       `json:"ref,omitempty"`
 		Status       TextStatus   `json:"status,omitempty"`
 		StatusDetail *TypedObject `json:"statusDetail,omitempty"`
+		Download     bool         `json:"download,omitempty"`
 	}{}
 
 	if err := json.Unmarshal(data, &x); err != nil {
@@ -41,6 +45,7 @@ func (lc *LinkConfig) UnmarshalJSON(data []byte) error {
 	lc.Text = x.Text
 	lc.Ref = x.Ref
 	lc.Status = x.Status
+	lc.Download = x.Download
 	if x.StatusDetail != nil {
 		sd, err := x.StatusDetail.ToComponent()
 		if err != nil {
@@ -54,6 +59,13 @@ func (lc *LinkConfig) UnmarshalJSON(data []byte) error {
 
 type LinkOption func(l *Link)
 
+// DownloadLink configures a link to download a file from the API.
+func DownloadLink() LinkOption {
+	return func(l *Link) {
+		l.Config.Download = true
+	}
+}
+
 // NewLink creates a link component
 func NewLink(title, s, ref string, options ...LinkOption) *Link {
 	l := &Link{
@@ -64,6 +64,28 @@ func Test_Link_Marshal(t *testing.T) {
                   "ref": "/overview/deployments/nginx-deployment"
                 }
             }
+`,
+		},
+		{
+			name: "download",
+			input: &Link{
+				Config: LinkConfig{
+					Text:     "Download",
+					Ref:      "/api/v1/configmaps/default/settings/data/app.yaml",
+					Download: true,
+				},
+			},
+			expected: `
+            {
+                "metadata": {
+                  "type": "link"
+                },
+                "config": {
+                  "value": "Download",
+                  "ref": "/api/v1/configmaps/default/settings/data/app.yaml",
+                  "download": true
+                }
+            }
 `,
 		},
 	}
@@ -81,6 +103,11 @@ func Test_Link_Marshal(t *testing.T) {
 	}
 }
 
+func Test_DownloadLink(t *testing.T) {
+	l := NewLink("", "Download", "/download", DownloadLink())
+	assert.True(t, l.Config.Download)
+}
+
 func Test_Link_String(t *testing.T) {
 	c := NewLink("title", "string", "/path")
 	assert.Equal(t, "string", c.String())
@@ -32,6 +32,9 @@ type TableConfig struct {
 	Loading      bool                   `json:"loading"`
 	Filters      map[string]TableFilter `json:"filters"`
 	ButtonGroup  *ButtonGroup           `json:"buttonGroup,omitempty"`
+	// StreamID is set when the table holds only its first rows. The remaining rows are
+	// sent in table rows events with the same stream ID.
+	StreamID string `json:"streamID,omitempty"`
 }
 
 func (t *TableConfig) UnmarshalJSON(data []byte) error {
@@ -42,6 +45,7 @@ func (t *TableConfig) UnmarshalJSON(data []byte) error {
 		Loading      bool                   `json:"loading"`
 		Filters      map[string]TableFilter `json:"filters"`
 		ButtonGroup  *TypedObject           `json:"buttonGroup,omitempty"`
+		StreamID     string                 `json:"streamID,omitempty"`
 	}{}
 
 	if err := json.Unmarshal(data, &x); err != nil {
@@ -66,6 +70,7 @@ func (t *TableConfig) UnmarshalJSON(data []byte) error {
 	t.EmptyContent = x.EmptyContent
 	t.Loading = x.Loading
 	t.Filters = x.Filters
+	t.StreamID = x.StreamID
 
 	return nil
 }
@@ -217,9 +222,11 @@ type multiSorter struct {
 	less []lessFunc
 }
 
+// Sort sorts rows. Rows which are equal stay in the order they were added, so a table
+// generated from the same objects always has the same order.
 func (ms *multiSorter) Sort(tableRow []TableRow) {
 	ms.rows = tableRow
-	sort.Sort(ms)
+	sort.Stable(ms)
 }
 
 func OrderedBy(less []lessFunc) *multiSorter {
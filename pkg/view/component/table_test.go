@@ -210,6 +210,21 @@ func Test_Table_Sort(t *testing.T) {
 				{"a": NewText("1"), "b": NewText("1")},
 			},
 		},
+		{
+			name: "equal keys keep their order",
+			rows: []TableRow{
+				{"a": NewText("1"), "b": NewText("x")},
+				{"a": NewText("1"), "b": NewText("y")},
+				{"a": NewText("0"), "b": NewText("z")},
+				{"a": NewText("1"), "b": NewText("w")},
+			},
+			expected: []TableRow{
+				{"a": NewText("0"), "b": NewText("z")},
+				{"a": NewText("1"), "b": NewText("x")},
+				{"a": NewText("1"), "b": NewText("y")},
+				{"a": NewText("1"), "b": NewText("w")},
+			},
+		},
 	}
 
 	for _, tc := range cases {
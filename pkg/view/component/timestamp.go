@@ -20,9 +20,21 @@ type Timestamp struct {
 
 var _ Component = (*Timestamp)(nil)
 
+const (
+	// TimestampFormatRelative shows a timestamp as the time since it happened, e.g. 5m.
+	TimestampFormatRelative = "relative"
+	// TimestampFormatAbsolute shows a timestamp as a date and time.
+	TimestampFormatAbsolute = "absolute"
+)
+
 // TimestampConfig is the contents of Timestamp
 type TimestampConfig struct {
 	Timestamp int64 `json:"timestamp"`
+	// Format is how the timestamp is shown. Clients choose if it is blank.
+	Format string `json:"format,omitempty"`
+	// TimeZone is the IANA time zone absolute timestamps are shown in. Clients use
+	// their local time zone if it is blank.
+	TimeZone string `json:"timeZone,omitempty"`
 }
 
 // NewTimestamp creates a timestamp component
@@ -35,6 +47,12 @@ func NewTimestamp(t time.Time) *Timestamp {
 	}
 }
 
+// SetFormat sets how the timestamp is shown and the time zone absolute timestamps are shown in.
+func (t *Timestamp) SetFormat(format, timeZone string) {
+	t.Config.Format = format
+	t.Config.TimeZone = timeZone
+}
+
 type timestampMarshal Timestamp
 
 // MarshalJSON implements json.Marshaler
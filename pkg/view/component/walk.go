@@ -0,0 +1,171 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"reflect"
+)
+
+var componentType = reflect.TypeOf((*Component)(nil)).Elem()
+
+// Walk calls fn for c and every component nested in it, parents before children.
+// Nested components are found in fields, slices, and maps of the component's config.
+func Walk(c Component, fn func(Component)) {
+	if c == nil {
+		return
+	}
+	walkValue(reflect.ValueOf(c), fn)
+}
+
+func walkValue(v reflect.Value, fn func(Component)) {
+	switch v.Kind() {
+	case reflect.Interface:
+		if v.IsNil() {
+			return
+		}
+		walkValue(v.Elem(), fn)
+	case reflect.Ptr:
+		if v.IsNil() {
+			return
+		}
+		if v.Type().Implements(componentType) {
+			fn(v.Interface().(Component))
+		}
+		walkValue(v.Elem(), fn)
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).PkgPath != "" {
+				// Unexported fields don't contain components which are sent to clients.
+				continue
+			}
+			walkValue(v.Field(i), fn)
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			walkValue(v.Index(i), fn)
+		}
+	case reflect.Map:
+		iter := v.MapRange()
+		for iter.Next() {
+			walkValue(iter.Value(), fn)
+		}
+	}
+}
+
+// Rewrite returns c with every component nested in it replaced by the result of fn.
+// Components which fn returns unchanged are searched for nested components. c is not
+// modified: the components containing a replaced component are shallow copies.
+func Rewrite(c Component, fn func(Component) Component) Component {
+	if c == nil {
+		return nil
+	}
+
+	v, changed := rewriteValue(reflect.ValueOf(c), fn)
+	if !changed {
+		return c
+	}
+	return v.Interface().(Component)
+}
+
+func rewriteValue(v reflect.Value, fn func(Component) Component) (reflect.Value, bool) {
+	switch v.Kind() {
+	case reflect.Interface:
+		if v.IsNil() {
+			return v, false
+		}
+		elem, changed := rewriteValue(v.Elem(), fn)
+		if !changed {
+			return v, false
+		}
+		out := reflect.New(v.Type()).Elem()
+		out.Set(elem)
+		return out, true
+	case reflect.Ptr:
+		if v.IsNil() {
+			return v, false
+		}
+		if v.Type().Implements(componentType) {
+			c := v.Interface().(Component)
+			if replaced := fn(c); replaced != c {
+				rv := reflect.ValueOf(replaced)
+				if rv.Type().AssignableTo(v.Type()) {
+					return rv, true
+				}
+			}
+		}
+		elem, changed := rewriteValue(v.Elem(), fn)
+		if !changed {
+			return v, false
+		}
+		out := reflect.New(v.Type().Elem())
+		out.Elem().Set(elem)
+		return out, true
+	case reflect.Struct:
+		var out reflect.Value
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).PkgPath != "" {
+				continue
+			}
+			field, changed := rewriteValue(v.Field(i), fn)
+			if !changed {
+				continue
+			}
+			if !out.IsValid() {
+				out = reflect.New(v.Type()).Elem()
+				out.Set(v)
+			}
+			out.Field(i).Set(field)
+		}
+		if !out.IsValid() {
+			return v, false
+		}
+		return out, true
+	case reflect.Slice, reflect.Array:
+		var out reflect.Value
+		for i := 0; i < v.Len(); i++ {
+			elem, changed := rewriteValue(v.Index(i), fn)
+			if !changed {
+				continue
+			}
+			if !out.IsValid() {
+				if v.Kind() == reflect.Slice {
+					out = reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+				} else {
+					out = reflect.New(v.Type()).Elem()
+				}
+				reflect.Copy(out, v)
+			}
+			out.Index(i).Set(elem)
+		}
+		if !out.IsValid() {
+			return v, false
+		}
+		return out, true
+	case reflect.Map:
+		var out reflect.Value
+		iter := v.MapRange()
+		for iter.Next() {
+			value, changed := rewriteValue(iter.Value(), fn)
+			if !changed {
+				continue
+			}
+			if !out.IsValid() {
+				out = reflect.MakeMapWithSize(v.Type(), v.Len())
+				copyIter := v.MapRange()
+				for copyIter.Next() {
+					out.SetMapIndex(copyIter.Key(), copyIter.Value())
+				}
+			}
+			out.SetMapIndex(iter.Key(), value)
+		}
+		if !out.IsValid() {
+			return v, false
+		}
+		return out, true
+	default:
+		return v, false
+	}
+}
@@ -0,0 +1,110 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWalk(t *testing.T) {
+	created := NewTimestamp(time.Unix(1600000000, 0))
+	updated := NewTimestamp(time.Unix(1600000100, 0))
+
+	table := NewTableWithRows("table", "placeholder", NewTableCols("Name", "Age"), []TableRow{
+		{"Name": NewText("name"), "Age": created},
+	})
+
+	card := NewCard(TitleFromString("card"))
+	card.SetBody(NewList(TitleFromString("list"), []Component{updated}))
+
+	layout := NewFlexLayout("layout")
+	layout.AddSections(FlexLayoutSection{
+		{Width: WidthFull, View: table},
+		{Width: WidthFull, View: card},
+	})
+
+	var timestamps []*Timestamp
+	var visited []Component
+	Walk(layout, func(c Component) {
+		visited = append(visited, c)
+		if ts, ok := c.(*Timestamp); ok {
+			timestamps = append(timestamps, ts)
+		}
+	})
+
+	assert.ElementsMatch(t, []*Timestamp{created, updated}, timestamps)
+	assert.Equal(t, Component(layout), visited[0])
+	assert.Contains(t, visited, Component(table))
+	assert.Contains(t, visited, Component(card))
+}
+
+func TestWalk_nil(t *testing.T) {
+	Walk(nil, func(Component) {
+		t.Fatal("fn should not be called")
+	})
+}
+
+func TestRewrite(t *testing.T) {
+	text := NewText("text")
+	table := NewTableWithRows("table", "placeholder", NewTableCols("Name"), []TableRow{
+		{"Name": NewText("name")},
+	})
+
+	card := NewCard(TitleFromString("card"))
+	card.SetBody(NewList(TitleFromString("list"), []Component{table}))
+
+	layout := NewFlexLayout("layout")
+	layout.AddSections(FlexLayoutSection{
+		{Width: WidthFull, View: text},
+		{Width: WidthFull, View: card},
+	})
+
+	replacement := NewTable("replacement", "placeholder", NewTableCols("Name"))
+	got := Rewrite(layout, func(c Component) Component {
+		if c == table {
+			return replacement
+		}
+		return c
+	})
+
+	var visited []Component
+	Walk(got, func(c Component) {
+		visited = append(visited, c)
+	})
+	assert.Contains(t, visited, Component(replacement))
+	assert.Contains(t, visited, Component(text))
+	assert.NotContains(t, visited, Component(table))
+
+	// The original components are unchanged.
+	visited = nil
+	Walk(layout, func(c Component) {
+		visited = append(visited, c)
+	})
+	assert.Contains(t, visited, Component(table))
+	assert.NotContains(t, visited, Component(replacement))
+
+	unchanged := Rewrite(layout, func(c Component) Component { return c })
+	assert.True(t, unchanged == Component(layout))
+}
+
+func TestRewrite_nil(t *testing.T) {
+	assert.Nil(t, Rewrite(nil, func(c Component) Component { return c }))
+}
+
+func TestTimestamp_SetFormat(t *testing.T) {
+	ts := NewTimestamp(time.Unix(1600000000, 0))
+	ts.SetFormat(TimestampFormatAbsolute, "Europe/Berlin")
+
+	expected := TimestampConfig{
+		Timestamp: 1600000000,
+		Format:    TimestampFormatAbsolute,
+		TimeZone:  "Europe/Berlin",
+	}
+	assert.Equal(t, expected, ts.Config)
+}
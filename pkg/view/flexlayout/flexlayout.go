@@ -30,6 +30,15 @@ func (fl *FlexLayout) AddSection() *Section {
 	return section
 }
 
+// Append adds the sections of other to the end of the flex layout. The buttons of other
+// are ignored.
+func (fl *FlexLayout) Append(other *FlexLayout) {
+	if other == nil {
+		return
+	}
+	fl.sections = append(fl.sections, other.sections...)
+}
+
 // AddButton adds a button the button group for a flex layout.
 func (fl *FlexLayout) AddButton(name string, payload action.Payload, buttonOptions ...component.ButtonOption) {
 	button := component.NewButton(name, payload, buttonOptions...)
@@ -2,6 +2,7 @@ import {
   ChangeDetectionStrategy,
   Component,
   Input,
+  OnChanges,
   OnInit,
 } from '@angular/core';
 import { Alert } from '../../../models/content';
@@ -26,7 +27,7 @@ const alertShapes = {
   styleUrls: ['./alert.component.scss'],
   changeDetection: ChangeDetectionStrategy.OnPush,
 })
-export class AlertComponent implements OnInit {
+export class AlertComponent implements OnInit, OnChanges {
   @Input() alert: Alert;
   shape = '';
   alertClass = '';
@@ -36,6 +37,14 @@ export class AlertComponent implements OnInit {
   constructor() {}
 
   ngOnInit(): void {
+    this.update();
+  }
+
+  ngOnChanges(): void {
+    this.update();
+  }
+
+  private update(): void {
     if (this.alert) {
       const alertClass = alertLookup[this.alert.type] || alertLookup.error;
       this.shape = alertShapes[alertClass];
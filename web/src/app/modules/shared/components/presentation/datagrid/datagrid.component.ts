@@ -13,6 +13,7 @@ import {
   Confirmation,
   GridAction,
   GridActionsView,
+  TableColumn,
   TableFilters,
   TableRow,
   TableRowWithMetadata,
@@ -40,7 +41,7 @@ export class DatagridComponent extends AbstractViewComponent<TableView> {
   timeStampComparator = new TimestampComparator();
   sortOrder: ClrDatagridSortOrder = ClrDatagridSortOrder.UNSORTED;
 
-  columns: string[];
+  columns: TableColumn[];
   rowsWithMetadata: TableRowWithMetadata[];
   title: string;
   placeholder: string;
@@ -52,7 +53,7 @@ export class DatagridComponent extends AbstractViewComponent<TableView> {
   actionDialogOptions: ActionDialogOptions = undefined;
 
   identifyRow = trackByIndex;
-  identifyColumn = trackByIdentity;
+  identifyColumn = (index: number, column: TableColumn) => column.accessor;
   identifyAction = trackByIdentity;
 
   loading: boolean;
@@ -85,7 +86,7 @@ export class DatagridComponent extends AbstractViewComponent<TableView> {
       done.complete();
       this.cdr.markForCheck();
     });
-    this.columns = this.v.config.columns.map(column => column.name);
+    this.columns = this.v.config.columns;
     this.filters = this.v.config.filters;
     this.buttonGroup = this.v.config.buttonGroup;
   }
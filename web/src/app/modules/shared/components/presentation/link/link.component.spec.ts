@@ -28,4 +28,22 @@ describe('LinkComponent', () => {
   it('should create', () => {
     expect(component).toBeTruthy();
   });
+
+  it('should render download links with the download attribute', () => {
+    component.view = {
+      config: {
+        value: 'Download',
+        ref: '/api/v1/configmaps/default/settings/data/app.yaml',
+        download: true,
+      },
+      metadata: { type: 'link' },
+    };
+    fixture.detectChanges();
+
+    const anchor: HTMLAnchorElement = fixture.nativeElement.querySelector('a');
+    expect(anchor.hasAttribute('download')).toBeTrue();
+    expect(anchor.getAttribute('href')).toContain(
+      '/api/v1/configmaps/default/settings/data/app.yaml'
+    );
+  });
 });
@@ -5,6 +5,7 @@
 import { Component } from '@angular/core';
 import { LinkView } from 'src/app/modules/shared/models/content';
 import { AbstractViewComponent } from '../../abstract-view/abstract-view.component';
+import getAPIBase from '../../../services/common/getAPIBase';
 
 const isUrlExternal = url =>
   url?.indexOf('://') > 0 || url?.indexOf('//') === 0;
@@ -18,6 +19,7 @@ export class LinkComponent extends AbstractViewComponent<LinkView> {
   ref: string;
   value: string;
   isExternal: boolean;
+  isDownload: boolean;
   hasStatus: boolean;
 
   constructor() {
@@ -29,6 +31,10 @@ export class LinkComponent extends AbstractViewComponent<LinkView> {
     this.ref = view.config.ref;
     this.value = view.config.value;
     this.isExternal = isUrlExternal(this.ref);
+    this.isDownload = !!view.config.download;
+    if (this.isDownload && !this.isExternal) {
+      this.ref = getAPIBase() + this.ref;
+    }
 
     if (view.config.status) {
       this.hasStatus = true;
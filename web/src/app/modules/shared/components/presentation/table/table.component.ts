@@ -3,8 +3,11 @@
 //
 
 import { Component } from '@angular/core';
-import { TableRow, TableView } from 'src/app/modules/shared/models/content';
-import trackByIdentity from 'src/app/util/trackBy/trackByIdentity';
+import {
+  TableColumn,
+  TableRow,
+  TableView,
+} from 'src/app/modules/shared/models/content';
 import trackByIndex from 'src/app/util/trackBy/trackByIndex';
 import { ViewService } from '../../../services/view/view.service';
 import { AbstractViewComponent } from '../../abstract-view/abstract-view.component';
@@ -15,11 +18,11 @@ import { AbstractViewComponent } from '../../abstract-view/abstract-view.compone
   styleUrls: ['./table.component.scss'],
 })
 export class TableComponent extends AbstractViewComponent<TableView> {
-  columns: string[];
+  columns: TableColumn[];
   rows: TableRow[];
   title: string;
   placeholder: string;
-  trackByIdentity = trackByIdentity;
+  identifyColumn = (index: number, column: TableColumn) => column.accessor;
   trackByIndex = trackByIndex;
 
   constructor(private viewService: ViewService) {
@@ -29,7 +32,7 @@ export class TableComponent extends AbstractViewComponent<TableView> {
   update() {
     const current = this.v;
     this.title = this.viewService.viewTitleAsText(current);
-    this.columns = current.config.columns.map(column => column.name);
+    this.columns = current.config.columns;
     this.rows = current.config.rows;
     this.placeholder = current.config.emptyContent;
   }
@@ -10,6 +10,10 @@ import { ActionService } from '../../../services/action/action.service';
 import { AbstractViewComponent } from '../../abstract-view/abstract-view.component';
 import { ThemeService } from '../../../services/theme/theme.service';
 import { Subscription } from 'rxjs';
+import getAPIBase from '../../../services/common/getAPIBase';
+import { yamlFieldPath } from './yaml-field-path';
+
+declare const monaco: any;
 
 interface Options {
   readOnly: boolean;
@@ -42,6 +46,7 @@ export class EditorComponent
   private pristineValue: string;
   uri: string;
   private metadata: { [p: string]: string };
+  private hoverProvider: { dispose: () => void };
 
   isModified = false;
 
@@ -50,6 +55,9 @@ export class EditorComponent
   submitAction = 'action.octant.dev/update';
   submitLabel = 'Update';
 
+  validateAction: string;
+  validateLabel = 'Validate';
+
   constructor(
     private namespaceService: NamespaceService,
     private themeService: ThemeService,
@@ -87,11 +95,21 @@ export class EditorComponent
 
     this.submitAction = view.config.submitAction || this.submitAction;
     this.submitLabel = view.config.submitLabel || this.submitLabel;
+    this.validateAction = view.config.validateAction;
+    this.validateLabel = view.config.validateLabel || this.validateLabel;
   }
 
   submit() {
+    this.perform(this.submitAction);
+  }
+
+  validate() {
+    this.perform(this.validateAction);
+  }
+
+  private perform(action: string) {
     const payload = {
-      action: this.submitAction,
+      action,
       update: this.value,
       ...(this.metadata || {
         namespace: this.namespaceService.activeNamespace.value,
@@ -100,6 +118,46 @@ export class EditorComponent
     this.actionService.perform(payload);
   }
 
+  onEditorInit(editor: any) {
+    const model = editor.getModel();
+    this.hoverProvider = monaco.languages.registerHoverProvider('yaml', {
+      provideHover: (hoverModel, position) => {
+        if (hoverModel !== model) {
+          return null;
+        }
+        return this.explain(model.getLinesContent(), position.lineNumber - 1);
+      },
+    });
+  }
+
+  private explain(lines: string[], lineIndex: number) {
+    const field = yamlFieldPath(lines, lineIndex);
+    if (!field || !this.metadata?.apiVersion || !this.metadata?.kind) {
+      return null;
+    }
+
+    const params = new URLSearchParams({
+      apiVersion: this.metadata.apiVersion,
+      kind: this.metadata.kind,
+      field,
+    });
+
+    return fetch(`${getAPIBase()}/api/v1/explain?${params.toString()}`)
+      .then(response => (response.ok ? response.json() : null))
+      .then(explained => {
+        if (!explained) {
+          return null;
+        }
+        return {
+          contents: [
+            { value: `**${field}** <${explained.type}>` },
+            { value: explained.description },
+          ],
+        };
+      })
+      .catch(() => null);
+  }
+
   isUpdateEnabled() {
     return !this.isModified;
   }
@@ -110,5 +168,8 @@ export class EditorComponent
 
   ngOnDestroy() {
     this.subscriptionTheme.unsubscribe();
+    if (this.hoverProvider) {
+      this.hoverProvider.dispose();
+    }
   }
 }
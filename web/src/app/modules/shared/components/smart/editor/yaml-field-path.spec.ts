@@ -0,0 +1,46 @@
+// Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+//
+
+import { yamlFieldPath } from './yaml-field-path';
+
+describe('yamlFieldPath', () => {
+  const lines = [
+    'apiVersion: apps/v1',
+    'kind: Deployment',
+    'spec:',
+    '  replicas: 3',
+    '  template:',
+    '    spec:',
+    '      containers:',
+    '      - name: app',
+    '        image: nginx',
+    '        ports:',
+    '        - containerPort: 80',
+    '      # a comment',
+  ];
+
+  it('returns top level fields', () => {
+    expect(yamlFieldPath(lines, 0)).toEqual('apiVersion');
+  });
+
+  it('returns nested fields', () => {
+    expect(yamlFieldPath(lines, 3)).toEqual('spec.replicas');
+  });
+
+  it('returns fields of list items', () => {
+    expect(yamlFieldPath(lines, 7)).toEqual(
+      'spec.template.spec.containers.name'
+    );
+    expect(yamlFieldPath(lines, 8)).toEqual(
+      'spec.template.spec.containers.image'
+    );
+    expect(yamlFieldPath(lines, 10)).toEqual(
+      'spec.template.spec.containers.ports.containerPort'
+    );
+  });
+
+  it('returns null for lines without a field', () => {
+    expect(yamlFieldPath(lines, 11)).toBeNull();
+  });
+});
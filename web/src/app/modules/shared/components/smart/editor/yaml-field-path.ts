@@ -0,0 +1,33 @@
+// Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+//
+
+const keyPattern = /^(\s*(?:-\s+)?)([A-Za-z0-9_./-]+):(\s|$)/;
+
+/**
+ * Returns the dotted path of the field defined on a line of a YAML document,
+ * e.g. spec.template.spec.containers.image. List items don't add to the path,
+ * which matches how kubectl explain names fields. Null is returned if the line
+ * doesn't define a field.
+ */
+export function yamlFieldPath(lines: string[], lineIndex: number): string {
+  const match = keyPattern.exec(lines[lineIndex] || '');
+  if (!match) {
+    return null;
+  }
+
+  const path = [match[2]];
+  let indent = match[1].length;
+
+  for (let i = lineIndex - 1; i >= 0 && indent > 0; i--) {
+    const parent = keyPattern.exec(lines[i]);
+    if (!parent || parent[1].length >= indent) {
+      continue;
+    }
+
+    path.unshift(parent[2]);
+    indent = parent[1].length;
+  }
+
+  return path.join('.');
+}
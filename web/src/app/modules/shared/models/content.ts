@@ -12,12 +12,14 @@ export interface Content {
   viewComponents: View[];
   title: View[];
   buttonGroup?: ButtonGroupView;
+  alert?: Alert;
 }
 
 export interface Metadata {
   type: string;
   title?: View[];
   accessor?: string;
+  tooltip?: string;
 }
 
 export interface View {
@@ -166,6 +168,7 @@ export interface LinkView extends View {
     value: string;
     status?: number;
     statusDetail?: View;
+    download?: boolean;
   };
 }
 
@@ -330,6 +333,7 @@ export interface TableView extends View {
     loading: boolean;
     filters: TableFilters;
     buttonGroup?: ButtonGroupView;
+    streamID?: string;
   };
 }
 
@@ -477,6 +481,8 @@ export interface EditorView extends View {
     metadata: { [key: string]: string };
     submitAction: string;
     submitLabel: string;
+    validateAction?: string;
+    validateLabel?: string;
   };
 }
 
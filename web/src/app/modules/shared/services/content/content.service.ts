@@ -7,7 +7,12 @@
 import { Injectable } from '@angular/core';
 import { WebsocketService } from '../../../../data/services/websocket/websocket.service';
 import { BehaviorSubject, Observable } from 'rxjs';
-import { Content, ContentResponse } from '../../models/content';
+import {
+  Content,
+  ContentResponse,
+  TableRow,
+  TableView,
+} from '../../models/content';
 import { Params, Router } from '@angular/router';
 import {
   Filter,
@@ -26,6 +31,15 @@ export interface ContentUpdate {
   queryParams: { [key: string]: string[] };
 }
 
+export const TableRowsMessage = 'event.octant.dev/tableRows';
+
+export interface TableRowsUpdate {
+  contentPath: string;
+  streamID: string;
+  rows: TableRow[];
+  done: boolean;
+}
+
 const emptyContentResponse: ContentResponse = {
   content: { extensionComponent: null, viewComponents: [], title: [] },
   currentPath: '',
@@ -96,6 +110,10 @@ export class ContentService {
       this.previousContentPath = response.contentPath;
     });
 
+    websocketService.registerHandler(TableRowsMessage, data => {
+      this.appendTableRows(data as TableRowsUpdate);
+    });
+
     labelFilterService.filters.subscribe(filters => {
       this.filters = filters;
     });
@@ -141,7 +159,44 @@ export class ContentService {
     this.current.next(contentResponse);
   }
 
+  private appendTableRows(update: TableRowsUpdate) {
+    const current = this.current.getValue();
+    if (current.currentPath !== update.contentPath) {
+      return;
+    }
+
+    const table = findStreamedTable(
+      current.content.viewComponents,
+      update.streamID
+    );
+    if (!table) {
+      return;
+    }
+
+    table.config.rows = [...table.config.rows, ...update.rows];
+    this.current.next({ ...current });
+  }
+
   setScrollPos(pos: number) {
     this.viewScrollPos.next(pos);
   }
 }
+
+const findStreamedTable = (value: any, streamID: string): TableView => {
+  if (!value || typeof value !== 'object') {
+    return undefined;
+  }
+
+  if (value.metadata?.type === 'table' && value.config?.streamID === streamID) {
+    return value as TableView;
+  }
+
+  for (const key of Object.keys(value)) {
+    const found = findStreamedTable(value[key], streamID);
+    if (found) {
+      return found;
+    }
+  }
+
+  return undefined;
+};
@@ -21,6 +21,8 @@ export class ApplyYAMLComponent implements OnInit {
       metadata: null,
       submitAction: 'action.octant.dev/apply',
       submitLabel: 'Apply',
+      validateAction: 'action.octant.dev/validateYaml',
+      validateLabel: 'Validate',
     },
     metadata: {
       type: 'editor',
@@ -39,6 +39,7 @@ import { windowProvider, WindowToken } from '../../../../../window';
 import { SharedModule } from 'src/app/modules/shared/shared.module';
 import { OverlayScrollbarsComponent } from 'overlayscrollbars-ngx';
 import { ApplyYAMLComponent } from '../apply-yaml/apply-yaml.component';
+import { EventModalComponent } from '../event-modal/event-modal.component';
 
 describe('AppComponent', () => {
   let component: ContainerComponent;
@@ -67,6 +68,7 @@ describe('AppComponent', () => {
         declarations: [
           ApplyYAMLComponent,
           ContainerComponent,
+          EventModalComponent,
           NamespaceComponent,
           PageNotFoundComponent,
           HelperComponent,
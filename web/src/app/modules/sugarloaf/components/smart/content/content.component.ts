@@ -12,6 +12,7 @@ import {
 } from '@angular/core';
 import { Params, Router, UrlSegment } from '@angular/router';
 import {
+  Alert,
   ButtonGroupView,
   ContentResponse,
   ExtensionView,
@@ -42,6 +43,7 @@ export class ContentComponent implements OnInit, OnDestroy {
   extView: ExtensionView = null;
   singleView: View = null;
   buttonGroup: ButtonGroupView = null;
+  alert: Alert = null;
   private contentSubscription: Subscription;
   private previousUrl = '';
   private defaultPath: string;
@@ -131,6 +133,7 @@ export class ContentComponent implements OnInit, OnDestroy {
   private resetView() {
     this.title = null;
     this.views = null;
+    this.alert = null;
   }
 
   private setContent = (contentResponse: ContentResponse) => {
@@ -148,6 +151,7 @@ export class ContentComponent implements OnInit, OnDestroy {
     }
 
     this.buttonGroup = contentResponse.content.buttonGroup;
+    this.alert = contentResponse.content.alert;
 
     this.extView = contentResponse.content.extensionComponent;
     this.views = views;
@@ -0,0 +1,70 @@
+// Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+//
+
+import { ComponentFixture, TestBed, waitForAsync } from '@angular/core/testing';
+import { EventModalComponent, ModalMessage } from './event-modal.component';
+import { SharedModule } from 'src/app/modules/shared/shared.module';
+import { WebsocketService } from '../../../../../data/services/websocket/websocket.service';
+import { WebsocketServiceMock } from '../../../../../data/services/websocket/mock';
+import { ActionService } from '../../../../shared/services/action/action.service';
+
+describe('EventModalComponent', () => {
+  let component: EventModalComponent;
+  let fixture: ComponentFixture<EventModalComponent>;
+  let websocketService: WebsocketServiceMock;
+
+  beforeEach(
+    waitForAsync(() => {
+      TestBed.configureTestingModule({
+        declarations: [EventModalComponent],
+        imports: [SharedModule],
+        providers: [
+          { provide: WebsocketService, useClass: WebsocketServiceMock },
+        ],
+      }).compileComponents();
+    })
+  );
+
+  beforeEach(() => {
+    fixture = TestBed.createComponent(EventModalComponent);
+    component = fixture.componentInstance;
+    websocketService = TestBed.inject(WebsocketService) as any;
+    fixture.detectChanges();
+  });
+
+  it('should create', () => {
+    expect(component).toBeTruthy();
+    expect(component.opened).toBeFalse();
+  });
+
+  it('should open modals sent by the backend', () => {
+    const payload = { action: 'action.octant.dev/update' };
+    websocketService.triggerHandler(ModalMessage, {
+      modal: {
+        metadata: { type: 'modal', title: [] },
+        config: {
+          opened: true,
+          size: 'xl',
+          buttons: [{ name: 'Apply', payload }],
+        },
+      },
+    });
+
+    expect(component.opened).toBeTrue();
+    expect(component.size).toEqual('xl');
+    expect(component.buttons).toEqual([{ name: 'Apply', payload }]);
+  });
+
+  it('should perform a button action and close', () => {
+    const actionService = TestBed.inject(ActionService);
+    spyOn(actionService, 'perform');
+    component.opened = true;
+
+    const payload = { action: 'action.octant.dev/update' };
+    component.onClick(payload);
+
+    expect(actionService.perform).toHaveBeenCalledWith(payload);
+    expect(component.opened).toBeFalse();
+  });
+});
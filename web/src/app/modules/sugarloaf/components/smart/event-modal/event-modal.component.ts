@@ -0,0 +1,70 @@
+// Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+//
+
+import { Component, OnDestroy, OnInit } from '@angular/core';
+import { WebsocketService } from '../../../../../data/services/websocket/websocket.service';
+import { ActionService } from '../../../../shared/services/action/action.service';
+import {
+  Button,
+  ModalView,
+  TitleView,
+  View,
+} from '../../../../shared/models/content';
+
+export const ModalMessage = 'event.octant.dev/modal';
+
+interface ModalEvent {
+  modal: ModalView;
+}
+
+// EventModalComponent opens modals sent by the backend, such as the
+// results of an action.
+@Component({
+  selector: 'app-event-modal',
+  templateUrl: './event-modal.component.html',
+  styleUrls: ['./event-modal.component.scss'],
+})
+export class EventModalComponent implements OnInit, OnDestroy {
+  opened = false;
+  title: TitleView[];
+  body: View;
+  size: string;
+  buttons: Button[];
+
+  private unregisterHandler: () => void;
+
+  constructor(
+    private websocketService: WebsocketService,
+    private actionService: ActionService
+  ) {}
+
+  ngOnInit(): void {
+    this.unregisterHandler = this.websocketService.registerHandler(
+      ModalMessage,
+      data => {
+        const modal = (data as ModalEvent).modal;
+        this.title = modal.metadata.title as TitleView[];
+        this.body = modal.config.body;
+        this.size = modal.config.size;
+        this.buttons = modal.config.buttons;
+        this.opened = modal.config.opened;
+      }
+    );
+  }
+
+  ngOnDestroy(): void {
+    if (this.unregisterHandler) {
+      this.unregisterHandler();
+    }
+  }
+
+  onClick(payload: {}) {
+    this.actionService.perform(payload);
+    this.opened = false;
+  }
+
+  trackByFn(index, _) {
+    return index;
+  }
+}
@@ -9,6 +9,7 @@ import { NavigationComponent } from './components/smart/navigation/navigation.co
 import { QuickSwitcherComponent } from './components/smart/quick-switcher/quick-switcher.component';
 import { ApplyYAMLComponent } from './components/smart/apply-yaml/apply-yaml.component';
 import { ThemeSwitchButtonComponent } from './components/smart/theme-switch/theme-switch-button.component';
+import { EventModalComponent } from './components/smart/event-modal/event-modal.component';
 import { UploaderComponent } from './components/smart/uploader/uploader.component';
 import { ClarityModule } from '@clr/angular';
 import { HttpClientModule } from '@angular/common/http';
@@ -39,6 +40,7 @@ export class UnstripTrailingSlashLocation extends Location {
   declarations: [
     ApplyYAMLComponent,
     ContainerComponent,
+    EventModalComponent,
     NamespaceComponent,
     PageNotFoundComponent,
     InputFilterComponent,